@@ -95,6 +95,14 @@ check_data_osv() {
   fi
 }
 
+# check_db generates the database from the reports committed at HEAD,
+# which checks the invariants between its files.
+check_db() {
+  local out=$(mktemp -d)
+  runcmd go run ./cmd/gendb -repo . -out $out
+  rm -rf $out
+}
+
 go_linters() {
   check_vet
   check_staticcheck
@@ -109,6 +117,7 @@ go_modtidy() {
 runchecks() {
   check_data_osv
   check_headers
+  check_db
   go_linters
   go_modtidy
 }
@@ -117,6 +126,7 @@ runchecks() {
 checkoffline() {
   check_data_osv
   check_headers
+  check_db
   check_vet
 }
 
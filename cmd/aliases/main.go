@@ -0,0 +1,116 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Command aliases maps a batch of CVE and GHSA IDs to the Go IDs
+// of their reports, and whether the Go vulnerability database covers
+// them, excluded them (and why) or does not know about them.
+//
+// Usage:
+//
+//	$ go run ./cmd/aliases [-repo DIR | -index FILE] [-json] [ID ...]
+//
+// If no IDs are given, they are read from standard input, one per
+// line. The -index flag reads the alias index written by
+// gendb -aliases instead of the reports in a vulndb repo.
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"golang.org/x/vulndb/internal/report"
+)
+
+var (
+	repoDir   = flag.String("repo", "", "directory containing the vulndb repo (default: clone github.com/golang/vulndb)")
+	indexFile = flag.String("index", "", "alias index file written by gendb -aliases, to use instead of a vulndb repo")
+	asJSON    = flag.Bool("json", false, "print the results as JSON")
+)
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: aliases [flags] [ID ...]\n\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	ids := flag.Args()
+	if len(ids) == 0 {
+		var err error
+		if ids, err = readIDs(); err != nil {
+			log.Fatal(err)
+		}
+	}
+	idx, err := loadIndex(context.Background())
+	if err != nil {
+		log.Fatal(err)
+	}
+	results := idx.Resolve(ids...)
+
+	if *asJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(results); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+	tw := tabwriter.NewWriter(os.Stdout, 2, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "ALIAS\tSTATUS\tIDS\tREASONS")
+	for _, r := range results {
+		var reasons []string
+		for _, reason := range r.Reasons {
+			reasons = append(reasons, string(reason))
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", r.Alias, r.Status, strings.Join(r.IDs, ", "), strings.Join(reasons, ", "))
+	}
+	tw.Flush()
+}
+
+// readIDs reads IDs from standard input, one per line,
+// ignoring blank lines.
+func readIDs() ([]string, error) {
+	var ids []string
+	s := bufio.NewScanner(os.Stdin)
+	for s.Scan() {
+		if id := strings.TrimSpace(s.Text()); id != "" {
+			ids = append(ids, id)
+		}
+	}
+	return ids, s.Err()
+}
+
+func loadIndex(ctx context.Context) (report.AliasIndex, error) {
+	if *indexFile != "" {
+		b, err := os.ReadFile(*indexFile)
+		if err != nil {
+			return nil, err
+		}
+		var idx report.AliasIndex
+		if err := json.Unmarshal(b, &idx); err != nil {
+			return nil, fmt.Errorf("%s: %w", *indexFile, err)
+		}
+		return idx, nil
+	}
+	var (
+		rc  *report.Client
+		err error
+	)
+	if *repoDir != "" {
+		rc, err = report.NewLocalClient(ctx, *repoDir)
+	} else {
+		rc, err = report.NewDefaultClient(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return rc.AliasIndex(), nil
+}
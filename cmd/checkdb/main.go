@@ -11,6 +11,7 @@ import (
 	"log"
 
 	db "golang.org/x/vulndb/internal/database"
+	"golang.org/x/vulndb/internal/osvutils"
 )
 
 func main() {
@@ -22,5 +23,8 @@ func main() {
 	if _, err := db.Load(path); err != nil {
 		log.Fatal(err)
 	}
+	if err := osvutils.ValidateDB(path); err != nil {
+		log.Fatal(err)
+	}
 	fmt.Printf("%s contains valid v1 database\n", path)
 }
@@ -15,8 +15,9 @@ import (
 )
 
 var (
-	newPath      = flag.String("new", "", "path to new database")
-	existingPath = flag.String("existing", "", "path to existing database")
+	newPath        = flag.String("new", "", "path to new database")
+	existingPath   = flag.String("existing", "", "path to existing database")
+	allowAnomalies = flag.Bool("allow-anomalies", false, "allow deploying a database with anomalies compared with the existing one")
 )
 
 func main() {
@@ -31,5 +32,17 @@ func main() {
 	if err := db.ValidateDeploy(*newPath, *existingPath); err != nil {
 		log.Fatal(err)
 	}
+	anomalies, err := db.FindAnomalies(*newPath, *existingPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if len(anomalies) > 0 {
+		if !*allowAnomalies {
+			log.Fatalf("%v\nuse -allow-anomalies to deploy anyway", db.AnomaliesError(anomalies))
+		}
+		for _, a := range anomalies {
+			fmt.Printf("allowed anomaly: %s\n", a)
+		}
+	}
 	fmt.Printf("ok to deploy v1 database %s on top of %s\n", *newPath, *existingPath)
 }
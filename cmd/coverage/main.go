@@ -0,0 +1,96 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Command coverage reports which modules of a go.sum file or SBOM the
+// Go vulnerability database has assessed.
+//
+// Usage:
+//
+//	$ go run ./cmd/coverage [-repo DIR] [-json] FILE
+//
+// FILE is a go.sum file, or an SBOM in JSON form (CycloneDX or SPDX)
+// that identifies Go modules by their package URLs. For each module
+// version, coverage prints whether it is affected by a report in the
+// database, has reports that do not affect it, has only excluded
+// reports, or has never been assessed, followed by a summary.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"golang.org/x/vulndb/internal/coverage"
+	"golang.org/x/vulndb/internal/report"
+)
+
+var (
+	repoDir = flag.String("repo", "", "directory containing the vulndb repo (default: clone github.com/golang/vulndb)")
+	asJSON  = flag.Bool("json", false, "print the results as JSON")
+)
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: coverage [flags] FILE\n\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	data, err := os.ReadFile(flag.Arg(0))
+	if err != nil {
+		log.Fatal(err)
+	}
+	mods, err := coverage.Parse(data)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	ctx := context.Background()
+	var rc *report.Client
+	if *repoDir != "" {
+		rc, err = report.NewLocalClient(ctx, *repoDir)
+	} else {
+		rc, err = report.NewDefaultClient(ctx)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+	results, err := coverage.Check(mods, rc)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if *asJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(results); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+	display(results)
+}
+
+func display(results []*coverage.Result) {
+	tw := tabwriter.NewWriter(os.Stdout, 2, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "MODULE\tVERSION\tSTATUS\tREPORTS")
+	counts := make(map[coverage.Status]int)
+	for _, r := range results {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", r.Module, r.Version, r.Status, strings.Join(r.Reports, ", "))
+		counts[r.Status]++
+	}
+	tw.Flush()
+
+	fmt.Printf("\n%d of %d module versions have a history in vulndb (%d affected, %d assessed, %d excluded); %d have never been assessed\n",
+		len(results)-counts[coverage.NotAssessed], len(results),
+		counts[coverage.Affected], counts[coverage.Assessed], counts[coverage.Excluded], counts[coverage.NotAssessed])
+}
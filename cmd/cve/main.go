@@ -8,6 +8,7 @@ package main
 
 import (
 	"bufio"
+	"context"
 	"encoding/json"
 	"errors"
 	"flag"
@@ -23,17 +24,20 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"golang.org/x/vulndb/internal/cve5"
 	"golang.org/x/vulndb/internal/idstr"
+	"golang.org/x/vulndb/internal/secrets"
 )
 
 var (
 	apiKey = flag.String("key",
-		os.Getenv("CVE_API_KEY"), "key for accessing the CVE API (can also be set via env var CVE_API_KEY)")
+		"", "key for accessing the CVE API (default: the cve-api-key secret)")
 	apiUser = flag.String("user",
-		os.Getenv("CVE_API_USER"), "username for accessing the CVE API (can also be set via env var CVE_API_USER)")
+		"", "username for accessing the CVE API (default: the cve-api-user secret)")
 	testApiKey = flag.String("test-key",
-		os.Getenv("TEST_CVE_API_KEY"), "key for accessing the CVE API in test env (can also be set via env var TEST_CVE_API_KEY)")
+		"", "key for accessing the CVE API in test env (default: the test-cve-api-key secret)")
 	testApiUser = flag.String("test-user",
-		os.Getenv("TEST_CVE_API_USER"), "username for accessing the CVE API in test env (can also be set via env var TEST_CVE_API_USER)")
+		"", "username for accessing the CVE API in test env (default: the test-cve-api-user secret)")
+	secretsSpec = flag.String("secrets",
+		"env", "where to read secrets from: env (environment variables), file:DIR (files named after the secrets in DIR) or gcp:PROJECT (GCP Secret Manager)")
 	apiOrg = flag.String("org",
 		"Go", "organization name for accessing the CVE API")
 	// Note: the cve tool does not currently support the dev endpoint as there
@@ -74,7 +78,11 @@ func main() {
 		logFatalUsageErr("cve", fmt.Errorf("must provide subcommand"))
 	}
 
-	c := cve5.NewClient(*cfgFromFlags())
+	cfg, err := cfgFromFlags(context.Background())
+	if err != nil {
+		logFatalUsageErr("cve", err)
+	}
+	c := cve5.NewClient(*cfg)
 
 	cmd := flag.Arg(0)
 	switch cmd {
@@ -192,34 +200,38 @@ func currentYear() int {
 	return year
 }
 
-func cfgFromFlags() *cve5.Config {
-	if *test {
-		if *testApiKey == "" {
-			logFatalUsageErr("cve", errors.New("the test CVE API key (flag -test-key or env var TEST_CVE_API_KEY) must be set in test env"))
-		}
-		if *testApiUser == "" {
-			logFatalUsageErr("cve", errors.New("the test CVE API user (flag -test-user or env var TEST_CVE_API_USER) must be set in test env"))
-		}
-		return &cve5.Config{
-			Endpoint: cve5.TestEndpoint,
-			Key:      *testApiKey,
-			Org:      *apiOrg,
-			User:     *testApiUser,
-		}
+// cfgFromFlags returns the CVE API config for the environment selected
+// by the -test flag. Credentials given as flags take precedence over
+// those from the -secrets provider.
+func cfgFromFlags(ctx context.Context) (*cve5.Config, error) {
+	sp, cleanup, err := secrets.FromSpec(ctx, *secretsSpec)
+	if err != nil {
+		return nil, err
 	}
+	defer cleanup()
 
-	if *apiKey == "" {
-		logFatalUsageErr("cve", errors.New("the CVE API key (flag -key or env var CVE_API_KEY) must be set in prod env"))
+	endpoint, keyName, userName := cve5.ProdEndpoint, secrets.CVEAPIKey, secrets.CVEAPIUser
+	flags := map[string]string{keyName: *apiKey, userName: *apiUser}
+	hint := "set them with -key and -user, or -secrets"
+	if *test {
+		endpoint, keyName, userName = cve5.TestEndpoint, secrets.TestCVEAPIKey, secrets.TestCVEAPIUser
+		flags = map[string]string{keyName: *testApiKey, userName: *testApiUser}
+		hint = "set them with -test-key and -test-user, or -secrets"
 	}
-	if *apiUser == "" {
-		logFatalUsageErr("cve", errors.New("the CVE API user (flag -user or env var CVE_API_USER) must be set in prod env"))
+	vals, err := secrets.Require(ctx, secrets.Chain(secrets.Static(flags), sp), keyName, userName)
+	if me := new(secrets.MissingError); errors.As(err, &me) {
+		me.Hint = hint
+		return nil, me
+	}
+	if err != nil {
+		return nil, err
 	}
 	return &cve5.Config{
-		Endpoint: cve5.ProdEndpoint,
-		Key:      *apiKey,
+		Endpoint: endpoint,
+		Key:      vals[0],
 		Org:      *apiOrg,
-		User:     *apiUser,
-	}
+		User:     vals[1],
+	}, nil
 }
 
 func validateID(id string) (string, error) {
@@ -349,17 +361,36 @@ func publish(c *cve5.Client, filename string, interactive bool) (err error) {
 	if err != nil {
 		return err
 	}
+	// Never overwrite the record of a CVE owned by another CNA.
+	// Such CVEs belong in the cves section of a report, not cve_metadata.
+	if assigned.CNA != "" && assigned.CNA != c.Org {
+		return fmt.Errorf("%s: %w (owning CNA %s)", cveID, cve5.ErrNotGoAssigned, assigned.CNA)
+	}
 
 	var (
 		publishFunc func(string, *cve5.Containers) (*cve5.CVERecord, error)
 		action      string
 	)
-	switch state := assigned.State; state {
-	case cve5.StatePublished:
+	// Records of withdrawn reports are published by rejecting the CVE.
+	reasons := toPublish.CNAContainer.RejectedReasons
+	switch state := assigned.State; {
+	case len(reasons) > 0 && state == cve5.StateRejected:
+		fmt.Printf("%s is already rejected, skipping\n", cveID)
+		return nil
+	case len(reasons) > 0:
+		fmt.Printf("publish would reject %s with reason %q\n", cveID, reasons[0].Value)
+		publishFunc = func(id string, _ *cve5.Containers) (*cve5.CVERecord, error) {
+			return nil, c.Reject(id, reasons[0].Value)
+		}
+		action = "reject"
+	case state == cve5.StatePublished:
 		existing, err := c.RetrieveRecord(cveID)
 		if err != nil {
 			return err
 		}
+		if err := cve5.CheckGoAssigned(existing); err != nil {
+			return err
+		}
 		fmt.Printf("%s is published at %s\n", cveID, c.WebURL(cveID))
 		if diff := cmp.Diff(existing.Containers, *toPublish); diff != "" {
 			fmt.Printf("publish would update record with diff (-existing, +new):\n%s\n", diff)
@@ -369,7 +400,7 @@ func publish(c *cve5.Client, filename string, interactive bool) (err error) {
 		}
 		publishFunc = c.UpdateRecord
 		action = "update"
-	case cve5.StateReserved:
+	case state == cve5.StateReserved:
 		fmt.Printf("publish would create new record for %s\n", cveID)
 		publishFunc = c.CreateRecord
 		action = "create"
@@ -392,7 +423,7 @@ func publish(c *cve5.Client, filename string, interactive bool) (err error) {
 		return err
 	}
 
-	fmt.Printf("successfully %sd record for %s at %s\n", action, cveID, c.WebURL(cveID))
+	fmt.Printf("successfully %sed record for %s at %s\n", strings.TrimSuffix(action, "e"), cveID, c.WebURL(cveID))
 
 	return nil
 }
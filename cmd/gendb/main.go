@@ -8,17 +8,27 @@ package main
 
 import (
 	"context"
+	"encoding/json"
 	"flag"
 	"log"
+	"os"
 
 	db "golang.org/x/vulndb/internal/database"
 	"golang.org/x/vulndb/internal/gitrepo"
+	"golang.org/x/vulndb/internal/osvutils"
+	"golang.org/x/vulndb/internal/report"
 )
 
 var (
-	repoDir = flag.String("repo", ".", "Directory containing vulndb repo")
-	jsonDir = flag.String("out", "out", "Directory to write JSON database to")
-	zipFile = flag.String("zip", "", "if provided, file to write zipped database to (for v1 database only)")
+	repoDir   = flag.String("repo", ".", "Directory containing vulndb repo")
+	jsonDir   = flag.String("out", "out", "Directory to write JSON database to")
+	zipFile   = flag.String("zip", "", "if provided, file to write zipped database to (for v1 database only)")
+	encodings = flag.String("encodings", "gzip", "comma-separated compressed variants to write for each file (gzip, zstd)")
+	csafDir   = flag.String("csaf", "", "if provided, directory to write a CSAF 2.0 tree of advisories to")
+	csafURL   = flag.String("csaf-url", "https://vuln.go.dev/csaf", "URL the CSAF tree is served at, for its provider metadata")
+	shard     = flag.Bool("shard-modules", false, "also write shards of the modules index by first path element (index/modules/<element>.json)")
+	aliasFile = flag.String("aliases", "", "if provided, file to write an index mapping CVE and GHSA IDs to Go IDs and their status (covered, withdrawn, excluded) to")
+	prevDir   = flag.String("prev", "", "if provided, directory containing the previous generation of the database, which the new one must not remove entries from or go back in time from")
 )
 
 func main() {
@@ -32,12 +42,49 @@ func main() {
 	if err != nil {
 		log.Fatal(err)
 	}
+	encs, err := db.ParseEncodings(*encodings)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := d.SetEncodings(encs...); err != nil {
+		log.Fatal(err)
+	}
+	if *shard {
+		d.ShardModules()
+	}
 	if err := d.Write(*jsonDir); err != nil {
 		log.Fatal(err)
 	}
+	if *prevDir != "" {
+		err = osvutils.ValidateDBUpdate(*jsonDir, *prevDir)
+	} else {
+		err = osvutils.ValidateDB(*jsonDir)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
 	if *zipFile != "" {
 		if err := d.WriteZip(*zipFile); err != nil {
 			log.Fatal(err)
 		}
 	}
+	if *csafDir != "" {
+		if err := d.WriteCSAF(*csafDir, *csafURL); err != nil {
+			log.Fatal(err)
+		}
+	}
+	if *aliasFile != "" {
+		// Unlike the database, the alias index includes excluded reports.
+		rc, err := report.NewClient(repo)
+		if err != nil {
+			log.Fatal(err)
+		}
+		b, err := json.Marshal(rc.AliasIndex())
+		if err != nil {
+			log.Fatal(err)
+		}
+		if err := os.WriteFile(*aliasFile, b, 0644); err != nil {
+			log.Fatal(err)
+		}
+	}
 }
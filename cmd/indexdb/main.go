@@ -14,8 +14,10 @@ import (
 )
 
 var (
-	vulnsDir = flag.String("vulns", "", "Directory containing JSON OSV files")
-	outDir   = flag.String("out", "", "Directory to write database to")
+	vulnsDir  = flag.String("vulns", "", "Directory containing JSON OSV files")
+	outDir    = flag.String("out", "", "Directory to write database to")
+	encodings = flag.String("encodings", "gzip", "comma-separated compressed variants to write for each file (gzip, zstd)")
+	shard     = flag.Bool("shard-modules", false, "also write shards of the modules index by first path element (index/modules/<element>.json)")
 )
 
 func main() {
@@ -30,6 +32,16 @@ func main() {
 	if err != nil {
 		log.Fatal(err)
 	}
+	encs, err := database.ParseEncodings(*encodings)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := db.SetEncodings(encs...); err != nil {
+		log.Fatal(err)
+	}
+	if *shard {
+		db.ShardModules()
+	}
 	if err = db.Write(*outDir); err != nil {
 		log.Fatal(err)
 	}
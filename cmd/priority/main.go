@@ -5,23 +5,27 @@
 // Command priority gives direct access to the module prioritization
 // code used by vulnreport triage.
 // Prints the priority result for the given module(s).
-// Can be used for experimentation / debugging.
-// Usage: $ go run ./cmd/priority <module_path>
+// Can be used for experimentation / debugging, for example
+// with a modified scoring model.
+// Usage: $ go run ./cmd/priority [-model FILE] <module_path>
 package main
 
 import (
 	"context"
+	"flag"
 	"log"
 	"math"
-	"os"
 
 	vlog "golang.org/x/vulndb/cmd/vulnreport/log"
 	"golang.org/x/vulndb/internal/report"
 	"golang.org/x/vulndb/internal/triage/priority"
 )
 
+var modelFile = flag.String("model", "", "YAML file with the scoring model, in the format of internal/triage/priority/data/model.yaml (default: the checked-in model)")
+
 func main() {
-	args := os.Args[1:]
+	flag.Parse()
+	args := flag.Args()
 	if len(args) == 0 {
 		log.Fatal("missing module paths")
 	}
@@ -32,6 +36,17 @@ func main() {
 	if err != nil {
 		log.Fatal(err)
 	}
+	h, err := priority.LoadHistory()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	in := &priority.Inputs{ModulesToImports: ms, History: h}
+	if *modelFile != "" {
+		if in.Model, err = priority.ReadModel(*modelFile); err != nil {
+			log.Fatal(err)
+		}
+	}
 
 	rc, err := report.NewDefaultClient(ctx)
 	if err != nil {
@@ -39,7 +54,7 @@ func main() {
 	}
 
 	for _, arg := range args {
-		pr, notGo := priority.Analyze(arg, math.MaxInt, rc.ReportsByModule(arg), ms)
+		pr, notGo := priority.Analyze(arg, nil, math.MaxInt, rc.ReportsByModule(arg), in)
 		vlog.Outf("%s:\npriority = %s\n%s", arg, pr.Priority, pr.Reason)
 		if notGo != nil {
 			vlog.Outf("%s is likely not Go because %s", arg, notGo.Reason)
@@ -0,0 +1,149 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Command updatetestcves updates the corpora of real CVE records used by
+// tests: txtar files in the shape of a CVE list repo, such as
+// internal/cve5/testdata/cve/cvelist.txtar.
+//
+// Usage:
+//
+//	go run ./cmd/updatetestcves [-add CVE-ID,...] [-remove CVE-ID,...] TXTAR...
+//
+// For each file, it adds and removes the given CVEs, refreshes all the
+// CVEs in the file from the head of the CVE list the file is in the shape
+// of (which takes a while), and prints which CVEs were added, removed and
+// changed.
+//
+// The tests using a corpus only know what to expect of the CVEs they
+// list (for example, in cvelistrepo.TestCVEsToModules, or in
+// internal/triage/testdata/cve/go_cves.txt), so new CVEs must be added
+// there too. Then, update the golden files of the tests with -update.
+package main
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"slices"
+	"strings"
+
+	"golang.org/x/exp/maps"
+	"golang.org/x/vulndb/internal/cvelistrepo"
+	"golang.org/x/vulndb/internal/idstr"
+)
+
+var (
+	add    = flag.String("add", "", "comma-separated IDs of CVEs to add")
+	remove = flag.String("remove", "", "comma-separated IDs of CVEs to remove")
+)
+
+func main() {
+	flag.Usage = func() {
+		out := flag.CommandLine.Output()
+		fmt.Fprintln(out, "usage: updatetestcves [-add CVE-ID,...] [-remove CVE-ID,...] TXTAR...")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	toAdd, err := parseIDs(*add)
+	if err != nil {
+		log.Fatalf("-add: %v", err)
+	}
+	toRemove, err := parseIDs(*remove)
+	if err != nil {
+		log.Fatalf("-remove: %v", err)
+	}
+
+	ctx := context.Background()
+	for _, filename := range flag.Args() {
+		if err := update(ctx, filename, toAdd, toRemove); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
+
+func parseIDs(list string) ([]string, error) {
+	var ids []string
+	for _, id := range strings.Split(list, ",") {
+		id = strings.TrimSpace(id)
+		if id == "" {
+			continue
+		}
+		if !idstr.IsCVE(id) {
+			return nil, fmt.Errorf("%q is not a CVE ID", id)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+func update(ctx context.Context, filename string, toAdd, toRemove []string) error {
+	url, old, err := cvelistrepo.ReadTxtarFile(filename)
+	if err != nil {
+		return err
+	}
+	ids := make(map[string]bool)
+	for id := range old {
+		ids[id] = true
+	}
+	for _, id := range toAdd {
+		ids[id] = true
+	}
+	for _, id := range toRemove {
+		if !ids[id] {
+			log.Printf("%s: %s is not in the corpus", filename, id)
+		}
+		delete(ids, id)
+	}
+
+	log.Printf("%s: refreshing %d CVEs from %s...", filename, len(ids), url)
+	if err := cvelistrepo.UpdateTxtarFile(ctx, url, filename, maps.Keys(ids)); err != nil {
+		return err
+	}
+	_, updated, err := cvelistrepo.ReadTxtarFile(filename)
+	if err != nil {
+		return err
+	}
+	printSummary(filename, old, updated)
+	return nil
+}
+
+// printSummary prints how the CVE records of a corpus changed.
+func printSummary(filename string, old, updated map[string][]byte) {
+	var added, removed, changed []string
+	for id, data := range updated {
+		switch od, ok := old[id]; {
+		case !ok:
+			added = append(added, id)
+		case !bytes.Equal(od, data):
+			changed = append(changed, id)
+		}
+	}
+	for id := range old {
+		if _, ok := updated[id]; !ok {
+			removed = append(removed, id)
+		}
+	}
+	fmt.Printf("%s: %d CVEs (%d unchanged)\n", filename, len(updated), len(updated)-len(added)-len(changed))
+	for _, s := range []struct {
+		verb string
+		ids  []string
+	}{
+		{"added", added},
+		{"removed", removed},
+		{"changed", changed},
+	} {
+		if len(s.ids) == 0 {
+			continue
+		}
+		slices.Sort(s.ids)
+		fmt.Printf("  %s: %s\n", s.verb, strings.Join(s.ids, ", "))
+	}
+}
@@ -0,0 +1,353 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+	"golang.org/x/exp/maps"
+	"golang.org/x/vulndb/cmd/vulnreport/log"
+	"golang.org/x/vulndb/internal/cwe"
+	"golang.org/x/vulndb/internal/report"
+)
+
+// A campaign is a transformation that upgrades legacy reports in bulk.
+type campaign struct {
+	// summary describes the change, for commit messages.
+	summary string
+	// matches reports whether the campaign may apply to r.
+	// It is a cheap filter; apply decides whether r changes.
+	matches func(r *report.Report) bool
+	// apply transforms r, and reports whether it changed.
+	apply func(r *report.Report) bool
+}
+
+// campaigns are the registered campaigns, by name.
+var campaigns = map[string]*campaign{
+	"normalize-references": {
+		summary: "normalize references",
+		matches: func(r *report.Report) bool { return len(r.References) > 0 },
+		apply: func(r *report.Report) bool {
+			before := refStrings(r.References)
+			r.FixReferences()
+			return !slices.Equal(before, refStrings(r.References))
+		},
+	},
+	"normalize-cwe": {
+		summary: `write CWEs as "CWE-NNN: Name"`,
+		matches: func(r *report.Report) bool { return r.CVEMetadata != nil && r.CVEMetadata.CWE != "" },
+		apply: func(r *report.Report) bool {
+			fixed := cweSpaceRE.ReplaceAllString(r.CVEMetadata.CWE, "CWE-$1")
+			if fixed == r.CVEMetadata.CWE {
+				return false
+			}
+			r.CVEMetadata.CWE = fixed
+			return true
+		},
+	},
+	"backfill-cwe": {
+		summary: "backfill CWEs from pattern classes",
+		matches: func(r *report.Report) bool {
+			return r.CVEMetadata != nil && r.CVEMetadata.CWE == "" && r.PatternClass != ""
+		},
+		apply: func(r *report.Report) bool {
+			// Only a class with a single CWE determines the CWE.
+			cwes := r.PatternClass.CWEs()
+			if len(cwes) != 1 {
+				return false
+			}
+			name := cwe.Name(cwes[0])
+			if name == "" {
+				return false
+			}
+			r.CVEMetadata.CWE = fmt.Sprintf("%s: %s", cwes[0], name)
+			return true
+		},
+	},
+	"structure-credits": {
+		summary: "convert free-form credits to structured credits",
+		matches: func(r *report.Report) bool { return len(r.Credits) > 0 },
+		apply: func(r *report.Report) bool {
+			changed := false
+			for i, c := range r.Credits {
+				// Only free-form credits are parsed.
+				if c.Type != "" || len(c.Contact) > 0 {
+					continue
+				}
+				if p := report.ParseCredit(c.Name); p.Name != c.Name || p.Type != "" || len(p.Contact) > 0 {
+					r.Credits[i] = p
+					changed = true
+				}
+			}
+			return changed
+		},
+	},
+}
+
+var cweSpaceRE = regexp.MustCompile(`^CWE (\d+)`)
+
+func refStrings(refs []*report.Reference) []string {
+	var ss []string
+	for _, ref := range refs {
+		ss = append(ss, fmt.Sprintf("%s %s", ref.Type, ref.URL))
+	}
+	return ss
+}
+
+type campaignCmd struct {
+	*fileWriter
+	*filenameParser
+	noSkip
+
+	repo   *git.Repository
+	dryRun bool
+
+	campaignName string
+	campaign     *campaign
+	state        *campaignState
+	upgraded     []*yamlReport
+}
+
+func (campaignCmd) name() string { return "campaign" }
+
+func (campaignCmd) usage() (string, string) {
+	const desc = "upgrades legacy reports with a registered campaign, in batches committed to separate branches"
+	return "<campaign> " + filenameArgs, desc
+}
+
+func (campaignCmd) capabilities() capability { return capReadRepo | capWriteFiles }
+
+func (c *campaignCmd) setup(ctx context.Context, env environment) error {
+	c.fileWriter = new(fileWriter)
+	c.filenameParser = new(filenameParser)
+	repo, err := env.ReportRepo(ctx)
+	if err != nil {
+		return err
+	}
+	c.repo = repo
+	c.dryRun = env.dryRun
+	return setupAll(ctx, env, c.fileWriter, c.filenameParser)
+}
+
+// parseArgs looks up the campaign named by the first argument, and
+// returns the reports named by the others, or all regular reports if
+// there are none.
+func (c *campaignCmd) parseArgs(ctx context.Context, args []string) ([]string, error) {
+	if len(args) == 0 {
+		return nil, fmt.Errorf("missing campaign name (one of: %s)", strings.Join(campaignNames(), ", "))
+	}
+	c.campaignName, args = args[0], args[1:]
+	c.campaign = campaigns[c.campaignName]
+	if c.campaign == nil {
+		return nil, fmt.Errorf("unknown campaign %q (one of: %s)", c.campaignName, strings.Join(campaignNames(), ", "))
+	}
+	state, err := readCampaignState(c.fsys, c.campaignName)
+	if err != nil {
+		return nil, err
+	}
+	c.state = state
+	if len(args) > 0 || *sinceCommit != "" {
+		return c.filenameParser.parseArgs(ctx, args)
+	}
+	return fs.Glob(c.fsys, path.Join(filepath.ToSlash(report.YAMLDir), "*.yaml"))
+}
+
+func campaignNames() []string {
+	names := maps.Keys(campaigns)
+	slices.Sort(names)
+	return names
+}
+
+func (c *campaignCmd) skip(input any) string {
+	r := input.(*yamlReport)
+	if b := c.state.batchOf(r.ID); b != nil {
+		return fmt.Sprintf("already upgraded in batch %d (%s)", b.Number, b.Branch)
+	}
+	if !c.campaign.matches(r.Report) {
+		return "not matched by campaign"
+	}
+	return ""
+}
+
+func (c *campaignCmd) run(_ context.Context, input any) error {
+	r := input.(*yamlReport)
+	before := r.LintOffline()
+	if !c.campaign.apply(r.Report) {
+		log.Infof("%s: no changes", r.ID)
+		return nil
+	}
+	if after := r.LintOffline(); len(after) > len(before) {
+		return fmt.Errorf("%s: campaign %s introduced lints:%s%s", r.ID, c.campaignName, listItem, strings.Join(after, listItem))
+	}
+	c.upgraded = append(c.upgraded, r)
+	return nil
+}
+
+// close writes the upgraded reports in batches, each committed to its
+// own branch off the current one, and records the batches in the
+// campaign's state file.
+func (c *campaignCmd) close() error {
+	if len(c.upgraded) == 0 {
+		return nil
+	}
+	base, err := c.baseBranch()
+	if err != nil {
+		return err
+	}
+	size := *batch
+	if size <= 0 {
+		size = defaultCampaignBatch
+	}
+	slices.SortFunc(c.upgraded, func(a, b *yamlReport) int {
+		return strings.Compare(a.ID, b.ID)
+	})
+	for reports := range slices.Chunk(c.upgraded, size) {
+		b := &campaignBatch{
+			Number: len(c.state.Batches) + 1,
+			Base:   base,
+		}
+		b.Branch = fmt.Sprintf("campaign/%s/%d", c.campaignName, b.Number)
+		for _, r := range reports {
+			b.Reports = append(b.Reports, r.ID)
+		}
+		if err := c.commitBatch(b, reports); err != nil {
+			return fmt.Errorf("batch %d: %w", b.Number, err)
+		}
+		c.state.Batches = append(c.state.Batches, b)
+		if err := writeJSON(c, campaignStateFile(c.campaignName), c.state); err != nil {
+			return err
+		}
+	}
+	log.Outf("campaign %s: %d report(s) upgraded in %d batch(es) so far", c.campaignName, c.state.numReports(), len(c.state.Batches))
+	return nil
+}
+
+const defaultCampaignBatch = 20
+
+// baseBranch returns the name of the branch that is checked out
+// in the report repo.
+func (c *campaignCmd) baseBranch() (string, error) {
+	head, err := c.repo.Head()
+	if err != nil {
+		return "", err
+	}
+	if !head.Name().IsBranch() {
+		return "", errors.New("HEAD is not a branch; check out the branch to base the campaign's batches on")
+	}
+	return head.Name().Short(), nil
+}
+
+// commitBatch writes the reports of the batch, and their derived files,
+// and commits them to the batch's branch, returning to the base branch
+// afterwards.
+func (c *campaignCmd) commitBatch(b *campaignBatch, reports []*yamlReport) error {
+	var globs []string
+	for _, r := range reports {
+		globs = append(globs, fmt.Sprintf("*%s*", r.ID))
+	}
+	msg := newCampaignCommitMessage(c.campaignName, c.campaign, b, reports)
+	if c.dryRun {
+		log.Outf("would run: git checkout -b %s %s", b.Branch, b.Base)
+	} else if err := gitCheckout("-b", b.Branch, b.Base); err != nil {
+		return err
+	}
+	for _, r := range reports {
+		if err := c.write(r); err != nil {
+			return err
+		}
+		if err := c.writeDerived(r); err != nil {
+			return err
+		}
+	}
+	if c.dryRun {
+		log.Outf("would run: git add %s", strings.Join(globs, " "))
+		log.Outf("would commit with message:\n\n%s", msg)
+		log.Outf("would run: git checkout %s", b.Base)
+		return nil
+	}
+	if err := gitAdd(globs...); err != nil {
+		return err
+	}
+	if err := gitCommit(msg, globs...); err != nil {
+		return err
+	}
+	return gitCheckout(b.Base)
+}
+
+func newCampaignCommitMessage(name string, c *campaign, b *campaignBatch, reports []*yamlReport) string {
+	var files []string
+	for _, r := range reports {
+		files = append(files, r.Filename)
+	}
+	return fmt.Sprintf("data/reports: %s (campaign %s, batch %d)\n\nGenerated by \"vulnreport campaign %s\".\n%s%s\n",
+		c.summary, name, b.Number, name, listItem, strings.Join(files, listItem))
+}
+
+// campaignState records the progress of a campaign.
+type campaignState struct {
+	Campaign string           `json:"campaign"`
+	Batches  []*campaignBatch `json:"batches,omitempty"`
+}
+
+// A campaignBatch is a set of reports upgraded by a campaign
+// and committed together to a branch for review.
+type campaignBatch struct {
+	Number  int      `json:"number"`
+	Branch  string   `json:"branch"`
+	Base    string   `json:"base"`
+	Reports []string `json:"reports"`
+}
+
+// campaignStateFile returns the name of the state file of the campaign.
+// It is not committed, so that it persists as branches are checked out.
+func campaignStateFile(name string) string {
+	return filepath.Join(".campaigns", name+".json")
+}
+
+// readCampaignState reads the state file of the campaign from fsys,
+// returning an empty state if there is none.
+func readCampaignState(fsys fs.FS, name string) (*campaignState, error) {
+	b, err := fs.ReadFile(fsys, filepath.ToSlash(campaignStateFile(name)))
+	if errors.Is(err, fs.ErrNotExist) {
+		return &campaignState{Campaign: name}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var s campaignState
+	if err := json.Unmarshal(b, &s); err != nil {
+		return nil, fmt.Errorf("%s: %w", campaignStateFile(name), err)
+	}
+	return &s, nil
+}
+
+// batchOf returns the batch the report with the given ID
+// was upgraded in, or nil if none.
+func (s *campaignState) batchOf(id string) *campaignBatch {
+	for _, b := range s.Batches {
+		if slices.Contains(b.Reports, id) {
+			return b
+		}
+	}
+	return nil
+}
+
+func (s *campaignState) numReports() int {
+	n := 0
+	for _, b := range s.Batches {
+		n += len(b.Reports)
+	}
+	return n
+}
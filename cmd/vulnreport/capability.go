@@ -0,0 +1,144 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"golang.org/x/vulndb/internal/issues"
+)
+
+var (
+	noNetwork = flag.Bool("no-network", false, "refuse to run commands that talk to the network, and block any network access")
+	readOnly  = flag.Bool("read-only", false, "refuse to run commands that write files or modify the issue tracker")
+)
+
+// A capability is a set of things a command may do to the outside world.
+// Each command declares the capabilities it needs, and run refuses to
+// execute a command that needs a capability that has been denied.
+type capability int
+
+const (
+	// capReadRepo indicates the command reads the local report repo.
+	capReadRepo capability = 1 << iota
+	// capWriteFiles indicates the command writes or removes files
+	// (including staging and committing them with git).
+	capWriteFiles
+	// capNetwork indicates the command may talk to the network
+	// (e.g., the module proxy, pkgsite or the GitHub API).
+	capNetwork
+	// capMutateTracker indicates the command modifies the issue tracker
+	// (e.g., sets labels or posts comments), a GitHub security advisory
+	// or a CVE record.
+	capMutateTracker
+)
+
+var capabilityNames = []struct {
+	c    capability
+	name string
+}{
+	{capReadRepo, "read-repo"},
+	{capWriteFiles, "write-files"},
+	{capNetwork, "network"},
+	{capMutateTracker, "mutate-tracker"},
+}
+
+func (c capability) String() string {
+	var names []string
+	for _, cn := range capabilityNames {
+		if c&cn.c != 0 {
+			names = append(names, cn.name)
+		}
+	}
+	if len(names) == 0 {
+		return "none"
+	}
+	return strings.Join(names, ",")
+}
+
+// deniedCapabilities returns the capabilities disabled by
+// the -no-network and -read-only flags.
+func deniedCapabilities() (denied capability) {
+	if *noNetwork {
+		denied |= capNetwork
+	}
+	if *readOnly {
+		denied |= capWriteFiles | capMutateTracker
+	}
+	return denied
+}
+
+// checkCapabilities returns an error if the command needs any
+// capability that is denied in env.
+func checkCapabilities(c command, env environment) error {
+	if d := c.capabilities() & env.denied; d != 0 {
+		return fmt.Errorf("%s requires capabilities [%s], which are disabled by flag(s)", c.name(), d)
+	}
+	return nil
+}
+
+var errNetworkDisabled = errors.New("network access is disabled (-no-network)")
+
+// offlineTransport is an http.RoundTripper that refuses all requests.
+// It is installed as the default transport when network access is denied,
+// so that commands that use the network without declaring it fail loudly.
+type offlineTransport struct{}
+
+func (offlineTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return nil, fmt.Errorf("%s %s: %w", req.Method, req.URL, errNetworkDisabled)
+}
+
+// disableNetwork blocks all HTTP requests made with the default transport.
+func disableNetwork() {
+	http.DefaultTransport = offlineTransport{}
+}
+
+var errReadOnly = errors.New("modification is disabled (-read-only)")
+
+// readOnlyWFS is a wfs that refuses all writes.
+type readOnlyWFS struct{}
+
+var _ wfs = readOnlyWFS{}
+
+func (readOnlyWFS) WriteFile(filename string, _ []byte) (bool, error) {
+	return false, fmt.Errorf("write %s: %w", filename, errReadOnly)
+}
+
+func (readOnlyWFS) RemoveFile(filename string) (bool, error) {
+	return false, fmt.Errorf("remove %s: %w", filename, errReadOnly)
+}
+
+// readOnlyIC is an issueClient that allows reads but
+// refuses modifications.
+type readOnlyIC struct {
+	issueClient
+}
+
+var _ issueClient = readOnlyIC{}
+
+func (readOnlyIC) SetLabels(_ context.Context, n int, _ []string) error {
+	return fmt.Errorf("set labels on issue %d: %w", n, errReadOnly)
+}
+
+func (readOnlyIC) SetAssignee(_ context.Context, n int, _ string) error {
+	return fmt.Errorf("set assignee of issue %d: %w", n, errReadOnly)
+}
+
+func (readOnlyIC) AddComments(_ context.Context, n int, _ []string) error {
+	return fmt.Errorf("add comments to issue %d: %w", n, errReadOnly)
+}
+
+func (readOnlyIC) EditComment(_ context.Context, n int, _ int64, _ string) error {
+	return fmt.Errorf("edit comment on issue %d: %w", n, errReadOnly)
+}
+
+func (readOnlyIC) CreateIssue(_ context.Context, iss *issues.Issue) (int, error) {
+	return 0, fmt.Errorf("create issue %q: %w", iss.Title, errReadOnly)
+}
@@ -0,0 +1,98 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"golang.org/x/vulndb/cmd/vulnreport/log"
+)
+
+func TestCheckCapabilities(t *testing.T) {
+	log.Discard()
+	for _, tc := range []struct {
+		name    string
+		cmd     command
+		denied  capability
+		wantErr bool
+	}{
+		{
+			name: "nothing denied",
+			cmd:  &lint{},
+		},
+		{
+			name:   "read-only command with writes denied",
+			cmd:    &xref{},
+			denied: capWriteFiles | capMutateTracker,
+		},
+		{
+			name:    "network command with network denied",
+			cmd:     &lint{},
+			denied:  capNetwork,
+			wantErr: true,
+		},
+		{
+			name:    "tracker command with tracker denied",
+			cmd:     &triage{},
+			denied:  capMutateTracker,
+			wantErr: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := checkCapabilities(tc.cmd, environment{denied: tc.denied})
+			if gotErr := err != nil; gotErr != tc.wantErr {
+				t.Errorf("checkCapabilities(%s, denied=%s) = %v, want error=%t", tc.cmd.name(), tc.denied, err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestCapabilityString(t *testing.T) {
+	for _, tc := range []struct {
+		c    capability
+		want string
+	}{
+		{0, "none"},
+		{capReadRepo, "read-repo"},
+		{capReadRepo | capNetwork | capMutateTracker, "read-repo,network,mutate-tracker"},
+	} {
+		if got := tc.c.String(); got != tc.want {
+			t.Errorf("%d.String() = %q, want %q", tc.c, got, tc.want)
+		}
+	}
+}
+
+func TestDeniedEnvironment(t *testing.T) {
+	memIC, err := newMemIC(testIssueTracker)
+	if err != nil {
+		t.Fatal(err)
+	}
+	env := &environment{
+		wfs:    newInMemoryWFS(),
+		ic:     memIC,
+		denied: capWriteFiles | capMutateTracker,
+	}
+
+	if _, err := env.WFS().WriteFile("data/reports/GO-9999-0001.yaml", nil); !errors.Is(err, errReadOnly) {
+		t.Errorf("WriteFile: got error %v, want %v", err, errReadOnly)
+	}
+
+	ctx := context.Background()
+	ic, err := env.IssueClient(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ic.Issue(ctx, 1); err != nil {
+		t.Errorf("Issue: got error %v, want nil", err)
+	}
+	if err := ic.SetLabels(ctx, 1, []string{"triaged"}); !errors.Is(err, errReadOnly) {
+		t.Errorf("SetLabels: got error %v, want %v", err, errReadOnly)
+	}
+	if err := ic.AddComments(ctx, 1, []string{"hi"}); !errors.Is(err, errReadOnly) {
+		t.Errorf("AddComments: got error %v, want %v", err, errReadOnly)
+	}
+}
@@ -0,0 +1,136 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"golang.org/x/vulndb/internal/gitrepo"
+	"golang.org/x/vulndb/internal/report"
+)
+
+// headReport returns the report at filename in the HEAD commit of repo,
+// or nil if there is no such file.
+func headReport(repo *git.Repository, filename string) (*report.Report, error) {
+	root, err := gitrepo.Root(repo)
+	if err != nil {
+		return nil, err
+	}
+	f, err := root.File(filepath.ToSlash(filename))
+	if errors.Is(err, object.ErrFileNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	contents, err := f.Contents()
+	if err != nil {
+		return nil, err
+	}
+	// Not strict, so that reports using fields that have since been
+	// removed can still be compared.
+	r, err := report.Unmarshal([]byte(contents))
+	if err != nil {
+		return nil, fmt.Errorf("%s at HEAD: %w", filename, err)
+	}
+	return r, nil
+}
+
+// summarizeChanges returns short descriptions of the changes from old
+// to new, for commit messages. It does not describe every change, only
+// the ones a reviewer most needs to know about: aliases, review and
+// exclusion status, versions, and modules, packages and symbols.
+//
+// If old is nil, the report is new and there are no changes to describe.
+func summarizeChanges(old, new *report.Report) []string {
+	if old == nil {
+		return nil
+	}
+	var changes []string
+	add := func(format string, args ...any) {
+		changes = append(changes, fmt.Sprintf(format, args...))
+	}
+
+	added, removed := diffStrings(old.Aliases(), new.Aliases())
+	if len(added) > 0 {
+		add("add aliases %s", strings.Join(added, ", "))
+	}
+	if len(removed) > 0 {
+		add("remove aliases %s", strings.Join(removed, ", "))
+	}
+	if old.ReviewStatus != new.ReviewStatus {
+		add("review status %s -> %s", old.ReviewStatus, new.ReviewStatus)
+	}
+	if old.Excluded != new.Excluded {
+		add("excluded %q -> %q", old.Excluded, new.Excluded)
+	}
+	if old.Withdrawn == nil && new.Withdrawn != nil {
+		add("withdraw")
+	}
+
+	oldModules := make(map[string]*report.Module)
+	for _, m := range old.Modules {
+		oldModules[m.Module] = m
+	}
+	for _, m := range new.Modules {
+		om, ok := oldModules[m.Module]
+		if !ok {
+			add("add module %s", m.Module)
+			continue
+		}
+		delete(oldModules, m.Module)
+		if vs, ovs := m.Versions.String(), om.Versions.String(); vs != ovs {
+			add("%s: versions [%s] -> [%s]", m.Module, ovs, vs)
+		}
+		oldPackages := make(map[string]*report.Package)
+		for _, p := range om.Packages {
+			oldPackages[p.Package] = p
+		}
+		for _, p := range m.Packages {
+			op, ok := oldPackages[p.Package]
+			if !ok {
+				add("add package %s", p.Package)
+				continue
+			}
+			added, removed := diffStrings(op.Symbols, p.Symbols)
+			if len(added) > 0 {
+				add("%s: add symbols %s", p.Package, strings.Join(added, ", "))
+			}
+			if len(removed) > 0 {
+				add("%s: remove symbols %s", p.Package, strings.Join(removed, ", "))
+			}
+		}
+	}
+	for _, m := range old.Modules {
+		if _, ok := oldModules[m.Module]; ok {
+			add("remove module %s", m.Module)
+		}
+	}
+	return changes
+}
+
+// diffStrings returns the sorted elements of new that are not in old,
+// and of old that are not in new.
+func diffStrings(old, new []string) (added, removed []string) {
+	for _, s := range new {
+		if !slices.Contains(old, s) {
+			added = append(added, s)
+		}
+	}
+	for _, s := range old {
+		if !slices.Contains(new, s) {
+			removed = append(removed, s)
+		}
+	}
+	slices.Sort(added)
+	slices.Sort(removed)
+	return slices.Compact(added), slices.Compact(removed)
+}
@@ -0,0 +1,102 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"testing"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/vulndb/internal/report"
+)
+
+func TestSummarizeChanges(t *testing.T) {
+	old := &report.Report{
+		ID:   "GO-9999-0001",
+		CVEs: []string{"CVE-9999-0001"},
+		Modules: []*report.Module{
+			{
+				Module:   "golang.org/x/net",
+				Versions: report.Versions{report.Fixed("0.1.0")},
+				Packages: []*report.Package{{Package: "golang.org/x/net/html", Symbols: []string{"Parse"}}},
+			},
+			{Module: "golang.org/x/text"},
+		},
+		ReviewStatus: report.Unreviewed,
+	}
+	new := &report.Report{
+		ID:    "GO-9999-0001",
+		CVEs:  []string{"CVE-9999-0001"},
+		GHSAs: []string{"GHSA-xxxx-yyyy-zzzz"},
+		Modules: []*report.Module{
+			{
+				Module:   "golang.org/x/net",
+				Versions: report.Versions{report.Fixed("0.2.0")},
+				Packages: []*report.Package{
+					{Package: "golang.org/x/net/html", Symbols: []string{"Parse", "Tokenizer.Next"}},
+					{Package: "golang.org/x/net/http2"},
+				},
+			},
+			{Module: "golang.org/x/crypto"},
+		},
+		ReviewStatus: report.Reviewed,
+	}
+	want := []string{
+		"add aliases GHSA-xxxx-yyyy-zzzz",
+		"review status UNREVIEWED -> REVIEWED",
+		"golang.org/x/net: versions [0.1.0] -> [0.2.0]",
+		"golang.org/x/net/html: add symbols Tokenizer.Next",
+		"add package golang.org/x/net/http2",
+		"add module golang.org/x/crypto",
+		"remove module golang.org/x/text",
+	}
+	if diff := cmp.Diff(want, summarizeChanges(old, new)); diff != "" {
+		t.Errorf("mismatch (-want, +got):\n%s", diff)
+	}
+	if got := summarizeChanges(nil, new); got != nil {
+		t.Errorf("summarizeChanges(nil, _) = %v, want nil", got)
+	}
+}
+
+func TestNewCommitMessage(t *testing.T) {
+	const filename = "data/reports/GO-9999-0001.yaml"
+	r := &yamlReport{
+		Report:   &report.Report{ID: "GO-9999-0001", ReviewStatus: report.Reviewed},
+		Filename: filename,
+	}
+	status := git.Status{filename: &git.FileStatus{Staging: git.Modified}}
+	changes := map[string][]string{
+		r.ID: {"add aliases CVE-9999-0001", "review status UNREVIEWED -> REVIEWED"},
+	}
+	got, err := newCommitMessage(status, []*yamlReport{r}, changes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `data/reports: review GO-9999-0001
+
+  - data/reports/GO-9999-0001.yaml
+      - add aliases CVE-9999-0001
+      - review status UNREVIEWED -> REVIEWED
+
+Fixes golang/vulndb#1`
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("mismatch (-want, +got):\n%s", diff)
+	}
+}
+
+func TestBatchKind(t *testing.T) {
+	for _, test := range []struct {
+		r    *report.Report
+		want string
+	}{
+		{&report.Report{Excluded: "NOT_IMPORTABLE"}, "excluded"},
+		{&report.Report{ReviewStatus: report.Reviewed}, "reviewed"},
+		{&report.Report{ReviewStatus: report.Unreviewed}, "unreviewed"},
+	} {
+		if got := batchKind(&yamlReport{Report: test.r}); got != test.want {
+			t.Errorf("batchKind(%v) = %s, want %s", test.r, got, test.want)
+		}
+	}
+}
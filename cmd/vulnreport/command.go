@@ -9,8 +9,11 @@ import (
 	"errors"
 	"fmt"
 	"io/fs"
+	"time"
 
+	"github.com/go-git/go-git/v5"
 	"golang.org/x/vulndb/cmd/vulnreport/log"
+	"golang.org/x/vulndb/internal/gitrepo"
 	"golang.org/x/vulndb/internal/issues"
 	"golang.org/x/vulndb/internal/report"
 )
@@ -21,6 +24,9 @@ type command interface {
 	name() string
 	// usage outputs strings indicating how to use the subcommand.
 	usage() (args string, desc string)
+	// capabilities outputs the things the subcommand may do
+	// (read the repo, write files, use the network, etc.).
+	capabilities() capability
 	setuper
 	// parseArgs takes in the raw args passed to the command line,
 	// and converts them to a representation understood by "run".
@@ -38,11 +44,16 @@ type command interface {
 
 // run executes the given command on the given raw arguments.
 func run(ctx context.Context, c command, args []string, env environment) (err error) {
+	if err := checkCapabilities(c, env); err != nil {
+		return err
+	}
+
 	if err := c.setup(ctx, env); err != nil {
 		return err
 	}
 
 	stats := &counter{}
+	start := time.Now()
 	defer func() {
 		if cerr := c.close(); cerr != nil {
 			err = errors.Join(err, cerr)
@@ -53,6 +64,11 @@ func run(ctx context.Context, c command, args []string, env environment) (err er
 		if stats.errored > 0 {
 			err = errors.Join(err, fmt.Errorf("errored on %d inputs", stats.errored))
 		}
+		result := "ok"
+		if err != nil {
+			result = "failed"
+		}
+		env.Telemetry().record(&telemetryEvent{Kind: kindCommand, Result: result, Inputs: stats.total(), Duration: time.Since(start)})
 	}()
 
 	inputs, err := c.parseArgs(ctx, args)
@@ -145,10 +161,19 @@ const (
 // interface, and can be used by commands that operate on YAML filenames.
 type filenameParser struct {
 	fsys fs.FS
+	// sinceRepo is only set if the -since-commit flag is provided.
+	sinceRepo *git.Repository
 }
 
-func (f *filenameParser) setup(_ context.Context, env environment) error {
+func (f *filenameParser) setup(ctx context.Context, env environment) error {
 	f.fsys = env.ReportFS()
+	if *sinceCommit != "" {
+		repo, err := env.ReportRepo(ctx)
+		if err != nil {
+			return err
+		}
+		f.sinceRepo = repo
+	}
 	return nil
 }
 
@@ -158,6 +183,9 @@ func (*filenameParser) inputType() string {
 
 func (f *filenameParser) parseArgs(_ context.Context, args []string) (filenames []string, allErrs error) {
 	if len(args) == 0 {
+		if *sinceCommit != "" {
+			return f.changedSince(*sinceCommit)
+		}
 		return nil, fmt.Errorf("no arguments provided")
 	}
 	for _, arg := range args {
@@ -176,6 +204,24 @@ func (f *filenameParser) parseArgs(_ context.Context, args []string) (filenames
 	return filenames, nil
 }
 
+// changedSince returns the YAML reports added or modified
+// since the given git revision.
+func (f *filenameParser) changedSince(rev string) (filenames []string, _ error) {
+	changed, err := gitrepo.ChangedSince(f.sinceRepo, rev, "data/")
+	if err != nil {
+		return nil, err
+	}
+	for _, fname := range changed {
+		if report.IsYAMLReport(fname) {
+			filenames = append(filenames, fname)
+		}
+	}
+	if len(filenames) == 0 {
+		return nil, fmt.Errorf("no arguments provided, and no reports changed since %s", rev)
+	}
+	return filenames, nil
+}
+
 func argToFilename(arg string, fsys fs.FS) (string, error) {
 	if _, err := fs.Stat(fsys, arg); err != nil {
 		// If arg isn't a file, see if it might be an issue ID
@@ -25,8 +25,8 @@ var (
 	// Note: It would be probably be ideal if -dry did not stage
 	// the files, but the logic to determine the commit message
 	// currently depends on the status of the staging area.
-	dry   = flag.Bool("dry", false, "for commit & create-excluded, stage but do not commit files")
-	batch = flag.Int("batch", 0, "for commit, create batched commits of the specified size")
+	dry   = flag.Bool("dry", false, "for commit, create-excluded & update-module-map, stage but do not commit files")
+	batch = flag.Int("batch", 0, "for commit, create batched commits of the specified size, with excluded, reviewed and unreviewed reports in separate batches; for campaign, the number of reports per branch (default 20)")
 )
 
 type commit struct {
@@ -46,6 +46,8 @@ func (commit) usage() (string, string) {
 	return filenameArgs, desc
 }
 
+func (commit) capabilities() capability { return capReadRepo | capWriteFiles | capNetwork }
+
 func (c *commit) setup(ctx context.Context, env environment) error {
 	c.committer = new(committer)
 	c.fixer = new(fixer)
@@ -88,19 +90,41 @@ func (c *commit) close() (err error) {
 	if len(c.toCommit) != 0 {
 		batchSize := *batch
 		slices.SortFunc(c.toCommit, func(a, b *yamlReport) int {
-			return cmp.Compare(a.ID, b.ID)
+			return cmp.Or(cmp.Compare(batchKind(a), batchKind(b)), cmp.Compare(a.ID, b.ID))
 		})
-		for start := 0; start < len(c.toCommit); start += batchSize {
-			end := min(start+batchSize, len(c.toCommit))
-			log.Infof("committing batch %s-%s", c.toCommit[start].ID, c.toCommit[end-1].ID)
-			if cerr := c.commit(c.toCommit[start:end]...); err != nil {
+		for start := 0; start < len(c.toCommit); {
+			// A batch never mixes kinds of reports, so this one ends
+			// early if the next kind starts before it is full.
+			kind := batchKind(c.toCommit[start])
+			end := start + 1
+			for end < min(start+batchSize, len(c.toCommit)) && batchKind(c.toCommit[end]) == kind {
+				end++
+			}
+			log.Infof("committing batch %s-%s (%s)", c.toCommit[start].ID, c.toCommit[end-1].ID, kind)
+			if cerr := c.commit(c.toCommit[start:end]...); cerr != nil {
 				err = errors.Join(err, cerr)
 			}
+			start = end
 		}
 	}
 	return err
 }
 
+// batchKind returns the kind of report r is, for the purposes of
+// batching. Batched commits contain a single kind of report, so that
+// excluded reports, which need little review, are not mixed with
+// reviewed reports.
+func batchKind(r *yamlReport) string {
+	switch {
+	case r.IsExcluded():
+		return "excluded"
+	case r.IsReviewed():
+		return "reviewed"
+	default:
+		return "unreviewed"
+	}
+}
+
 func (c *commit) skip(input any) string {
 	r := input.(*yamlReport)
 
@@ -126,6 +150,11 @@ func (c *commit) run(ctx context.Context, input any) error {
 		return err
 	}
 
+	if err := c.runPreCommitHooks(ctx, r); err != nil {
+		return err
+	}
+	c.tm.recordOutcomes(r)
+
 	if *batch > 0 {
 		c.toCommit = append(c.toCommit, r)
 		return nil
@@ -134,8 +163,33 @@ func (c *commit) run(ctx context.Context, input any) error {
 	return c.commit(r)
 }
 
+// runPreCommitHooks runs the pre-commit hooks on r, writing their
+// edits (if any). It returns an error if they found any problems,
+// so that r isn't committed.
+func (c *commit) runPreCommitHooks(ctx context.Context, r *yamlReport) error {
+	lints, edited, err := c.runHooks(ctx, stagePreCommit, r)
+	if err != nil {
+		return fmt.Errorf("%s: %w", r.ID, err)
+	}
+	if edited {
+		r.Normalize()
+		lints = append(lints, r.Lint(c.pxc)...)
+		if err := c.write(r); err != nil {
+			return err
+		}
+		if err := c.writeDerived(r); err != nil {
+			return err
+		}
+	}
+	if len(lints) > 0 {
+		return fmt.Errorf("%s: pre-commit hooks found problems:\n\t- %s", r.ID, strings.Join(lints, "\n\t- "))
+	}
+	return nil
+}
+
 type committer struct {
-	repo *git.Repository
+	repo   *git.Repository
+	dryRun bool
 }
 
 func (c *committer) setup(ctx context.Context, env environment) error {
@@ -144,6 +198,7 @@ func (c *committer) setup(ctx context.Context, env environment) error {
 		return err
 	}
 	c.repo = repo
+	c.dryRun = env.dryRun
 	return nil
 }
 
@@ -153,22 +208,39 @@ func (c *committer) commit(reports ...*yamlReport) error {
 		globs = append(globs, fmt.Sprintf("*%s*", r.ID))
 	}
 
-	// Stage all the files.
-	if err := gitAdd(globs...); err != nil {
-		return err
+	var (
+		status git.Status
+		err    error
+	)
+	if c.dryRun {
+		log.Outf("would run: git add %s", strings.Join(globs, " "))
+		status, err = dryRunStatus(c.repo, reports)
+	} else {
+		// Stage all the files.
+		if err := gitAdd(globs...); err != nil {
+			return err
+		}
+		status, err = gitrepo.WorktreeStatus(c.repo)
 	}
-
-	status, err := gitrepo.WorktreeStatus(c.repo)
 	if err != nil {
 		return err
 	}
 
-	msg, err := newCommitMessage(status, reports)
+	changes := make(map[string][]string)
+	for _, r := range reports {
+		old, err := headReport(c.repo, r.Filename)
+		if err != nil {
+			return err
+		}
+		changes[r.ID] = summarizeChanges(old, r.Report)
+	}
+
+	msg, err := newCommitMessage(status, reports, changes)
 	if err != nil {
 		return err
 	}
 
-	if *dry {
+	if *dry || c.dryRun {
 		log.Outf("would commit with message:\n\n%s", msg)
 		return nil
 	}
@@ -228,7 +300,10 @@ func actionPhrases(status git.Status, r *yamlReport) (reportAction, issueAction
 	}
 }
 
-func newCommitMessage(status git.Status, reports []*yamlReport) (string, error) {
+// newCommitMessage returns the commit message for the reports.
+// The body lists each report's file, followed by the summaries of its
+// changes (see summarizeChanges) in changes, keyed by report ID.
+func newCommitMessage(status git.Status, reports []*yamlReport, changes map[string][]string) (string, error) {
 	actions := make(map[string][]*yamlReport)
 	issueActions := make(map[string][]*yamlReport)
 	for _, r := range reports {
@@ -259,8 +334,12 @@ func newCommitMessage(status git.Status, reports []*yamlReport) (string, error)
 			}
 
 			folders[folder] = true
-			bodySegments = append(bodySegments, r.Filename)
-			issueSegments = append(issueSegments, fmt.Sprintf("%s golang/vulndb#%d", issueAction, issueID))
+			segment := r.Filename
+			for _, change := range changes[r.ID] {
+				segment += subListItem + change
+			}
+			bodySegments = append(bodySegments, segment)
+			issueSegments = append(issueSegments, fmt.Sprintf("%s %s#%d", issueAction, issueRepoPath(), issueID))
 		}
 	}
 
@@ -276,4 +355,7 @@ func newCommitMessage(status git.Status, reports []*yamlReport) (string, error)
 	return b.String(), nil
 }
 
-const listItem = "\n  - "
+const (
+	listItem    = "\n  - "
+	subListItem = "\n      - "
+)
@@ -13,7 +13,7 @@ import (
 
 var (
 	preferCVE       = flag.Bool("cve", false, "for create, prefer CVEs over GHSAs as canonical source")
-	useAI           = flag.Bool("ai", false, "for create, use AI to write draft summary and description when creating report")
+	useAI           = flag.Bool("ai", false, "for create, use AI to write draft summary and description when creating report (and, with suggest -cwe, to suggest CWEs)")
 	populateSymbols = flag.Bool("symbols", true, "for create, attempt to auto-populate symbols")
 	user            = flag.String("user", "", "for create & create-excluded, only consider issues assigned to the given user")
 	reviewStatus    = flag.String("status", "", "for create, use this review status (REVIEWED or UNREVIEWED) instead of default based on label; for commit, only commit reports with this status")
@@ -27,14 +27,34 @@ type create struct {
 func (create) name() string { return "create" }
 
 func (create) usage() (string, string) {
-	const desc = "creates a new vulnerability YAML report"
+	const desc = "creates a new vulnerability YAML report (refusing likely duplicates of open issues and reports, unless -f is set)"
 	return ghIssueArgs, desc
 }
 
+func (create) capabilities() capability {
+	c := capReadRepo | capWriteFiles | capNetwork
+	if *minimalReport {
+		// To file the follow-up issue.
+		c |= capMutateTracker
+	}
+	return c
+}
+
 func (c *create) setup(ctx context.Context, env environment) error {
 	c.creator = new(creator)
 	c.issueParser = new(issueParser)
-	return setupAll(ctx, env, c.creator, c.issueParser)
+	if err := setupAll(ctx, env, c.creator, c.issueParser); err != nil {
+		return err
+	}
+	if *minimalReport {
+		c.fixers = fastFixers(c.fixers)
+	}
+	open, err := c.openIssues(ctx)
+	if err != nil {
+		return err
+	}
+	c.dups = newDupIndex(open, c.rc)
+	return nil
 }
 
 func (c *create) close() error {
@@ -43,5 +63,11 @@ func (c *create) close() error {
 
 func (c *create) run(ctx context.Context, input any) error {
 	iss := input.(*issues.Issue)
-	return c.newReportFromIssue(ctx, iss)
+	if err := c.checkAliasDuplicates(ctx, iss); err != nil {
+		return err
+	}
+	if *minimalReport {
+		return c.newMinimalReport(ctx, iss, c.ic)
+	}
+	return c.newReportFromIssue(ctx, iss, c.triageNote(ctx, iss))
 }
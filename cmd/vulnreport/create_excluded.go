@@ -26,6 +26,8 @@ func (createExcluded) usage() (string, string) {
 	return "", desc
 }
 
+func (createExcluded) capabilities() capability { return capReadRepo | capWriteFiles | capNetwork }
+
 func (c *createExcluded) close() (err error) {
 	defer func() {
 		if cerr := closeAll(c.creator); cerr != nil {
@@ -46,7 +48,7 @@ func (c *createExcluded) setup(ctx context.Context, env environment) error {
 
 func (c *createExcluded) run(ctx context.Context, input any) (err error) {
 	iss := input.(*issues.Issue)
-	return c.newReportFromIssue(ctx, iss)
+	return c.newReportFromIssue(ctx, iss, c.triageNote(ctx, iss))
 }
 
 func (c *createExcluded) skip(input any) string {
@@ -29,6 +29,13 @@ type creator struct {
 	// instead of the default for new reports.
 	reviewStatus report.ReviewStatus
 
+	// The CVE IDs reserved for new Go CNA reports.
+	ledger *cveLedger
+
+	// If non-nil, refuse to create likely duplicates
+	// of the open issues and reports it indexes.
+	dups *dupIndex
+
 	*fixer
 	*xrefer
 	*suggester
@@ -47,6 +54,12 @@ func (c *creator) setup(ctx context.Context, env environment) (err error) {
 	}
 	c.reviewStatus = rs
 
+	l, err := readCVELedger(env.ReportFS())
+	if err != nil {
+		return err
+	}
+	c.ledger = l
+
 	c.fixer = new(fixer)
 	c.xrefer = new(xrefer)
 	if *useAI {
@@ -95,22 +108,43 @@ func skip(iss *issues.Issue, x *xrefer) string {
 	return ""
 }
 
-func (c *creator) newReportFromIssue(ctx context.Context, iss *issues.Issue) error {
+// newReportFromIssue creates a new report for iss. If note (the triage
+// note posted on iss by vulnreport triage) is non-nil, its module,
+// aliases and priority are used instead of recomputing them.
+func (c *creator) newReportFromIssue(ctx context.Context, iss *issues.Issue, note *issues.TriageNote) (err error) {
 	id := iss.NewGoID()
-	r, err := c.reportFromMeta(ctx, &reportMeta{
+	cve, reserved := c.goCNACVE(iss, id)
+	if reserved {
+		defer func() { err = c.finishReservedCVE(cve, err) }()
+	}
+	meta := &reportMeta{
 		id:           id,
 		excluded:     excludedReason(iss),
 		modulePath:   modulePath(iss),
+		candidates:   candidateModules(iss),
 		aliases:      aliases(iss),
 		reviewStatus: reviewStatusOf(iss, c.reviewStatus),
-		originalCVE:  originalCVE(iss),
-	})
+		originalCVE:  cve,
+	}
+	if note != nil {
+		if note.Module != "" {
+			meta.modulePath = note.Module
+		}
+		for _, a := range note.Aliases {
+			meta.aliases = appendNew(meta.aliases, a)
+		}
+		meta.priority = notePriority(note)
+	}
+	r, err := c.reportFromMeta(ctx, meta)
 	if err != nil {
 		return err
 	}
 	if r.Withdrawn != nil {
 		return fmt.Errorf("new regular report should not be created for withdrawn vulnerability; %s", withdrawnGuidance(id, iss.Number))
 	}
+	if err := c.checkVersionDuplicates(r); err != nil {
+		return err
+	}
 	return c.write(ctx, r)
 }
 
@@ -122,6 +156,38 @@ func originalCVE(iss *issues.Issue) string {
 	return ""
 }
 
+// goCNACVE returns the Go-CNA-assigned CVE for the report with ID goID
+// for the issue, if it has one. A first-party issue without a CVE is
+// for a CVE the Go CNA is assigning, so goCNACVE assigns it the next
+// ID reserved in the ledger (reporting reserved = true), if there is one.
+func (c *creator) goCNACVE(iss *issues.Issue, goID string) (cve string, reserved bool) {
+	if cve := originalCVE(iss); cve != "" {
+		return cve, false
+	}
+	if !iss.HasLabel(labelFirstParty) || excludedReason(iss) != "" ||
+		slices.ContainsFunc(aliases(iss), idstr.IsCVE) {
+		return "", false
+	}
+	cve, ok := c.ledger.use(goID)
+	if !ok {
+		log.Warnf("%s: no reserved CVE IDs left in %s; reserve some with 'vulnreport cve reserve'", goID, *cveLedgerFile)
+		return "", false
+	}
+	log.Infof("%s: using reserved %s", goID, cve)
+	return cve, true
+}
+
+// finishReservedCVE records in the ledger that the reserved CVE was
+// used, or, if creating its report failed with err, returns it to
+// the reserved IDs.
+func (c *creator) finishReservedCVE(cve string, err error) error {
+	if err != nil {
+		c.ledger.unuse(cve)
+		return err
+	}
+	return c.ledger.write(c.wfs)
+}
+
 func reviewStatusOf(iss *issues.Issue, reviewStatus report.ReviewStatus) report.ReviewStatus {
 	d := defaultReviewStatus(iss)
 	// If a valid review status is provided, it overrides the priority label.
@@ -171,6 +237,7 @@ func (c *creator) rawReport(ctx context.Context, meta *reportMeta) *report.Repor
 		report.WithAliases(meta.aliases),
 		report.WithReviewStatus(meta.reviewStatus),
 		report.WithUnexcluded(meta.unexcluded),
+		report.WithCreatedBy(c.assignee),
 	)
 }
 
@@ -196,11 +263,18 @@ func (c *creator) reportFromMeta(ctx context.Context, meta *reportMeta) (*yamlRe
 		}
 	}
 
-	// The initial quick triage algorithm doesn't know about all
+	if meta.excluded == "" {
+		c.addCandidateModules(raw, meta.candidates)
+	}
+
+	// The initial quick triage algorithm may not know about all
 	// affected modules, so double check the priority after the
 	// report is created.
 	if raw.IsUnreviewed() && !raw.IsExcluded() {
-		pr, _ := c.reportPriority(raw)
+		pr := meta.priority
+		if pr == nil {
+			pr, _ = c.reportPriority(raw)
+		}
 		if pr.Priority == priority.High {
 			log.Warnf("%s: vuln is high priority and should be NEEDS_REVIEW or REVIEWED; reason: %s", raw.ID, pr.Reason)
 			raw.ReviewStatus = report.NeedsReview
@@ -224,6 +298,8 @@ func (c *creator) reportFromMeta(ctx context.Context, meta *reportMeta) (*yamlRe
 		} else {
 			log.Infof("%s: applying AI-generated suggestion", r.ID)
 			r.applySuggestion(suggestions[0])
+			c.tm.recordSuggestion(r.ID, suggestedSummary, r.Summary.String())
+			c.tm.recordSuggestion(r.ID, suggestedDescription, r.Description.String())
 		}
 	}
 
@@ -246,11 +322,21 @@ func (c *creator) reportFromMeta(ctx context.Context, meta *reportMeta) (*yamlRe
 		r.removeUnreachableRefs()
 	default:
 		// Regular, full-length reports.
+		c.addCWESuggestions(ctx, r)
 		addTODOs(r)
 		if xrefs := c.xref(r); len(xrefs) != 0 {
 			log.Infof("%s: found cross-references: %s", r.ID, xrefs)
 		}
 	}
+
+	lints, _, err := c.runHooks(ctx, stagePostCreate, r)
+	if err != nil {
+		r.AddNote(report.NoteTypeCreate, "post-create hooks failed")
+		log.Warnf("%s: %v", r.ID, err)
+	}
+	for _, l := range lints {
+		r.AddNote(report.NoteTypeCreate, "%s", l)
+	}
 	return r, nil
 }
 
@@ -315,21 +401,75 @@ func modulePath(iss *issues.Issue) string {
 	return ""
 }
 
+// candidateModules returns the paths of the modules that might be
+// affected by the vulnerability of iss: the modules listed in its body
+// (see issues.Meta), or else the module (or package) in its title.
+// The first is the most likely.
+func candidateModules(iss *issues.Issue) []string {
+	if m := iss.Meta(); m != nil && len(m.Modules) > 0 {
+		return m.Modules
+	}
+	return []string{modulePath(iss)}
+}
+
 func aliases(iss *issues.Issue) (aliases []string) {
 	for _, p := range strings.Fields(iss.Title) {
 		if idstr.IsAliasType(p) {
-			aliases = append(aliases, strings.TrimSuffix(p, ","))
+			aliases = appendNew(aliases, strings.TrimSuffix(p, ","))
+		}
+	}
+	if m := iss.Meta(); m != nil {
+		for _, a := range m.Aliases {
+			aliases = appendNew(aliases, a)
 		}
 	}
 	return aliases
 }
 
+// addCandidateModules adds a module to r for each of the given module
+// (or package) paths that is not already one of its modules, with a
+// note asking for it to be checked.
+func (c *creator) addCandidateModules(r *report.Report, paths []string) {
+	for _, mp := range paths {
+		if module, err := c.pxc.FindModule(mp); err == nil {
+			mp = module
+		}
+		if slices.ContainsFunc(r.Modules, func(m *report.Module) bool { return m.Module == mp }) {
+			continue
+		}
+		r.Modules = append(r.Modules, &report.Module{Module: mp})
+		r.AddNote(report.NoteTypeCreate, "candidate module %s added from the tracker issue; remove it if it is not affected", mp)
+	}
+}
+
+// notePriority returns the priority recorded in the triage note n,
+// or nil if it records none.
+func notePriority(n *issues.TriageNote) *priority.Result {
+	for _, p := range []priority.Priority{priority.Unknown, priority.Low, priority.High} {
+		if n.Priority == p.String() {
+			return &priority.Result{Priority: p, Reason: n.Reason}
+		}
+	}
+	return nil
+}
+
+func appendNew(ss []string, s string) []string {
+	if s == "" || slices.Contains(ss, s) {
+		return ss
+	}
+	return append(ss, s)
+}
+
 // Data that can be combined with a source vulnerability
 // to create a new report.
 type reportMeta struct {
-	id                   string
-	modulePath           string
-	aliases              []string
+	id         string
+	modulePath string
+	candidates []string // candidate modules from the tracker issue, if any
+	aliases    []string
+	// priority is the priority found by vulnreport triage,
+	// or nil to compute it from the report.
+	priority             *priority.Result
 	excluded, unexcluded report.ExcludedType
 	reviewStatus         report.ReviewStatus
 	originalCVE          string
@@ -337,6 +477,26 @@ type reportMeta struct {
 
 const todo = "TODO: "
 
+// addCWESuggestions fills in a TODO for the CWE of a Go CNA report
+// that lists candidate CWEs, so that the triager only needs to confirm
+// one of them (for example, with "vulnreport suggest -cwe -i").
+func (c *creator) addCWESuggestions(ctx context.Context, r *yamlReport) {
+	if r.CVEMetadata == nil || r.CVEMetadata.CWE != "" {
+		return
+	}
+	candidates := c.suggestCWEs(ctx, r)
+	if len(candidates) == 0 {
+		return
+	}
+	var ss []string
+	for _, cand := range candidates {
+		log.Infof("%s: suggested %s (confidence %.2f)", r.ID, cand, cand.Confidence)
+		ss = append(ss, fmt.Sprintf("%s (%.0f%%)", cand.ID, 100*cand.Confidence))
+	}
+	r.CVEMetadata.CWE = todo + "confirm CWE ID; suggestions: " + strings.Join(ss, ", ")
+	c.tm.recordSuggestion(r.ID, suggestedCWE, candidates[0].ID)
+}
+
 // addTODOs adds "TODO" comments to unfilled fields of r.
 func addTODOs(r *yamlReport) {
 	if r.Excluded != "" {
@@ -383,9 +543,10 @@ func addTODOs(r *yamlReport) {
 		r.Description = todo + "description of the vulnerability"
 	}
 	if len(r.Credits) == 0 {
-		r.Credits = []string{todo + "who discovered/reported this vulnerability (optional)"}
+		r.Credits = []*report.Credit{{Name: todo + "who discovered/reported this vulnerability (optional)"}}
 	}
-	if r.CVEMetadata == nil && len(r.CVEs) == 0 {
+	// A report from a GHSA may be for a vulnerability with no CVE.
+	if r.CVEMetadata == nil && len(r.CVEs) == 0 && r.Origin() != report.OriginGHSA {
 		r.CVEs = []string{todo + "CVE id(s) for this vulnerability"}
 	}
 	if r.CVEMetadata != nil && r.CVEMetadata.CWE == "" {
@@ -22,6 +22,8 @@ func (cveCmd) usage() (string, string) {
 	return filenameArgs, desc
 }
 
+func (cveCmd) capabilities() capability { return capReadRepo | capWriteFiles | capNetwork }
+
 func (c *cveCmd) setup(ctx context.Context, env environment) error {
 	c.linter = new(linter)
 	c.filenameParser = new(filenameParser)
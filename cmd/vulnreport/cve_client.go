@@ -0,0 +1,174 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"path"
+	"strings"
+
+	"golang.org/x/tools/txtar"
+	"golang.org/x/vulndb/internal/cve5"
+)
+
+var (
+	cveOrg  = flag.String("cve-org", "Go", "for cve subcommands, the organization name for accessing the CVE Services API")
+	cveTest = flag.Bool("cve-test", false, "for cve subcommands, use the CVE Services test environment")
+)
+
+// cveClient is the part of the CVE Services API
+// (implemented by *cve5.Client) used by vulnreport.
+type cveClient interface {
+	RetrieveID(id string) (*cve5.AssignedCVE, error)
+	RetrieveRecord(id string) (*cve5.CVERecord, error)
+	CreateRecord(id string, record *cve5.Containers) (*cve5.CVERecord, error)
+	UpdateRecord(id string, record *cve5.Containers) (*cve5.CVERecord, error)
+	Reject(id, reason string) error
+	ReserveIDs(opts cve5.ReserveOptions) (cve5.AssignedCVEList, error)
+	WebURL(id string) string
+}
+
+var (
+	_ cveClient = &cve5.Client{}
+	_ cveClient = &memCVEC{}
+)
+
+// memCVEC is an in-memory CVE Services for testing.
+// It sets the dateUpdated of every record it changes to memDateUpdated.
+type memCVEC struct {
+	assigned map[string]*cve5.AssignedCVE
+	records  map[string]*cve5.CVERecord
+}
+
+const memDateUpdated = "2022-01-01T00:00:00"
+
+// newMemCVEC returns a memCVEC with the CVE IDs and records in the
+// archive, which has files "assigned/ID.json" with the JSON of an
+// assigned CVE and "records/ID.json" with the JSON of a record.
+func newMemCVEC(archive []byte) (*memCVEC, error) {
+	ar := txtar.Parse(archive)
+	m := &memCVEC{
+		assigned: make(map[string]*cve5.AssignedCVE),
+		records:  make(map[string]*cve5.CVERecord),
+	}
+	for _, f := range ar.Files {
+		dir, name := path.Split(f.Name)
+		id := strings.TrimSuffix(name, ".json")
+		var err error
+		switch dir {
+		case "assigned/":
+			a := new(cve5.AssignedCVE)
+			err = json.Unmarshal(f.Data, a)
+			m.assigned[id] = a
+		case "records/":
+			r := new(cve5.CVERecord)
+			err = json.Unmarshal(f.Data, r)
+			m.records[id] = r
+		default:
+			return nil, fmt.Errorf("unexpected file %s", f.Name)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", f.Name, err)
+		}
+	}
+	return m, nil
+}
+
+func (m *memCVEC) RetrieveID(id string) (*cve5.AssignedCVE, error) {
+	if a, ok := m.assigned[id]; ok {
+		return a, nil
+	}
+	return nil, fmt.Errorf("%s not found", id)
+}
+
+func (m *memCVEC) RetrieveRecord(id string) (*cve5.CVERecord, error) {
+	if r, ok := m.records[id]; ok {
+		return r, nil
+	}
+	return nil, fmt.Errorf("%s: no record", id)
+}
+
+func (m *memCVEC) CreateRecord(id string, record *cve5.Containers) (*cve5.CVERecord, error) {
+	if _, ok := m.records[id]; ok {
+		return nil, fmt.Errorf("%s: record already exists", id)
+	}
+	return m.publish(id, record)
+}
+
+func (m *memCVEC) UpdateRecord(id string, record *cve5.Containers) (*cve5.CVERecord, error) {
+	if _, ok := m.records[id]; !ok {
+		return nil, fmt.Errorf("%s: no record", id)
+	}
+	return m.publish(id, record)
+}
+
+func (m *memCVEC) publish(id string, containers *cve5.Containers) (*cve5.CVERecord, error) {
+	a, err := m.RetrieveID(id)
+	if err != nil {
+		return nil, err
+	}
+	a.State = cve5.StatePublished
+	r := &cve5.CVERecord{
+		DataType:    "CVE_RECORD",
+		DataVersion: "5.0",
+		Metadata: cve5.Metadata{
+			ID:          id,
+			OrgID:       cve5.GoOrgUUID,
+			State:       cve5.StatePublished,
+			DateUpdated: memDateUpdated,
+		},
+		Containers: *containers,
+	}
+	m.records[id] = r
+	return r, nil
+}
+
+func (m *memCVEC) Reject(id, _ string) error {
+	a, err := m.RetrieveID(id)
+	if err != nil {
+		return err
+	}
+	a.State = cve5.StateRejected
+	m.records[id] = &cve5.CVERecord{
+		Metadata: cve5.Metadata{
+			ID:          id,
+			OrgID:       cve5.GoOrgUUID,
+			State:       cve5.StateRejected,
+			DateUpdated: memDateUpdated,
+		},
+	}
+	return nil
+}
+
+// ReserveIDs reserves IDs after the highest one already assigned.
+// For reproducible tests, it ignores opts.Year and uses 9999.
+func (m *memCVEC) ReserveIDs(opts cve5.ReserveOptions) (cve5.AssignedCVEList, error) {
+	serial := 0
+	for id := range m.assigned {
+		var n int
+		if _, err := fmt.Sscanf(id, "CVE-9999-%d", &n); err == nil && n > serial {
+			serial = n
+		}
+	}
+	var cves cve5.AssignedCVEList
+	for range opts.NumIDs {
+		serial++
+		a := &cve5.AssignedCVE{
+			ID:    fmt.Sprintf("CVE-9999-%04d", serial),
+			Year:  "9999",
+			State: cve5.StateReserved,
+			CNA:   "Go",
+		}
+		m.assigned[a.ID] = a
+		cves = append(cves, *a)
+	}
+	return cves, nil
+}
+
+func (m *memCVEC) WebURL(id string) string {
+	return fmt.Sprintf("%s/CVERecord?id=%s", cve5.WebURL, id)
+}
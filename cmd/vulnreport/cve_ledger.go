@@ -0,0 +1,101 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"slices"
+
+	"gopkg.in/yaml.v3"
+)
+
+var cveLedgerFile = flag.String("cve-ledger", ".cve-ledger.yaml", "file in the local repo that tracks the CVE IDs reserved with cve reserve, which create uses for Go CNA reports")
+
+// A cveLedger tracks the CVE IDs the Go CNA has reserved with
+// "vulnreport cve reserve", so that "vulnreport create" can use
+// them for new reports without going to CVE Services.
+//
+// The ledger is local to a triager, as each triager reserves
+// their own IDs; it is not checked in.
+type cveLedger struct {
+	// Reserved are the reserved IDs that no report uses yet,
+	// in the order they will be used.
+	Reserved []string `yaml:"reserved,omitempty"`
+	// Used are the reserved IDs that reports use, and their reports.
+	Used []*usedCVE `yaml:"used,omitempty"`
+}
+
+type usedCVE struct {
+	ID     string `yaml:"id"`
+	Report string `yaml:"report"`
+}
+
+// readCVELedger reads the ledger from fsys, returning
+// an empty ledger if it doesn't exist.
+func readCVELedger(fsys fs.FS) (*cveLedger, error) {
+	b, err := fs.ReadFile(fsys, *cveLedgerFile)
+	if errors.Is(err, fs.ErrNotExist) {
+		return new(cveLedger), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var l cveLedger
+	d := yaml.NewDecoder(bytes.NewReader(b))
+	d.KnownFields(true)
+	if err := d.Decode(&l); err != nil {
+		return nil, fmt.Errorf("%s: %w", *cveLedgerFile, err)
+	}
+	return &l, nil
+}
+
+func (l *cveLedger) write(w wfs) error {
+	var b bytes.Buffer
+	enc := yaml.NewEncoder(&b)
+	enc.SetIndent(2)
+	if err := enc.Encode(l); err != nil {
+		return err
+	}
+	modified, err := w.WriteFile(*cveLedgerFile, b.Bytes())
+	if err != nil {
+		return err
+	}
+	return ok(*cveLedgerFile, modified)
+}
+
+// add adds the IDs, if they are not already in the ledger,
+// to the end of the reserved IDs.
+func (l *cveLedger) add(ids ...string) {
+	for _, id := range ids {
+		if slices.Contains(l.Reserved, id) || slices.ContainsFunc(l.Used, func(u *usedCVE) bool { return u.ID == id }) {
+			continue
+		}
+		l.Reserved = append(l.Reserved, id)
+	}
+}
+
+// use marks the next reserved ID as used by the report with the
+// given ID, and returns it. It returns false if there are no
+// reserved IDs left.
+func (l *cveLedger) use(reportID string) (string, bool) {
+	if len(l.Reserved) == 0 {
+		return "", false
+	}
+	id := l.Reserved[0]
+	l.Reserved = l.Reserved[1:]
+	l.Used = append(l.Used, &usedCVE{ID: id, Report: reportID})
+	return id, true
+}
+
+// unuse returns the ID, which was returned by use but
+// ended up unused, to the front of the reserved IDs.
+func (l *cveLedger) unuse(id string) {
+	l.Used = slices.DeleteFunc(l.Used, func(u *usedCVE) bool { return u.ID == id })
+	l.Reserved = slices.Insert(l.Reserved, 0, id)
+}
@@ -0,0 +1,168 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"golang.org/x/vulndb/cmd/vulnreport/log"
+	"golang.org/x/vulndb/internal/cve5"
+)
+
+type cvePublish struct {
+	cc cveClient
+
+	*linter
+	*filenameParser
+	*fileWriter
+}
+
+func (cvePublish) name() string { return "cve publish" }
+
+func (cvePublish) usage() (string, string) {
+	const desc = "publishes or updates the CVE records of YAML reports with CVE Services, and records their state in cve_metadata"
+	return filenameArgs, desc
+}
+
+func (cvePublish) capabilities() capability {
+	return capReadRepo | capWriteFiles | capNetwork | capMutateTracker
+}
+
+func (c *cvePublish) setup(ctx context.Context, env environment) error {
+	cc, err := env.CVEClient(ctx)
+	if err != nil {
+		return err
+	}
+	c.cc = cc
+	c.linter = new(linter)
+	c.filenameParser = new(filenameParser)
+	c.fileWriter = new(fileWriter)
+	return setupAll(ctx, env, c.linter, c.filenameParser, c.fileWriter)
+}
+
+func (c *cvePublish) close() error { return nil }
+
+func (*cvePublish) skip(input any) string {
+	r := input.(*yamlReport)
+	if r.CVEMetadata == nil {
+		return "no cve_metadata (the CVE was not assigned by the Go CNA)"
+	}
+	return ""
+}
+
+// run generates the CVE record of the report, publishes it (creating,
+// updating or, for withdrawn reports, rejecting it as needed), and
+// stores the state CVE Services returns in the report's cve_metadata.
+func (c *cvePublish) run(_ context.Context, input any) error {
+	r := input.(*yamlReport)
+	if err := c.lint(r); err != nil {
+		return err
+	}
+	rec, err := cve5.FromReport(r.Report)
+	if err != nil {
+		return err
+	}
+	id := r.CVEMetadata.ID
+	md, err := c.publish(id, &rec.Containers)
+	if err != nil {
+		return fmt.Errorf("%s: %w", id, err)
+	}
+	// Keep the checked-in record in sync with the published one.
+	if err := c.writeCVE(r); err != nil {
+		return err
+	}
+	if md == nil {
+		return nil
+	}
+	updated, err := md.UpdatedTime()
+	if err != nil {
+		return fmt.Errorf("%s: invalid dateUpdated: %w", id, err)
+	}
+	r.CVEMetadata.State = string(md.State)
+	r.CVEMetadata.Updated = updated
+	return c.write(r)
+}
+
+// publish creates, updates or rejects the record of the CVE as needed
+// to match toPublish, and returns the resulting metadata of the record,
+// or nil if nothing needed to change.
+func (c *cvePublish) publish(id string, toPublish *cve5.Containers) (*cve5.Metadata, error) {
+	assigned, err := c.cc.RetrieveID(id)
+	if err != nil {
+		return nil, err
+	}
+	// Never overwrite the record of a CVE owned by another CNA.
+	// Such CVEs belong in the cves section of a report, not cve_metadata.
+	if assigned.CNA != "" && assigned.CNA != *cveOrg {
+		return nil, fmt.Errorf("%w (owning CNA %s)", cve5.ErrNotGoAssigned, assigned.CNA)
+	}
+
+	// Records of withdrawn reports are published by rejecting the CVE.
+	reasons := toPublish.CNAContainer.RejectedReasons
+	switch state := assigned.State; {
+	case len(reasons) > 0 && state == cve5.StateRejected:
+		log.Infof("%s is already rejected", id)
+		return nil, nil
+	case len(reasons) > 0:
+		if err := c.cc.Reject(id, reasons[0].Value); err != nil {
+			return nil, err
+		}
+		log.Infof("rejected %s", id)
+		md := &cve5.Metadata{ID: id, State: cve5.StateRejected}
+		if current, err := c.cc.RetrieveRecord(id); err == nil {
+			md.DateUpdated = current.Metadata.DateUpdated
+		}
+		return md, nil
+	case state == cve5.StatePublished:
+		existing, err := c.cc.RetrieveRecord(id)
+		if err != nil {
+			return nil, err
+		}
+		if err := cve5.CheckGoAssigned(existing); err != nil {
+			return nil, err
+		}
+		diff, err := jsonDiff(id, existing.Containers, *toPublish)
+		if err != nil {
+			return nil, err
+		}
+		if diff == "" {
+			log.Infof("%s is up to date at %s", id, c.cc.WebURL(id))
+			return nil, nil
+		}
+		log.Infof("updating %s with diff:\n%s", id, diff)
+		rec, err := c.cc.UpdateRecord(id, toPublish)
+		if err != nil {
+			return nil, err
+		}
+		log.Infof("updated %s at %s", id, c.cc.WebURL(id))
+		return &rec.Metadata, nil
+	case state == cve5.StateReserved:
+		rec, err := c.cc.CreateRecord(id, toPublish)
+		if err != nil {
+			return nil, err
+		}
+		log.Infof("published %s at %s", id, c.cc.WebURL(id))
+		return &rec.Metadata, nil
+	default:
+		return nil, fmt.Errorf("publishing a %s record is not supported", state)
+	}
+}
+
+// jsonDiff returns a line-based diff of the JSON encodings of old
+// and new, as they would be sent to CVE Services, or "" if they
+// are the same.
+func jsonDiff(name string, old, new any) (string, error) {
+	ob, err := json.MarshalIndent(old, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	nb, err := json.MarshalIndent(new, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return lineDiff(name, append(ob, '\n'), append(nb, '\n')), nil
+}
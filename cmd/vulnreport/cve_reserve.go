@@ -0,0 +1,96 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"golang.org/x/vulndb/cmd/vulnreport/log"
+	"golang.org/x/vulndb/internal/cve5"
+)
+
+type cveReserve struct {
+	cc     cveClient
+	ledger *cveLedger
+	wfs    wfs
+	dryRun bool
+	noSkip
+}
+
+func (cveReserve) name() string { return "cve reserve" }
+
+func (cveReserve) usage() (string, string) {
+	const desc = "reserves CVE IDs (default 1) from CVE Services, and adds them to the ledger of IDs for create to use"
+	return "[count]", desc
+}
+
+func (cveReserve) capabilities() capability {
+	return capReadRepo | capWriteFiles | capNetwork | capMutateTracker
+}
+
+func (c *cveReserve) setup(ctx context.Context, env environment) error {
+	cc, err := env.CVEClient(ctx)
+	if err != nil {
+		return err
+	}
+	c.cc = cc
+	l, err := readCVELedger(env.ReportFS())
+	if err != nil {
+		return err
+	}
+	c.ledger = l
+	c.wfs = env.WFS()
+	c.dryRun = env.dryRun
+	return nil
+}
+
+func (c *cveReserve) close() error {
+	log.Infof("%s: %d reserved CVE ID(s) left to use", *cveLedgerFile, len(c.ledger.Reserved))
+	return nil
+}
+
+func (*cveReserve) inputType() string { return "request" }
+
+func (*cveReserve) parseArgs(_ context.Context, args []string) ([]string, error) {
+	switch len(args) {
+	case 0:
+		return []string{"1"}, nil
+	case 1:
+		return args, nil
+	default:
+		return nil, fmt.Errorf("want at most one count, got %d", len(args))
+	}
+}
+
+func (*cveReserve) lookup(_ context.Context, arg string) (any, error) {
+	n, err := strconv.Atoi(arg)
+	if err != nil || n < 1 {
+		return nil, fmt.Errorf("count must be a positive integer, got %q", arg)
+	}
+	return n, nil
+}
+
+func (c *cveReserve) run(_ context.Context, input any) error {
+	n := input.(int)
+	ids, err := c.cc.ReserveIDs(cve5.ReserveOptions{
+		NumIDs: n,
+		Year:   time.Now().Year(),
+		Mode:   cve5.SequentialRequest,
+	})
+	if err != nil {
+		return err
+	}
+	if len(ids) < n && !c.dryRun {
+		log.Warnf("reserved only %d of %d CVE ID(s) (check the quota)", len(ids), n)
+	}
+	for _, id := range ids {
+		log.Outf("reserved %s", id.ID)
+		c.ledger.add(id.ID)
+	}
+	return c.ledger.write(c.wfs)
+}
@@ -0,0 +1,109 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"slices"
+
+	"golang.org/x/vulndb/cmd/vulnreport/log"
+	"golang.org/x/vulndb/internal/report"
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+var workerStore = flag.String("worker-store", "", "for disputes, the vuln worker's store, given as PROJECT/NAMESPACE (default: the -issue-mirror store)")
+
+// disputes marks the symbols of reports that users have reported as
+// not vulnerable (see the worker's process-symbol-feedback), so that
+// the OSV entries show them as disputed until the feedback is resolved.
+type disputes struct {
+	// open holds the open symbol feedback, by report ID.
+	open map[string][]*store.SymbolFeedbackRecord
+
+	*filenameParser
+	*fileWriter
+	noSkip
+}
+
+func (disputes) name() string { return "disputes" }
+
+func (disputes) usage() (string, string) {
+	const desc = "shows unresolved feedback that report symbols are not vulnerable, and marks those symbols as disputed"
+	return filenameArgs, desc
+}
+
+func (disputes) capabilities() capability { return capReadRepo | capWriteFiles | capNetwork }
+
+func (d *disputes) setup(ctx context.Context, env environment) error {
+	st, err := env.WorkerStore(ctx)
+	if err != nil {
+		return err
+	}
+	rs, err := st.ListSymbolFeedbackRecords(ctx, store.SymbolFeedbackOpen)
+	if err != nil {
+		return err
+	}
+	d.open = make(map[string][]*store.SymbolFeedbackRecord)
+	for _, r := range rs {
+		d.open[r.ReportID] = append(d.open[r.ReportID], r)
+	}
+	d.filenameParser = new(filenameParser)
+	d.fileWriter = new(fileWriter)
+	return setupAll(ctx, env, d.filenameParser, d.fileWriter)
+}
+
+func (*disputes) close() error { return nil }
+
+func (d *disputes) run(_ context.Context, input any) error {
+	r := input.(*yamlReport)
+	disputed := make(map[*report.Package][]string)
+	for _, fb := range d.open[r.ID] {
+		p := affectedPackage(r.Report, fb)
+		if p == nil {
+			log.Warnf("%s: %s.%s is disputed in issue(s) %v, but is not an affected symbol", r.ID, fb.Package, fb.Symbol, fb.Issues)
+			continue
+		}
+		log.Outf("%s: %s.%s is disputed in issue(s) %v", r.ID, fb.Package, fb.Symbol, fb.Issues)
+		disputed[p] = append(disputed[p], fb.Symbol)
+	}
+
+	changed := false
+	for _, m := range r.Modules {
+		for _, p := range m.Packages {
+			syms := disputed[p]
+			slices.Sort(syms)
+			if !slices.Equal(syms, p.DisputedSymbols) {
+				p.DisputedSymbols = syms
+				changed = true
+			}
+		}
+	}
+	if !changed {
+		log.Infof("%s: disputed symbols are up to date", r.ID)
+		return nil
+	}
+	if err := d.write(r); err != nil {
+		return err
+	}
+	if err := d.writeOSV(r); err != nil {
+		return fmt.Errorf("%s: %w", r.ID, err)
+	}
+	return nil
+}
+
+// affectedPackage returns the package of r that lists the symbol
+// of fb, or nil if there is none.
+func affectedPackage(r *report.Report, fb *store.SymbolFeedbackRecord) *report.Package {
+	for _, m := range r.Modules {
+		for _, p := range m.Packages {
+			if p.Package == fb.Package && slices.Contains(p.AllSymbols(), fb.Symbol) {
+				return p
+			}
+		}
+	}
+	return nil
+}
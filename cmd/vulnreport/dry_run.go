@@ -0,0 +1,189 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"flag"
+	"io/fs"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+	"golang.org/x/vulndb/cmd/vulnreport/log"
+	"golang.org/x/vulndb/internal/cve5"
+	"golang.org/x/vulndb/internal/diff"
+	"golang.org/x/vulndb/internal/ghsa"
+	"golang.org/x/vulndb/internal/gitrepo"
+	"golang.org/x/vulndb/internal/issues"
+)
+
+var dryRun = flag.Bool("dry-run", false, "print the files that would be written and the changes that would be made to the issue tracker, GHSAs and git, without making them")
+
+// dryRunWFS is a wfs that prints what would be written
+// instead of writing it. The existing contents of files are
+// read from fsys.
+type dryRunWFS struct {
+	fsys fs.FS
+}
+
+var _ wfs = dryRunWFS{}
+
+func (d dryRunWFS) WriteFile(filename string, b []byte) (bool, error) {
+	name := filepath.ToSlash(filename)
+	existing, err := fs.ReadFile(d.fsys, name)
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		log.Outf("would create %s:\n%s", name, b)
+	case err != nil:
+		return false, err
+	case bytes.Equal(existing, b):
+		return false, nil
+	default:
+		log.Outf("would write %s:\n%s", name, lineDiff(name, existing, b))
+	}
+	return true, nil
+}
+
+func (d dryRunWFS) RemoveFile(filename string) (bool, error) {
+	name := filepath.ToSlash(filename)
+	if _, err := fs.Stat(d.fsys, name); errors.Is(err, fs.ErrNotExist) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	log.Outf("would remove %s", name)
+	return true, nil
+}
+
+// dryRunIC is an issueClient that allows reads but prints
+// modifications instead of making them.
+type dryRunIC struct {
+	issueClient
+}
+
+var _ issueClient = dryRunIC{}
+
+func (d dryRunIC) SetLabels(_ context.Context, n int, labels []string) error {
+	log.Outf("would set labels of %s to [%s]", d.Reference(n), strings.Join(labels, ", "))
+	return nil
+}
+
+func (d dryRunIC) SetAssignee(_ context.Context, n int, assignee string) error {
+	log.Outf("would assign %s to %s", d.Reference(n), assignee)
+	return nil
+}
+
+func (d dryRunIC) CreateIssue(_ context.Context, iss *issues.Issue) (int, error) {
+	log.Outf("would create issue %q:\n%s", iss.Title, iss.Body)
+	return 0, nil
+}
+
+func (d dryRunIC) AddComments(_ context.Context, n int, comments []string) error {
+	for _, c := range comments {
+		log.Outf("would comment on %s:\n%s", d.Reference(n), c)
+	}
+	return nil
+}
+
+func (d dryRunIC) EditComment(_ context.Context, n int, _ int64, body string) error {
+	log.Outf("would edit comment on %s:\n%s", d.Reference(n), body)
+	return nil
+}
+
+// dryRunGC is a ghsaClient that allows reads but prints
+// changes to advisories instead of making them.
+type dryRunGC struct {
+	ghsaClient
+}
+
+var (
+	_ ghsaWriter   = dryRunGC{}
+	_ ghsaReporter = dryRunGC{}
+)
+
+func (dryRunGC) UpdateRepositoryAdvisory(_ context.Context, repo, ghsaID string, a *ghsa.RepositoryAdvisory) (*ghsa.RepositoryAdvisory, error) {
+	log.Outf("would update repository advisory %s of %s", ghsaID, repo)
+	return a, nil
+}
+
+func (dryRunGC) ReportRepositoryVulnerability(_ context.Context, repo string, a *ghsa.RepositoryAdvisory) (*ghsa.RepositoryAdvisory, error) {
+	log.Outf("would report the draft advisory to %s", repo)
+	return a, nil
+}
+
+// dryRunCVEC is a cveClient that allows reads but prints
+// changes to CVE records instead of making them.
+type dryRunCVEC struct {
+	cveClient
+}
+
+var _ cveClient = dryRunCVEC{}
+
+func (dryRunCVEC) CreateRecord(id string, record *cve5.Containers) (*cve5.CVERecord, error) {
+	log.Outf("would create the record of %s", id)
+	return &cve5.CVERecord{Metadata: cve5.Metadata{ID: id, State: cve5.StatePublished}, Containers: *record}, nil
+}
+
+func (dryRunCVEC) UpdateRecord(id string, record *cve5.Containers) (*cve5.CVERecord, error) {
+	log.Outf("would update the record of %s", id)
+	return &cve5.CVERecord{Metadata: cve5.Metadata{ID: id, State: cve5.StatePublished}, Containers: *record}, nil
+}
+
+func (dryRunCVEC) ReserveIDs(opts cve5.ReserveOptions) (cve5.AssignedCVEList, error) {
+	log.Outf("would reserve %d CVE ID(s) for %d", opts.NumIDs, opts.Year)
+	return nil, nil
+}
+
+func (dryRunCVEC) Reject(id, reason string) error {
+	log.Outf("would reject %s with reason %q", id, reason)
+	return nil
+}
+
+// dryRunStatus returns the status that the worktree of repo would
+// have if the reports were written and all changes were staged, as
+// by "git add".
+func dryRunStatus(repo *git.Repository, reports []*yamlReport) (git.Status, error) {
+	status, err := gitrepo.WorktreeStatus(repo)
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range status {
+		switch f.Worktree {
+		case git.Unmodified:
+		case git.Untracked:
+			f.Staging = git.Added
+		default:
+			f.Staging = f.Worktree
+		}
+	}
+	// The reports were not actually written, so they may
+	// not have changed in the worktree.
+	for _, r := range reports {
+		name := filepath.ToSlash(r.Filename)
+		if f, ok := status[name]; ok && f.Staging != git.Unmodified {
+			continue
+		}
+		old, err := headReport(repo, r.Filename)
+		if err != nil {
+			return nil, err
+		}
+		f := &git.FileStatus{Staging: git.Modified}
+		if old == nil {
+			f.Staging = git.Added
+		}
+		status[name] = f
+	}
+	return status, nil
+}
+
+// lineDiff returns a line-based diff of the old and new contents
+// of the file name in the unified diff format, or "" if they are
+// the same.
+func lineDiff(name string, old, new []byte) string {
+	return string(diff.Diff("a/"+name, old, "b/"+name, new))
+}
@@ -0,0 +1,118 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"io/fs"
+	"strings"
+	"testing"
+
+	"golang.org/x/tools/txtar"
+	"golang.org/x/vulndb/cmd/vulnreport/log"
+	"golang.org/x/vulndb/internal/ghsa"
+	"golang.org/x/vulndb/internal/test"
+)
+
+func TestDryRunEnvironment(t *testing.T) {
+	fsys, err := test.TxtarArchiveToFS(txtar.Parse(testRepo))
+	if err != nil {
+		t.Fatal(err)
+	}
+	memIC, err := newMemIC(testIssueTracker)
+	if err != nil {
+		t.Fatal(err)
+	}
+	memGC, err := newMemGC(testLegacyGHSAs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mem := newInMemoryWFS()
+	env := &environment{
+		reportFS: fsys,
+		wfs:      mem,
+		ic:       memIC,
+		gc:       memGC,
+		dryRun:   true,
+	}
+	var out bytes.Buffer
+	log.WriteTo(&out, &bytes.Buffer{})
+	defer log.Discard()
+
+	const filename = "data/reports/GO-9999-0001.yaml"
+	existing, err := fs.ReadFile(fsys, filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wfs := env.WFS()
+	for _, tc := range []struct {
+		filename    string
+		data        []byte
+		wantChanged bool
+		wantOut     string
+	}{
+		{filename, existing, false, ""},
+		{filename, []byte(strings.Replace(string(existing), "A description", "Another description", 1)), true, "would write " + filename},
+		{"data/reports/GO-9999-9999.yaml", []byte("id: GO-9999-9999\n"), true, "would create data/reports/GO-9999-9999.yaml"},
+	} {
+		out.Reset()
+		changed, err := wfs.WriteFile(tc.filename, tc.data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if changed != tc.wantChanged {
+			t.Errorf("WriteFile(%s): changed = %t, want %t", tc.filename, changed, tc.wantChanged)
+		}
+		if !strings.Contains(out.String(), tc.wantOut) {
+			t.Errorf("WriteFile(%s): output %q does not contain %q", tc.filename, out.String(), tc.wantOut)
+		}
+	}
+	if existed, err := wfs.RemoveFile(filename); err != nil || !existed {
+		t.Errorf("RemoveFile(%s) = (%t, %v), want (true, nil)", filename, existed, err)
+	}
+	if len(mem.written) != 0 {
+		t.Errorf("files were written: %v", mem.written)
+	}
+
+	ctx := context.Background()
+	ic, err := env.IssueClient(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	before, err := ic.Issue(ctx, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ic.SetLabels(ctx, 1, []string{"triaged"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := ic.AddComments(ctx, 1, []string{"hi"}); err != nil {
+		t.Fatal(err)
+	}
+	after, err := memIC.Issue(ctx, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(after.Labels, ",") != strings.Join(before.Labels, ",") {
+		t.Errorf("labels changed from %v to %v", before.Labels, after.Labels)
+	}
+
+	gc, err := env.GHSAClient(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	w, ok := gc.(ghsaWriter)
+	if !ok {
+		t.Fatal("dry-run GHSA client is not a ghsaWriter")
+	}
+	out.Reset()
+	if _, err := w.UpdateRepositoryAdvisory(ctx, "owner/repo", "GHSA-xxxx-yyyy-zzzz", &ghsa.RepositoryAdvisory{}); err != nil {
+		t.Fatal(err)
+	}
+	if want := "would update repository advisory GHSA-xxxx-yyyy-zzzz of owner/repo"; !strings.Contains(out.String(), want) {
+		t.Errorf("UpdateRepositoryAdvisory: output %q does not contain %q", out.String(), want)
+	}
+}
@@ -0,0 +1,169 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"golang.org/x/vulndb/cmd/vulnreport/log"
+	"golang.org/x/vulndb/internal/issues"
+	"golang.org/x/vulndb/internal/report"
+)
+
+// dupIndex finds the open issues and filed reports that likely cover
+// the same vulnerability as a new report.
+type dupIndex struct {
+	rc *report.Client
+	// The open issues with each alias (in their titles or structured
+	// sections), not counting those already marked as duplicates.
+	issuesByAlias map[string][]int
+}
+
+func newDupIndex(open []*issues.Issue, rc *report.Client) *dupIndex {
+	d := &dupIndex{rc: rc, issuesByAlias: make(map[string][]int)}
+	for _, iss := range open {
+		if iss.HasLabel(labelDuplicate) {
+			continue
+		}
+		for _, a := range aliases(iss) {
+			d.issuesByAlias[a] = append(d.issuesByAlias[a], iss.Number)
+		}
+	}
+	return d
+}
+
+// A duplicate is an open issue or a report that is likely a duplicate
+// of the one being created.
+type duplicate struct {
+	// The number of the open issue, or 0 for a report.
+	issue int
+	// The ID of the report, or "" for an open issue.
+	id string
+	// Why it is likely a duplicate.
+	reason string
+}
+
+func (d duplicate) String() string {
+	if d.issue != 0 {
+		return fmt.Sprintf("issue #%d (%s)", d.issue, d.reason)
+	}
+	return fmt.Sprintf("%s (%s)", d.id, d.reason)
+}
+
+// byAliases returns the open issues, other than issue number num, and
+// the reports that have any of the given aliases.
+func (d *dupIndex) byAliases(num int, aliases []string) []duplicate {
+	shared := make(map[duplicate][]string)
+	for _, a := range aliases {
+		for _, n := range d.issuesByAlias[a] {
+			if n != num {
+				k := duplicate{issue: n}
+				shared[k] = append(shared[k], a)
+			}
+		}
+		for _, r := range d.rc.ReportsByAlias(a) {
+			k := duplicate{id: r.ID}
+			shared[k] = append(shared[k], a)
+		}
+	}
+	var dups []duplicate
+	for k, as := range shared {
+		slices.Sort(as)
+		k.reason = "shares " + strings.Join(slices.Compact(as), ", ")
+		dups = append(dups, k)
+	}
+	sortDuplicates(dups)
+	return dups
+}
+
+// byVersions returns the reports, other than the one with the given ID,
+// that affect the same versions of any of the given modules. Open
+// issues don't say which versions are affected, so they aren't checked.
+func (d *dupIndex) byVersions(id string, modules []*report.Module) []duplicate {
+	var dups []duplicate
+	for _, m := range modules {
+		if len(m.Versions) == 0 {
+			continue
+		}
+		for _, r := range d.rc.ReportsByModule(m.Module) {
+			if r.ID == id || slices.ContainsFunc(dups, func(d duplicate) bool { return d.id == r.ID }) {
+				continue
+			}
+			for _, m2 := range r.Modules {
+				if m2.Module == m.Module && slices.EqualFunc(m2.Versions, m.Versions, func(v1, v2 *report.Version) bool { return *v1 == *v2 }) {
+					dups = append(dups, duplicate{
+						id:     r.ID,
+						reason: fmt.Sprintf("same versions of %s: %s", m.Module, versionsString(m.Versions)),
+					})
+					break
+				}
+			}
+		}
+	}
+	sortDuplicates(dups)
+	return dups
+}
+
+// versionsString returns vs in the form "introduced 1.2.0, fixed 1.3.1".
+func versionsString(vs report.Versions) string {
+	var strs []string
+	for _, v := range vs {
+		strs = append(strs, fmt.Sprintf("%s %s", v.Type, v.Version))
+	}
+	return strings.Join(strs, ", ")
+}
+
+// sortDuplicates sorts issues, by number, before reports, by ID.
+func sortDuplicates(dups []duplicate) {
+	slices.SortFunc(dups, func(a, b duplicate) int {
+		if (a.issue == 0) != (b.issue == 0) {
+			return b.issue - a.issue
+		}
+		return cmp.Or(cmp.Compare(a.issue, b.issue), cmp.Compare(a.id, b.id))
+	})
+}
+
+// checkAliasDuplicates returns an error if the open issues or the
+// reports have any of the aliases of iss (or their aliases), unless
+// c does not check for duplicates or -f is set, in which case it
+// only warns.
+func (c *creator) checkAliasDuplicates(ctx context.Context, iss *issues.Issue) error {
+	if c.dups == nil {
+		return nil
+	}
+	as := aliases(iss)
+	if len(as) == 0 {
+		return nil
+	}
+	return refuseDuplicates(fmt.Sprintf("issue #%d", iss.Number), c.dups.byAliases(iss.Number, c.allAliases(ctx, as)))
+}
+
+// checkVersionDuplicates is like checkAliasDuplicates, but for the
+// reports that affect the same versions of the same modules as r.
+func (c *creator) checkVersionDuplicates(r *yamlReport) error {
+	if c.dups == nil {
+		return nil
+	}
+	return refuseDuplicates(r.ID, c.dups.byVersions(r.ID, r.Modules))
+}
+
+func refuseDuplicates(what string, dups []duplicate) error {
+	if len(dups) == 0 {
+		return nil
+	}
+	var strs []string
+	for _, d := range dups {
+		strs = append(strs, d.String())
+	}
+	if *force {
+		log.Warnf("%s: creating anyway (-f), but likely a duplicate of:%s%s", what, listItem, strings.Join(strs, listItem))
+		return nil
+	}
+	return fmt.Errorf("%s: likely a duplicate of %s; mark it as a duplicate, or use -f to create the report anyway", what, strings.Join(strs, ", "))
+}
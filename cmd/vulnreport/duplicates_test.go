@@ -0,0 +1,95 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/vulndb/internal/issues"
+	"golang.org/x/vulndb/internal/report"
+)
+
+func TestDupIndex(t *testing.T) {
+	rc, err := report.NewTestClient(map[string]*report.Report{
+		"data/reports/GO-1999-0001.yaml": {
+			ID:   "GO-1999-0001",
+			CVEs: []string{"CVE-1999-0001"},
+			Modules: []*report.Module{{
+				Module:   "example.com/a",
+				Versions: report.Versions{report.Introduced("1.2.0"), report.Fixed("1.3.1")},
+			}},
+		},
+		"data/reports/GO-1999-0002.yaml": {
+			ID: "GO-1999-0002",
+			Modules: []*report.Module{{
+				Module:   "example.com/a",
+				Versions: report.Versions{report.Fixed("1.2.0")},
+			}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	open := []*issues.Issue{
+		{Number: 1, Title: "x/vulndb: potential Go vuln in example.com/a: CVE-1999-0001"},
+		{Number: 2, Title: "x/vulndb: potential Go vuln in example.com/a: GHSA-xxxx-yyyy-zzzz", Body: (&issues.Meta{Aliases: []string{"CVE-1999-0001"}}).Section()},
+		{Number: 3, Title: "x/vulndb: potential Go vuln in example.com/a: GHSA-xxxx-yyyy-zzzz", Labels: []string{labelDuplicate}},
+		{Number: 4, Title: "x/vulndb: potential Go vuln in example.com/b", Body: (&issues.Meta{Aliases: []string{"CVE-1999-0004"}}).Section()},
+	}
+	d := newDupIndex(open, rc)
+
+	for _, tc := range []struct {
+		name    string
+		num     int
+		aliases []string
+		want    []duplicate
+	}{
+		{
+			name:    "issues_and_report",
+			num:     1,
+			aliases: []string{"CVE-1999-0001", "GHSA-xxxx-yyyy-zzzz"},
+			want: []duplicate{
+				{issue: 2, reason: "shares CVE-1999-0001, GHSA-xxxx-yyyy-zzzz"},
+				{id: "GO-1999-0001", reason: "shares CVE-1999-0001"},
+			},
+		},
+		{
+			name:    "alias_in_body",
+			num:     6,
+			aliases: []string{"CVE-1999-0004"},
+			want:    []duplicate{{issue: 4, reason: "shares CVE-1999-0004"}},
+		},
+		{
+			name:    "none",
+			num:     5,
+			aliases: []string{"CVE-1999-0005"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got := d.byAliases(tc.num, tc.aliases)
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(duplicate{})); diff != "" {
+				t.Errorf("byAliases() mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+
+	modules := []*report.Module{{
+		Module:   "example.com/a",
+		Versions: report.Versions{report.Introduced("1.2.0"), report.Fixed("1.3.1")},
+	}}
+	want := []duplicate{{id: "GO-1999-0001", reason: "same versions of example.com/a: introduced 1.2.0, fixed 1.3.1"}}
+	if diff := cmp.Diff(want, d.byVersions("GO-1999-0003", modules), cmp.AllowUnexported(duplicate{})); diff != "" {
+		t.Errorf("byVersions() mismatch (-want, +got):\n%s", diff)
+	}
+	if got := d.byVersions("GO-1999-0001", modules); len(got) != 0 {
+		t.Errorf("byVersions() of the report itself = %v, want none", got)
+	}
+	// The same version as GO-1999-0002, but introduced rather than fixed.
+	introduced := []*report.Module{{Module: "example.com/a", Versions: report.Versions{report.Introduced("1.2.0")}}}
+	if got := d.byVersions("GO-1999-0003", introduced); len(got) != 0 {
+		t.Errorf("byVersions(%v) = %v, want none", versionsString(introduced[0].Versions), got)
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+
+	"golang.org/x/vulndb/cmd/vulnreport/log"
+	"golang.org/x/vulndb/internal/epss"
+	"golang.org/x/vulndb/internal/report"
+)
+
+type enrich struct {
+	ec epssClient
+
+	*filenameParser
+	*fileWriter
+}
+
+func (enrich) name() string { return "enrich" }
+
+func (enrich) usage() (string, string) {
+	const desc = "records the current EPSS score of the CVEs of YAML reports"
+	return filenameArgs, desc
+}
+
+func (enrich) capabilities() capability { return capReadRepo | capWriteFiles | capNetwork }
+
+func (e *enrich) setup(ctx context.Context, env environment) error {
+	e.ec = env.EPSSClient()
+	e.filenameParser = new(filenameParser)
+	e.fileWriter = new(fileWriter)
+	return setupAll(ctx, env, e.filenameParser, e.fileWriter)
+}
+
+func (e *enrich) close() error { return nil }
+
+func (*enrich) skip(input any) string {
+	r := input.(*yamlReport)
+	if r.IsExcluded() {
+		return "excluded"
+	}
+	if r.Withdrawn != nil {
+		return "withdrawn"
+	}
+	if len(r.AllCVEs()) == 0 {
+		return "no CVEs"
+	}
+	return ""
+}
+
+// run sets the EPSS field of the report to the highest current score
+// of its CVEs. To avoid churn, the field is only updated if the score
+// changed significantly since it was recorded, unless -f is set.
+func (e *enrich) run(ctx context.Context, input any) error {
+	r := input.(*yamlReport)
+	cves := r.AllCVEs()
+	scores, err := e.ec.Scores(ctx, cves)
+	if err != nil {
+		return err
+	}
+	s := epss.Max(scores, cves)
+	if s == nil {
+		log.Infof("%s: EPSS has no score for %v", r.ID, cves)
+		return nil
+	}
+	if !*force && !r.EPSS.Outdated(s) {
+		log.Infof("%s: EPSS score of %s is unchanged", r.ID, r.EPSS.CVE)
+		return nil
+	}
+	r.EPSS = report.NewEPSS(s)
+	if err := e.write(r); err != nil {
+		return err
+	}
+	return e.writeOSV(r)
+}
+
+type epssClient interface {
+	Scores(ctx context.Context, cves []string) (map[string]*epss.Score, error)
+}
+
+// memEPSS is an in-memory epssClient, for testing.
+type memEPSS map[string]*epss.Score
+
+func (m memEPSS) Scores(_ context.Context, cves []string) (map[string]*epss.Score, error) {
+	scores := make(map[string]*epss.Score)
+	for _, cve := range cves {
+		if s, ok := m[cve]; ok {
+			scores[cve] = s
+		}
+	}
+	return scores, nil
+}
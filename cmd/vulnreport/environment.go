@@ -6,33 +6,59 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io/fs"
 	"os"
+	"strings"
 
 	"github.com/go-git/go-git/v5"
+	"golang.org/x/vulndb/cmd/vulnreport/log"
+	"golang.org/x/vulndb/internal/cve5"
+	"golang.org/x/vulndb/internal/epss"
 	"golang.org/x/vulndb/internal/ghsa"
 	"golang.org/x/vulndb/internal/gitrepo"
 	"golang.org/x/vulndb/internal/issues"
+	"golang.org/x/vulndb/internal/kev"
 	"golang.org/x/vulndb/internal/pkgsite"
 	"golang.org/x/vulndb/internal/proxy"
+	"golang.org/x/vulndb/internal/secrets"
+	"golang.org/x/vulndb/internal/stdlib"
+	"golang.org/x/vulndb/internal/triage/owners"
 	"golang.org/x/vulndb/internal/triage/priority"
+	"golang.org/x/vulndb/internal/worker/store"
 )
 
 // environment stores fakes/mocks of external dependencies for testing.
 type environment struct {
-	reportRepo *git.Repository
-	reportFS   fs.FS
-	pxc        *proxy.Client
-	pkc        *pkgsite.Client
-	wfs        wfs
-	ic         issueClient
-	gc         ghsaClient
-	moduleMap  map[string]int
+	reportRepo    *git.Repository
+	reportFS      fs.FS
+	pxc           *proxy.Client
+	pkc           *pkgsite.Client
+	wfs           wfs
+	ic            issueClient
+	gc            ghsaClient
+	cvec          cveClient
+	moduleMap     map[string]int
+	history       priority.History
+	priorityModel *priority.Model
+	kev           map[string]bool
+	epssc         epssClient
+	owners        *owners.Owners
+	st            store.Store
+	secrets       secrets.Provider
+	releases      *stdlib.Schedule
+	telemetry     *telemetry
+	hooks         hookExecutor
+
+	// capabilities that commands may not use
+	denied capability
+	// whether to print changes instead of making them (-dry-run)
+	dryRun bool
 }
 
 func defaultEnv() environment {
-	return environment{}
+	return environment{denied: deniedCapabilities(), dryRun: *dryRun}
 }
 
 func (e *environment) ReportRepo(ctx context.Context) (*git.Repository, error) {
@@ -64,10 +90,33 @@ func (e *environment) PkgsiteClient() *pkgsite.Client {
 		return v
 	}
 
-	return pkgsite.Default()
+	return pkgsite.Default(pkgsite.WithProxyFallback(e.ProxyClient()))
+}
+
+// Telemetry returns the telemetry recorder, which is nil unless
+// telemetry is enabled with -telemetry.
+func (e *environment) Telemetry() *telemetry {
+	return e.telemetry
+}
+
+// HookExecutor returns the executor for the hooks in the -hooks file,
+// which runs them in the local repo.
+func (e *environment) HookExecutor() hookExecutor {
+	if v := e.hooks; v != nil {
+		return v
+	}
+
+	return cmdHookExecutor{dir: *reportRepo}
 }
 
 func (e *environment) WFS() wfs {
+	if e.denied&capWriteFiles != 0 {
+		return readOnlyWFS{}
+	}
+	if e.dryRun {
+		return dryRunWFS{fsys: e.ReportFS()}
+	}
+
 	if v := e.wfs; v != nil {
 		return v
 	}
@@ -76,35 +125,277 @@ func (e *environment) WFS() wfs {
 }
 
 func (e *environment) IssueClient(ctx context.Context) (issueClient, error) {
+	ic, err := e.issueClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if e.denied&capMutateTracker != 0 {
+		return readOnlyIC{ic}, nil
+	}
+	if e.dryRun {
+		return dryRunIC{ic}, nil
+	}
+	return ic, nil
+}
+
+func (e *environment) issueClient(ctx context.Context) (issueClient, error) {
 	if e.ic != nil {
 		return e.ic, nil
 	}
 
-	if *githubToken == "" {
-		return nil, fmt.Errorf("githubToken must be provided")
+	if host, project, ok := gitLabRepo(*issueRepo); ok {
+		if *issueMirror != "" {
+			return nil, fmt.Errorf("-issue-mirror is not supported for GitLab issue trackers")
+		}
+		token, err := e.Secret(ctx, secrets.GitLabToken)
+		if err != nil {
+			return nil, err
+		}
+		return issues.NewGitLabClient(&issues.GitLabConfig{BaseURL: "https://" + host, Project: project, Token: token}), nil
 	}
+
 	owner, repoName, err := gitrepo.ParseGitHubRepo(*issueRepo)
 	if err != nil {
 		return nil, err
 	}
-	return issues.NewClient(ctx, &issues.Config{Owner: owner, Repo: repoName, Token: *githubToken}), nil
+	if *issueMirror != "" {
+		st, err := openIssueMirror(ctx, *issueMirror)
+		if err != nil {
+			return nil, err
+		}
+		var live issueClient
+		token, err := e.Secret(ctx, secrets.GitHubToken)
+		if err == nil {
+			live = issues.NewClient(ctx, &issues.Config{Owner: owner, Repo: repoName, Token: token})
+		} else if !errors.Is(err, secrets.ErrNotFound) {
+			return nil, err
+		}
+		return newMirrorIC(st, live, owner, repoName), nil
+	}
+
+	token, err := e.Secret(ctx, secrets.GitHubToken)
+	if err != nil {
+		return nil, err
+	}
+	return issues.NewClient(ctx, &issues.Config{Owner: owner, Repo: repoName, Token: token}), nil
+}
+
+// gitLabRepo reports whether the -issue-repo value s names a GitLab
+// project, which is any repo with a host other than github.com
+// (for example, "gitlab.example.com/security/vulndb").
+// It returns the host and the full path of the project.
+func gitLabRepo(s string) (host, project string, ok bool) {
+	host, project, found := strings.Cut(s, "/")
+	if !found || host == "github.com" || !strings.Contains(host, ".") {
+		return "", "", false
+	}
+	return host, project, true
+}
+
+// issueRepoPath returns the path of the -issue-repo repo
+// without its host (for example, "golang/vulndb"), as used to
+// refer to its issues from commit messages.
+func issueRepoPath() string {
+	if _, project, ok := gitLabRepo(*issueRepo); ok {
+		return project
+	}
+	return strings.TrimPrefix(*issueRepo, "github.com/")
 }
 
 func (e *environment) GHSAClient(ctx context.Context) (ghsaClient, error) {
+	gc, err := e.ghsaClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if e.dryRun {
+		return dryRunGC{gc}, nil
+	}
+	return gc, nil
+}
+
+func (e *environment) ghsaClient(ctx context.Context) (ghsaClient, error) {
 	if v := e.gc; v != nil {
 		return v, nil
 	}
 
-	if *githubToken == "" {
-		return nil, fmt.Errorf("githubToken must be provided")
+	token, err := e.Secret(ctx, secrets.GitHubToken)
+	if err != nil {
+		return nil, err
+	}
+	return ghsa.NewClient(ctx, token), nil
+}
+
+// CVEClient returns a client for CVE Services, which
+// only prints changes to CVE records if -dry-run is set.
+func (e *environment) CVEClient(ctx context.Context) (cveClient, error) {
+	cc, err := e.cveClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if e.dryRun {
+		return dryRunCVEC{cc}, nil
+	}
+	return cc, nil
+}
+
+func (e *environment) cveClient(ctx context.Context) (cveClient, error) {
+	if v := e.cvec; v != nil {
+		return v, nil
+	}
+
+	endpoint, keyName, userName := cve5.ProdEndpoint, secrets.CVEAPIKey, secrets.CVEAPIUser
+	if *cveTest {
+		endpoint, keyName, userName = cve5.TestEndpoint, secrets.TestCVEAPIKey, secrets.TestCVEAPIUser
+	}
+	key, err := e.Secret(ctx, keyName)
+	if err != nil {
+		return nil, err
+	}
+	user, err := e.Secret(ctx, userName)
+	if err != nil {
+		return nil, err
+	}
+	return cve5.NewClient(cve5.Config{
+		Endpoint: endpoint,
+		Key:      key,
+		Org:      *cveOrg,
+		User:     user,
+	}), nil
+}
+
+// secretHints explains how to provide each secret, beyond
+// the -secrets flag.
+var secretHints = map[string]string{
+	secrets.GitHubToken:    "set it with -ghtoken or -secrets (see doc/quickstart.md)",
+	secrets.GitLabToken:    "set it with -secrets or $VULN_GITLAB_ACCESS_TOKEN; it needs the api scope",
+	secrets.GeminiAPIKey:   "set it with -secrets; get a key at https://aistudio.google.com/app/apikey",
+	secrets.CVEAPIKey:      "set it with -secrets; use -cve-test for the test environment",
+	secrets.CVEAPIUser:     "set it with -secrets; use -cve-test for the test environment",
+	secrets.TestCVEAPIKey:  "set it with -secrets",
+	secrets.TestCVEAPIUser: "set it with -secrets",
+}
+
+// Secret returns the named secret, or an error explaining
+// how to provide it.
+func (e *environment) Secret(ctx context.Context, name string) (string, error) {
+	p := e.secrets
+	if p == nil {
+		p = secrets.Env
 	}
-	return ghsa.NewClient(ctx, *githubToken), nil
+	vals, err := secrets.Require(ctx, p, name)
+	if me := new(secrets.MissingError); errors.As(err, &me) {
+		me.Hint = secretHints[name]
+		return "", me
+	}
+	if err != nil {
+		return "", err
+	}
+	return vals[0], nil
 }
 
-func (e *environment) ModuleMap() (map[string]int, error) {
+func (e *environment) ModuleMap(ctx context.Context) (map[string]int, error) {
 	if v := e.moduleMap; v != nil {
 		return v, nil
 	}
 
+	if *moduleMapSource != "" {
+		m, err := priority.FetchModuleMap(ctx, *moduleMapSource)
+		if err == nil {
+			return m, nil
+		}
+		log.Warnf("%s; falling back to the checked-in module map", err)
+	}
 	return priority.LoadModuleMap()
 }
+
+func (e *environment) ModuleHistory() (priority.History, error) {
+	if v := e.history; v != nil {
+		return v, nil
+	}
+
+	return priority.LoadHistory()
+}
+
+// PriorityModel returns the priority scoring model, from the
+// -priority-model flag or else the checked-in model.
+func (e *environment) PriorityModel() (*priority.Model, error) {
+	if v := e.priorityModel; v != nil {
+		return v, nil
+	}
+
+	if *priorityModel != "" {
+		return priority.ReadModel(*priorityModel)
+	}
+	return priority.Default(), nil
+}
+
+// KEV returns the set of CVE IDs in CISA's catalog of Known Exploited
+// Vulnerabilities, read from the -kev flag, or nil if the flag is unset
+// or the catalog can't be read.
+func (e *environment) KEV(ctx context.Context) map[string]bool {
+	if v := e.kev; v != nil {
+		return v
+	}
+
+	if *kevSource == "" {
+		return nil
+	}
+	cat, err := kev.Read(ctx, *kevSource)
+	if err != nil {
+		log.Warnf("%s; not taking known exploitation into account", err)
+		return nil
+	}
+	return cat.CVEs()
+}
+
+// EPSSClient returns a client for FIRST's EPSS API.
+func (e *environment) EPSSClient() epssClient {
+	if v := e.epssc; v != nil {
+		return v
+	}
+
+	return epss.NewClient()
+}
+
+// Owners returns the module areas and their preferred reviewers,
+// from the -owners flag or else the checked-in owners.
+func (e *environment) Owners() (*owners.Owners, error) {
+	if v := e.owners; v != nil {
+		return v, nil
+	}
+
+	if *ownersFile != "" {
+		return owners.Read(*ownersFile)
+	}
+	return owners.Default(), nil
+}
+
+// GoReleases returns the announced Go releases, given by
+// the -go-releases flag.
+func (e *environment) GoReleases(ctx context.Context) (*stdlib.Schedule, error) {
+	if v := e.releases; v != nil {
+		return v, nil
+	}
+
+	return stdlib.FetchSchedule(ctx, *goReleases)
+}
+
+// WorkerStore returns the vuln worker's store, given by the
+// -worker-store flag, or else by -issue-mirror.
+func (e *environment) WorkerStore(ctx context.Context) (store.Store, error) {
+	if v := e.st; v != nil {
+		return v, nil
+	}
+
+	spec := *workerStore
+	if spec == "" {
+		spec = *issueMirror
+	}
+	if spec == "" {
+		return nil, errors.New("need -worker-store=PROJECT/NAMESPACE")
+	}
+	return openIssueMirror(ctx, spec)
+}
@@ -0,0 +1,74 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"flag"
+	"strings"
+
+	"golang.org/x/vulndb/cmd/vulnreport/log"
+	"golang.org/x/vulndb/internal/proxy"
+)
+
+var nestedModules = flag.String("nested-modules", "", "for expand-monorepo, comma-separated nested modules to consider in addition to those in the module map")
+
+type expandMonorepo struct {
+	pxc *proxy.Client
+	// candidates are the modules that may be nested
+	// in the modules of a report.
+	candidates []string
+
+	*filenameParser
+	*fileWriter
+	noSkip
+}
+
+func (expandMonorepo) name() string { return "expand-monorepo" }
+
+func (expandMonorepo) usage() (string, string) {
+	const desc = "moves packages of YAML reports to the nested modules of monorepos that contain them"
+	return filenameArgs, desc
+}
+
+func (expandMonorepo) capabilities() capability {
+	return capReadRepo | capWriteFiles | capNetwork
+}
+
+func (e *expandMonorepo) setup(ctx context.Context, env environment) error {
+	e.pxc = env.ProxyClient()
+	mm, err := env.ModuleMap(ctx)
+	if err != nil {
+		return err
+	}
+	for mod := range mm {
+		e.candidates = append(e.candidates, mod)
+	}
+	for _, mod := range strings.Split(*nestedModules, ",") {
+		if mod = strings.TrimSpace(mod); mod != "" {
+			e.candidates = append(e.candidates, mod)
+		}
+	}
+	e.filenameParser = new(filenameParser)
+	e.fileWriter = new(fileWriter)
+	return setupAll(ctx, env, e.filenameParser, e.fileWriter)
+}
+
+func (e *expandMonorepo) close() error { return nil }
+
+// run moves the packages of the report that are in nested modules
+// (e.g., the service modules of github.com/aws/aws-sdk-go-v2) to their
+// own module entries, and writes the report if anything moved.
+func (e *expandMonorepo) run(ctx context.Context, input any) error {
+	r := input.(*yamlReport)
+	added := r.ExpandMonorepo(e.pxc, e.candidates)
+	if len(added) == 0 {
+		log.Infof("%s: no nested modules found", r.ID)
+		return nil
+	}
+	log.Infof("%s: added nested module(s):%s%s", r.ID, listItem, strings.Join(added, listItem))
+	log.Infof("%s: run 'vulnreport fix %s' to fill in the new modules' vulnerable_at versions", r.ID, r.ID)
+	return e.write(r)
+}
@@ -0,0 +1,111 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"flag"
+	"fmt"
+	"io/fs"
+	"path"
+	"path/filepath"
+
+	"golang.org/x/vulndb/internal/parquet"
+	"golang.org/x/vulndb/internal/report"
+)
+
+var (
+	exportFormat = flag.String("export-format", "csv", "for export, the format of the table to write (csv or parquet)")
+	exportOut    = flag.String("export-out", "", "for export, the file to write the table to (default: vulndb.csv or vulndb.parquet)")
+)
+
+// export writes a table of the affected version ranges
+// in the reports, for analytics.
+type export struct {
+	*filenameParser
+	*fileWriter
+
+	rows [][]string
+}
+
+func (export) name() string { return "export" }
+
+func (export) usage() (string, string) {
+	const desc = "writes a CSV or Parquet table with a row for each affected version range of each module (all reports if no arguments are given)"
+	return filenameArgs, desc
+}
+
+func (export) capabilities() capability { return capReadRepo | capWriteFiles }
+
+func (e *export) setup(ctx context.Context, env environment) error {
+	if *exportFormat != "csv" && *exportFormat != "parquet" {
+		return fmt.Errorf("invalid -export-format %q (want csv or parquet)", *exportFormat)
+	}
+	e.filenameParser = new(filenameParser)
+	e.fileWriter = new(fileWriter)
+	return setupAll(ctx, env, e.filenameParser, e.fileWriter)
+}
+
+// parseArgs returns all reports if no arguments are given.
+func (e *export) parseArgs(ctx context.Context, args []string) ([]string, error) {
+	if len(args) > 0 || *sinceCommit != "" {
+		return e.filenameParser.parseArgs(ctx, args)
+	}
+	return fs.Glob(e.fsys, path.Join(filepath.ToSlash(report.YAMLDir), "*.yaml"))
+}
+
+func (*export) skip(input any) string {
+	r := input.(*yamlReport)
+	if r.IsExcluded() {
+		return "excluded"
+	}
+	if r.Withdrawn != nil {
+		return "withdrawn"
+	}
+	return ""
+}
+
+func (e *export) run(_ context.Context, input any) error {
+	r := input.(*yamlReport)
+	rows, err := r.ExportRows()
+	if err != nil {
+		return err
+	}
+	e.rows = append(e.rows, rows...)
+	return nil
+}
+
+// close writes the table.
+func (e *export) close() error {
+	if len(e.rows) == 0 {
+		return nil
+	}
+	var b bytes.Buffer
+	switch *exportFormat {
+	case "csv":
+		w := csv.NewWriter(&b)
+		if err := w.Write(report.ExportColumns); err != nil {
+			return err
+		}
+		if err := w.WriteAll(e.rows); err != nil {
+			return err
+		}
+	case "parquet":
+		if err := parquet.Write(&b, report.ExportColumns, e.rows); err != nil {
+			return err
+		}
+	}
+	out := *exportOut
+	if out == "" {
+		out = "vulndb." + *exportFormat
+	}
+	modified, err := e.WriteFile(out, b.Bytes())
+	if err != nil {
+		return err
+	}
+	return ok(out, modified)
+}
@@ -13,22 +13,30 @@ import (
 	"runtime"
 	"slices"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"golang.org/x/vulndb/cmd/vulnreport/log"
 	"golang.org/x/vulndb/internal/osvutils"
 	"golang.org/x/vulndb/internal/pkgsite"
 	"golang.org/x/vulndb/internal/report"
+	"golang.org/x/vulndb/internal/stdlib"
 	"golang.org/x/vulndb/internal/symbols"
+	"golang.org/x/vulndb/internal/version"
 )
 
 var (
-	force        = flag.Bool("f", false, "for fix, force Fix to run even if there are no lint errors")
+	force        = flag.Bool("f", false, "for fix, force Fix to run even if there are no lint errors; for create, create reports even if they are likely duplicates")
 	skipChecks   = flag.Bool("skip-checks", false, "for fix, skip all checks except lint")
 	skipAlias    = flag.Bool("skip-alias", false, "for fix, skip adding new GHSAs and CVEs")
 	skipSymbols  = flag.Bool("skip-symbols", false, "for fix, don't load package for symbols checks")
 	skipPackages = flag.Bool("skip-packages", false, "for fix, don't check if packages exist")
 	skipRefs     = flag.Bool("skip-refs", false, "for fix, don't check if references exist")
+	skipReleases = flag.Bool("skip-releases", false, "for fix, don't check that standard library fixed versions are Go releases")
+	skipFixed    = flag.Bool("skip-fixed", false, "for fix, don't add missing fixed versions from the tags containing fix commits")
+
+	forceReviewed = flag.String("force-reviewed", "", "for fix, allow fixers to change the frozen fields (versions and symbols) of REVIEWED reports, recording this reason in a force-reviewed note")
 )
 
 type fix struct {
@@ -44,13 +52,20 @@ func (fix) usage() (string, string) {
 	return filenameArgs, desc
 }
 
+func (fix) capabilities() capability { return capReadRepo | capWriteFiles | capNetwork }
+
 func (f *fix) setup(ctx context.Context, env environment) error {
 	f.fixer = new(fixer)
 	f.filenameParser = new(filenameParser)
 	return setupAll(ctx, env, f.fixer, f.filenameParser)
 }
 
-func (*fix) close() error { return nil }
+func (f *fix) close() error {
+	if *fixerStats {
+		f.logFixerStats()
+	}
+	return nil
+}
 
 func (f *fix) run(ctx context.Context, input any) error {
 	r := input.(*yamlReport)
@@ -61,16 +76,37 @@ type fixer struct {
 	*linter
 	*aliasFinder
 	*fileWriter
+	*hookRunner
 
 	pkc *pkgsite.Client
+
+	// goReleases fetches the Go release schedule, which is only
+	// needed for standard library and toolchain reports.
+	goReleases func() (*stdlib.Schedule, error)
+
+	// fixers are the enabled fixers, in the order to run them.
+	fixers []*fixerSpec
+	stats  map[string]*fixerStat
+	tm     *telemetry
 }
 
 func (f *fixer) setup(ctx context.Context, env environment) error {
 	f.pkc = env.PkgsiteClient()
+	f.goReleases = sync.OnceValues(func() (*stdlib.Schedule, error) {
+		return env.GoReleases(ctx)
+	})
+	fixers, err := selectFixers(allFixers)
+	if err != nil {
+		return err
+	}
+	f.fixers = fixers
+	f.stats = make(map[string]*fixerStat)
+	f.tm = env.Telemetry()
 	f.linter = new(linter)
 	f.aliasFinder = new(aliasFinder)
 	f.fileWriter = new(fileWriter)
-	return setupAll(ctx, env, f.linter, f.aliasFinder, f.fileWriter)
+	f.hookRunner = new(hookRunner)
+	return setupAll(ctx, env, f.linter, f.aliasFinder, f.fileWriter, f.hookRunner)
 }
 
 func (f *fixer) fixAndWriteAll(ctx context.Context, r *yamlReport, addNotes bool) error {
@@ -91,15 +127,31 @@ func (f *fixer) fixAndWriteAll(ctx context.Context, r *yamlReport, addNotes bool
 func (f *fixer) fix(ctx context.Context, r *yamlReport, addNotes bool) (fixed bool) {
 	fixed = true
 
+	// The versions and symbols of REVIEWED reports are frozen, unless
+	// the report is being created (in which case notes are added),
+	// as no person has reviewed it yet.
+	var frozen *report.FrozenSnapshot
+	if !addNotes {
+		frozen = r.FrozenSnapshot()
+	}
+
 	if lints := r.Lint(f.pxc); *force || len(lints) > 0 {
+		before, start := f.tm.fieldSnapshot(r.Report), time.Now()
 		r.Fix(f.pxc)
+		f.tm.recordFixer("lint", r.Report, before, "ok", time.Since(start))
 	}
 
-	if !*skipChecks {
-		if ok := f.allChecks(ctx, r, addNotes); !ok {
-			fixed = false
-		}
+	if ok := f.runFixers(ctx, r, addNotes); !ok {
+		fixed = false
+	}
+	hookLints, _, err := f.runHooks(ctx, stagePostFix, r)
+	if err != nil {
+		log.Errf("%s: %v", r.ID, err)
+		fixed = false
 	}
+	r.Normalize()
+
+	checkFrozen(r, frozen, *forceReviewed)
 
 	// Check for remaining lint errors.
 	if addNotes {
@@ -114,50 +166,41 @@ func (f *fixer) fix(ctx context.Context, r *yamlReport, addNotes bool) (fixed bo
 		}
 	}
 
-	return fixed
-}
-
-// allChecks runs additional checks/fixes that are slow and require a network connection.
-// Unlike lint checks which cannot be bypassed, these *should* pass before submit,
-// but it is possible to bypass them if needed with the "-skip-checks" flag.
-func (f *fixer) allChecks(ctx context.Context, r *yamlReport, addNotes bool) (ok bool) {
-	ok = true
-	fixErr := func(f string, v ...any) {
-		log.Errf(r.ID+": "+f, v...)
+	// Lints from hooks can't be fixed here, so they are reported
+	// the same way as the remaining lint errors.
+	if len(hookLints) > 0 {
 		if addNotes {
-			r.AddNote(report.NoteTypeFix, f, v...)
+			for _, l := range hookLints {
+				r.AddNote(report.NoteTypeLint, "%s", l)
+			}
+		} else {
+			log.Warnf("%s: hooks found problems:\n\t- %s", r.ID, strings.Join(hookLints, "\n\t- "))
 		}
-		ok = false
+		fixed = false
 	}
 
-	if !*skipPackages {
-		log.Infof("%s: checking that all packages exist", r.ID)
-		if err := r.CheckPackages(ctx, f.pkc); err != nil {
-			fixErr("package error: %s", err)
-		}
-	}
+	return fixed
+}
 
-	if !*skipSymbols {
-		log.Infof("%s: checking symbols (use -skip-symbols to skip this)", r.ID)
-		if err := r.checkSymbols(); err != nil {
-			fixErr("symbol error: %s", err)
-		}
+// checkFrozen undoes the changes to the frozen fields of r since
+// the snapshot was taken, unless a reason to force them is given,
+// in which case it records the reason in a force-reviewed note.
+func checkFrozen(r *yamlReport, frozen *report.FrozenSnapshot, reason string) {
+	changes := frozen.Changes(r.Report)
+	if len(changes) == 0 {
+		return
 	}
-
-	if !*skipAlias {
-		log.Infof("%s: checking for missing GHSAs and CVEs (use -skip-alias to skip this)", r.ID)
-		if added := r.addMissingAliases(ctx, f.aliasFinder); added > 0 {
-			log.Infof("%s: added %d missing aliases", r.ID, added)
-		}
+	var descs []string
+	for _, c := range changes {
+		descs = append(descs, c.String())
 	}
-
-	if !*skipRefs {
-		// For now, this is a fix check instead of a lint.
-		log.Infof("%s: checking that all references are reachable", r.ID)
-		checkRefs(r.References, fixErr)
+	if reason == "" {
+		frozen.Restore(r.Report)
+		log.Warnf("%s: reverted changes to the frozen fields of a REVIEWED report (use -force-reviewed=REASON to keep them):\n\t- %s", r.ID, strings.Join(descs, "\n\t- "))
+		return
 	}
-
-	return ok
+	r.AddNote(report.NoteTypeForceReviewed, "%s", report.NewFrozenOverride(changes, reason, time.Now()))
+	log.Warnf("%s: forced changes to the frozen fields of a REVIEWED report:\n\t- %s", r.ID, strings.Join(descs, "\n\t- "))
 }
 
 func checkRefs(refs []*report.Reference, fixErr func(f string, v ...any)) {
@@ -181,6 +224,46 @@ func checkRefs(refs []*report.Reference, fixErr func(f string, v ...any)) {
 	}
 }
 
+// checkMetadata warns about the module m of report id, and its packages,
+// if pkgsite says the module is deprecated or a package is a command.
+// If m is not fixed and has no vulnerable_at version, checkMetadata sets
+// it to the latest version of m.
+func checkMetadata(ctx context.Context, pkc *pkgsite.Client, id string, m *report.Module) error {
+	if m.Module == "" || m.IsFirstParty() {
+		return nil
+	}
+	md, err := pkc.Metadata(ctx, m.Module)
+	if err != nil {
+		return err
+	}
+	if md != nil {
+		if md.Deprecated {
+			log.Warnf("%s: module %s is deprecated: %s", id, m.Module, md.DeprecationComment)
+		}
+		if m.VulnerableAt == nil && md.LatestVersion != "" && !slices.ContainsFunc(m.Versions, (*report.Version).IsFixed) {
+			m.VulnerableAt = report.VulnerableAt(version.TrimPrefix(md.LatestVersion))
+			log.Infof("%s: %s: no fixed version, so set vulnerable_at to the latest version %s", id, m.Module, md.LatestVersion)
+		}
+	}
+	for _, p := range m.Packages {
+		if p.Package == "" || p.Package == m.Module {
+			// The module's metadata covers its root package.
+			if md != nil && md.IsCommand() {
+				log.Warnf("%s: package %s is a command, so govulncheck can only find it in binaries", id, m.Module)
+			}
+			continue
+		}
+		pmd, err := pkc.Metadata(ctx, p.Package)
+		if err != nil {
+			return err
+		}
+		if pmd != nil && pmd.IsCommand() {
+			log.Warnf("%s: package %s is a command, so govulncheck can only find it in binaries", id, p.Package)
+		}
+	}
+	return nil
+}
+
 func (r *yamlReport) checkSymbols() error {
 	if r.IsExcluded() {
 		log.Infof("%s: excluded, skipping symbol checks", r.ID)
@@ -0,0 +1,338 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"golang.org/x/vulndb/cmd/vulnreport/log"
+	"golang.org/x/vulndb/internal/gitrepo"
+	"golang.org/x/vulndb/internal/report"
+)
+
+var (
+	fixerNames     = flag.String("fixers", "", "for fix, comma-separated names of the only fixers to run (default: all)")
+	skipFixerNames = flag.String("skip-fixers", "", "for fix, comma-separated names of fixers not to run")
+	fixerStats     = flag.Bool("fixer-stats", false, "for fix, print the results and running time of each fixer")
+)
+
+// A fixerSpec describes a fixer: a check (and possibly a fix) that runs
+// after the lint fixes. Unlike lints, fixers can be slow or need the
+// network, so they can be turned off (all of them with -skip-checks),
+// though they should pass before a report is submitted.
+//
+// To add a fixer, add it to allFixers.
+type fixerSpec struct {
+	// name identifies the fixer in the -fixers and -skip-fixers flags.
+	name string
+	// msg is logged before the fixer runs on a report.
+	msg string
+	// requires are the names of the fixers whose results this fixer
+	// depends on. The fixer runs after them, and is skipped for a
+	// report if any of them failed on it.
+	requires []string
+	// skip is the fixer's own flag to turn it off, if any.
+	skip *bool
+	// slow is set for fixers that can take minutes on a single report
+	// (building code or making a request per alias or reference),
+	// which create -minimal skips.
+	slow bool
+	// applies reports whether the fixer applies to a report.
+	// If nil, it applies to all reports.
+	applies func(*yamlReport) bool
+	// run runs the fixer on a report, calling fixErr for each
+	// problem it could not fix.
+	run func(ctx context.Context, f *fixer, r *yamlReport, fixErr func(format string, v ...any))
+}
+
+// allFixers are the fixers, in the order to run them when their
+// requirements allow.
+var allFixers = []*fixerSpec{
+	{
+		name:    "fixed",
+		msg:     "adding missing fixed versions from the tags containing fix commits (use -skip-fixed to skip this)",
+		skip:    skipFixed,
+		slow:    true,
+		applies: func(r *yamlReport) bool { return r.NeedsFixedVersions() },
+		run: func(ctx context.Context, f *fixer, r *yamlReport, fixErr func(string, ...any)) {
+			added, err := r.AddMissingFixedVersions(ctx, f.pxc, gitrepo.CloneWithTags)
+			if err != nil {
+				fixErr("could not add fixed versions: %s", err)
+			}
+			if added > 0 {
+				log.Infof("%s: added %d missing fixed versions", r.ID, added)
+			}
+		},
+	},
+	{
+		name: "compress",
+		msg:  "compressing version ranges",
+		// Compression is only possible with more than one range.
+		applies: func(r *yamlReport) bool {
+			return slices.ContainsFunc(r.Modules, func(m *report.Module) bool {
+				return !m.IsFirstParty() && len(m.Versions) > 2
+			})
+		},
+		run: func(_ context.Context, f *fixer, r *yamlReport, fixErr func(string, ...any)) {
+			for _, m := range r.Modules {
+				c, err := m.CompressVersions(f.pxc)
+				if err != nil {
+					fixErr("could not compress versions: %s", err)
+					continue
+				}
+				if c == nil {
+					continue
+				}
+				if c.After < c.Before {
+					log.Infof("%s: %s: compressed %d version ranges to %d", r.ID, m.Module, c.Before, c.After)
+				}
+				for _, i := range c.Empty {
+					log.Warnf("%s: %s: range %s contains no published versions", r.ID, m.Module, i)
+				}
+			}
+		},
+	},
+	{
+		name: "packages",
+		msg:  "checking that all packages exist",
+		skip: skipPackages,
+		run: func(ctx context.Context, f *fixer, r *yamlReport, fixErr func(string, ...any)) {
+			if err := r.CheckPackages(ctx, f.pkc); err != nil {
+				fixErr("package error: %s", err)
+			}
+		},
+	},
+	{
+		name: "metadata",
+		msg:  "checking pkgsite metadata of modules and packages",
+		// Metadata of packages that don't exist is not useful.
+		requires: []string{"packages"},
+		applies:  func(r *yamlReport) bool { return !r.IsExcluded() && !r.IsFirstParty() },
+		run: func(ctx context.Context, f *fixer, r *yamlReport, fixErr func(string, ...any)) {
+			for _, m := range r.Modules {
+				if err := checkMetadata(ctx, f.pkc, r.ID, m); err != nil {
+					fixErr("could not get pkgsite metadata: %s", err)
+				}
+			}
+		},
+	},
+	{
+		name: "symbols",
+		msg:  "checking symbols (use -skip-symbols to skip this)",
+		// Symbols can't be found in packages that don't exist.
+		requires: []string{"packages"},
+		skip:     skipSymbols,
+		slow:     true,
+		run: func(_ context.Context, _ *fixer, r *yamlReport, fixErr func(string, ...any)) {
+			if err := r.checkSymbols(); err != nil {
+				fixErr("symbol error: %s", err)
+			}
+		},
+	},
+	{
+		name: "aliases",
+		msg:  "checking for missing GHSAs and CVEs (use -skip-alias to skip this)",
+		skip: skipAlias,
+		slow: true,
+		run: func(ctx context.Context, f *fixer, r *yamlReport, _ func(string, ...any)) {
+			if added := r.addMissingAliases(ctx, f.aliasFinder); added > 0 {
+				log.Infof("%s: added %d missing aliases", r.ID, added)
+			}
+		},
+	},
+	{
+		name:    "releases",
+		msg:     "checking that fixed versions are Go releases (use -skip-releases to skip this)",
+		skip:    skipReleases,
+		applies: func(r *yamlReport) bool { return r.IsFirstParty() },
+		run: func(_ context.Context, f *fixer, r *yamlReport, fixErr func(string, ...any)) {
+			if s, err := f.goReleases(); err != nil {
+				fixErr("could not get Go releases: %s", err)
+			} else if err := r.CheckReleases(s); err != nil {
+				fixErr("release error: %s", err)
+			}
+		},
+	},
+	{
+		// For now, this is a fixer instead of a lint.
+		name: "refs",
+		msg:  "checking that all references are reachable",
+		skip: skipRefs,
+		slow: true,
+		run: func(_ context.Context, _ *fixer, r *yamlReport, fixErr func(string, ...any)) {
+			checkRefs(r.References, fixErr)
+		},
+	},
+}
+
+// fixerStat records the results of a fixer over all reports.
+type fixerStat struct {
+	ok, failed, skipped int
+	time                time.Duration
+}
+
+// selectFixers returns the fixers in specs that are enabled by the
+// flags, in the order to run them.
+func selectFixers(specs []*fixerSpec) ([]*fixerSpec, error) {
+	sorted, err := sortFixers(specs)
+	if err != nil {
+		return nil, err
+	}
+	only, err := parseFixerNames(*fixerNames, specs)
+	if err != nil {
+		return nil, fmt.Errorf("-fixers: %w", err)
+	}
+	skip, err := parseFixerNames(*skipFixerNames, specs)
+	if err != nil {
+		return nil, fmt.Errorf("-skip-fixers: %w", err)
+	}
+	if *skipChecks {
+		return nil, nil
+	}
+	var enabled []*fixerSpec
+	for _, s := range sorted {
+		if (s.skip != nil && *s.skip) || skip[s.name] || (len(only) > 0 && !only[s.name]) {
+			continue
+		}
+		enabled = append(enabled, s)
+	}
+	return enabled, nil
+}
+
+// fastFixers returns the fixers in specs that are not slow.
+func fastFixers(specs []*fixerSpec) []*fixerSpec {
+	return slices.DeleteFunc(slices.Clone(specs), func(s *fixerSpec) bool { return s.slow })
+}
+
+func parseFixerNames(list string, specs []*fixerSpec) (map[string]bool, error) {
+	names := make(map[string]bool)
+	for _, n := range strings.Split(list, ",") {
+		n = strings.TrimSpace(n)
+		if n == "" {
+			continue
+		}
+		if !slices.ContainsFunc(specs, func(s *fixerSpec) bool { return s.name == n }) {
+			return nil, fmt.Errorf("unknown fixer %q (must be one of: %s)", n, strings.Join(fixerSpecNames(specs), ", "))
+		}
+		names[n] = true
+	}
+	return names, nil
+}
+
+func fixerSpecNames(specs []*fixerSpec) []string {
+	var names []string
+	for _, s := range specs {
+		names = append(names, s.name)
+	}
+	return names
+}
+
+// sortFixers orders specs so that each fixer comes after the fixers it
+// requires, keeping the given order otherwise.
+func sortFixers(specs []*fixerSpec) ([]*fixerSpec, error) {
+	byName := make(map[string]*fixerSpec)
+	for _, s := range specs {
+		if byName[s.name] != nil {
+			return nil, fmt.Errorf("duplicate fixer %q", s.name)
+		}
+		byName[s.name] = s
+	}
+	for _, s := range specs {
+		for _, req := range s.requires {
+			if byName[req] == nil {
+				return nil, fmt.Errorf("fixer %q requires unknown fixer %q", s.name, req)
+			}
+		}
+	}
+
+	var sorted []*fixerSpec
+	done := make(map[string]bool)
+	for len(sorted) < len(specs) {
+		i := slices.IndexFunc(specs, func(s *fixerSpec) bool {
+			return !done[s.name] && !slices.ContainsFunc(s.requires, func(req string) bool { return !done[req] })
+		})
+		if i < 0 {
+			var cycle []string
+			for _, s := range specs {
+				if !done[s.name] {
+					cycle = append(cycle, s.name)
+				}
+			}
+			return nil, fmt.Errorf("fixers have circular requirements: %s", strings.Join(cycle, ", "))
+		}
+		sorted = append(sorted, specs[i])
+		done[specs[i].name] = true
+	}
+	return sorted, nil
+}
+
+// runFixers runs the enabled fixers on r, and reports whether they
+// all succeeded. If addNotes is true, problems are also added to
+// the report as notes.
+func (f *fixer) runFixers(ctx context.Context, r *yamlReport, addNotes bool) (ok bool) {
+	ok = true
+	failed := make(map[string]bool)
+	for _, s := range f.fixers {
+		if s.applies != nil && !s.applies(r) {
+			continue
+		}
+		stat := f.stat(s.name)
+		before := f.tm.fieldSnapshot(r.Report)
+		if i := slices.IndexFunc(s.requires, func(req string) bool { return failed[req] }); i >= 0 {
+			log.Warnf("%s: skipping fixer %s (requires %s, which failed)", r.ID, s.name, s.requires[i])
+			stat.skipped++
+			failed[s.name] = true
+			f.tm.recordFixer(s.name, r.Report, before, "skipped", 0)
+			continue
+		}
+
+		log.Infof("%s: %s", r.ID, s.msg)
+		var errs int
+		fixErr := func(format string, v ...any) {
+			log.Errf(r.ID+": "+format, v...)
+			if addNotes {
+				r.AddNote(report.NoteTypeFix, format, v...)
+			}
+			errs++
+		}
+		start := time.Now()
+		s.run(ctx, f, r, fixErr)
+		d := time.Since(start)
+		stat.time += d
+		result := "ok"
+		if errs > 0 {
+			stat.failed++
+			failed[s.name] = true
+			ok = false
+			result = "failed"
+		} else {
+			stat.ok++
+		}
+		f.tm.recordFixer(s.name, r.Report, before, result, d)
+	}
+	return ok
+}
+
+func (f *fixer) stat(name string) *fixerStat {
+	s, ok := f.stats[name]
+	if !ok {
+		s = new(fixerStat)
+		f.stats[name] = s
+	}
+	return s
+}
+
+// logFixerStats logs the results and running time of each enabled fixer.
+func (f *fixer) logFixerStats() {
+	for _, s := range f.fixers {
+		stat := f.stat(s.name)
+		log.Infof("fixer %s: ok=%d; failed=%d; skipped=%d; time=%s", s.name, stat.ok, stat.failed, stat.skipped, stat.time.Round(time.Millisecond))
+	}
+}
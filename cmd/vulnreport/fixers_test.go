@@ -0,0 +1,211 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/vulndb/cmd/vulnreport/log"
+	"golang.org/x/vulndb/internal/pkgsite"
+	"golang.org/x/vulndb/internal/report"
+)
+
+func TestAllFixers(t *testing.T) {
+	sorted, err := sortFixers(allFixers)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// allFixers is already in a valid order.
+	if diff := cmp.Diff(fixerSpecNames(allFixers), fixerSpecNames(sorted)); diff != "" {
+		t.Errorf("allFixers is not sorted (-want, +got):\n%s", diff)
+	}
+}
+
+func TestSortFixers(t *testing.T) {
+	specs := []*fixerSpec{
+		{name: "a", requires: []string{"c"}},
+		{name: "b"},
+		{name: "c", requires: []string{"b"}},
+		{name: "d"},
+	}
+	got, err := sortFixers(specs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{"b", "c", "a", "d"}, fixerSpecNames(got)); diff != "" {
+		t.Errorf("mismatch (-want, +got):\n%s", diff)
+	}
+
+	for _, specs := range [][]*fixerSpec{
+		{{name: "a"}, {name: "a"}},
+		{{name: "a", requires: []string{"b"}}},
+		{{name: "a", requires: []string{"b"}}, {name: "b", requires: []string{"a"}}},
+	} {
+		if _, err := sortFixers(specs); err == nil {
+			t.Errorf("sortFixers(%v): got nil error, want error", fixerSpecNames(specs))
+		}
+	}
+}
+
+func TestSelectFixers(t *testing.T) {
+	var skipB bool
+	specs := []*fixerSpec{
+		{name: "a"},
+		{name: "b", skip: &skipB},
+		{name: "c", requires: []string{"a"}},
+	}
+	for _, tc := range []struct {
+		name       string
+		only, skip string
+		skipB      bool
+		skipChecks bool
+		want       []string
+		wantErr    bool
+	}{
+		{name: "default", want: []string{"a", "b", "c"}},
+		{name: "only", only: "c, a", want: []string{"a", "c"}},
+		{name: "skip", skip: "a", want: []string{"b", "c"}},
+		{name: "own flag", skipB: true, want: []string{"a", "c"}},
+		{name: "only and own flag", only: "b", skipB: true, want: nil},
+		{name: "skip checks", skipChecks: true, want: nil},
+		{name: "unknown", only: "x", wantErr: true},
+		{name: "unknown skip", skip: "a,x", wantErr: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			*fixerNames, *skipFixerNames, *skipChecks, skipB = tc.only, tc.skip, tc.skipChecks, tc.skipB
+			defer func() {
+				*fixerNames, *skipFixerNames, *skipChecks = "", "", false
+			}()
+			got, err := selectFixers(specs)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("got nil error, want error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want, fixerSpecNames(got)); diff != "" {
+				t.Errorf("mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRunFixers(t *testing.T) {
+	log.Discard()
+
+	var ran []string
+	spec := func(name string, fail bool, requires ...string) *fixerSpec {
+		return &fixerSpec{
+			name:     name,
+			msg:      "running " + name,
+			requires: requires,
+			run: func(_ context.Context, _ *fixer, _ *yamlReport, fixErr func(string, ...any)) {
+				ran = append(ran, name)
+				if fail {
+					fixErr("%s failed", name)
+				}
+			},
+		}
+	}
+	never := spec("never", false)
+	never.applies = func(*yamlReport) bool { return false }
+	f := &fixer{
+		fixers: []*fixerSpec{
+			spec("commits", true),
+			spec("symbols", false, "commits"),
+			spec("after-symbols", false, "symbols"),
+			spec("refs", false),
+			never,
+		},
+		stats: make(map[string]*fixerStat),
+	}
+	r := &yamlReport{Report: &report.Report{ID: "GO-9999-0001"}}
+
+	if ok := f.runFixers(context.Background(), r, true); ok {
+		t.Error("runFixers() = true, want false")
+	}
+	if diff := cmp.Diff([]string{"commits", "refs"}, ran); diff != "" {
+		t.Errorf("ran mismatch (-want, +got):\n%s", diff)
+	}
+	if got := len(r.Notes); got != 1 {
+		t.Errorf("got %d notes, want 1", got)
+	}
+
+	got := make(map[string]fixerStat)
+	for name, s := range f.stats {
+		got[name] = fixerStat{ok: s.ok, failed: s.failed, skipped: s.skipped}
+	}
+	want := map[string]fixerStat{
+		"commits":       {failed: 1},
+		"symbols":       {skipped: 1},
+		"after-symbols": {skipped: 1},
+		"refs":          {ok: 1},
+	}
+	if diff := cmp.Diff(want, got, cmp.AllowUnexported(fixerStat{})); diff != "" {
+		t.Errorf("stats mismatch (-want, +got):\n%s", diff)
+	}
+}
+
+func TestCheckMetadata(t *testing.T) {
+	log.Discard()
+	ctx := context.Background()
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/package/example.com/m":
+			fmt.Fprint(w, `{"path": "example.com/m", "modulePath": "example.com/m", "moduleVersion": "v1.4.0", "deprecated": true}`)
+		case "/v1/package/example.com/m/cmd/tool":
+			fmt.Fprint(w, `{"path": "example.com/m/cmd/tool", "modulePath": "example.com/m", "moduleVersion": "v1.4.0", "name": "main"}`)
+		default:
+			http.Error(w, "not found", http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(s.Close)
+	pkc := pkgsite.New(s.URL)
+
+	newModule := func(vs ...*report.Version) *report.Module {
+		return &report.Module{
+			Module:   "example.com/m",
+			Versions: vs,
+			Packages: []*report.Package{{Package: "example.com/m/cmd/tool"}},
+		}
+	}
+	for _, tc := range []struct {
+		name string
+		m    *report.Module
+		want *report.Version
+	}{
+		{
+			name: "unfixed",
+			m:    newModule(report.Introduced("1.0.0")),
+			want: report.VulnerableAt("1.4.0"),
+		},
+		{
+			name: "fixed",
+			m:    newModule(report.Fixed("1.3.0")),
+		},
+		{
+			name: "unknown",
+			m:    &report.Module{Module: "example.com/unknown"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if err := checkMetadata(ctx, pkc, "GO-9999-0001", tc.m); err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want, tc.m.VulnerableAt); diff != "" {
+				t.Errorf("vulnerable_at mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,123 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"golang.org/x/vulndb/cmd/vulnreport/log"
+	"golang.org/x/vulndb/internal/ghsa"
+)
+
+// ghsaDraft drafts GitHub repository advisories for reviewed reports
+// that have no GHSA, so that the maintainers of the modules' repos
+// can publish our version data to GitHub's database.
+type ghsaDraft struct {
+	gc ghsaClient
+	*filenameParser
+}
+
+func (ghsaDraft) name() string { return "ghsa-draft" }
+
+func (ghsaDraft) usage() (string, string) {
+	const desc = "drafts GitHub security advisories for reviewed reports of modules hosted on GitHub that have no GHSA"
+	return filenameArgs, desc
+}
+
+func (ghsaDraft) capabilities() capability {
+	c := capReadRepo | capNetwork
+	if *pushGHSA {
+		c |= capMutateTracker
+	}
+	return c
+}
+
+func (g *ghsaDraft) setup(ctx context.Context, env environment) error {
+	gc, err := env.GHSAClient(ctx)
+	if err != nil {
+		return err
+	}
+	g.gc = gc
+	g.filenameParser = new(filenameParser)
+	return setupAll(ctx, env, g.filenameParser)
+}
+
+func (*ghsaDraft) close() error { return nil }
+
+func (*ghsaDraft) skip(input any) string {
+	r := input.(*yamlReport)
+	if r.IsExcluded() {
+		return "excluded"
+	}
+	if r.Withdrawn != nil {
+		return "withdrawn"
+	}
+	if !r.IsReviewed() {
+		return "not reviewed"
+	}
+	if len(r.GHSAs) > 0 {
+		return "already has a GHSA"
+	}
+	if len(ghsa.DraftRepositories(r.Report)) == 0 {
+		return "no modules hosted on GitHub"
+	}
+	return ""
+}
+
+// ghsaReporter is implemented by GHSA clients that can
+// report vulnerabilities to repositories.
+type ghsaReporter interface {
+	ReportRepositoryVulnerability(ctx context.Context, repo string, a *ghsa.RepositoryAdvisory) (*ghsa.RepositoryAdvisory, error)
+}
+
+func (g *ghsaDraft) run(ctx context.Context, input any) error {
+	r := input.(*yamlReport)
+
+	// The report may not list a GHSA that GitHub has for one of its CVEs.
+	for _, cve := range r.CVEs {
+		sas, err := g.gc.ListForCVE(ctx, cve)
+		if err != nil {
+			return err
+		}
+		if len(sas) > 0 {
+			log.Warnf("%s: GitHub already has %s for %s; add it to the report instead of drafting an advisory", r.ID, sas[0].ID, cve)
+			return nil
+		}
+	}
+
+	var errs []error
+	for _, repo := range ghsa.DraftRepositories(r.Report) {
+		if err := g.draft(ctx, r, repo); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", repo, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (g *ghsaDraft) draft(ctx context.Context, r *yamlReport, repo string) error {
+	a := ghsa.Draft(r.Report, repo)
+	b, err := json.MarshalIndent(a, "", "  ")
+	if err != nil {
+		return err
+	}
+	log.Outf("%s: draft advisory for %s:\n%s", r.ID, repo, b)
+	if !*pushGHSA {
+		log.Infof("%s: use -push to report the advisory to the maintainers of %s", r.ID, repo)
+		return nil
+	}
+	w, ok := g.gc.(ghsaReporter)
+	if !ok {
+		return errors.New("GHSA client cannot report vulnerabilities")
+	}
+	created, err := w.ReportRepositoryVulnerability(ctx, repo, a)
+	if err != nil {
+		return err
+	}
+	log.Infof("%s: reported to the maintainers of %s as %s (%s); add the GHSA to the report once it is published", r.ID, repo, created.GHSAID, created.HTMLURL)
+	return nil
+}
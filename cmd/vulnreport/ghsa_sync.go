@@ -0,0 +1,140 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"strings"
+
+	"golang.org/x/vulndb/cmd/vulnreport/log"
+	"golang.org/x/vulndb/internal/ghsa"
+)
+
+var pushGHSA = flag.Bool("push", false, "for ghsa-sync, send the proposed corrections to GitHub (only possible for repository advisories that the GitHub token can edit); for ghsa-draft, report the drafts to the repositories' maintainers")
+
+type ghsaSync struct {
+	gc ghsaClient
+	*filenameParser
+}
+
+func (ghsaSync) name() string { return "ghsa-sync" }
+
+func (ghsaSync) usage() (string, string) {
+	const desc = "proposes corrections to the GHSAs of reviewed reports, where they disagree with the report's affected versions or references"
+	return filenameArgs, desc
+}
+
+func (ghsaSync) capabilities() capability {
+	c := capReadRepo | capNetwork
+	if *pushGHSA {
+		c |= capMutateTracker
+	}
+	return c
+}
+
+func (g *ghsaSync) setup(ctx context.Context, env environment) error {
+	gc, err := env.GHSAClient(ctx)
+	if err != nil {
+		return err
+	}
+	g.gc = gc
+	g.filenameParser = new(filenameParser)
+	return setupAll(ctx, env, g.filenameParser)
+}
+
+func (*ghsaSync) close() error { return nil }
+
+func (*ghsaSync) skip(input any) string {
+	r := input.(*yamlReport)
+	if r.IsExcluded() {
+		return "excluded"
+	}
+	if !r.IsReviewed() {
+		return "not reviewed"
+	}
+	if len(r.GHSAs) == 0 {
+		return "no GHSAs"
+	}
+	return ""
+}
+
+func (g *ghsaSync) run(ctx context.Context, input any) error {
+	r := input.(*yamlReport)
+	var errs []error
+	for _, id := range r.GHSAs {
+		if err := g.sync(ctx, r, id); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", id, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// ghsaWriter is implemented by GHSA clients that can
+// update repository advisories.
+type ghsaWriter interface {
+	UpdateRepositoryAdvisory(ctx context.Context, repo, ghsaID string, a *ghsa.RepositoryAdvisory) (*ghsa.RepositoryAdvisory, error)
+}
+
+func (g *ghsaSync) sync(ctx context.Context, r *yamlReport, id string) error {
+	sa, err := g.gc.FetchGHSA(ctx, id)
+	if err != nil {
+		return err
+	}
+	c := ghsa.Corrections(sa, r.Report)
+	if c.IsEmpty() {
+		log.Infof("%s: %s agrees with the report", r.ID, id)
+		return nil
+	}
+	log.Outf("%s: proposed corrections to %s:\n%s", r.ID, id, formatCorrection(c))
+
+	repo := ghsa.RepositoryOf(sa.Permalink)
+	if repo == "" {
+		log.Infof("%s is a global advisory; suggest the corrections at https://github.com/advisories/%s/improve", id, id)
+		return nil
+	}
+	if !*pushGHSA {
+		log.Infof("%s is a repository advisory of %s; use -push to send the corrections", id, repo)
+		return nil
+	}
+	if len(c.Vulnerabilities) == 0 {
+		log.Infof("%s: only references differ, which the repository advisory API cannot set; add them at %s", id, sa.Permalink)
+		return nil
+	}
+	w, ok := g.gc.(ghsaWriter)
+	if !ok {
+		return errors.New("GHSA client cannot update advisories")
+	}
+	if _, err := w.UpdateRepositoryAdvisory(ctx, repo, id, &ghsa.RepositoryAdvisory{
+		Vulnerabilities: c.Vulnerabilities,
+	}); err != nil {
+		return err
+	}
+	log.Infof("%s: updated the affected versions of %s", r.ID, id)
+	return nil
+}
+
+func formatCorrection(c *ghsa.Correction) string {
+	var b strings.Builder
+	if len(c.Vulnerabilities) > 0 {
+		fmt.Fprintln(&b, "  affected versions should be:")
+		for _, v := range c.Vulnerabilities {
+			fmt.Fprintf(&b, "    %s %s", v.Package.Name, v.VulnerableVersionRange)
+			if v.PatchedVersions != "" {
+				fmt.Fprintf(&b, " (patched: %s)", v.PatchedVersions)
+			}
+			fmt.Fprintln(&b)
+		}
+	}
+	if len(c.AddReferences) > 0 {
+		fmt.Fprintln(&b, "  missing references:")
+		for _, u := range c.AddReferences {
+			fmt.Fprintf(&b, "    %s\n", u)
+		}
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
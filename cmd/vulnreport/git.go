@@ -24,6 +24,11 @@ func gitCommit(msg string, files ...string) (err error) {
 	return irun("git", commitArgs...)
 }
 
+func gitCheckout(args ...string) (err error) {
+	derrors.Wrap(&err, "git checkout")
+	return irun("git", append([]string{"checkout"}, args...)...)
+}
+
 func irun(name string, arg ...string) error {
 	// Exec git commands rather than using go-git so as to run commit hooks
 	// and give the user a chance to edit the commit message.
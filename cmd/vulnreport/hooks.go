@@ -0,0 +1,290 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"golang.org/x/exp/maps"
+	"golang.org/x/vulndb/cmd/vulnreport/log"
+	"golang.org/x/vulndb/internal/report"
+	"gopkg.in/yaml.v3"
+)
+
+var hooksFile = flag.String("hooks", "", "file in the local repo that configures the hooks run by create, fix and commit (by default, no hooks are run)")
+
+// Hooks let a fork of the report repo add its own policy to vulnreport
+// without patching it. A hook is an external command, configured in
+// the -hooks file, that is run at one of the stages below with the
+// report as JSON on stdin. It may print a hookResult to stdout to add
+// lints, or to edit the report.
+//
+// Hooks are arbitrary commands from the repo, so they are only run if
+// -hooks is set, without any of vulnreport's secrets in their
+// environment, and never when writing files or using the network is
+// disabled.
+//
+// See doc/vulnreport.md for the details.
+type hookStage string
+
+const (
+	// stagePostCreate runs when create has made a new report,
+	// before it is fixed and written.
+	stagePostCreate hookStage = "post-create"
+	// stagePostFix runs after the fixers, before the report is
+	// normalized and linted.
+	stagePostFix hookStage = "post-fix"
+	// stagePreCommit runs after commit has fixed the report,
+	// before it is committed.
+	stagePreCommit hookStage = "pre-commit"
+)
+
+var hookStages = []hookStage{stagePostCreate, stagePostFix, stagePreCommit}
+
+// A hook is an entry in the -hooks file.
+type hook struct {
+	Name  string    `yaml:"name"`
+	Stage hookStage `yaml:"stage"`
+	// Command is the command and its arguments. A relative command
+	// path that has a slash is relative to the root of the repo.
+	Command []string `yaml:"command"`
+}
+
+type hooksConfig struct {
+	Hooks []*hook `yaml:"hooks"`
+}
+
+// hookResult is what a hook may print to stdout. Printing nothing
+// is the same as printing an empty result.
+type hookResult struct {
+	// Lints are problems with the report that the hook found.
+	Lints []string `json:"lints,omitempty"`
+	// Edits is a JSON merge patch (RFC 7386) of the report, in the
+	// format of the JSON on stdin: for example, {"related": ["CVE-1"]}
+	// sets the related IDs, and {"notes": null} removes the notes.
+	Edits map[string]any `json:"edits,omitempty"`
+}
+
+// hookCapabilities are the capabilities of hooks: since they are
+// arbitrary commands, they may write files and use the network.
+const hookCapabilities = capWriteFiles | capNetwork
+
+// hookEnvVars are the environment variables passed on to hooks.
+// Everything else, notably the tokens in $VULN_GITHUB_ACCESS_TOKEN
+// and the like, is left out.
+var hookEnvVars = []string{"PATH", "HOME", "TMPDIR", "LANG", "LC_ALL"}
+
+// hookEnv returns the environment of a hook run at stage.
+func hookEnv(stage hookStage) []string {
+	var env []string
+	for _, k := range hookEnvVars {
+		if v, ok := os.LookupEnv(k); ok {
+			env = append(env, k+"="+v)
+		}
+	}
+	return append(env, "VULNREPORT_HOOK_STAGE="+string(stage))
+}
+
+// readHooks reads the -hooks file from fsys, returning
+// no hooks if it is not set or doesn't exist.
+func readHooks(fsys fs.FS) ([]*hook, error) {
+	if *hooksFile == "" {
+		return nil, nil
+	}
+	b, err := fs.ReadFile(fsys, *hooksFile)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var c hooksConfig
+	d := yaml.NewDecoder(bytes.NewReader(b))
+	d.KnownFields(true)
+	if err := d.Decode(&c); err != nil {
+		return nil, fmt.Errorf("%s: %w", *hooksFile, err)
+	}
+	for i, h := range c.Hooks {
+		switch {
+		case h.Name == "":
+			return nil, fmt.Errorf("%s: hook %d has no name", *hooksFile, i+1)
+		case !slices.Contains(hookStages, h.Stage):
+			return nil, fmt.Errorf("%s: hook %s: invalid stage %q (want one of %v)", *hooksFile, h.Name, h.Stage, hookStages)
+		case len(h.Command) == 0:
+			return nil, fmt.Errorf("%s: hook %s has no command", *hooksFile, h.Name)
+		}
+	}
+	return c.Hooks, nil
+}
+
+// A hookExecutor runs the command of a hook with the given input,
+// returning its output.
+type hookExecutor interface {
+	Exec(ctx context.Context, h *hook, stdin []byte) ([]byte, error)
+}
+
+// cmdHookExecutor runs hooks as commands in the repo at dir.
+type cmdHookExecutor struct {
+	dir string
+}
+
+func (e cmdHookExecutor) Exec(ctx context.Context, h *hook, stdin []byte) ([]byte, error) {
+	name := h.Command[0]
+	if !filepath.IsAbs(name) && strings.ContainsRune(name, '/') {
+		name = filepath.Join(e.dir, name)
+	}
+	cmd := exec.CommandContext(ctx, name, h.Command[1:]...)
+	cmd.Dir = e.dir
+	cmd.Env = hookEnv(h.Stage)
+	cmd.Stdin = bytes.NewReader(stdin)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		if s := strings.TrimSpace(stderr.String()); s != "" {
+			return nil, fmt.Errorf("%w: %s", err, s)
+		}
+		return nil, err
+	}
+	return out, nil
+}
+
+// hookRunner runs the hooks configured in the -hooks file.
+type hookRunner struct {
+	hooks []*hook
+	ex    hookExecutor
+}
+
+func (h *hookRunner) setup(_ context.Context, env environment) error {
+	hooks, err := readHooks(env.ReportFS())
+	if err != nil {
+		return err
+	}
+	if d := hookCapabilities & env.denied; len(hooks) > 0 && d != 0 {
+		return fmt.Errorf("hooks in %s require capabilities [%s], which are disabled by flag(s)", *hooksFile, d)
+	}
+	h.hooks = hooks
+	h.ex = env.HookExecutor()
+	return nil
+}
+
+// runHooks runs the hooks for the stage on r, in the order they are
+// configured, applying their edits to r. It returns the lints they
+// found, each prefixed with the name of its hook, and whether any
+// hook edited r.
+func (h *hookRunner) runHooks(ctx context.Context, stage hookStage, r *yamlReport) (lints []string, edited bool, _ error) {
+	for _, hk := range h.hooks {
+		if hk.Stage != stage {
+			continue
+		}
+		log.Infof("%s: running %s hook %s", r.ID, stage, hk.Name)
+		in, err := reportToJSON(r.Report)
+		if err != nil {
+			return nil, false, err
+		}
+		out, err := h.ex.Exec(ctx, hk, in)
+		if err != nil {
+			return nil, false, fmt.Errorf("hook %s: %w", hk.Name, err)
+		}
+		var res hookResult
+		if len(bytes.TrimSpace(out)) != 0 {
+			d := json.NewDecoder(bytes.NewReader(out))
+			d.DisallowUnknownFields()
+			if err := d.Decode(&res); err != nil {
+				return nil, false, fmt.Errorf("hook %s: invalid output: %w", hk.Name, err)
+			}
+		}
+		for _, l := range res.Lints {
+			lints = append(lints, fmt.Sprintf("%s: %s", hk.Name, l))
+		}
+		if len(res.Edits) != 0 {
+			if err := applyEdits(r.Report, res.Edits); err != nil {
+				return nil, false, fmt.Errorf("hook %s: %w", hk.Name, err)
+			}
+			fields := maps.Keys(res.Edits)
+			slices.Sort(fields)
+			log.Infof("%s: hook %s edited %s", r.ID, hk.Name, strings.Join(fields, ", "))
+			edited = true
+		}
+	}
+	return lints, edited, nil
+}
+
+// reportToJSON returns r as JSON, with the same field
+// names as its YAML.
+func reportToJSON(r *report.Report) ([]byte, error) {
+	m, err := reportToMap(r)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(m)
+}
+
+func reportToMap(r *report.Report) (map[string]any, error) {
+	b, err := yaml.Marshal(r)
+	if err != nil {
+		return nil, err
+	}
+	var m map[string]any
+	if err := yaml.Unmarshal(b, &m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// applyEdits applies the JSON merge patch edits to r.
+func applyEdits(r *report.Report, edits map[string]any) error {
+	m, err := reportToMap(r)
+	if err != nil {
+		return err
+	}
+	mergePatch(m, edits)
+	b, err := yaml.Marshal(m)
+	if err != nil {
+		return err
+	}
+	var edited report.Report
+	d := yaml.NewDecoder(bytes.NewReader(b))
+	d.KnownFields(true)
+	if err := d.Decode(&edited); err != nil {
+		return fmt.Errorf("invalid edits: %w", err)
+	}
+	if edited.ID != r.ID {
+		return fmt.Errorf("invalid edits: cannot change the ID of %s", r.ID)
+	}
+	edited.Comments = r.Comments
+	*r = edited
+	return nil
+}
+
+// mergePatch applies the JSON merge patch (RFC 7386) patch to target.
+func mergePatch(target, patch map[string]any) {
+	for k, v := range patch {
+		switch v := v.(type) {
+		case nil:
+			delete(target, k)
+		case map[string]any:
+			t, ok := target[k].(map[string]any)
+			if !ok {
+				t = make(map[string]any)
+			}
+			mergePatch(t, v)
+			target[k] = t
+		default:
+			target[k] = v
+		}
+	}
+}
@@ -0,0 +1,260 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/vulndb/internal/report"
+)
+
+// fakeHookExecutor returns the output for each hook by name,
+// recording the input it was given.
+type fakeHookExecutor struct {
+	outputs map[string]string
+	inputs  map[string][]byte
+}
+
+func (e *fakeHookExecutor) Exec(_ context.Context, h *hook, stdin []byte) ([]byte, error) {
+	if e.inputs == nil {
+		e.inputs = make(map[string][]byte)
+	}
+	e.inputs[h.Name] = stdin
+	return []byte(e.outputs[h.Name]), nil
+}
+
+func TestReadHooks(t *testing.T) {
+	*hooksFile = "vulnreport-hooks.yaml"
+	defer func() { *hooksFile = "" }()
+
+	for _, tc := range []struct {
+		name    string
+		config  string
+		want    []*hook
+		wantErr string
+	}{
+		{
+			name: "ok",
+			config: `hooks:
+  - name: license
+    stage: post-fix
+    command: [tools/license, -strict]
+  - name: owners
+    stage: pre-commit
+    command: [check-owners]
+`,
+			want: []*hook{
+				{Name: "license", Stage: stagePostFix, Command: []string{"tools/license", "-strict"}},
+				{Name: "owners", Stage: stagePreCommit, Command: []string{"check-owners"}},
+			},
+		},
+		{
+			name: "bad_stage",
+			config: `hooks:
+  - name: license
+    stage: pre-fix
+    command: [tools/license]
+`,
+			wantErr: `invalid stage "pre-fix"`,
+		},
+		{
+			name: "no_command",
+			config: `hooks:
+  - name: license
+    stage: post-fix
+`,
+			wantErr: "hook license has no command",
+		},
+		{
+			name: "unknown_field",
+			config: `hooks:
+  - name: license
+    stage: post-fix
+    command: [tools/license]
+    timeout: 10s
+`,
+			wantErr: "field timeout not found",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			fsys := fstest.MapFS{*hooksFile: {Data: []byte(tc.config)}}
+			got, err := readHooks(fsys)
+			if tc.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Fatalf("readHooks() error = %v, want error containing %q", err, tc.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("readHooks() mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+
+	t.Run("missing", func(t *testing.T) {
+		got, err := readHooks(fstest.MapFS{})
+		if err != nil || got != nil {
+			t.Errorf("readHooks() = %v, %v, want no hooks", got, err)
+		}
+	})
+
+	t.Run("unset", func(t *testing.T) {
+		fsys := fstest.MapFS{*hooksFile: {Data: []byte(validHooks)}}
+		*hooksFile = ""
+		got, err := readHooks(fsys)
+		if err != nil || got != nil {
+			t.Errorf("readHooks() = %v, %v, want no hooks", got, err)
+		}
+	})
+}
+
+const validHooks = `hooks:
+  - name: license
+    stage: post-fix
+    command: [tools/license]
+`
+
+func TestHookRunnerSetup(t *testing.T) {
+	*hooksFile = "vulnreport-hooks.yaml"
+	defer func() { *hooksFile = "" }()
+
+	fsys := fstest.MapFS{*hooksFile: {Data: []byte(validHooks)}}
+	for _, tc := range []struct {
+		name    string
+		denied  capability
+		wantErr bool
+	}{
+		{name: "allowed"},
+		{name: "read_only", denied: capWriteFiles | capMutateTracker, wantErr: true},
+		{name: "no_network", denied: capNetwork, wantErr: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			env := environment{reportFS: fsys, hooks: &fakeHookExecutor{}, denied: tc.denied}
+			err := new(hookRunner).setup(context.Background(), env)
+			if gotErr := err != nil; gotErr != tc.wantErr {
+				t.Errorf("setup() error = %v, want error: %t", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestHookEnv(t *testing.T) {
+	t.Setenv("PATH", "/bin")
+	t.Setenv("VULN_GITHUB_ACCESS_TOKEN", "secret")
+	env := hookEnv(stagePostFix)
+	if slices.Contains(env, "VULN_GITHUB_ACCESS_TOKEN=secret") {
+		t.Errorf("hookEnv() = %v, want no token", env)
+	}
+	for _, want := range []string{"PATH=/bin", "VULNREPORT_HOOK_STAGE=post-fix"} {
+		if !slices.Contains(env, want) {
+			t.Errorf("hookEnv() = %v, want it to contain %q", env, want)
+		}
+	}
+}
+
+func TestRunHooks(t *testing.T) {
+	ex := &fakeHookExecutor{outputs: map[string]string{
+		"related": `{"edits": {"related": ["CVE-2024-0001"], "notes": null}}`,
+		"summary": `{"lints": ["summary must mention the product"]}`,
+		"quiet":   ``,
+	}}
+	h := &hookRunner{
+		hooks: []*hook{
+			{Name: "related", Stage: stagePostFix},
+			{Name: "summary", Stage: stagePostFix},
+			{Name: "quiet", Stage: stagePostFix},
+			{Name: "ignored", Stage: stagePreCommit},
+		},
+		ex: ex,
+	}
+	r := &yamlReport{Report: &report.Report{
+		ID:      "GO-9999-0001",
+		Modules: []*report.Module{{Module: "golang.org/x/vulndb"}},
+		Summary: "A problem",
+		Notes:   []*report.Note{{Body: "a note"}},
+	}}
+
+	lints, edited, err := h.runHooks(context.Background(), stagePostFix, r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"summary: summary must mention the product"}; !cmp.Equal(lints, want) {
+		t.Errorf("lints = %v, want %v", lints, want)
+	}
+	if !edited {
+		t.Error("edited = false, want true")
+	}
+	want := &report.Report{
+		ID:      "GO-9999-0001",
+		Modules: []*report.Module{{Module: "golang.org/x/vulndb"}},
+		Summary: "A problem",
+		Related: []string{"CVE-2024-0001"},
+	}
+	if diff := cmp.Diff(want, r.Report); diff != "" {
+		t.Errorf("report mismatch (-want, +got):\n%s", diff)
+	}
+
+	// The later hooks see the edits of the earlier ones.
+	var in map[string]any
+	if err := json.Unmarshal(ex.inputs["summary"], &in); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := in["related"], []any{"CVE-2024-0001"}; !cmp.Equal(got, want) {
+		t.Errorf("input related = %v, want %v", got, want)
+	}
+	if _, ok := ex.inputs["ignored"]; ok {
+		t.Error("ran a hook for a different stage")
+	}
+}
+
+func TestRunHooksInvalidEdits(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		out     string
+		wantErr string
+	}{
+		{
+			name:    "bad_json",
+			out:     `lints: [x]`,
+			wantErr: "invalid output",
+		},
+		{
+			name:    "unknown_field",
+			out:     `{"warnings": ["x"]}`,
+			wantErr: "invalid output",
+		},
+		{
+			name:    "change_id",
+			out:     `{"edits": {"id": "GO-9999-0002"}}`,
+			wantErr: "cannot change the ID",
+		},
+		{
+			name:    "unknown_report_field",
+			out:     `{"edits": {"severity": "high"}}`,
+			wantErr: "field severity not found",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			h := &hookRunner{
+				hooks: []*hook{{Name: "h", Stage: stagePreCommit}},
+				ex:    &fakeHookExecutor{outputs: map[string]string{"h": tc.out}},
+			}
+			r := &yamlReport{Report: &report.Report{ID: "GO-9999-0001"}}
+			_, _, err := h.runHooks(context.Background(), stagePreCommit, r)
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Errorf("runHooks() error = %v, want error containing %q", err, tc.wantErr)
+			}
+		})
+	}
+}
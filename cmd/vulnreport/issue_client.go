@@ -20,8 +20,18 @@ import (
 type issueClient interface {
 	Issues(context.Context, issues.IssuesOptions) ([]*issues.Issue, error)
 	Issue(context.Context, int) (*issues.Issue, error)
+	// IssuesByNumber returns the issues with the given numbers, omitting
+	// any that don't exist. It may be faster than calling Issue for each.
+	IssuesByNumber(context.Context, []int) (map[int]*issues.Issue, error)
 	SetLabels(context.Context, int, []string) error
+	SetAssignee(context.Context, int, string) error
 	AddComments(context.Context, int, []string) error
+	// Comments returns the comments on the issue, oldest first.
+	Comments(context.Context, int) ([]*issues.Comment, error)
+	// EditComment replaces the body of a comment on the issue.
+	EditComment(_ context.Context, n int, id int64, body string) error
+	// CreateIssue files a new issue and returns its number.
+	CreateIssue(context.Context, *issues.Issue) (int, error)
 	Reference(int) string
 }
 
@@ -29,6 +39,9 @@ var _ issueClient = &memIC{}
 
 type memIC struct {
 	is map[int]issues.Issue
+	// comments by issue number
+	comments map[int][]*issues.Comment
+	nextID   int64
 }
 
 func newMemIC(archive []byte) (*memIC, error) {
@@ -53,6 +66,20 @@ func (m *memIC) Issue(_ context.Context, n int) (*issues.Issue, error) {
 	return nil, fmt.Errorf("issue %d not found", n)
 }
 
+func (m *memIC) IssuesByNumber(ctx context.Context, ns []int) (map[int]*issues.Issue, error) {
+	result := make(map[int]*issues.Issue)
+	for _, n := range ns {
+		if i, ok := m.is[n]; ok {
+			i.Comments, _ = m.Comments(ctx, n)
+			if i.Comments == nil {
+				i.Comments = []*issues.Comment{}
+			}
+			result[n] = &i
+		}
+	}
+	return result, nil
+}
+
 func (m *memIC) Issues(_ context.Context, opts issues.IssuesOptions) (result []*issues.Issue, err error) {
 	if len(opts.Labels) != 0 {
 		return nil, fmt.Errorf("label option not supported for in-memory issues client")
@@ -64,6 +91,9 @@ func (m *memIC) Issues(_ context.Context, opts issues.IssuesOptions) (result []*
 		if opts.State != "" && opts.State != i.State {
 			continue
 		}
+		if !opts.Since.IsZero() && i.UpdatedAt.Before(opts.Since) {
+			continue
+		}
 		result = append(result, &i)
 	}
 	return result, nil
@@ -80,10 +110,24 @@ func (m *memIC) SetLabels(_ context.Context, n int, labels []string) error {
 	return fmt.Errorf("issue %d not found", n)
 }
 
+func (m *memIC) SetAssignee(_ context.Context, n int, assignee string) error {
+	if iss, ok := m.is[n]; ok {
+		iss.Assignee = assignee
+		m.is[n] = iss
+		return nil
+	}
+
+	return fmt.Errorf("issue %d not found", n)
+}
+
 func (m *memIC) AddComments(_ context.Context, n int, comments []string) error {
 	if iss, ok := m.is[n]; ok {
+		if m.comments == nil {
+			m.comments = make(map[int][]*issues.Comment)
+		}
 		for _, comment := range comments {
-			// TODO(tatianabradley): Store this instead of just printing it out.
+			m.nextID++
+			m.comments[n] = append(m.comments[n], &issues.Comment{ID: m.nextID, Body: comment})
 			log.Outf("posted comment to issue %d: %s", iss.Number, comment)
 		}
 		return nil
@@ -92,6 +136,43 @@ func (m *memIC) AddComments(_ context.Context, n int, comments []string) error {
 	return fmt.Errorf("issue %d not found", n)
 }
 
+func (m *memIC) Comments(_ context.Context, n int) ([]*issues.Comment, error) {
+	if _, ok := m.is[n]; ok {
+		var cs []*issues.Comment
+		for _, c := range m.comments[n] {
+			c := *c
+			cs = append(cs, &c)
+		}
+		return cs, nil
+	}
+
+	return nil, fmt.Errorf("issue %d not found", n)
+}
+
+func (m *memIC) EditComment(_ context.Context, n int, id int64, body string) error {
+	for _, c := range m.comments[n] {
+		if c.ID == id {
+			c.Body = body
+			log.Outf("edited comment %d on issue %d: %s", id, n, body)
+			return nil
+		}
+	}
+
+	return fmt.Errorf("comment %d on issue %d not found", id, n)
+}
+
+func (m *memIC) CreateIssue(_ context.Context, iss *issues.Issue) (int, error) {
+	n := 1
+	for num := range m.is {
+		n = max(n, num+1)
+	}
+	i := *iss
+	i.Number, i.State = n, issueStateOpen
+	m.is[n] = i
+	log.Outf("created issue %d: %s", n, iss.Title)
+	return n, nil
+}
+
 func (*memIC) Reference(n int) string {
 	return fmt.Sprintf("test-issue-tracker/%d", n)
 }
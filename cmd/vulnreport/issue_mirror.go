@@ -0,0 +1,177 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"golang.org/x/vulndb/internal/issues"
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+// mirrorIC is an issueClient that reads issues from the worker's
+// mirror of the issue tracker, instead of from the tracker itself.
+//
+// Modifications are sent to the live tracker, if there is one,
+// and are also applied to the mirror so that later reads see them.
+type mirrorIC struct {
+	st store.Store
+	// live is the client for the tracker itself, or nil
+	// to operate offline.
+	live issueClient
+	// ref formats issue references like the live client would.
+	ref *issues.Client
+}
+
+var _ issueClient = &mirrorIC{}
+
+var errNoLiveTracker = errors.New("issue mirror is read-only without a GitHub token (-ghtoken)")
+
+func newMirrorIC(st store.Store, live issueClient, owner, repo string) *mirrorIC {
+	return &mirrorIC{st: st, live: live, ref: &issues.Client{Owner: owner, Repo: repo}}
+}
+
+// openIssueMirror opens the worker store given as "PROJECT/NAMESPACE".
+func openIssueMirror(ctx context.Context, spec string) (store.Store, error) {
+	project, namespace, ok := strings.Cut(spec, "/")
+	if !ok || project == "" || namespace == "" {
+		return nil, fmt.Errorf("invalid -issue-mirror %q: want PROJECT/NAMESPACE", spec)
+	}
+	return store.NewFireStore(ctx, project, namespace, "")
+}
+
+func (m *mirrorIC) Issue(ctx context.Context, n int) (*issues.Issue, error) {
+	ir, err := m.st.GetIssueRecord(ctx, n)
+	if err != nil {
+		return nil, err
+	}
+	if ir == nil {
+		return nil, fmt.Errorf("issue %d not found in mirror (it may need a worker sync-issues)", n)
+	}
+	return ir.Issue(), nil
+}
+
+func (m *mirrorIC) IssuesByNumber(ctx context.Context, ns []int) (map[int]*issues.Issue, error) {
+	result := make(map[int]*issues.Issue)
+	for _, n := range ns {
+		ir, err := m.st.GetIssueRecord(ctx, n)
+		if err != nil {
+			return nil, err
+		}
+		if ir != nil {
+			result[n] = ir.Issue()
+		}
+	}
+	return result, nil
+}
+
+// Issues returns the mirrored issues that match opts, using the
+// same semantics as the GitHub API.
+func (m *mirrorIC) Issues(ctx context.Context, opts issues.IssuesOptions) ([]*issues.Issue, error) {
+	var state string
+	switch opts.State {
+	case "":
+		state = "open"
+	case "all":
+	default:
+		state = opts.State
+	}
+	irs, err := m.st.ListIssueRecords(ctx, state)
+	if err != nil {
+		return nil, err
+	}
+	var result []*issues.Issue
+	for _, ir := range irs {
+		if !opts.Since.IsZero() && ir.UpdatedAt.Before(opts.Since) {
+			continue
+		}
+		if !hasAllLabels(ir.Labels, opts.Labels) {
+			continue
+		}
+		result = append(result, ir.Issue())
+	}
+	return result, nil
+}
+
+func hasAllLabels(have, want []string) bool {
+	for _, l := range want {
+		if !slices.Contains(have, l) {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *mirrorIC) SetLabels(ctx context.Context, n int, labels []string) error {
+	if m.live == nil {
+		return fmt.Errorf("set labels on issue %d: %w", n, errNoLiveTracker)
+	}
+	if err := m.live.SetLabels(ctx, n, labels); err != nil {
+		return err
+	}
+	ir, err := m.st.GetIssueRecord(ctx, n)
+	if err != nil || ir == nil {
+		// The next sync will pick up the change.
+		return err
+	}
+	ir.Labels = labels
+	return m.st.SetIssueRecords(ctx, []*store.IssueRecord{ir})
+}
+
+func (m *mirrorIC) SetAssignee(ctx context.Context, n int, assignee string) error {
+	if m.live == nil {
+		return fmt.Errorf("set assignee of issue %d: %w", n, errNoLiveTracker)
+	}
+	if err := m.live.SetAssignee(ctx, n, assignee); err != nil {
+		return err
+	}
+	ir, err := m.st.GetIssueRecord(ctx, n)
+	if err != nil || ir == nil {
+		// The next sync will pick up the change.
+		return err
+	}
+	ir.Assignee = assignee
+	return m.st.SetIssueRecords(ctx, []*store.IssueRecord{ir})
+}
+
+func (m *mirrorIC) AddComments(ctx context.Context, n int, comments []string) error {
+	if m.live == nil {
+		return fmt.Errorf("add comments to issue %d: %w", n, errNoLiveTracker)
+	}
+	return m.live.AddComments(ctx, n, comments)
+}
+
+// Comments returns the comments on the issue from the live tracker.
+// Comments are not mirrored, so offline there are none.
+func (m *mirrorIC) Comments(ctx context.Context, n int) ([]*issues.Comment, error) {
+	if m.live == nil {
+		return nil, nil
+	}
+	return m.live.Comments(ctx, n)
+}
+
+func (m *mirrorIC) EditComment(ctx context.Context, n int, id int64, body string) error {
+	if m.live == nil {
+		return fmt.Errorf("edit comment on issue %d: %w", n, errNoLiveTracker)
+	}
+	return m.live.EditComment(ctx, n, id, body)
+}
+
+// CreateIssue creates the issue on the live tracker; the next sync
+// adds it to the mirror.
+func (m *mirrorIC) CreateIssue(ctx context.Context, iss *issues.Issue) (int, error) {
+	if m.live == nil {
+		return 0, fmt.Errorf("create issue %q: %w", iss.Title, errNoLiveTracker)
+	}
+	return m.live.CreateIssue(ctx, iss)
+}
+
+func (m *mirrorIC) Reference(n int) string {
+	return m.ref.Reference(n)
+}
@@ -0,0 +1,80 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/vulndb/internal/issues"
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+func TestMirrorIC(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	st := store.NewMemStore()
+	if err := st.SetIssueRecords(ctx, []*store.IssueRecord{
+		{Number: 1, State: issueStateOpen, Labels: []string{"a", "b"}, UpdatedAt: now.Add(-48 * time.Hour)},
+		{Number: 2, State: issueStateOpen, Labels: []string{"a"}, UpdatedAt: now},
+		{Number: 3, State: "closed", Labels: []string{"a", "b"}, UpdatedAt: now},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	offline := newMirrorIC(st, nil, "golang", "vulndb")
+	for _, tc := range []struct {
+		opts issues.IssuesOptions
+		want []int
+	}{
+		{opts: issues.IssuesOptions{}, want: []int{1, 2}},
+		{opts: issues.IssuesOptions{State: "all"}, want: []int{1, 2, 3}},
+		{opts: issues.IssuesOptions{State: "closed"}, want: []int{3}},
+		{opts: issues.IssuesOptions{State: "all", Labels: []string{"b"}}, want: []int{1, 3}},
+		{opts: issues.IssuesOptions{Since: now.Add(-time.Hour)}, want: []int{2}},
+	} {
+		iss, err := offline.Issues(ctx, tc.opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got []int
+		for _, i := range iss {
+			got = append(got, i.Number)
+		}
+		if diff := cmp.Diff(tc.want, got); diff != "" {
+			t.Errorf("Issues(%+v) mismatch (-want, +got):\n%s", tc.opts, diff)
+		}
+	}
+
+	if _, err := offline.Issue(ctx, 4); err == nil {
+		t.Error("Issue(4): got nil error, want not found")
+	}
+	if err := offline.SetLabels(ctx, 1, nil); !errors.Is(err, errNoLiveTracker) {
+		t.Errorf("offline SetLabels: got error %v, want %v", err, errNoLiveTracker)
+	}
+	if got, want := offline.Reference(1), "https://github.com/golang/vulndb/issues/1"; got != want {
+		t.Errorf("Reference(1) = %q, want %q", got, want)
+	}
+
+	// With a live tracker, modifications go to the tracker and the mirror.
+	live := &memIC{is: map[int]issues.Issue{1: {Number: 1}}}
+	online := newMirrorIC(st, live, "golang", "vulndb")
+	if err := online.SetLabels(ctx, 1, []string{"c"}); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{"c"}, live.is[1].Labels); diff != "" {
+		t.Errorf("live labels mismatch (-want, +got):\n%s", diff)
+	}
+	iss, err := online.Issue(ctx, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{"c"}, iss.Labels); diff != "" {
+		t.Errorf("mirrored labels mismatch (-want, +got):\n%s", diff)
+	}
+}
@@ -9,7 +9,9 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
+	"golang.org/x/vulndb/cmd/vulnreport/log"
 	"golang.org/x/vulndb/internal/issues"
 )
 
@@ -40,11 +42,27 @@ func (ip *issueParser) openIssues(ctx context.Context) ([]*issues.Issue, error)
 
 func (ip *issueParser) parseArgs(ctx context.Context, args []string) (issNums []string, _ error) {
 	if len(args) > 0 {
-		return argsToIDs(args)
+		issNums, err := argsToIDs(args)
+		if err != nil {
+			return nil, err
+		}
+		return issNums, ip.prefetch(ctx, issNums)
 	}
 
-	// If no arguments are provided, operate on all open issues.
-	open, err := ip.openIssues(ctx)
+	// If no arguments are provided, operate on all open issues
+	// (or, with -since, all recently updated open issues).
+	var (
+		open []*issues.Issue
+		err  error
+	)
+	if *since > 0 {
+		open, err = ip.ic.Issues(ctx, issues.IssuesOptions{
+			State: issueStateOpen,
+			Since: time.Now().Add(-*since),
+		})
+	} else {
+		open, err = ip.openIssues(ctx)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -58,6 +76,53 @@ func (ip *issueParser) parseArgs(ctx context.Context, args []string) (issNums []
 	return issNums, nil
 }
 
+// prefetch fetches the given issues in as few requests as possible,
+// so that lookup doesn't need to make a request per issue.
+// Issues that can't be fetched are left for lookup to report.
+func (ip *issueParser) prefetch(ctx context.Context, issNums []string) error {
+	if len(issNums) < 2 {
+		return nil
+	}
+	var ns []int
+	for _, s := range issNums {
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			return err
+		}
+		ns = append(ns, n)
+	}
+	iss, err := ip.ic.IssuesByNumber(ctx, ns)
+	if err != nil {
+		return err
+	}
+	for n, i := range iss {
+		ip.toProcess[strconv.Itoa(n)] = i
+	}
+	return nil
+}
+
+// triageNote returns the latest triage note posted on iss by
+// vulnreport triage, or nil if there is none.
+func (ip *issueParser) triageNote(ctx context.Context, iss *issues.Issue) *issues.TriageNote {
+	comments, err := ip.comments(ctx, iss)
+	if err != nil {
+		log.Warnf("issue #%d: could not read comments to find triage note: %v", iss.Number, err)
+		return nil
+	}
+	n, _ := issues.LatestTriageNote(comments)
+	return n
+}
+
+// comments returns the comments on iss, using those fetched along
+// with it (see prefetch) if there are any, so that reading the
+// comments of many issues doesn't take a request per issue.
+func (ip *issueParser) comments(ctx context.Context, iss *issues.Issue) ([]*issues.Comment, error) {
+	if iss.Comments != nil {
+		return iss.Comments, nil
+	}
+	return ip.ic.Comments(ctx, iss.Number)
+}
+
 func (ip *issueParser) setup(ctx context.Context, env environment) error {
 	ic, err := env.IssueClient(ctx)
 	if err != nil {
@@ -0,0 +1,106 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"golang.org/x/vulndb/internal/issues"
+)
+
+func TestParseArgsSince(t *testing.T) {
+	now := time.Now()
+	ic := &memIC{is: map[int]issues.Issue{
+		1: {Number: 1, State: issueStateOpen, UpdatedAt: now.Add(-48 * time.Hour)},
+		2: {Number: 2, State: issueStateOpen, UpdatedAt: now.Add(-1 * time.Hour)},
+		3: {Number: 3, State: "closed", UpdatedAt: now.Add(-1 * time.Hour)},
+		4: {Number: 4, State: issueStateOpen, UpdatedAt: now.Add(-23 * time.Hour)},
+	}}
+
+	defer func(d time.Duration) { *since = d }(*since)
+	for _, tc := range []struct {
+		since time.Duration
+		want  []string
+	}{
+		{want: []string{"1", "2", "4"}},
+		{since: 24 * time.Hour, want: []string{"2", "4"}},
+		{since: 30 * time.Minute, want: nil},
+	} {
+		*since = tc.since
+		cic := &countingIC{issueClient: ic}
+		ip := &issueParser{ic: cic, toProcess: make(map[string]*issues.Issue)}
+		got, err := ip.parseArgs(context.Background(), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(tc.want, got); diff != "" {
+			t.Errorf("since=%s: parseArgs mismatch (-want, +got):\n%s", tc.since, diff)
+		}
+		if cic.calls != 1 {
+			t.Errorf("since=%s: parseArgs fetched issues %d times, want 1", tc.since, cic.calls)
+		}
+
+		errFetch := errors.New("fetch failed")
+		ip = &issueParser{ic: &countingIC{issueClient: ic, err: errFetch}, toProcess: make(map[string]*issues.Issue)}
+		if _, err := ip.parseArgs(context.Background(), nil); !errors.Is(err, errFetch) {
+			t.Errorf("since=%s: parseArgs error = %v, want %v", tc.since, err, errFetch)
+		}
+	}
+}
+
+// countingIC is an issueClient that counts the calls to Issues
+// and Comments, and makes the calls to Issues fail with err if it
+// is set.
+type countingIC struct {
+	issueClient
+	calls        int
+	commentCalls int
+	err          error
+}
+
+func (c *countingIC) Issues(ctx context.Context, opts issues.IssuesOptions) ([]*issues.Issue, error) {
+	c.calls++
+	if c.err != nil {
+		return nil, c.err
+	}
+	return c.issueClient.Issues(ctx, opts)
+}
+
+func TestParseArgsPrefetch(t *testing.T) {
+	ic := &memIC{is: map[int]issues.Issue{
+		1: {Number: 1, Title: "one"},
+		2: {Number: 2, Title: "two"},
+	}}
+	ip := &issueParser{ic: ic, toProcess: make(map[string]*issues.Issue)}
+	got, err := ip.parseArgs(context.Background(), []string{"1-3"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{"1", "2", "3"}, got); diff != "" {
+		t.Errorf("parseArgs mismatch (-want, +got):\n%s", diff)
+	}
+	// Issues that exist are fetched up front; the rest are
+	// left for lookup.
+	var fetched []string
+	for n := range ip.toProcess {
+		fetched = append(fetched, n)
+	}
+	if diff := cmp.Diff([]string{"1", "2"}, fetched, cmpopts.SortSlices(func(a, b string) bool { return a < b })); diff != "" {
+		t.Errorf("prefetched issues mismatch (-want, +got):\n%s", diff)
+	}
+	if _, err := ip.lookup(context.Background(), "3"); err == nil {
+		t.Error("lookup(3): got nil error, want not found")
+	}
+}
+
+func (c *countingIC) Comments(ctx context.Context, n int) ([]*issues.Comment, error) {
+	c.commentCalls++
+	return c.issueClient.Comments(ctx, n)
+}
@@ -6,12 +6,20 @@ package main
 
 import (
 	"context"
+	"errors"
+	"flag"
 	"fmt"
 	"strings"
+	"time"
 
+	"golang.org/x/vulndb/cmd/vulnreport/log"
+	"golang.org/x/vulndb/internal/osvutils"
 	"golang.org/x/vulndb/internal/proxy"
+	"golang.org/x/vulndb/internal/report"
 )
 
+var checkOSVVersions = flag.Bool("osv-versions", false, "for lint, also check with the module proxy that each introduced and fixed version in the report's OSV exists")
+
 type lint struct {
 	*linter
 	*filenameParser
@@ -25,30 +33,90 @@ func (lint) usage() (string, string) {
 	return filenameArgs, desc
 }
 
+func (lint) capabilities() capability { return capReadRepo | capNetwork }
+
 func (l *lint) setup(ctx context.Context, env environment) error {
 	l.linter = new(linter)
 	l.filenameParser = new(filenameParser)
 	return setupAll(ctx, env, l.linter, l.filenameParser)
 }
 
-func (l *lint) close() error { return nil }
+func (l *lint) close() error {
+	if l.numSuppressed > 0 {
+		log.Outf("suppressed %d lint(s) in %d report(s) with lint-ignore notes", l.numSuppressed, l.numSuppressedReports)
+	}
+	return nil
+}
 
 func (l *lint) run(_ context.Context, input any) error {
 	r := input.(*yamlReport)
-	return l.lint(r)
+	err := l.lint(r)
+	if *checkOSVVersions && !r.IsExcluded() {
+		err = errors.Join(err, l.checkOSVVersions(r))
+	}
+	l.warnUnresolvedModules(r)
+	return err
+}
+
+// warnUnresolvedModules warns about the modules of r that have been
+// deleted from the proxy or taken over by a module whose path differs
+// only in case, so that the report can be updated or withdrawn.
+func (l *linter) warnUnresolvedModules(r *yamlReport) {
+	if r.IsExcluded() || r.Withdrawn != nil {
+		return
+	}
+	for _, m := range r.Modules {
+		if m.IsFirstParty() || m.SkipLint {
+			continue
+		}
+		err := l.pxc.CheckModuleResolves(m.Module)
+		if errors.Is(err, proxy.ErrModuleGone) || errors.Is(err, proxy.ErrModuleCaseCollision) {
+			log.Warnf("%s: module %s no longer resolves: %v", r.ID, m.Module, err)
+		}
+	}
+}
+
+// checkOSVVersions checks that the versions in the OSV generated
+// from r exist. Unlike the version checks in lint, which look at
+// the report's versions one by one, this looks at exactly what
+// will be published.
+func (l *linter) checkOSVVersions(r *yamlReport) error {
+	entry, err := r.ToOSV(time.Time{})
+	if err != nil {
+		return err
+	}
+	return osvutils.ValidateVersionsExist(&entry, l.pxc)
 }
 
 type linter struct {
 	pxc *proxy.Client
+
+	// Number of lints suppressed by lint-ignore notes,
+	// and number of reports with suppressed lints.
+	numSuppressed, numSuppressedReports int
 }
 
 func (l *linter) setup(_ context.Context, env environment) error {
 	l.pxc = env.ProxyClient()
+	policy, err := report.ReadLintPolicy(env.ReportFS())
+	if err != nil {
+		return err
+	}
+	report.SetLintPolicy(policy)
 	return nil
 }
 
 func (l *linter) lint(r *yamlReport) error {
-	if lints := r.Lint(l.pxc); len(lints) > 0 {
+	lints, warnings, suppressed := r.LintWithSuppressed(l.pxc)
+	if len(warnings) > 0 {
+		log.Warnf("%s: has %d lint(s) that %s downgrades to warnings:%s%s", r.ID, len(warnings), report.LintPolicyFile, listItem, strings.Join(warnings, listItem))
+	}
+	if len(suppressed) > 0 {
+		log.Infof("%s: suppressed %d lint(s) with lint-ignore notes", r.ID, len(suppressed))
+		l.numSuppressed += len(suppressed)
+		l.numSuppressedReports++
+	}
+	if len(lints) > 0 {
 		return fmt.Errorf("%v has %d lint warnings:%s%s", r.ID, len(lints), listItem, strings.Join(lints, listItem))
 	}
 	return nil
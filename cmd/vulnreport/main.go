@@ -16,15 +16,27 @@ import (
 	"text/tabwriter"
 
 	vlog "golang.org/x/vulndb/cmd/vulnreport/log"
+	"golang.org/x/vulndb/internal/kev"
+	"golang.org/x/vulndb/internal/secrets"
+	"golang.org/x/vulndb/internal/stdlib"
 )
 
 var (
-	githubToken = flag.String("ghtoken", "", "GitHub access token (default: value of VULN_GITHUB_ACCESS_TOKEN)")
-	cpuprofile  = flag.String("cpuprofile", "", "write cpuprofile to this file")
-	quiet       = flag.Bool("q", false, "quiet mode (suppress info logs)")
-	colorize    = flag.Bool("color", os.Getenv("NO_COLOR") == "", "show colors in logs")
-	issueRepo   = flag.String("issue-repo", "github.com/golang/vulndb", "repo to locate Github issues")
-	reportRepo  = flag.String("local-repo", ".", "local path to repo to locate YAML reports")
+	githubToken     = flag.String("ghtoken", "", "GitHub access token (default: the github-token secret)")
+	secretsSpec     = flag.String("secrets", "env", "where to read secrets from: env (environment variables), file:DIR (files named after the secrets in DIR) or gcp:PROJECT (GCP Secret Manager)")
+	cpuprofile      = flag.String("cpuprofile", "", "write cpuprofile to this file")
+	quiet           = flag.Bool("q", false, "quiet mode (suppress info logs)")
+	colorize        = flag.Bool("color", os.Getenv("NO_COLOR") == "", "show colors in logs")
+	issueRepo       = flag.String("issue-repo", "github.com/golang/vulndb", "repo to locate issues: a GitHub repo, or a GitLab project given as HOST/PATH (see doc/vulnreport.md)")
+	reportRepo      = flag.String("local-repo", ".", "local path to repo to locate YAML reports")
+	since           = flag.Duration("since", 0, "for commands that operate on all open issues when given no args, only consider issues updated within this duration (e.g., 72h)")
+	issueMirror     = flag.String("issue-mirror", "", "read issues from the vuln worker's mirror of the issue tracker, given as PROJECT/NAMESPACE, instead of the GitHub API")
+	moduleMapSource = flag.String("module-map", "", "URL or file with current module importer counts (as CSV) to use for triage instead of the checked-in snapshot")
+	ownersFile      = flag.String("owners", "", "YAML file mapping module areas to preferred reviewers to use for triage instead of the checked-in internal/triage/owners/data/owners.yaml")
+	priorityModel   = flag.String("priority-model", "", "YAML file with the priority scoring model to use for triage instead of the checked-in internal/triage/priority/data/model.yaml")
+	kevSource       = flag.String("kev", "", "URL or file with CISA's catalog of Known Exploited Vulnerabilities (as JSON, e.g. "+kev.URL+") to take into account for triage")
+	goReleases      = flag.String("go-releases", stdlib.DownloadsURL, "URL or file with the Go releases (as go.dev/dl JSON) to check standard library fixed versions against")
+	sinceCommit     = flag.String("since-commit", "", "for commands that operate on reports, when given no args, operate on the reports added or modified since this git revision")
 )
 
 func init() {
@@ -34,7 +46,7 @@ func init() {
 		tw := tabwriter.NewWriter(out, 2, 4, 2, ' ', 0)
 		for _, command := range commands {
 			argUsage, desc := command.usage()
-			fmt.Fprintf(tw, "  %s\t%s\t%s\n", command.name(), argUsage, desc)
+			fmt.Fprintf(tw, "  %s\t%s\t%s\t[%s]\n", command.name(), argUsage, desc, command.capabilities())
 		}
 		tw.Flush()
 		fmt.Fprint(out, "\nsupported flags:\n\n")
@@ -44,24 +56,45 @@ func init() {
 
 // The subcommands supported by vulnreport.
 // To add a new command, implement the command interface and
-// add the command to this list.
+// add the command to this list. A command whose name has two
+// words (like "cve publish") is invoked with both.
 var commands = map[string]command{
-	"create":          &create{},
-	"create-excluded": &createExcluded{},
-	"commit":          &commit{},
-	"cve":             &cveCmd{},
-	"triage":          &triage{},
-	"fix":             &fix{},
-	"lint":            &lint{},
-	"regen":           &regenerate{},
-	"review":          &review{},
-	"set-dates":       &setDates{},
-	"suggest":         &suggest{},
-	"symbols":         &symbolsCmd{},
-	"osv":             &osvCmd{},
-	"unexclude":       &unexclude{},
-	"withdraw":        &withdraw{},
-	"xref":            &xref{},
+	"campaign":          &campaignCmd{},
+	"create":            &create{},
+	"create-excluded":   &createExcluded{},
+	"commit":            &commit{},
+	"cve":               &cveCmd{},
+	"cve publish":       &cvePublish{},
+	"cve reserve":       &cveReserve{},
+	"migrate":           &migrate{},
+	"migrate-cve":       &migrateCVE{},
+	"disputes":          &disputes{},
+	"enrich":            &enrich{},
+	"export":            &export{},
+	"expand-monorepo":   &expandMonorepo{},
+	"triage":            &triage{},
+	"fix":               &fix{},
+	"ghsa-draft":        &ghsaDraft{},
+	"ghsa-sync":         &ghsaSync{},
+	"lint":              &lint{},
+	"match":             &match{},
+	"regen":             &regenerate{},
+	"regen-derived":     &regenDerived{},
+	"review":            &review{},
+	"set-dates":         &setDates{},
+	"show":              &show{},
+	"suggest":           &suggest{},
+	"symbols":           &symbolsCmd{},
+	"osv":               &osvCmd{},
+	"package-matrix":    &packageMatrix{},
+	"unexclude":         &unexclude{},
+	"stats":             &statsCmd{},
+	"update-module-map": &updateModuleMap{},
+	"verify-cve":        &verifyCVE{},
+	"vex":               &vex{},
+	"what-if":           &whatIf{},
+	"withdraw":          &withdraw{},
+	"xref":              &xref{},
 }
 
 func main() {
@@ -80,8 +113,8 @@ func main() {
 		vlog.RemoveColor()
 	}
 
-	if *githubToken == "" {
-		*githubToken = os.Getenv("VULN_GITHUB_ACCESS_TOKEN")
+	if *noNetwork {
+		disableNetwork()
 	}
 
 	// Start CPU profiler.
@@ -97,13 +130,36 @@ func main() {
 	cmdName := flag.Arg(0)
 	args := flag.Args()[1:]
 
+	if len(args) > 0 {
+		if _, ok := commands[cmdName+" "+args[0]]; ok {
+			cmdName, args = cmdName+" "+args[0], args[1:]
+		}
+	}
 	cmd, ok := commands[cmdName]
 	if !ok {
 		flag.Usage()
 		log.Fatalf("unsupported command: %q", cmdName)
 	}
 
-	if err := run(ctx, cmd, args, defaultEnv()); err != nil {
+	sp, cleanup, err := secrets.FromSpec(ctx, *secretsSpec)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer cleanup()
+
+	env := defaultEnv()
+	env.secrets = secrets.Chain(secrets.Static(map[string]string{
+		secrets.GitHubToken: *githubToken,
+	}), sp)
+	if *telemetryFile != "" {
+		t, closer, err := openTelemetry(*telemetryFile, cmdName)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer closer.Close()
+		env.telemetry = t
+	}
+	if err := run(ctx, cmd, args, env); err != nil {
 		log.Fatalf("%s: %s", cmdName, err)
 	}
 }
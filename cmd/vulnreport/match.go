@@ -0,0 +1,172 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"golang.org/x/mod/module"
+	"golang.org/x/mod/semver"
+	"golang.org/x/vulndb/cmd/vulnreport/log"
+	"golang.org/x/vulndb/internal/coverage"
+	"golang.org/x/vulndb/internal/osvutils"
+	"golang.org/x/vulndb/internal/report"
+	"golang.org/x/vulndb/internal/version"
+)
+
+var matchSBOM = flag.String("sbom", "", "for match, the SBOM (CycloneDX or SPDX JSON) whose Go modules to match against the reports")
+
+// match prints the reports that affect the Go modules of an SBOM.
+type match struct {
+	*filenameParser
+
+	// Module versions of the SBOM, by module path.
+	mods map[string][]module.Version
+	// Number of module versions in the SBOM.
+	n       int
+	matches []*sbomMatch
+}
+
+// An sbomMatch is a module version of the SBOM affected by a report.
+type sbomMatch struct {
+	mod     module.Version
+	id      string
+	fixed   string
+	summary string
+}
+
+func (match) name() string { return "match" }
+
+func (match) usage() (string, string) {
+	const desc = "prints the reports that affect the Go modules of the SBOM given with -sbom (checking all reports if no arguments are given)"
+	return filenameArgs, desc
+}
+
+func (match) capabilities() capability { return capReadRepo }
+
+func (m *match) setup(ctx context.Context, env environment) error {
+	if *matchSBOM == "" {
+		return errors.New("need -sbom=FILE")
+	}
+	data, err := os.ReadFile(*matchSBOM)
+	if err != nil {
+		return err
+	}
+	mods, err := coverage.ParseSBOM(data)
+	if err != nil {
+		return err
+	}
+	if len(mods) == 0 {
+		return fmt.Errorf("%s: no Go modules (pkg:golang/ package URLs) found", *matchSBOM)
+	}
+	m.mods = make(map[string][]module.Version)
+	for _, mod := range mods {
+		m.mods[mod.Path] = append(m.mods[mod.Path], mod)
+	}
+	m.n = len(mods)
+	m.filenameParser = new(filenameParser)
+	return setupAll(ctx, env, m.filenameParser)
+}
+
+// parseArgs returns all reports if no arguments are given.
+func (m *match) parseArgs(ctx context.Context, args []string) ([]string, error) {
+	if len(args) > 0 || *sinceCommit != "" {
+		return m.filenameParser.parseArgs(ctx, args)
+	}
+	return fs.Glob(m.fsys, path.Join(filepath.ToSlash(report.YAMLDir), "*.yaml"))
+}
+
+func (*match) skip(input any) string {
+	r := input.(*yamlReport)
+	if r.IsExcluded() {
+		return "excluded"
+	}
+	if r.Withdrawn != nil {
+		return "withdrawn"
+	}
+	return ""
+}
+
+// run matches the module versions of the SBOM against the
+// affected ranges of the report's OSV entry.
+func (m *match) run(_ context.Context, input any) error {
+	r := input.(*yamlReport)
+	e, err := r.ToOSV(time.Time{})
+	if err != nil {
+		return err
+	}
+	for _, a := range e.Affected {
+		for _, mod := range m.mods[a.Module.Path] {
+			v := version.TrimPrefix(mod.Version)
+			if !version.IsValid(v) {
+				log.Warnf("%s: skipping %s, which does not have a semantic version", r.ID, mod)
+				continue
+			}
+			affected, err := osvutils.AffectsSemver(a.Ranges, v)
+			if err != nil {
+				return err
+			}
+			if affected {
+				m.matches = append(m.matches, &sbomMatch{
+					mod:     mod,
+					id:      r.ID,
+					fixed:   osvutils.LatestFixed(a.Ranges),
+					summary: e.Summary,
+				})
+			}
+		}
+	}
+	return nil
+}
+
+// close prints the matches, ordered by module version, and a summary.
+func (m *match) close() error {
+	slices.SortFunc(m.matches, func(a, b *sbomMatch) int {
+		if c := strings.Compare(a.mod.Path, b.mod.Path); c != 0 {
+			return c
+		}
+		if c := semver.Compare(a.mod.Version, b.mod.Version); c != 0 {
+			return c
+		}
+		return strings.Compare(a.id, b.id)
+	})
+	affected := make(map[module.Version]bool)
+	if len(m.matches) > 0 {
+		var b strings.Builder
+		tw := tabwriter.NewWriter(&b, 2, 4, 2, ' ', 0)
+		fmt.Fprintln(tw, "MODULE\tVERSION\tID\tFIXED\tSUMMARY")
+		for _, mt := range m.matches {
+			fixed := mt.fixed
+			if fixed == "" {
+				fixed = "-"
+			}
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", mt.mod.Path, mt.mod.Version, mt.id, fixed, mt.summary)
+			affected[mt.mod] = true
+		}
+		tw.Flush()
+		log.Out(strings.TrimSuffix(b.String(), "\n"))
+	}
+	log.Outf("%d of %d Go module versions in %s are affected by %d advisories", len(affected), m.n, filepath.Base(*matchSBOM), m.advisories())
+	return nil
+}
+
+func (m *match) advisories() int {
+	ids := make(map[string]bool)
+	for _, mt := range m.matches {
+		ids[mt.id] = true
+	}
+	return len(ids)
+}
@@ -0,0 +1,75 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"path"
+	"path/filepath"
+	"slices"
+
+	"golang.org/x/vulndb/internal/report"
+)
+
+// migrate rewrites reports in the latest version of the report schema.
+type migrate struct {
+	*filenameParser
+	*fileWriter
+}
+
+func (migrate) name() string { return "migrate" }
+
+func (migrate) usage() (string, string) {
+	const desc = "rewrites reports in the latest version of the report schema (all reports if no arguments are given)"
+	return filenameArgs, desc
+}
+
+func (migrate) capabilities() capability { return capReadRepo | capWriteFiles }
+
+func (m *migrate) setup(ctx context.Context, env environment) error {
+	m.filenameParser = new(filenameParser)
+	m.fileWriter = new(fileWriter)
+	return setupAll(ctx, env, m.filenameParser, m.fileWriter)
+}
+
+func (*migrate) close() error { return nil }
+
+// parseArgs returns all reports if no arguments are given.
+func (m *migrate) parseArgs(ctx context.Context, args []string) ([]string, error) {
+	if len(args) > 0 || *sinceCommit != "" {
+		return m.filenameParser.parseArgs(ctx, args)
+	}
+	var filenames []string
+	for _, dir := range []string{report.YAMLDir, report.ExcludedDir} {
+		fnames, err := fs.Glob(m.fsys, path.Join(filepath.ToSlash(dir), "*.yaml"))
+		if err != nil {
+			return nil, err
+		}
+		filenames = append(filenames, fnames...)
+	}
+	if len(filenames) == 0 {
+		return nil, fmt.Errorf("no arguments provided, and no reports found")
+	}
+	slices.Sort(filenames)
+	return filenames, nil
+}
+
+func (*migrate) skip(input any) string {
+	r := input.(*yamlReport)
+	if r.SchemaVersion == report.CurrentSchemaVersion {
+		return fmt.Sprintf("already at schema version %d", r.SchemaVersion)
+	}
+	return ""
+}
+
+// run writes the report, which was upgraded to the current schema
+// when it was read.
+func (m *migrate) run(_ context.Context, input any) error {
+	r := input.(*yamlReport)
+	r.SchemaVersion = report.CurrentSchemaVersion
+	return m.write(r)
+}
@@ -0,0 +1,150 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"golang.org/x/vulndb/cmd/vulnreport/log"
+	"golang.org/x/vulndb/internal/cve4"
+	"golang.org/x/vulndb/internal/cve5"
+	"golang.org/x/vulndb/internal/report"
+)
+
+// migrateCVE replaces the legacy CVE JSON 4.0 records of reports
+// with CVE JSON 5.0 records.
+type migrateCVE struct {
+	*filenameParser
+	*fileWriter
+}
+
+func (migrateCVE) name() string { return "migrate-cve" }
+
+func (migrateCVE) usage() (string, string) {
+	const desc = "replaces the CVE 4.0 records in data/cve/v4 with CVE 5.0 records, after checking that they agree with the reports (all records if no arguments are given)"
+	return filenameArgs, desc
+}
+
+func (migrateCVE) capabilities() capability { return capReadRepo | capWriteFiles }
+
+func (m *migrateCVE) setup(ctx context.Context, env environment) error {
+	m.filenameParser = new(filenameParser)
+	m.fileWriter = new(fileWriter)
+	return setupAll(ctx, env, m.filenameParser, m.fileWriter)
+}
+
+func (*migrateCVE) close() error { return nil }
+
+// parseArgs returns the reports with a CVE 4.0 record
+// if no arguments are given.
+func (m *migrateCVE) parseArgs(ctx context.Context, args []string) ([]string, error) {
+	if len(args) > 0 || *sinceCommit != "" {
+		return m.filenameParser.parseArgs(ctx, args)
+	}
+	v4s, err := fs.Glob(m.fsys, path.Join(filepath.ToSlash(report.CVE4Dir), "*.json"))
+	if err != nil {
+		return nil, err
+	}
+	var filenames []string
+	for _, v4 := range v4s {
+		id := strings.TrimSuffix(path.Base(v4), ".json")
+		fnames, _ := fs.Glob(m.fsys, "data/*/"+id+".yaml")
+		if len(fnames) != 1 {
+			log.Errf("%s: found %d reports with ID %s (want 1)", filepath.FromSlash(v4), len(fnames), id)
+			continue
+		}
+		filenames = append(filenames, fnames[0])
+	}
+	if len(filenames) == 0 {
+		return nil, fmt.Errorf("no arguments provided, and no CVE 4.0 records found in %s", report.CVE4Dir)
+	}
+	return filenames, nil
+}
+
+func (m *migrateCVE) skip(input any) string {
+	r := input.(*yamlReport)
+	if r.CVEMetadata == nil {
+		return "no cve_metadata"
+	}
+	if _, err := fs.Stat(m.fsys, filepath.ToSlash(r.CVE4Filename())); err != nil {
+		return "no CVE 4.0 record"
+	}
+	return ""
+}
+
+func (m *migrateCVE) run(_ context.Context, input any) error {
+	r := input.(*yamlReport)
+	v4name := r.CVE4Filename()
+	b, err := fs.ReadFile(m.fsys, filepath.ToSlash(v4name))
+	if err != nil {
+		return err
+	}
+	var c cve4.CVE
+	if err := json.Unmarshal(b, &c); err != nil {
+		return fmt.Errorf("%s: %w", v4name, err)
+	}
+	migrated, err := cve5.FromCVE4(&c)
+	if err != nil {
+		return err
+	}
+	want, err := cve5.FromReport(r.Report)
+	if err != nil {
+		return err
+	}
+	if diffs := diffMigratedCVE(want, migrated); len(diffs) > 0 {
+		if !*force {
+			return fmt.Errorf("%s: %s does not agree with the report in: %s; use -f to migrate anyway", r.ID, filepath.ToSlash(v4name), strings.Join(diffs, ", "))
+		}
+		log.Warnf("%s: %s does not agree with the report in: %s; but -f was specified, continuing", r.ID, filepath.ToSlash(v4name), strings.Join(diffs, ", "))
+	}
+
+	// The CVE 5.0 record is generated from the report, which has
+	// information (such as affected symbols) that the 4.0 record lacks.
+	if err := m.writeCVE(r); err != nil {
+		return err
+	}
+	existed, err := m.RemoveFile(v4name)
+	if err != nil {
+		return err
+	}
+	if existed {
+		log.Outf("removed %s", filepath.ToSlash(v4name))
+	}
+	return nil
+}
+
+// diffMigratedCVE compares the parts of a CVE 5.0 record generated from
+// a report that can be represented in CVE 4.0 with a record migrated
+// from CVE 4.0, returning the names of the parts that differ.
+func diffMigratedCVE(fromReport, migrated *cve5.CVERecord) []string {
+	want, got := fromReport.Containers.CNAContainer, migrated.Containers.CNAContainer
+	ignoreAffected := cmpopts.IgnoreFields(cve5.Affected{}, "Vendor", "Platforms", "ProgramRoutines")
+	var diffs []string
+	for _, part := range []struct {
+		name      string
+		want, got any
+	}{
+		{"state", fromReport.Metadata.State, migrated.Metadata.State},
+		{"descriptions", want.Descriptions, got.Descriptions},
+		{"problem types", want.ProblemTypes, got.ProblemTypes},
+		{"affected", want.Affected, got.Affected},
+		{"references", want.References, got.References},
+		{"credits", want.Credits, got.Credits},
+		{"rejected reasons", want.RejectedReasons, got.RejectedReasons},
+	} {
+		if !cmp.Equal(part.want, part.got, ignoreAffected, cmpopts.EquateEmpty()) {
+			diffs = append(diffs, part.name)
+		}
+	}
+	return diffs
+}
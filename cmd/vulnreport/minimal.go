@@ -0,0 +1,266 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"golang.org/x/mod/semver"
+	"golang.org/x/vulndb/cmd/vulnreport/log"
+	"golang.org/x/vulndb/internal/idstr"
+	"golang.org/x/vulndb/internal/issues"
+	"golang.org/x/vulndb/internal/osv"
+	"golang.org/x/vulndb/internal/report"
+	"golang.org/x/vulndb/internal/stdlib"
+	"golang.org/x/vulndb/internal/version"
+)
+
+var (
+	minimalReport  = flag.Bool("minimal", false, "for create, quickly create a minimal report from -fixed, -summary (and optionally -module and -refs), skipping slow fixers, and file an issue to complete it")
+	minimalModule  = flag.String("module", "", "for create -minimal, the affected module or package path (default: from the issue title)")
+	minimalFixed   = flag.String("fixed", "", "for create -minimal, comma-separated fixed versions")
+	minimalSummary = flag.String("summary", "", "for create -minimal, the summary of the report")
+	minimalRefs    = flag.String("refs", "", "for create -minimal, comma-separated references of the form TYPE:URL (e.g. fix:https://go.dev/cl/123)")
+)
+
+// minimalSkipFix is the skip_fix reason of the packages of a minimal
+// first-party report, whose vulnerable_at can't be guessed.
+const minimalSkipFix = "minimal report; symbols to be added in the follow-up issue"
+
+// newMinimalReport creates a report for the issue from the -minimal
+// flags alone, for when a report must be published before there is
+// time to write a full one (e.g., for a std vulnerability that is
+// about to be announced). It files a follow-up issue listing what
+// is left to do, which is also recorded in the report's notes.
+func (c *creator) newMinimalReport(ctx context.Context, iss *issues.Issue, ic issueClient) error {
+	r, err := c.minimalReport(iss)
+	if err != nil {
+		return err
+	}
+	if err := c.checkVersionDuplicates(r); err != nil {
+		return err
+	}
+	// Like fixAndWriteAll, but the follow-up issue is filed
+	// even if the report can't be published yet.
+	addNotes := true
+	fixed := c.fix(ctx, r, addNotes)
+	if err := c.fileWriter.write(r); err != nil {
+		return err
+	}
+	if fixed {
+		if err := c.writeDerived(r); err != nil {
+			return err
+		}
+	}
+	c.created = append(c.created, r)
+
+	num, err := ic.CreateIssue(ctx, followUpIssue(r, ic.Reference(iss.Number), c.assignee))
+	if err != nil {
+		return fmt.Errorf("%s: could not file follow-up issue: %w", r.ID, err)
+	}
+	log.Infof("%s: filed follow-up issue %s to complete the report", r.ID, ic.Reference(num))
+	if !fixed {
+		return fmt.Errorf("%s: could not fix all errors; requires manual review", r.ID)
+	}
+	return nil
+}
+
+// minimalReport returns the (unfixed) minimal report for the issue.
+func (c *creator) minimalReport(iss *issues.Issue) (*yamlReport, error) {
+	path := *minimalModule
+	if path == "" {
+		path = modulePath(iss)
+	}
+	if path == "" {
+		return nil, fmt.Errorf("issue %d: could not find module path in title; set it with -module", iss.Number)
+	}
+	if *minimalSummary == "" {
+		return nil, errors.New("-summary is required with -minimal")
+	}
+	fixed, err := parseFixedVersions(*minimalFixed)
+	if err != nil {
+		return nil, err
+	}
+	refs, err := parseRefs(*minimalRefs)
+	if err != nil {
+		return nil, err
+	}
+
+	rs := c.reviewStatus
+	if rs == 0 {
+		// A minimal report is written by hand, so
+		// unlike a generated one, it is already reviewed.
+		rs = report.Reviewed
+	}
+	mod, pkg := c.minimalModulePath(path)
+	id := iss.NewGoID()
+	m := &report.Module{Module: mod}
+	m.Versions = minimalVersions(fixed, m.IsFirstParty())
+	if pkg != "" {
+		p := &report.Package{Package: pkg}
+		if m.IsFirstParty() {
+			p.SkipFixSymbols = minimalSkipFix
+		}
+		m.Packages = []*report.Package{p}
+	}
+	// Unlike report.New, don't fix the report yet, as it
+	// doesn't have its versions.
+	created := time.Now()
+	raw := &report.Report{
+		ID:         id,
+		Modules:    []*report.Module{m},
+		Summary:    report.Summary(*minimalSummary),
+		References: refs,
+		SourceMeta: &report.SourceMeta{
+			ID:        report.Original().SourceID(),
+			Origin:    report.OriginGoCNA,
+			Created:   &created,
+			CreatedBy: c.assignee,
+		},
+		ReviewStatus: rs,
+	}
+	raw.AddAliases(aliases(iss))
+	// The references are given in any order.
+	raw.Normalize()
+	for _, t := range minimalTODOs(raw) {
+		raw.AddNote(report.NoteTypeCreate, "%s", todo+t)
+	}
+
+	fname, err := raw.YAMLFilename()
+	if err != nil {
+		return nil, err
+	}
+	log.Infof("%s: creating minimal %s report", id, rs)
+	return &yamlReport{Report: raw, Filename: fname}, nil
+}
+
+// minimalModulePath returns the module for a -module path and, if
+// the path is a package path, the package.
+func (c *creator) minimalModulePath(path string) (mod, pkg string) {
+	switch {
+	case stdlib.IsStdModule(path), stdlib.IsCmdModule(path):
+		return path, ""
+	case strings.HasPrefix(path, stdlib.ToolchainModulePath+"/"):
+		return stdlib.ToolchainModulePath, path
+	case stdlib.Contains(path):
+		return stdlib.ModulePath, path
+	}
+	if m, err := c.pxc.FindModule(path); err == nil && m != path {
+		return m, path
+	}
+	return path, ""
+}
+
+// parseFixedVersions parses the -fixed flag into sorted,
+// unprefixed semantic versions.
+func parseFixedVersions(s string) ([]string, error) {
+	var vs []string
+	for _, v := range strings.Split(s, ",") {
+		v = strings.TrimSpace(v)
+		if v == "" {
+			continue
+		}
+		v = version.TrimPrefix(v)
+		if !version.IsValid(v) {
+			return nil, fmt.Errorf("-fixed: %q is not a valid version", v)
+		}
+		vs = append(vs, v)
+	}
+	if len(vs) == 0 {
+		return nil, errors.New("-fixed is required with -minimal")
+	}
+	slices.SortFunc(vs, func(a, b string) int { return semver.Compare("v"+a, "v"+b) })
+	return slices.Compact(vs), nil
+}
+
+// minimalVersions returns the version ranges for the fixed versions,
+// treating each fix after the first as a backport to the release
+// branch of its minor version (as is usual for Go releases).
+func minimalVersions(fixed []string, firstParty bool) report.Versions {
+	var vs report.Versions
+	for i, v := range fixed {
+		if i > 0 {
+			intro := strings.TrimPrefix(semver.MajorMinor("v"+v), "v") + ".0"
+			if firstParty {
+				// Include pre-releases of the minor version.
+				intro += "-0"
+			}
+			if intro != v && version.Before(fixed[i-1], intro) {
+				vs = append(vs, report.Introduced(intro))
+			}
+		}
+		vs = append(vs, report.Fixed(v))
+	}
+	return vs
+}
+
+// parseRefs parses the -refs flag.
+func parseRefs(s string) ([]*report.Reference, error) {
+	var refs []*report.Reference
+	for _, ref := range strings.Split(s, ",") {
+		ref = strings.TrimSpace(ref)
+		if ref == "" {
+			continue
+		}
+		typ, u, ok := strings.Cut(ref, ":")
+		t := osv.ReferenceType(strings.ToUpper(typ))
+		if !ok || !slices.Contains(osv.ReferenceTypes, t) {
+			return nil, fmt.Errorf("-refs: %q is not of the form TYPE:URL", ref)
+		}
+		refs = append(refs, &report.Reference{Type: t, URL: u})
+	}
+	return refs, nil
+}
+
+// minimalTODOs returns what is left to do to complete the
+// minimal report r.
+func minimalTODOs(r *report.Report) []string {
+	var todos []string
+	if r.Description == "" {
+		todos = append(todos, "add a description")
+	}
+	for _, m := range r.Modules {
+		if len(m.Packages) == 0 {
+			todos = append(todos, fmt.Sprintf("add the affected packages of %s", m.Module))
+		}
+		for _, p := range m.Packages {
+			if len(p.Symbols) == 0 {
+				todos = append(todos, fmt.Sprintf("add the affected symbols of %s", p.Package))
+			}
+		}
+	}
+	if !slices.ContainsFunc(r.Aliases(), idstr.IsCVE) {
+		todos = append(todos, "add the CVE, once assigned")
+	}
+	if len(r.Credits) == 0 {
+		todos = append(todos, "add credits")
+	}
+	if len(r.References) == 0 {
+		todos = append(todos, "add references")
+	}
+	return todos
+}
+
+// followUpIssue returns the issue to file to complete the minimal
+// report r, which was created for the issue at ref.
+func followUpIssue(r *yamlReport, ref, assignee string) *issues.Issue {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s was created as a minimal report (with `vulnreport create -minimal`) for %s.\n\n", r.ID, ref)
+	fmt.Fprintf(&b, "To complete it, edit %s and run `vulnreport fix %s`:\n\n", r.Filename, r.ID)
+	for _, n := range r.Notes {
+		fmt.Fprintf(&b, "- [ ] %s\n", strings.TrimPrefix(n.Body, todo))
+	}
+	return &issues.Issue{
+		Title:    fmt.Sprintf("x/vulndb: complete minimal report %s", r.ID),
+		Body:     b.String(),
+		Assignee: assignee,
+	}
+}
@@ -6,6 +6,26 @@ package main
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"path"
+	"path/filepath"
+	"time"
+
+	"golang.org/x/vulndb/cmd/vulnreport/log"
+	"golang.org/x/vulndb/internal/osv"
+	"golang.org/x/vulndb/internal/osvutils"
+	"golang.org/x/vulndb/internal/report"
+)
+
+var (
+	osvAll          = flag.Bool("all", false, "for osv, operate on all reports in data/reports")
+	checkLinks      = flag.Bool("check-links", false, "for osv, check that the internal links (to Go advisories, related reports and aliases) in the generated entries point at existing entries")
+	osvStdout       = flag.Bool("stdout", false, "for osv, print the generated entries to stdout instead of writing them to data/osv")
+	osvOnlyValidate = flag.Bool("validate", false, "for osv, only validate the generated entries against the OSV schema, without writing or printing them")
 )
 
 type osvCmd struct {
@@ -13,15 +33,26 @@ type osvCmd struct {
 	*fileWriter
 	*filenameParser
 	noSkip
+
+	// entries are the generated entries, if their links
+	// should be checked.
+	entries []*osv.Entry
 }
 
 func (osvCmd) name() string { return "osv" }
 
 func (osvCmd) usage() (string, string) {
-	const desc = "converts YAML reports to OSV JSON and writes to data/osv"
+	const desc = "converts YAML reports to OSV JSON and writes to data/osv (use -stdout to print instead, or -validate to only validate)"
 	return filenameArgs, desc
 }
 
+func (osvCmd) capabilities() capability {
+	if *osvStdout || *osvOnlyValidate {
+		return capReadRepo | capNetwork
+	}
+	return capReadRepo | capWriteFiles | capNetwork
+}
+
 func (o *osvCmd) setup(ctx context.Context, env environment) error {
 	o.linter = new(linter)
 	o.filenameParser = new(filenameParser)
@@ -29,12 +60,121 @@ func (o *osvCmd) setup(ctx context.Context, env environment) error {
 	return setupAll(ctx, env, o.linter, o.filenameParser, o.fileWriter)
 }
 
-func (o *osvCmd) close() error { return nil }
+// parseArgs returns all reports in data/reports if -all is set.
+func (o *osvCmd) parseArgs(ctx context.Context, args []string) ([]string, error) {
+	if !*osvAll {
+		return o.filenameParser.parseArgs(ctx, args)
+	}
+	if len(args) > 0 {
+		return nil, fmt.Errorf("-all cannot be used with arguments")
+	}
+	return fs.Glob(o.fsys, path.Join(filepath.ToSlash(report.YAMLDir), "*.yaml"))
+}
+
+// close checks the links in the generated entries, if requested.
+func (o *osvCmd) close() error {
+	if !*checkLinks || len(o.entries) == 0 {
+		return nil
+	}
+	resolve, err := o.linkResolver()
+	if err != nil {
+		return err
+	}
+	var broken int
+	for _, e := range o.entries {
+		if err := osvutils.CheckLinks(e, resolve); err != nil {
+			log.Err(err)
+			broken++
+		}
+	}
+	if broken > 0 {
+		return fmt.Errorf("found broken links in %d of %d entries", broken, len(o.entries))
+	}
+	log.Infof("osv: links in %d entries are OK", len(o.entries))
+	return nil
+}
+
+// linkResolver returns a function that errors if the given Go ID
+// is not a live entry in the database.
+func (o *osvCmd) linkResolver() (func(id string) error, error) {
+	// Why each ID that has a report is not a live entry ("" if it is).
+	status := make(map[string]string)
+	for _, dir := range []string{report.YAMLDir, report.ExcludedDir} {
+		fnames, err := fs.Glob(o.fsys, path.Join(filepath.ToSlash(dir), "*.yaml"))
+		if err != nil {
+			return nil, err
+		}
+		for _, fname := range fnames {
+			r, err := report.ReadStrict(o.fsys, fname)
+			if err != nil {
+				return nil, err
+			}
+			switch {
+			case r.IsExcluded():
+				status[r.ID] = fmt.Sprintf("is excluded (%s)", r.Excluded)
+			case r.Withdrawn != nil:
+				status[r.ID] = "is withdrawn"
+			default:
+				status[r.ID] = ""
+			}
+		}
+	}
+	return func(id string) error {
+		s, ok := status[id]
+		if !ok {
+			return errors.New("does not exist")
+		}
+		if s != "" {
+			return errors.New(s)
+		}
+		return nil
+	}, nil
+}
 
 func (o *osvCmd) run(_ context.Context, input any) error {
 	r := input.(*yamlReport)
 	if err := o.lint(r); err != nil {
 		return err
 	}
-	return o.writeOSV(r)
+	if r.IsExcluded() {
+		if *osvStdout || *osvOnlyValidate {
+			log.Warnf("%s: excluded reports have no OSV entry", r.ID)
+		}
+		return nil
+	}
+	e, err := r.ToOSV(time.Time{})
+	if err != nil {
+		return err
+	}
+	switch {
+	case *osvOnlyValidate:
+		// The entry's timestamps are only set when the database
+		// is generated.
+		if err := osvutils.ValidateExceptTimestamps(&e); err != nil {
+			return err
+		}
+		log.Infof("%s: OSV entry is valid", r.ID)
+	case *osvStdout:
+		if err := printOSV(&e); err != nil {
+			return err
+		}
+	default:
+		if err := o.writeOSV(r); err != nil {
+			return err
+		}
+	}
+	if *checkLinks {
+		o.entries = append(o.entries, &e)
+	}
+	return nil
+}
+
+// printOSV prints e to stdout, as it would be written to data/osv.
+func printOSV(e *osv.Entry) error {
+	b, err := json.MarshalIndent(e, "", "  ")
+	if err != nil {
+		return err
+	}
+	log.Out(string(b))
+	return nil
 }
@@ -0,0 +1,81 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"flag"
+	"strings"
+
+	"golang.org/x/vulndb/cmd/vulnreport/log"
+	"golang.org/x/vulndb/internal/proxy"
+)
+
+var splitByPackages = flag.Bool("split", false, "for package-matrix, split modules whose packages did not all exist at the same version range endpoints, and write the reports")
+
+type packageMatrix struct {
+	pxc *proxy.Client
+
+	*filenameParser
+	*fileWriter
+	noSkip
+}
+
+func (packageMatrix) name() string { return "package-matrix" }
+
+func (packageMatrix) usage() (string, string) {
+	const desc = "shows whether the affected packages of YAML reports existed at each version range endpoint, and (with -split) splits ranges to match"
+	return filenameArgs, desc
+}
+
+func (packageMatrix) capabilities() capability {
+	c := capReadRepo | capNetwork
+	if *splitByPackages {
+		c |= capWriteFiles
+	}
+	return c
+}
+
+func (p *packageMatrix) setup(ctx context.Context, env environment) error {
+	p.pxc = env.ProxyClient()
+	p.filenameParser = new(filenameParser)
+	p.fileWriter = new(fileWriter)
+	return setupAll(ctx, env, p.filenameParser, p.fileWriter)
+}
+
+func (p *packageMatrix) close() error { return nil }
+
+// run prints the package matrix of each module of the report,
+// which marks with "x" the packages that existed at each endpoint
+// of the module's version ranges (according to its module zips)
+// and with "-" those that did not.
+func (p *packageMatrix) run(_ context.Context, input any) error {
+	r := input.(*yamlReport)
+	for _, m := range r.Modules {
+		if m.IsFirstParty() || len(m.Packages) == 0 {
+			continue
+		}
+		pm, err := m.PackageMatrix(p.pxc)
+		if err != nil {
+			return err
+		}
+		log.Outf("%s:\n%s", r.ID, pm)
+	}
+	if !*splitByPackages {
+		return nil
+	}
+
+	split, err := r.SplitModulesByPackages(p.pxc)
+	if err != nil {
+		return err
+	}
+	if len(split) == 0 {
+		log.Infof("%s: no modules need to be split", r.ID)
+		return nil
+	}
+	log.Infof("%s: split module(s) by package existence:%s%s", r.ID, listItem, strings.Join(split, listItem))
+	log.Infof("%s: run 'vulnreport fix %s' to check the vulnerable_at versions of the split modules", r.ID, r.ID)
+	return p.write(r)
+}
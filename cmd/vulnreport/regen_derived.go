@@ -0,0 +1,236 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"runtime"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+	"golang.org/x/vulndb/cmd/vulnreport/log"
+	"golang.org/x/vulndb/internal/cve5"
+	"golang.org/x/vulndb/internal/report"
+)
+
+var (
+	regenBase     = flag.String("regen-base", "origin/master", "for regen-derived, when given no args (and no -since-commit), regenerate files for the reports changed since this git revision")
+	regenParallel = flag.Int("parallel", runtime.GOMAXPROCS(0), "for regen-derived, the number of reports to generate files for at once")
+)
+
+// regenDerived regenerates the files derived from YAML reports
+// (OSV entries and CVE records), for the reports that changed.
+//
+// Unlike the "regen" command, which regenerates YAML reports from
+// their sources, it does not use the network.
+type regenDerived struct {
+	*filenameParser
+	*fileWriter
+	noSkip
+
+	// generated are the derived files of each report, by report
+	// filename, generated in parallel by parseArgs.
+	generated map[string]*derived
+	// counts of derived files by drift status, for the summary.
+	counts map[driftStatus]int
+}
+
+func (regenDerived) name() string { return "regen-derived" }
+
+func (regenDerived) usage() (string, string) {
+	const desc = "regenerates OSV and CVE files for the reports changed since -regen-base"
+	return filenameArgs, desc
+}
+
+func (regenDerived) capabilities() capability { return capReadRepo | capWriteFiles }
+
+func (g *regenDerived) setup(ctx context.Context, env environment) error {
+	if *regenParallel < 1 {
+		return fmt.Errorf("-parallel must be at least 1")
+	}
+	g.filenameParser = new(filenameParser)
+	g.fileWriter = new(fileWriter)
+	g.counts = make(map[driftStatus]int)
+	if err := setupAll(ctx, env, g.filenameParser, g.fileWriter); err != nil {
+		return err
+	}
+	// With no args, the reports changed since -regen-base are used,
+	// so the repo is needed even if -since-commit is not set.
+	if g.sinceRepo == nil {
+		repo, err := env.ReportRepo(ctx)
+		if err != nil {
+			return err
+		}
+		g.sinceRepo = repo
+	}
+	return nil
+}
+
+func (g *regenDerived) parseArgs(ctx context.Context, args []string) (filenames []string, err error) {
+	switch {
+	case len(args) > 0 || *sinceCommit != "":
+		filenames, err = g.filenameParser.parseArgs(ctx, args)
+	default:
+		filenames, err = g.changedSince(*regenBase)
+	}
+	if err != nil {
+		return nil, err
+	}
+	g.generated = generateAll(g.fsys, filenames, *regenParallel)
+	return filenames, nil
+}
+
+// lookup returns the already-generated derived files of the report.
+func (g *regenDerived) lookup(_ context.Context, filename string) (any, error) {
+	d := g.generated[filename]
+	if d.err != nil {
+		return nil, d.err
+	}
+	return d, nil
+}
+
+func (g *regenDerived) run(_ context.Context, input any) error {
+	d := input.(*derived)
+	for _, f := range d.files {
+		status, err := g.drift(f)
+		if err != nil {
+			return err
+		}
+		g.counts[status]++
+		if status == upToDate {
+			continue
+		}
+		log.Infof("%s: %s is %s", d.id, filepath.ToSlash(f.name), status)
+		modified, err := g.WriteFile(f.name, f.content)
+		if err != nil {
+			return err
+		}
+		if err := ok(f.name, modified); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (g *regenDerived) close() error {
+	if len(g.generated) == 0 {
+		return nil
+	}
+	log.Infof("regen-derived: %d file(s) up to date, %d drifted, %d missing", g.counts[upToDate], g.counts[drifted], g.counts[missing])
+	return nil
+}
+
+// drift compares a derived file with the version in the repo.
+func (g *regenDerived) drift(f derivedFile) (driftStatus, error) {
+	existing, err := fs.ReadFile(g.fsys, filepath.ToSlash(f.name))
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		return missing, nil
+	case err != nil:
+		return 0, err
+	case string(existing) == string(f.content):
+		return upToDate, nil
+	default:
+		return drifted, nil
+	}
+}
+
+type driftStatus int
+
+const (
+	upToDate driftStatus = iota
+	drifted
+	missing
+)
+
+func (s driftStatus) String() string {
+	switch s {
+	case upToDate:
+		return "up to date"
+	case drifted:
+		return "out of date"
+	case missing:
+		return "missing"
+	}
+	return fmt.Sprintf("driftStatus(%d)", int(s))
+}
+
+// derived is the result of generating the derived files of a report.
+type derived struct {
+	id    string
+	files []derivedFile
+	err   error
+}
+
+type derivedFile struct {
+	name    string
+	content []byte
+}
+
+// generateAll generates the derived files of the given reports,
+// working on up to n reports at once.
+func generateAll(fsys fs.FS, filenames []string, n int) map[string]*derived {
+	var (
+		mu        sync.Mutex
+		generated = make(map[string]*derived)
+		g         errgroup.Group
+	)
+	g.SetLimit(n)
+	for _, fname := range filenames {
+		g.Go(func() error {
+			d := generate(fsys, fname)
+			mu.Lock()
+			defer mu.Unlock()
+			generated[fname] = d
+			return nil
+		})
+	}
+	_ = g.Wait() // errors are recorded per report
+	return generated
+}
+
+// generate reads a report and generates its OSV entry (unless it
+// is excluded) and its CVE record (if the Go CNA assigned its CVE).
+func generate(fsys fs.FS, filename string) *derived {
+	r, err := report.ReadStrict(fsys, filename)
+	if err != nil {
+		return &derived{err: err}
+	}
+	d := &derived{id: r.ID}
+	add := func(name string, v any) error {
+		b, err := json.MarshalIndent(v, "", "  ")
+		if err != nil {
+			return err
+		}
+		d.files = append(d.files, derivedFile{name: name, content: b})
+		return nil
+	}
+	if !r.IsExcluded() {
+		entry, err := r.ToOSV(time.Time{})
+		if err != nil {
+			return &derived{err: fmt.Errorf("%s: %w", r.ID, err)}
+		}
+		if err := add(r.OSVFilename(), entry); err != nil {
+			return &derived{err: err}
+		}
+	}
+	if r.CVEMetadata != nil {
+		cve, err := cve5.FromReport(r)
+		if err != nil {
+			return &derived{err: fmt.Errorf("%s: %w", r.ID, err)}
+		}
+		if err := add(r.CVEFilename(), cve); err != nil {
+			return &derived{err: err}
+		}
+	}
+	return d
+}
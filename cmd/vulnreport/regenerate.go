@@ -25,6 +25,8 @@ func (regenerate) usage() (string, string) {
 	return filenameArgs, desc
 }
 
+func (regenerate) capabilities() capability { return capReadRepo | capWriteFiles | capNetwork }
+
 func (u *regenerate) setup(ctx context.Context, env environment) error {
 	u.creator = new(creator)
 	u.filenameParser = new(filenameParser)
@@ -71,6 +73,9 @@ func (u *regenerate) run(ctx context.Context, input any) (err error) {
 		return err
 	}
 
+	// Keep the comments of the old report.
+	r.Comments = oldR.Comments
+
 	if !cmp.Equal(r, oldR,
 		cmpopts.IgnoreFields(report.SourceMeta{}, "Created"),
 		// VulnerableAt can change based on latest published version, so we don't
@@ -22,6 +22,8 @@ func (review) usage() (string, string) {
 	return filenameArgs, desc
 }
 
+func (review) capabilities() capability { return capReadRepo | capWriteFiles | capNetwork }
+
 func (u *review) setup(ctx context.Context, env environment) error {
 	u.creator = new(creator)
 	u.filenameParser = new(filenameParser)
@@ -59,6 +59,14 @@ func (m *memWFS) WriteFile(fname string, b []byte) (bool, error) {
 	m.written[fname] = b
 	return true, nil
 }
+
+// RemoveFile forgets any file written to fname. Since the files
+// that are not written are in a separate file system, it always
+// reports that the file existed.
+func (m *memWFS) RemoveFile(fname string) (bool, error) {
+	delete(m.written, fname)
+	return true, nil
+}
 func testFilename(t *testing.T) string {
 	return filepath.Join("testdata", t.Name()+".txtar")
 }
@@ -129,6 +137,11 @@ func newDefaultTestEnv(t *testing.T) (*environment, error) {
 		ic:         ic,
 		gc:         gc,
 		moduleMap:  mm,
+		history:    priority.History{},
+		kev:        map[string]bool{"CVE-2021-0000": true},
+		epssc: memEPSS{
+			"CVE-9999-0005": {CVE: "CVE-9999-0005", EPSS: 0.01234, Percentile: 0.85, Date: "2024-06-25"},
+		},
 	}, nil
 }
 
@@ -27,6 +27,8 @@ func (setDates) usage() (string, string) {
 	return filenameArgs, desc
 }
 
+func (setDates) capabilities() capability { return capReadRepo | capWriteFiles }
+
 func (sd *setDates) setup(ctx context.Context, env environment) error {
 	repo, err := env.ReportRepo(ctx)
 	if err != nil {
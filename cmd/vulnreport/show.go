@@ -0,0 +1,349 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"golang.org/x/vulndb/cmd/vulnreport/log"
+	"golang.org/x/vulndb/internal/cve5"
+	"golang.org/x/vulndb/internal/osv"
+	"golang.org/x/vulndb/internal/report"
+)
+
+// show prints a report together with the state of everything
+// derived from it: its OSV entry and CVE record, its tracker issue
+// and its lints.
+type show struct {
+	*linter
+	*filenameParser
+	noSkip
+
+	ic issueClient
+
+	// views are the views of the reports, if they should be
+	// printed as JSON.
+	views []*reportView
+}
+
+func (show) name() string { return "show" }
+
+func (show) usage() (string, string) {
+	const desc = "shows YAML reports with their OSV, CVE, tracker issue and lints (use -json for JSON)"
+	return filenameArgs, desc
+}
+
+func (show) capabilities() capability { return capReadRepo | capNetwork }
+
+func (s *show) setup(ctx context.Context, env environment) error {
+	ic, err := env.IssueClient(ctx)
+	if err != nil {
+		return err
+	}
+	s.ic = ic
+	s.linter = new(linter)
+	s.filenameParser = new(filenameParser)
+	return setupAll(ctx, env, s.linter, s.filenameParser)
+}
+
+// reportView is what show displays about a report, as printed
+// by show -json.
+type reportView struct {
+	ID           string        `json:"id"`
+	Filename     string        `json:"filename"`
+	Summary      string        `json:"summary,omitempty"`
+	ReviewStatus string        `json:"review_status,omitempty"`
+	Excluded     string        `json:"excluded,omitempty"`
+	Withdrawn    bool          `json:"withdrawn,omitempty"`
+	Aliases      []string      `json:"aliases,omitempty"`
+	Modules      []*moduleView `json:"modules,omitempty"`
+	OSV          *derivedView  `json:"osv,omitempty"`
+	CVE          *derivedView  `json:"cve,omitempty"`
+	Issue        *issueView    `json:"issue,omitempty"`
+	Lints        []string      `json:"lints"`
+	LintWarnings []string      `json:"lint_warnings,omitempty"`
+}
+
+type moduleView struct {
+	Module   string   `json:"module"`
+	Versions string   `json:"versions"`
+	Packages []string `json:"packages,omitempty"`
+}
+
+// derivedView is the state of a file derived from a report.
+type derivedView struct {
+	Filename string `json:"filename"`
+	// State is "current" if the file matches the report, "stale" if
+	// it doesn't (vulnreport regen-derived updates it), or "missing".
+	State string `json:"state"`
+	// CVEState is the state of the CVE record in CVE Services,
+	// as of its last publication.
+	CVEState string `json:"cve_state,omitempty"`
+	// Generated is the OSV entry or CVE record generated from the report.
+	Generated any `json:"generated"`
+}
+
+type issueView struct {
+	Number   int      `json:"number"`
+	URL      string   `json:"url"`
+	State    string   `json:"state,omitempty"`
+	Title    string   `json:"title,omitempty"`
+	Assignee string   `json:"assignee,omitempty"`
+	Labels   []string `json:"labels,omitempty"`
+	// NotFound is set if the issue could not be read
+	// (usually because it does not exist).
+	NotFound bool `json:"not_found,omitempty"`
+}
+
+const (
+	derivedCurrent = "current"
+	derivedStale   = "stale"
+	derivedMissing = "missing"
+)
+
+func (s *show) run(ctx context.Context, input any) error {
+	r := input.(*yamlReport)
+	v, err := s.view(ctx, r)
+	if err != nil {
+		return err
+	}
+	if *printJSON {
+		s.views = append(s.views, v)
+		return nil
+	}
+	log.Out(v.String())
+	return nil
+}
+
+func (s *show) close() error {
+	if !*printJSON {
+		return nil
+	}
+	if s.views == nil {
+		s.views = []*reportView{}
+	}
+	b, err := json.MarshalIndent(s.views, "", "  ")
+	if err != nil {
+		return err
+	}
+	log.Out(string(b))
+	return nil
+}
+
+// view returns the view of r.
+func (s *show) view(ctx context.Context, r *yamlReport) (_ *reportView, err error) {
+	v := &reportView{
+		ID:           r.ID,
+		Filename:     filepath.ToSlash(r.Filename),
+		Summary:      r.Summary.String(),
+		ReviewStatus: r.ReviewStatus.String(),
+		Excluded:     string(r.Excluded),
+		Withdrawn:    r.Withdrawn != nil,
+		Aliases:      r.Aliases(),
+	}
+	for _, m := range r.Modules {
+		mv := &moduleView{Module: m.Module, Versions: versionsText(m.Versions)}
+		for _, p := range m.Packages {
+			mv.Packages = append(mv.Packages, p.Package)
+		}
+		v.Modules = append(v.Modules, mv)
+	}
+	if !r.IsExcluded() {
+		entry, err := r.ToOSV(time.Time{})
+		if err != nil {
+			return nil, err
+		}
+		v.OSV, err = s.derived(r.OSVFilename(), entry, func(b []byte) (bool, error) {
+			// Compare the entries as vulnreport osv would write
+			// them, ignoring formatting.
+			var current osv.Entry
+			if err := json.Unmarshal(b, &current); err != nil {
+				return false, err
+			}
+			cb, err := json.Marshal(current)
+			if err != nil {
+				return false, err
+			}
+			gb, err := json.Marshal(entry)
+			if err != nil {
+				return false, err
+			}
+			return bytes.Equal(cb, gb), nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	if r.CVEMetadata != nil {
+		// An invalid record is reported by lint.
+		if rec, err := cve5.FromReport(r.Report); err == nil {
+			v.CVE, err = s.derived(r.CVEFilename(), rec, func(b []byte) (bool, error) {
+				var current *cve5.CVERecord
+				if err := json.Unmarshal(b, &current); err != nil {
+					return false, err
+				}
+				return len(cve5.Drift(rec, current)) == 0, nil
+			})
+			if err != nil {
+				return nil, err
+			}
+			v.CVE.CVEState = r.CVEMetadata.State
+		}
+	}
+	if v.Issue, err = s.issue(ctx, r); err != nil {
+		return nil, err
+	}
+	lints, warnings, _ := r.LintWithSuppressed(s.pxc)
+	v.Lints = append([]string{}, lints...)
+	v.LintWarnings = warnings
+	return v, nil
+}
+
+// derived returns the state of the file fname derived from a report,
+// for which generated is the generated contents and current reports
+// whether the contents of the file match them.
+func (s *show) derived(fname string, generated any, current func([]byte) (bool, error)) (*derivedView, error) {
+	fname = filepath.ToSlash(fname)
+	dv := &derivedView{Filename: fname, Generated: generated}
+	b, err := fs.ReadFile(s.fsys, fname)
+	if errors.Is(err, fs.ErrNotExist) {
+		dv.State = derivedMissing
+		return dv, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	ok, err := current(b)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", fname, err)
+	}
+	dv.State = derivedStale
+	if ok {
+		dv.State = derivedCurrent
+	}
+	return dv, nil
+}
+
+// issue returns the view of the tracker issue of r.
+func (s *show) issue(ctx context.Context, r *yamlReport) (*issueView, error) {
+	_, _, num, err := report.ParseFilepath(r.Filename)
+	if err != nil {
+		return nil, err
+	}
+	iv := &issueView{Number: num, URL: s.ic.Reference(num)}
+	iss, err := s.ic.Issue(ctx, num)
+	if err != nil {
+		// The issue clients don't distinguish missing issues from
+		// other errors, so report the error and carry on.
+		log.Warnf("%s: could not read issue #%d: %v", r.ID, num, err)
+		iv.NotFound = true
+		return iv, nil
+	}
+	iv.State, iv.Title, iv.Assignee, iv.Labels = iss.State, iss.Title, iss.Assignee, iss.Labels
+	return iv, nil
+}
+
+// versionsText returns a human-readable form of vs.
+func versionsText(vs report.Versions) string {
+	if len(vs) == 0 {
+		return "all versions"
+	}
+	var parts []string
+	for _, v := range vs {
+		parts = append(parts, fmt.Sprintf("%s %s", v.Type, v.Version))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// String returns the console view of the report.
+func (v *reportView) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s (%s)\n", v.ID, v.Filename)
+	if v.Summary != "" {
+		fmt.Fprintf(&b, "  summary: %s\n", v.Summary)
+	}
+	status := v.ReviewStatus
+	switch {
+	case v.Excluded != "":
+		status = "excluded: " + v.Excluded
+	case v.Withdrawn:
+		status += ", withdrawn"
+	}
+	fmt.Fprintf(&b, "  status: %s\n", status)
+	if len(v.Aliases) > 0 {
+		fmt.Fprintf(&b, "  aliases: %s\n", strings.Join(v.Aliases, ", "))
+	}
+	for _, m := range v.Modules {
+		fmt.Fprintf(&b, "  module %s: %s\n", m.Module, m.Versions)
+		for _, p := range m.Packages {
+			fmt.Fprintf(&b, "    package %s\n", p)
+		}
+	}
+	if v.OSV != nil {
+		fmt.Fprintf(&b, "  osv: %s (%s)\n", v.OSV.Filename, v.OSV.State)
+		if e, ok := v.OSV.Generated.(osv.Entry); ok {
+			for _, a := range e.Affected {
+				fmt.Fprintf(&b, "    affected %s: %s\n", a.Module.Path, rangesText(a.Ranges))
+			}
+		}
+	}
+	if v.CVE != nil {
+		state := v.CVE.CVEState
+		if state == "" {
+			state = "not published"
+		}
+		fmt.Fprintf(&b, "  cve: %s (%s; %s)\n", v.CVE.Filename, v.CVE.State, state)
+	}
+	if iv := v.Issue; iv != nil {
+		switch {
+		case iv.NotFound:
+			fmt.Fprintf(&b, "  issue: %s (not found)\n", iv.URL)
+		default:
+			fmt.Fprintf(&b, "  issue: %s (%s) %s\n", iv.URL, iv.State, iv.Title)
+			if iv.Assignee != "" {
+				fmt.Fprintf(&b, "    assignee: %s\n", iv.Assignee)
+			}
+			if len(iv.Labels) > 0 {
+				fmt.Fprintf(&b, "    labels: %s\n", strings.Join(iv.Labels, ", "))
+			}
+		}
+	}
+	if len(v.Lints) == 0 {
+		fmt.Fprint(&b, "  lints: none")
+	} else {
+		fmt.Fprintf(&b, "  lints (%d):", len(v.Lints))
+		for _, l := range v.Lints {
+			fmt.Fprintf(&b, "\n    - %s", l)
+		}
+	}
+	for _, w := range v.LintWarnings {
+		fmt.Fprintf(&b, "\n    - (warning) %s", w)
+	}
+	return b.String()
+}
+
+// rangesText returns a human-readable form of OSV ranges.
+func rangesText(ranges []osv.Range) string {
+	var parts []string
+	for _, r := range ranges {
+		for _, e := range r.Events {
+			switch {
+			case e.Introduced != "":
+				parts = append(parts, "introduced "+e.Introduced)
+			case e.Fixed != "":
+				parts = append(parts, "fixed "+e.Fixed)
+			}
+		}
+	}
+	return strings.Join(parts, ", ")
+}
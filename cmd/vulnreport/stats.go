@@ -0,0 +1,190 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"golang.org/x/vulndb/cmd/vulnreport/log"
+)
+
+var statsTooling = flag.Bool("tooling", false, "for stats, summarize the local telemetry recorded with -telemetry")
+
+type statsCmd struct {
+	noSkip
+}
+
+func (statsCmd) name() string { return "stats" }
+
+func (statsCmd) usage() (string, string) {
+	const desc = "with -tooling, summarizes how often commands, fixers and suggestions were used, from a telemetry file (default: -telemetry)"
+	return "[telemetry-file]", desc
+}
+
+func (statsCmd) capabilities() capability { return 0 }
+
+func (*statsCmd) setup(context.Context, environment) error {
+	if !*statsTooling {
+		return fmt.Errorf("-tooling is required (it is the only kind of stats so far)")
+	}
+	return nil
+}
+
+func (*statsCmd) close() error { return nil }
+
+func (*statsCmd) inputType() string { return "telemetry file" }
+
+func (*statsCmd) parseArgs(_ context.Context, args []string) ([]string, error) {
+	switch len(args) {
+	case 0:
+		if *telemetryFile == "" {
+			return nil, fmt.Errorf("no telemetry file provided: pass a file, or set -telemetry")
+		}
+		return []string{*telemetryFile}, nil
+	case 1:
+		return args, nil
+	default:
+		return nil, fmt.Errorf("want at most one telemetry file, got %d", len(args))
+	}
+}
+
+func (*statsCmd) lookup(_ context.Context, filename string) (any, error) {
+	return readTelemetry(filename)
+}
+
+func (*statsCmd) run(_ context.Context, input any) error {
+	var b strings.Builder
+	summarizeTelemetry(input.([]*telemetryEvent)).write(&b)
+	log.Out(strings.TrimSuffix(b.String(), "\n"))
+	return nil
+}
+
+// toolingStats are the aggregated telemetry events.
+type toolingStats struct {
+	commands    map[string]*usageStat
+	fixers      map[string]*usageStat
+	suggestions map[string]*suggestionStat
+}
+
+// usageStat aggregates the runs of a command or fixer.
+type usageStat struct {
+	runs, failed, skipped int
+	// inputs is the number of inputs of a command.
+	inputs int
+	// changed is the number of runs in which a fixer changed the report,
+	// and fields counts the changes by field.
+	changed int
+	fields  map[string]int
+	time    time.Duration
+}
+
+type suggestionStat struct {
+	suggested, kept, overridden int
+}
+
+func summarizeTelemetry(events []*telemetryEvent) *toolingStats {
+	s := &toolingStats{
+		commands:    make(map[string]*usageStat),
+		fixers:      make(map[string]*usageStat),
+		suggestions: make(map[string]*suggestionStat),
+	}
+	usage := func(m map[string]*usageStat, name string) *usageStat {
+		u, ok := m[name]
+		if !ok {
+			u = &usageStat{fields: make(map[string]int)}
+			m[name] = u
+		}
+		return u
+	}
+	suggestion := func(name string) *suggestionStat {
+		ss, ok := s.suggestions[name]
+		if !ok {
+			ss = new(suggestionStat)
+			s.suggestions[name] = ss
+		}
+		return ss
+	}
+	for _, e := range events {
+		switch e.Kind {
+		case kindCommand:
+			u := usage(s.commands, e.Command)
+			u.runs++
+			u.inputs += e.Inputs
+			u.time += e.Duration
+			if e.Result == "failed" {
+				u.failed++
+			}
+		case kindFixer:
+			u := usage(s.fixers, e.Name)
+			u.runs++
+			u.time += e.Duration
+			switch e.Result {
+			case "failed":
+				u.failed++
+			case "skipped":
+				u.skipped++
+			}
+			if len(e.Fields) > 0 {
+				u.changed++
+			}
+			for _, f := range e.Fields {
+				u.fields[f]++
+			}
+		case kindSuggestion:
+			suggestion(e.Name).suggested++
+		case kindOutcome:
+			switch e.Result {
+			case "kept":
+				suggestion(e.Name).kept++
+			case "overridden":
+				suggestion(e.Name).overridden++
+			}
+		}
+	}
+	return s
+}
+
+func (s *toolingStats) write(w io.Writer) {
+	tw := tabwriter.NewWriter(w, 2, 4, 2, ' ', 0)
+	defer tw.Flush()
+
+	fmt.Fprintln(tw, "command\truns\tfailed\tinputs\ttime")
+	for _, name := range slices.Sorted(maps.Keys(s.commands)) {
+		u := s.commands[name]
+		fmt.Fprintf(tw, "%s\t%d\t%d\t%d\t%s\n", name, u.runs, u.failed, u.inputs, u.time.Round(time.Millisecond))
+	}
+
+	fmt.Fprintln(tw, "\nfixer\truns\tchanged\tfailed\tskipped\ttime\tfields changed")
+	for _, name := range slices.Sorted(maps.Keys(s.fixers)) {
+		u := s.fixers[name]
+		fields := "-"
+		if len(u.fields) > 0 {
+			var parts []string
+			for _, f := range slices.Sorted(maps.Keys(u.fields)) {
+				parts = append(parts, fmt.Sprintf("%s (%d)", f, u.fields[f]))
+			}
+			fields = strings.Join(parts, ", ")
+		}
+		fmt.Fprintf(tw, "%s\t%d\t%d\t%d\t%d\t%s\t%s\n", name, u.runs, u.changed, u.failed, u.skipped, u.time.Round(time.Millisecond), fields)
+	}
+
+	fmt.Fprintln(tw, "\nsuggestion\tsuggested\tkept\toverridden\tpending\toverride rate")
+	for _, name := range slices.Sorted(maps.Keys(s.suggestions)) {
+		ss := s.suggestions[name]
+		rate := "-"
+		if decided := ss.kept + ss.overridden; decided > 0 {
+			rate = fmt.Sprintf("%.0f%%", 100*float64(ss.overridden)/float64(decided))
+		}
+		fmt.Fprintf(tw, "%s\t%d\t%d\t%d\t%d\t%s\n", name, ss.suggested, ss.kept, ss.overridden, ss.suggested-ss.kept-ss.overridden, rate)
+	}
+}
@@ -8,15 +8,20 @@ import (
 	"context"
 	"flag"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"golang.org/x/vulndb/cmd/vulnreport/log"
+	"golang.org/x/vulndb/internal/cwe"
 	"golang.org/x/vulndb/internal/genai"
 	"golang.org/x/vulndb/internal/report"
+	"golang.org/x/vulndb/internal/secrets"
 )
 
 var (
 	interactive    = flag.Bool("i", false, "for suggest, interactive mode")
 	numSuggestions = flag.Int("n", 1, "for suggest, the number of suggestions to generate (>1 can be slow)")
+	suggestCWE     = flag.Bool("cwe", false, "for suggest, suggest CWEs instead of a summary and description (with -ai, also ask the Gemini API)")
 )
 
 type suggest struct {
@@ -24,25 +29,37 @@ type suggest struct {
 	*filenameParser
 	*fileWriter
 	noSkip
+
+	tm *telemetry
 }
 
 func (suggest) name() string { return "suggest" }
 
 func (suggest) usage() (string, string) {
-	const desc = "(EXPERIMENTAL) use AI to suggest summary and description for YAML reports"
+	const desc = "(EXPERIMENTAL) use AI to suggest summary and description for YAML reports, or with -cwe, suggest CWEs"
 	return filenameArgs, desc
 }
 
+func (suggest) capabilities() capability { return capReadRepo | capWriteFiles | capNetwork }
+
 func (s *suggest) setup(ctx context.Context, env environment) error {
-	s.suggester = new(suggester)
+	// CWE suggestions only need the AI if asked for.
+	if !*suggestCWE || *useAI {
+		s.suggester = new(suggester)
+	}
 	s.filenameParser = new(filenameParser)
 	s.fileWriter = new(fileWriter)
+	s.tm = env.Telemetry()
 	return setupAll(ctx, env, s.suggester, s.filenameParser, s.fileWriter)
 }
 
 func (s *suggest) run(ctx context.Context, input any) (err error) {
 	r := input.(*yamlReport)
 
+	if *suggestCWE {
+		return s.runCWE(ctx, r)
+	}
+
 	log.Info("contacting the Gemini API...")
 	suggestions, err := s.suggest(ctx, r, *numSuggestions)
 	if err != nil {
@@ -74,6 +91,8 @@ func (s *suggest) run(ctx context.Context, input any) (err error) {
 			switch choice {
 			case "a":
 				r.applySuggestion(sugg)
+				s.tm.recordSuggestion(r.ID, suggestedSummary, r.Summary.String())
+				s.tm.recordSuggestion(r.ID, suggestedDescription, r.Description.String())
 				if err := s.write(r); err != nil {
 					log.Err(err)
 				}
@@ -89,16 +108,88 @@ func (s *suggest) run(ctx context.Context, input any) (err error) {
 	return nil
 }
 
+func (s *suggest) runCWE(ctx context.Context, r *yamlReport) error {
+	candidates := s.suggestCWEs(ctx, r)
+	if len(candidates) == 0 {
+		log.Outf("%s: no CWE suggestions\n", r.ID)
+		return nil
+	}
+
+	s.tm.recordSuggestion(r.ID, suggestedCWE, candidates[0].ID)
+	log.Outf("== CWE suggestions for report %s ==\n\n", r.ID)
+	for i, c := range candidates {
+		log.Outf("%d. %s (confidence %.2f)\n", i+1, c, c.Confidence)
+	}
+
+	// In interactive mode, allow the user to pick one of the
+	// candidates for the report's CWE.
+	if !*interactive {
+		return nil
+	}
+	if r.CVEMetadata == nil {
+		return fmt.Errorf("%s: report has no cve_metadata to set a CWE in", r.ID)
+	}
+	log.Outf("\naccept a suggestion or quit? (1-%d=accept/Q=quit) ", len(candidates))
+	var choice string
+	if _, err := fmt.Scanln(&choice); err != nil {
+		return err
+	}
+	i, err := strconv.Atoi(choice)
+	if err != nil || i < 1 || i > len(candidates) {
+		return nil
+	}
+	r.CVEMetadata.CWE = candidates[i-1].String()
+	return s.write(r)
+}
+
+// maxCWESuggestions is the number of candidate CWEs to surface.
+const maxCWESuggestions = 3
+
+// suggestCWEs returns candidate CWEs for r, most likely first, based
+// on its summary and description.
+// If s is non-nil, the rule-based candidates are merged with
+// candidates from the Gemini API.
+func (s *suggester) suggestCWEs(ctx context.Context, r *yamlReport) []*cwe.Candidate {
+	text := strings.TrimSpace(r.Summary.String() + "\n" + r.Description.String())
+	candidates := cwe.Classify(text, maxCWESuggestions)
+	if s == nil || s.ac == nil {
+		return candidates
+	}
+
+	var module string
+	if len(r.Modules) > 0 {
+		module = r.Modules[0].Module
+	}
+	log.Info("contacting the Gemini API...")
+	ss, err := genai.SuggestCWEs(ctx, s.ac, &genai.Input{
+		Module:      module,
+		Description: text,
+	})
+	if err != nil {
+		log.Warnf("%s: could not get AI-generated CWE suggestions: %v", r.ID, err)
+		return candidates
+	}
+	var ai []*cwe.Candidate
+	for _, s := range ss {
+		ai = append(ai, &cwe.Candidate{ID: s.ID, Name: s.Name, Confidence: s.Confidence})
+	}
+	return cwe.Merge(maxCWESuggestions, candidates, ai)
+}
+
 type suggester struct {
 	ac *genai.GeminiClient
 }
 
-func (s *suggester) setup(ctx context.Context, _ environment) error {
+func (s *suggester) setup(ctx context.Context, env environment) error {
 	if s == nil {
 		return nil
 	}
 
-	ac, err := genai.NewGeminiClient(ctx)
+	key, err := env.Secret(ctx, secrets.GeminiAPIKey)
+	if err != nil {
+		return err
+	}
+	ac, err := genai.NewGeminiClient(ctx, key)
 	if err != nil {
 		return err
 	}
@@ -33,6 +33,8 @@ func (symbolsCmd) usage() (string, string) {
 	return filenameArgs, desc
 }
 
+func (symbolsCmd) capabilities() capability { return capReadRepo | capWriteFiles | capNetwork }
+
 func (s *symbolsCmd) parseArgs(ctx context.Context, args []string) ([]string, error) {
 	if len(args) > 0 {
 		return s.filenameParser.parseArgs(ctx, args)
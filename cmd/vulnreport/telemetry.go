@@ -0,0 +1,248 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/fs"
+	"maps"
+	"os"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/vulndb/cmd/vulnreport/log"
+	"golang.org/x/vulndb/internal/report"
+	"gopkg.in/yaml.v3"
+)
+
+var telemetryFile = flag.String("telemetry", "", "opt in to recording local telemetry (which fixers change which fields, and which suggestions are kept) by appending to this file; see doc/vulnreport.md")
+
+// A telemetryEvent is a line of the telemetry file.
+type telemetryEvent struct {
+	Time    time.Time     `json:"time"`
+	Command string        `json:"command"`
+	Kind    telemetryKind `json:"kind"`
+	// Report is the ID of the report, if any.
+	Report string `json:"report,omitempty"`
+	// Name is the name of the fixer, or the field a suggestion is for.
+	Name string `json:"name,omitempty"`
+	// Fields are the top-level report fields a fixer changed.
+	Fields []string `json:"fields,omitempty"`
+	// Result is the result of a command or fixer ("ok", "failed" or
+	// "skipped"), or the outcome of a suggestion ("kept" or "overridden").
+	Result string `json:"result,omitempty"`
+	// Value is the suggested value, for a suggestion.
+	Value string `json:"value,omitempty"`
+	// Inputs is the number of inputs, for a command.
+	Inputs   int           `json:"inputs,omitempty"`
+	Duration time.Duration `json:"duration,omitempty"`
+}
+
+type telemetryKind string
+
+const (
+	kindCommand    telemetryKind = "command"
+	kindFixer      telemetryKind = "fixer"
+	kindSuggestion telemetryKind = "suggestion"
+	// kindOutcome records whether a suggestion was kept by the time
+	// the report was committed.
+	kindOutcome telemetryKind = "outcome"
+)
+
+// Fields that suggestions are recorded for.
+const (
+	suggestedSummary     = "summary"
+	suggestedDescription = "description"
+	suggestedCWE         = "cwe"
+)
+
+// telemetry records telemetry events. A nil *telemetry records nothing,
+// so callers need not check whether telemetry is enabled.
+type telemetry struct {
+	command string
+	now     func() time.Time
+
+	mu sync.Mutex
+	w  io.Writer
+	// events are all the events in the file, including the ones
+	// recorded by this run.
+	events []*telemetryEvent
+}
+
+// openTelemetry opens the telemetry file, creating it if needed,
+// to record events for the given command.
+func openTelemetry(filename, command string) (*telemetry, io.Closer, error) {
+	events, err := readTelemetry(filename)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, nil, err
+	}
+	f, err := os.OpenFile(filename, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &telemetry{command: command, now: time.Now, w: f, events: events}, f, nil
+}
+
+// readTelemetry reads the events in a telemetry file.
+func readTelemetry(filename string) ([]*telemetryEvent, error) {
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	return parseTelemetry(b)
+}
+
+func parseTelemetry(b []byte) ([]*telemetryEvent, error) {
+	var events []*telemetryEvent
+	s := bufio.NewScanner(bytes.NewReader(b))
+	s.Buffer(nil, 1<<20)
+	for line := 1; s.Scan(); line++ {
+		if len(bytes.TrimSpace(s.Bytes())) == 0 {
+			continue
+		}
+		var e telemetryEvent
+		if err := json.Unmarshal(s.Bytes(), &e); err != nil {
+			return nil, fmt.Errorf("telemetry line %d: %w", line, err)
+		}
+		events = append(events, &e)
+	}
+	return events, s.Err()
+}
+
+func (t *telemetry) record(e *telemetryEvent) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	e.Time = t.now()
+	e.Command = t.command
+	b, err := json.Marshal(e)
+	if err != nil {
+		log.Warnf("telemetry: %s", err)
+		return
+	}
+	if _, err := t.w.Write(append(b, '\n')); err != nil {
+		log.Warnf("telemetry: %s", err)
+		return
+	}
+	t.events = append(t.events, e)
+}
+
+// recordSuggestion records that a value was suggested for a field
+// of a report.
+func (t *telemetry) recordSuggestion(id, field, value string) {
+	t.record(&telemetryEvent{Kind: kindSuggestion, Report: id, Name: field, Value: value})
+}
+
+// recordOutcomes records, for each field of r with a suggestion that
+// has no outcome yet, whether r still has the suggested value.
+func (t *telemetry) recordOutcomes(r *yamlReport) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	pending := make(map[string]string)
+	for _, e := range t.events {
+		if e.Report != r.ID {
+			continue
+		}
+		switch e.Kind {
+		case kindSuggestion:
+			pending[e.Name] = e.Value
+		case kindOutcome:
+			delete(pending, e.Name)
+		}
+	}
+	t.mu.Unlock()
+
+	for _, field := range slices.Sorted(maps.Keys(pending)) {
+		result := "overridden"
+		if suggestedValue(r, field) == pending[field] {
+			result = "kept"
+		}
+		t.record(&telemetryEvent{Kind: kindOutcome, Report: r.ID, Name: field, Result: result})
+	}
+}
+
+// suggestedValue returns the value of the field of r that suggestions
+// are made for, in the form the suggestion was recorded in.
+func suggestedValue(r *yamlReport, field string) string {
+	switch field {
+	case suggestedSummary:
+		return r.Summary.String()
+	case suggestedDescription:
+		return r.Description.String()
+	case suggestedCWE:
+		if r.CVEMetadata == nil {
+			return ""
+		}
+		// Only the ID matters, not how the name is written.
+		id, _, _ := strings.Cut(r.CVEMetadata.CWE, ":")
+		return id
+	}
+	return ""
+}
+
+// fieldSnapshot returns the top-level fields of r, as encoded in YAML,
+// so that fixers' changes can be found by comparing snapshots.
+// It returns nil if telemetry is disabled.
+func (t *telemetry) fieldSnapshot(r *report.Report) map[string]any {
+	if t == nil {
+		return nil
+	}
+	b, err := yaml.Marshal(r)
+	if err != nil {
+		return nil
+	}
+	var m map[string]any
+	if err := yaml.Unmarshal(b, &m); err != nil {
+		return nil
+	}
+	return m
+}
+
+// changedFields returns the names of the fields that differ between
+// two snapshots, in order.
+func changedFields(before, after map[string]any) []string {
+	var changed []string
+	for k := range before {
+		if !reflect.DeepEqual(before[k], after[k]) {
+			changed = append(changed, k)
+		}
+	}
+	for k := range after {
+		if _, ok := before[k]; !ok {
+			changed = append(changed, k)
+		}
+	}
+	slices.Sort(changed)
+	return changed
+}
+
+// recordFixer records the result of running a fixer on r.
+func (t *telemetry) recordFixer(name string, r *report.Report, before map[string]any, result string, d time.Duration) {
+	if t == nil {
+		return
+	}
+	t.record(&telemetryEvent{
+		Kind:     kindFixer,
+		Report:   r.ID,
+		Name:     name,
+		Fields:   changedFields(before, t.fieldSnapshot(r)),
+		Result:   result,
+		Duration: d,
+	})
+}
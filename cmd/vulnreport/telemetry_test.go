@@ -0,0 +1,100 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/vulndb/internal/report"
+)
+
+func newTestTelemetry(w *bytes.Buffer) *telemetry {
+	return &telemetry{
+		command: "test",
+		now:     func() time.Time { return testTime },
+		w:       w,
+	}
+}
+
+func TestTelemetrySuggestions(t *testing.T) {
+	var buf bytes.Buffer
+	tm := newTestTelemetry(&buf)
+	r := &yamlReport{Report: &report.Report{
+		ID:          "GO-9999-0001",
+		Summary:     "Suggested summary",
+		Description: "Suggested description",
+		CVEMetadata: &report.CVEMeta{ID: "CVE-9999-0001"},
+	}}
+	tm.recordSuggestion(r.ID, suggestedSummary, r.Summary.String())
+	tm.recordSuggestion(r.ID, suggestedDescription, r.Description.String())
+	tm.recordSuggestion(r.ID, suggestedCWE, "CWE-400")
+	tm.recordSuggestion("GO-9999-0002", suggestedSummary, "Another summary")
+
+	// A human edits the description and picks the suggested CWE.
+	r.Description = "Edited description"
+	r.CVEMetadata.CWE = "CWE-400: Uncontrolled Resource Consumption"
+	tm.recordOutcomes(r)
+	// Outcomes are only recorded once per suggestion.
+	tm.recordOutcomes(r)
+
+	events, err := parseTelemetry(buf.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []*telemetryEvent
+	for _, e := range events {
+		if e.Kind == kindOutcome {
+			got = append(got, e)
+		}
+	}
+	outcome := func(field, result string) *telemetryEvent {
+		return &telemetryEvent{Time: testTime, Command: "test", Kind: kindOutcome, Report: r.ID, Name: field, Result: result}
+	}
+	want := []*telemetryEvent{
+		outcome(suggestedCWE, "kept"),
+		outcome(suggestedDescription, "overridden"),
+		outcome(suggestedSummary, "kept"),
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("mismatch (-want, +got):\n%s", diff)
+	}
+}
+
+func TestTelemetryFixer(t *testing.T) {
+	var buf bytes.Buffer
+	tm := newTestTelemetry(&buf)
+	r := &report.Report{ID: "GO-9999-0001", Summary: "A summary"}
+
+	before := tm.fieldSnapshot(r)
+	r.Summary = "A fixed summary"
+	r.References = []*report.Reference{{Type: "WEB", URL: "https://example.com"}}
+	tm.recordFixer("lint", r, before, "ok", time.Second)
+
+	events, err := parseTelemetry(buf.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*telemetryEvent{{
+		Time:     testTime,
+		Command:  "test",
+		Kind:     kindFixer,
+		Report:   r.ID,
+		Name:     "lint",
+		Fields:   []string{"references", "summary"},
+		Result:   "ok",
+		Duration: time.Second,
+	}}
+	if diff := cmp.Diff(want, events); diff != "" {
+		t.Errorf("mismatch (-want, +got):\n%s", diff)
+	}
+
+	// A nil telemetry records nothing.
+	var nilTM *telemetry
+	nilTM.recordFixer("lint", r, nilTM.fieldSnapshot(r), "ok", 0)
+	nilTM.recordOutcomes(&yamlReport{Report: r})
+}
@@ -8,6 +8,7 @@ import (
 	"context"
 	_ "embed"
 	"fmt"
+	"maps"
 	"path/filepath"
 	"slices"
 	"strconv"
@@ -17,6 +18,7 @@ import (
 	"golang.org/x/vulndb/cmd/vulnreport/log"
 	"golang.org/x/vulndb/internal/issues"
 	"golang.org/x/vulndb/internal/report"
+	"golang.org/x/vulndb/internal/triage/owners"
 	"golang.org/x/vulndb/internal/triage/priority"
 )
 
@@ -25,9 +27,13 @@ type triage struct {
 	*issueParser
 	*fixer
 
-	mu              sync.Mutex // protects aliasesToIssues and stats
+	owners *owners.Owners
+
+	mu              sync.Mutex // protects aliasesToIssues, stats and areas
 	aliasesToIssues map[string][]int
 	stats           []issuesList
+	// issues by the name of their area
+	areas map[string]issuesList
 
 	// issues that have already been marked as duplicate
 	duplicates map[int]bool
@@ -40,6 +46,8 @@ func (*triage) usage() (string, string) {
 	return "<no args> | " + ghIssueArgs, desc
 }
 
+func (*triage) capabilities() capability { return capReadRepo | capNetwork | capMutateTracker }
+
 func (t *triage) close() error {
 	log.Outf("triaged %d issues:%s%s",
 		len(t.stats[statTriaged]), listItem, strings.Join(toStrings(t.stats[:len(t.stats)-1]), listItem))
@@ -47,6 +55,13 @@ func (t *triage) close() error {
 	if len(t.stats[statHighPriority]) > 0 {
 		log.Outf("helpful commands:\n  $ vulnreport create %s", t.stats[statHighPriority].issNums())
 	}
+	if len(t.areas) > 0 {
+		var strs []string
+		for _, name := range slices.Sorted(maps.Keys(t.areas)) {
+			strs = append(strs, fmt.Sprintf("%s: %s", name, t.areas[name].issNums()))
+		}
+		log.Outf("issues by area:%s%s", listItem, strings.Join(strs, listItem))
+	}
 	return nil
 }
 
@@ -60,6 +75,13 @@ func toStrings(stats []issuesList) (strs []string) {
 func (t *triage) setup(ctx context.Context, env environment) error {
 	t.aliasesToIssues = make(map[string][]int)
 	t.stats = make([]issuesList, len(statNames))
+	t.areas = make(map[string]issuesList)
+
+	o, err := env.Owners()
+	if err != nil {
+		return err
+	}
+	t.owners = o
 
 	t.issueParser = new(issueParser)
 	t.fixer = new(fixer)
@@ -86,6 +108,22 @@ func (t *triage) setup(ctx context.Context, env environment) error {
 	return nil
 }
 
+// parseArgs is like issueParser.parseArgs, but with no arguments it
+// also refetches the open issues in batches, with their comments, so
+// that finding their triage notes doesn't take a request per issue.
+func (t *triage) parseArgs(ctx context.Context, args []string) ([]string, error) {
+	issNums, err := t.issueParser.parseArgs(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+	if len(args) == 0 {
+		if err := t.prefetch(ctx, issNums); err != nil {
+			return nil, err
+		}
+	}
+	return issNums, nil
+}
+
 func (t *triage) skip(input any) string {
 	iss := input.(*issues.Issue)
 
@@ -113,8 +151,11 @@ func (t *triage) run(ctx context.Context, input any) (err error) {
 func (t *triage) triage(ctx context.Context, iss *issues.Issue) {
 	labels := []string{labelTriaged}
 	comments := []string{}
+	assignee := ""
+	note := &issues.TriageNote{}
 	defer func() {
-		t.editIssue(ctx, iss, labels, comments)
+		t.editIssue(ctx, iss, labels, comments, assignee)
+		t.postTriageNote(ctx, iss, note)
 		t.addStat(iss, statTriaged, "")
 	}()
 
@@ -123,21 +164,33 @@ func (t *triage) triage(ctx context.Context, iss *issues.Issue) {
 		var strs []string
 		for d, aliases := range dupes {
 			ref := t.ic.Reference(d.iss)
+			dref := fmt.Sprintf("#%d", d.iss)
 			if d.fname != "" {
 				ref = filepath.ToSlash(d.fname)
+				dref = ref
 			}
 			strs = append(strs, fmt.Sprintf("#%d shares alias(es) %s with %s", iss.Number,
 				strings.Join(aliases, ", "), ref))
 			comments = append(comments, fmt.Sprintf("Duplicate of #%d", d.iss))
+			note.Duplicates = appendNew(note.Duplicates, dref)
 		}
 		slices.Sort(strs)
+		slices.Sort(note.Duplicates)
 		t.addStat(iss, statDuplicate, strings.Join(strs, listItem))
 		labels = append(labels, labelDuplicate)
 	}
 
-	mp := t.canonicalModule(modulePath(iss))
-	pr, notGo := t.modulePriority(mp)
+	var mps []string
+	for _, mp := range candidateModules(iss) {
+		mps = appendNew(mps, t.canonicalModule(mp))
+	}
+	note.Aliases = t.aliases(ctx, iss)
+	pr, notGo := t.modulesPriority(mps, note.Aliases)
 	t.addStat(iss, toStat(pr.Priority), pr.Reason)
+	if len(mps) > 0 {
+		note.Module = mps[0]
+	}
+	note.Priority, note.Reason = pr.Priority.String(), pr.Reason
 
 	if notGo != nil {
 		t.addStat(iss, statNotGo, notGo.Reason)
@@ -147,9 +200,46 @@ func (t *triage) triage(ctx context.Context, iss *issues.Issue) {
 	if pr.Priority == priority.High {
 		labels = append(labels, labelHighPriority)
 	}
+
+	// Route the issue to the preferred reviewers of its area,
+	// unless someone is already assigned.
+	if a := t.owners.Lookup(mps...); a != nil {
+		labels = append(labels, a.Label())
+		if iss.Assignee == "" {
+			assignee = a.Reviewer(strconv.Itoa(iss.Number))
+		}
+		t.addArea(iss, a, assignee)
+	}
+}
+
+// postTriageNote posts the triage note n as a comment on iss,
+// or, if iss already has one, updates it in place if it has changed.
+func (t *triage) postTriageNote(ctx context.Context, iss *issues.Issue, n *issues.TriageNote) {
+	body := n.Comment()
+	if *dry {
+		log.Infof("issue #%d: would post triage note:\n%s", iss.Number, body)
+		return
+	}
+
+	comments, err := t.comments(ctx, iss)
+	if err != nil {
+		log.Warnf("issue #%d: could not read comments to find triage note\n\t%v", iss.Number, err)
+		return
+	}
+	switch _, c := issues.LatestTriageNote(comments); {
+	case c == nil:
+		err = t.ic.AddComments(ctx, iss.Number, []string{body})
+	case c.Body != body:
+		err = t.ic.EditComment(ctx, iss.Number, c.ID, body)
+	}
+	// The prefetched comments are now out of date.
+	iss.Comments = nil
+	if err != nil {
+		log.Warnf("issue #%d: could not post triage note\n\t%v", iss.Number, err)
+	}
 }
 
-func (t *triage) editIssue(ctx context.Context, iss *issues.Issue, labels, comments []string) {
+func (t *triage) editIssue(ctx context.Context, iss *issues.Issue, labels, comments []string, assignee string) {
 	// Preserve any existing labels.
 	labels = append(labels, iss.Labels...)
 
@@ -173,6 +263,9 @@ func (t *triage) editIssue(ctx context.Context, iss *issues.Issue, labels, comme
 		if len(comments) != 0 {
 			log.Infof("issue #%d: would add comments: [%s]", iss.Number, strings.Join(comments, ", "))
 		}
+		if assignee != "" {
+			log.Infof("issue #%d: would assign to %s", iss.Number, assignee)
+		}
 		return
 	}
 
@@ -180,6 +273,12 @@ func (t *triage) editIssue(ctx context.Context, iss *issues.Issue, labels, comme
 		log.Warnf("issue #%d: could not auto-set label(s) %s\n\t%v", iss.Number, labels, err)
 	}
 
+	if assignee != "" {
+		if err := t.ic.SetAssignee(ctx, iss.Number, assignee); err != nil {
+			log.Warnf("issue #%d: could not auto-assign to %s\n\t%v", iss.Number, assignee, err)
+		}
+	}
+
 	// TODO(tatianabradley): Read existing comments to ensure we aren't
 	// posting the same comment twice.
 	// (This requires an extra Github API request.)
@@ -307,6 +406,18 @@ func (t *triage) addStat(iss *issues.Issue, stat int, reason string) {
 	lg("issue %s is %s%s%s", t.ic.Reference(iss.Number), statNames[stat], listItem, reason)
 }
 
+func (t *triage) addArea(iss *issues.Issue, a *owners.Area, assignee string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.areas[a.Name] = append(t.areas[a.Name], iss)
+	if assignee != "" {
+		log.Infof("issue %s is in area %s; assigning to %s", t.ic.Reference(iss.Number), a.Name, assignee)
+	} else {
+		log.Infof("issue %s is in area %s", t.ic.Reference(iss.Number), a.Name)
+	}
+}
+
 const (
 	statHighPriority = iota
 	statLowPriority
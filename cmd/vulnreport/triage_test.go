@@ -0,0 +1,72 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/vulndb/internal/issues"
+)
+
+func TestPostTriageNote(t *testing.T) {
+	ctx := context.Background()
+	iss := issues.Issue{Number: 1}
+	ic := &memIC{is: map[int]issues.Issue{1: iss}}
+	if err := ic.AddComments(ctx, 1, []string{"Duplicate of #2"}); err != nil {
+		t.Fatal(err)
+	}
+	tr := &triage{issueParser: &issueParser{ic: ic}}
+
+	post := func(n *issues.TriageNote) {
+		t.Helper()
+		tr.postTriageNote(ctx, &iss, n)
+		if diff := cmp.Diff(n, tr.triageNote(ctx, &iss)); diff != "" {
+			t.Errorf("triage note mismatch (-want, +got):\n%s", diff)
+		}
+	}
+	post(&issues.TriageNote{Module: "example.com/a", Priority: "low", Reason: "few importers"})
+	post(&issues.TriageNote{Module: "example.com/a", Priority: "high", Reason: "many importers"})
+
+	// The note is updated in place, not posted again.
+	comments, err := ic.Comments(ctx, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(comments) != 2 {
+		t.Errorf("got %d comments, want 2 (the first comment and one triage note)", len(comments))
+	}
+}
+
+func TestTriageNotePrefetched(t *testing.T) {
+	ctx := context.Background()
+	ic := &memIC{is: map[int]issues.Issue{1: {Number: 1}, 2: {Number: 2}}}
+	note := &issues.TriageNote{Module: "example.com/a", Priority: "low", Reason: "few importers"}
+	for _, n := range []int{1, 2} {
+		if err := ic.AddComments(ctx, n, []string{note.Comment()}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	cic := &countingIC{issueClient: ic}
+	tr := &triage{issueParser: &issueParser{ic: cic, toProcess: make(map[string]*issues.Issue)}}
+	issNums, err := tr.parseArgs(ctx, []string{"1", "2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, n := range issNums {
+		iss, err := tr.lookup(ctx, n)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(note, tr.triageNote(ctx, iss.(*issues.Issue))); diff != "" {
+			t.Errorf("issue #%s: triage note mismatch (-want, +got):\n%s", n, diff)
+		}
+	}
+	// The comments were fetched along with the issues.
+	if cic.commentCalls != 0 {
+		t.Errorf("fetched comments %d times, want 0", cic.commentCalls)
+	}
+}
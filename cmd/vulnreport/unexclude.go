@@ -6,16 +6,38 @@ package main
 
 import (
 	"context"
+	"flag"
 	"fmt"
-	"os"
+	"io/fs"
+	"path"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
 
 	"golang.org/x/vulndb/cmd/vulnreport/log"
 	"golang.org/x/vulndb/internal/report"
 )
 
+var modulePrefix = flag.String("module-prefix", "", "for unexclude, with no arguments, promote all excluded reports with a module path that has this prefix")
+
 type unexclude struct {
 	*creator
 	*filenameParser
+
+	// bulk is true if the reports were selected
+	// with -reason and -module-prefix.
+	bulk bool
+	// promoted describes each report that was unexcluded,
+	// for the summary printed by close.
+	promoted []promotion
+}
+
+// A promotion is an excluded report converted to a regular report.
+type promotion struct {
+	id, module, filename string
+	oldReason            report.ExcludedType
+	reviewStatus         report.ReviewStatus
+	priority             string
 }
 
 func (unexclude) name() string { return "unexclude" }
@@ -25,13 +47,76 @@ func (unexclude) usage() (string, string) {
 	return filenameArgs, desc
 }
 
+func (unexclude) capabilities() capability { return capReadRepo | capWriteFiles | capNetwork }
+
 func (u *unexclude) setup(ctx context.Context, env environment) error {
 	u.creator = new(creator)
 	u.filenameParser = new(filenameParser)
 	return setupAll(ctx, env, u.creator, u.filenameParser)
 }
 
+// parseArgs returns the excluded reports that match the -reason and
+// -module-prefix filters, if no arguments are given and either is set.
+func (u *unexclude) parseArgs(ctx context.Context, args []string) ([]string, error) {
+	if len(args) > 0 || (*reason == "" && *modulePrefix == "") {
+		return u.filenameParser.parseArgs(ctx, args)
+	}
+	excluded := report.ExcludedType(*reason)
+	if excluded != "" && !excluded.IsValid() {
+		return nil, fmt.Errorf("invalid -reason=%s (accepted: %v)", excluded, report.ExcludedTypes)
+	}
+	fnames, err := fs.Glob(u.fsys, path.Join(filepath.ToSlash(report.ExcludedDir), "*.yaml"))
+	if err != nil {
+		return nil, err
+	}
+	var matches []string
+	for _, fname := range fnames {
+		r, err := report.ReadStrict(u.fsys, fname)
+		if err != nil {
+			return nil, err
+		}
+		if matchesUnexcludeFilters(r, excluded, *modulePrefix) {
+			matches = append(matches, fname)
+		}
+	}
+	if len(matches) == 0 {
+		return nil, fmt.Errorf("no excluded reports match -reason=%q -module-prefix=%q", excluded, *modulePrefix)
+	}
+	u.bulk = true
+	return matches, nil
+}
+
+// matchesUnexcludeFilters reports whether r has the given excluded
+// reason and a module with the given prefix. Empty filters match
+// all reports.
+func matchesUnexcludeFilters(r *report.Report, reason report.ExcludedType, modulePrefix string) bool {
+	if reason != "" && r.Excluded != reason {
+		return false
+	}
+	if modulePrefix == "" {
+		return true
+	}
+	for _, m := range r.Modules {
+		if strings.HasPrefix(m.Module, modulePrefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// close prints a summary of the promoted reports, if they
+// were selected with filters.
 func (u *unexclude) close() error {
+	if u.bulk && len(u.promoted) > 0 {
+		var b strings.Builder
+		tw := tabwriter.NewWriter(&b, 2, 4, 2, ' ', 0)
+		fmt.Fprintln(tw, "ID\tMODULE\tWAS\tSTATUS\tPRIORITY\tFILE")
+		for _, p := range u.promoted {
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\n", p.id, p.module, p.oldReason, p.reviewStatus, p.priority, p.filename)
+		}
+		tw.Flush()
+		log.Out(strings.TrimSuffix(b.String(), "\n"))
+	}
 	return closeAll(u.creator)
 }
 
@@ -86,12 +171,24 @@ func (u *unexclude) run(ctx context.Context, input any) (err error) {
 		return err
 	}
 
-	remove(oldR)
+	u.remove(oldR)
+
+	p := promotion{
+		id:           r.ID,
+		module:       modulePath,
+		filename:     filepath.ToSlash(r.Filename),
+		oldReason:    oldR.Excluded,
+		reviewStatus: r.ReviewStatus,
+	}
+	if pr, _ := u.reportPriority(r.Report); pr != nil {
+		p.priority = pr.Priority.String()
+	}
+	u.promoted = append(u.promoted, p)
 	return nil
 }
 
-func remove(r *yamlReport) {
-	if err := os.Remove(r.Filename); err != nil {
+func (u *unexclude) remove(r *yamlReport) {
+	if _, err := u.RemoveFile(r.Filename); err != nil {
 		log.Errf("%s: could not remove file %s: %v", r.ID, r.Filename, err)
 		return
 	}
@@ -0,0 +1,69 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/tools/txtar"
+	"golang.org/x/vulndb/internal/test"
+)
+
+func TestUnexcludeParseArgs(t *testing.T) {
+	fsys, err := test.TxtarArchiveToFS(txtar.Parse(testRepo))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { *reason, *modulePrefix = "", "" }()
+	for _, tc := range []struct {
+		name, reason, modulePrefix string
+		want                       []string
+	}{
+		{
+			name:   "reason",
+			reason: "NOT_GO_CODE",
+			want:   []string{"data/excluded/GO-9999-0003.yaml"},
+		},
+		{
+			name:         "module prefix",
+			modulePrefix: "golang.org/x/",
+			want:         []string{"data/excluded/GO-9999-0002.yaml"},
+		},
+		{
+			name:         "both",
+			reason:       "EFFECTIVELY_PRIVATE",
+			modulePrefix: "golang.org/x/exp",
+			want:         []string{"data/excluded/GO-9999-0002.yaml"},
+		},
+		{
+			name:         "no match",
+			reason:       "NOT_GO_CODE",
+			modulePrefix: "golang.org/x/",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			*reason, *modulePrefix = tc.reason, tc.modulePrefix
+			u := &unexclude{filenameParser: &filenameParser{fsys: fsys}}
+			got, err := u.parseArgs(context.Background(), nil)
+			if len(tc.want) == 0 {
+				if err == nil {
+					t.Errorf("parseArgs() = %v, want error", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("parseArgs() mismatch (-want, +got):\n%s", diff)
+			}
+			if !u.bulk {
+				t.Error("parseArgs() did not select bulk mode")
+			}
+		})
+	}
+}
@@ -0,0 +1,177 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"strings"
+	"time"
+
+	"golang.org/x/vulndb/cmd/vulnreport/log"
+	"golang.org/x/vulndb/internal/report"
+	"golang.org/x/vulndb/internal/triage/priority"
+)
+
+// moduleMapDir is the directory, relative to the repo root,
+// of the checked-in module importer counts.
+const moduleMapDir = "internal/triage/priority/data"
+
+type updateModuleMap struct {
+	fsys   fs.FS
+	wfs    wfs
+	rc     *report.Client
+	dryRun bool
+	noSkip
+}
+
+func (updateModuleMap) name() string { return "update-module-map" }
+
+func (updateModuleMap) usage() (string, string) {
+	const desc = "refreshes the checked-in module importer counts (and their history) used for triage, commits them, and lists modules whose excluded reports should be re-reviewed"
+	return "[source]", desc
+}
+
+func (updateModuleMap) capabilities() capability {
+	return capReadRepo | capWriteFiles | capNetwork
+}
+
+func (u *updateModuleMap) setup(ctx context.Context, env environment) error {
+	u.fsys = env.ReportFS()
+	u.wfs = env.WFS()
+	u.dryRun = env.dryRun
+	repo, err := env.ReportRepo(ctx)
+	if err != nil {
+		return err
+	}
+	u.rc, err = report.NewClient(repo)
+	return err
+}
+
+func (*updateModuleMap) close() error { return nil }
+
+func (*updateModuleMap) inputType() string { return "source" }
+
+// parseArgs returns the source of the importer counts: the argument,
+// if there is one, or else the value of the -module-map flag.
+func (*updateModuleMap) parseArgs(_ context.Context, args []string) ([]string, error) {
+	switch len(args) {
+	case 0:
+		if *moduleMapSource == "" {
+			return nil, fmt.Errorf("no source provided: pass a URL or file, or set -module-map")
+		}
+		return []string{*moduleMapSource}, nil
+	case 1:
+		return args, nil
+	default:
+		return nil, fmt.Errorf("want at most one source, got %d", len(args))
+	}
+}
+
+func (*updateModuleMap) lookup(ctx context.Context, source string) (any, error) {
+	return priority.FetchModuleMap(ctx, source)
+}
+
+func (u *updateModuleMap) run(ctx context.Context, input any) error {
+	m := input.(map[string]int)
+
+	var b bytes.Buffer
+	if err := priority.WriteModuleMap(&b, m); err != nil {
+		return err
+	}
+	date := time.Now().Format("20060102")
+	filename := path.Join(moduleMapDir, fmt.Sprintf("importers-%s.csv.gz", date))
+	if _, err := u.wfs.WriteFile(filename, b.Bytes()); err != nil {
+		return err
+	}
+
+	// The snapshot is embedded with a glob, so there must
+	// be only one.
+	old, err := fs.Glob(u.fsys, path.Join(moduleMapDir, "importers-*.csv.gz"))
+	if err != nil {
+		return err
+	}
+	for _, f := range old {
+		if f == filename {
+			continue
+		}
+		if _, err := u.wfs.RemoveFile(f); err != nil {
+			return err
+		}
+	}
+	log.Infof("wrote importer counts for %d modules to %s", len(m), filename)
+
+	h, err := u.updateHistory(m)
+	if err != nil {
+		return err
+	}
+	u.printPopularityChanges(h, m)
+
+	if u.dryRun {
+		log.Outf("would run: git add --all %s", moduleMapDir)
+	} else if err := gitAdd("--all", moduleMapDir); err != nil {
+		return err
+	}
+	msg := fmt.Sprintf("internal/triage/priority: update module importer counts\n\nUpdates the importer counts of %d modules to their values as of %s,\nand records them in the history.", len(m), date)
+	if *dry || u.dryRun {
+		log.Outf("would commit with message:\n\n%s", msg)
+		return nil
+	}
+	return gitCommit(msg, moduleMapDir)
+}
+
+// historyFile is the file, relative to the repo root,
+// of the history of module importer counts.
+var historyFile = path.Join(moduleMapDir, "history.csv.gz")
+
+// updateHistory adds m to the checked-in history of importer
+// counts, and returns the new history.
+func (u *updateModuleMap) updateHistory(m map[string]int) (priority.History, error) {
+	h := priority.History{}
+	f, err := u.fsys.Open(historyFile)
+	switch {
+	case err == nil:
+		h, err = priority.ReadHistory(f)
+		f.Close()
+		if err != nil {
+			return nil, err
+		}
+	case !errors.Is(err, fs.ErrNotExist):
+		return nil, err
+	}
+	h = h.Add(time.Now(), m)
+	var b bytes.Buffer
+	if err := priority.WriteHistory(&b, h); err != nil {
+		return nil, err
+	}
+	if _, err := u.wfs.WriteFile(historyFile, b.Bytes()); err != nil {
+		return nil, err
+	}
+	return h, nil
+}
+
+// printPopularityChanges lists the modules whose reports were excluded
+// as likely binaries or private code, but which have become popular.
+func (u *updateModuleMap) printPopularityChanges(h priority.History, m map[string]int) {
+	pcs := priority.PopularityChanges(h, m, u.rc)
+	if len(pcs) == 0 {
+		log.Infof("no modules with excluded reports became popular")
+		return
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "%d module(s) with excluded reports became popular; consider re-reviewing:", len(pcs))
+	for _, pc := range pcs {
+		var ids []string
+		for _, r := range pc.Excluded {
+			ids = append(ids, fmt.Sprintf("%s (%s)", r.ID, r.Excluded))
+		}
+		fmt.Fprintf(&b, "\n  - %s: %d importers, %s: %s", pc.Module, pc.Trend.To, pc.Trend, strings.Join(ids, ", "))
+	}
+	log.Outf("%s", b.String())
+}
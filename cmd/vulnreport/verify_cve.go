@@ -0,0 +1,178 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/vulndb/cmd/vulnreport/log"
+	"golang.org/x/vulndb/internal/cve5"
+	"golang.org/x/vulndb/internal/report"
+)
+
+var (
+	printJSON = flag.Bool("json", false, "for verify-cve and show, print the results as JSON")
+	regenCVE  = flag.Bool("regen-cve", false, "for verify-cve, regenerate CVE records that do not match their reports, keeping fields set by CVE Services")
+)
+
+// verifyCVE checks that the CVE records of reports match the records
+// generated from the reports.
+type verifyCVE struct {
+	*filenameParser
+	*fileWriter
+
+	results []*cveDrift
+}
+
+// cveDrift is the result of verifying the CVE record of one report,
+// as printed by verify-cve -json.
+type cveDrift struct {
+	ID       string `json:"id"`
+	CVE      string `json:"cve"`
+	Filename string `json:"filename"`
+	// Status is "ok", "drift" or "missing".
+	Status string `json:"status"`
+	// Drift is the parts of the record that differ from the report.
+	Drift []*cve5.PartDrift `json:"drift,omitempty"`
+	// Regenerated is true if the record was rewritten (with -regen-cve).
+	Regenerated bool `json:"regenerated,omitempty"`
+}
+
+const (
+	driftOK      = "ok"
+	driftDrift   = "drift"
+	driftMissing = "missing"
+)
+
+func (verifyCVE) name() string { return "verify-cve" }
+
+func (verifyCVE) usage() (string, string) {
+	const desc = "checks that CVE records match the records generated from their reports, ignoring fields set by CVE Services"
+	return filenameArgs, desc
+}
+
+func (verifyCVE) capabilities() capability { return capReadRepo | capWriteFiles }
+
+func (v *verifyCVE) setup(ctx context.Context, env environment) error {
+	v.filenameParser = new(filenameParser)
+	v.fileWriter = new(fileWriter)
+	return setupAll(ctx, env, v.filenameParser, v.fileWriter)
+}
+
+// parseArgs returns all reports with a CVE record
+// if no arguments are given.
+func (v *verifyCVE) parseArgs(ctx context.Context, args []string) ([]string, error) {
+	if len(args) > 0 || *sinceCommit != "" {
+		return v.filenameParser.parseArgs(ctx, args)
+	}
+	filenames, err := fs.Glob(v.fsys, path.Join(filepath.ToSlash(report.YAMLDir), "*.yaml"))
+	if err != nil {
+		return nil, err
+	}
+	if len(filenames) == 0 {
+		return nil, fmt.Errorf("no arguments provided, and no reports found")
+	}
+	return filenames, nil
+}
+
+func (*verifyCVE) skip(input any) string {
+	r := input.(*yamlReport)
+	if r.CVEMetadata == nil {
+		return "no cve_metadata"
+	}
+	return ""
+}
+
+func (v *verifyCVE) run(_ context.Context, input any) error {
+	r := input.(*yamlReport)
+	generated, err := cve5.FromReport(r.Report)
+	if err != nil {
+		return err
+	}
+	fname := filepath.ToSlash(r.CVEFilename())
+	result := &cveDrift{
+		ID:       r.ID,
+		CVE:      r.CVEMetadata.ID,
+		Filename: fname,
+		Status:   driftOK,
+	}
+	v.results = append(v.results, result)
+
+	var current *cve5.CVERecord
+	b, err := fs.ReadFile(v.fsys, fname)
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		result.Status = driftMissing
+	case err != nil:
+		return err
+	default:
+		if err := json.Unmarshal(b, &current); err != nil {
+			return fmt.Errorf("%s: %w", fname, err)
+		}
+		// Never regenerate the CNA container of another CNA's CVE.
+		if err := cve5.CheckGoAssigned(current); err != nil {
+			return fmt.Errorf("%s: %w; list the CVE in cves instead of cve_metadata", r.ID, err)
+		}
+		if drift := cve5.Drift(generated, current); len(drift) > 0 {
+			result.Status, result.Drift = driftDrift, drift
+		}
+	}
+	if result.Status == driftOK {
+		return nil
+	}
+
+	if *regenCVE {
+		// Keep the fields set by CVE Services, so that the
+		// only changes are the ones made to the report.
+		cve5.KeepVolatile(generated, current)
+		if err := writeJSON(v.fileWriter, r.CVEFilename(), generated); err != nil {
+			return err
+		}
+		result.Regenerated = true
+		return nil
+	}
+	if result.Status == driftMissing {
+		return fmt.Errorf("%s: %s does not exist; run vulnreport cve %s", r.ID, fname, r.ID)
+	}
+	var parts []string
+	for _, d := range result.Drift {
+		parts = append(parts, d.Part)
+		if !*printJSON {
+			log.Outf("%s %s:\n- %s\n+ %s", r.ID, d.Part, toJSON(d.Current), toJSON(d.Generated))
+		}
+	}
+	return fmt.Errorf("%s: %s does not match report in: %s; run vulnreport verify-cve -regen-cve %s", r.ID, fname, strings.Join(parts, ", "), r.ID)
+}
+
+func toJSON(v any) string {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(b)
+}
+
+func (v *verifyCVE) close() error {
+	if !*printJSON {
+		return nil
+	}
+	if v.results == nil {
+		v.results = []*cveDrift{}
+	}
+	b, err := json.MarshalIndent(v.results, "", "  ")
+	if err != nil {
+		return err
+	}
+	log.Out(string(b))
+	return nil
+}
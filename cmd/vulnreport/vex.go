@@ -0,0 +1,107 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/fs"
+	"path"
+	"path/filepath"
+	"time"
+
+	"golang.org/x/vulndb/cmd/vulnreport/log"
+	"golang.org/x/vulndb/internal/openvex"
+	"golang.org/x/vulndb/internal/report"
+)
+
+var vexTime = flag.String("vex-time", "", "for vex, the time of the VEX document, in RFC 3339 format (default: now)")
+
+// vexURLPrefix is the prefix of the IDs of the VEX documents
+// generated by vex.
+const vexURLPrefix = "https://vuln.go.dev/vex/"
+
+// vex prints an OpenVEX document with "not affected" statements
+// for excluded reports.
+type vex struct {
+	*filenameParser
+
+	statements []*openvex.Statement
+}
+
+func (vex) name() string { return "vex" }
+
+func (vex) usage() (string, string) {
+	const desc = "prints OpenVEX \"not affected\" statements for excluded reports (all excluded reports if no arguments are given)"
+	return filenameArgs, desc
+}
+
+func (vex) capabilities() capability { return capReadRepo }
+
+func (v *vex) setup(ctx context.Context, env environment) error {
+	v.filenameParser = new(filenameParser)
+	return setupAll(ctx, env, v.filenameParser)
+}
+
+// parseArgs returns all excluded reports if no arguments are given.
+func (v *vex) parseArgs(ctx context.Context, args []string) ([]string, error) {
+	if len(args) > 0 || *sinceCommit != "" {
+		return v.filenameParser.parseArgs(ctx, args)
+	}
+	filenames, err := fs.Glob(v.fsys, path.Join(filepath.ToSlash(report.ExcludedDir), "*.yaml"))
+	if err != nil {
+		return nil, err
+	}
+	if len(filenames) == 0 {
+		return nil, fmt.Errorf("no arguments provided, and no excluded reports found")
+	}
+	return filenames, nil
+}
+
+func (*vex) skip(input any) string {
+	r := input.(*yamlReport)
+	if !r.IsExcluded() {
+		return "not excluded"
+	}
+	if !r.HasVEX() {
+		return fmt.Sprintf("excluded reason %s has no OpenVEX justification", r.Excluded)
+	}
+	return ""
+}
+
+func (v *vex) run(_ context.Context, input any) error {
+	r := input.(*yamlReport)
+	s, err := r.ToOpenVEX()
+	if err != nil {
+		return err
+	}
+	v.statements = append(v.statements, s)
+	return nil
+}
+
+// close prints the document, if there are any statements.
+func (v *vex) close() error {
+	if len(v.statements) == 0 {
+		return nil
+	}
+	t := time.Now()
+	if *vexTime != "" {
+		var err error
+		t, err = time.Parse(time.RFC3339, *vexTime)
+		if err != nil {
+			return fmt.Errorf("invalid -vex-time: %w", err)
+		}
+	}
+	id := vexURLPrefix + t.UTC().Format("20060102T150405Z")
+	doc := openvex.NewDocument(id, "vulnreport", t, v.statements)
+	b, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return err
+	}
+	log.Out(string(b))
+	return nil
+}
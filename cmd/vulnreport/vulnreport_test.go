@@ -5,7 +5,19 @@
 package main
 
 import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+	"testing/fstest"
+
+	"golang.org/x/tools/txtar"
+	"golang.org/x/vulndb/internal/stdlib"
+	"golang.org/x/vulndb/internal/test"
+	"golang.org/x/vulndb/internal/triage/owners"
+	"golang.org/x/vulndb/internal/worker/store"
 )
 
 func TestCreate(t *testing.T) {
@@ -25,11 +37,91 @@ func TestCreate(t *testing.T) {
 			wantErr:     true,
 			expectedErr: "ERROR: create: GO-0000-0100: could not fix all errors; requires manual review",
 		},
+		{
+			name:    "likely duplicate of open issues",
+			args:    []string{"14"},
+			wantErr: true,
+		},
+		{
+			name:    "likely duplicate of report",
+			args:    []string{"7"},
+			wantErr: true,
+		},
 	} {
 		runTest(t, &create{}, tc)
 	}
 }
 
+func TestCreateReservedCVE(t *testing.T) {
+	newEnv := func(t *testing.T) (*environment, error) {
+		env, err := newDefaultTestEnv(t)
+		if err != nil {
+			return nil, err
+		}
+		ar := txtar.Parse(testRepo)
+		ar.Files = append(ar.Files, txtar.File{
+			Name: *cveLedgerFile,
+			Data: []byte("reserved:\n  - CVE-9999-0100\n  - CVE-9999-0101\n"),
+		})
+		fsys, err := test.TxtarArchiveToFS(ar)
+		if err != nil {
+			return nil, err
+		}
+		env.reportFS = fsys
+		// A first-party issue for a CVE the Go CNA is assigning.
+		ic, err := newMemIC(append(testIssueTracker, []byte(`
+-- 16 --
+number: 16
+title: "x/vulndb: potential Go vuln in golang.org/x/net"
+state: open
+labels:
+  - first party
+`)...))
+		if err != nil {
+			return nil, err
+		}
+		env.ic = ic
+		return env, nil
+	}
+	for _, tc := range []*testCase{
+		{
+			name: "first_party",
+			args: []string{"16"},
+		},
+	} {
+		runTestWithEnv(t, &create{}, tc, newEnv)
+	}
+}
+
+func TestCreateMinimal(t *testing.T) {
+	newEnv := func(t *testing.T) (*environment, error) {
+		env, err := newDefaultTestEnv(t)
+		if err != nil {
+			return nil, err
+		}
+		env.releases = stdlib.NewSchedule("1.22.0", "1.22.5", "1.23.0", "1.23.1")
+		return env, nil
+	}
+	*minimalReport, *minimalModule, *minimalFixed = true, "net/http", "1.23.1, 1.22.5"
+	*minimalSummary = "Request smuggling in net/http"
+	defer func() {
+		*minimalReport, *minimalModule, *minimalFixed, *minimalSummary, *minimalRefs = false, "", "", "", ""
+	}()
+	for _, tc := range []*testCase{
+		{
+			name:        "missing_refs",
+			args:        []string{"11"},
+			wantErr:     true,
+			expectedErr: "ERROR: create: GO-0000-0011: could not fix all errors; requires manual review",
+		},
+	} {
+		runTestWithEnv(t, &create{}, tc, newEnv)
+	}
+
+	*minimalRefs = "report:https://go.dev/issue/12345,fix:https://go.dev/cl/12345,web:https://groups.google.com/g/golang-announce/c/abcdef"
+	runTestWithEnv(t, &create{}, &testCase{name: "ok", args: []string{"11"}}, newEnv)
+}
+
 func TestCreateExcluded(t *testing.T) {
 	for _, tc := range []*testCase{
 		// TODO(tatianabradley): add test cases
@@ -62,6 +154,319 @@ func TestCVE(t *testing.T) {
 	}
 }
 
+func TestMigrate(t *testing.T) {
+	newEnv := func(t *testing.T) (*environment, error) {
+		env, err := newDefaultTestEnv(t)
+		if err != nil {
+			return nil, err
+		}
+		fsys, err := test.ReadTxtarFS(filepath.Join("testdata", "schema_repo.txtar"))
+		if err != nil {
+			return nil, err
+		}
+		env.reportFS = fsys
+		return env, nil
+	}
+	for _, tc := range []*testCase{
+		{
+			name: "legacy",
+			args: []string{"20"},
+		},
+		{
+			name: "current",
+			args: []string{"21"},
+		},
+		{
+			name: "all",
+			// no args
+		},
+	} {
+		runTestWithEnv(t, &migrate{}, tc, newEnv)
+	}
+}
+
+func TestMigrateCVE(t *testing.T) {
+	newEnv := func(t *testing.T) (*environment, error) {
+		env, err := newDefaultTestEnv(t)
+		if err != nil {
+			return nil, err
+		}
+		fsys, err := test.ReadTxtarFS(filepath.Join("testdata", "cve4_repo.txtar"))
+		if err != nil {
+			return nil, err
+		}
+		env.reportFS = fsys
+		return env, nil
+	}
+	for _, tc := range []*testCase{
+		{
+			name: "ok",
+			args: []string{"10"},
+		},
+		{
+			name:        "mismatch",
+			args:        []string{"11"},
+			wantErr:     true,
+			expectedErr: "does not agree with the report in: descriptions",
+		},
+		{
+			name: "no_record",
+			args: []string{"12"},
+		},
+		{
+			name:    "all",
+			wantErr: true,
+			// no args
+		},
+	} {
+		runTestWithEnv(t, &migrateCVE{}, tc, newEnv)
+	}
+}
+
+func TestCVEPublish(t *testing.T) {
+	newEnv := func(t *testing.T) (*environment, error) {
+		env, err := newDefaultTestEnv(t)
+		if err != nil {
+			return nil, err
+		}
+		fsys, err := test.ReadTxtarFS(filepath.Join("testdata", "cve5_repo.txtar"))
+		if err != nil {
+			return nil, err
+		}
+		env.reportFS = fsys
+		archive, err := os.ReadFile(filepath.Join("testdata", "cve_services.txtar"))
+		if err != nil {
+			return nil, err
+		}
+		cvec, err := newMemCVEC(archive)
+		if err != nil {
+			return nil, err
+		}
+		env.cvec = cvec
+		return env, nil
+	}
+	for _, tc := range []*testCase{
+		{
+			name: "create",
+			args: []string{"30"},
+		},
+		{
+			name: "update",
+			args: []string{"31"},
+		},
+		{
+			name:        "not_go_assigned",
+			args:        []string{"32"},
+			wantErr:     true,
+			expectedErr: "CVE is owned by another CNA (owning CNA other)",
+		},
+		{
+			name: "no_cve_metadata",
+			args: []string{"33"},
+		},
+	} {
+		runTestWithEnv(t, &cvePublish{}, tc, newEnv)
+	}
+}
+
+func TestCVEReserve(t *testing.T) {
+	newEnv := func(t *testing.T) (*environment, error) {
+		env, err := newDefaultTestEnv(t)
+		if err != nil {
+			return nil, err
+		}
+		archive, err := os.ReadFile(filepath.Join("testdata", "cve_services.txtar"))
+		if err != nil {
+			return nil, err
+		}
+		cvec, err := newMemCVEC(archive)
+		if err != nil {
+			return nil, err
+		}
+		env.cvec = cvec
+		return env, nil
+	}
+	for _, tc := range []*testCase{
+		{
+			name: "ok",
+			args: []string{"2"},
+		},
+		{
+			name:    "bad_count",
+			args:    []string{"0"},
+			wantErr: true,
+		},
+	} {
+		runTestWithEnv(t, &cveReserve{}, tc, newEnv)
+	}
+}
+
+func TestVerifyCVE(t *testing.T) {
+	newEnv := func(t *testing.T) (*environment, error) {
+		env, err := newDefaultTestEnv(t)
+		if err != nil {
+			return nil, err
+		}
+		fsys, err := test.ReadTxtarFS(filepath.Join("testdata", "cve5_repo.txtar"))
+		if err != nil {
+			return nil, err
+		}
+		env.reportFS = fsys
+		return env, nil
+	}
+	for _, tc := range []struct {
+		*testCase
+		json, regen bool
+	}{
+		{
+			testCase: &testCase{
+				name: "ok",
+				args: []string{"30"},
+			},
+		},
+		{
+			testCase: &testCase{
+				name:        "drift",
+				args:        []string{"31"},
+				wantErr:     true,
+				expectedErr: "does not match report in: descriptions",
+			},
+		},
+		{
+			testCase: &testCase{
+				name:        "missing",
+				args:        []string{"32"},
+				wantErr:     true,
+				expectedErr: "does not exist",
+			},
+		},
+		{
+			testCase: &testCase{
+				name: "no_cve_metadata",
+				args: []string{"33"},
+			},
+		},
+		{
+			testCase: &testCase{
+				name: "regen",
+				args: []string{"31", "32"},
+			},
+			regen: true,
+		},
+		{
+			testCase: &testCase{
+				name:    "json",
+				wantErr: true,
+				// no args
+			},
+			json: true,
+		},
+	} {
+		*printJSON, *regenCVE = tc.json, tc.regen
+		runTestWithEnv(t, &verifyCVE{}, tc.testCase, newEnv)
+	}
+	*printJSON, *regenCVE = false, false
+}
+
+func TestExport(t *testing.T) {
+	for _, tc := range []*testCase{
+		{
+			name: "one",
+			args: []string{"6"},
+		},
+		{
+			name: "all",
+			// no args
+		},
+	} {
+		runTest(t, &export{}, tc)
+	}
+}
+
+func TestEnrich(t *testing.T) {
+	for _, tc := range []*testCase{
+		{
+			name: "ok",
+			args: []string{"5"},
+		},
+		{
+			name: "no_cves",
+			args: []string{"1"},
+		},
+	} {
+		runTest(t, &enrich{}, tc)
+	}
+}
+
+func TestMatch(t *testing.T) {
+	*matchSBOM = filepath.Join("testdata", "sbom.cdx.json")
+	defer func() { *matchSBOM = "" }()
+	for _, tc := range []*testCase{
+		{
+			name: "all",
+			// no args
+		},
+		{
+			name: "one",
+			args: []string{"6"},
+		},
+	} {
+		runTest(t, &match{}, tc)
+	}
+}
+
+func TestVEX(t *testing.T) {
+	*vexTime = "2026-01-02T03:04:05Z"
+	defer func() { *vexTime = "" }()
+	for _, tc := range []*testCase{
+		{
+			name: "excluded",
+			args: []string{"2", "3"},
+		},
+		{
+			name: "not_excluded",
+			args: []string{"1"},
+		},
+		{
+			name: "all",
+			// no args
+		},
+	} {
+		runTest(t, &vex{}, tc)
+	}
+}
+
+func TestDisputes(t *testing.T) {
+	newEnv := func(t *testing.T) (*environment, error) {
+		env, err := newDefaultTestEnv(t)
+		if err != nil {
+			return nil, err
+		}
+		st := store.NewMemStore()
+		if err := st.SetSymbolFeedbackRecords(context.Background(), []*store.SymbolFeedbackRecord{
+			{ReportID: "GO-9999-0005", Package: "golang.org/x/tools/go/packages", Symbol: "Load", Issues: []int{7}, Status: store.SymbolFeedbackOpen},
+			// Not a symbol of the report.
+			{ReportID: "GO-9999-0005", Package: "golang.org/x/tools/go/packages", Symbol: "Visit", Issues: []int{8}, Status: store.SymbolFeedbackOpen},
+		}); err != nil {
+			return nil, err
+		}
+		env.st = st
+		return env, nil
+	}
+	for _, tc := range []*testCase{
+		{
+			name: "disputed",
+			args: []string{"5"},
+		},
+		{
+			name: "no_feedback",
+			args: []string{"1"},
+		},
+	} {
+		runTestWithEnv(t, &disputes{}, tc, newEnv)
+	}
+}
+
 func TestTriage(t *testing.T) {
 	for _, tc := range []*testCase{
 		{
@@ -71,6 +476,27 @@ func TestTriage(t *testing.T) {
 	} {
 		runTest(t, &triage{}, tc)
 	}
+
+	// Issues are routed to the preferred reviewers of their area.
+	o, err := owners.Parse([]byte(`
+areas:
+  - name: tools
+    prefixes: [golang.org/x/tools]
+    reviewers: [alice, bob]
+  - name: vuln
+    prefixes: [golang.org/x/vuln]
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	runTestWithEnv(t, &triage{}, &testCase{name: "owners"}, func(t *testing.T) (*environment, error) {
+		env, err := newDefaultTestEnv(t)
+		if err != nil {
+			return nil, err
+		}
+		env.owners = o
+		return env, nil
+	})
 }
 
 func TestFix(t *testing.T) {
@@ -82,6 +508,59 @@ func TestFix(t *testing.T) {
 	} {
 		runTest(t, &fix{}, tc)
 	}
+
+	// The post-fix hooks edit the report and add lints.
+	*hooksFile = "vulnreport-hooks.yaml"
+	defer func() { *hooksFile = "" }()
+	runTestWithEnv(t, &fix{}, &testCase{name: "hooks", args: []string{"1"}, wantErr: true, expectedErr: "policy: description must credit the reporter"}, func(t *testing.T) (*environment, error) {
+		env, err := newDefaultTestEnv(t)
+		if err != nil {
+			return nil, err
+		}
+		env.reportFS.(fstest.MapFS)[*hooksFile] = &fstest.MapFile{Data: []byte(`hooks:
+  - name: related
+    stage: post-fix
+    command: [tools/related]
+  - name: policy
+    stage: post-fix
+    command: [tools/policy]
+`)}
+		env.hooks = &fakeHookExecutor{outputs: map[string]string{
+			"related": `{"edits": {"related": ["CVE-9999-0001"]}}`,
+			"policy":  `{"lints": ["description must credit the reporter"]}`,
+		}}
+		return env, nil
+	})
+}
+
+func TestGHSASync(t *testing.T) {
+	for _, tc := range []*testCase{
+		{
+			name: "corrections",
+			args: []string{"6"},
+		},
+		{
+			name: "unreviewed",
+			args: []string{"4"},
+		},
+	} {
+		runTest(t, &ghsaSync{}, tc)
+	}
+}
+
+func TestGHSADraft(t *testing.T) {
+	for _, tc := range []*testCase{
+		{
+			name: "not_github",
+			args: []string{"5"},
+		},
+		{
+			name: "has_ghsa",
+			args: []string{"6"},
+		},
+	} {
+		runTest(t, &ghsaDraft{}, tc)
+	}
 }
 
 func TestLint(t *testing.T) {
@@ -95,9 +574,111 @@ func TestLint(t *testing.T) {
 			args:    []string{"4"},
 			wantErr: true,
 		},
+		{
+			name:        "module_gone",
+			args:        []string{"6"},
+			wantErr:     true,
+			expectedErr: "module golang.org/x/net no longer resolves",
+		},
 	} {
 		runTest(t, &lint{}, tc)
 	}
+
+	// Lints matching lint-ignore notes are suppressed and counted.
+	runTestWithEnv(t, &lint{}, &testCase{name: "suppressed", args: []string{"4"}}, func(t *testing.T) (*environment, error) {
+		env, err := newDefaultTestEnv(t)
+		if err != nil {
+			return nil, err
+		}
+		fsys, err := test.ReadTxtarFS(filepath.Join("testdata", "lint_ignore_repo.txtar"))
+		if err != nil {
+			return nil, err
+		}
+		env.reportFS = fsys
+		return env, nil
+	})
+}
+
+func TestShow(t *testing.T) {
+	for _, tc := range []struct {
+		*testCase
+		json bool
+	}{
+		{
+			testCase: &testCase{
+				name: "reviewed",
+				args: []string{"1"},
+			},
+		},
+		{
+			testCase: &testCase{
+				name: "lints_and_no_issue",
+				args: []string{"4"},
+			},
+		},
+		{
+			testCase: &testCase{
+				name: "excluded",
+				args: []string{"2"},
+			},
+		},
+		{
+			testCase: &testCase{
+				name: "json",
+				args: []string{"6"},
+			},
+			json: true,
+		},
+	} {
+		*printJSON = tc.json
+		runTest(t, &show{}, tc.testCase)
+	}
+	*printJSON = false
+
+	// A report with a CVE record that is current.
+	runTestWithEnv(t, &show{}, &testCase{name: "cve", args: []string{"30"}}, func(t *testing.T) (*environment, error) {
+		env, err := newDefaultTestEnv(t)
+		if err != nil {
+			return nil, err
+		}
+		fsys, err := test.ReadTxtarFS(filepath.Join("testdata", "cve5_repo.txtar"))
+		if err != nil {
+			return nil, err
+		}
+		env.reportFS = fsys
+		return env, nil
+	})
+}
+
+func TestCampaign(t *testing.T) {
+	newEnv := func(t *testing.T) (*environment, error) {
+		env, err := newDefaultTestEnv(t)
+		if err != nil {
+			return nil, err
+		}
+		fsys, err := test.ReadTxtarFS(filepath.Join("testdata", "campaign_repo.txtar"))
+		if err != nil {
+			return nil, err
+		}
+		env.reportFS = fsys
+		env.dryRun = true
+		return env, nil
+	}
+	*batch = 1
+	defer func() { *batch = 0 }()
+	for _, tc := range []*testCase{
+		{
+			name: "dry_run",
+			args: []string{"normalize-cwe"},
+		},
+		{
+			name:    "unknown",
+			args:    []string{"not-a-campaign"},
+			wantErr: true,
+		},
+	} {
+		runTestWithEnv(t, &campaignCmd{}, tc, newEnv)
+	}
 }
 
 func TestOSV(t *testing.T) {
@@ -114,6 +695,74 @@ func TestOSV(t *testing.T) {
 	} {
 		runTest(t, &osvCmd{}, tc)
 	}
+
+	// With -dry-run, the OSV file is printed instead of written.
+	runTestWithEnv(t, &osvCmd{}, &testCase{name: "dry_run", args: []string{"1"}}, func(t *testing.T) (*environment, error) {
+		env, err := newDefaultTestEnv(t)
+		if err != nil {
+			return nil, err
+		}
+		env.dryRun = true
+		return env, nil
+	})
+
+	// With -stdout, the entry is printed instead of written.
+	*osvStdout = true
+	runTest(t, &osvCmd{}, &testCase{name: "stdout", args: []string{"1"}})
+	*osvStdout = false
+
+	// With -validate, the entry is only validated.
+	*osvOnlyValidate = true
+	runTest(t, &osvCmd{}, &testCase{name: "validate", args: []string{"1"}})
+	*osvOnlyValidate = false
+}
+
+func TestOSVCheckLinks(t *testing.T) {
+	newEnv := func(t *testing.T) (*environment, error) {
+		env, err := newDefaultTestEnv(t)
+		if err != nil {
+			return nil, err
+		}
+		fsys, err := test.ReadTxtarFS(filepath.Join("testdata", "links_repo.txtar"))
+		if err != nil {
+			return nil, err
+		}
+		env.reportFS = fsys
+		return env, nil
+	}
+	*checkLinks = true
+	defer func() { *checkLinks = false }()
+	for _, tc := range []struct {
+		*testCase
+		all bool
+	}{
+		{
+			testCase: &testCase{
+				name: "ok",
+				args: []string{"10", "11"},
+			},
+		},
+		{
+			testCase: &testCase{
+				name:        "broken",
+				args:        []string{"12"},
+				wantErr:     true,
+				expectedErr: "link to GO-9999-0013 is broken: is withdrawn",
+			},
+		},
+		{
+			testCase: &testCase{
+				name:        "all",
+				wantErr:     true,
+				expectedErr: "details: link to GO-9999-0015 is broken: does not exist",
+			},
+			all: true,
+		},
+	} {
+		*osvAll = tc.all
+		runTestWithEnv(t, &osvCmd{}, tc.testCase, newEnv)
+	}
+	*osvAll = false
 }
 
 func TestRegen(t *testing.T) {
@@ -124,6 +773,39 @@ func TestRegen(t *testing.T) {
 	}
 }
 
+func TestRegenDerived(t *testing.T) {
+	newEnv := func(t *testing.T) (*environment, error) {
+		env, err := newDefaultTestEnv(t)
+		if err != nil {
+			return nil, err
+		}
+		fsys, err := test.ReadTxtarFS(filepath.Join("testdata", "regen_repo.txtar"))
+		if err != nil {
+			return nil, err
+		}
+		// Derived files are written without a trailing newline,
+		// but txtar adds one.
+		for name, f := range fsys.(fstest.MapFS) {
+			if strings.HasSuffix(name, ".json") {
+				f.Data = bytes.TrimSuffix(f.Data, []byte("\n"))
+			}
+		}
+		env.reportFS = fsys
+		return env, nil
+	}
+	runTestWithEnv(t, &regenDerived{}, &testCase{
+		name: "selected",
+		args: []string{"1", "3", "6", "7"},
+	}, newEnv)
+
+	*regenBase = "HEAD"
+	defer func() { *regenBase = "origin/master" }()
+	runTestWithEnv(t, &regenDerived{}, &testCase{
+		name:    "no_changes",
+		wantErr: true,
+	}, newEnv)
+}
+
 func TestSetDates(t *testing.T) {
 	for _, tc := range []*testCase{
 		// TODO(tatianabradley): add test cases
@@ -132,6 +814,23 @@ func TestSetDates(t *testing.T) {
 	}
 }
 
+func TestStats(t *testing.T) {
+	*statsTooling = true
+	defer func() { *statsTooling = false }()
+	for _, tc := range []*testCase{
+		{
+			name: "tooling",
+			args: []string{filepath.Join("testdata", "telemetry.jsonl")},
+		},
+		{
+			name:    "no_file",
+			wantErr: true,
+		},
+	} {
+		runTest(t, &statsCmd{}, tc)
+	}
+}
+
 func TestSuggest(t *testing.T) {
 	for _, tc := range []*testCase{
 		// TODO(tatianabradley): add test cases
@@ -156,11 +855,30 @@ func TestSymbols(t *testing.T) {
 }
 
 func TestUnexclude(t *testing.T) {
-	for _, tc := range []*testCase{
-		// TODO(tatianabradley): add test cases
+	for _, tc := range []struct {
+		*testCase
+		reason, modulePrefix string
+	}{
+		// TODO(tatianabradley): add test cases that create reports
+		{
+			testCase: &testCase{
+				name:    "no_match",
+				wantErr: true,
+			},
+			reason: "NOT_A_VULNERABILITY",
+		},
+		{
+			testCase: &testCase{
+				name:    "invalid_reason",
+				wantErr: true,
+			},
+			reason: "NOT_A_REASON",
+		},
 	} {
-		runTest(t, &unexclude{}, tc)
+		*reason, *modulePrefix = tc.reason, tc.modulePrefix
+		runTest(t, &unexclude{}, tc.testCase)
 	}
+	*reason, *modulePrefix = "", ""
 }
 
 func TestXref(t *testing.T) {
@@ -173,6 +891,18 @@ func TestXref(t *testing.T) {
 			name: "found_xrefs",
 			args: []string{"4"},
 		},
+		{
+			name: "commit",
+			args: []string{"https://github.com/golang/tools/commit/0123456789abcdef0123456789abcdef01234567", "0123456"},
+		},
+		{
+			name: "symbol",
+			args: []string{"packages.Load", "Unknown"},
+		},
+		{
+			name: "text",
+			args: []string{"problem tools", "no such text"},
+		},
 	} {
 		runTest(t, &xref{}, tc)
 	}
@@ -0,0 +1,70 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+
+	"github.com/go-git/go-git/v5"
+	"golang.org/x/vulndb/cmd/vulnreport/log"
+	"golang.org/x/vulndb/internal/proxy"
+	"golang.org/x/vulndb/internal/report"
+)
+
+type whatIf struct {
+	repo *git.Repository
+	pxc  *proxy.Client
+
+	*filenameParser
+	noSkip
+}
+
+func (whatIf) name() string { return "what-if" }
+
+func (whatIf) usage() (string, string) {
+	const desc = "shows which versions known to the proxy the version range changes to YAML reports (since HEAD) would newly flag or unflag"
+	return filenameArgs, desc
+}
+
+func (whatIf) capabilities() capability { return capReadRepo | capNetwork }
+
+func (w *whatIf) setup(ctx context.Context, env environment) error {
+	repo, err := env.ReportRepo(ctx)
+	if err != nil {
+		return err
+	}
+	w.repo = repo
+	w.pxc = env.ProxyClient()
+	w.filenameParser = new(filenameParser)
+	return setupAll(ctx, env, w.filenameParser)
+}
+
+func (w *whatIf) close() error { return nil }
+
+// run prints the impact of the changes to the version ranges of
+// the report since the HEAD commit, for each changed module.
+func (w *whatIf) run(_ context.Context, input any) error {
+	r := input.(*yamlReport)
+	old, err := headReport(w.repo, r.Filename)
+	if err != nil {
+		return err
+	}
+	if old == nil {
+		log.Infof("%s: not in HEAD, so there are no changes to compare", r.ID)
+		return nil
+	}
+	impacts, err := report.RangeImpacts(old, r.Report, w.pxc)
+	if err != nil {
+		return err
+	}
+	if len(impacts) == 0 {
+		log.Infof("%s: no changes to the affected versions", r.ID)
+		return nil
+	}
+	for _, ri := range impacts {
+		log.Outf("%s: %s", r.ID, ri)
+	}
+	return nil
+}
@@ -8,15 +8,20 @@ import (
 	"context"
 	"flag"
 	"fmt"
+	"strings"
 	"time"
 
 	"golang.org/x/vulndb/internal/osv"
 	"golang.org/x/vulndb/internal/report"
 )
 
-var reason = flag.String("reason", "", "the reason this report is being withdrawn")
+var (
+	reason      = flag.String("reason", "", "for withdraw, the reason this report is being withdrawn: one of DUPLICATE, NOT_A_VULNERABILITY, UPSTREAM_WITHDRAWN or CREATED_IN_ERROR; for unexclude, with no arguments, promote all excluded reports with this excluded reason")
+	explanation = flag.String("explanation", "", "for withdraw, an optional explanation of the reason, added to the description")
+)
 
 type withdraw struct {
+	reason report.WithdrawnReason
 	*fixer
 	*filenameParser
 }
@@ -24,14 +29,18 @@ type withdraw struct {
 func (withdraw) name() string { return "withdraw" }
 
 func (withdraw) usage() (string, string) {
-	const desc = "withdraws a report"
+	const desc = "withdraws a report, updating its YAML, OSV and (for Go CNA reports) CVE record"
 	return filenameArgs, desc
 }
 
+func (withdraw) capabilities() capability { return capReadRepo | capWriteFiles | capNetwork }
+
 func (w *withdraw) setup(ctx context.Context, env environment) error {
-	if *reason == "" {
-		return fmt.Errorf("flag -reason must be provided")
+	wr, ok := report.ToWithdrawnReason(*reason)
+	if !ok {
+		return fmt.Errorf("flag -reason must be one of %v", report.WithdrawnReasons)
 	}
+	w.reason = wr
 	w.fixer = new(fixer)
 	w.filenameParser = new(filenameParser)
 	return setupAll(ctx, env, w.fixer, w.filenameParser)
@@ -52,19 +61,23 @@ func (w *withdraw) skip(input any) string {
 		return "already withdrawn"
 	}
 
-	if r.CVEMetadata != nil {
-		return "withdrawing Go-published report not yet supported"
-	}
-
 	return ""
 }
 
 func (w *withdraw) run(ctx context.Context, input any) (err error) {
 	r := input.(*yamlReport)
 	r.Withdrawn = &osv.Time{Time: time.Now()}
+	r.WithdrawnReason = w.reason
 	r.Summary = "WITHDRAWN: " + r.Summary
 	r.Description = report.Description(
-		fmt.Sprintf("(This report has been withdrawn with reason: %q). %s",
-			*reason, r.Description))
+		fmt.Sprintf("(%s) %s", withdrawnNote(w.reason, *explanation), r.Description))
 	return w.fixAndWriteAll(ctx, r, false)
 }
+
+func withdrawnNote(wr report.WithdrawnReason, explanation string) string {
+	note := "This report has been withdrawn because " + wr.Text()
+	if explanation != "" {
+		note += ": " + strings.TrimSuffix(explanation, ".")
+	}
+	return note + "."
+}
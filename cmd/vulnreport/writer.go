@@ -8,6 +8,8 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
+	"io/fs"
 	"os"
 	"path/filepath"
 	"time"
@@ -89,6 +91,8 @@ func ok(fname string, modified bool) error {
 // a simple representation of a writeable file system
 type wfs interface {
 	WriteFile(string, []byte) (bool, error)
+	// RemoveFile removes the file, reporting whether it existed.
+	RemoveFile(string) (bool, error)
 }
 
 type defaultWFS struct{}
@@ -102,3 +106,11 @@ func (defaultWFS) WriteFile(filename string, b []byte) (bool, error) {
 	}
 	return true, os.WriteFile(filename, b, 0644)
 }
+
+func (defaultWFS) RemoveFile(filename string) (bool, error) {
+	err := os.Remove(filename)
+	if errors.Is(err, fs.ErrNotExist) {
+		return false, nil
+	}
+	return err == nil, err
+}
@@ -8,6 +8,8 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"path/filepath"
+	"strings"
 
 	"golang.org/x/vulndb/cmd/vulnreport/log"
 	"golang.org/x/vulndb/internal/report"
@@ -18,26 +20,72 @@ type xref struct {
 	*xrefer
 	*filenameParser
 	noSkip
+
+	// args that are not reports, to be looked up as
+	// commits, symbols or text
+	queries map[string]bool
 }
 
 func (xref) name() string { return "xref" }
 
 func (xref) usage() (string, string) {
-	const desc = "prints cross references for YAML reports"
-	return filenameArgs, desc
+	const desc = "prints cross references for YAML reports, or lists the reports that reference a fix commit (URL or hash) or vulnerable symbol, or that contain the given text"
+	return "[filename | github-id | commit | symbol | text] ...", desc
 }
 
+func (xref) capabilities() capability { return capReadRepo }
+
 func (x *xref) setup(ctx context.Context, env environment) error {
 	x.xrefer = new(xrefer)
 	x.filenameParser = new(filenameParser)
+	x.queries = make(map[string]bool)
 	return setupAll(ctx, env, x.xrefer, x.filenameParser)
 }
 
 func (x *xref) close() error { return nil }
 
+// parseArgs treats any argument that does not refer to a report
+// as a commit, symbol or text to look up.
+func (x *xref) parseArgs(ctx context.Context, args []string) (inputs []string, _ error) {
+	if len(args) == 0 {
+		return x.filenameParser.parseArgs(ctx, args)
+	}
+	for _, arg := range args {
+		fname, err := argToFilename(arg, x.fsys)
+		if err == nil {
+			inputs = append(inputs, fname)
+			continue
+		}
+		if strings.HasSuffix(arg, ".yaml") {
+			log.Err(err)
+			continue
+		}
+		x.queries[arg] = true
+		inputs = append(inputs, arg)
+	}
+	if len(inputs) == 0 {
+		return nil, fmt.Errorf("could not parse any valid arguments")
+	}
+	return inputs, nil
+}
+
+func (x *xref) lookup(ctx context.Context, input string) (any, error) {
+	if x.queries[input] {
+		return xrefQuery(input), nil
+	}
+	return x.filenameParser.lookup(ctx, input)
+}
+
+// xrefQuery is a commit (URL or hash), symbol name or
+// text to find references to.
+type xrefQuery string
+
 // xref returns cross-references for a report (information about other reports
 // for the same CVE, GHSA, or module), and the priority of a report.
 func (x *xref) run(ctx context.Context, input any) (err error) {
+	if q, ok := input.(xrefQuery); ok {
+		return x.query(q)
+	}
 	r := input.(*yamlReport)
 
 	if xrefs := x.xref(r); len(xrefs) > 0 {
@@ -55,6 +103,48 @@ func (x *xref) run(ctx context.Context, input any) (err error) {
 	return nil
 }
 
+// query prints the reports that reference the commit or symbol q.
+// If there are none, it prints the reports that contain q as text.
+func (x *xref) query(q xrefQuery) error {
+	found := false
+	for _, match := range []struct {
+		kind string
+		rs   []*report.Report
+	}{
+		{"commit", x.rc.ReportsByCommit(string(q))},
+		{"symbol", x.rc.ReportsBySymbol(string(q))},
+	} {
+		if len(match.rs) == 0 {
+			continue
+		}
+		found = true
+		if err := printQueryMatches(q, "referencing "+match.kind, match.rs); err != nil {
+			return err
+		}
+	}
+	if found {
+		return nil
+	}
+	if rs := x.rc.Search(string(q)); len(rs) > 0 {
+		return printQueryMatches(q, "matching text", rs)
+	}
+	log.Infof("%s: no reports reference this commit or symbol, or contain this text", q)
+	return nil
+}
+
+func printQueryMatches(q xrefQuery, kind string, rs []*report.Report) error {
+	var fnames []string
+	for _, r := range rs {
+		fname, err := r.YAMLFilename()
+		if err != nil {
+			return err
+		}
+		fnames = append(fnames, filepath.ToSlash(fname))
+	}
+	log.Outf("%s: found %d report(s) %s:%s%s", q, len(fnames), kind, listItem, strings.Join(fnames, listItem))
+	return nil
+}
+
 func (x *xrefer) setup(ctx context.Context, env environment) (err error) {
 	repo, err := env.ReportRepo(ctx)
 	if err != nil {
@@ -66,18 +156,26 @@ func (x *xrefer) setup(ctx context.Context, env environment) (err error) {
 	}
 	x.rc = rc
 
-	mm, err := env.ModuleMap()
+	mm, err := env.ModuleMap(ctx)
+	if err != nil {
+		return err
+	}
+	h, err := env.ModuleHistory()
+	if err != nil {
+		return err
+	}
+	m, err := env.PriorityModel()
 	if err != nil {
 		return err
 	}
-	x.moduleMap = mm
+	x.priorityInputs = &priority.Inputs{Model: m, ModulesToImports: mm, History: h, KEV: env.KEV(ctx)}
 
 	return nil
 }
 
 type xrefer struct {
-	rc        *report.Client
-	moduleMap map[string]int
+	rc             *report.Client
+	priorityInputs *priority.Inputs
 }
 
 func (x *xrefer) xref(r *yamlReport) string {
@@ -86,10 +184,10 @@ func (x *xrefer) xref(r *yamlReport) string {
 	return x.rc.XRef(r.Report).ToString(aliasTitle, moduleTitle, "")
 }
 
-func (x *xrefer) modulePriority(modulePath string) (*priority.Result, *priority.NotGoResult) {
-	return priority.Analyze(modulePath, math.MaxInt, x.rc.ReportsByModule(modulePath), x.moduleMap)
+func (x *xrefer) modulesPriority(modulePaths, aliases []string) (*priority.Result, *priority.NotGoResult) {
+	return priority.AnalyzeModules(modulePaths, aliases, math.MaxInt, x.rc, x.priorityInputs)
 }
 
 func (x *xrefer) reportPriority(r *report.Report) (*priority.Result, *priority.NotGoResult) {
-	return priority.AnalyzeReport(r, x.rc, x.moduleMap)
+	return priority.AnalyzeReport(r, x.rc, x.priorityInputs)
 }
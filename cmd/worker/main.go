@@ -20,13 +20,20 @@ import (
 	"text/tabwriter"
 	"time"
 
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
 	"golang.org/x/vulndb/internal/cvelistrepo"
+	"golang.org/x/vulndb/internal/epss"
+	"golang.org/x/vulndb/internal/genericosv"
 	"golang.org/x/vulndb/internal/ghsa"
 	"golang.org/x/vulndb/internal/gitrepo"
 	"golang.org/x/vulndb/internal/issues"
+	"golang.org/x/vulndb/internal/kev"
+	"golang.org/x/vulndb/internal/nvd"
 	"golang.org/x/vulndb/internal/pkgsite"
 	"golang.org/x/vulndb/internal/proxy"
 	"golang.org/x/vulndb/internal/report"
+	"golang.org/x/vulndb/internal/secrets"
 	"golang.org/x/vulndb/internal/worker"
 	"golang.org/x/vulndb/internal/worker/log"
 	"golang.org/x/vulndb/internal/worker/store"
@@ -41,6 +48,16 @@ var (
 	githubTokenFile = flag.String("ghtokenfile", "",
 		"path to file containing GitHub access token (for creating issues)")
 	knownModuleFile = flag.String("known-module-file", "", "file with list of all known modules")
+	nvdWindow       = flag.Duration("nvd-window", 7*24*time.Hour, "for scan-nvd, how far back to look for modified CVEs")
+	osvWindow       = flag.Duration("osv-window", 7*24*time.Hour, "for scan-osv, how far back to look for modified OSV entries")
+	cveSource       = flag.String("cve-source", worker.CVESourceServices, "for reconcile-cves, sync-cve-publications and retriage-cves, where to read published CVE records from: cve-services or cvelist (the cvelistV5 repo, or the -local-cve-repo clone of it); for update-provenance, cvelist also reads the cvelistV5 repo")
+	replayOffline   = flag.Bool("offline", false, "for replay-decision and recompute-triage, treat module paths that were not recorded as unknown instead of asking pkgsite")
+	localVulnDBRepo = flag.String("local-vulndb-repo", "", "for regenerate-db, path to a local clone of the vulndb repo (with its history), instead of cloning remote")
+	existingDB      = flag.String("existing-db", "", "for regenerate-db, directory holding the deployed database to validate against, instead of downloading it from -vuln-db")
+	publishDir      = flag.String("publish-dir", "", "for regenerate-db, directory to publish the database to, instead of the -db-bucket bucket")
+	allowAnomalies  = flag.Bool("allow-anomalies", false, "for regenerate-db, publish the database even if it has anomalies compared with the deployed one")
+	fileReReviews   = flag.Bool("file-issues", false, "for sync-ghsa-reviews and import-finding-stats, file issues in -issue-repo for the reports to re-review (import-finding-stats files at most -limit); for sync-kev, escalate the open issues of known-exploited vulnerabilities and file issues for their unreviewed reports")
+	secretsSpec     = flag.String("secrets", "env", "where to read secrets (github-token, nvd-api-key, worker-api-token) from: env (environment variables), file:DIR or gcp:PROJECT")
 )
 
 // Config for both the server and the command-line tool.
@@ -52,6 +69,10 @@ func init() {
 	flag.BoolVar(&cfg.UseErrorReporting, "report-errors", os.Getenv("VULN_WORKER_REPORT_ERRORS") == "true",
 		"use the error reporting API")
 	flag.StringVar(&cfg.IssueRepo, "issue-repo", os.Getenv("VULN_WORKER_ISSUE_REPO"), "repo to create issues in")
+	flag.StringVar(&cfg.ConfigFile, "config-file", os.Getenv("VULN_WORKER_CONFIG_FILE"), "JSON file with runtime settings, loaded into the store at startup and on /reload-config")
+	flag.StringVar(&cfg.VulnDBURL, "vuln-db", os.Getenv("VULN_WORKER_VULN_DB"), "URL of the vulnerability database whose added and modified entries notify-osv sends notifications about (default "+worker.DefaultVulnDBURL+")")
+	flag.StringVar(&cfg.DBBucket, "db-bucket", os.Getenv("VULN_WORKER_DB_BUCKET"), "Cloud Storage bucket that regenerate-db publishes the vulnerability database to (if empty, the database is generated and validated, but not published)")
+	flag.StringVar(&cfg.NVDAPIKey, "nvd-api-key", "", "NVD API key (optional; raises the NVD rate limit; default: the nvd-api-key secret)")
 }
 
 func main() {
@@ -66,28 +87,41 @@ func main() {
 		fmt.Fprintln(out, "    update COMMIT: perform an update operation")
 		fmt.Fprintln(out, "    list-updates: display info about update operations")
 		fmt.Fprintln(out, "    list-cves TRIAGE_STATE: display info about CVE records")
+		fmt.Fprintln(out, "    scan-nvd: mark CVEs that NVD CPE data says affect Go as needing issues")
+		fmt.Fprintln(out, "    scan-osv [ECOSYSTEM ...]: mark CVEs of OSV.dev advisories in other ecosystems that refer to Go modules as needing issues")
 		fmt.Fprintln(out, "    create-issues: create issues for CVEs that need them")
+		fmt.Fprintln(out, "    reconcile-cves: check that the published records of the Go CNA's CVEs match their reports")
+		fmt.Fprintln(out, "    sync-cve-publications: record the publication state of the Go CNA's CVEs in the store")
+		fmt.Fprintln(out, "    sync-ghsa-reviews: record which GHSAs of reviewed reports say more than the reports (use -file-issues to file re-review issues)")
+		fmt.Fprintln(out, "    sync-kev: record which reports and open issues have CVEs in CISA's catalog of Known Exploited Vulnerabilities (use -file-issues to escalate them)")
+		fmt.Fprintln(out, "    refresh-epss: record the current EPSS scores of the reports and which reports have out-of-date scores")
+		fmt.Fprintln(out, "    import-finding-stats FILE|URL: record aggregated govulncheck findings for each report and flag those found unusually often or never (use -file-issues to file re-review issues)")
+		fmt.Fprintln(out, "    refresh-issues: update the modules, aliases and references in the open issues of CVEs and GHSAs that changed since they were filed")
+		fmt.Fprintln(out, "    retriage-cves: re-file CVEs triaged as not Go whose records now refer to Go modules")
+		fmt.Fprintln(out, "    update-provenance: record which sources have copies of the records of CVEs that need issues")
+		fmt.Fprintln(out, "    notify-osv: notify the notification targets of OSV entries added or modified since the last notification")
+		fmt.Fprintln(out, "    regenerate-db: regenerate the vulnerability database from the vulndb repo, validate it and publish it (use -force to regenerate an unchanged repo)")
+		fmt.Fprintln(out, "    sync-issues: mirror the issue tracker's issues into the store (use -force for a full sync)")
+		fmt.Fprintln(out, "    process-intake: answer public reports of vulnerabilities missing from the database")
+		fmt.Fprintln(out, "    process-symbol-feedback: record feedback that symbols listed in reports are not vulnerable")
+		fmt.Fprintln(out, "    set-config FILE: replace the runtime settings in the store with those in the JSON file")
+		fmt.Fprintln(out, "    show-config: display the runtime settings and their recent changes")
+		fmt.Fprintln(out, "    replay-decision CVE-ID: re-run the decision that a CVE does not affect Go, from its recorded inputs")
+		fmt.Fprintln(out, "    recompute-triage: re-run the current triage logic on all CVEs and GHSAs in the store, and display those whose disposition would change")
 		fmt.Fprintln(out, "    show ID1 ID2 ...: display CVE records")
 		fmt.Fprintln(out, "flags:")
 		flag.PrintDefaults()
 	}
 
 	flag.Parse()
-	if *githubTokenFile != "" {
-		data, err := os.ReadFile(*githubTokenFile)
-		if err != nil {
-			die("%v", err)
-		}
-		cfg.GitHubAccessToken = strings.TrimSpace(string(data))
-	} else {
-		cfg.GitHubAccessToken = os.Getenv("VULN_GITHUB_ACCESS_TOKEN")
+	ctx := context.Background()
+	if err := readSecrets(ctx); err != nil {
+		die("%v", err)
 	}
 	if err := cfg.Validate(); err != nil {
 		dieWithUsage("%v", err)
 	}
 
-	ctx := context.Background()
-
 	if img := os.Getenv("DOCKER_IMAGE"); img != "" {
 		log.Infof(ctx, "running in docker image %s", img)
 	}
@@ -133,8 +167,57 @@ func runCommandLine(ctx context.Context) error {
 			return errors.New("usage: update COMMIT")
 		}
 		return updateCommand(ctx, flag.Arg(1))
+	case "scan-nvd":
+		return scanNVDCommand(ctx)
+	case "scan-osv":
+		return scanOSVCommand(ctx, flag.Args()[1:])
 	case "create-issues":
 		return createIssuesCommand(ctx)
+	case "reconcile-cves":
+		return reconcileCVEsCommand(ctx)
+	case "sync-cve-publications":
+		return syncCVEPublicationsCommand(ctx)
+	case "sync-ghsa-reviews":
+		return syncGHSAReviewsCommand(ctx)
+	case "sync-kev":
+		return syncKEVCommand(ctx)
+	case "refresh-epss":
+		return refreshEPSSCommand(ctx)
+	case "import-finding-stats":
+		if flag.NArg() != 2 {
+			return errors.New("usage: import-finding-stats FILE|URL")
+		}
+		return importFindingStatsCommand(ctx, flag.Arg(1))
+	case "refresh-issues":
+		return refreshIssuesCommand(ctx)
+	case "retriage-cves":
+		return retriageCVEsCommand(ctx)
+	case "update-provenance":
+		return updateProvenanceCommand(ctx)
+	case "notify-osv":
+		return notifyOSVCommand(ctx)
+	case "regenerate-db":
+		return regenerateDBCommand(ctx)
+	case "sync-issues":
+		return syncIssuesCommand(ctx)
+	case "process-intake":
+		return processIntakeCommand(ctx)
+	case "process-symbol-feedback":
+		return processSymbolFeedbackCommand(ctx)
+	case "set-config":
+		if flag.NArg() != 2 {
+			return errors.New("usage: set-config FILE")
+		}
+		return setConfigCommand(ctx, flag.Arg(1))
+	case "show-config":
+		return showConfigCommand(ctx)
+	case "recompute-triage":
+		return recomputeTriageCommand(ctx)
+	case "replay-decision":
+		if flag.NArg() != 2 {
+			return errors.New("usage: replay-decision CVE-ID")
+		}
+		return replayDecisionCommand(ctx, flag.Arg(1))
 	case "show":
 		return showCommand(ctx, flag.Args()[1:])
 	default:
@@ -193,7 +276,7 @@ func updateCommand(ctx context.Context, commitHash string) error {
 	if *localRepoPath != "" {
 		repoPath = *localRepoPath
 	}
-	pc := pkgsite.Default()
+	pc := pkgsite.Default(pkgsite.WithProxyFallback(proxy.NewDefaultClient()))
 	if *knownModuleFile != "" {
 		known, err := readKnownModules(*knownModuleFile)
 		if err != nil {
@@ -226,6 +309,38 @@ func updateCommand(ctx context.Context, commitHash string) error {
 	return err
 }
 
+// readSecrets fills in the secrets in cfg that were not given as flags.
+// All of them are optional; commands that need one report its absence.
+func readSecrets(ctx context.Context) error {
+	if *githubTokenFile != "" {
+		data, err := os.ReadFile(*githubTokenFile)
+		if err != nil {
+			return err
+		}
+		cfg.GitHubAccessToken = strings.TrimSpace(string(data))
+	}
+	sp, cleanup, err := secrets.FromSpec(ctx, *secretsSpec)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	for name, v := range map[string]*string{
+		secrets.GitHubToken:    &cfg.GitHubAccessToken,
+		secrets.NVDAPIKey:      &cfg.NVDAPIKey,
+		secrets.WorkerAPIToken: &cfg.APIToken,
+	} {
+		if *v != "" {
+			continue
+		}
+		s, err := sp.Get(ctx, name)
+		if err != nil && !errors.Is(err, secrets.ErrNotFound) {
+			return err
+		}
+		*v = s
+	}
+	return nil
+}
+
 func readKnownModules(filename string) ([]string, error) {
 	f, err := os.Open(filename)
 	if err != nil {
@@ -249,12 +364,320 @@ func readKnownModules(filename string) ([]string, error) {
 	return mods, nil
 }
 
+func scanNVDCommand(ctx context.Context) error {
+	rc, err := report.NewDefaultClient(ctx)
+	if err != nil {
+		return err
+	}
+	nvdClient := nvd.NewClient(cfg.NVDAPIKey)
+	listCVEs := func(ctx context.Context, since time.Time) ([]*nvd.CVE, error) {
+		return nvdClient.List(ctx, since)
+	}
+	stats, err := worker.ScanNVD(ctx, listCVEs, time.Now().Add(-*nvdWindow), cfg.Store, proxy.NewDefaultClient(), rc)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("%d CVEs processed, %d refer to Go, %d marked as needing issues\n",
+		stats.NumProcessed, stats.NumGo, stats.NumNeedsIssue)
+	return nil
+}
+
+func scanOSVCommand(ctx context.Context, args []string) error {
+	rc, err := report.NewDefaultClient(ctx)
+	if err != nil {
+		return err
+	}
+	ecosystems := worker.DefaultOSVScanEcosystems
+	if len(args) > 0 {
+		ecosystems = nil
+		for _, a := range args {
+			ecosystems = append(ecosystems, genericosv.Ecosystem(a))
+		}
+	}
+	list := func(ctx context.Context, eco genericosv.Ecosystem) ([]*genericosv.Entry, error) {
+		return genericosv.ListEcosystem(ctx, http.DefaultClient, eco)
+	}
+	pc := pkgsite.Default(pkgsite.WithProxyFallback(proxy.NewDefaultClient()))
+	stats, err := worker.ScanOSV(ctx, list, ecosystems, time.Now().Add(-*osvWindow), cfg.Store, pc, rc)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("%d OSV entries processed, %d refer to Go, %d CVEs marked as needing issues\n",
+		stats.NumProcessed, stats.NumGo, stats.NumNeedsIssue)
+	return nil
+}
+
+func reconcileCVEsCommand(ctx context.Context) error {
+	rc, err := report.NewDefaultClient(ctx)
+	if err != nil {
+		return err
+	}
+	published, err := worker.NewCVERecordFunc(ctx, *cveSource, *localRepoPath)
+	if err != nil {
+		return err
+	}
+	stats, ds, err := worker.ReconcileCVEs(ctx, published, rc)
+	if err != nil {
+		return err
+	}
+	for _, d := range ds {
+		fmt.Println(d)
+	}
+	fmt.Printf("%d CVEs checked, %d ok, %d not published, %d owned by other CNAs, %d with differing records\n",
+		stats.NumChecked, stats.NumOK, stats.NumUnpublished, stats.NumOtherCNA, stats.NumDiffering)
+	return nil
+}
+
+func syncCVEPublicationsCommand(ctx context.Context) error {
+	rc, err := report.NewDefaultClient(ctx)
+	if err != nil {
+		return err
+	}
+	published, err := worker.NewCVERecordFunc(ctx, *cveSource, *localRepoPath)
+	if err != nil {
+		return err
+	}
+	stats, err := worker.SyncCVEPublications(ctx, published, rc, cfg.Store)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("%d CVEs synced, %d changed state, %d need attention\n",
+		stats.NumSynced, stats.NumChanged, stats.NumNeedsAttention)
+	return nil
+}
+
+func syncGHSAReviewsCommand(ctx context.Context) error {
+	if cfg.GitHubAccessToken == "" {
+		return &secrets.MissingError{Names: []string{secrets.GitHubToken}, Hint: "set it with -ghtokenfile or -secrets"}
+	}
+	var client *issues.Client
+	if *fileReReviews {
+		if cfg.IssueRepo == "" {
+			return errors.New("need -issue-repo")
+		}
+		owner, repoName, err := gitrepo.ParseGitHubRepo(cfg.IssueRepo)
+		if err != nil {
+			return err
+		}
+		client = issues.NewClient(ctx, &issues.Config{Owner: owner, Repo: repoName, Token: cfg.GitHubAccessToken})
+	}
+	rc, err := report.NewDefaultClient(ctx)
+	if err != nil {
+		return err
+	}
+	ghsaClient := ghsa.NewClient(ctx, cfg.GitHubAccessToken)
+	stats, err := worker.SyncGHSAReviews(ctx, ghsaClient.FetchGHSA, rc, cfg.Store, client)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("%d GHSAs checked: %d unchanged since review, %d diverging (%d newly), %d issues filed\n",
+		stats.NumChecked, stats.NumUnchanged, stats.NumDiverging, stats.NumNewlyDiverging, stats.NumIssues)
+	return nil
+}
+
+func refreshIssuesCommand(ctx context.Context) error {
+	if cfg.IssueRepo == "" {
+		return errors.New("need -issue-repo")
+	}
+	if cfg.GitHubAccessToken == "" {
+		return &secrets.MissingError{Names: []string{secrets.GitHubToken}, Hint: "set it with -ghtokenfile or -secrets"}
+	}
+	owner, repoName, err := gitrepo.ParseGitHubRepo(cfg.IssueRepo)
+	if err != nil {
+		return err
+	}
+	client := issues.NewClient(ctx, &issues.Config{Owner: owner, Repo: repoName, Token: cfg.GitHubAccessToken})
+	stats, err := worker.RefreshIssues(ctx, cfg.Store, client, proxy.NewDefaultClient())
+	if err != nil {
+		return err
+	}
+	fmt.Printf("%d issues checked: %d skipped, %d unchanged, %d refreshed\n",
+		stats.NumChecked, stats.NumSkipped, stats.NumUnchanged, stats.NumRefreshed)
+	return nil
+}
+
+func syncKEVCommand(ctx context.Context) error {
+	var client *issues.Client
+	if *fileReReviews {
+		if cfg.GitHubAccessToken == "" {
+			return &secrets.MissingError{Names: []string{secrets.GitHubToken}, Hint: "set it with -ghtokenfile or -secrets"}
+		}
+		if cfg.IssueRepo == "" {
+			return errors.New("need -issue-repo")
+		}
+		owner, repoName, err := gitrepo.ParseGitHubRepo(cfg.IssueRepo)
+		if err != nil {
+			return err
+		}
+		client = issues.NewClient(ctx, &issues.Config{Owner: owner, Repo: repoName, Token: cfg.GitHubAccessToken})
+	}
+	rc, err := report.NewDefaultClient(ctx)
+	if err != nil {
+		return err
+	}
+	stats, err := worker.SyncKEV(ctx, kev.NewClient().Fetch, rc, cfg.Store, client)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("%d KEV CVEs: %d match reports or open issues (%d newly), %d issues escalated, %d issues filed\n",
+		stats.NumCVEs, stats.NumMatched, stats.NumNewlyMatched, stats.NumEscalated, stats.NumIssues)
+	return nil
+}
+
+func refreshEPSSCommand(ctx context.Context) error {
+	rc, err := report.NewDefaultClient(ctx)
+	if err != nil {
+		return err
+	}
+	stats, err := worker.RefreshEPSS(ctx, epss.NewClient().Scores, rc, cfg.Store)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("%d reports with CVEs: %d scored, %d with out-of-date scores (update them with vulnreport enrich)\n",
+		stats.NumReports, stats.NumScored, stats.NumStale)
+	return nil
+}
+
+func importFindingStatsCommand(ctx context.Context, src string) error {
+	var client *issues.Client
+	if *fileReReviews {
+		if cfg.GitHubAccessToken == "" {
+			return &secrets.MissingError{Names: []string{secrets.GitHubToken}, Hint: "set it with -ghtokenfile or -secrets"}
+		}
+		if cfg.IssueRepo == "" {
+			return errors.New("need -issue-repo")
+		}
+		owner, repoName, err := gitrepo.ParseGitHubRepo(cfg.IssueRepo)
+		if err != nil {
+			return err
+		}
+		client = issues.NewClient(ctx, &issues.Config{Owner: owner, Repo: repoName, Token: cfg.GitHubAccessToken})
+	}
+	fs, err := worker.ReadFindingStats(ctx, http.DefaultClient, src)
+	if err != nil {
+		return err
+	}
+	rc, err := report.NewDefaultClient(ctx)
+	if err != nil {
+		return err
+	}
+	stats, err := worker.ImportFindingStats(ctx, fs, rc, cfg.Store, client, *limit)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("%d reports imported: %d over-matched, %d never matched, %d never called (%d newly flagged), %d issues filed\n",
+		stats.NumReports, stats.NumAnomalies[store.FindingAnomalyOverMatched], stats.NumAnomalies[store.FindingAnomalyNeverMatched],
+		stats.NumAnomalies[store.FindingAnomalyNeverCalled], stats.NumNewAnomalies, stats.NumIssues)
+	return nil
+}
+
+func regenerateDBCommand(ctx context.Context) error {
+	var (
+		repo *git.Repository
+		err  error
+	)
+	if *localVulnDBRepo != "" {
+		repo, err = gitrepo.Open(ctx, *localVulnDBRepo)
+	} else {
+		repo, err = gitrepo.CloneWithHistory(ctx, report.VulndbURL)
+	}
+	if err != nil {
+		return err
+	}
+	existing := worker.DownloadDB(http.DefaultClient, cfg.VulnDBURL)
+	if *existingDB != "" {
+		existing = worker.LocalDB(*existingDB)
+	}
+	var pub worker.DBPublisher
+	switch {
+	case *publishDir != "":
+		pub = worker.DirPublisher(*publishDir)
+	case cfg.DBBucket != "":
+		pub, err = worker.NewGCSPublisher(ctx, cfg.DBBucket)
+		if err != nil {
+			return err
+		}
+	}
+	stats, err := worker.RegenerateDB(ctx, repo, existing, pub, cfg.Store, *force, *allowAnomalies)
+	if err != nil {
+		return err
+	}
+	if stats.Skipped {
+		fmt.Println("database already generated from the repo head; use -force to regenerate it")
+		return nil
+	}
+	fmt.Printf("%d entries, %d files (%d bytes), manifest digest %s, %d anomalies, published: %t\n",
+		stats.NumEntries, stats.NumFiles, stats.Size, stats.Digest, stats.NumAnomalies, stats.Published)
+	return nil
+}
+
+func retriageCVEsCommand(ctx context.Context) error {
+	rc, err := report.NewDefaultClient(ctx)
+	if err != nil {
+		return err
+	}
+	published, err := worker.NewCVERecordFunc(ctx, *cveSource, *localRepoPath)
+	if err != nil {
+		return err
+	}
+	pc := pkgsite.Default(pkgsite.WithProxyFallback(proxy.NewDefaultClient()))
+	stats, err := worker.RetriageDismissedCVEs(ctx, cfg.Store, published, pc, rc)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("%d dismissed CVEs checked, %d unchanged, %d still not Go, %d marked as needing issues\n",
+		stats.NumChecked, stats.NumUnchanged, stats.NumStillDismissed, stats.NumRefiled)
+	return nil
+}
+
+func updateProvenanceCommand(ctx context.Context) error {
+	var commit *object.Commit
+	if *cveSource == worker.CVESourceList {
+		var (
+			repo *git.Repository
+			err  error
+		)
+		if *localRepoPath != "" {
+			repo, err = gitrepo.Open(ctx, *localRepoPath)
+		} else {
+			repo, err = cvelistrepo.Clone(ctx, cvelistrepo.URLv5, "")
+		}
+		if err != nil {
+			return err
+		}
+		if commit, err = gitrepo.HeadCommit(repo); err != nil {
+			return err
+		}
+	}
+	sources, err := worker.ProvenanceSources(ctx, cfg.Store, nvd.NewClient(cfg.NVDAPIKey), commit)
+	if err != nil {
+		return err
+	}
+	stats, err := worker.UpdateProvenance(ctx, cfg.Store, sources)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("%d CVEs checked, %d copies fetched (%d failed), %d with changed provenance\n",
+		stats.NumChecked, stats.NumFetched, stats.NumErrors, stats.NumChanged)
+	return nil
+}
+
+func notifyOSVCommand(ctx context.Context) error {
+	stats, err := worker.NotifyOSVChanges(ctx, cfg.Store, cfg.VulnDBURL)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("%d entries, %d added or modified, %d targets notified\n",
+		stats.NumEntries, stats.NumChanged, stats.NumNotified)
+	return nil
+}
+
 func createIssuesCommand(ctx context.Context) error {
 	if cfg.IssueRepo == "" {
 		return errors.New("need -issue-repo")
 	}
 	if cfg.GitHubAccessToken == "" {
-		return errors.New("need -ghtokenfile")
+		return &secrets.MissingError{Names: []string{secrets.GitHubToken}, Hint: "set it with -ghtokenfile or -secrets"}
 	}
 	owner, repoName, err := gitrepo.ParseGitHubRepo(cfg.IssueRepo)
 	if err != nil {
@@ -269,6 +692,177 @@ func createIssuesCommand(ctx context.Context) error {
 	return worker.CreateIssues(ctx, cfg.Store, client, pc, rc, *limit)
 }
 
+func syncIssuesCommand(ctx context.Context) error {
+	if cfg.IssueRepo == "" {
+		return errors.New("need -issue-repo")
+	}
+	owner, repoName, err := gitrepo.ParseGitHubRepo(cfg.IssueRepo)
+	if err != nil {
+		return err
+	}
+	client := issues.NewClient(ctx, &issues.Config{Owner: owner, Repo: repoName, Token: cfg.GitHubAccessToken})
+	stats, err := worker.SyncIssues(ctx, client.Issues, cfg.Store, *force)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("%d issues synced\n", stats.NumSynced)
+	return nil
+}
+
+func processIntakeCommand(ctx context.Context) error {
+	if cfg.IssueRepo == "" {
+		return errors.New("need -issue-repo")
+	}
+	if cfg.GitHubAccessToken == "" {
+		return &secrets.MissingError{Names: []string{secrets.GitHubToken}, Hint: "set it with -ghtokenfile or -secrets"}
+	}
+	owner, repoName, err := gitrepo.ParseGitHubRepo(cfg.IssueRepo)
+	if err != nil {
+		return err
+	}
+	client := issues.NewClient(ctx, &issues.Config{Owner: owner, Repo: repoName, Token: cfg.GitHubAccessToken})
+	rc, err := report.NewDefaultClient(ctx)
+	if err != nil {
+		return err
+	}
+	stats, err := worker.ProcessIntake(ctx, cfg.Store, client, rc)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("%d issues checked: %d already covered, %d need triage, %d without IDs\n",
+		stats.NumChecked, stats.NumCovered, stats.NumNeedsTriage, stats.NumNoIDs)
+	return nil
+}
+
+func processSymbolFeedbackCommand(ctx context.Context) error {
+	if cfg.IssueRepo == "" {
+		return errors.New("need -issue-repo")
+	}
+	if cfg.GitHubAccessToken == "" {
+		return &secrets.MissingError{Names: []string{secrets.GitHubToken}, Hint: "set it with -ghtokenfile or -secrets"}
+	}
+	owner, repoName, err := gitrepo.ParseGitHubRepo(cfg.IssueRepo)
+	if err != nil {
+		return err
+	}
+	client := issues.NewClient(ctx, &issues.Config{Owner: owner, Repo: repoName, Token: cfg.GitHubAccessToken})
+	rc, err := report.NewDefaultClient(ctx)
+	if err != nil {
+		return err
+	}
+	stats, err := worker.ProcessSymbolFeedback(ctx, cfg.Store, client, rc)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("%d issues checked: %d symbols recorded, %d invalid issues, %d records resolved\n",
+		stats.NumChecked, stats.NumRecorded, stats.NumInvalid, stats.NumResolved)
+	return nil
+}
+
+func setConfigCommand(ctx context.Context, filename string) error {
+	source := fmt.Sprintf("set-config %s by %s", filename, os.Getenv("USER"))
+	changed, err := worker.ReloadWorkerConfig(ctx, cfg.Store, filename, source)
+	if err != nil {
+		return err
+	}
+	if changed {
+		fmt.Println("config changed")
+	} else {
+		fmt.Println("config unchanged")
+	}
+	return nil
+}
+
+func showConfigCommand(ctx context.Context) error {
+	c, err := cfg.Store.GetWorkerConfig(ctx)
+	if err != nil {
+		return err
+	}
+	if c == nil {
+		fmt.Println("no config set; using defaults")
+	} else {
+		data, err := json.MarshalIndent(c, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Printf("%s\n", data)
+	}
+	n := *limit
+	if n == 0 {
+		n = 10
+	}
+	ccs, err := cfg.Store.ListConfigChanges(ctx, n)
+	if err != nil {
+		return err
+	}
+	if len(ccs) == 0 {
+		return nil
+	}
+	fmt.Println("\nrecent changes:")
+	tw := tabwriter.NewWriter(os.Stdout, 1, 8, 2, ' ', 0)
+	fmt.Fprintf(tw, "Time\tSource\n")
+	for _, cc := range ccs {
+		fmt.Fprintf(tw, "%s\t%s\n", cc.ChangedAt.In(time.Local).Format(timeFormat), cc.Source)
+	}
+	return tw.Flush()
+}
+
+func replayDecisionCommand(ctx context.Context, id string) error {
+	var pc *pkgsite.Client
+	if !*replayOffline {
+		pc = pkgsite.Default()
+	}
+	cr, result, err := worker.ReplayDecision(ctx, cfg.Store, id, pc)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("%s: recorded decision at commit %s: %s (not a Go vuln)\n", id, cr.CommitHash, cr.TriageState)
+	for _, l := range cr.TriageInputs.Lookups {
+		fmt.Printf("  %s known to pkgsite: %t\n", l.ModulePath, l.Known)
+	}
+	if result == nil {
+		fmt.Println("replayed decision: not a Go vuln (unchanged)")
+		return nil
+	}
+	fmt.Printf("replayed decision: Go vuln in module %q", result.ModulePath)
+	if result.PackagePath != "" {
+		fmt.Printf(", package %q", result.PackagePath)
+	}
+	fmt.Printf("\n  reason: %s\n", result.Reason)
+	return nil
+}
+
+func recomputeTriageCommand(ctx context.Context) error {
+	var pc *pkgsite.Client
+	if !*replayOffline {
+		pc = pkgsite.Default()
+	}
+	rc, err := report.NewDefaultClient(ctx)
+	if err != nil {
+		return err
+	}
+	changes, stats, err := worker.RecomputeTriage(ctx, cfg.Store, pc, rc)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("%d records triaged again (%d skipped): %d would change\n", stats.NumChecked, stats.NumSkipped, stats.NumChanged)
+	if len(changes) == 0 {
+		return nil
+	}
+	tw := tabwriter.NewWriter(os.Stdout, 1, 8, 2, ' ', 0)
+	fmt.Fprintf(tw, "ID\tStored\tRecomputed\tReason\n")
+	decision := func(ts store.TriageState, module string) string {
+		if module == "" {
+			return string(ts)
+		}
+		return fmt.Sprintf("%s (%s)", ts, module)
+	}
+	for _, c := range changes {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", c.ID, decision(c.OldState, c.OldModule), decision(c.NewState, c.NewModule), c.Reason)
+	}
+	return tw.Flush()
+}
+
 func showCommand(ctx context.Context, ids []string) error {
 	for _, id := range ids {
 		r, err := cfg.Store.GetRecord(ctx, id)
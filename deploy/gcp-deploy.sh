@@ -5,8 +5,7 @@
 
 set -e
 
-# Deploy v1 database files.
-gsutil -m cp -r /workspace/db/* gs://go-vulndb
+# The v1 database files are deployed by the worker (see doc/worker.md).
 
 # Deploy web files.
 # index.html is deployed as-is to avoid a name conflict with
@@ -0,0 +1,228 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package coverage reports which of a program's dependencies the Go
+// vulnerability database has assessed.
+//
+// A govulncheck or SBOM scan that finds no vulnerabilities in a module
+// is only meaningful if the database has looked at the module. This
+// package gives consumers that signal: for each module version in a
+// go.sum file or SBOM, whether it is affected by a report, has reports
+// that do not affect it, has only excluded reports, or has never been
+// assessed.
+package coverage
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"slices"
+	"sort"
+	"strings"
+
+	"golang.org/x/mod/module"
+	"golang.org/x/vulndb/internal/osvutils"
+	"golang.org/x/vulndb/internal/report"
+	"golang.org/x/vulndb/internal/version"
+)
+
+// Status is the database's coverage of a module version.
+type Status string
+
+const (
+	// Affected means that a report in the database
+	// affects the module version.
+	Affected Status = "affected"
+	// Assessed means that the module has reports,
+	// but none of them affect the version.
+	Assessed Status = "assessed"
+	// Excluded means that the module's only reports are excluded
+	// (for example, because it is not importable).
+	Excluded Status = "excluded"
+	// NotAssessed means that the database has no reports,
+	// regular or excluded, for the module.
+	NotAssessed Status = "not-assessed"
+)
+
+// A Result is the coverage of a module version.
+type Result struct {
+	Module  string `json:"module"`
+	Version string `json:"version,omitempty"`
+	Status  Status `json:"status"`
+	// The IDs of the reports for the module, which affect the version
+	// if the status is Affected.
+	Reports []string `json:"reports,omitempty"`
+}
+
+// Check returns the coverage of each of the given module versions by
+// the reports in rc, sorted by module path and version.
+// Versions may be given with or without a "v" prefix, or be empty
+// if the version is unknown.
+func Check(mods []module.Version, rc *report.Client) ([]*Result, error) {
+	var results []*Result
+	for _, m := range mods {
+		res, err := check(m, rc.ReportsByModule(m.Path))
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, res)
+	}
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].Module != results[j].Module {
+			return results[i].Module < results[j].Module
+		}
+		return version.Before(results[i].Version, results[j].Version)
+	})
+	return results, nil
+}
+
+func check(m module.Version, rs []*report.Report) (*Result, error) {
+	res := &Result{Module: m.Path, Version: version.TrimPrefix(m.Version)}
+	var reviewed, affecting []string
+	for _, r := range rs {
+		if r.IsExcluded() {
+			continue
+		}
+		reviewed = append(reviewed, r.ID)
+		if r.Withdrawn != nil || res.Version == "" {
+			continue
+		}
+		for _, rm := range r.Modules {
+			if rm.Module != m.Path {
+				continue
+			}
+			ranges, err := rm.Versions.ToSemverRanges()
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", r.ID, err)
+			}
+			affected, err := osvutils.AffectsSemver(ranges, res.Version)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", r.ID, err)
+			}
+			if affected && !slices.Contains(affecting, r.ID) {
+				affecting = append(affecting, r.ID)
+			}
+		}
+	}
+	switch {
+	case len(affecting) > 0:
+		res.Status, res.Reports = Affected, affecting
+	case len(reviewed) > 0:
+		res.Status, res.Reports = Assessed, reviewed
+	case len(rs) > 0:
+		res.Status = Excluded
+		for _, r := range rs {
+			res.Reports = append(res.Reports, r.ID)
+		}
+	default:
+		res.Status = NotAssessed
+	}
+	slices.Sort(res.Reports)
+	return res, nil
+}
+
+// Parse returns the module versions listed in data, which is either
+// a go.sum file or an SBOM in JSON form (such as CycloneDX or SPDX).
+func Parse(data []byte) ([]module.Version, error) {
+	if t := bytes.TrimSpace(data); len(t) > 0 && t[0] == '{' {
+		return ParseSBOM(data)
+	}
+	return ParseGoSum(data)
+}
+
+// ParseGoSum returns the module versions whose contents are recorded
+// in the go.sum file data. Modules for which go.sum has only the hash
+// of their go.mod file are not part of the build, so they are omitted.
+func ParseGoSum(data []byte) ([]module.Version, error) {
+	var mods []module.Version
+	s := bufio.NewScanner(bytes.NewReader(data))
+	for n := 1; s.Scan(); n++ {
+		fields := strings.Fields(s.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		if len(fields) != 3 {
+			return nil, fmt.Errorf("go.sum:%d: malformed line %q", n, s.Text())
+		}
+		if strings.HasSuffix(fields[1], "/go.mod") {
+			continue
+		}
+		m := module.Version{Path: fields[0], Version: fields[1]}
+		if !slices.Contains(mods, m) {
+			mods = append(mods, m)
+		}
+	}
+	return mods, s.Err()
+}
+
+// purlPrefix is the prefix of package URLs of Go modules.
+const purlPrefix = "pkg:golang/"
+
+// ParseSBOM returns the Go module versions in the JSON SBOM data.
+// It recognizes modules by their package URLs ("pkg:golang/PATH@VERSION")
+// wherever they appear, which covers both the "purl" fields of
+// CycloneDX components and the external references of SPDX packages.
+func ParseSBOM(data []byte) ([]module.Version, error) {
+	var v any
+	if err := json.Unmarshal(data, &v); err != nil {
+		return nil, fmt.Errorf("parsing SBOM: %w", err)
+	}
+	var mods []module.Version
+	var walk func(any) error
+	walk = func(v any) error {
+		switch v := v.(type) {
+		case map[string]any:
+			for _, e := range v {
+				if err := walk(e); err != nil {
+					return err
+				}
+			}
+		case []any:
+			for _, e := range v {
+				if err := walk(e); err != nil {
+					return err
+				}
+			}
+		case string:
+			if !strings.HasPrefix(v, purlPrefix) {
+				return nil
+			}
+			m, err := parsePURL(v)
+			if err != nil {
+				return err
+			}
+			if !slices.Contains(mods, m) {
+				mods = append(mods, m)
+			}
+		}
+		return nil
+	}
+	if err := walk(v); err != nil {
+		return nil, err
+	}
+	slices.SortFunc(mods, func(a, b module.Version) int {
+		return strings.Compare(a.Path+"@"+a.Version, b.Path+"@"+b.Version)
+	})
+	return mods, nil
+}
+
+// parsePURL parses a package URL of a Go module, ignoring
+// any qualifiers and subpath.
+func parsePURL(purl string) (module.Version, error) {
+	rest := strings.TrimPrefix(purl, purlPrefix)
+	rest, _, _ = strings.Cut(rest, "#")
+	rest, _, _ = strings.Cut(rest, "?")
+	path, ver, _ := strings.Cut(rest, "@")
+	path, err := url.PathUnescape(path)
+	if err != nil {
+		return module.Version{}, fmt.Errorf("invalid package URL %q: %w", purl, err)
+	}
+	ver, err = url.PathUnescape(ver)
+	if err != nil {
+		return module.Version{}, fmt.Errorf("invalid package URL %q: %w", purl, err)
+	}
+	return module.Version{Path: path, Version: ver}, nil
+}
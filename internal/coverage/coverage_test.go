@@ -0,0 +1,139 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package coverage
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/mod/module"
+	"golang.org/x/vulndb/internal/osv"
+	"golang.org/x/vulndb/internal/report"
+)
+
+func TestCheck(t *testing.T) {
+	rc, err := report.NewTestClient(map[string]*report.Report{
+		"data/reports/GO-1999-0001.yaml": {
+			ID: "GO-1999-0001",
+			Modules: []*report.Module{{
+				Module:   "example.com/a",
+				Versions: report.Versions{report.Introduced("1.1.0"), report.Fixed("1.2.0")},
+			}},
+		},
+		"data/reports/GO-1999-0002.yaml": {
+			ID: "GO-1999-0002",
+			Modules: []*report.Module{{
+				Module:   "example.com/a",
+				Versions: report.Versions{report.Fixed("1.0.5")},
+			}},
+		},
+		"data/reports/GO-1999-0003.yaml": {
+			ID:        "GO-1999-0003",
+			Withdrawn: &osv.Time{},
+			Modules:   []*report.Module{{Module: "example.com/withdrawn"}},
+		},
+		"data/excluded/GO-1999-0004.yaml": {
+			ID:       "GO-1999-0004",
+			Excluded: report.ExcludedNotImportable,
+			Modules:  []*report.Module{{Module: "example.com/excluded"}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := Check([]module.Version{
+		{Path: "example.com/unknown", Version: "v1.0.0"},
+		{Path: "example.com/a", Version: "v1.1.5"},
+		{Path: "example.com/a", Version: "v1.0.0"},
+		{Path: "example.com/a", Version: "v1.3.0"},
+		{Path: "example.com/a"},
+		{Path: "example.com/withdrawn", Version: "v1.0.0"},
+		{Path: "example.com/excluded", Version: "v1.0.0"},
+	}, rc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*Result{
+		{Module: "example.com/a", Status: Assessed, Reports: []string{"GO-1999-0001", "GO-1999-0002"}},
+		{Module: "example.com/a", Version: "1.0.0", Status: Affected, Reports: []string{"GO-1999-0002"}},
+		{Module: "example.com/a", Version: "1.1.5", Status: Affected, Reports: []string{"GO-1999-0001"}},
+		{Module: "example.com/a", Version: "1.3.0", Status: Assessed, Reports: []string{"GO-1999-0001", "GO-1999-0002"}},
+		{Module: "example.com/excluded", Version: "1.0.0", Status: Excluded, Reports: []string{"GO-1999-0004"}},
+		{Module: "example.com/unknown", Version: "1.0.0", Status: NotAssessed},
+		{Module: "example.com/withdrawn", Version: "1.0.0", Status: Assessed, Reports: []string{"GO-1999-0003"}},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Check() mismatch (-want, +got):\n%s", diff)
+	}
+}
+
+func TestParse(t *testing.T) {
+	for _, tc := range []struct {
+		name, data string
+		want       []module.Version
+	}{
+		{
+			name: "go.sum",
+			data: `example.com/a v1.0.0 h1:abc=
+example.com/a v1.0.0/go.mod h1:def=
+example.com/b v0.1.0/go.mod h1:ghi=
+
+example.com/c v1.2.3+incompatible h1:jkl=
+`,
+			want: []module.Version{
+				{Path: "example.com/a", Version: "v1.0.0"},
+				{Path: "example.com/c", Version: "v1.2.3+incompatible"},
+			},
+		},
+		{
+			name: "CycloneDX",
+			data: `{
+  "bomFormat": "CycloneDX",
+  "components": [
+    {"name": "example.com/b", "purl": "pkg:golang/example.com/b@v0.1.0?type=module"},
+    {"name": "example.com/a", "purl": "pkg:golang/example.com%2Fa@v1.0.0"},
+    {"name": "left-pad", "purl": "pkg:npm/left-pad@1.3.0"}
+  ]
+}`,
+			want: []module.Version{
+				{Path: "example.com/a", Version: "v1.0.0"},
+				{Path: "example.com/b", Version: "v0.1.0"},
+			},
+		},
+		{
+			name: "SPDX",
+			data: `{
+  "spdxVersion": "SPDX-2.3",
+  "packages": [{
+    "name": "example.com/a",
+    "externalRefs": [{
+      "referenceCategory": "PACKAGE-MANAGER",
+      "referenceType": "purl",
+      "referenceLocator": "pkg:golang/example.com/a@v1.0.0#sub/dir"
+    }]
+  }]
+}`,
+			want: []module.Version{
+				{Path: "example.com/a", Version: "v1.0.0"},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := Parse([]byte(tc.data))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Parse() mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestParseGoSumError(t *testing.T) {
+	if _, err := ParseGoSum([]byte("example.com/a v1.0.0\n")); err == nil {
+		t.Error("ParseGoSum() of malformed line succeeded, want error")
+	}
+}
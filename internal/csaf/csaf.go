@@ -0,0 +1,144 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package csaf contains the schema for CSAF 2.0 security advisories and
+// provider metadata, and converts Go vulnerability database entries
+// to CSAF advisories. The package implements the subset of the schema
+// needed to publish the vulnerability database.
+//
+// https://docs.oasis-open.org/csaf/csaf/v2.0/csaf-v2.0.html
+// contains the full specification.
+package csaf
+
+// Advisory is a CSAF 2.0 document in the
+// "csaf_security_advisory" profile.
+type Advisory struct {
+	Document        Document         `json:"document"`
+	ProductTree     ProductTree      `json:"product_tree"`
+	Vulnerabilities []*Vulnerability `json:"vulnerabilities"`
+}
+
+type Document struct {
+	Category     string       `json:"category"`
+	CSAFVersion  string       `json:"csaf_version"`
+	Distribution Distribution `json:"distribution"`
+	Lang         string       `json:"lang,omitempty"`
+	Notes        []*Note      `json:"notes,omitempty"`
+	Publisher    Publisher    `json:"publisher"`
+	References   []*Reference `json:"references,omitempty"`
+	Title        string       `json:"title"`
+	Tracking     Tracking     `json:"tracking"`
+}
+
+type Distribution struct {
+	TLP TLP `json:"tlp"`
+}
+
+// TLP is a Traffic Light Protocol label.
+type TLP struct {
+	Label string `json:"label"`
+	URL   string `json:"url,omitempty"`
+}
+
+type Note struct {
+	Category string `json:"category"`
+	Text     string `json:"text"`
+	Title    string `json:"title,omitempty"`
+}
+
+type Publisher struct {
+	Category       string `json:"category"`
+	Name           string `json:"name"`
+	Namespace      string `json:"namespace"`
+	ContactDetails string `json:"contact_details,omitempty"`
+}
+
+type Reference struct {
+	Category string `json:"category,omitempty"`
+	Summary  string `json:"summary"`
+	URL      string `json:"url"`
+}
+
+type Tracking struct {
+	CurrentReleaseDate string      `json:"current_release_date"`
+	ID                 string      `json:"id"`
+	InitialReleaseDate string      `json:"initial_release_date"`
+	RevisionHistory    []*Revision `json:"revision_history"`
+	Status             string      `json:"status"`
+	Version            string      `json:"version"`
+}
+
+type Revision struct {
+	Date    string `json:"date"`
+	Number  string `json:"number"`
+	Summary string `json:"summary"`
+}
+
+type ProductTree struct {
+	Branches []*Branch `json:"branches"`
+}
+
+// A Branch is a node of the product tree. Exactly one of Branches
+// and Product is set.
+type Branch struct {
+	Category string    `json:"category"`
+	Name     string    `json:"name"`
+	Branches []*Branch `json:"branches,omitempty"`
+	Product  *Product  `json:"product,omitempty"`
+}
+
+type Product struct {
+	Name                        string                `json:"name"`
+	ProductID                   string                `json:"product_id"`
+	ProductIdentificationHelper *IdentificationHelper `json:"product_identification_helper,omitempty"`
+}
+
+type IdentificationHelper struct {
+	PURL string `json:"purl,omitempty"`
+}
+
+type Vulnerability struct {
+	CVE           string         `json:"cve,omitempty"`
+	IDs           []*ID          `json:"ids,omitempty"`
+	Notes         []*Note        `json:"notes,omitempty"`
+	ProductStatus *ProductStatus `json:"product_status,omitempty"`
+	References    []*Reference   `json:"references,omitempty"`
+	Remediations  []*Remediation `json:"remediations,omitempty"`
+	Title         string         `json:"title,omitempty"`
+}
+
+type ID struct {
+	SystemName string `json:"system_name"`
+	Text       string `json:"text"`
+}
+
+type ProductStatus struct {
+	KnownAffected []string `json:"known_affected,omitempty"`
+}
+
+type Remediation struct {
+	Category   string   `json:"category"`
+	Details    string   `json:"details"`
+	ProductIDs []string `json:"product_ids"`
+	URL        string   `json:"url,omitempty"`
+}
+
+// ProviderMetadata is the provider-metadata.json file
+// of a CSAF provider.
+type ProviderMetadata struct {
+	CanonicalURL            string                  `json:"canonical_url"`
+	Distributions           []*ProviderDistribution `json:"distributions"`
+	LastUpdated             string                  `json:"last_updated"`
+	ListOnCSAFAggregators   bool                    `json:"list_on_CSAF_aggregators"`
+	MetadataVersion         string                  `json:"metadata_version"`
+	MirrorOnCSAFAggregators bool                    `json:"mirror_on_CSAF_aggregators"`
+	Publisher               Publisher               `json:"publisher"`
+	Role                    string                  `json:"role"`
+}
+
+// ProviderDistribution is a location from which a provider's
+// advisories can be downloaded.
+type ProviderDistribution struct {
+	DirectoryURL string `json:"directory_url"`
+}
@@ -0,0 +1,219 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package csaf
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/vulndb/internal/derrors"
+	"golang.org/x/vulndb/internal/idstr"
+	"golang.org/x/vulndb/internal/osv"
+)
+
+const (
+	csafVersion = "2.0"
+
+	// TLPLabel is the Traffic Light Protocol label of all Go advisories,
+	// which may be distributed without restriction.
+	TLPLabel = "WHITE"
+	tlpURL   = "https://www.first.org/tlp/"
+
+	systemName     = "Go Vulnerability Database"
+	ghsaSystemName = "GitHub Security Advisories"
+)
+
+// GoPublisher is the publisher of the Go vulnerability database.
+var GoPublisher = Publisher{
+	Category:       "coordinator",
+	Name:           "Go Vulnerability Management",
+	Namespace:      "https://go.dev",
+	ContactDetails: "security@golang.org",
+}
+
+// FromEntry converts an OSV entry from the Go vulnerability database
+// to a CSAF advisory.
+//
+// There is one product per affected module, whose product ID is the
+// module path, and whose version range is given in the vers format
+// (https://github.com/package-url/purl-spec/blob/master/VERSION-RANGE-SPEC.rst).
+func FromEntry(e *osv.Entry) (_ *Advisory, err error) {
+	defer derrors.Wrap(&err, "FromEntry(%q)", e.ID)
+
+	if e.Published.IsZero() {
+		return nil, fmt.Errorf("entry has no published time")
+	}
+	tracking := Tracking{
+		ID:                 e.ID,
+		InitialReleaseDate: formatTime(e.Published.Time),
+		CurrentReleaseDate: formatTime(e.Published.Time),
+		RevisionHistory: []*Revision{
+			{Date: formatTime(e.Published.Time), Number: "1", Summary: "Initial version"},
+		},
+		Status: "final",
+	}
+	if e.Modified.After(e.Published.Time) {
+		tracking.CurrentReleaseDate = formatTime(e.Modified.Time)
+		tracking.RevisionHistory = append(tracking.RevisionHistory,
+			&Revision{Date: formatTime(e.Modified.Time), Number: "2", Summary: "Latest update"})
+	}
+	tracking.Version = strconv.Itoa(len(tracking.RevisionHistory))
+
+	doc := Document{
+		Category:     "csaf_security_advisory",
+		CSAFVersion:  csafVersion,
+		Distribution: Distribution{TLP: TLP{Label: TLPLabel, URL: tlpURL}},
+		Lang:         "en",
+		Publisher:    GoPublisher,
+		Title:        title(e),
+		Tracking:     tracking,
+	}
+	if e.Summary != "" {
+		doc.Notes = append(doc.Notes, &Note{Category: "summary", Text: e.Summary})
+	}
+	if e.Withdrawn != nil {
+		doc.Notes = append(doc.Notes, &Note{
+			Category: "general",
+			Title:    "Withdrawn",
+			Text:     fmt.Sprintf("This advisory was withdrawn on %s.", formatTime(e.Withdrawn.Time)),
+		})
+	}
+	if e.DatabaseSpecific != nil && e.DatabaseSpecific.URL != "" {
+		doc.References = append(doc.References, &Reference{
+			Category: "external",
+			Summary:  "Go vulnerability report",
+			URL:      e.DatabaseSpecific.URL,
+		})
+	}
+
+	v := &Vulnerability{
+		IDs:   []*ID{{SystemName: systemName, Text: e.ID}},
+		Title: e.Summary,
+		Notes: []*Note{{Category: "description", Text: e.Details}},
+	}
+	for _, alias := range e.Aliases {
+		switch {
+		case idstr.IsCVE(alias) && v.CVE == "":
+			// A CSAF vulnerability has at most one CVE.
+			v.CVE = alias
+		case idstr.IsGHSA(alias):
+			v.IDs = append(v.IDs, &ID{SystemName: ghsaSystemName, Text: alias})
+		}
+	}
+	for _, ref := range e.References {
+		v.References = append(v.References, &Reference{
+			Category: "external",
+			Summary:  strings.ToLower(string(ref.Type)),
+			URL:      ref.URL,
+		})
+	}
+
+	var tree ProductTree
+	for _, a := range e.Affected {
+		id := a.Module.Path
+		vers := toVers(a.Ranges)
+		product := &Product{
+			Name:      fmt.Sprintf("%s %s", a.Module.Path, vers),
+			ProductID: id,
+		}
+		if purl := toPURL(a.Module.Path); purl != "" {
+			product.ProductIdentificationHelper = &IdentificationHelper{PURL: purl}
+		}
+		tree.Branches = append(tree.Branches, &Branch{
+			Category: "product_name",
+			Name:     a.Module.Path,
+			Branches: []*Branch{{
+				Category: "product_version_range",
+				Name:     vers,
+				Product:  product,
+			}},
+		})
+		if v.ProductStatus == nil {
+			v.ProductStatus = &ProductStatus{}
+		}
+		v.ProductStatus.KnownAffected = append(v.ProductStatus.KnownAffected, id)
+		v.Remediations = append(v.Remediations, remediation(id, a.Ranges))
+	}
+
+	return &Advisory{
+		Document:        doc,
+		ProductTree:     tree,
+		Vulnerabilities: []*Vulnerability{v},
+	}, nil
+}
+
+// Filename returns the path of the advisory relative to the
+// distribution directory, as required by the CSAF specification
+// (section 7.1.11): the year of its initial release, and its
+// lowercased ID.
+func Filename(a *Advisory) string {
+	year := strings.SplitN(a.Document.Tracking.InitialReleaseDate, "-", 2)[0]
+	return year + "/" + strings.ToLower(a.Document.Tracking.ID) + ".json"
+}
+
+func title(e *osv.Entry) string {
+	if e.Summary == "" {
+		return e.ID
+	}
+	return fmt.Sprintf("%s: %s", e.ID, e.Summary)
+}
+
+func formatTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
+
+// toVers converts the OSV ranges of a module to a vers range
+// in the "golang" versioning scheme.
+func toVers(ranges []osv.Range) string {
+	var constraints []string
+	for _, r := range ranges {
+		for _, e := range r.Events {
+			switch {
+			case e.Introduced != "" && e.Introduced != "0":
+				constraints = append(constraints, ">=v"+e.Introduced)
+			case e.Fixed != "":
+				constraints = append(constraints, "<v"+e.Fixed)
+			}
+		}
+	}
+	if len(constraints) == 0 {
+		return "vers:golang/*"
+	}
+	return "vers:golang/" + strings.Join(constraints, "|")
+}
+
+// toPURL returns the package URL of the module, or "" for the
+// standard library and toolchain, which have none.
+func toPURL(modulePath string) string {
+	if modulePath == osv.GoStdModulePath || modulePath == osv.GoCmdModulePath {
+		return ""
+	}
+	return "pkg:golang/" + modulePath
+}
+
+func remediation(productID string, ranges []osv.Range) *Remediation {
+	var fixed []string
+	for _, r := range ranges {
+		for _, e := range r.Events {
+			if e.Fixed != "" {
+				fixed = append(fixed, "v"+e.Fixed)
+			}
+		}
+	}
+	if len(fixed) == 0 {
+		return &Remediation{
+			Category:   "none_available",
+			Details:    "No fixed version is available.",
+			ProductIDs: []string{productID},
+		}
+	}
+	return &Remediation{
+		Category:   "vendor_fix",
+		Details:    fmt.Sprintf("Fixed in %s.", strings.Join(fixed, ", ")),
+		ProductIDs: []string{productID},
+	}
+}
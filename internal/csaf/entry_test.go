@@ -0,0 +1,132 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package csaf
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/vulndb/internal/osv"
+)
+
+func TestFromEntry(t *testing.T) {
+	e := &osv.Entry{
+		ID:        "GO-2023-0001",
+		Published: osv.Time{Time: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)},
+		Modified:  osv.Time{Time: time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC)},
+		Aliases:   []string{"CVE-2023-1234", "GHSA-xxxx-yyyy-zzzz"},
+		Summary:   "Panic in golang.org/x/net/html",
+		Details:   "Parsing some inputs causes a panic.",
+		Affected: []osv.Affected{
+			{
+				Module: osv.Module{Path: "golang.org/x/net", Ecosystem: osv.GoEcosystem},
+				Ranges: []osv.Range{{
+					Type: osv.RangeTypeSemver,
+					Events: []osv.RangeEvent{
+						{Introduced: "0"}, {Fixed: "0.1.0"},
+						{Introduced: "0.2.0"}, {Fixed: "0.2.1"},
+					},
+				}},
+			},
+			{
+				Module: osv.Module{Path: osv.GoStdModulePath, Ecosystem: osv.GoEcosystem},
+				Ranges: []osv.Range{{
+					Type:   osv.RangeTypeSemver,
+					Events: []osv.RangeEvent{{Introduced: "1.21.0"}},
+				}},
+			},
+		},
+		References: []osv.Reference{{Type: osv.ReferenceTypeFix, URL: "https://go.dev/cl/12345"}},
+		DatabaseSpecific: &osv.DatabaseSpecific{
+			URL: "https://pkg.go.dev/vuln/GO-2023-0001",
+		},
+	}
+	want := &Advisory{
+		Document: Document{
+			Category:     "csaf_security_advisory",
+			CSAFVersion:  "2.0",
+			Distribution: Distribution{TLP: TLP{Label: "WHITE", URL: "https://www.first.org/tlp/"}},
+			Lang:         "en",
+			Notes:        []*Note{{Category: "summary", Text: "Panic in golang.org/x/net/html"}},
+			Publisher:    GoPublisher,
+			References: []*Reference{{
+				Category: "external",
+				Summary:  "Go vulnerability report",
+				URL:      "https://pkg.go.dev/vuln/GO-2023-0001",
+			}},
+			Title: "GO-2023-0001: Panic in golang.org/x/net/html",
+			Tracking: Tracking{
+				CurrentReleaseDate: "2023-02-01T00:00:00Z",
+				ID:                 "GO-2023-0001",
+				InitialReleaseDate: "2023-01-01T00:00:00Z",
+				RevisionHistory: []*Revision{
+					{Date: "2023-01-01T00:00:00Z", Number: "1", Summary: "Initial version"},
+					{Date: "2023-02-01T00:00:00Z", Number: "2", Summary: "Latest update"},
+				},
+				Status:  "final",
+				Version: "2",
+			},
+		},
+		ProductTree: ProductTree{Branches: []*Branch{
+			{
+				Category: "product_name",
+				Name:     "golang.org/x/net",
+				Branches: []*Branch{{
+					Category: "product_version_range",
+					Name:     "vers:golang/<v0.1.0|>=v0.2.0|<v0.2.1",
+					Product: &Product{
+						Name:                        "golang.org/x/net vers:golang/<v0.1.0|>=v0.2.0|<v0.2.1",
+						ProductID:                   "golang.org/x/net",
+						ProductIdentificationHelper: &IdentificationHelper{PURL: "pkg:golang/golang.org/x/net"},
+					},
+				}},
+			},
+			{
+				Category: "product_name",
+				Name:     "stdlib",
+				Branches: []*Branch{{
+					Category: "product_version_range",
+					Name:     "vers:golang/>=v1.21.0",
+					Product: &Product{
+						Name:      "stdlib vers:golang/>=v1.21.0",
+						ProductID: "stdlib",
+					},
+				}},
+			},
+		}},
+		Vulnerabilities: []*Vulnerability{{
+			CVE: "CVE-2023-1234",
+			IDs: []*ID{
+				{SystemName: "Go Vulnerability Database", Text: "GO-2023-0001"},
+				{SystemName: "GitHub Security Advisories", Text: "GHSA-xxxx-yyyy-zzzz"},
+			},
+			Notes:         []*Note{{Category: "description", Text: "Parsing some inputs causes a panic."}},
+			ProductStatus: &ProductStatus{KnownAffected: []string{"golang.org/x/net", "stdlib"}},
+			References:    []*Reference{{Category: "external", Summary: "fix", URL: "https://go.dev/cl/12345"}},
+			Remediations: []*Remediation{
+				{Category: "vendor_fix", Details: "Fixed in v0.1.0, v0.2.1.", ProductIDs: []string{"golang.org/x/net"}},
+				{Category: "none_available", Details: "No fixed version is available.", ProductIDs: []string{"stdlib"}},
+			},
+			Title: "Panic in golang.org/x/net/html",
+		}},
+	}
+	got, err := FromEntry(e)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("FromEntry() mismatch (-want, +got):\n%s", diff)
+	}
+	if got, want := Filename(got), "2023/go-2023-0001.json"; got != want {
+		t.Errorf("Filename() = %s, want %s", got, want)
+	}
+}
+
+func TestFromEntryNoPublished(t *testing.T) {
+	if _, err := FromEntry(&osv.Entry{ID: "GO-2023-0001"}); err == nil {
+		t.Error("FromEntry(no published time) = nil, want error")
+	}
+}
@@ -0,0 +1,43 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package csaf
+
+import (
+	"strings"
+	"time"
+)
+
+const (
+	// ProviderMetadataFile is the name of the provider metadata file,
+	// which is at the root of the CSAF tree.
+	ProviderMetadataFile = "provider-metadata.json"
+
+	// The index and changes files list the advisories in
+	// a distribution directory (sections 7.1.13 and 7.1.14).
+	IndexFile   = "index.txt"
+	ChangesFile = "changes.csv"
+)
+
+// DistributionDir is the directory containing the advisories,
+// relative to the root of the CSAF tree, named after their TLP label.
+var DistributionDir = strings.ToLower(TLPLabel)
+
+// NewProviderMetadata returns the provider metadata of a CSAF tree
+// served at baseURL, whose advisories were last updated at lastUpdated.
+func NewProviderMetadata(baseURL string, lastUpdated time.Time) *ProviderMetadata {
+	baseURL = strings.TrimSuffix(baseURL, "/")
+	return &ProviderMetadata{
+		CanonicalURL: baseURL + "/" + ProviderMetadataFile,
+		Distributions: []*ProviderDistribution{
+			{DirectoryURL: baseURL + "/" + DistributionDir},
+		},
+		LastUpdated:             formatTime(lastUpdated),
+		ListOnCSAFAggregators:   true,
+		MetadataVersion:         csafVersion,
+		MirrorOnCSAFAggregators: true,
+		Publisher:               GoPublisher,
+		Role:                    "csaf_provider",
+	}
+}
@@ -38,9 +38,9 @@ func cveToReport(c *CVE, modulePath string) *report.Report {
 	for _, r := range c.References.Data {
 		refs = append(refs, report.ReferenceFromUrl(r.URL))
 	}
-	var credits []string
+	var credits []*report.Credit
 	for _, v := range c.Credit.Data.Description.Data {
-		credits = append(credits, v.Value)
+		credits = append(credits, report.ParseCredit(v.Value))
 	}
 
 	var pkgPath string
@@ -0,0 +1,135 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cve5
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/vulndb/internal/cve4"
+	"golang.org/x/vulndb/internal/derrors"
+	"golang.org/x/vulndb/internal/report"
+	"golang.org/x/vulndb/internal/stdlib"
+)
+
+// goCNAAssigner is the assigner of CVE 4.0 records published by the Go CNA.
+const goCNAAssigner = "security@golang.org"
+
+// FromCVE4 converts a record in the legacy CVE JSON 4.0 format,
+// published by the Go CNA, to the CVE JSON 5.0 format.
+//
+// CVE 4.0 records do not contain module paths, so the vendor of each
+// affected product is the vendor name of the 4.0 record if it has one,
+// and otherwise is derived from the product's package path. Nor do they
+// contain titles, platforms or affected symbols, so these are left unset.
+func FromCVE4(c *cve4.CVE) (_ *CVERecord, err error) {
+	defer derrors.Wrap(&err, "FromCVE4(%q)", c.Metadata.ID)
+
+	if c.Metadata.ID == "" {
+		return nil, errors.New("missing CVE ID")
+	}
+	if c.Metadata.Assigner != goCNAAssigner {
+		return nil, fmt.Errorf("assigned by %q, not the Go CNA", c.Metadata.Assigner)
+	}
+
+	record := &CVERecord{
+		DataType:    "CVE_RECORD",
+		DataVersion: "5.0",
+		Metadata: Metadata{
+			ID: c.Metadata.ID,
+		},
+	}
+	cna := &record.Containers.CNAContainer
+	cna.ProviderMetadata = ProviderMetadata{OrgID: GoOrgUUID}
+
+	descriptions := fromCVE4LangStrings(c.Description.Data)
+	switch c.Metadata.State {
+	case cve4.StatePublic, "":
+	case cve4.StateRejected:
+		record.Metadata.State = StateRejected
+		cna.RejectedReasons = descriptions
+		return record, nil
+	default:
+		return nil, fmt.Errorf("cannot convert a record in state %s", c.Metadata.State)
+	}
+
+	cna.Descriptions = descriptions
+	for _, pt := range c.ProblemType.Data {
+		var ptds []ProblemTypeDescription
+		for _, d := range fromCVE4LangStrings(pt.Description) {
+			ptds = append(ptds, ProblemTypeDescription{Lang: d.Lang, Description: d.Value})
+		}
+		cna.ProblemTypes = append(cna.ProblemTypes, ProblemType{Descriptions: ptds})
+	}
+	for _, v := range c.Affects.Vendor.Data {
+		for _, p := range v.Product.Data {
+			vs, err := fromCVE4Versions(p.Version.Data)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", p.ProductName, err)
+			}
+			versions, defaultStatus := versionsToVersionRanges(vs, nil)
+			cna.Affected = append(cna.Affected, Affected{
+				Vendor:        fromCVE4Vendor(v.VendorName, p.ProductName),
+				Product:       p.ProductName,
+				CollectionURL: "https://pkg.go.dev",
+				PackageName:   p.ProductName,
+				Versions:      versions,
+				DefaultStatus: defaultStatus,
+			})
+		}
+	}
+	for _, ref := range c.References.Data {
+		cna.References = append(cna.References, Reference{URL: ref.URL})
+	}
+	for _, credit := range fromCVE4LangStrings(c.Credit.Data.Description.Data) {
+		cna.Credits = append(cna.Credits, Credit{Lang: credit.Lang, Value: credit.Value})
+	}
+	return record, nil
+}
+
+// fromCVE4LangStrings converts CVE 4.0 strings, which use ISO 639-2
+// language codes ("eng"), to CVE 5.0 descriptions, which use ISO 639-1
+// codes ("en").
+func fromCVE4LangStrings(ls []cve4.LangString) []Description {
+	var ds []Description
+	for _, l := range ls {
+		lang := l.Lang
+		if lang == "eng" || lang == "" {
+			lang = "en"
+		}
+		ds = append(ds, Description{Lang: lang, Value: report.RemoveNewlines(l.Value)})
+	}
+	return ds
+}
+
+// fromCVE4Versions converts the version data of a CVE 4.0 product, in
+// which ">=" marks an introduced version and "<" a fixed version, to
+// report versions.
+func fromCVE4Versions(vds []cve4.VersionDataItem) (report.Versions, error) {
+	var vs report.Versions
+	for _, vd := range vds {
+		v := strings.TrimPrefix(vd.VersionValue, "v")
+		switch vd.VersionAffected {
+		case ">=":
+			vs = append(vs, report.Introduced(v))
+		case "<":
+			vs = append(vs, report.Fixed(v))
+		default:
+			return nil, fmt.Errorf("unsupported version_affected %q for version %q", vd.VersionAffected, vd.VersionValue)
+		}
+	}
+	return vs, nil
+}
+
+func fromCVE4Vendor(vendorName, pkgPath string) string {
+	if vendorName != "" && vendorName != "n/a" {
+		return vendorName
+	}
+	if stdlib.Contains(pkgPath) {
+		return report.Vendor(stdlib.ModulePath)
+	}
+	return pkgPath
+}
@@ -0,0 +1,150 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cve5
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/vulndb/internal/cve4"
+)
+
+func TestFromCVE4(t *testing.T) {
+	meta := func(state string) cve4.Metadata {
+		return cve4.Metadata{ID: "CVE-9999-0001", Assigner: "security@golang.org", State: state}
+	}
+	product := func(name string, vds ...cve4.VersionDataItem) cve4.VendorDataItem {
+		return cve4.VendorDataItem{
+			VendorName: "n/a",
+			Product: cve4.Product{Data: []cve4.ProductDataItem{{
+				ProductName: name,
+				Version:     cve4.VersionData{Data: vds},
+			}}},
+		}
+	}
+	eng := func(s string) []cve4.LangString { return []cve4.LangString{{Lang: "eng", Value: s}} }
+	provider := ProviderMetadata{OrgID: GoOrgUUID}
+
+	for _, test := range []struct {
+		name string
+		in   *cve4.CVE
+		want *CVERecord
+	}{
+		{
+			name: "public",
+			in: &cve4.CVE{
+				Metadata: meta(cve4.StatePublic),
+				Affects: cve4.Affects{Vendor: cve4.Vendor{Data: []cve4.VendorDataItem{
+					product("golang.org/x/net/html",
+						cve4.VersionDataItem{VersionValue: "v0.1.0", VersionAffected: ">="},
+						cve4.VersionDataItem{VersionValue: "v0.2.0", VersionAffected: "<"}),
+					product("net/http"),
+				}}},
+				Description: cve4.Description{Data: eng("A\ndescription.\n")},
+				ProblemType: cve4.ProblemType{Data: []cve4.ProblemTypeDataItem{{Description: eng("CWE-400: Uncontrolled Resource Consumption")}}},
+				References:  cve4.References{Data: []cve4.Reference{{URL: "https://go.dev/cl/1"}}},
+				Credit:      cve4.Credit{Data: cve4.CreditData{Description: cve4.Description{Data: eng("Jane Doe")}}},
+			},
+			want: &CVERecord{
+				DataType:    "CVE_RECORD",
+				DataVersion: "5.0",
+				Metadata:    Metadata{ID: "CVE-9999-0001"},
+				Containers: Containers{CNAContainer: CNAPublishedContainer{
+					ProviderMetadata: provider,
+					Descriptions:     []Description{{Lang: "en", Value: "A description."}},
+					Affected: []Affected{
+						{
+							Vendor:        "golang.org/x/net/html",
+							Product:       "golang.org/x/net/html",
+							CollectionURL: "https://pkg.go.dev",
+							PackageName:   "golang.org/x/net/html",
+							Versions: []VersionRange{{
+								Introduced:  "0.1.0",
+								Fixed:       "0.2.0",
+								Status:      StatusAffected,
+								VersionType: "semver",
+							}},
+							DefaultStatus: StatusUnaffected,
+						},
+						{
+							Vendor:        "Go standard library",
+							Product:       "net/http",
+							CollectionURL: "https://pkg.go.dev",
+							PackageName:   "net/http",
+							DefaultStatus: StatusAffected,
+						},
+					},
+					ProblemTypes: []ProblemType{{Descriptions: []ProblemTypeDescription{{Lang: "en", Description: "CWE-400: Uncontrolled Resource Consumption"}}}},
+					References:   []Reference{{URL: "https://go.dev/cl/1"}},
+					Credits:      []Credit{{Lang: "en", Value: "Jane Doe"}},
+				}},
+			},
+		},
+		{
+			name: "rejected",
+			in: &cve4.CVE{
+				Metadata:    meta(cve4.StateRejected),
+				Description: cve4.Description{Data: eng("** REJECT ** Duplicate.")},
+			},
+			want: &CVERecord{
+				DataType:    "CVE_RECORD",
+				DataVersion: "5.0",
+				Metadata:    Metadata{ID: "CVE-9999-0001", State: StateRejected},
+				Containers: Containers{CNAContainer: CNAPublishedContainer{
+					ProviderMetadata: provider,
+					RejectedReasons:  []Description{{Lang: "en", Value: "** REJECT ** Duplicate."}},
+				}},
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := FromCVE4(test.in)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFromCVE4Error(t *testing.T) {
+	for _, test := range []struct {
+		name string
+		in   *cve4.CVE
+	}{
+		{
+			name: "no ID",
+			in:   &cve4.CVE{Metadata: cve4.Metadata{Assigner: "security@golang.org"}},
+		},
+		{
+			name: "other CNA",
+			in:   &cve4.CVE{Metadata: cve4.Metadata{ID: "CVE-9999-0001", Assigner: "cve@mitre.org"}},
+		},
+		{
+			name: "reserved",
+			in:   &cve4.CVE{Metadata: cve4.Metadata{ID: "CVE-9999-0001", Assigner: "security@golang.org", State: cve4.StateReserved}},
+		},
+		{
+			name: "unsupported version",
+			in: &cve4.CVE{
+				Metadata: cve4.Metadata{ID: "CVE-9999-0001", Assigner: "security@golang.org", State: cve4.StatePublic},
+				Affects: cve4.Affects{Vendor: cve4.Vendor{Data: []cve4.VendorDataItem{{
+					Product: cve4.Product{Data: []cve4.ProductDataItem{{
+						ProductName: "example.com/p",
+						Version:     cve4.VersionData{Data: []cve4.VersionDataItem{{VersionValue: "1.0.0", VersionAffected: "="}}},
+					}}},
+				}}}},
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			if _, err := FromCVE4(test.in); err == nil {
+				t.Error("got no error, want error")
+			}
+		})
+	}
+}
@@ -223,6 +223,30 @@ func (c *Client) RetrieveRecord(id string) (cve *CVERecord, err error) {
 	return
 }
 
+// RetrievePublishedRecord requests a CVE record, returning nil
+// if the CVE has no record (for example, because it is reserved
+// but its record was never published).
+func (c *Client) RetrievePublishedRecord(id string) (*CVERecord, error) {
+	req, err := c.createRequest(http.MethodGet, c.requestURL(cveTarget, id), nil)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		cve      *CVERecord
+		notFound bool
+	)
+	if err := c.sendRequest(req, func(status int) bool {
+		notFound = status == http.StatusNotFound
+		return status == http.StatusOK || notFound
+	}, &cve); err != nil {
+		return nil, err
+	}
+	if notFound {
+		return nil, nil
+	}
+	return cve, nil
+}
+
 func (c *Client) cveRecordEndpoint(cveID string) string {
 	return c.requestURL(cveTarget, cveID, cnaTarget)
 }
@@ -214,6 +214,9 @@ var (
 	retrieveRecordQuery = func(t *testing.T, c *Client) (any, error) {
 		return c.RetrieveRecord(defaultTestCVERecord(t).Metadata.ID)
 	}
+	retrievePublishedRecordQuery = func(t *testing.T, c *Client) (any, error) {
+		return c.RetrievePublishedRecord(defaultTestCVERecord(t).Metadata.ID)
+	}
 	createRecordQuery = func(t *testing.T, c *Client) (any, error) {
 		return c.CreateRecord(defaultTestCVE.ID, &defaultTestCVERecord(t).Containers)
 	}
@@ -286,6 +289,15 @@ func TestAllSuccess(t *testing.T) {
 			wantPath:       "/api/cve/CVE-2022-0000",
 			want:           defaultTestCVERecord,
 		},
+		{
+			name:           "RetrievePublishedRecord",
+			query:          retrievePublishedRecordQuery,
+			mockStatus:     http.StatusOK,
+			mockResponse:   defaultTestCVERecord,
+			wantHTTPMethod: http.MethodGet,
+			wantPath:       "/api/cve/CVE-2022-0000",
+			want:           defaultTestCVERecord,
+		},
 		{
 			name:           "CreateRecord",
 			query:          createRecordQuery,
@@ -372,6 +384,10 @@ func TestAllFail(t *testing.T) {
 			name:  "RetrieveRecord",
 			query: retrieveRecordQuery,
 		},
+		{
+			name:  "RetrievePublishedRecord",
+			query: retrievePublishedRecordQuery,
+		},
 		{
 			name:  "CreateRecord",
 			query: createRecordQuery,
@@ -410,6 +426,22 @@ func TestAllFail(t *testing.T) {
 	}
 }
 
+func TestRetrievePublishedRecordNotFound(t *testing.T) {
+	mockResponse := apiError{
+		Error:   "CVE_RECORD_DNE",
+		Message: "The cve record for the cve id does not exist.",
+	}
+	c, s := newTestClientAndServer(newTestHandler(t, http.StatusNotFound, mockResponse, nil))
+	defer s.Close()
+	got, err := c.RetrievePublishedRecord(defaultTestCVEID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != nil {
+		t.Errorf("RetrievePublishedRecord() = %v, want nil", got)
+	}
+}
+
 func TestCreateListOrgCVEsRequest(t *testing.T) {
 	tests := []struct {
 		opts       ListOptions
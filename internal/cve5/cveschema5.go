@@ -13,6 +13,7 @@ package cve5
 import (
 	"encoding/json"
 	"os"
+	"time"
 )
 
 type CVERecord struct {
@@ -35,6 +36,22 @@ type Metadata struct {
 	OrgID  string `json:"assignerOrgId,omitempty"`
 	Serial int    `json:"serial,omitempty"`
 	State  State  `json:"state,omitempty"`
+	// DateUpdated is the time the record was last updated, set by
+	// CVE Services. Use UpdatedTime to parse it.
+	DateUpdated string `json:"dateUpdated,omitempty"`
+}
+
+// UpdatedTime returns the time the record was last updated, or the
+// zero time if it is not set. Records in the wild use both RFC 3339
+// timestamps and timestamps without a time zone, which are in UTC.
+func (m *Metadata) UpdatedTime() (time.Time, error) {
+	if m.DateUpdated == "" {
+		return time.Time{}, nil
+	}
+	if t, err := time.Parse(time.RFC3339, m.DateUpdated); err == nil {
+		return t, nil
+	}
+	return time.Parse("2006-01-02T15:04:05", m.DateUpdated)
 }
 
 type Containers struct {
@@ -44,11 +61,14 @@ type Containers struct {
 type CNAPublishedContainer struct {
 	ProviderMetadata ProviderMetadata `json:"providerMetadata"`
 	Title            string           `json:"title,omitempty"`
-	Descriptions     []Description    `json:"descriptions"`
-	Affected         []Affected       `json:"affected"`
+	Descriptions     []Description    `json:"descriptions,omitempty"`
+	Affected         []Affected       `json:"affected,omitempty"`
 	ProblemTypes     []ProblemType    `json:"problemTypes,omitempty"`
-	References       []Reference      `json:"references"`
+	References       []Reference      `json:"references,omitempty"`
 	Credits          []Credit         `json:"credits,omitempty"`
+	// RejectedReasons is set instead of the fields above
+	// for records in the REJECTED state.
+	RejectedReasons []Description `json:"rejectedReasons,omitempty"`
 }
 
 type ProviderMetadata struct {
@@ -88,6 +108,23 @@ type Reference struct {
 type Credit struct {
 	Lang  string `json:"lang"`
 	Value string `json:"value"`
+	// Type is one of CreditTypes, or empty.
+	Type string `json:"type,omitempty"`
+}
+
+// CreditTypes are the types of credits in the CVE 5.0 schema.
+// They are the OSV credit types, in lower case with spaces.
+var CreditTypes = []string{
+	"finder",
+	"reporter",
+	"analyst",
+	"coordinator",
+	"remediation developer",
+	"remediation reviewer",
+	"remediation verifier",
+	"tool",
+	"sponsor",
+	"other",
 }
 
 type VersionRange struct {
@@ -7,6 +7,7 @@ package cve5
 import (
 	"reflect"
 	"testing"
+	"time"
 )
 
 var basicExampleRecord = &CVERecord{
@@ -72,3 +73,31 @@ func TestRead(t *testing.T) {
 		t.Errorf("Read(%s) = %v\n want %v", f, got, want)
 	}
 }
+
+func TestUpdatedTime(t *testing.T) {
+	want := time.Date(2024, 3, 5, 17, 20, 1, 0, time.UTC)
+	for _, tc := range []struct {
+		dateUpdated string
+		want        time.Time
+	}{
+		{"", time.Time{}},
+		{"2024-03-05T17:20:01Z", want},
+		{"2024-03-05T17:20:01.000Z", want},
+		// Timestamps without a time zone are in UTC.
+		{"2024-03-05T17:20:01", want},
+		{"2024-03-05T17:20:01.123", want.Add(123 * time.Millisecond)},
+	} {
+		m := Metadata{DateUpdated: tc.dateUpdated}
+		got, err := m.UpdatedTime()
+		if err != nil {
+			t.Fatalf("UpdatedTime(%q): %v", tc.dateUpdated, err)
+		}
+		if !got.Equal(tc.want) {
+			t.Errorf("UpdatedTime(%q) = %s, want %s", tc.dateUpdated, got, tc.want)
+		}
+	}
+	m := Metadata{DateUpdated: "yesterday"}
+	if _, err := m.UpdatedTime(); err == nil {
+		t.Error("UpdatedTime(bad time): got nil error, want error")
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cve5
+
+import (
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+// KeepVolatile copies the fields of current that are set by CVE Services
+// rather than generated from a report (the assigner, the serial
+// number, the update time, the schema version, which CVE Services
+// upgrades, and, for published records, the state) into generated.
+//
+// This makes it possible to compare a generated record with one
+// downloaded from CVE Services, and to regenerate a record without
+// spurious diffs.
+func KeepVolatile(generated, current *CVERecord) {
+	if current == nil {
+		return
+	}
+	generated.Metadata.OrgID = current.Metadata.OrgID
+	generated.Metadata.Serial = current.Metadata.Serial
+	generated.Metadata.DateUpdated = current.Metadata.DateUpdated
+	if current.DataVersion != "" {
+		generated.DataVersion = current.DataVersion
+	}
+	if generated.Metadata.State == "" {
+		generated.Metadata.State = current.Metadata.State
+	}
+}
+
+// A PartDrift is a part of a CVE record that differs from the
+// record generated from its report.
+type PartDrift struct {
+	Part string `json:"part"`
+	// Generated and Current are the values of the part
+	// in the generated and current records.
+	Generated any `json:"generated"`
+	Current   any `json:"current"`
+}
+
+// Drift returns the parts of the record current that differ from the
+// record generated from a report, ignoring volatile fields (see
+// KeepVolatile).
+func Drift(generated, current *CVERecord) []*PartDrift {
+	g := *generated
+	KeepVolatile(&g, current)
+	want, got := g.Containers.CNAContainer, current.Containers.CNAContainer
+	var drift []*PartDrift
+	for _, part := range []*PartDrift{
+		{"metadata", g.Metadata, current.Metadata},
+		{"data type", g.DataType, current.DataType},
+		{"provider metadata", want.ProviderMetadata, got.ProviderMetadata},
+		{"title", want.Title, got.Title},
+		{"descriptions", want.Descriptions, got.Descriptions},
+		{"affected", want.Affected, got.Affected},
+		{"problem types", want.ProblemTypes, got.ProblemTypes},
+		{"references", want.References, got.References},
+		{"credits", want.Credits, got.Credits},
+		{"rejected reasons", want.RejectedReasons, got.RejectedReasons},
+	} {
+		if !cmp.Equal(part.Generated, part.Current, cmpopts.EquateEmpty()) {
+			drift = append(drift, part)
+		}
+	}
+	return drift
+}
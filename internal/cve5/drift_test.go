@@ -0,0 +1,61 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cve5
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDrift(t *testing.T) {
+	generated := &CVERecord{
+		DataType:    "CVE_RECORD",
+		DataVersion: "5.0",
+		Metadata:    Metadata{ID: "CVE-9999-0001"},
+		Containers: Containers{CNAContainer: CNAPublishedContainer{
+			Title:        "A title",
+			Descriptions: []Description{{Lang: "en", Value: "A description."}},
+		}},
+	}
+	published := func(title string) *CVERecord {
+		c := *generated
+		c.Metadata = Metadata{ID: "CVE-9999-0001", OrgID: GoOrgUUID, Serial: 2, State: StatePublished}
+		c.Containers.CNAContainer.Title = title
+		return &c
+	}
+
+	if got := Drift(generated, published("A title")); len(got) != 0 {
+		t.Errorf("Drift(volatile fields only) = %v, want none", got)
+	}
+
+	reordered := published("A title")
+	reordered.Containers.CNAContainer.References = []Reference{{URL: "https://go.dev/issue/2"}, {URL: "https://go.dev/cl/1"}}
+	generated.Containers.CNAContainer.References = []Reference{{URL: "https://go.dev/cl/1"}, {URL: "https://go.dev/issue/2"}}
+	if got := Drift(generated, reordered); len(got) != 1 || got[0].Part != "references" {
+		t.Errorf("Drift(reordered references) = %v, want references", got)
+	}
+	generated.Containers.CNAContainer.References = nil
+
+	want := []*PartDrift{{Part: "title", Generated: "A title", Current: "An old title"}}
+	if diff := cmp.Diff(want, Drift(generated, published("An old title"))); diff != "" {
+		t.Errorf("Drift() mismatch (-want, +got):\n%s", diff)
+	}
+}
+
+func TestKeepVolatile(t *testing.T) {
+	generated := &CVERecord{Metadata: Metadata{ID: "CVE-9999-0001"}}
+	current := &CVERecord{Metadata: Metadata{ID: "CVE-9999-0001", OrgID: GoOrgUUID, Serial: 2, State: StatePublished}}
+	KeepVolatile(generated, current)
+	if generated.Metadata != current.Metadata {
+		t.Errorf("KeepVolatile: got %v, want %v", generated.Metadata, current.Metadata)
+	}
+
+	rejected := &CVERecord{Metadata: Metadata{ID: "CVE-9999-0001", State: StateRejected}}
+	KeepVolatile(rejected, current)
+	if rejected.Metadata.State != StateRejected {
+		t.Errorf("KeepVolatile: state = %s, want %s", rejected.Metadata.State, StateRejected)
+	}
+}
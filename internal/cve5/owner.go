@@ -0,0 +1,43 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cve5
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrNotGoAssigned indicates that a CVE record is owned by a CNA
+// other than the Go CNA, so we must not generate or publish its
+// CNA container.
+var ErrNotGoAssigned = errors.New("CVE is owned by another CNA")
+
+// OwnerOrgID returns the UUID of the CNA that owns the record: the
+// provider of its CNA container or, for records without one (such as
+// reserved records), the assigner.
+func (c *CVERecord) OwnerOrgID() string {
+	if id := c.Containers.CNAContainer.ProviderMetadata.OrgID; id != "" {
+		return id
+	}
+	return c.Metadata.OrgID
+}
+
+// IsGoAssigned reports whether the record is owned by the Go CNA.
+// Records with no owner (such as those generated from
+// reports before they are published) are considered Go-assigned.
+func (c *CVERecord) IsGoAssigned() bool {
+	id := c.OwnerOrgID()
+	return id == "" || id == GoOrgUUID
+}
+
+// CheckGoAssigned returns an error wrapping ErrNotGoAssigned if current,
+// the published record of a CVE, is owned by another CNA. A nil
+// record (one that has not been published) is not an error.
+func CheckGoAssigned(current *CVERecord) error {
+	if current == nil || current.IsGoAssigned() {
+		return nil
+	}
+	return fmt.Errorf("%s: %w (org %s)", current.Metadata.ID, ErrNotGoAssigned, current.OwnerOrgID())
+}
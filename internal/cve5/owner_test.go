@@ -0,0 +1,43 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cve5
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCheckGoAssigned(t *testing.T) {
+	const otherOrg = "00000000-0000-0000-0000-000000000000"
+	record := func(assigner, provider string) *CVERecord {
+		return &CVERecord{
+			Metadata: Metadata{ID: "CVE-9999-0001", OrgID: assigner},
+			Containers: Containers{CNAContainer: CNAPublishedContainer{
+				ProviderMetadata: ProviderMetadata{OrgID: provider},
+			}},
+		}
+	}
+	for _, tc := range []struct {
+		name    string
+		record  *CVERecord
+		wantErr bool
+	}{
+		{name: "unpublished", record: nil},
+		{name: "go", record: record(GoOrgUUID, GoOrgUUID)},
+		{name: "reserved by go", record: record(GoOrgUUID, "")},
+		{name: "generated", record: record("", "")},
+		{name: "other", record: record(otherOrg, otherOrg), wantErr: true},
+		{name: "reserved by other", record: record(otherOrg, ""), wantErr: true},
+		// The provider of the CNA container takes precedence.
+		{name: "provided by other", record: record(GoOrgUUID, otherOrg), wantErr: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := CheckGoAssigned(tc.record)
+			if got := errors.Is(err, ErrNotGoAssigned); got != tc.wantErr {
+				t.Errorf("CheckGoAssigned() = %v, want ErrNotGoAssigned: %t", err, tc.wantErr)
+			}
+		})
+	}
+}
@@ -18,6 +18,7 @@ import (
 	"golang.org/x/vulndb/internal/report"
 	"golang.org/x/vulndb/internal/stdlib"
 	"golang.org/x/vulndb/internal/version"
+	"golang.org/x/vulndb/internal/version/interval"
 )
 
 var (
@@ -36,10 +37,16 @@ func FromReport(r *report.Report) (_ *CVERecord, err error) {
 	if r.CVEMetadata.ID == "" {
 		return nil, errors.New("report missing CVE ID")
 	}
+	if r.Withdrawn != nil {
+		return rejected(r), nil
+	}
 	description := r.CVEMetadata.Description
 	if description == "" {
 		description = r.Description.String()
 	}
+	if text := r.ConditionsText(); text != "" {
+		description = fmt.Sprintf("%s\n\n%s", description, text)
+	}
 	if r.CVEMetadata.CWE == "" {
 		return nil, errors.New("report missing CWE")
 	}
@@ -68,21 +75,18 @@ func FromReport(r *report.Report) (_ *CVERecord, err error) {
 	}
 
 	for _, m := range r.Modules {
-		versions, defaultStatus := versionsToVersionRanges(m.Versions)
+		versions, defaultStatus := versionsToVersionRanges(m.Versions, m.UnaffectedVersions)
 		for _, p := range m.Packages {
-			affected := Affected{
-				Vendor:        report.Vendor(m.Module),
-				Product:       p.Package,
-				CollectionURL: "https://pkg.go.dev",
-				PackageName:   p.Package,
-				Versions:      versions,
-				DefaultStatus: defaultStatus,
-				Platforms:     p.GOOS,
-			}
-			for _, symbol := range p.AllSymbols() {
-				affected.ProgramRoutines = append(affected.ProgramRoutines, ProgramRoutine{Name: symbol})
+			c.Affected = append(c.Affected, packageAffected(m, p, versions, defaultStatus, p.GOOS))
+		}
+		// Versions that differ by platform are listed again for the
+		// packages on those platforms, with their GOOS and GOARCH as
+		// the platforms.
+		for _, pv := range m.PlatformVersions {
+			versions, defaultStatus := versionsToVersionRanges(pv.Versions, m.UnaffectedVersions)
+			for _, p := range pv.Packages(m) {
+				c.Affected = append(c.Affected, packageAffected(m, p, versions, defaultStatus, slices.Concat(p.GOOS, p.GOARCH)))
 			}
-			c.Affected = append(c.Affected, affected)
 		}
 	}
 
@@ -99,7 +103,8 @@ func FromReport(r *report.Report) (_ *CVERecord, err error) {
 	for _, credit := range r.Credits {
 		c.Credits = append(c.Credits, Credit{
 			Lang:  "en",
-			Value: credit,
+			Value: credit.Name,
+			Type:  toCreditType(credit.Type),
 		})
 	}
 
@@ -115,70 +120,140 @@ func FromReport(r *report.Report) (_ *CVERecord, err error) {
 	}, nil
 }
 
+func packageAffected(m *report.Module, p *report.Package, versions []VersionRange, defaultStatus VersionStatus, platforms []string) Affected {
+	affected := Affected{
+		Vendor:        report.Vendor(m.Module),
+		Product:       p.Package,
+		CollectionURL: "https://pkg.go.dev",
+		PackageName:   p.Package,
+		Versions:      versions,
+		DefaultStatus: defaultStatus,
+		Platforms:     platforms,
+	}
+	for _, symbol := range p.AllSymbols() {
+		affected.ProgramRoutines = append(affected.ProgramRoutines, ProgramRoutine{Name: symbol})
+	}
+	return affected
+}
+
+// rejected returns the REJECTED CVE record for a withdrawn report.
+func rejected(r *report.Report) *CVERecord {
+	return &CVERecord{
+		DataType:    "CVE_RECORD",
+		DataVersion: "5.0",
+		Metadata: Metadata{
+			ID:    r.CVEMetadata.ID,
+			State: StateRejected,
+		},
+		Containers: Containers{
+			CNAContainer: CNAPublishedContainer{
+				ProviderMetadata: ProviderMetadata{
+					OrgID: GoOrgUUID,
+				},
+				RejectedReasons: []Description{
+					{
+						Lang:  "en",
+						Value: RejectedReason(r),
+					},
+				},
+			},
+		},
+	}
+}
+
+// RejectedReason returns the explanation, for the CVE record,
+// of why the withdrawn report r was withdrawn.
+func RejectedReason(r *report.Report) string {
+	reason := "This candidate was withdrawn by its CNA"
+	if text := r.WithdrawnReason.Text(); text != "" {
+		reason += " because " + text
+	}
+	return reason + "."
+}
+
 const (
 	typeSemver  = "semver"
 	versionZero = "0"
 )
 
-func versionsToVersionRanges(vs report.Versions) ([]VersionRange, VersionStatus) {
+// versionsToVersionRanges returns the version ranges and default
+// status of a module with affected versions vs, and versions known
+// to be unaffected.
+func versionsToVersionRanges(vs, unaffected report.Versions) ([]VersionRange, VersionStatus) {
 	if len(vs) == 0 {
 		// If there are no recorded versions affected, we assume all versions are affected.
 		return nil, StatusAffected
 	}
 
-	var vrs []VersionRange
+	affected := versionsToSet(vs)
 
-	// If there is no final fixed version, then the default status is
-	// "affected" and we express the versions in terms of which ranges
-	// are *unaffected*. This is due to the fact that the CVE schema
-	// does not allow us to express a range as "version X.X.X and above are affected".
-	if vs[len(vs)-1].Type != report.VersionTypeFixed {
-		current := &VersionRange{}
-		for _, vr := range vs {
-			if vr.IsIntroduced() {
-				if current.Introduced == "" {
-					current.Introduced = versionZero
-				}
-				current.Fixed = Version(vr.Version)
-				current.Status = StatusUnaffected
-				current.VersionType = typeSemver
-				vrs = append(vrs, *current)
-				current = &VersionRange{}
-			} else if vr.IsFixed() {
-				current.Introduced = Version(vr.Version)
-			}
-		}
-		return vrs, StatusAffected
+	// If the latest versions are affected, then the default status
+	// is "affected" and we express the versions in terms of which
+	// ranges are *unaffected*. This is due to the fact that the CVE
+	// schema does not allow us to express a range as "version X.X.X
+	// and above are affected".
+	if !affected.IsEmpty() && affected.Latest() == "" {
+		return toVersionRanges(affected.Complement(), StatusUnaffected), StatusAffected
 	}
 
 	// Otherwise, express the version ranges normally as affected ranges,
-	// with a default status of "unaffected".
-	var current *VersionRange
-	for _, vr := range vs {
-		if vr.IsIntroduced() {
-			if current == nil {
-				current = &VersionRange{
-					Status:      StatusAffected,
-					VersionType: typeSemver,
-					Introduced:  Version(vr.Version),
-				}
-			}
+	// with a default status of "unaffected". The versions known to be
+	// unaffected are listed too, so that the record says which of
+	// them are not just assumed to be. (With a default status of
+	// "affected", they are already among the unaffected ranges.)
+	// A range with no end can't be expressed, and is implied by the
+	// default status anyway.
+	vrs := toVersionRanges(affected, StatusAffected)
+	for _, i := range versionsToSet(unaffected).Difference(affected) {
+		if i.Fixed != "" {
+			vrs = append(vrs, toVersionRanges(interval.Set{i}, StatusUnaffected)...)
 		}
-		if vr.IsFixed() {
+	}
+	return vrs, StatusUnaffected
+}
+
+// versionsToSet returns the set of versions affected according to vs,
+// in which each introduced version starts an affected interval and
+// each fixed version ends one.
+func versionsToSet(vs report.Versions) interval.Set {
+	var (
+		is      []interval.Interval
+		current *interval.Interval
+	)
+	for _, v := range vs {
+		switch {
+		case v.IsIntroduced() && current == nil:
+			current = &interval.Interval{Introduced: v.Version}
+		case v.IsFixed():
 			if current == nil {
-				current = &VersionRange{
-					Status:      StatusAffected,
-					VersionType: typeSemver,
-					Introduced:  versionZero,
-				}
+				current = &interval.Interval{}
 			}
-			current.Fixed = Version(vr.Version)
-			vrs = append(vrs, *current)
+			current.Fixed = v.Version
+			is = append(is, *current)
 			current = nil
 		}
 	}
+	if current != nil {
+		is = append(is, *current)
+	}
+	return interval.Normalize(is...)
+}
 
-	return vrs, StatusUnaffected
+func toVersionRanges(s interval.Set, status VersionStatus) []VersionRange {
+	var vrs []VersionRange
+	for _, i := range s {
+		introduced := Version(i.Introduced)
+		if introduced == "" {
+			introduced = versionZero
+		}
+		vrs = append(vrs, VersionRange{
+			Introduced:  introduced,
+			Fixed:       Version(i.Fixed),
+			Status:      status,
+			VersionType: typeSemver,
+		})
+	}
+	return vrs
 }
 
 var _ report.Source = &CVERecord{}
@@ -209,9 +284,9 @@ func cve5ToReport(c *CVERecord, pxc *proxy.Client, modulePath string) *report.Re
 		}
 	}
 
-	var credits []string
+	var credits []*report.Credit
 	for _, c := range cna.Credits {
-		credits = append(credits, c.Value)
+		credits = append(credits, convertCredit(c))
 	}
 
 	var refs []*report.Reference
@@ -231,6 +306,23 @@ func cve5ToReport(c *CVERecord, pxc *proxy.Client, modulePath string) *report.Re
 	return r
 }
 
+// toCreditType converts an OSV credit type to a CVE 5.0 credit type.
+func toCreditType(t osv.CreditType) string {
+	return strings.ReplaceAll(strings.ToLower(string(t)), "_", " ")
+}
+
+// convertCredit converts a CVE 5.0 credit to a report credit.
+// Untyped credits are parsed as free-form credits.
+func convertCredit(c Credit) *report.Credit {
+	if c.Type == "" || !slices.Contains(CreditTypes, c.Type) {
+		return report.ParseCredit(c.Value)
+	}
+	return &report.Credit{
+		Name: c.Value,
+		Type: osv.CreditType(strings.ToUpper(strings.ReplaceAll(c.Type, " ", "_"))),
+	}
+}
+
 func convertRef(ref Reference) *report.Reference {
 	if t := typeFromTags(ref.Tags); t != osv.ReferenceTypeWeb {
 		return &report.Reference{
@@ -12,6 +12,7 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"golang.org/x/vulndb/internal/cvelistrepo"
+	"golang.org/x/vulndb/internal/osv"
 	"golang.org/x/vulndb/internal/report"
 )
 
@@ -266,6 +267,31 @@ func TestFromReport(t *testing.T) {
 			filename: "testdata/no-versions.yaml",
 			want:     testNoVersionsRecord,
 		},
+		{
+			name:     "Withdrawn Report",
+			filename: "testdata/withdrawn-report.yaml",
+			want: &CVERecord{
+				DataType:    "CVE_RECORD",
+				DataVersion: "5.0",
+				Metadata: Metadata{
+					ID:    "CVE-9999-0001",
+					State: StateRejected,
+				},
+				Containers: Containers{
+					CNAContainer: CNAPublishedContainer{
+						ProviderMetadata: ProviderMetadata{
+							OrgID: GoOrgUUID,
+						},
+						RejectedReasons: []Description{
+							{
+								Lang:  "en",
+								Value: "This candidate was withdrawn by its CNA because it was created in error.",
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
@@ -284,10 +310,59 @@ func TestFromReport(t *testing.T) {
 	}
 }
 
+func TestFromReportPlatformVersions(t *testing.T) {
+	r := &report.Report{
+		ID: "GO-9999-0001",
+		Modules: []*report.Module{{
+			Module:   "golang.org/x/sys",
+			Versions: report.Versions{report.Fixed("0.5.0")},
+			PlatformVersions: []*report.PlatformVersions{
+				{GOOS: []string{"windows"}, GOARCH: []string{"arm64"}, Versions: report.Versions{report.Fixed("0.6.0")}},
+			},
+			Packages: []*report.Package{
+				{Package: "golang.org/x/sys/unix", GOOS: []string{"linux"}},
+				{Package: "golang.org/x/sys/windows"},
+			},
+		}},
+		Description: "A description",
+		CVEMetadata: &report.CVEMeta{ID: "CVE-9999-0001", CWE: "CWE-20: Improper Input Validation"},
+	}
+	got, err := FromReport(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	affected := func(pkg string, fixed string, platforms ...string) Affected {
+		return Affected{
+			Vendor:        "golang.org/x/sys",
+			Product:       pkg,
+			CollectionURL: "https://pkg.go.dev",
+			PackageName:   pkg,
+			Versions: []VersionRange{{
+				Introduced:  "0",
+				Fixed:       Version(fixed),
+				Status:      StatusAffected,
+				VersionType: "semver",
+			}},
+			DefaultStatus: StatusUnaffected,
+			Platforms:     platforms,
+		}
+	}
+	want := []Affected{
+		affected("golang.org/x/sys/unix", "0.5.0", "linux"),
+		affected("golang.org/x/sys/windows", "0.5.0"),
+		// golang.org/x/sys/unix is not built on windows.
+		affected("golang.org/x/sys/windows", "0.6.0", "windows", "arm64"),
+	}
+	if diff := cmp.Diff(want, got.Containers.CNAContainer.Affected); diff != "" {
+		t.Errorf("Affected mismatch (-want, +got):\n%s", diff)
+	}
+}
+
 func TestVersionRangeToVersionRange(t *testing.T) {
 	tests := []struct {
 		name        string
 		versions    report.Versions
+		unaffected  report.Versions
 		wantRange   []VersionRange
 		wantDefault VersionStatus
 	}{
@@ -412,10 +487,36 @@ func TestVersionRangeToVersionRange(t *testing.T) {
 			},
 			wantDefault: StatusAffected,
 		},
+		{
+			name: "unaffected",
+			versions: report.Versions{
+				report.Introduced("1.2.0"),
+				report.Fixed("1.2.5"),
+			},
+			unaffected: report.Versions{
+				report.Fixed("1.2.0"),
+				report.Introduced("1.3.0"),
+			},
+			wantRange: []VersionRange{
+				{
+					Introduced:  "1.2.0",
+					Fixed:       "1.2.5",
+					Status:      StatusAffected,
+					VersionType: typeSemver,
+				},
+				{
+					Introduced:  "0",
+					Fixed:       "1.2.0",
+					Status:      StatusUnaffected,
+					VersionType: typeSemver,
+				},
+			},
+			wantDefault: StatusUnaffected,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			gotRange, gotStatus := versionsToVersionRanges(tt.versions)
+			gotRange, gotStatus := versionsToVersionRanges(tt.versions, tt.unaffected)
 			if !reflect.DeepEqual(gotRange, tt.wantRange) {
 				t.Errorf("versionRangeToVersionRange() got version range = %v, want %v", gotRange, tt.wantRange)
 			}
@@ -544,3 +645,38 @@ func TestToReport(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestCredits(t *testing.T) {
+	r := &report.Report{
+		Credits: []*report.Credit{
+			{Name: "Jane Doe"},
+			{Name: "John Doe", Type: osv.CreditTypeRemediationDeveloper, Contact: []string{"https://github.com/johndoe"}},
+		},
+	}
+	wantCVE := []Credit{
+		{Lang: "en", Value: "Jane Doe"},
+		{Lang: "en", Value: "John Doe", Type: "remediation developer"},
+	}
+	var got []Credit
+	for _, c := range r.Credits {
+		got = append(got, Credit{Lang: "en", Value: c.Name, Type: toCreditType(c.Type)})
+	}
+	if diff := cmp.Diff(wantCVE, got); diff != "" {
+		t.Errorf("toCreditType mismatch (-want, +got):\n%s", diff)
+	}
+
+	// Contacts are not part of CVE credits, and untyped credits
+	// are parsed.
+	wantReport := []*report.Credit{
+		{Name: "Jane Doe"},
+		{Name: "John Doe", Type: osv.CreditTypeRemediationDeveloper},
+		{Name: "Jane Roe", Contact: []string{"https://github.com/jroe"}},
+	}
+	var gotReport []*report.Credit
+	for _, c := range append(wantCVE, Credit{Lang: "en", Value: "Jane Roe (@jroe)"}) {
+		gotReport = append(gotReport, convertCredit(c))
+	}
+	if diff := cmp.Diff(wantReport, gotReport); diff != "" {
+		t.Errorf("convertCredit mismatch (-want, +got):\n%s", diff)
+	}
+}
@@ -7,6 +7,7 @@
 package cvelistrepo
 
 import (
+	"context"
 	"fmt"
 	"path"
 	"sort"
@@ -28,6 +29,28 @@ const (
 	URLv5 = "https://github.com/CVEProject/cvelistV5"
 )
 
+// Clone returns a bare repo by cloning the CVE list repo at url at the
+// commit with the given hash, or at HEAD if commit is "" or "HEAD".
+// Only that commit is fetched: the history of the repo, which is
+// large, is not needed to read the CVEs.
+func Clone(ctx context.Context, url, commit string) (*git.Repository, error) {
+	opts := &gitrepo.ShallowOptions{}
+	if commit != "" && commit != plumbing.HEAD.String() {
+		opts.Commits = []string{commit}
+	}
+	return gitrepo.CloneShallow(ctx, url, opts)
+}
+
+// CloneOrOpen clones the CVE list repo at repoPath at the given commit
+// (see Clone) if it is an HTTP(S) URL, or opens it from the local disk
+// otherwise.
+func CloneOrOpen(ctx context.Context, repoPath, commit string) (*git.Repository, error) {
+	if strings.HasPrefix(repoPath, "http://") || strings.HasPrefix(repoPath, "https://") {
+		return Clone(ctx, repoPath, commit)
+	}
+	return gitrepo.Open(ctx, repoPath)
+}
+
 // A File is a file in the cvelist repo that contains a CVE.
 type File struct {
 	DirPath  string
@@ -102,6 +125,20 @@ func isCVEFilename(name string) bool {
 	return strings.HasPrefix(name, "CVE-") && path.Ext(name) == ".json"
 }
 
+// PathV5 returns the path of the record of the CVE with the given ID
+// in the cvelistV5 repo, for example "cves/2023/45xxx/CVE-2023-45283.json".
+func PathV5(cveID string) (string, error) {
+	if !idstr.IsCVE(cveID) {
+		return "", fmt.Errorf("%q is not a CVE ID", cveID)
+	}
+	parts := strings.Split(cveID, "-")
+	number, err := strconv.Atoi(parts[2])
+	if err != nil {
+		return "", err
+	}
+	return path.Join("cves", parts[1], fmt.Sprintf("%dxxx", number/1000), cveID+".json"), nil
+}
+
 func (f *File) ID() string {
 	return idstr.FindCVE(f.Filename)
 }
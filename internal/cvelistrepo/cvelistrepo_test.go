@@ -5,6 +5,7 @@
 package cvelistrepo
 
 import (
+	"bytes"
 	"context"
 	"flag"
 	"fmt"
@@ -133,3 +134,54 @@ func testParse[S report.Source](t *testing.T, name, txtarFile string) {
 		}
 	})
 }
+
+func TestPathV5(t *testing.T) {
+	for _, test := range []struct {
+		id, want string
+	}{
+		{"CVE-2023-45283", "cves/2023/45xxx/CVE-2023-45283.json"},
+		{"CVE-2021-0001", "cves/2021/0xxx/CVE-2021-0001.json"},
+		{"CVE-2014-100009", "cves/2014/100xxx/CVE-2014-100009.json"},
+	} {
+		got, err := PathV5(test.id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != test.want {
+			t.Errorf("PathV5(%s) = %s, want %s", test.id, got, test.want)
+		}
+	}
+	if _, err := PathV5("GO-2023-0001"); err == nil {
+		t.Error("PathV5(GO-2023-0001): got nil, want error")
+	}
+}
+
+func TestReadTxtarFile(t *testing.T) {
+	for _, test := range []struct {
+		filename string
+		wantURL  string
+	}{
+		{filename: v4txtar, wantURL: URLv4},
+		{filename: v5txtar, wantURL: URLv5},
+	} {
+		t.Run(test.filename, func(t *testing.T) {
+			url, records, err := ReadTxtarFile(test.filename)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if url != test.wantURL {
+				t.Errorf("url = %q, want %q", url, test.wantURL)
+			}
+			var ids []string
+			for id, data := range records {
+				ids = append(ids, id)
+				if !bytes.Contains(data, []byte(id)) {
+					t.Errorf("%s: record does not mention its ID", id)
+				}
+			}
+			if diff := cmp.Diff(cveIDs, ids, cmpopts.SortSlices(func(a, b string) bool { return a < b })); diff != "" {
+				t.Errorf("IDs mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,232 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cvelistrepo
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"golang.org/x/vulndb/internal/derrors"
+	"golang.org/x/vulndb/internal/nvd"
+)
+
+// A Source is a source of CVE records: the cvelist repo, the NVD,
+// or the feed of an individual CNA. Copies of a record from
+// different sources may differ, for example because a CNA publishes
+// to its own feed before the cvelist repo is updated.
+type Source interface {
+	// Name identifies the source in provenance, for example "cvelist".
+	Name() string
+	// Fetch returns the source's copy of the record of the CVE
+	// with the given ID, or (nil, nil) if the source has none.
+	Fetch(ctx context.Context, cveID string) (*Record, error)
+}
+
+// A Record is a copy of a CVE record from a Source.
+type Record struct {
+	ID string
+	// Source is the name of the source.
+	Source string
+	// Updated is when the record was last updated, according to
+	// the source. It is zero if the source does not say.
+	Updated time.Time
+	// Version identifies the copy, for change detection:
+	// for example, a blob hash or a modification timestamp.
+	Version string
+	// URL is where the copy can be viewed, if anywhere.
+	URL string
+	// Data is the record as served by the source.
+	Data []byte
+}
+
+// Freshest returns the most recently updated of the records,
+// or nil if there are none. Records with no update time are
+// considered older than any with one; ties go to the earliest
+// record in the list, so that callers can order sources by trust.
+func Freshest(rs []*Record) *Record {
+	var freshest *Record
+	for _, r := range rs {
+		if r == nil {
+			continue
+		}
+		if freshest == nil || r.Updated.After(freshest.Updated) {
+			freshest = r
+		}
+	}
+	return freshest
+}
+
+// Names of the built-in sources.
+const (
+	SourceCVEList = "cvelist"
+	SourceNVD     = "nvd"
+)
+
+// NewRepoSource returns a Source that reads CVE JSON 5.0 records
+// from the given commit of the cvelistV5 repo.
+func NewRepoSource(commit *object.Commit) Source {
+	return &repoSource{commit: commit}
+}
+
+type repoSource struct {
+	commit *object.Commit
+}
+
+func (*repoSource) Name() string { return SourceCVEList }
+
+func (s *repoSource) Fetch(_ context.Context, cveID string) (_ *Record, err error) {
+	defer derrors.Wrap(&err, "repoSource.Fetch(%s)", cveID)
+
+	p, err := PathV5(cveID)
+	if err != nil {
+		return nil, err
+	}
+	f, err := s.commit.File(p)
+	if errors.Is(err, object.ErrFileNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	contents, err := f.Contents()
+	if err != nil {
+		return nil, err
+	}
+	r, err := cve5Record(cveID, SourceCVEList, []byte(contents))
+	if err != nil {
+		return nil, err
+	}
+	r.Version = f.Hash.String()
+	r.URL = fmt.Sprintf("%s/blob/%s/%s", URLv5, s.commit.Hash, p)
+	return r, nil
+}
+
+// NewNVDSource returns a Source that reads records from the NVD.
+func NewNVDSource(c *nvd.Client) Source {
+	return &nvdSource{c: c}
+}
+
+type nvdSource struct {
+	c *nvd.Client
+}
+
+func (*nvdSource) Name() string { return SourceNVD }
+
+func (s *nvdSource) Fetch(ctx context.Context, cveID string) (_ *Record, err error) {
+	defer derrors.Wrap(&err, "nvdSource.Fetch(%s)", cveID)
+
+	c, err := s.c.Get(ctx, cveID)
+	if err != nil || c == nil {
+		return nil, err
+	}
+	data, err := json.Marshal(c)
+	if err != nil {
+		return nil, err
+	}
+	r := &Record{
+		ID:      cveID,
+		Source:  SourceNVD,
+		Version: c.LastModified,
+		URL:     "https://nvd.nist.gov/vuln/detail/" + cveID,
+		Data:    data,
+	}
+	if t, err := c.LastModifiedTime(); err == nil {
+		r.Updated = t
+	}
+	return r, nil
+}
+
+// NewFeedSource returns a Source called name that reads CVE JSON 5.0
+// records over HTTP from the feed of a CNA. The URL of the record of a
+// CVE is urlTemplate with "{id}" replaced by the CVE ID. A 404 response
+// means the feed has no record of the CVE.
+func NewFeedSource(name, urlTemplate string, hc *http.Client) Source {
+	if hc == nil {
+		hc = http.DefaultClient
+	}
+	return &feedSource{name: name, urlTemplate: urlTemplate, hc: hc}
+}
+
+type feedSource struct {
+	name, urlTemplate string
+	hc                *http.Client
+}
+
+func (s *feedSource) Name() string { return s.name }
+
+func (s *feedSource) Fetch(ctx context.Context, cveID string) (_ *Record, err error) {
+	defer derrors.Wrap(&err, "feedSource(%s).Fetch(%s)", s.name, cveID)
+
+	u := strings.ReplaceAll(s.urlTemplate, "{id}", cveID)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.hc.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("GET %s: HTTP error: %s", u, resp.Status)
+	}
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	r, err := cve5Record(cveID, s.name, data)
+	if err != nil {
+		return nil, err
+	}
+	r.Version = r.Updated.UTC().Format(time.RFC3339)
+	r.URL = u
+	return r, nil
+}
+
+// cve5Record returns a Record for the CVE JSON 5.0 data,
+// which must be the record of the CVE with the given ID.
+//
+// Only the metadata is decoded, so that this package need not
+// depend on package cve5, whose tests depend on this package.
+func cve5Record(cveID, source string, data []byte) (*Record, error) {
+	var c struct {
+		Metadata struct {
+			ID          string `json:"cveId"`
+			DateUpdated string `json:"dateUpdated"`
+		} `json:"cveMetadata"`
+	}
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, err
+	}
+	if c.Metadata.ID != cveID {
+		return nil, fmt.Errorf("got record of %q, want %q", c.Metadata.ID, cveID)
+	}
+	var updated time.Time
+	if d := c.Metadata.DateUpdated; d != "" {
+		var err error
+		if updated, err = time.Parse(time.RFC3339, d); err != nil {
+			if updated, err = time.Parse("2006-01-02T15:04:05", d); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return &Record{
+		ID:      cveID,
+		Source:  source,
+		Updated: updated,
+		Data:    data,
+	}, nil
+}
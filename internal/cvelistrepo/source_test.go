@@ -0,0 +1,114 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cvelistrepo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/vulndb/internal/gitrepo"
+)
+
+func TestRepoSource(t *testing.T) {
+	_, commit, err := gitrepo.TxtarRepoAndHead(v5txtar)
+	if err != nil {
+		t.Fatal(err)
+	}
+	src := NewRepoSource(commit)
+	ctx := context.Background()
+
+	r, err := src.Fetch(ctx, "CVE-2021-0001")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r == nil {
+		t.Fatal("Fetch(CVE-2021-0001) = nil, want record")
+	}
+	if r.Source != SourceCVEList || r.ID != "CVE-2021-0001" || r.Version == "" {
+		t.Errorf("Fetch(CVE-2021-0001) = {ID: %s, Source: %s, Version: %s}, want ID, source %s and version", r.ID, r.Source, r.Version, SourceCVEList)
+	}
+	if want := time.Date(2021, 6, 9, 19, 1, 55, 0, time.UTC); !r.Updated.Equal(want) {
+		t.Errorf("Updated = %s, want %s", r.Updated, want)
+	}
+
+	r, err = src.Fetch(ctx, "CVE-2021-9999")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r != nil {
+		t.Errorf("Fetch(CVE-2021-9999) = %+v, want nil", r)
+	}
+}
+
+func TestFeedSource(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := strings.TrimPrefix(r.URL.Path, "/cves/")
+		switch id {
+		case "CVE-2024-0001":
+			fmt.Fprintf(w, `{"dataType": "CVE_RECORD", "cveMetadata": {"cveId": %q, "dateUpdated": "2024-03-04T05:06:07"}}`, id)
+		case "CVE-2024-0002":
+			http.Error(w, "boom", http.StatusInternalServerError)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer s.Close()
+
+	src := NewFeedSource("example-cna", s.URL+"/cves/{id}", s.Client())
+	ctx := context.Background()
+
+	r, err := src.Fetch(ctx, "CVE-2024-0001")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r == nil {
+		t.Fatal("Fetch(CVE-2024-0001) = nil, want record")
+	}
+	if want := s.URL + "/cves/CVE-2024-0001"; r.URL != want {
+		t.Errorf("URL = %q, want %q", r.URL, want)
+	}
+	if r.Source != "example-cna" {
+		t.Errorf("Source = %q, want %q", r.Source, "example-cna")
+	}
+	if want := time.Date(2024, 3, 4, 5, 6, 7, 0, time.UTC); !r.Updated.Equal(want) {
+		t.Errorf("Updated = %s, want %s", r.Updated, want)
+	}
+
+	r, err = src.Fetch(ctx, "CVE-2024-0003")
+	if err != nil || r != nil {
+		t.Errorf("Fetch(CVE-2024-0003) = (%+v, %v), want (nil, nil)", r, err)
+	}
+	if _, err := src.Fetch(ctx, "CVE-2024-0002"); err == nil {
+		t.Error("Fetch(CVE-2024-0002): got nil error, want error")
+	}
+}
+
+func TestFreshest(t *testing.T) {
+	t1 := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	t2 := t1.Add(time.Hour)
+	a := &Record{Source: "a", Updated: t1}
+	b := &Record{Source: "b", Updated: t2}
+	c := &Record{Source: "c", Updated: t2}
+	d := &Record{Source: "d"}
+	for _, tc := range []struct {
+		rs   []*Record
+		want *Record
+	}{
+		{nil, nil},
+		{[]*Record{nil, d}, d},
+		{[]*Record{d, a}, a},
+		{[]*Record{a, b, c}, b},
+		{[]*Record{c, b, a}, c},
+	} {
+		if got := Freshest(tc.rs); got != tc.want {
+			t.Errorf("Freshest(%v) = %v, want %v", tc.rs, got, tc.want)
+		}
+	}
+}
@@ -10,7 +10,9 @@ import (
 	"os"
 	"path"
 	"path/filepath"
+	"regexp"
 	"slices"
+	"strings"
 	"testing"
 	"time"
 
@@ -66,8 +68,38 @@ var (
 )
 
 func UpdateTxtar(ctx context.Context, url string, ids []string) error {
+	return UpdateTxtarFile(ctx, url, txtarRepo, ids)
+}
+
+// UpdateTxtarFile writes the given CVEs, as they are at the head of the
+// CVE list (v4 or v5) in url, to the txtar repo filename.
+func UpdateTxtarFile(ctx context.Context, url, filename string, ids []string) error {
+	ids = slices.Clone(ids)
 	slices.Sort(ids)
-	return writeTxtarRepo(ctx, url, txtarRepo, ids)
+	return writeTxtarRepo(ctx, url, filename, ids)
+}
+
+var shapeRegexp = regexp.MustCompile(`Repo in the shape of "([^"]+)"`)
+
+// ReadTxtarFile reads a txtar repo written by UpdateTxtarFile, and
+// returns the URL of the CVE list it is in the shape of, and the
+// contents of its CVE records by CVE ID.
+func ReadTxtarFile(filename string) (url string, records map[string][]byte, err error) {
+	ar, err := txtar.ParseFile(filename)
+	if err != nil {
+		return "", nil, err
+	}
+	m := shapeRegexp.FindSubmatch(ar.Comment)
+	if m == nil {
+		return "", nil, fmt.Errorf("%s: no CVE list URL in comment", filename)
+	}
+	records = make(map[string][]byte)
+	for _, f := range ar.Files {
+		if name := path.Base(f.Name); isCVEFilename(name) {
+			records[strings.TrimSuffix(name, ".json")] = f.Data
+		}
+	}
+	return string(m[1]), records, nil
 }
 
 func RunTest[S report.Source](t *testing.T, update bool, wantFunc func(*testing.T, S) ([]txtar.File, error)) error {
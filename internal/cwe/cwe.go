@@ -0,0 +1,254 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package cwe suggests Common Weakness Enumeration (CWE) entries
+// for vulnerabilities, based on their descriptions.
+package cwe
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// A Candidate is a CWE suggested for a vulnerability.
+type Candidate struct {
+	// ID is the CWE ID, of the form "CWE-NNN".
+	ID string
+	// Name is the name of the weakness, or empty if unknown.
+	Name string
+	// Confidence is a measure, from 0 to 1, of how well
+	// the weakness matches the vulnerability.
+	Confidence float64
+}
+
+// String returns the candidate in the form used in the cve_metadata.cwe
+// field of a report, e.g. "CWE-400: Uncontrolled Resource Consumption".
+func (c *Candidate) String() string {
+	if c.Name == "" {
+		return c.ID
+	}
+	return fmt.Sprintf("%s: %s", c.ID, c.Name)
+}
+
+// Name returns the name of the weakness with the given ID,
+// or "" if the ID is not known to the classifier.
+func Name(id string) string {
+	for _, r := range rules {
+		if r.id == id {
+			return r.name
+		}
+	}
+	return ""
+}
+
+// Classify returns up to n candidate CWEs for the vulnerability
+// described by text, most likely first.
+//
+// Classification is rule-based: each known weakness has a list of
+// weighted keywords, and a weakness's confidence grows with the total
+// weight of its keywords that appear in text.
+func Classify(text string, n int) []*Candidate {
+	var cs []*Candidate
+	for _, r := range rules {
+		score := 0
+		for _, k := range r.keywords {
+			if k.re.MatchString(text) {
+				score += k.weight
+			}
+		}
+		if score == 0 {
+			continue
+		}
+		cs = append(cs, &Candidate{
+			ID:         r.id,
+			Name:       r.name,
+			Confidence: float64(score) / float64(score+1),
+		})
+	}
+	return top(cs, n)
+}
+
+// Merge combines lists of candidates, as returned by different
+// classifiers, and returns the n most likely.
+// If a CWE appears in more than one list, the highest confidence is
+// kept, and its name is taken from the classifier's table if known.
+func Merge(n int, lists ...[]*Candidate) []*Candidate {
+	byID := make(map[string]*Candidate)
+	var cs []*Candidate
+	for _, l := range lists {
+		for _, c := range l {
+			if prev, ok := byID[c.ID]; ok {
+				prev.Confidence = max(prev.Confidence, c.Confidence)
+				continue
+			}
+			c := *c
+			if name := Name(c.ID); name != "" {
+				c.Name = name
+			}
+			byID[c.ID] = &c
+			cs = append(cs, &c)
+		}
+	}
+	return top(cs, n)
+}
+
+// top sorts cs by decreasing confidence, breaking ties by ID,
+// and returns the first n.
+func top(cs []*Candidate, n int) []*Candidate {
+	slices.SortFunc(cs, func(a, b *Candidate) int {
+		switch {
+		case a.Confidence > b.Confidence:
+			return -1
+		case a.Confidence < b.Confidence:
+			return 1
+		default:
+			return strings.Compare(a.ID, b.ID)
+		}
+	})
+	if len(cs) > n {
+		cs = cs[:n]
+	}
+	return cs
+}
+
+type rule struct {
+	id, name string
+	keywords []keyword
+}
+
+type keyword struct {
+	re     *regexp.Regexp
+	weight int
+}
+
+// Keyword weights: a strong keyword is nearly conclusive on its own,
+// and a weak one only hints at the weakness.
+const (
+	weak   = 1
+	medium = 2
+	strong = 3
+)
+
+// kw returns a keyword that matches phrase case-insensitively
+// at the start of a word.
+func kw(phrase string, weight int) keyword {
+	expr := regexp.QuoteMeta(phrase)
+	if c := phrase[0]; c == '_' || 'a' <= c && c <= 'z' || '0' <= c && c <= '9' {
+		expr = `\b` + expr
+	}
+	return keyword{re: regexp.MustCompile(`(?i)` + expr), weight: weight}
+}
+
+// rules are the weaknesses known to the classifier, and the keywords
+// that suggest them. The weaknesses are those most often seen in
+// Go vulnerabilities.
+var rules = []rule{
+	{"CWE-20", "Improper Input Validation", []keyword{
+		kw("input validation", medium), kw("validat", weak), kw("malformed", weak), kw("untrusted input", weak),
+	}},
+	{"CWE-22", "Improper Limitation of a Pathname to a Restricted Directory ('Path Traversal')", []keyword{
+		kw("path traversal", strong), kw("directory traversal", strong), kw("../", medium), kw("outside of the", weak), kw("outside the", weak),
+	}},
+	{"CWE-59", "Improper Link Resolution Before File Access ('Link Following')", []keyword{
+		kw("symlink", strong), kw("symbolic link", strong), kw("hard link", medium),
+	}},
+	{"CWE-78", "Improper Neutralization of Special Elements used in an OS Command ('OS Command Injection')", []keyword{
+		kw("command injection", strong), kw("shell command", medium), kw("arbitrary command", medium), kw("execute commands", medium),
+	}},
+	{"CWE-79", "Improper Neutralization of Input During Web Page Generation ('Cross-site Scripting')", []keyword{
+		kw("cross-site scripting", strong), kw("cross site scripting", strong), kw("xss", strong), kw("html injection", medium), kw("javascript", weak),
+	}},
+	{"CWE-88", "Improper Neutralization of Argument Delimiters in a Command ('Argument Injection')", []keyword{
+		kw("argument injection", strong), kw("command-line argument", medium), kw("command line argument", medium),
+	}},
+	{"CWE-89", "Improper Neutralization of Special Elements used in an SQL Command ('SQL Injection')", []keyword{
+		kw("sql injection", strong), kw("sql", weak),
+	}},
+	{"CWE-94", "Improper Control of Generation of Code ('Code Injection')", []keyword{
+		kw("code injection", strong), kw("template injection", medium), kw("arbitrary code", medium), kw("remote code execution", medium), kw("code execution", weak),
+	}},
+	{"CWE-113", "Improper Neutralization of CRLF Sequences in HTTP Headers ('HTTP Request/Response Splitting')", []keyword{
+		kw("crlf", strong), kw("header injection", strong), kw("response splitting", strong),
+	}},
+	{"CWE-125", "Out-of-bounds Read", []keyword{
+		kw("out-of-bounds read", strong), kw("out of bounds read", strong), kw("over-read", strong), kw("out of bounds", medium), kw("out-of-bounds", medium), kw("index out of range", medium),
+	}},
+	{"CWE-150", "Improper Neutralization of Escape, Meta, or Control Sequences", []keyword{
+		kw("escape sequence", strong), kw("control character", medium), kw("control sequence", medium), kw("terminal", weak),
+	}},
+	{"CWE-190", "Integer Overflow or Wraparound", []keyword{
+		kw("integer overflow", strong), kw("wraparound", medium), kw("overflow", weak),
+	}},
+	{"CWE-200", "Exposure of Sensitive Information to an Unauthorized Actor", []keyword{
+		kw("information disclosure", medium), kw("sensitive information", medium), kw("information leak", medium), kw("leak", weak), kw("expos", weak),
+	}},
+	{"CWE-208", "Observable Timing Discrepancy", []keyword{
+		kw("timing", medium), kw("constant time", medium), kw("constant-time", medium), kw("side channel", medium), kw("side-channel", medium),
+	}},
+	{"CWE-269", "Improper Privilege Management", []keyword{
+		kw("privilege escalation", strong), kw("escalate privileges", strong), kw("privilege", weak),
+	}},
+	{"CWE-287", "Improper Authentication", []keyword{
+		kw("authentication bypass", strong), kw("bypass authentication", strong), kw("unauthenticated", weak), kw("authenticat", weak),
+	}},
+	{"CWE-295", "Improper Certificate Validation", []keyword{
+		kw("certificate validation", strong), kw("certificate verification", strong), kw("insecureskipverify", strong), kw("certificate", medium), kw("x509", weak), kw("tls", weak),
+	}},
+	{"CWE-327", "Use of a Broken or Risky Cryptographic Algorithm", []keyword{
+		kw("weak cryptograph", strong), kw("broken cryptograph", strong), kw("weak cipher", medium), kw("cryptograph", weak),
+	}},
+	{"CWE-347", "Improper Verification of Cryptographic Signature", []keyword{
+		kw("signature verification", strong), kw("verify signature", strong), kw("forged signature", strong), kw("signature", medium),
+	}},
+	{"CWE-352", "Cross-Site Request Forgery (CSRF)", []keyword{
+		kw("cross-site request forgery", strong), kw("cross site request forgery", strong), kw("csrf", strong),
+	}},
+	{"CWE-362", "Concurrent Execution using Shared Resource with Improper Synchronization ('Race Condition')", []keyword{
+		kw("race condition", strong), kw("data race", strong), kw("concurrent", weak), kw("synchroniz", weak),
+	}},
+	{"CWE-400", "Uncontrolled Resource Consumption", []keyword{
+		kw("resource consumption", strong), kw("resource exhaustion", medium), kw("denial of service", weak), kw("excessive", weak), kw("consum", weak),
+	}},
+	{"CWE-444", "Inconsistent Interpretation of HTTP Requests ('HTTP Request/Response Smuggling')", []keyword{
+		kw("request smuggling", strong), kw("smuggl", medium), kw("transfer-encoding", medium), kw("content-length", weak),
+	}},
+	{"CWE-476", "NULL Pointer Dereference", []keyword{
+		kw("nil pointer", strong), kw("null pointer", strong), kw("nil dereference", strong), kw("nil-pointer", strong),
+	}},
+	{"CWE-502", "Deserialization of Untrusted Data", []keyword{
+		kw("deserializ", strong), kw("unmarshal", weak),
+	}},
+	{"CWE-532", "Insertion of Sensitive Information into Log File", []keyword{
+		kw("log file", medium), kw("logged", medium), kw("logs", weak),
+	}},
+	{"CWE-601", "URL Redirection to Untrusted Site ('Open Redirect')", []keyword{
+		kw("open redirect", strong), kw("redirect", weak),
+	}},
+	{"CWE-611", "Improper Restriction of XML External Entity Reference", []keyword{
+		kw("xml external entit", strong), kw("xxe", strong),
+	}},
+	{"CWE-674", "Uncontrolled Recursion", []keyword{
+		kw("uncontrolled recursion", strong), kw("stack exhaustion", strong), kw("deeply nested", strong), kw("recursion", medium), kw("recursive", medium), kw("stack overflow", medium),
+	}},
+	{"CWE-682", "Incorrect Calculation", []keyword{
+		kw("incorrect calculation", strong), kw("miscalculat", medium), kw("incorrectly calculat", medium), kw("incorrectly comput", medium),
+	}},
+	{"CWE-770", "Allocation of Resources Without Limits or Throttling", []keyword{
+		kw("without limit", medium), kw("unbounded", medium), kw("no limit", medium), kw("allocat", medium), kw("memory exhaustion", weak),
+	}},
+	{"CWE-835", "Loop with Unreachable Exit Condition ('Infinite Loop')", []keyword{
+		kw("infinite loop", strong), kw("infinitely", medium), kw("hang", weak), kw("loop", weak),
+	}},
+	{"CWE-862", "Missing Authorization", []keyword{
+		kw("missing authorization", strong), kw("authorization check", medium), kw("unauthorized", weak), kw("authoriz", weak),
+	}},
+	{"CWE-918", "Server-Side Request Forgery (SSRF)", []keyword{
+		kw("server-side request forgery", strong), kw("server side request forgery", strong), kw("ssrf", strong),
+	}},
+	{"CWE-1333", "Inefficient Regular Expression Complexity", []keyword{
+		kw("redos", strong), kw("regular expression", medium), kw("regex", medium),
+	}},
+}
@@ -0,0 +1,94 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cwe
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestClassify(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		text string
+		want []string
+	}{
+		{
+			name: "recursion",
+			text: "Parsing a deeply nested document can cause a stack overflow due to unbounded recursion.",
+			want: []string{"CWE-674", "CWE-770"},
+		},
+		{
+			name: "path traversal",
+			text: "A crafted archive entry such as ../../etc/passwd can write files outside the target directory (path traversal).",
+			want: []string{"CWE-22"},
+		},
+		{
+			name: "smuggling",
+			text: "Improper handling of Transfer-Encoding headers allows HTTP request smuggling.",
+			want: []string{"CWE-444"},
+		},
+		{
+			name: "denial of service",
+			text: "An attacker can cause an infinite loop, resulting in excessive CPU consumption and denial of service.",
+			want: []string{"CWE-835", "CWE-400"},
+		},
+		{
+			name: "word start",
+			text: "The change fixes the behavior.",
+			want: nil,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var got []string
+			for _, c := range Classify(tc.text, 2) {
+				got = append(got, c.ID)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Classify() mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestClassifyConfidence(t *testing.T) {
+	cs := Classify("A nil pointer dereference causes a panic.", 3)
+	if len(cs) != 1 {
+		t.Fatalf("got %d candidates, want 1", len(cs))
+	}
+	want := &Candidate{ID: "CWE-476", Name: "NULL Pointer Dereference", Confidence: 0.75}
+	if diff := cmp.Diff(want, cs[0]); diff != "" {
+		t.Errorf("mismatch (-want, +got):\n%s", diff)
+	}
+	if got, want := cs[0].String(), "CWE-476: NULL Pointer Dereference"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+}
+
+func TestMerge(t *testing.T) {
+	rules := []*Candidate{
+		{ID: "CWE-400", Name: "Uncontrolled Resource Consumption", Confidence: 0.5},
+		{ID: "CWE-770", Name: "Allocation of Resources Without Limits or Throttling", Confidence: 0.67},
+	}
+	ai := []*Candidate{
+		{ID: "CWE-400", Name: "Resource Consumption", Confidence: 0.9},
+		{ID: "CWE-1000", Name: "Unknown", Confidence: 0.6},
+		{ID: "CWE-20", Confidence: 0.1},
+	}
+	want := []*Candidate{
+		{ID: "CWE-400", Name: "Uncontrolled Resource Consumption", Confidence: 0.9},
+		{ID: "CWE-770", Name: "Allocation of Resources Without Limits or Throttling", Confidence: 0.67},
+		{ID: "CWE-1000", Name: "Unknown", Confidence: 0.6},
+	}
+	got := Merge(3, rules, ai)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Merge() mismatch (-want, +got):\n%s", diff)
+	}
+	// The inputs are not modified.
+	if rules[0].Confidence != 0.5 {
+		t.Errorf("Merge modified its input")
+	}
+}
@@ -0,0 +1,172 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package database
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"golang.org/x/vulndb/internal/derrors"
+	"golang.org/x/vulndb/internal/osv"
+)
+
+// An Anomaly is a statistically unusual difference between a newly
+// generated database and the deployed one. Unlike the problems found
+// by ValidateDeploy, anomalies are not necessarily wrong, but they are
+// typical of bad bulk edits of the reports, so they should be checked
+// by a person before the database is deployed.
+type Anomaly struct {
+	// ID is the ID of the entry with the anomaly,
+	// or empty if the anomaly is in the database as a whole.
+	ID string
+	// Message describes the anomaly.
+	Message string
+}
+
+func (a *Anomaly) String() string {
+	if a.ID == "" {
+		return a.Message
+	}
+	return a.ID + ": " + a.Message
+}
+
+const (
+	// maxWithdrawnFraction is the largest fraction of the entries
+	// of the deployed database that can be withdrawn at once.
+	maxWithdrawnFraction = 0.01
+	// maxSizeGrowth is the largest factor by which the size of an
+	// entry can grow at once, for entries larger than minGrowthSize.
+	maxSizeGrowth = 4
+	// minGrowthSize is the size in bytes an entry must reach
+	// for its growth to be an anomaly.
+	minGrowthSize = 8 << 10
+)
+
+// FindAnomalies returns the anomalies of the database in newPath
+// compared with the database in oldPath, which it can be deployed on
+// top of (see ValidateDeploy). They are:
+//   - a drop in the number of entries that are not withdrawn;
+//   - an entry whose ranges for a module covered some versions,
+//     but now cover all versions;
+//   - an entry modified before it was published;
+//   - an entry whose size grew by a large factor.
+func FindAnomalies(newPath, oldPath string) (_ []*Anomaly, err error) {
+	defer derrors.Wrap(&err, "FindAnomalies(new=%s, old=%s)", newPath, oldPath)
+
+	new, err := Load(newPath)
+	if err != nil {
+		return nil, err
+	}
+	old, err := RawLoad(filepath.Join(oldPath, idDir))
+	if err != nil {
+		return nil, err
+	}
+	return findAnomalies(new, old)
+}
+
+func findAnomalies(new, old *Database) ([]*Anomaly, error) {
+	var as []*Anomaly
+	add := func(id, format string, args ...any) {
+		as = append(as, &Anomaly{ID: id, Message: fmt.Sprintf(format, args...)})
+	}
+
+	oldLive, newLive := numLive(old), numLive(new)
+	if dropped := oldLive - newLive; dropped > 0 && float64(dropped) > maxWithdrawnFraction*float64(oldLive) {
+		add("", "number of entries that are not withdrawn dropped by %d (new %d, old %d)", dropped, newLive, oldLive)
+	}
+
+	oldEntriesByID := make(map[string]osv.Entry, len(old.Entries))
+	for _, oldEntry := range old.Entries {
+		oldEntriesByID[oldEntry.ID] = oldEntry
+	}
+	for _, newEntry := range new.Entries {
+		if newEntry.Modified.Before(newEntry.Published.Time) {
+			add(newEntry.ID, "modified time %s is before published time %s", newEntry.Modified, newEntry.Published)
+		}
+		oldEntry, ok := oldEntriesByID[newEntry.ID]
+		if !ok || newEntry.Withdrawn != nil {
+			continue
+		}
+		for _, m := range allVersionModules(&newEntry, &oldEntry) {
+			add(newEntry.ID, "ranges for module %s now cover all versions", m)
+		}
+		newSize, err := entrySize(&newEntry)
+		if err != nil {
+			return nil, err
+		}
+		oldSize, err := entrySize(&oldEntry)
+		if err != nil {
+			return nil, err
+		}
+		if newSize >= minGrowthSize && newSize > maxSizeGrowth*oldSize {
+			add(newEntry.ID, "size grew from %d to %d bytes", oldSize, newSize)
+		}
+	}
+	return as, nil
+}
+
+func numLive(db *Database) int {
+	n := 0
+	for _, e := range db.Entries {
+		if e.Withdrawn == nil {
+			n++
+		}
+	}
+	return n
+}
+
+// allVersionModules returns the modules that the ranges of newEntry,
+// but not those of oldEntry, say are affected at all versions.
+func allVersionModules(newEntry, oldEntry *osv.Entry) []string {
+	var mods []string
+	for _, na := range newEntry.Affected {
+		if !affectsAllVersions(na.Ranges) {
+			continue
+		}
+		i := slices.IndexFunc(oldEntry.Affected, func(oa osv.Affected) bool {
+			return oa.Module.Path == na.Module.Path
+		})
+		if i >= 0 && !affectsAllVersions(oldEntry.Affected[i].Ranges) {
+			mods = append(mods, na.Module.Path)
+		}
+	}
+	return mods
+}
+
+// affectsAllVersions reports whether the ranges say that every
+// version is affected: that is, there are none, or they only
+// introduce the vulnerability at version 0.
+func affectsAllVersions(ranges []osv.Range) bool {
+	for _, r := range ranges {
+		for _, e := range r.Events {
+			if e.Fixed != "" || (e.Introduced != "" && e.Introduced != "0") {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func entrySize(e *osv.Entry) (int, error) {
+	b, err := json.Marshal(e)
+	if err != nil {
+		return 0, err
+	}
+	return len(b), nil
+}
+
+// AnomaliesError returns an error describing the anomalies.
+func AnomaliesError(as []*Anomaly) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%d anomalies in the new database:", len(as))
+	for _, a := range as {
+		fmt.Fprintf(&b, "\n\t%s", a)
+	}
+	return errors.New(b.String())
+}
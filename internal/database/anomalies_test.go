@@ -0,0 +1,75 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package database
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/vulndb/internal/osv"
+)
+
+func TestFindAnomalies(t *testing.T) {
+	entry := func(id string, events ...osv.RangeEvent) osv.Entry {
+		return osv.Entry{
+			ID:        id,
+			Published: jan1999,
+			Modified:  jan2000,
+			Affected: []osv.Affected{{
+				Module: osv.Module{Path: "example.com/module", Ecosystem: osv.GoEcosystem},
+				Ranges: []osv.Range{{Type: osv.RangeTypeSemver, Events: events}},
+			}},
+		}
+	}
+	fixed := []osv.RangeEvent{{Introduced: "0"}, {Fixed: "1.2.0"}}
+	var old []osv.Entry
+	for _, id := range []string{"GO-1999-0001", "GO-1999-0002", "GO-1999-0003", "GO-1999-0004"} {
+		old = append(old, entry(id, fixed...))
+	}
+
+	t.Run("none", func(t *testing.T) {
+		new := append(slices.Clone(old), entry("GO-1999-0005"))
+		got, err := findAnomalies(&Database{Entries: new}, &Database{Entries: old})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(got) != 0 {
+			t.Errorf("got anomalies %v, want none", got)
+		}
+	})
+
+	t.Run("all", func(t *testing.T) {
+		new := slices.Clone(old)
+		// Withdrawn.
+		new[0].Withdrawn = &jan2000
+		// Now affects all versions.
+		new[1] = entry("GO-1999-0002", osv.RangeEvent{Introduced: "0"})
+		// Modified before published.
+		new[2].Modified = osv.Time{Time: jan1999.AddDate(0, 0, -1)}
+		// Ballooned.
+		new[3].Details = strings.Repeat("x", minGrowthSize)
+
+		got, err := findAnomalies(&Database{Entries: new}, &Database{Entries: old})
+		if err != nil {
+			t.Fatal(err)
+		}
+		var gotStrings []string
+		for _, a := range got {
+			gotStrings = append(gotStrings, a.String())
+		}
+		want := []string{
+			"number of entries that are not withdrawn dropped by 1 (new 3, old 4)",
+			"GO-1999-0002: ranges for module example.com/module now cover all versions",
+			"GO-1999-0003: modified time 1998-12-31 00:00:00 +0000 UTC is before published time 1999-01-01 00:00:00 +0000 UTC",
+		}
+		if len(got) != 4 || !strings.HasPrefix(got[3].String(), "GO-1999-0004: size grew from ") {
+			t.Errorf("got %v, want size anomaly last", gotStrings)
+		} else if diff := cmp.Diff(want, gotStrings[:3]); diff != "" {
+			t.Errorf("mismatch (-want, +got):\n%s", diff)
+		}
+	})
+}
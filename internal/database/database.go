@@ -32,6 +32,15 @@ type DBMeta struct {
 	// Modified is the time the database was last modified, calculated
 	// as the most recent time any single OSV entry was modified.
 	Modified osv.Time `json:"modified"`
+	// Encodings lists the compressed variants provided for each
+	// file in the database. If empty, only gzip variants are provided.
+	Encodings []Encoding `json:"encodings,omitempty"`
+	// ModuleShards lists the shards of the modules index, by the
+	// first path element of their modules: for each shard "NAME",
+	// index/modules/NAME.json contains the entries of modules.json
+	// for the modules whose paths start with NAME. If empty, there
+	// are no shards.
+	ModuleShards []string `json:"module_shards,omitempty"`
 }
 
 // ModulesIndex is a map from module paths to module metadata.
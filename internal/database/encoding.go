@@ -0,0 +1,81 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package database
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// An Encoding is a compression format in which a database provides
+// a variant of each file, alongside the uncompressed JSON.
+type Encoding string
+
+const (
+	// Gzip variants are named with a ".gz" suffix.
+	// A database that doesn't list its encodings provides only these.
+	Gzip Encoding = "gzip"
+	// Zstd variants are named with a ".zst" suffix.
+	Zstd Encoding = "zstd"
+)
+
+var encodings = map[Encoding]struct {
+	ext   string
+	write func(filename string, data []byte) error
+	read  func(filename string) ([]byte, error)
+}{
+	Gzip: {".gz", writeGzipped, readGzipped},
+	Zstd: {".zst", writeZstd, readZstd},
+}
+
+// ParseEncodings parses a comma-separated list of encodings,
+// e.g. "gzip,zstd".
+func ParseEncodings(s string) ([]Encoding, error) {
+	var encs []Encoding
+	for _, e := range strings.Split(s, ",") {
+		if e = strings.TrimSpace(e); e == "" {
+			continue
+		}
+		if _, ok := encodings[Encoding(e)]; !ok {
+			return nil, fmt.Errorf("unknown encoding %q", e)
+		}
+		encs = append(encs, Encoding(e))
+	}
+	return encs, nil
+}
+
+// SetEncodings sets the compressed variants that Write provides
+// for each file, and that db.json advertises. The uncompressed
+// files are always written.
+//
+// Writing only gzip variants is the default, and is not advertised,
+// so that such databases are unchanged from before encodings existed.
+func (db *Database) SetEncodings(encs ...Encoding) error {
+	if len(encs) == 0 {
+		return fmt.Errorf("need at least one encoding")
+	}
+	for _, e := range encs {
+		if _, ok := encodings[e]; !ok {
+			return fmt.Errorf("unknown encoding %q", e)
+		}
+	}
+	encs = slices.Clone(encs)
+	slices.Sort(encs)
+	encs = slices.Compact(encs)
+	if len(encs) == 1 && encs[0] == Gzip {
+		encs = nil
+	}
+	db.DB.Encodings = encs
+	return nil
+}
+
+// encodings returns the compressed variants the database provides.
+func (db *Database) encodings() []Encoding {
+	if len(db.DB.Encodings) == 0 {
+		return []Encoding{Gzip}
+	}
+	return db.DB.Encodings
+}
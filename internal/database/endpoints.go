@@ -10,6 +10,9 @@ var (
 	dbEndpoint      = "db.json"
 	modulesEndpoint = "modules.json"
 	vulnsEndpoint   = "vulns.json"
+	// modulesShardDir is the directory in the index
+	// containing the shards of modules.json.
+	modulesShardDir = "modules"
 )
 
 func IsIndexEndpoint(filename string) bool {
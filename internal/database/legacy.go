@@ -0,0 +1,149 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package database
+
+import (
+	"bytes"
+	"net/http"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	"golang.org/x/mod/module"
+	"golang.org/x/vulndb/internal/osv"
+)
+
+// LegacyHandler returns a handler that serves the endpoints of the
+// legacy (pre-v1) database layout, still requested by old versions of
+// govulncheck, computed from db on the fly:
+//
+//   - index.json: a JSON object mapping each module path to the time
+//     its most recently modified entry was modified
+//   - <escaped module path>.json (e.g. stdlib.json,
+//     github.com/!burnt!sushi/toml.json): a JSON array of the entries
+//     affecting the module, sorted by ID
+//   - ID/index.json: a JSON array of the IDs of all entries
+//   - aliases.json: a JSON object mapping each alias to the IDs of the
+//     entries that have it
+//
+// Entries (ID/GO-YYYY-XXXX.json) are the same in both layouts, so those
+// requests, and all other requests, are passed to next, which normally
+// serves the v1 database.
+func LegacyHandler(db *Database, next http.Handler) http.Handler {
+	h := &legacyHandler{
+		db:      db,
+		next:    next,
+		entries: make(map[string]*osv.Entry, len(db.Entries)),
+	}
+	for i := range db.Entries {
+		h.entries[db.Entries[i].ID] = &db.Entries[i]
+	}
+	return h
+}
+
+type legacyHandler struct {
+	db      *Database
+	next    http.Handler
+	entries map[string]*osv.Entry // by ID
+}
+
+func (h *legacyHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		h.next.ServeHTTP(w, r)
+		return
+	}
+	v, ok := h.legacyValue(strings.TrimPrefix(path.Clean(r.URL.Path), "/"))
+	if !ok {
+		h.next.ServeHTTP(w, r)
+		return
+	}
+	b, err := jsonMarshal(v, false)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	http.ServeContent(w, r, path.Base(r.URL.Path), h.db.DB.Modified.Time, bytes.NewReader(b))
+}
+
+// legacyValue returns the value to serve at the given path of the
+// legacy layout, and false if the path is not a legacy endpoint.
+func (h *legacyHandler) legacyValue(p string) (any, bool) {
+	switch p {
+	case "index.json":
+		return h.modulesIndex(), true
+	case "aliases.json":
+		return h.aliases(), true
+	case path.Join(idDir, "index.json"):
+		return h.ids(), true
+	}
+	if !strings.HasSuffix(p, ".json") ||
+		strings.HasPrefix(p, indexDir+"/") || strings.HasPrefix(p, idDir+"/") {
+		return nil, false
+	}
+	modulePath := strings.TrimSuffix(p, ".json")
+	if modulePath != osv.GoStdModulePath {
+		var err error
+		modulePath, err = module.UnescapePath(modulePath)
+		if err != nil {
+			return nil, false
+		}
+	}
+	m, ok := h.db.Modules[modulePath]
+	if !ok {
+		return nil, false
+	}
+	return h.moduleEntries(m), true
+}
+
+func (h *legacyHandler) modulesIndex() map[string]time.Time {
+	index := make(map[string]time.Time, len(h.db.Modules))
+	for p, m := range h.db.Modules {
+		var modified time.Time
+		for _, v := range m.Vulns {
+			if v.Modified.After(modified) {
+				modified = v.Modified.Time
+			}
+		}
+		index[p] = modified
+	}
+	return index
+}
+
+func (h *legacyHandler) moduleEntries(m *Module) []*osv.Entry {
+	entries := []*osv.Entry{}
+	for _, v := range m.Vulns {
+		if e, ok := h.entries[v.ID]; ok {
+			entries = append(entries, e)
+		}
+	}
+	slices.SortFunc(entries, func(a, b *osv.Entry) int {
+		return strings.Compare(a.ID, b.ID)
+	})
+	return entries
+}
+
+func (h *legacyHandler) ids() []string {
+	ids := make([]string, 0, len(h.db.Entries))
+	for _, e := range h.db.Entries {
+		ids = append(ids, e.ID)
+	}
+	slices.Sort(ids)
+	return ids
+}
+
+func (h *legacyHandler) aliases() map[string][]string {
+	aliases := make(map[string][]string)
+	for _, e := range h.db.Entries {
+		for _, a := range e.Aliases {
+			aliases[a] = append(aliases[a], e.ID)
+		}
+	}
+	for _, ids := range aliases {
+		slices.Sort(ids)
+	}
+	return aliases
+}
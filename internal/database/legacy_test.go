@@ -0,0 +1,114 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package database
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/vulndb/internal/osv"
+)
+
+func TestLegacyHandler(t *testing.T) {
+	db, err := New(testOSV1, testOSV2, testOSV3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Next", "true")
+		w.WriteHeader(http.StatusTeapot)
+	})
+	srv := httptest.NewServer(LegacyHandler(db, next))
+	defer srv.Close()
+
+	for _, tc := range []struct {
+		path string
+		// got and want are the response, unmarshaled.
+		got, want any
+	}{
+		{
+			path: "/index.json",
+			got:  new(map[string]time.Time),
+			want: &map[string]time.Time{
+				"stdlib":             jan2000.Time,
+				"example.com/module": jan2003.Time,
+			},
+		},
+		{
+			path: "/ID/index.json",
+			got:  new([]string),
+			want: &[]string{"GO-1999-0001", "GO-2000-0002", "GO-2000-0003"},
+		},
+		{
+			path: "/aliases.json",
+			got:  new(map[string][]string),
+			want: &map[string][]string{
+				"CVE-1999-1111":       {"GO-1999-0001"},
+				"CVE-1999-2222":       {"GO-2000-0002"},
+				"CVE-1999-3333":       {"GO-2000-0003"},
+				"GHSA-xxxx-yyyy-zzzz": {"GO-2000-0003"},
+			},
+		},
+		{
+			path: "/stdlib.json",
+			got:  new([]osv.Entry),
+			want: &[]osv.Entry{testOSV1},
+		},
+		{
+			path: "/example.com/module.json",
+			got:  new([]osv.Entry),
+			want: &[]osv.Entry{testOSV2, testOSV3},
+		},
+	} {
+		t.Run(tc.path, func(t *testing.T) {
+			resp := get(t, srv.URL+tc.path)
+			if resp.StatusCode != http.StatusOK {
+				t.Fatalf("status = %d, want %d", resp.StatusCode, http.StatusOK)
+			}
+			if got, want := resp.Header.Get("Content-Type"), "application/json"; got != want {
+				t.Errorf("Content-Type = %q, want %q", got, want)
+			}
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := json.Unmarshal(b, tc.got); err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want, tc.got); diff != "" {
+				t.Errorf("mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+
+	// Everything else is served by next.
+	for _, p := range []string{
+		"/ID/GO-1999-0001.json",
+		"/index/db.json",
+		"/unknown.com/module.json",
+		"/README",
+	} {
+		t.Run(p, func(t *testing.T) {
+			if resp := get(t, srv.URL+p); resp.Header.Get("X-Next") != "true" {
+				t.Errorf("not passed to next handler (status %d)", resp.StatusCode)
+			}
+		})
+	}
+}
+
+func get(t *testing.T, url string) *http.Response {
+	t.Helper()
+	resp, err := http.Get(url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { resp.Body.Close() })
+	return resp
+}
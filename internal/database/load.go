@@ -35,11 +35,29 @@ func Load(path string) (_ *Database, err error) {
 		return nil, err
 	}
 
-	requireGzip := true
-	if err := db.validateIndex(filepath.Join(path, indexDir), requireGzip); err != nil {
+	// The encodings are not derived from the entries, so take them
+	// from db.json. The validation below checks the rest of its contents.
+	var meta DBMeta
+	if err := report.UnmarshalFromFile(filepath.Join(path, indexDir, dbEndpoint), &meta); err != nil {
 		return nil, err
 	}
-	if err := db.validateEntries(filepath.Join(path, idDir), requireGzip); err != nil {
+	for _, e := range meta.Encodings {
+		if _, ok := encodings[e]; !ok {
+			return nil, fmt.Errorf("%s: unknown encoding %q", dbEndpoint, e)
+		}
+	}
+	db.DB.Encodings = meta.Encodings
+	// Derive the shards from the entries if db.json advertises any,
+	// so that the validation below checks that the list is right.
+	if len(meta.ModuleShards) > 0 {
+		db.ShardModules()
+	}
+
+	encs := db.encodings()
+	if err := db.validateIndex(filepath.Join(path, indexDir), encs); err != nil {
+		return nil, err
+	}
+	if err := db.validateEntries(filepath.Join(path, idDir), encs); err != nil {
 		return nil, err
 	}
 	return db, nil
@@ -48,7 +66,7 @@ func Load(path string) (_ *Database, err error) {
 // RawLoad loads a database assuming that vulnsPath contains ".json" files
 // representing OSV entries.
 // It errors if any of the files cannot be unmarshaled into osv.Entry.
-// It does not require any database indexes or gzipped files to be present,
+// It does not require any database indexes or compressed files to be present.
 // Directories and non-JSON files are ignored.
 // Also, to accommodate the legacy spec, the file "index.json" is ignored
 // if present.
@@ -82,35 +100,45 @@ func RawLoad(vulnsPath string) (_ *Database, err error) {
 	return db, nil
 }
 
-func (db *Database) validateIndex(indexPath string, requireGzip bool) (err error) {
+func (db *Database) validateIndex(indexPath string, encs []Encoding) (err error) {
 	defer derrors.Wrap(&err, "validateIndex(%q)", indexPath)
 
 	// Check that the index files are present and have the correct
 	// contents.
 	dbPath := filepath.Join(indexPath, dbEndpoint)
-	if err := checkFiles(dbPath, db.DB, requireGzip); err != nil {
+	if err := checkFiles(dbPath, db.DB, encs); err != nil {
 		return err
 	}
 	modulesPath := filepath.Join(indexPath, modulesEndpoint)
-	if err := checkFiles(modulesPath, db.Modules, requireGzip); err != nil {
+	if err := checkFiles(modulesPath, db.Modules, encs); err != nil {
 		return err
 	}
 	vulnsPath := filepath.Join(indexPath, vulnsEndpoint)
-	if err := checkFiles(vulnsPath, db.Vulns, requireGzip); err != nil {
+	if err := checkFiles(vulnsPath, db.Vulns, encs); err != nil {
 		return err
 	}
+	for _, name := range db.DB.ModuleShards {
+		shardPath := filepath.Join(indexPath, shardFilename(name))
+		if err := checkFiles(shardPath, db.Modules.shard(name), encs); err != nil {
+			return err
+		}
+	}
 
 	// Check for unexpected files in the index folder.
-	expected := []string{
-		indexDir,
-		dbEndpoint, dbEndpoint + ".gz",
-		modulesEndpoint, modulesEndpoint + ".gz",
-		vulnsEndpoint, vulnsEndpoint + ".gz",
+	expected := []string{indexDir}
+	for _, endpoint := range []string{dbEndpoint, modulesEndpoint, vulnsEndpoint} {
+		expected = append(expected, withVariants(endpoint, encs)...)
+	}
+	if len(db.DB.ModuleShards) > 0 {
+		expected = append(expected, modulesShardDir)
+	}
+	for _, name := range db.DB.ModuleShards {
+		expected = append(expected, withVariants(name+".json", encs)...)
 	}
 	return checkNoUnexpectedFiles(indexPath, expected)
 }
 
-func (db *Database) validateEntries(idPath string, requireGzip bool) (err error) {
+func (db *Database) validateEntries(idPath string, encs []Encoding) (err error) {
 	defer derrors.Wrap(&err, "validateEntries(%q)", idPath)
 
 	expected := []string{
@@ -122,15 +150,25 @@ func (db *Database) validateEntries(idPath string, requireGzip bool) (err error)
 			return err
 		}
 		path := filepath.Join(idPath, entry.ID+".json")
-		if err = checkFiles(path, entry, requireGzip); err != nil {
+		if err = checkFiles(path, entry, encs); err != nil {
 			return err
 		}
-		expected = append(expected, entry.ID+".json", entry.ID+".json.gz")
+		expected = append(expected, withVariants(entry.ID+".json", encs)...)
 	}
 
 	return checkNoUnexpectedFiles(idPath, expected)
 }
 
+// withVariants returns filename and the names of its compressed
+// variants in the given encodings.
+func withVariants(filename string, encs []Encoding) []string {
+	fnames := []string{filename}
+	for _, e := range encs {
+		fnames = append(fnames, filename+encodings[e].ext)
+	}
+	return fnames
+}
+
 func checkNoUnexpectedFiles(path string, expected []string) error {
 	if err := filepath.WalkDir(path, func(path string, f fs.DirEntry, err error) error {
 		if err != nil {
@@ -146,15 +184,16 @@ func checkNoUnexpectedFiles(path string, expected []string) error {
 	return nil
 }
 
-// checkFiles ensures that filepath and filepath+".gz" exist and
-// have contents consistent with v.
+// checkFiles ensures that filepath and its compressed variants in the
+// given encodings (e.g. filepath+".gz") exist and have contents
+// consistent with v.
 // Returns an error if:
 //   - any expected files don't exist or v cannot be marshaled
 //   - the contents of filepath do not match the result
 //     of marshaling v
-//   - the uncompressed contents of filepath+".gz" do not match the
+//   - the uncompressed contents of a variant do not match the
 //     contents of filepath
-func checkFiles(filepath string, v any, requireGzip bool) (err error) {
+func checkFiles(filepath string, v any, encs []Encoding) (err error) {
 	defer derrors.Wrap(&err, "checkFiles(%q)", filepath)
 
 	contents, err := os.ReadFile(filepath)
@@ -169,13 +208,14 @@ func checkFiles(filepath string, v any, requireGzip bool) (err error) {
 		return fmt.Errorf("%s: contents do not match marshaled bytes of value:\ncontents:\n%s\nvalue (marshaled):\n%s", filepath, c, m)
 	}
 
-	if requireGzip {
-		gzipped, err := readGzipped(filepath + ".gz")
+	for _, e := range encs {
+		enc := encodings[e]
+		uncompressed, err := enc.read(filepath + enc.ext)
 		if err != nil {
 			return err
 		}
-		if c, g := string(contents), string(gzipped); c != g {
-			return fmt.Errorf("%[1]s: contents of uncompressed file do not match contents of compressed file:\ncontents of %[1]s:\n%[2]s\ncontents of %[1]s.gz:\n%[3]s", filepath, c, g)
+		if c, u := string(contents), string(uncompressed); c != u {
+			return fmt.Errorf("%[1]s: contents of uncompressed file do not match contents of compressed file:\ncontents of %[1]s:\n%[2]s\ncontents of %[1]s%[4]s:\n%[3]s", filepath, c, u, enc.ext)
 		}
 	}
 
@@ -0,0 +1,121 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package database
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// A Manifest lists the files of a database written to a directory
+// (by Write, and optionally WriteZip), so that a deployed copy can
+// be checked against the database that was generated.
+type Manifest struct {
+	// Files are the files of the database, sorted by path.
+	Files []*ManifestFile `json:"files"`
+	// Digest is the SHA-256 hash of the list of files and their
+	// hashes, which identifies the database as a whole.
+	Digest string `json:"digest"`
+}
+
+// A ManifestFile is a file of a database.
+type ManifestFile struct {
+	// Path is the slash-separated path of the file,
+	// relative to the database directory.
+	Path string `json:"path"`
+	// Size is the size of the file in bytes.
+	Size int64 `json:"size"`
+	// SHA256 is the hex-encoded SHA-256 hash of the file.
+	SHA256 string `json:"sha256"`
+}
+
+// NewManifest returns the manifest of the database in dir.
+// Files are read in a stream, rather than loaded into memory.
+func NewManifest(dir string) (*Manifest, error) {
+	m := &Manifest{}
+	// WalkDir visits files in lexical order, so the files are sorted.
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		f, err := hashFile(path)
+		if err != nil {
+			return err
+		}
+		f.Path = filepath.ToSlash(rel)
+		m.Files = append(m.Files, f)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	m.Digest = m.digest()
+	return m, nil
+}
+
+func hashFile(path string) (_ *ManifestFile, err error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	h := sha256.New()
+	n, err := io.Copy(h, f)
+	if err != nil {
+		return nil, err
+	}
+	return &ManifestFile{Size: n, SHA256: hex.EncodeToString(h.Sum(nil))}, nil
+}
+
+// digest returns the digest of m's files, computed over their
+// list in the format of the sha256sum command.
+func (m *Manifest) digest() string {
+	var b strings.Builder
+	for _, f := range m.Files {
+		fmt.Fprintf(&b, "%s  %s\n", f.SHA256, f.Path)
+	}
+	sum := sha256.Sum256([]byte(b.String()))
+	return hex.EncodeToString(sum[:])
+}
+
+// Size returns the total size of the files of m.
+func (m *Manifest) Size() int64 {
+	var n int64
+	for _, f := range m.Files {
+		n += f.Size
+	}
+	return n
+}
+
+// Uncompressed returns the files of m that are not compressed
+// variants of other files (see Encoding).
+func (m *Manifest) Uncompressed() []*ManifestFile {
+	var fs []*ManifestFile
+	for _, f := range m.Files {
+		if !isCompressed(f.Path) {
+			fs = append(fs, f)
+		}
+	}
+	return fs
+}
+
+func isCompressed(filename string) bool {
+	for _, enc := range encodings {
+		if strings.HasSuffix(filename, enc.ext) {
+			return true
+		}
+	}
+	return false
+}
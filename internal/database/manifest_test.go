@@ -0,0 +1,79 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package database
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestNewManifest(t *testing.T) {
+	dir := t.TempDir()
+	if err := valid.Write(dir); err != nil {
+		t.Fatal(err)
+	}
+	m, err := NewManifest(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var paths []string
+	for _, f := range m.Files {
+		paths = append(paths, f.Path)
+	}
+	want := []string{
+		"ID/GO-1999-0001.json", "ID/GO-1999-0001.json.gz",
+		"ID/GO-2000-0002.json", "ID/GO-2000-0002.json.gz",
+		"ID/GO-2000-0003.json", "ID/GO-2000-0003.json.gz",
+		"index/db.json", "index/db.json.gz",
+		"index/modules.json", "index/modules.json.gz",
+		"index/vulns.json", "index/vulns.json.gz",
+	}
+	if diff := cmp.Diff(want, paths); diff != "" {
+		t.Errorf("paths mismatch (-want, +got):\n%s", diff)
+	}
+	var uncompressed []string
+	for _, f := range m.Uncompressed() {
+		uncompressed = append(uncompressed, f.Path)
+	}
+	wantUncompressed := []string{
+		"ID/GO-1999-0001.json", "ID/GO-2000-0002.json", "ID/GO-2000-0003.json",
+		"index/db.json", "index/modules.json", "index/vulns.json",
+	}
+	if diff := cmp.Diff(wantUncompressed, uncompressed); diff != "" {
+		t.Errorf("Uncompressed() mismatch (-want, +got):\n%s", diff)
+	}
+
+	b, err := os.ReadFile(filepath.Join(dir, "index", "db.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := m.Files[6]; got.Size != int64(len(b)) {
+		t.Errorf("%s: Size = %d, want %d", got.Path, got.Size, len(b))
+	}
+
+	// The digest is the same for the same files, and
+	// changes if any file does.
+	m2, err := NewManifest(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m2.Digest != m.Digest {
+		t.Errorf("Digest = %s, then %s; want equal", m.Digest, m2.Digest)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "index", "db.json"), []byte("{}"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	m3, err := NewManifest(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m3.Digest == m.Digest {
+		t.Errorf("Digest unchanged after a file changed")
+	}
+}
@@ -40,6 +40,9 @@ func (db *Database) Add(entries ...osv.Entry) error {
 		db.Entries = append(db.Entries, entry)
 		db.Modules.add(entry)
 		db.DB.add(entry)
+		if len(db.DB.ModuleShards) > 0 {
+			db.DB.ModuleShards = db.Modules.shardNames()
+		}
 	}
 	return nil
 }
@@ -0,0 +1,58 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package database
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"golang.org/x/vulndb/internal/osv"
+	"golang.org/x/vulndb/internal/osvutils"
+	"golang.org/x/vulndb/internal/version"
+)
+
+// FixedBetween returns the entries of db, sorted by ID, that are
+// fixed in the module with the given path (e.g., "stdlib") by an
+// upgrade from version from to version to: the entries that are not
+// withdrawn and that have a fixed version v for the module such that
+// from < v <= to.
+//
+// from and to are semantic versions, which may have a "v" or "go"
+// prefix, like "v1.2.0" or "go1.21.0" (see version.Normalize).
+func (db *Database) FixedBetween(modulePath, from, to string) ([]osv.Entry, error) {
+	from, to = version.Normalize(from), version.Normalize(to)
+	var entries []osv.Entry
+	for _, e := range db.Entries {
+		if e.Withdrawn != nil {
+			continue
+		}
+		fixed, err := entryFixedBetween(&e, modulePath, from, to)
+		if err != nil {
+			return nil, err
+		}
+		if fixed {
+			entries = append(entries, e)
+		}
+	}
+	slices.SortFunc(entries, func(a, b osv.Entry) int { return strings.Compare(a.ID, b.ID) })
+	return entries, nil
+}
+
+func entryFixedBetween(e *osv.Entry, modulePath, from, to string) (bool, error) {
+	for _, a := range e.Affected {
+		if a.Module.Path != modulePath {
+			continue
+		}
+		fixed, err := osvutils.FixedBetween(a.Ranges, from, to)
+		if err != nil {
+			return false, fmt.Errorf("%s: %w", e.ID, err)
+		}
+		if len(fixed) > 0 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
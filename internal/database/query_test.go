@@ -0,0 +1,64 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package database
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/vulndb/internal/osv"
+)
+
+func TestFixedBetween(t *testing.T) {
+	entry := func(id, modulePath string, events ...osv.RangeEvent) osv.Entry {
+		return osv.Entry{
+			ID: id,
+			Affected: []osv.Affected{{
+				Module: osv.Module{Path: modulePath, Ecosystem: osv.GoEcosystem},
+				Ranges: []osv.Range{{Type: osv.RangeTypeSemver, Events: events}},
+			}},
+		}
+	}
+	withdrawn := entry("GO-1999-0005", "stdlib", osv.RangeEvent{Introduced: "0"}, osv.RangeEvent{Fixed: "1.21.3"})
+	withdrawn.Withdrawn = &jan2000
+	db := &Database{Entries: []osv.Entry{
+		entry("GO-1999-0004", "stdlib", osv.RangeEvent{Introduced: "0"}, osv.RangeEvent{Fixed: "1.20.10"},
+			osv.RangeEvent{Introduced: "1.21.0"}, osv.RangeEvent{Fixed: "1.21.3"}),
+		entry("GO-1999-0001", "stdlib", osv.RangeEvent{Introduced: "0"}, osv.RangeEvent{Fixed: "1.21.5"}),
+		// Fixed before the window.
+		entry("GO-1999-0002", "stdlib", osv.RangeEvent{Introduced: "0"}, osv.RangeEvent{Fixed: "1.21.0"}),
+		// Not fixed.
+		entry("GO-1999-0003", "stdlib", osv.RangeEvent{Introduced: "1.21.0"}),
+		withdrawn,
+		// Other module.
+		entry("GO-1999-0006", "example.com/module", osv.RangeEvent{Introduced: "0"}, osv.RangeEvent{Fixed: "1.21.1"}),
+	}}
+
+	for _, test := range []struct {
+		modulePath, from, to string
+		want                 []string
+	}{
+		{"stdlib", "go1.21.0", "go1.21.5", []string{"GO-1999-0001", "GO-1999-0004"}},
+		{"stdlib", "go1.21", "1.21.4", []string{"GO-1999-0004"}},
+		{"stdlib", "1.21.5", "1.22.0", nil},
+		{"example.com/module", "v1.2.0", "v1.21.1", []string{"GO-1999-0006"}},
+	} {
+		got, err := db.FixedBetween(test.modulePath, test.from, test.to)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var gotIDs []string
+		for _, e := range got {
+			gotIDs = append(gotIDs, e.ID)
+		}
+		if diff := cmp.Diff(test.want, gotIDs); diff != "" {
+			t.Errorf("FixedBetween(%s, %s, %s) mismatch (-want, +got):\n%s", test.modulePath, test.from, test.to, diff)
+		}
+	}
+
+	if _, err := db.FixedBetween("stdlib", "latest", "1.21.5"); err == nil {
+		t.Error("FixedBetween(stdlib, latest, 1.21.5): got nil error, want error")
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package database
+
+import (
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// ShardModules makes Write provide, alongside index/modules.json, a
+// shard of the modules index for each first path element of the
+// modules (e.g., index/modules/github.com.json for the modules on
+// github.com), so that clients interested in one host can fetch
+// a smaller file. db.json advertises the shards.
+//
+// Call ShardModules after adding the entries. (Once a database has
+// shards, it also shards the modules of entries added later.)
+func (db *Database) ShardModules() {
+	db.DB.ModuleShards = db.Modules.shardNames()
+}
+
+// shardName returns the name of the shard of the modules index
+// that the module with the given path is in.
+func shardName(modulePath string) string {
+	first, _, _ := strings.Cut(modulePath, "/")
+	return first
+}
+
+// shardNames returns the sorted names of the shards of m.
+func (m ModulesIndex) shardNames() []string {
+	var names []string
+	for path := range m {
+		names = append(names, shardName(path))
+	}
+	slices.Sort(names)
+	return slices.Compact(names)
+}
+
+// shard returns the part of m with the modules in the named shard.
+func (m ModulesIndex) shard(name string) ModulesIndex {
+	s := make(ModulesIndex)
+	for path, mod := range m {
+		if shardName(path) == name {
+			s[path] = mod
+		}
+	}
+	return s
+}
+
+// shardFilename returns the path of the named shard,
+// relative to the index directory.
+func shardFilename(name string) string {
+	return filepath.Join(modulesShardDir, name+".json")
+}
@@ -0,0 +1,93 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package database
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/vulndb/internal/osv"
+)
+
+func TestShardModules(t *testing.T) {
+	db, err := New(testOSV1, testOSV2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	db.ShardModules()
+	if want := []string{"example.com", "stdlib"}; !cmp.Equal(db.DB.ModuleShards, want) {
+		t.Errorf("ModuleShards = %v, want %v", db.DB.ModuleShards, want)
+	}
+
+	// Entries added later are sharded too.
+	e := testOSV3
+	a := e.Affected[0]
+	a.Module.Path = "github.com/a/b"
+	a.EcosystemSpecific = &osv.EcosystemSpecific{Packages: []osv.Package{{Path: "github.com/a/b"}}}
+	e.Affected = append(slices.Clone(e.Affected), a)
+	if err := db.Add(e); err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"example.com", "github.com", "stdlib"}; !cmp.Equal(db.DB.ModuleShards, want) {
+		t.Errorf("ModuleShards = %v, want %v", db.DB.ModuleShards, want)
+	}
+
+	tmpDir := t.TempDir()
+	if err := db.Write(tmpDir); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range db.DB.ModuleShards {
+		b, err := os.ReadFile(filepath.Join(tmpDir, indexDir, modulesShardDir, name+".json"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := make(ModulesIndex)
+		if err := json.Unmarshal(b, &got); err != nil {
+			t.Fatal(err)
+		}
+		for path := range got {
+			if shardName(path) != name {
+				t.Errorf("shard %s: has module %s", name, path)
+			}
+		}
+		if want := db.Modules.shard(name); len(got) != len(want) {
+			t.Errorf("shard %s: got %d modules, want %d", name, len(got), len(want))
+		}
+	}
+
+	loaded, err := Load(tmpDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(db, loaded); diff != "" {
+		t.Errorf("write/load mismatch (-db, +loaded):\n%s", diff)
+	}
+
+	// The zipped copy has the shards too.
+	zipped, unzipped := filepath.Join(t.TempDir(), "all.zip"), t.TempDir()
+	if err := db.WriteZip(zipped); err != nil {
+		t.Fatal(err)
+	}
+	if err := Unzip(zipped, unzipped); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range db.DB.ModuleShards {
+		if _, err := os.Stat(filepath.Join(unzipped, indexDir, modulesShardDir, name+".json")); err != nil {
+			t.Error(err)
+		}
+	}
+
+	// A missing shard is an error.
+	if err := os.Remove(filepath.Join(tmpDir, indexDir, modulesShardDir, "stdlib.json")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Load(tmpDir); err == nil {
+		t.Error("Load with missing shard: got nil error, want error")
+	}
+}
@@ -11,44 +11,58 @@ import (
 	"path/filepath"
 )
 
+// Write writes the database to dir, with a compressed variant of
+// each file in each of the database's encodings (see SetEncodings).
 func (db *Database) Write(dir string) error {
-	gzip := true
-	if err := db.writeIndex(filepath.Join(dir, indexDir), gzip); err != nil {
+	encs := db.encodings()
+	if err := db.writeIndex(filepath.Join(dir, indexDir), encs); err != nil {
 		return err
 	}
-	return db.writeEntries(filepath.Join(dir, idDir), gzip)
+	return db.writeEntries(filepath.Join(dir, idDir), encs)
 }
 
-func (db *Database) writeIndex(dir string, gzip bool) error {
+func (db *Database) writeIndex(dir string, encs []Encoding) error {
 	if err := os.MkdirAll(dir, 0755); err != nil {
 		return fmt.Errorf("failed to create directory %q: %s", dir, err)
 	}
 
-	if err := write(filepath.Join(dir, dbEndpoint), db.DB, gzip); err != nil {
+	if err := write(filepath.Join(dir, dbEndpoint), db.DB, encs); err != nil {
 		return err
 	}
 
-	if err := write(filepath.Join(dir, modulesEndpoint), db.Modules, gzip); err != nil {
+	if err := write(filepath.Join(dir, modulesEndpoint), db.Modules, encs); err != nil {
 		return err
 	}
 
-	return write(filepath.Join(dir, vulnsEndpoint), db.Vulns, gzip)
+	if len(db.DB.ModuleShards) > 0 {
+		shardDir := filepath.Join(dir, modulesShardDir)
+		if err := os.MkdirAll(shardDir, 0755); err != nil {
+			return fmt.Errorf("failed to create directory %q: %s", shardDir, err)
+		}
+	}
+	for _, name := range db.DB.ModuleShards {
+		if err := write(filepath.Join(dir, shardFilename(name)), db.Modules.shard(name), encs); err != nil {
+			return err
+		}
+	}
+
+	return write(filepath.Join(dir, vulnsEndpoint), db.Vulns, encs)
 }
 
-func (db *Database) writeEntries(dir string, gzip bool) error {
+func (db *Database) writeEntries(dir string, encs []Encoding) error {
 	if err := os.MkdirAll(dir, 0755); err != nil {
 		return fmt.Errorf("failed to create directory %q: %s", dir, err)
 	}
 
 	for _, entry := range db.Entries {
-		if err := write(filepath.Join(dir, entry.ID+".json"), entry, gzip); err != nil {
+		if err := write(filepath.Join(dir, entry.ID+".json"), entry, encs); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
-func write(filename string, v any, gzip bool) error {
+func write(filename string, v any, encs []Encoding) error {
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
@@ -59,8 +73,11 @@ func write(filename string, v any, gzip bool) error {
 		return err
 	}
 
-	if gzip {
-		return writeGzipped(filename+".gz", b)
+	for _, e := range encs {
+		enc := encodings[e]
+		if err := enc.write(filename+enc.ext, b); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -0,0 +1,73 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package database
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"golang.org/x/vulndb/internal/csaf"
+)
+
+// WriteCSAF writes the database to dir as a CSAF 2.0 tree served at
+// baseURL: a provider-metadata.json file, and a distribution directory
+// with an advisory for each entry, and the index.txt and changes.csv
+// files that list them.
+func (db *Database) WriteCSAF(dir, baseURL string) error {
+	distDir := filepath.Join(dir, csaf.DistributionDir)
+	if err := os.MkdirAll(distDir, 0755); err != nil {
+		return fmt.Errorf("failed to create directory %q: %s", distDir, err)
+	}
+
+	type change struct {
+		filename, updated string
+	}
+	var changes []change
+	for _, entry := range db.Entries {
+		a, err := csaf.FromEntry(&entry)
+		if err != nil {
+			return err
+		}
+		fname := csaf.Filename(a)
+		path := filepath.Join(distDir, filepath.FromSlash(fname))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			return err
+		}
+		if err := write(path, a, nil); err != nil {
+			return err
+		}
+		changes = append(changes, change{fname, a.Document.Tracking.CurrentReleaseDate})
+	}
+
+	// index.txt is in lexical order, and changes.csv in
+	// reverse chronological order.
+	slices.SortFunc(changes, func(a, b change) int {
+		return strings.Compare(a.filename, b.filename)
+	})
+	var index bytes.Buffer
+	for _, c := range changes {
+		fmt.Fprintln(&index, c.filename)
+	}
+	if err := os.WriteFile(filepath.Join(distDir, csaf.IndexFile), index.Bytes(), 0644); err != nil {
+		return err
+	}
+	slices.SortStableFunc(changes, func(a, b change) int {
+		return strings.Compare(b.updated, a.updated)
+	})
+	var csv bytes.Buffer
+	for _, c := range changes {
+		fmt.Fprintf(&csv, "%q,%q\n", c.filename, c.updated)
+	}
+	if err := os.WriteFile(filepath.Join(distDir, csaf.ChangesFile), csv.Bytes(), 0644); err != nil {
+		return err
+	}
+
+	return write(filepath.Join(dir, csaf.ProviderMetadataFile),
+		csaf.NewProviderMetadata(baseURL, db.DB.Modified.Time), nil)
+}
@@ -0,0 +1,75 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package database
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/vulndb/internal/csaf"
+)
+
+func TestWriteCSAF(t *testing.T) {
+	dir := t.TempDir()
+	if err := valid.WriteCSAF(dir, "https://vuln.go.dev/csaf/"); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, f := range []struct {
+		name, want string
+	}{
+		{
+			name: "white/index.txt",
+			want: `1999/go-1999-0001.json
+2000/go-2000-0002.json
+2000/go-2000-0003.json
+`,
+		},
+		{
+			name: "white/changes.csv",
+			want: `"2000/go-2000-0003.json","2003-01-01T00:00:00Z"
+"2000/go-2000-0002.json","2002-01-01T00:00:00Z"
+"1999/go-1999-0001.json","2000-01-01T00:00:00Z"
+`,
+		},
+	} {
+		b, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(f.name)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(f.want, string(b)); diff != "" {
+			t.Errorf("%s: mismatch (-want, +got):\n%s", f.name, diff)
+		}
+	}
+
+	var pm csaf.ProviderMetadata
+	readJSON(t, filepath.Join(dir, "provider-metadata.json"), &pm)
+	if want := "https://vuln.go.dev/csaf/provider-metadata.json"; pm.CanonicalURL != want {
+		t.Errorf("canonical_url = %s, want %s", pm.CanonicalURL, want)
+	}
+	if want := "2003-01-01T00:00:00Z"; pm.LastUpdated != want {
+		t.Errorf("last_updated = %s, want %s", pm.LastUpdated, want)
+	}
+
+	var a csaf.Advisory
+	readJSON(t, filepath.Join(dir, "white", "2000", "go-2000-0003.json"), &a)
+	if got, want := a.Document.Tracking.ID, "GO-2000-0003"; got != want {
+		t.Errorf("tracking ID = %s, want %s", got, want)
+	}
+}
+
+func readJSON(t *testing.T, filename string, v any) {
+	t.Helper()
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal(b, v); err != nil {
+		t.Fatal(err)
+	}
+}
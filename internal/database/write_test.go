@@ -6,6 +6,9 @@ package database
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"golang.org/x/mod/sumdb/dirhash"
@@ -67,3 +70,69 @@ func cmpDirHashes(d1, d2 string) error {
 	}
 	return nil
 }
+
+func TestWriteEncodings(t *testing.T) {
+	db := *valid
+	encs, err := ParseEncodings("zstd,gzip")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SetEncodings(encs...); err != nil {
+		t.Fatal(err)
+	}
+	dir := t.TempDir()
+	if err := db.Write(dir); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, f := range []string{"index/db.json.gz", "index/modules.json.zst", "ID/GO-1999-0001.json.zst"} {
+		if _, err := os.Stat(filepath.Join(dir, f)); err != nil {
+			t.Error(err)
+		}
+	}
+	b, err := os.ReadFile(filepath.Join(dir, "index", "db.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `"encodings":["gzip","zstd"]`; !strings.Contains(string(b), want) {
+		t.Errorf("db.json = %s, want it to contain %s", b, want)
+	}
+
+	// Load and Write are inverses, including the encodings.
+	loaded, err := Load(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := t.TempDir()
+	if err := loaded.Write(got); err != nil {
+		t.Fatal(err)
+	}
+	if err := cmpDirHashes(dir, got); err != nil {
+		t.Error(err)
+	}
+
+	// Advertised variants must be present.
+	if err := os.Remove(filepath.Join(dir, "ID", "GO-1999-0001.json.zst")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Load(dir); err == nil {
+		t.Error("Load: got nil error, want error for missing zstd file")
+	}
+}
+
+func TestSetEncodings(t *testing.T) {
+	db := *valid
+	if err := db.SetEncodings(Gzip); err != nil {
+		t.Fatal(err)
+	}
+	// The default is not advertised.
+	if db.DB.Encodings != nil {
+		t.Errorf("got encodings %v, want nil", db.DB.Encodings)
+	}
+	if err := db.SetEncodings(); err == nil {
+		t.Error("SetEncodings(): got nil error, want error")
+	}
+	if _, err := ParseEncodings("gzip,br"); err == nil {
+		t.Error("ParseEncodings: got nil error, want error for unknown encoding")
+	}
+}
@@ -27,8 +27,19 @@ func (db *Database) WriteZip(filename string) error {
 	zw := zip.NewWriter(f)
 	defer zw.Close()
 
-	for endpoint, v := range map[string]any{dbEndpoint: db.DB, modulesEndpoint: db.Modules, vulnsEndpoint: db.Vulns} {
-		if err := writeZip(zw, filepath.Join(indexDir, endpoint), v); err != nil {
+	// Write the files in a fixed order, so that the zip file
+	// is the same each time the database is written.
+	for _, index := range []struct {
+		endpoint string
+		v        any
+	}{{dbEndpoint, db.DB}, {modulesEndpoint, db.Modules}, {vulnsEndpoint, db.Vulns}} {
+		if err := writeZip(zw, filepath.Join(indexDir, index.endpoint), index.v); err != nil {
+			return err
+		}
+	}
+
+	for _, name := range db.DB.ModuleShards {
+		if err := writeZip(zw, filepath.Join(indexDir, shardFilename(name)), db.Modules.shard(name)); err != nil {
 			return err
 		}
 	}
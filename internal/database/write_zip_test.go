@@ -5,6 +5,8 @@
 package database
 
 import (
+	"bytes"
+	"os"
 	"path/filepath"
 	"testing"
 )
@@ -27,4 +29,21 @@ func TestWriteZip(t *testing.T) {
 	if err := cmpDirHashes(want, got); err != nil {
 		t.Error(err)
 	}
+
+	// The zip file is the same each time.
+	again := filepath.Join(tmp, "again.zip")
+	if err := valid.WriteZip(again); err != nil {
+		t.Fatal("WriteZip:", err)
+	}
+	b1, err := os.ReadFile(zipped)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b2, err := os.ReadFile(again)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(b1, b2) {
+		t.Error("WriteZip wrote different files for the same database")
+	}
 }
@@ -0,0 +1,39 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package database
+
+import (
+	"os"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+// writeZstd compresses the data in data with zstd and writes it
+// to filename, creating the file if needed.
+func writeZstd(filename string, data []byte) error {
+	w, err := zstd.NewWriter(nil, zstd.WithEncoderLevel(zstd.SpeedBestCompression))
+	if err != nil {
+		return err
+	}
+	defer w.Close()
+
+	return os.WriteFile(filename, w.EncodeAll(data, nil), 0644)
+}
+
+// readZstd returns the uncompressed bytes of zstd-compressed file filename.
+func readZstd(filename string) ([]byte, error) {
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := zstd.NewReader(nil)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	return r.DecodeAll(b, nil)
+}
@@ -0,0 +1,28 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package database
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestWriteReadZstd(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "test.json.zst")
+
+	want := []byte(`{"test":"Hello world!"}`)
+	if err := writeZstd(filename, want); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := readZstd(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(got) != string(want) {
+		t.Errorf("readZstd: got %s, want %s", got, want)
+	}
+}
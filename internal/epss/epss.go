@@ -0,0 +1,135 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package epss supports the API of the Exploit Prediction Scoring
+// System (EPSS) of FIRST, which estimates the probability that a CVE
+// will be exploited in the wild in the next 30 days.
+//
+// See https://www.first.org/epss/api.
+package epss
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"golang.org/x/vulndb/internal/derrors"
+)
+
+// URL is the endpoint of the EPSS API.
+const URL = "https://api.first.org/data/v1/epss"
+
+// The most CVEs to ask for in one request. The API returns at most 100
+// results per page, and limits the length of the query.
+const maxCVEsPerRequest = 100
+
+// A Client is a client for the EPSS API.
+type Client struct {
+	url        string
+	httpClient *http.Client
+}
+
+// NewClient returns a client for the EPSS API.
+func NewClient() *Client {
+	return newClient(URL)
+}
+
+func newClient(url string) *Client {
+	return &Client{
+		url:        url,
+		httpClient: http.DefaultClient,
+	}
+}
+
+// A Score is the EPSS score of a CVE on a given date.
+type Score struct {
+	CVE string
+	// EPSS is the probability, from 0 to 1, that the CVE will be
+	// exploited in the next 30 days.
+	EPSS float64
+	// Percentile is the proportion, from 0 to 1, of all scored CVEs
+	// with the same or a lower score.
+	Percentile float64
+	// Date is the date (YYYY-MM-DD) the score was computed.
+	Date string
+}
+
+type response struct {
+	Status string `json:"status"`
+	Total  int    `json:"total"`
+	Data   []struct {
+		CVE string `json:"cve"`
+		// The API returns numbers as strings.
+		EPSS       string `json:"epss"`
+		Percentile string `json:"percentile"`
+		Date       string `json:"date"`
+	} `json:"data"`
+}
+
+// Scores returns the current scores of the given CVEs, by CVE ID.
+// CVEs that EPSS has not scored (for example, because they are too
+// new) are missing from the result.
+func (c *Client) Scores(ctx context.Context, cves []string) (_ map[string]*Score, err error) {
+	defer derrors.Wrap(&err, "epss.Scores(%d CVEs)", len(cves))
+
+	scores := make(map[string]*Score)
+	for start := 0; start < len(cves); start += maxCVEsPerRequest {
+		end := min(start+maxCVEsPerRequest, len(cves))
+		if err := c.scores(ctx, cves[start:end], scores); err != nil {
+			return nil, err
+		}
+	}
+	return scores, nil
+}
+
+// scores adds the scores of the given CVEs to m.
+func (c *Client) scores(ctx context.Context, cves []string, m map[string]*Score) error {
+	params := url.Values{}
+	params.Set("cve", strings.Join(cves, ","))
+	u := c.url + "?" + params.Encode()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("GET %s: HTTP error: %s", u, resp.Status)
+	}
+	var r response
+	if err := json.NewDecoder(resp.Body).Decode(&r); err != nil {
+		return fmt.Errorf("GET %s: decoding response: %w", u, err)
+	}
+	for _, d := range r.Data {
+		e, err := strconv.ParseFloat(d.EPSS, 64)
+		if err != nil {
+			return fmt.Errorf("%s: invalid score: %w", d.CVE, err)
+		}
+		p, err := strconv.ParseFloat(d.Percentile, 64)
+		if err != nil {
+			return fmt.Errorf("%s: invalid percentile: %w", d.CVE, err)
+		}
+		m[d.CVE] = &Score{CVE: d.CVE, EPSS: e, Percentile: p, Date: d.Date}
+	}
+	return nil
+}
+
+// Max returns the highest of the scores of the given CVEs in m,
+// or nil if none of them has a score.
+func Max(m map[string]*Score, cves []string) *Score {
+	var best *Score
+	for _, cve := range cves {
+		if s, ok := m[cve]; ok && (best == nil || s.EPSS > best.EPSS) {
+			best = s
+		}
+	}
+	return best
+}
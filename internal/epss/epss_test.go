@@ -0,0 +1,82 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package epss
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestScores(t *testing.T) {
+	var requests int
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		cves := strings.Split(r.URL.Query().Get("cve"), ",")
+		if len(cves) > maxCVEsPerRequest {
+			http.Error(w, "too many CVEs", http.StatusBadRequest)
+			return
+		}
+		var data []string
+		for _, cve := range cves {
+			// CVE-2000-0000 has no score.
+			if cve == "CVE-2000-0000" {
+				continue
+			}
+			data = append(data, fmt.Sprintf(`{"cve":%q,"epss":"0.012340000","percentile":"0.850000000","date":"2024-03-01"}`, cve))
+		}
+		fmt.Fprintf(w, `{"status":"OK","status-code":200,"total":%d,"data":[%s]}`, len(data), strings.Join(data, ","))
+	}))
+	defer s.Close()
+
+	var cves []string
+	for i := range maxCVEsPerRequest + 1 {
+		cves = append(cves, fmt.Sprintf("CVE-2000-%04d", i))
+	}
+	got, err := newClient(s.URL).Scores(context.Background(), cves)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if requests != 2 {
+		t.Errorf("got %d requests, want 2", requests)
+	}
+	if len(got) != len(cves)-1 {
+		t.Errorf("got %d scores, want %d", len(got), len(cves)-1)
+	}
+	want := &Score{CVE: "CVE-2000-0100", EPSS: 0.01234, Percentile: 0.85, Date: "2024-03-01"}
+	if diff := cmp.Diff(want, got["CVE-2000-0100"]); diff != "" {
+		t.Errorf("mismatch (-want, +got):\n%s", diff)
+	}
+}
+
+func TestScoresError(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+	}))
+	defer s.Close()
+
+	if _, err := newClient(s.URL).Scores(context.Background(), []string{"CVE-2000-0001"}); err == nil {
+		t.Error("Scores() succeeded, want error")
+	}
+}
+
+func TestMax(t *testing.T) {
+	m := map[string]*Score{
+		"CVE-2000-0001": {CVE: "CVE-2000-0001", EPSS: 0.1},
+		"CVE-2000-0002": {CVE: "CVE-2000-0002", EPSS: 0.5},
+		"CVE-2000-0003": {CVE: "CVE-2000-0003", EPSS: 0.9},
+	}
+	if got := Max(m, []string{"CVE-2000-0001", "CVE-2000-0002", "CVE-2000-0004"}); got == nil || got.CVE != "CVE-2000-0002" {
+		t.Errorf("Max() = %v, want the score of CVE-2000-0002", got)
+	}
+	if got := Max(m, []string{"CVE-2000-0004"}); got != nil {
+		t.Errorf("Max() = %v, want nil", got)
+	}
+}
@@ -0,0 +1,108 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package genai
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"text/template"
+)
+
+// CWESuggestion is a Common Weakness Enumeration (CWE)
+// entry suggested for a vulnerability.
+type CWESuggestion struct {
+	// The CWE ID, of the form "CWE-NNN".
+	ID string
+	// The name of the weakness.
+	Name string
+	// How confident the model is that the CWE applies, from 0 to 1.
+	Confidence float64
+}
+
+var (
+	//go:embed templates/cwe_prompt.tmpl
+	cwePromptTmpl string
+	cwePrompt     = template.Must(template.New("cwe_prompt").Funcs(template.FuncMap{"toJSON": toJSON}).Parse(cwePromptTmpl))
+)
+
+// maxCWESuggestions is the number of CWEs the model is asked for.
+const maxCWESuggestions = 3
+
+// SuggestCWEs uses generative AI to suggest CWEs for the vulnerability
+// described by the input, most likely first.
+func SuggestCWEs(ctx context.Context, c Client, in *Input) ([]*CWESuggestion, error) {
+	prompt, err := newCWEPrompt(in)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates, err := c.GenerateText(ctx, prompt)
+	if err != nil {
+		return nil, err
+	}
+	if len(candidates) == 0 {
+		return nil, errors.New("GenAI API returned no candidates")
+	}
+
+	// Use the first valid candidate; each candidate is a full list.
+	var candidateErr error
+	for _, c := range candidates {
+		ss, err := parseCWESuggestions(c)
+		if err != nil {
+			candidateErr = err
+			continue
+		}
+		return ss, nil
+	}
+	return nil, fmt.Errorf("GenAI API returned no valid candidates: example error: %w", candidateErr)
+}
+
+func newCWEPrompt(in *Input) (string, error) {
+	var b strings.Builder
+	if err := cwePrompt.Execute(&b, struct {
+		Max   int
+		Input *Input
+	}{
+		Max:   maxCWESuggestions,
+		Input: in,
+	}); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+var cweIDRegex = regexp.MustCompile(`^CWE-[1-9][0-9]*$`)
+
+func parseCWESuggestions(str string) ([]*CWESuggestion, error) {
+	// Models sometimes wrap JSON in a Markdown code block.
+	s := strings.TrimSpace(str)
+	s = strings.TrimPrefix(s, "```json")
+	s = strings.Trim(s, "`\n ")
+
+	var ss []*CWESuggestion
+	if err := json.Unmarshal([]byte(s), &ss); err != nil {
+		return nil, fmt.Errorf("invalid candidate %q: unmarshal: %w", str, err)
+	}
+	if len(ss) == 0 {
+		return nil, fmt.Errorf("invalid candidate %q: no CWEs", str)
+	}
+	for _, cwe := range ss {
+		if !cweIDRegex.MatchString(cwe.ID) {
+			return nil, fmt.Errorf("invalid candidate %q: malformed CWE ID %q", str, cwe.ID)
+		}
+		if cwe.Confidence < 0 || cwe.Confidence > 1 {
+			return nil, fmt.Errorf("invalid candidate %q: confidence %v out of range", str, cwe.Confidence)
+		}
+	}
+	if len(ss) > maxCWESuggestions {
+		ss = ss[:maxCWESuggestions]
+	}
+	return ss, nil
+}
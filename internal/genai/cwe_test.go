@@ -0,0 +1,78 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package genai
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestSuggestCWEs(t *testing.T) {
+	prompt, err := newCWEPrompt(placeholderInput)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(prompt, placeholderInput.Description) {
+		t.Errorf("prompt does not contain the input description:\n%s", prompt)
+	}
+
+	for _, tc := range []struct {
+		name     string
+		response []string
+		want     []*CWESuggestion
+		wantErr  string
+	}{
+		{
+			name: "basic",
+			response: []string{
+				`[{"ID":"CWE-674","Name":"Uncontrolled Recursion","Confidence":0.9},{"ID":"CWE-400","Name":"Uncontrolled Resource Consumption","Confidence":0.4}]`,
+			},
+			want: []*CWESuggestion{
+				{ID: "CWE-674", Name: "Uncontrolled Recursion", Confidence: 0.9},
+				{ID: "CWE-400", Name: "Uncontrolled Resource Consumption", Confidence: 0.4},
+			},
+		},
+		{
+			name: "code block and invalid candidates",
+			response: []string{
+				`[{"ID":"674","Confidence":0.9}]`,
+				"```json\n[{\"ID\":\"CWE-22\",\"Name\":\"Path Traversal\",\"Confidence\":1}]\n```",
+			},
+			want: []*CWESuggestion{
+				{ID: "CWE-22", Name: "Path Traversal", Confidence: 1},
+			},
+		},
+		{
+			name:     "no response",
+			response: nil,
+			wantErr:  "no candidates",
+		},
+		{
+			name:     "all invalid",
+			response: []string{`[]`, `[{"ID":"CWE-1","Confidence":2}]`},
+			wantErr:  "out of range",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			c := &testCli{prompt: prompt, response: tc.response}
+			got, err := SuggestCWEs(context.Background(), c, placeholderInput)
+			if tc.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Fatalf("SuggestCWEs() error = %v, want err containing %s", err, tc.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("SuggestCWEs() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -6,8 +6,8 @@ package genai
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"os"
 
 	gemini "github.com/google/generative-ai-go/genai"
 	"google.golang.org/api/option"
@@ -26,15 +26,13 @@ type closer interface {
 	Close() error
 }
 
-const (
-	geminiAPIKeyEnv = "GEMINI_API_KEY"
-	geminiModel     = "gemini-pro"
-)
+const geminiModel = "gemini-pro"
 
-func NewGeminiClient(ctx context.Context) (*GeminiClient, error) {
-	key := os.Getenv(geminiAPIKeyEnv)
+// NewGeminiClient returns a client for the Gemini API that
+// authenticates with the given API key.
+func NewGeminiClient(ctx context.Context, key string) (*GeminiClient, error) {
 	if key == "" {
-		return nil, fmt.Errorf("Gemini API key (env var %s) not set. If you already have a key for the legacy PaLM API, you can use the same key for Gemini. Otherwise, you can get an API key at https://makersuite.google.com/app/apikey", geminiAPIKeyEnv)
+		return nil, errors.New("Gemini API key not set")
 	}
 	client, err := gemini.NewClient(ctx, option.WithAPIKey(key))
 	if err != nil {
@@ -0,0 +1,105 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package genericosv
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"slices"
+)
+
+// osvDevExports is the URL of the bucket holding the exports of
+// OSV.dev: an all.zip file with every entry of each ecosystem.
+const osvDevExports = "https://osv-vulnerabilities.storage.googleapis.com"
+
+// ListEcosystem returns all the entries of the given ecosystem in
+// OSV.dev (for example, the PyPI advisories, or the RustSec advisories
+// of the crates.io ecosystem), from its export.
+func ListEcosystem(ctx context.Context, hc *http.Client, eco Ecosystem) ([]*Entry, error) {
+	return listEcosystem(ctx, hc, osvDevExports, eco)
+}
+
+func listEcosystem(ctx context.Context, hc *http.Client, baseURL string, eco Ecosystem) ([]*Entry, error) {
+	u := fmt.Sprintf("%s/%s/all.zip", baseURL, url.PathEscape(string(eco)))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := hc.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("HTTP GET %s returned unexpected status code %d", u, resp.StatusCode)
+	}
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	return ReadZip(bytes.NewReader(b), int64(len(b)))
+}
+
+// ReadZip returns the entries in the zip file r of the given size,
+// which holds one JSON file per entry, as OSV.dev exports do.
+func ReadZip(r io.ReaderAt, size int64) ([]*Entry, error) {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, err
+	}
+	var entries []*Entry
+	for _, f := range zr.File {
+		if f.FileInfo().IsDir() || path.Ext(f.Name) != ".json" {
+			continue
+		}
+		e, err := readZipEntry(f)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", f.Name, err)
+		}
+		entries = append(entries, e)
+	}
+	return entries, nil
+}
+
+func readZipEntry(f *zip.File) (*Entry, error) {
+	rc, err := f.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+	var e Entry
+	if err := json.NewDecoder(rc).Decode(&e); err != nil {
+		return nil, err
+	}
+	return &e, nil
+}
+
+// ReferenceURLs returns the URLs of the references of e.
+func (e *Entry) ReferenceURLs() []string {
+	var urls []string
+	for _, r := range e.References {
+		urls = append(urls, r.URL)
+	}
+	return urls
+}
+
+// Ecosystems returns the ecosystems of the packages e affects,
+// in order of appearance.
+func (e *Entry) Ecosystems() []Ecosystem {
+	var ecos []Ecosystem
+	for _, a := range e.Affected {
+		if !slices.Contains(ecos, a.Package.Ecosystem) {
+			ecos = append(ecos, a.Package.Ecosystem)
+		}
+	}
+	return ecos
+}
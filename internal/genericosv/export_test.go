@@ -0,0 +1,68 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package genericosv
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestListEcosystem(t *testing.T) {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, content := range map[string]string{
+		"PYSEC-2024-1.json": `{"id":"PYSEC-2024-1","aliases":["CVE-2024-0001"],"affected":[{"package":{"ecosystem":"PyPI","name":"p"}}],"references":[{"type":"WEB","url":"https://github.com/a/b"}]}`,
+		"README":            "not an entry",
+	} {
+		w, err := zw.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := w.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/PyPI/all.zip" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write(buf.Bytes())
+	}))
+	defer s.Close()
+
+	got, err := listEcosystem(context.Background(), s.Client(), s.URL, EcosystemPyPI)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*Entry{{
+		ID:         "PYSEC-2024-1",
+		Aliases:    []string{"CVE-2024-0001"},
+		Affected:   []Affected{{Package: Package{Ecosystem: EcosystemPyPI, Name: "p"}}},
+		References: []Reference{{Type: ReferenceWeb, URL: "https://github.com/a/b"}},
+	}}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("mismatch (-want, +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"https://github.com/a/b"}, got[0].ReferenceURLs()); diff != "" {
+		t.Errorf("ReferenceURLs() mismatch (-want, +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]Ecosystem{EcosystemPyPI}, got[0].Ecosystems()); diff != "" {
+		t.Errorf("Ecosystems() mismatch (-want, +got):\n%s", diff)
+	}
+
+	if _, err := listEcosystem(context.Background(), s.Client(), s.URL, EcosystemNPM); err == nil {
+		t.Error("listEcosystem(npm): got nil error, want error")
+	}
+}
@@ -6,7 +6,6 @@ package genericosv
 
 import (
 	"fmt"
-	"strings"
 
 	"golang.org/x/vulndb/internal/idstr"
 	"golang.org/x/vulndb/internal/osv"
@@ -117,14 +116,14 @@ func convertRef(ref Reference) *report.Reference {
 	}
 }
 
-func convertCredits(cs []Credit) []string {
-	var credits []string
+func convertCredits(cs []Credit) []*report.Credit {
+	var credits []*report.Credit
 	for _, c := range cs {
-		credit := c.Name
-		if len(c.Contact) != 0 {
-			credit = fmt.Sprintf("%s (%s)", c.Name, strings.Join(c.Contact, ","))
-		}
-		credits = append(credits, credit)
+		credits = append(credits, &report.Credit{
+			Name:    c.Name,
+			Type:    osv.CreditType(c.Type),
+			Contact: c.Contact,
+		})
 	}
 	return credits
 }
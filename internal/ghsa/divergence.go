@@ -0,0 +1,122 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ghsa
+
+import (
+	"fmt"
+	"strings"
+
+	"golang.org/x/vulndb/internal/idstr"
+	"golang.org/x/vulndb/internal/report"
+	"golang.org/x/vulndb/internal/version/interval"
+)
+
+// A Divergence describes what a GHSA says about a vulnerability that
+// a Go report does not: the opposite of a Correction.
+type Divergence struct {
+	// GHSA is the ID of the GHSA.
+	GHSA string
+	// AddedVersions describe the vulnerable version ranges of the
+	// GHSA that include versions the report does not consider affected,
+	// in the form "MODULE VULNERABLE_VERSION_RANGE".
+	AddedVersions []string
+	// AddedReferences are the URLs the GHSA links to
+	// that are not in the report.
+	AddedReferences []string
+}
+
+// IsEmpty reports whether the GHSA says nothing the report does not.
+func (d *Divergence) IsEmpty() bool {
+	return len(d.AddedVersions) == 0 && len(d.AddedReferences) == 0
+}
+
+// Divergences compares the affected versions and references of sa with
+// those of r, and returns those of sa that r does not have: the changes
+// to r that would make it agree with sa.
+//
+// As with Corrections, modules in the standard library and toolchain are
+// not compared. Links to the GHSA itself and to the report are ignored.
+func Divergences(sa *SecurityAdvisory, r *report.Report) (*Divergence, error) {
+	d := &Divergence{GHSA: sa.ID}
+	affected := make(map[string]interval.Set)
+	for _, m := range r.Modules {
+		ranges, err := m.Versions.ToSemverRanges()
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", m.Module, err)
+		}
+		set, err := interval.FromOSV(ranges)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", m.Module, err)
+		}
+		affected[m.Module] = set
+	}
+	seen := make(map[string]bool)
+	for _, v := range sa.Vulns {
+		module := reportModule(v.Package, r)
+		if !compared(module) {
+			continue
+		}
+		added := module + " " + v.VulnerableVersionRange
+		if seen[added] {
+			continue
+		}
+		seen[added] = true
+		if !containsRange(affected[module], v.VulnerableVersionRange) {
+			d.AddedVersions = append(d.AddedVersions, added)
+		}
+	}
+
+	have := map[string]bool{idstr.GoAdvisory(r.ID): true}
+	for _, ref := range r.References {
+		have[ref.URL] = true
+	}
+	for _, ref := range sa.References {
+		if have[ref.URL] || idstr.IsAdvisoryFor(ref.URL, sa.ID) {
+			continue
+		}
+		have[ref.URL] = true
+		d.AddedReferences = append(d.AddedReferences, ref.URL)
+	}
+	return d, nil
+}
+
+// containsRange reports whether set contains all the versions in the
+// GHSA vulnerable version range vr. Ranges that can't be parsed, or
+// that use operators other than ">=", "<", "<=" and "=", are reported
+// as not contained, so that a person checks them.
+func containsRange(set interval.Set, vr string) bool {
+	items, err := parseVulnRange(vr)
+	if err != nil || len(items) == 0 {
+		return false
+	}
+	var (
+		in     interval.Interval
+		points []string // versions in the range, but not in the interval
+	)
+	for _, it := range items {
+		v := strings.TrimPrefix(it.version, "v")
+		switch it.op {
+		case ">=":
+			if v != "0" && v != "0.0.0" {
+				in.Introduced = v
+			}
+		case "<":
+			in.Fixed = v
+		case "<=":
+			in.Fixed = v
+			points = append(points, v)
+		case "=":
+			return set.Contains(v)
+		default:
+			return false
+		}
+	}
+	for _, p := range points {
+		if !set.Contains(p) {
+			return false
+		}
+	}
+	return set.ContainsSet(interval.Normalize(in))
+}
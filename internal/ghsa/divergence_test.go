@@ -0,0 +1,94 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ghsa
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/vulndb/internal/report"
+)
+
+func TestDivergences(t *testing.T) {
+	r := &report.Report{
+		ID: "GO-2024-0001",
+		Modules: []*report.Module{
+			{
+				Module:   "example.com/m",
+				Versions: report.Versions{report.Introduced("1.1.0"), report.Fixed("1.1.3"), report.Introduced("1.2.0")},
+			},
+			{
+				Module:   "std",
+				Versions: report.Versions{report.Fixed("1.21.1")},
+			},
+		},
+		References: []*report.Reference{
+			{Type: "FIX", URL: "https://example.com/m/commit/abc"},
+		},
+	}
+	for _, tc := range []struct {
+		name string
+		sa   *SecurityAdvisory
+		want *Divergence
+	}{
+		{
+			name: "agrees",
+			sa: &SecurityAdvisory{
+				ID: "GHSA-xxxx-yyyy-zzzz",
+				Vulns: []*Vuln{
+					{Package: "example.com/m/pkg", VulnerableVersionRange: ">= v1.1.1, < v1.1.3"},
+					{Package: "example.com/m", VulnerableVersionRange: ">= 1.2.0, <= 1.2.5"},
+					{Package: "example.com/m", VulnerableVersionRange: "= 1.2.0"},
+					{Package: "std", VulnerableVersionRange: "< 1.22.0"},
+				},
+				References: []Reference{
+					{URL: "https://github.com/advisories/GHSA-xxxx-yyyy-zzzz"},
+					{URL: "https://pkg.go.dev/vuln/GO-2024-0001"},
+					{URL: "https://example.com/m/commit/abc"},
+				},
+			},
+			want: &Divergence{GHSA: "GHSA-xxxx-yyyy-zzzz"},
+		},
+		{
+			name: "diverges",
+			sa: &SecurityAdvisory{
+				ID: "GHSA-xxxx-yyyy-zzzz",
+				Vulns: []*Vuln{
+					{Package: "example.com/m", VulnerableVersionRange: "< 1.1.3"},
+					{Package: "example.com/m", VulnerableVersionRange: "= 1.1.5"},
+					{Package: "example.com/m", VulnerableVersionRange: "> 1.2.0"},
+					{Package: "example.com/other", VulnerableVersionRange: "< 2.0.0"},
+				},
+				References: []Reference{
+					{URL: "https://example.com/m/commit/abc"},
+					{URL: "https://example.com/m/issues/1"},
+				},
+			},
+			want: &Divergence{
+				GHSA: "GHSA-xxxx-yyyy-zzzz",
+				AddedVersions: []string{
+					"example.com/m < 1.1.3",
+					"example.com/m = 1.1.5",
+					"example.com/m > 1.2.0",
+					"example.com/other < 2.0.0",
+				},
+				AddedReferences: []string{"https://example.com/m/issues/1"},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := Divergences(tc.sa, r)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Divergences() mismatch (-want, +got):\n%s", diff)
+			}
+			if got.IsEmpty() != (tc.name == "agrees") {
+				t.Errorf("IsEmpty() = %t", got.IsEmpty())
+			}
+		})
+	}
+}
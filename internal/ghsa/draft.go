@@ -0,0 +1,99 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ghsa
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"golang.org/x/vulndb/internal/derrors"
+	"golang.org/x/vulndb/internal/idstr"
+	"golang.org/x/vulndb/internal/report"
+)
+
+// GitHubRepository returns the repository, of the form "owner/name",
+// that hosts the module with the given path, or "" if the module is
+// not hosted on GitHub.
+//
+// Only paths that start with "github.com/" are recognized;
+// modules with vanity import paths are not.
+func GitHubRepository(modulePath string) string {
+	rest, ok := strings.CutPrefix(modulePath, "github.com/")
+	if !ok {
+		return ""
+	}
+	parts := strings.Split(rest, "/")
+	if len(parts) < 2 || parts[0] == "" || parts[1] == "" {
+		return ""
+	}
+	return parts[0] + "/" + parts[1]
+}
+
+// DraftRepositories returns the GitHub repositories, of the form
+// "owner/name", that host the modules of r, in sorted order.
+func DraftRepositories(r *report.Report) []string {
+	var repos []string
+	for _, m := range r.Modules {
+		if repo := GitHubRepository(m.Module); repo != "" && !slices.Contains(repos, repo) {
+			repos = append(repos, repo)
+		}
+	}
+	slices.Sort(repos)
+	return repos
+}
+
+// Draft returns a repository advisory for the modules of r that repo,
+// of the form "owner/name", hosts, for the repository's maintainers to
+// accept. It returns nil if repo hosts none of the modules of r.
+//
+// Repository advisories have no field for references, so they are
+// listed at the end of the description, after a link to the report.
+func Draft(r *report.Report, repo string) *RepositoryAdvisory {
+	var avs []*AdvisoryVulnerability
+	for _, av := range ReportVulnerabilities(r) {
+		if GitHubRepository(av.Package.Name) == repo {
+			avs = append(avs, av)
+		}
+	}
+	if len(avs) == 0 {
+		return nil
+	}
+	var b strings.Builder
+	if d := r.Description.String(); d != "" {
+		fmt.Fprintf(&b, "%s\n\n", d)
+	}
+	fmt.Fprintf(&b, "This advisory was drafted from the Go vulnerability report %s: %s", r.ID, idstr.GoAdvisory(r.ID))
+	if len(r.References) > 0 {
+		b.WriteString("\n\nReferences:")
+		for _, ref := range r.References {
+			fmt.Fprintf(&b, "\n- %s", ref.URL)
+		}
+	}
+	return &RepositoryAdvisory{
+		Summary:         r.Summary.String(),
+		Description:     b.String(),
+		Vulnerabilities: avs,
+	}
+}
+
+// ReportRepositoryVulnerability privately reports the vulnerability
+// described by a to the maintainers of repo, which is of the form
+// "owner/name", and returns the draft advisory that GitHub creates.
+// The maintainers can then accept, edit and publish the draft.
+//
+// This requires the repository to have enabled private
+// vulnerability reporting.
+func (c *Client) ReportRepositoryVulnerability(ctx context.Context, repo string, a *RepositoryAdvisory) (_ *RepositoryAdvisory, err error) {
+	defer derrors.Wrap(&err, "ReportRepositoryVulnerability(%q)", repo)
+
+	var created RepositoryAdvisory
+	if err := c.doREST(ctx, http.MethodPost, advisoriesPath(repo, "")+"/reports", a, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
@@ -0,0 +1,124 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ghsa
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/vulndb/internal/report"
+)
+
+func TestGitHubRepository(t *testing.T) {
+	for _, tc := range []struct {
+		path, want string
+	}{
+		{"github.com/owner/repo", "owner/repo"},
+		{"github.com/owner/repo/v2", "owner/repo"},
+		{"github.com/owner/repo/sub/mod", "owner/repo"},
+		{"github.com/owner", ""},
+		{"golang.org/x/net", ""},
+		{"example.com/github.com/owner/repo", ""},
+	} {
+		if got := GitHubRepository(tc.path); got != tc.want {
+			t.Errorf("GitHubRepository(%q) = %q, want %q", tc.path, got, tc.want)
+		}
+	}
+}
+
+func TestDraft(t *testing.T) {
+	r := &report.Report{
+		ID: "GO-2024-0001",
+		Modules: []*report.Module{
+			{
+				Module:   "github.com/owner/repo",
+				Versions: report.Versions{report.Introduced("1.1.0"), report.Fixed("1.1.3")},
+				Packages: []*report.Package{{Package: "github.com/owner/repo/pkg", Symbols: []string{"F"}}},
+			},
+			{
+				Module:   "github.com/owner/repo/v2",
+				Versions: report.Versions{report.Fixed("2.0.1")},
+			},
+			{
+				Module: "github.com/other/repo",
+			},
+		},
+		Summary:     "Panic in github.com/owner/repo",
+		Description: "Parsing crafted input panics.",
+		References: []*report.Reference{
+			{Type: "FIX", URL: "https://github.com/owner/repo/commit/abc"},
+		},
+	}
+	if diff := cmp.Diff([]string{"other/repo", "owner/repo"}, DraftRepositories(r)); diff != "" {
+		t.Errorf("DraftRepositories mismatch (-want, +got):\n%s", diff)
+	}
+	want := &RepositoryAdvisory{
+		Summary: "Panic in github.com/owner/repo",
+		Description: `Parsing crafted input panics.
+
+This advisory was drafted from the Go vulnerability report GO-2024-0001: https://pkg.go.dev/vuln/GO-2024-0001
+
+References:
+- https://github.com/owner/repo/commit/abc`,
+		Vulnerabilities: []*AdvisoryVulnerability{
+			{
+				Package:                AdvisoryPackage{Ecosystem: "go", Name: "github.com/owner/repo"},
+				VulnerableVersionRange: ">= 1.1.0, < 1.1.3",
+				PatchedVersions:        "1.1.3",
+				VulnerableFunctions:    []string{"github.com/owner/repo/pkg.F"},
+			},
+			{
+				Package:                AdvisoryPackage{Ecosystem: "go", Name: "github.com/owner/repo/v2"},
+				VulnerableVersionRange: "< 2.0.1",
+				PatchedVersions:        "2.0.1",
+			},
+		},
+	}
+	if diff := cmp.Diff(want, Draft(r, "owner/repo")); diff != "" {
+		t.Errorf("Draft mismatch (-want, +got):\n%s", diff)
+	}
+	if got := Draft(r, "owner/elsewhere"); got != nil {
+		t.Errorf("Draft(owner/elsewhere) = %+v, want nil", got)
+	}
+}
+
+func TestReportRepositoryVulnerability(t *testing.T) {
+	var got map[string]any
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/repos/owner/repo/security-advisories/reports" {
+			http.Error(w, "unexpected request "+r.Method+" "+r.URL.Path, http.StatusNotFound)
+			return
+		}
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		w.Write([]byte(`{"ghsa_id": "GHSA-xxxx-yyyy-zzzz", "state": "triage", "html_url": "https://github.com/owner/repo/security/advisories/GHSA-xxxx-yyyy-zzzz"}`))
+	}))
+	defer srv.Close()
+	c := &Client{httpClient: srv.Client(), restURL: srv.URL}
+
+	a, err := c.ReportRepositoryVulnerability(context.Background(), "owner/repo", &RepositoryAdvisory{
+		Summary: "A summary",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &RepositoryAdvisory{
+		GHSAID:  "GHSA-xxxx-yyyy-zzzz",
+		State:   "triage",
+		HTMLURL: "https://github.com/owner/repo/security/advisories/GHSA-xxxx-yyyy-zzzz",
+	}
+	if !cmp.Equal(a, want) {
+		t.Errorf("got %+v, want %+v", a, want)
+	}
+	if diff := cmp.Diff(map[string]any{"summary": "A summary"}, got); diff != "" {
+		t.Errorf("request mismatch (-want, +got):\n%s", diff)
+	}
+}
@@ -8,6 +8,7 @@ package ghsa
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"time"
 
 	"github.com/shurcooL/githubv4"
@@ -136,6 +137,9 @@ func (sa *gqlSecurityAdvisory) securityAdvisory() (*SecurityAdvisory, error) {
 type Client struct {
 	client *githubv4.Client
 	token  string
+	// For the REST API, which supports writing repository advisories.
+	httpClient *http.Client
+	restURL    string
 }
 
 // NewClient creates a new client for making requests to the GHSA API.
@@ -143,8 +147,10 @@ func NewClient(ctx context.Context, accessToken string) *Client {
 	ts := oauth2.StaticTokenSource(&oauth2.Token{AccessToken: accessToken})
 	tc := oauth2.NewClient(ctx, ts)
 	return &Client{
-		client: githubv4.NewClient(tc),
-		token:  accessToken,
+		client:     githubv4.NewClient(tc),
+		token:      accessToken,
+		httpClient: tc,
+		restURL:    defaultRESTURL,
 	}
 }
 
@@ -0,0 +1,202 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ghsa
+
+import (
+	"slices"
+	"strings"
+
+	"golang.org/x/vulndb/internal/idstr"
+	"golang.org/x/vulndb/internal/report"
+	"golang.org/x/vulndb/internal/stdlib"
+)
+
+// A Correction describes the changes that would make a GHSA agree
+// with a Go report.
+type Correction struct {
+	// GHSA is the ID of the GHSA to correct.
+	GHSA string
+	// Vulnerabilities are the affected packages and versions according
+	// to the report. It is set only if they differ from the GHSA's.
+	Vulnerabilities []*AdvisoryVulnerability
+	// AddReferences are the URLs in the report
+	// that the GHSA does not link to.
+	AddReferences []string
+}
+
+// IsEmpty reports whether c proposes no changes.
+func (c *Correction) IsEmpty() bool {
+	return len(c.Vulnerabilities) == 0 && len(c.AddReferences) == 0
+}
+
+// Corrections compares the affected versions and references of sa with
+// those of r, and returns the changes to sa that would make it agree
+// with r.
+//
+// Modules in the standard library and toolchain are not compared,
+// because GHSAs do not describe them in the same way.
+func Corrections(sa *SecurityAdvisory, r *report.Report) *Correction {
+	c := &Correction{GHSA: sa.ID}
+	want := ReportVulnerabilities(r)
+	if !sameVulnerabilities(sa.Vulns, want, r) {
+		c.Vulnerabilities = want
+	}
+
+	have := make(map[string]bool)
+	for _, ref := range sa.References {
+		have[ref.URL] = true
+	}
+	urls := []string{idstr.GoAdvisory(r.ID)}
+	for _, ref := range r.References {
+		urls = append(urls, ref.URL)
+	}
+	for _, u := range urls {
+		if have[u] || idstr.IsAdvisoryFor(u, sa.ID) {
+			continue
+		}
+		have[u] = true
+		c.AddReferences = append(c.AddReferences, u)
+	}
+	return c
+}
+
+// ReportVulnerabilities returns the affected modules and versions of r
+// in the form used by GHSAs: one entry for each module and range of
+// affected versions.
+func ReportVulnerabilities(r *report.Report) []*AdvisoryVulnerability {
+	var avs []*AdvisoryVulnerability
+	for _, m := range r.Modules {
+		if !compared(m.Module) {
+			continue
+		}
+		var funcs []string
+		for _, p := range m.Packages {
+			for _, s := range p.AllSymbols() {
+				funcs = append(funcs, p.Package+"."+s)
+			}
+		}
+		for _, vr := range ranges(m.Versions) {
+			avs = append(avs, &AdvisoryVulnerability{
+				Package:                AdvisoryPackage{Ecosystem: EcosystemGo, Name: m.Module},
+				VulnerableVersionRange: vr.String(),
+				PatchedVersions:        vr.fixed,
+				VulnerableFunctions:    funcs,
+			})
+		}
+	}
+	return avs
+}
+
+func compared(modulePath string) bool {
+	return modulePath != stdlib.ModulePath && modulePath != stdlib.ToolchainModulePath
+}
+
+// A versionRange is a range of affected versions. An empty
+// introduced version means all versions before fixed, and an empty
+// fixed version means all versions after introduced.
+type versionRange struct {
+	introduced, fixed string
+}
+
+// String returns the range in the form of a GHSA vulnerable version range.
+func (vr versionRange) String() string {
+	var items []string
+	if vr.introduced != "" {
+		items = append(items, ">= "+vr.introduced)
+	}
+	if vr.fixed != "" {
+		items = append(items, "< "+vr.fixed)
+	}
+	if len(items) == 0 {
+		return ">= 0"
+	}
+	return strings.Join(items, ", ")
+}
+
+// ranges groups versions, which are in order, into ranges.
+func ranges(vs report.Versions) []versionRange {
+	var (
+		rs   []versionRange
+		cur  versionRange
+		open bool
+	)
+	for _, v := range vs {
+		switch v.Type {
+		case report.VersionTypeIntroduced:
+			if open {
+				rs = append(rs, cur)
+			}
+			cur, open = versionRange{introduced: v.Version}, true
+		case report.VersionTypeFixed:
+			cur.fixed = v.Version
+			rs = append(rs, cur)
+			cur, open = versionRange{}, false
+		}
+	}
+	if open || len(rs) == 0 {
+		rs = append(rs, cur)
+	}
+	return rs
+}
+
+// sameVulnerabilities reports whether the GHSA vulns affect the
+// same modules and versions as the report's vulnerabilities, want.
+func sameVulnerabilities(vulns []*Vuln, want []*AdvisoryVulnerability, r *report.Report) bool {
+	key := func(module, vulnRange, fixed string) string {
+		return module + " " + normalizeRange(vulnRange) + " " + strings.TrimPrefix(fixed, "v")
+	}
+	var got, exp []string
+	for _, v := range vulns {
+		module := reportModule(v.Package, r)
+		if !compared(module) {
+			continue
+		}
+		got = append(got, key(module, v.VulnerableVersionRange, v.EarliestFixedVersion))
+	}
+	for _, av := range want {
+		exp = append(exp, key(av.Package.Name, av.VulnerableVersionRange, av.PatchedVersions))
+	}
+	slices.Sort(got)
+	got = slices.Compact(got)
+	slices.Sort(exp)
+	return slices.Equal(got, exp)
+}
+
+// reportModule returns the path of the module of r that contains the
+// package or module with the given path, which GHSAs use to identify
+// vulnerable Go code, or the path itself if there is none.
+func reportModule(path string, r *report.Report) string {
+	for _, m := range r.Modules {
+		if path == m.Module || strings.HasPrefix(path, m.Module+"/") {
+			return m.Module
+		}
+	}
+	return path
+}
+
+// normalizeRange returns a GHSA vulnerable version range in the
+// form produced by versionRange.String, so that ranges can be compared.
+func normalizeRange(s string) string {
+	items, err := parseVulnRange(s)
+	if err != nil {
+		return s
+	}
+	var vr versionRange
+	for _, it := range items {
+		v := strings.TrimPrefix(it.version, "v")
+		switch it.op {
+		case ">=":
+			if v != "0" && v != "0.0.0" {
+				vr.introduced = v
+			}
+		case "<":
+			vr.fixed = v
+		default:
+			// Not produced by versionRange; compare as is.
+			return s
+		}
+	}
+	return vr.String()
+}
@@ -0,0 +1,109 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ghsa
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/vulndb/internal/report"
+)
+
+func TestCorrections(t *testing.T) {
+	r := &report.Report{
+		ID: "GO-2024-0001",
+		Modules: []*report.Module{
+			{
+				Module:   "example.com/m",
+				Versions: report.Versions{report.Introduced("1.1.0"), report.Fixed("1.1.3"), report.Introduced("1.2.0"), report.Fixed("1.2.1")},
+			},
+			{
+				Module:   "std",
+				Versions: report.Versions{report.Fixed("1.21.1")},
+			},
+		},
+		References: []*report.Reference{
+			{Type: "FIX", URL: "https://example.com/m/commit/abc"},
+			{Type: "ADVISORY", URL: "https://github.com/advisories/GHSA-xxxx-yyyy-zzzz"},
+		},
+	}
+	wantVulns := []*AdvisoryVulnerability{
+		{
+			Package:                AdvisoryPackage{Ecosystem: "go", Name: "example.com/m"},
+			VulnerableVersionRange: ">= 1.1.0, < 1.1.3",
+			PatchedVersions:        "1.1.3",
+		},
+		{
+			Package:                AdvisoryPackage{Ecosystem: "go", Name: "example.com/m"},
+			VulnerableVersionRange: ">= 1.2.0, < 1.2.1",
+			PatchedVersions:        "1.2.1",
+		},
+	}
+	for _, tc := range []struct {
+		name string
+		sa   *SecurityAdvisory
+		want *Correction
+	}{
+		{
+			name: "agrees",
+			sa: &SecurityAdvisory{
+				ID: "GHSA-xxxx-yyyy-zzzz",
+				Vulns: []*Vuln{
+					{Package: "example.com/m/pkg", VulnerableVersionRange: ">= v1.1.0, < v1.1.3", EarliestFixedVersion: "1.1.3"},
+					{Package: "example.com/m", VulnerableVersionRange: ">= 1.2.0, < 1.2.1", EarliestFixedVersion: "1.2.1"},
+				},
+				References: []Reference{
+					{URL: "https://pkg.go.dev/vuln/GO-2024-0001"},
+					{URL: "https://example.com/m/commit/abc"},
+				},
+			},
+			want: &Correction{GHSA: "GHSA-xxxx-yyyy-zzzz"},
+		},
+		{
+			name: "disagrees",
+			sa: &SecurityAdvisory{
+				ID: "GHSA-xxxx-yyyy-zzzz",
+				Vulns: []*Vuln{
+					{Package: "example.com/m", VulnerableVersionRange: "< 1.1.3", EarliestFixedVersion: "1.1.3"},
+				},
+			},
+			want: &Correction{
+				GHSA:            "GHSA-xxxx-yyyy-zzzz",
+				Vulnerabilities: wantVulns,
+				AddReferences:   []string{"https://pkg.go.dev/vuln/GO-2024-0001", "https://example.com/m/commit/abc"},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got := Corrections(tc.sa, r)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Corrections() mismatch (-want, +got):\n%s", diff)
+			}
+			if got.IsEmpty() != (tc.name == "agrees") {
+				t.Errorf("IsEmpty() = %t", got.IsEmpty())
+			}
+		})
+	}
+}
+
+func TestRanges(t *testing.T) {
+	for _, tc := range []struct {
+		vs   report.Versions
+		want []string
+	}{
+		{nil, []string{">= 0"}},
+		{report.Versions{report.Fixed("1.0.0")}, []string{"< 1.0.0"}},
+		{report.Versions{report.Introduced("1.0.0")}, []string{">= 1.0.0"}},
+		{report.Versions{report.Fixed("1.0.0"), report.Introduced("1.1.0")}, []string{"< 1.0.0", ">= 1.1.0"}},
+	} {
+		var got []string
+		for _, r := range ranges(tc.vs) {
+			got = append(got, r.String())
+		}
+		if diff := cmp.Diff(tc.want, got); diff != "" {
+			t.Errorf("ranges(%v) mismatch (-want, +got):\n%s", tc.vs, diff)
+		}
+	}
+}
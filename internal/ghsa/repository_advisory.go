@@ -0,0 +1,154 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ghsa
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"golang.org/x/vulndb/internal/derrors"
+)
+
+const defaultRESTURL = "https://api.github.com"
+
+// A RepositoryAdvisory is a security advisory owned by a GitHub
+// repository, as represented by the REST API for repository security
+// advisories (https://docs.github.com/en/rest/security-advisories/repository-advisories).
+//
+// Unlike reviewed global advisories, which can only be read,
+// repository advisories can be created and updated by the
+// repository's maintainers.
+type RepositoryAdvisory struct {
+	GHSAID          string                   `json:"ghsa_id,omitempty"`
+	CVEID           string                   `json:"cve_id,omitempty"`
+	HTMLURL         string                   `json:"html_url,omitempty"`
+	Summary         string                   `json:"summary,omitempty"`
+	Description     string                   `json:"description,omitempty"`
+	Severity        string                   `json:"severity,omitempty"`
+	State           string                   `json:"state,omitempty"`
+	CWEIDs          []string                 `json:"cwe_ids,omitempty"`
+	Vulnerabilities []*AdvisoryVulnerability `json:"vulnerabilities,omitempty"`
+}
+
+// An AdvisoryVulnerability is a package affected by a
+// RepositoryAdvisory, and the affected versions.
+type AdvisoryVulnerability struct {
+	Package AdvisoryPackage `json:"package"`
+	// A range of versions, e.g. ">= 1.0.0, < 1.2.3".
+	VulnerableVersionRange string `json:"vulnerable_version_range"`
+	// The lowest fixed version, or empty if there is none.
+	PatchedVersions     string   `json:"patched_versions"`
+	VulnerableFunctions []string `json:"vulnerable_functions,omitempty"`
+}
+
+// An AdvisoryPackage identifies a package in an ecosystem.
+type AdvisoryPackage struct {
+	Ecosystem string `json:"ecosystem"`
+	Name      string `json:"name"`
+}
+
+// EcosystemGo is the value of AdvisoryPackage.Ecosystem for Go packages.
+const EcosystemGo = "go"
+
+// RepositoryAdvisory returns the advisory with the given GHSA ID
+// owned by repo, which is of the form "owner/name".
+func (c *Client) RepositoryAdvisory(ctx context.Context, repo, ghsaID string) (_ *RepositoryAdvisory, err error) {
+	defer derrors.Wrap(&err, "RepositoryAdvisory(%q, %q)", repo, ghsaID)
+
+	var a RepositoryAdvisory
+	if err := c.doREST(ctx, http.MethodGet, advisoriesPath(repo, ghsaID), nil, &a); err != nil {
+		return nil, err
+	}
+	return &a, nil
+}
+
+// CreateRepositoryAdvisory creates a draft advisory owned by repo,
+// which is of the form "owner/name", and returns it as created.
+func (c *Client) CreateRepositoryAdvisory(ctx context.Context, repo string, a *RepositoryAdvisory) (_ *RepositoryAdvisory, err error) {
+	defer derrors.Wrap(&err, "CreateRepositoryAdvisory(%q)", repo)
+
+	var created RepositoryAdvisory
+	if err := c.doREST(ctx, http.MethodPost, advisoriesPath(repo, ""), a, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+// UpdateRepositoryAdvisory updates the advisory with the given GHSA ID
+// owned by repo, which is of the form "owner/name", and returns it as
+// updated. Only the non-empty fields of a are changed.
+func (c *Client) UpdateRepositoryAdvisory(ctx context.Context, repo, ghsaID string, a *RepositoryAdvisory) (_ *RepositoryAdvisory, err error) {
+	defer derrors.Wrap(&err, "UpdateRepositoryAdvisory(%q, %q)", repo, ghsaID)
+
+	var updated RepositoryAdvisory
+	if err := c.doREST(ctx, http.MethodPatch, advisoriesPath(repo, ghsaID), a, &updated); err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+func advisoriesPath(repo, ghsaID string) string {
+	p := fmt.Sprintf("/repos/%s/security-advisories", repo)
+	if ghsaID != "" {
+		p += "/" + ghsaID
+	}
+	return p
+}
+
+// doREST sends a request with the JSON encoding of in (if non-nil) to
+// the REST API, and decodes the response into out.
+func (c *Client) doREST(ctx context.Context, method, path string, in, out any) error {
+	var body io.Reader
+	if in != nil {
+		b, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(b)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, strings.TrimSuffix(c.restURL, "/")+path, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("%s %s: %s: %s", method, path, resp.Status, bytes.TrimSpace(msg))
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+// RepositoryOf returns the repository, of the form "owner/name", that
+// owns the advisory with the given permalink, or "" if the permalink
+// is not that of a repository advisory.
+//
+// Repository advisories have permalinks of the form
+// https://github.com/OWNER/NAME/security/advisories/GHSA-xxxx-xxxx-xxxx,
+// while global advisories have the form
+// https://github.com/advisories/GHSA-xxxx-xxxx-xxxx.
+func RepositoryOf(permalink string) string {
+	rest, ok := strings.CutPrefix(permalink, "https://github.com/")
+	if !ok {
+		return ""
+	}
+	parts := strings.Split(rest, "/")
+	if len(parts) != 5 || parts[2] != "security" || parts[3] != "advisories" {
+		return ""
+	}
+	return parts[0] + "/" + parts[1]
+}
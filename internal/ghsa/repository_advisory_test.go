@@ -0,0 +1,72 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ghsa
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestUpdateRepositoryAdvisory(t *testing.T) {
+	var got map[string]any
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch || r.URL.Path != "/repos/owner/repo/security-advisories/GHSA-xxxx-yyyy-zzzz" {
+			http.Error(w, "unexpected request "+r.Method+" "+r.URL.Path, http.StatusNotFound)
+			return
+		}
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		w.Write([]byte(`{"ghsa_id": "GHSA-xxxx-yyyy-zzzz", "state": "published"}`))
+	}))
+	defer srv.Close()
+	c := &Client{httpClient: srv.Client(), restURL: srv.URL}
+
+	a, err := c.UpdateRepositoryAdvisory(context.Background(), "owner/repo", "GHSA-xxxx-yyyy-zzzz", &RepositoryAdvisory{
+		Vulnerabilities: []*AdvisoryVulnerability{{
+			Package:                AdvisoryPackage{Ecosystem: EcosystemGo, Name: "example.com/m"},
+			VulnerableVersionRange: "< 1.0.0",
+			PatchedVersions:        "1.0.0",
+		}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (&RepositoryAdvisory{GHSAID: "GHSA-xxxx-yyyy-zzzz", State: "published"}); !cmp.Equal(a, want) {
+		t.Errorf("got %+v, want %+v", a, want)
+	}
+	want := map[string]any{
+		"vulnerabilities": []any{map[string]any{
+			"package":                  map[string]any{"ecosystem": "go", "name": "example.com/m"},
+			"vulnerable_version_range": "< 1.0.0",
+			"patched_versions":         "1.0.0",
+		}},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("request mismatch (-want, +got):\n%s", diff)
+	}
+
+	if _, err := c.RepositoryAdvisory(context.Background(), "owner/repo", "GHSA-xxxx-yyyy-zzzz"); err == nil {
+		t.Error("RepositoryAdvisory: got nil error for 404 response")
+	}
+}
+
+func TestRepositoryOf(t *testing.T) {
+	for permalink, want := range map[string]string{
+		"https://github.com/owner/repo/security/advisories/GHSA-xxxx-yyyy-zzzz":  "owner/repo",
+		"https://github.com/advisories/GHSA-xxxx-yyyy-zzzz":                      "",
+		"https://example.com/owner/repo/security/advisories/GHSA-xxxx-yyyy-zzzz": "",
+	} {
+		if got := RepositoryOf(permalink); got != want {
+			t.Errorf("RepositoryOf(%q) = %q, want %q", permalink, got, want)
+		}
+	}
+}
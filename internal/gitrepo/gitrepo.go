@@ -8,13 +8,16 @@ package gitrepo
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/go-git/go-billy/v5/memfs"
 	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
 	"github.com/go-git/go-git/v5/plumbing"
 	"github.com/go-git/go-git/v5/plumbing/object"
 	"github.com/go-git/go-git/v5/storage/memory"
@@ -45,6 +48,202 @@ func CloneAt(ctx context.Context, repoURL string, ref plumbing.ReferenceName) (r
 	})
 }
 
+// CloneWithHistory returns a bare repo with the history of its HEAD
+// branch, by cloning the repo at repoURL. Unlike Clone, the history
+// is needed to compute the dates of files (see AllCommitDates).
+func CloneWithHistory(ctx context.Context, repoURL string) (repo *git.Repository, err error) {
+	defer derrors.Wrap(&err, "gitrepo.CloneWithHistory(%q)", repoURL)
+	ctx, span := observe.Start(ctx, "gitrepo.CloneWithHistory")
+	defer span.End()
+
+	log.Infof(ctx, "Cloning repo %q at HEAD with history", repoURL)
+	return git.CloneContext(ctx, memory.NewStorage(), nil, &git.CloneOptions{
+		URL:           repoURL,
+		ReferenceName: plumbing.HEAD,
+		SingleBranch:  true,
+		Tags:          git.NoTags,
+	})
+}
+
+// CloneWithTags returns a bare repo with the history of all its
+// branches and tags, by cloning the repo at repoURL.
+func CloneWithTags(ctx context.Context, repoURL string) (repo *git.Repository, err error) {
+	defer derrors.Wrap(&err, "gitrepo.CloneWithTags(%q)", repoURL)
+	ctx, span := observe.Start(ctx, "gitrepo.CloneWithTags")
+	defer span.End()
+
+	log.Infof(ctx, "Cloning repo %q with tags", repoURL)
+	return git.CloneContext(ctx, memory.NewStorage(), nil, &git.CloneOptions{
+		URL:  repoURL,
+		Tags: git.AllTags,
+	})
+}
+
+// ShallowOptions describe a shallow and, optionally, sparse clone
+// of a repo, which is much faster than a full clone for large repos.
+type ShallowOptions struct {
+	// Commits are the hashes of the commits to fetch.
+	// If there are none, the HEAD branch is fetched.
+	Commits []string
+	// Depth is the number of commits of the history of each commit
+	// to fetch, counting the commit itself. Zero means 1.
+	Depth int
+	// Paths are the directories to check out (a sparse checkout).
+	// If there are none, the whole tree is checked out. They only
+	// apply to non-bare repos.
+	Paths []string
+}
+
+// CloneShallow returns a bare repo by cloning the repo at repoURL
+// as described by opts.
+func CloneShallow(ctx context.Context, repoURL string, opts *ShallowOptions) (repo *git.Repository, err error) {
+	defer derrors.Wrap(&err, "gitrepo.CloneShallow(%q, %v)", repoURL, opts.Commits)
+	ctx, span := observe.Start(ctx, "gitrepo.CloneShallow")
+	defer span.End()
+
+	log.Infof(ctx, "Shallow cloning repo %q at %v", repoURL, opts.commits())
+	repo, err = git.Init(memory.NewStorage(), nil)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := fetchShallow(ctx, repo, repoURL, opts); err != nil {
+		return nil, err
+	}
+	return repo, nil
+}
+
+// PlainCloneShallow returns a (non-bare) repo in dir by cloning the repo
+// at repoURL as described by opts. The worktree is checked out at the
+// first of opts.Commits, or at HEAD, with only opts.Paths if there
+// are any.
+func PlainCloneShallow(ctx context.Context, dir, repoURL string, opts *ShallowOptions) (repo *git.Repository, err error) {
+	defer derrors.Wrap(&err, "gitrepo.PlainCloneShallow(%q, %v)", repoURL, opts.Commits)
+	ctx, span := observe.Start(ctx, "gitrepo.PlainCloneShallow")
+	defer span.End()
+
+	log.Infof(ctx, "Plain shallow cloning repo %q at %v", repoURL, opts.commits())
+	repo, err = git.PlainInit(dir, false)
+	if err != nil {
+		return nil, err
+	}
+	hash, err := fetchShallow(ctx, repo, repoURL, opts)
+	if err != nil {
+		return nil, err
+	}
+	w, err := repo.Worktree()
+	if err != nil {
+		return nil, err
+	}
+	if err := w.Checkout(&git.CheckoutOptions{
+		Hash:                      hash,
+		Force:                     true,
+		SparseCheckoutDirectories: opts.Paths,
+	}); err != nil {
+		return nil, err
+	}
+	return repo, nil
+}
+
+func (opts *ShallowOptions) commits() []string {
+	if len(opts.Commits) == 0 {
+		return []string{plumbing.HEAD.String()}
+	}
+	return opts.Commits
+}
+
+// The reference that fetchShallow fetches HEAD to.
+const shallowHead = plumbing.ReferenceName("refs/remotes/origin/HEAD")
+
+// fetchShallow fetches the commits of opts from repoURL into repo,
+// and returns the hash of the first (or of HEAD).
+//
+// The remote is configured to fetch only those commits, so that
+// fetching from it later doesn't pull in the rest of the repo.
+func fetchShallow(ctx context.Context, repo *git.Repository, repoURL string, opts *ShallowOptions) (plumbing.Hash, error) {
+	var specs []config.RefSpec
+	for _, c := range opts.Commits {
+		if !plumbing.IsHash(c) {
+			return plumbing.ZeroHash, fmt.Errorf("%q is not a full commit hash", c)
+		}
+		specs = append(specs, config.RefSpec(fmt.Sprintf("%s:refs/remotes/origin/%s", c, c)))
+	}
+	if len(specs) == 0 {
+		specs = append(specs, config.RefSpec(fmt.Sprintf("+%s:%s", plumbing.HEAD, shallowHead)))
+	}
+	remote, err := repo.CreateRemote(&config.RemoteConfig{
+		Name:  git.DefaultRemoteName,
+		URLs:  []string{repoURL},
+		Fetch: specs,
+	})
+	if err != nil {
+		return plumbing.ZeroHash, err
+	}
+	depth := max(opts.Depth, 1)
+	if err := remote.FetchContext(ctx, &git.FetchOptions{
+		RefSpecs: specs,
+		Depth:    depth,
+		Tags:     git.NoTags,
+	}); err != nil {
+		return plumbing.ZeroHash, err
+	}
+	if len(opts.Commits) > 0 {
+		return plumbing.NewHash(opts.Commits[0]), nil
+	}
+	ref, err := repo.Reference(shallowHead, true)
+	if err != nil {
+		return plumbing.ZeroHash, err
+	}
+	return ref.Hash(), nil
+}
+
+// EarliestTagContaining returns the first of tags whose commit
+// contains the commit with the given (possibly abbreviated) hash,
+// that is, whose merge base with the commit is the commit itself.
+// Tags that are not in repo are skipped. If no tag contains the
+// commit, EarliestTagContaining returns "".
+func EarliestTagContaining(repo *git.Repository, hash string, tags []string) (_ string, err error) {
+	defer derrors.Wrap(&err, "gitrepo.EarliestTagContaining(%s)", hash)
+
+	h, err := repo.ResolveRevision(plumbing.Revision(hash))
+	if err != nil {
+		return "", err
+	}
+	commit, err := repo.CommitObject(*h)
+	if err != nil {
+		return "", err
+	}
+	for _, tag := range tags {
+		tc, err := tagCommit(repo, tag)
+		if errors.Is(err, git.ErrTagNotFound) {
+			continue
+		}
+		if err != nil {
+			return "", err
+		}
+		bases, err := commit.MergeBase(tc)
+		if err != nil {
+			return "", err
+		}
+		if slices.ContainsFunc(bases, func(b *object.Commit) bool { return b.Hash == commit.Hash }) {
+			return tag, nil
+		}
+	}
+	return "", nil
+}
+
+// tagCommit returns the commit of the lightweight
+// or annotated tag with the given name.
+func tagCommit(repo *git.Repository, name string) (*object.Commit, error) {
+	ref, err := repo.Tag(name)
+	if err != nil {
+		return nil, err
+	}
+	if t, err := repo.TagObject(ref.Hash()); err == nil {
+		return t.Commit()
+	}
+	return repo.CommitObject(ref.Hash())
+}
+
 // PlainClone returns a (non-bare) repo with its history by cloning the repo at repoURL.
 func PlainClone(ctx context.Context, dir, repoURL string) (repo *git.Repository, err error) {
 	defer derrors.Wrap(&err, "gitrepo.PlainClone(%q)", repoURL)
@@ -301,6 +500,49 @@ func AllCommitDates(repo *git.Repository, refName ReferenceName, prefix string)
 	return dates, nil
 }
 
+// ChangedSince returns the sorted names of files that have been added or
+// modified between the given revision (e.g., a commit hash or a branch name)
+// and HEAD, where the filename begins with prefix. Deleted files are
+// not included.
+func ChangedSince(repo *git.Repository, rev, prefix string) (names []string, err error) {
+	defer derrors.Wrap(&err, "ChangedSince(%q, %q)", rev, prefix)
+
+	h, err := repo.ResolveRevision(plumbing.Revision(rev))
+	if err != nil {
+		return nil, err
+	}
+	from, err := repo.CommitObject(*h)
+	if err != nil {
+		return nil, err
+	}
+	fromTree, err := from.Tree()
+	if err != nil {
+		return nil, err
+	}
+	head, err := HeadCommit(repo)
+	if err != nil {
+		return nil, err
+	}
+	headTree, err := head.Tree()
+	if err != nil {
+		return nil, err
+	}
+	changes, err := object.DiffTree(fromTree, headTree)
+	if err != nil {
+		return nil, err
+	}
+	for _, change := range changes {
+		// A change with no destination is a deletion.
+		name := change.To.Name
+		if name == "" || !strings.HasPrefix(name, prefix) {
+			continue
+		}
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names, nil
+}
+
 func ReadAll(repo *git.Repository, hash plumbing.Hash) ([]byte, error) {
 	blob, err := repo.BlobObject(hash)
 	if err != nil {
@@ -5,13 +5,18 @@
 package gitrepo_test
 
 import (
+	"context"
 	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
 	"testing"
 	"time"
 
 	"github.com/go-git/go-billy/v5"
 	"github.com/go-git/go-billy/v5/memfs"
 	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
 	"github.com/go-git/go-git/v5/plumbing/object"
 	"github.com/go-git/go-git/v5/storage/memory"
 	"github.com/google/go-cmp/cmp"
@@ -54,6 +59,163 @@ func TestAllCommitDates(t *testing.T) {
 	}
 }
 
+func TestChangedSince(t *testing.T) {
+	test := newTest(t)
+	when := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	test.Commit("first", when, map[string]string{
+		"files/1": "one",
+		"files/2": "two",
+	})
+	first, err := gitrepo.HeadHash(test.Repo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.Commit("second", when.Add(time.Hour), map[string]string{
+		"files/2": "two, modified",
+		"files/3": "three",
+		"other/4": "four",
+	})
+
+	got, err := gitrepo.ChangedSince(test.Repo, first.String(), "files/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"files/2", "files/3"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("ChangedSince returned unexpected result (-want,+got):\n%v", diff)
+	}
+}
+
+func TestEarliestTagContaining(t *testing.T) {
+	test := newTest(t)
+	when := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	commit := func(name string) string {
+		test.Commit(name, when, map[string]string{"file": name})
+		when = when.Add(time.Hour)
+		h, err := gitrepo.HeadHash(test.Repo)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return h.String()
+	}
+	first := commit("first")
+	if _, err := test.Repo.CreateTag("v1.0.0", plumbing.NewHash(first), nil); err != nil {
+		t.Fatal(err)
+	}
+	fix := commit("fix")
+	third := commit("third")
+	// An annotated tag.
+	if _, err := test.Repo.CreateTag("v1.1.0", plumbing.NewHash(third), &git.CreateTagOptions{
+		Tagger:  &object.Signature{Name: "Tagger", Email: "tagger@example.com", When: when},
+		Message: "v1.1.0",
+	}); err != nil {
+		t.Fatal(err)
+	}
+	last := commit("last")
+
+	tags := []string{"v0.9.0", "v1.0.0", "v1.1.0"}
+	for _, tc := range []struct {
+		hash string
+		want string
+	}{
+		{first, "v1.0.0"},
+		{fix[:12], "v1.1.0"},
+		{third, "v1.1.0"},
+		{last, ""},
+	} {
+		got, err := gitrepo.EarliestTagContaining(test.Repo, tc.hash, tags)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tc.want {
+			t.Errorf("EarliestTagContaining(%s) = %q, want %q", tc.hash, got, tc.want)
+		}
+	}
+}
+
+func TestShallowClone(t *testing.T) {
+	// Cloning from a local repo runs git-upload-pack.
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skipf("skipping: %v", err)
+	}
+	ctx := context.Background()
+
+	test := newPlainTest(t)
+	cfg, err := test.Repo.Config()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Allow fetching commits by hash, as GitHub does.
+	cfg.Raw.Section("uploadpack").SetOption("allowAnySHA1InWant", "true")
+	if err := test.Repo.SetConfig(cfg); err != nil {
+		t.Fatal(err)
+	}
+	when := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	var hashes []plumbing.Hash
+	for _, name := range []string{"first", "fix", "last"} {
+		test.Commit(name, when, map[string]string{"a/file": name, "b/file": name})
+		when = when.Add(time.Hour)
+		h, err := gitrepo.HeadHash(test.Repo)
+		if err != nil {
+			t.Fatal(err)
+		}
+		hashes = append(hashes, h)
+	}
+	first, fix, last := hashes[0], hashes[1], hashes[2]
+	url := test.FS.Root()
+
+	t.Run("commit", func(t *testing.T) {
+		dir := t.TempDir()
+		repo, err := gitrepo.PlainCloneShallow(ctx, dir, url, &gitrepo.ShallowOptions{
+			Commits: []string{fix.String()},
+			Depth:   2,
+			Paths:   []string{"a"},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		// The commit and its parent are fetched, but not the later commit.
+		for _, h := range []plumbing.Hash{fix, first} {
+			if _, err := repo.CommitObject(h); err != nil {
+				t.Errorf("CommitObject(%s): %v", h, err)
+			}
+		}
+		if _, err := repo.CommitObject(last); err == nil {
+			t.Errorf("CommitObject(%s) succeeded, want error", last)
+		}
+		// Only a/ is checked out, at the commit.
+		b, err := os.ReadFile(filepath.Join(dir, "a", "file"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := string(b), "fix"; got != want {
+			t.Errorf("a/file = %q, want %q", got, want)
+		}
+		if _, err := os.Stat(filepath.Join(dir, "b", "file")); !os.IsNotExist(err) {
+			t.Errorf("b/file was checked out (err = %v)", err)
+		}
+	})
+
+	t.Run("head", func(t *testing.T) {
+		repo, err := gitrepo.CloneShallow(ctx, url, &gitrepo.ShallowOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := repo.CommitObject(last); err != nil {
+			t.Errorf("CommitObject(%s): %v", last, err)
+		}
+		if _, err := repo.CommitObject(fix); err == nil {
+			t.Errorf("CommitObject(%s) succeeded, want error", fix)
+		}
+	})
+
+	t.Run("not_a_hash", func(t *testing.T) {
+		if _, err := gitrepo.CloneShallow(ctx, url, &gitrepo.ShallowOptions{Commits: []string{"main"}}); err == nil {
+			t.Error("CloneShallow succeeded, want error")
+		}
+	})
+}
+
 type gitTest struct {
 	t    *testing.T
 	FS   billy.Filesystem
@@ -74,6 +236,24 @@ func newTest(t *testing.T) *gitTest {
 	}
 }
 
+// newPlainTest returns a test with a (non-bare) repo on disk.
+func newPlainTest(t *testing.T) *gitTest {
+	t.Helper()
+	repo, err := git.PlainInit(t.TempDir(), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wt, err := repo.Worktree()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &gitTest{
+		t:    t,
+		FS:   wt.Filesystem,
+		Repo: repo,
+	}
+}
+
 func (test *gitTest) Commit(message string, when time.Time, files map[string]string) {
 	test.t.Helper()
 	wt, err := test.Repo.Worktree()
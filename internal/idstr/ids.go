@@ -78,3 +78,19 @@ func FindID(s string) string {
 func IsAliasType(id string) bool {
 	return IsGHSA(id) || IsCVE(id)
 }
+
+// FindAliases returns the CVE and GHSA IDs in s, in order of
+// first appearance and without duplicates.
+func FindAliases(s string) []string {
+	var ids []string
+	seen := make(map[string]bool)
+	for _, id := range aliasRE.FindAllString(s, -1) {
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+var aliasRE = regexp.MustCompile(cveStr + `|` + ghsaStr)
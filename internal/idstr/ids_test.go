@@ -4,7 +4,10 @@
 
 package idstr
 
-import "testing"
+import (
+	"slices"
+	"testing"
+)
 
 func TestFindCVE(t *testing.T) {
 	s := "something/CVE-1999-0004.json"
@@ -13,3 +16,12 @@ func TestFindCVE(t *testing.T) {
 		t.Errorf("FindCVE(%s) = %s, want %s", s, got, want)
 	}
 }
+
+func TestFindAliases(t *testing.T) {
+	s := "x/vulndb: potential Go vuln in example.com/m: CVE-1999-0004, GHSA-xxxx-yyyy-zzzz\n\nSee also CVE-1999-0005 and CVE-1999-0004."
+	got := FindAliases(s)
+	want := []string{"CVE-1999-0004", "GHSA-xxxx-yyyy-zzzz", "CVE-1999-0005"}
+	if !slices.Equal(got, want) {
+		t.Errorf("FindAliases(%q) = %v, want %v", s, got, want)
+	}
+}
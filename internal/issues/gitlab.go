@@ -0,0 +1,368 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package issues
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/vulndb/internal/derrors"
+)
+
+// GitLabClient is a client for the issues of a GitLab project,
+// using the GitLab REST API (v4).
+type GitLabClient struct {
+	baseURL    string
+	project    string
+	token      string
+	httpClient *http.Client
+}
+
+// GitLabConfig is used to initialize a new GitLabClient.
+type GitLabConfig struct {
+	// BaseURL is the URL of the GitLab instance, for example
+	// "https://gitlab.com" or "https://gitlab.example.com".
+	BaseURL string
+
+	// Project is the full path of the project, for example
+	// "security/vulndb".
+	Project string
+
+	// Token is an access token with the "api" scope.
+	Token string
+
+	// HTTPClient is the client to use. If nil, http.DefaultClient is used.
+	HTTPClient *http.Client
+}
+
+// NewGitLabClient creates a GitLabClient for the issues of a GitLab project.
+func NewGitLabClient(cfg *GitLabConfig) *GitLabClient {
+	hc := cfg.HTTPClient
+	if hc == nil {
+		hc = http.DefaultClient
+	}
+	return &GitLabClient{
+		baseURL:    strings.TrimSuffix(cfg.BaseURL, "/"),
+		project:    cfg.Project,
+		token:      cfg.Token,
+		httpClient: hc,
+	}
+}
+
+// Destination returns the URL of the GitLab project.
+func (c *GitLabClient) Destination() string {
+	return fmt.Sprintf("%s/%s", c.baseURL, c.project)
+}
+
+// Reference returns the URL of the given issue.
+func (c *GitLabClient) Reference(num int) string {
+	return fmt.Sprintf("%s/-/issues/%d", c.Destination(), num)
+}
+
+// gitlabIssue is an issue, as returned by the GitLab API.
+type gitlabIssue struct {
+	IID         int    `json:"iid"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	State       string `json:"state"`
+	Assignees   []struct {
+		Username string `json:"username"`
+	} `json:"assignees"`
+	Labels    []string  `json:"labels"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func (gi *gitlabIssue) issue() *Issue {
+	iss := &Issue{
+		Number:    gi.IID,
+		Title:     gi.Title,
+		Body:      gi.Description,
+		State:     fromGitLabState(gi.State),
+		Labels:    gi.Labels,
+		CreatedAt: gi.CreatedAt,
+		UpdatedAt: gi.UpdatedAt,
+	}
+	if len(gi.Assignees) > 0 {
+		iss.Assignee = gi.Assignees[0].Username
+	}
+	return iss
+}
+
+// GitLab calls open issues "opened".
+const gitlabOpen = "opened"
+
+func fromGitLabState(s string) string {
+	if s == gitlabOpen {
+		return "open"
+	}
+	return s
+}
+
+func toGitLabState(s string) string {
+	switch s {
+	case "", "open":
+		return gitlabOpen
+	}
+	return s
+}
+
+// Issue returns the issue with the given number.
+func (c *GitLabClient) Issue(ctx context.Context, num int) (_ *Issue, err error) {
+	defer derrors.Wrap(&err, "Issue(%d)", num)
+
+	var gi gitlabIssue
+	if _, err := c.do(ctx, http.MethodGet, fmt.Sprintf("/issues/%d", num), nil, nil, &gi); err != nil {
+		return nil, err
+	}
+	return gi.issue(), nil
+}
+
+// Issues returns all issues that match the filters in opts.
+func (c *GitLabClient) Issues(ctx context.Context, opts IssuesOptions) (_ []*Issue, err error) {
+	defer derrors.Wrap(&err, "Issues()")
+
+	q := url.Values{"state": {toGitLabState(opts.State)}}
+	if len(opts.Labels) > 0 {
+		q.Set("labels", strings.Join(opts.Labels, ","))
+	}
+	if !opts.Since.IsZero() {
+		q.Set("updated_after", opts.Since.UTC().Format(time.RFC3339))
+	}
+	return c.list(ctx, q)
+}
+
+// maxPerPage is the largest page size the GitLab API accepts.
+const maxPerPage = 100
+
+// IssuesByNumber returns the issues with the given numbers, omitting
+// any that don't exist. It fetches up to 100 issues per request.
+func (c *GitLabClient) IssuesByNumber(ctx context.Context, nums []int) (_ map[int]*Issue, err error) {
+	defer derrors.Wrap(&err, "IssuesByNumber(%d issues)", len(nums))
+
+	result := make(map[int]*Issue)
+	for start := 0; start < len(nums); start += maxPerPage {
+		q := url.Values{"state": {"all"}}
+		for _, n := range nums[start:min(start+maxPerPage, len(nums))] {
+			q.Add("iids[]", strconv.Itoa(n))
+		}
+		is, err := c.list(ctx, q)
+		if err != nil {
+			return nil, err
+		}
+		for _, iss := range is {
+			result[iss.Number] = iss
+		}
+	}
+	return result, nil
+}
+
+// list returns all the pages of issues matching the query.
+func (c *GitLabClient) list(ctx context.Context, q url.Values) ([]*Issue, error) {
+	q.Set("per_page", strconv.Itoa(maxPerPage))
+	var issues []*Issue
+	for page := "1"; page != ""; {
+		q.Set("page", page)
+		var gis []*gitlabIssue
+		h, err := c.do(ctx, http.MethodGet, "/issues", q, nil, &gis)
+		if err != nil {
+			return nil, err
+		}
+		for _, gi := range gis {
+			issues = append(issues, gi.issue())
+		}
+		page = h.Get("X-Next-Page")
+	}
+	return issues, nil
+}
+
+// CreateIssue creates a new issue.
+func (c *GitLabClient) CreateIssue(ctx context.Context, iss *Issue) (num int, err error) {
+	defer derrors.Wrap(&err, "CreateIssue(%s)", iss.Title)
+
+	req := map[string]any{
+		"title":       iss.Title,
+		"description": iss.Body,
+	}
+	if len(iss.Labels) > 0 {
+		req["labels"] = strings.Join(iss.Labels, ",")
+	}
+	if iss.Assignee != "" {
+		id, err := c.userID(ctx, iss.Assignee)
+		if err != nil {
+			return 0, err
+		}
+		req["assignee_ids"] = []int{id}
+	}
+	var gi gitlabIssue
+	if _, err := c.do(ctx, http.MethodPost, "/issues", nil, req, &gi); err != nil {
+		return 0, err
+	}
+	return gi.IID, nil
+}
+
+// SetLabels replaces the labels of the issue.
+func (c *GitLabClient) SetLabels(ctx context.Context, num int, labels []string) (err error) {
+	defer derrors.Wrap(&err, "SetLabels(%d, %s)", num, labels)
+
+	req := map[string]any{"labels": strings.Join(labels, ",")}
+	_, err = c.do(ctx, http.MethodPut, fmt.Sprintf("/issues/%d", num), nil, req, nil)
+	return err
+}
+
+// SetBody replaces the body (the description, in GitLab terms)
+// of the issue.
+func (c *GitLabClient) SetBody(ctx context.Context, num int, body string) (err error) {
+	defer derrors.Wrap(&err, "SetBody(%d)", num)
+
+	req := map[string]any{"description": body}
+	_, err = c.do(ctx, http.MethodPut, fmt.Sprintf("/issues/%d", num), nil, req, nil)
+	return err
+}
+
+// SetAssignee assigns the issue to the GitLab user with the
+// given username.
+func (c *GitLabClient) SetAssignee(ctx context.Context, num int, assignee string) (err error) {
+	defer derrors.Wrap(&err, "SetAssignee(%d, %s)", num, assignee)
+
+	id, err := c.userID(ctx, assignee)
+	if err != nil {
+		return err
+	}
+	req := map[string]any{"assignee_ids": []int{id}}
+	_, err = c.do(ctx, http.MethodPut, fmt.Sprintf("/issues/%d", num), nil, req, nil)
+	return err
+}
+
+// AddComments adds the comments to the issue, in order.
+func (c *GitLabClient) AddComments(ctx context.Context, num int, comments []string) (err error) {
+	defer derrors.Wrap(&err, "AddComments(%d, %s)", num, comments)
+
+	for _, comment := range comments {
+		req := map[string]any{"body": comment}
+		if _, err := c.do(ctx, http.MethodPost, fmt.Sprintf("/issues/%d/notes", num), nil, req, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// A gitlabNote is a comment on a GitLab issue.
+type gitlabNote struct {
+	ID   int64  `json:"id"`
+	Body string `json:"body"`
+	// System notes are those GitLab adds to record changes,
+	// such as to labels.
+	System bool `json:"system"`
+}
+
+// Comments returns the comments on the issue, oldest first.
+// The notes GitLab adds to record changes to the issue are omitted.
+func (c *GitLabClient) Comments(ctx context.Context, num int) (_ []*Comment, err error) {
+	defer derrors.Wrap(&err, "Comments(%d)", num)
+
+	q := url.Values{
+		"order_by": {"created_at"},
+		"sort":     {"asc"},
+		"per_page": {strconv.Itoa(maxPerPage)},
+	}
+	var comments []*Comment
+	for page := "1"; page != ""; {
+		q.Set("page", page)
+		var notes []*gitlabNote
+		h, err := c.do(ctx, http.MethodGet, fmt.Sprintf("/issues/%d/notes", num), q, nil, &notes)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range notes {
+			if !n.System {
+				comments = append(comments, &Comment{ID: n.ID, Body: n.Body})
+			}
+		}
+		page = h.Get("X-Next-Page")
+	}
+	return comments, nil
+}
+
+// EditComment replaces the body of the comment with the given ID
+// on the issue.
+func (c *GitLabClient) EditComment(ctx context.Context, num int, id int64, body string) (err error) {
+	defer derrors.Wrap(&err, "EditComment(%d, %d)", num, id)
+
+	req := map[string]any{"body": body}
+	_, err = c.do(ctx, http.MethodPut, fmt.Sprintf("/issues/%d/notes/%d", num, id), nil, req, nil)
+	return err
+}
+
+// userID returns the ID of the user with the given username, which
+// the API needs to assign issues.
+func (c *GitLabClient) userID(ctx context.Context, username string) (int, error) {
+	var users []struct {
+		ID int `json:"id"`
+	}
+	if _, err := c.get(ctx, "/api/v4/users", url.Values{"username": {username}}, &users); err != nil {
+		return 0, err
+	}
+	if len(users) == 0 {
+		return 0, fmt.Errorf("no GitLab user %q", username)
+	}
+	return users[0].ID, nil
+}
+
+// do makes a request to the project's API at the given path
+// (such as "/issues"), decoding the response into v if non-nil.
+func (c *GitLabClient) do(ctx context.Context, method, path string, q url.Values, body, v any) (http.Header, error) {
+	return c.request(ctx, method, "/api/v4/projects/"+url.PathEscape(c.project)+path, q, body, v)
+}
+
+func (c *GitLabClient) get(ctx context.Context, path string, q url.Values, v any) (http.Header, error) {
+	return c.request(ctx, http.MethodGet, path, q, nil, v)
+}
+
+func (c *GitLabClient) request(ctx context.Context, method, path string, q url.Values, body, v any) (http.Header, error) {
+	u := c.baseURL + path
+	if len(q) > 0 {
+		u += "?" + q.Encode()
+	}
+	var r io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		r = bytes.NewReader(b)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, u, r)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("PRIVATE-TOKEN", c.token)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, fmt.Errorf("%s %s: %s: %s", method, path, resp.Status, bytes.TrimSpace(msg))
+	}
+	if v != nil {
+		if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+			return nil, err
+		}
+	}
+	return resp.Header, nil
+}
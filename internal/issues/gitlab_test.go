@@ -0,0 +1,210 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package issues_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/vulndb/internal/issues"
+)
+
+const (
+	testGitLabProject = "security/vulndb"
+	testGitLabToken   = "gltoken"
+	// The project's API path, with its path escaped as GitLab requires.
+	testGitLabAPI = "/api/v4/projects/security%2Fvulndb"
+)
+
+// setupGitLab returns a GitLabClient for a fake GitLab server that
+// serves the given handlers, keyed by "METHOD escaped-path".
+func setupGitLab(t *testing.T, handlers map[string]http.HandlerFunc) *issues.GitLabClient {
+	t.Helper()
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("PRIVATE-TOKEN"); got != testGitLabToken {
+			t.Errorf("PRIVATE-TOKEN = %q, want %q", got, testGitLabToken)
+		}
+		h, ok := handlers[r.Method+" "+r.URL.EscapedPath()]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		h(w, r)
+	}))
+	t.Cleanup(s.Close)
+	return issues.NewGitLabClient(&issues.GitLabConfig{
+		BaseURL: s.URL,
+		Project: testGitLabProject,
+		Token:   testGitLabToken,
+	})
+}
+
+func TestGitLabReference(t *testing.T) {
+	c := issues.NewGitLabClient(&issues.GitLabConfig{BaseURL: "https://gitlab.example.com/", Project: testGitLabProject})
+	if got, want := c.Destination(), "https://gitlab.example.com/security/vulndb"; got != want {
+		t.Errorf("Destination() = %q, want %q", got, want)
+	}
+	if got, want := c.Reference(7), "https://gitlab.example.com/security/vulndb/-/issues/7"; got != want {
+		t.Errorf("Reference(7) = %q, want %q", got, want)
+	}
+}
+
+func TestGitLabIssues(t *testing.T) {
+	created := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	c := setupGitLab(t, map[string]http.HandlerFunc{
+		"GET " + testGitLabAPI + "/issues": func(w http.ResponseWriter, r *http.Request) {
+			q := r.URL.Query()
+			if got, want := q.Get("state"), "opened"; got != want {
+				t.Errorf("state = %q, want %q", got, want)
+			}
+			if got, want := q.Get("labels"), "a,b"; got != want {
+				t.Errorf("labels = %q, want %q", got, want)
+			}
+			switch q.Get("page") {
+			case "1":
+				w.Header().Set("X-Next-Page", "2")
+				fmt.Fprintf(w, `[{"iid":1,"title":"one","description":"body","state":"opened","labels":["a","b"],"assignees":[{"username":"alice"}],"created_at":%q}]`, created.Format(time.RFC3339))
+			case "2":
+				fmt.Fprint(w, `[{"iid":2,"title":"two","state":"closed"}]`)
+			default:
+				t.Errorf("unexpected page %q", q.Get("page"))
+			}
+		},
+	})
+	got, err := c.Issues(context.Background(), issues.IssuesOptions{Labels: []string{"a", "b"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*issues.Issue{
+		{Number: 1, Title: "one", Body: "body", State: "open", Assignee: "alice", Labels: []string{"a", "b"}, CreatedAt: created},
+		{Number: 2, Title: "two", State: "closed"},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("mismatch (-want, +got):\n%s", diff)
+	}
+}
+
+func TestGitLabIssuesByNumber(t *testing.T) {
+	c := setupGitLab(t, map[string]http.HandlerFunc{
+		"GET " + testGitLabAPI + "/issues": func(w http.ResponseWriter, r *http.Request) {
+			if diff := cmp.Diff([]string{"1", "5"}, r.URL.Query()["iids[]"]); diff != "" {
+				t.Errorf("iids mismatch (-want, +got):\n%s", diff)
+			}
+			// Issue 5 does not exist.
+			fmt.Fprint(w, `[{"iid":1,"title":"one","state":"opened"}]`)
+		},
+	})
+	got, err := c.IssuesByNumber(context.Background(), []int{1, 5})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[int]*issues.Issue{1: {Number: 1, Title: "one", State: "open"}}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("mismatch (-want, +got):\n%s", diff)
+	}
+}
+
+func TestGitLabMutations(t *testing.T) {
+	var (
+		updates  []map[string]any
+		comments []string
+	)
+	decode := func(t *testing.T, r *http.Request) map[string]any {
+		var m map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&m); err != nil {
+			t.Fatal(err)
+		}
+		return m
+	}
+	c := setupGitLab(t, map[string]http.HandlerFunc{
+		"POST " + testGitLabAPI + "/issues": func(w http.ResponseWriter, r *http.Request) {
+			updates = append(updates, decode(t, r))
+			fmt.Fprint(w, `{"iid":15}`)
+		},
+		"PUT " + testGitLabAPI + "/issues/15": func(w http.ResponseWriter, r *http.Request) {
+			updates = append(updates, decode(t, r))
+			fmt.Fprint(w, `{}`)
+		},
+		"POST " + testGitLabAPI + "/issues/15/notes": func(w http.ResponseWriter, r *http.Request) {
+			comments = append(comments, decode(t, r)["body"].(string))
+			fmt.Fprint(w, `{}`)
+		},
+		"GET " + testGitLabAPI + "/issues/15/notes": func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprint(w, `[{"id":1,"body":"first"},{"id":2,"body":"added label","system":true},{"id":3,"body":"second"}]`)
+		},
+		"PUT " + testGitLabAPI + "/issues/15/notes/3": func(w http.ResponseWriter, r *http.Request) {
+			updates = append(updates, decode(t, r))
+			fmt.Fprint(w, `{}`)
+		},
+		"GET /api/v4/users": func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Query().Get("username") == "alice" {
+				fmt.Fprint(w, `[{"id":42}]`)
+				return
+			}
+			fmt.Fprint(w, `[]`)
+		},
+	})
+	ctx := context.Background()
+
+	num, err := c.CreateIssue(ctx, &issues.Issue{Title: "title", Body: "body", Labels: []string{"x", "y"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if num != 15 {
+		t.Errorf("CreateIssue() = %d, want 15", num)
+	}
+	if err := c.SetLabels(ctx, 15, []string{"z"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.SetBody(ctx, 15, "new body"); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.SetAssignee(ctx, 15, "alice"); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.SetAssignee(ctx, 15, "nobody"); err == nil {
+		t.Error("SetAssignee(unknown user): got nil error, want error")
+	}
+	if err := c.AddComments(ctx, 15, []string{"first", "second"}); err != nil {
+		t.Fatal(err)
+	}
+	gotComments, err := c.Comments(ctx, 15)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]*issues.Comment{{ID: 1, Body: "first"}, {ID: 3, Body: "second"}}, gotComments); diff != "" {
+		t.Errorf("Comments() mismatch (-want, +got):\n%s", diff)
+	}
+	if err := c.EditComment(ctx, 15, 3, "edited"); err != nil {
+		t.Fatal(err)
+	}
+
+	wantUpdates := []map[string]any{
+		{"title": "title", "description": "body", "labels": "x,y"},
+		{"labels": "z"},
+		{"description": "new body"},
+		{"assignee_ids": []any{float64(42)}},
+		{"body": "edited"},
+	}
+	if diff := cmp.Diff(wantUpdates, updates); diff != "" {
+		t.Errorf("updates mismatch (-want, +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"first", "second"}, comments); diff != "" {
+		t.Errorf("comments mismatch (-want, +got):\n%s", diff)
+	}
+}
+
+func TestGitLabError(t *testing.T) {
+	c := setupGitLab(t, nil)
+	if _, err := c.Issue(context.Background(), 3); err == nil {
+		t.Error("Issue(3): got nil error, want error")
+	}
+}
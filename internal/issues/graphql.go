@@ -0,0 +1,157 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package issues
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shurcooL/githubv4"
+	"golang.org/x/vulndb/internal/derrors"
+)
+
+// The number of issues to request in a single GraphQL query.
+// This keeps queries well within GitHub's node limits.
+const issueBatchSize = 100
+
+// A gqlIssue represents a GitHub issue structured for GitHub's
+// GraphQL schema.
+type gqlIssue struct {
+	Number    int
+	Title     string
+	Body      string
+	State     githubv4.IssueState
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	Assignees struct {
+		Nodes []struct{ Login string }
+	} `graphql:"assignees(first: 1)"`
+	Labels struct {
+		Nodes []struct{ Name string }
+	} `graphql:"labels(first: 100)"`
+	// The most recent comments. Those of issues with more
+	// comments are left for Comments to fetch.
+	Comments struct {
+		TotalCount int
+		Nodes      []struct {
+			// Comment IDs no longer fit in the 32-bit databaseId.
+			FullDatabaseID string `graphql:"fullDatabaseId"`
+			Body           string
+		}
+	} `graphql:"comments(last: 50)"`
+}
+
+func (gi *gqlIssue) issue() *Issue {
+	iss := &Issue{
+		Number:    gi.Number,
+		Title:     gi.Title,
+		Body:      gi.Body,
+		State:     strings.ToLower(string(gi.State)),
+		CreatedAt: gi.CreatedAt,
+		UpdatedAt: gi.UpdatedAt,
+	}
+	if len(gi.Assignees.Nodes) > 0 {
+		iss.Assignee = gi.Assignees.Nodes[0].Login
+	}
+	if len(gi.Labels.Nodes) > 0 {
+		iss.Labels = make([]string, len(gi.Labels.Nodes))
+		for i, l := range gi.Labels.Nodes {
+			iss.Labels[i] = l.Name
+		}
+	}
+	if gi.Comments.TotalCount <= len(gi.Comments.Nodes) {
+		comments := make([]*Comment, 0, len(gi.Comments.Nodes))
+		for _, c := range gi.Comments.Nodes {
+			id, err := strconv.ParseInt(c.FullDatabaseID, 10, 64)
+			if err != nil {
+				return iss
+			}
+			comments = append(comments, &Comment{ID: id, Body: c.Body})
+		}
+		iss.Comments = comments
+	}
+	return iss
+}
+
+// IssuesByNumber returns the issues with the given numbers, keyed
+// by number, along with their labels and comments. It fetches up to
+// 100 issues per request, instead of making one request per issue
+// like Issue (and another for its comments, like Comments). Issues
+// with many comments are returned without them.
+//
+// Numbers that do not refer to an issue (for example, those of pull
+// requests) are omitted from the result without error.
+func (c *Client) IssuesByNumber(ctx context.Context, numbers []int) (_ map[int]*Issue, err error) {
+	defer derrors.Wrap(&err, "IssuesByNumber(%d issues)", len(numbers))
+
+	numbers = slices.Clone(numbers)
+	slices.Sort(numbers)
+	numbers = slices.Compact(numbers)
+
+	result := make(map[int]*Issue)
+	for len(numbers) > 0 {
+		batch := numbers[:min(len(numbers), issueBatchSize)]
+		numbers = numbers[len(batch):]
+		if err := c.issueBatch(ctx, batch, result); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// issueBatch fetches the issues with the given numbers in a single
+// query, adding them to result.
+func (c *Client) issueBatch(ctx context.Context, numbers []int, result map[int]*Issue) error {
+	// GraphQL can only request several issues at a time by giving
+	// each one an alias, so build the query struct dynamically:
+	//
+	//	struct {
+	//		Repository struct {
+	//			I1 *gqlIssue `graphql:"i1: issue(number: 1)"`
+	//			...
+	//		} `graphql:"repository(owner: $owner, name: $name)"`
+	//	}
+	var fields []reflect.StructField
+	for _, n := range numbers {
+		fields = append(fields, reflect.StructField{
+			Name: fmt.Sprintf("I%d", n),
+			Type: reflect.TypeOf((*gqlIssue)(nil)),
+			Tag:  reflect.StructTag(fmt.Sprintf(`graphql:"i%d: issue(number: %d)"`, n, n)),
+		})
+	}
+	queryType := reflect.StructOf([]reflect.StructField{{
+		Name: "Repository",
+		Type: reflect.StructOf(fields),
+		Tag:  `graphql:"repository(owner: $owner, name: $name)"`,
+	}})
+	query := reflect.New(queryType)
+	vars := map[string]any{
+		"owner": githubv4.String(c.Owner),
+		"name":  githubv4.String(c.Repo),
+	}
+	// GitHub reports numbers that are not issues as errors, but still
+	// returns the rest of the data.
+	if err := c.gql.Query(ctx, query.Interface(), vars); err != nil && !isNotIssueError(err) {
+		return err
+	}
+	repo := query.Elem().Field(0)
+	for i := range numbers {
+		if gi := repo.Field(i).Interface().(*gqlIssue); gi != nil {
+			result[gi.Number] = gi.issue()
+		}
+	}
+	return nil
+}
+
+// isNotIssueError reports whether err is GitHub's GraphQL error
+// for an issue number that doesn't exist.
+func isNotIssueError(err error) bool {
+	return strings.Contains(err.Error(), "Could not resolve to an Issue")
+}
@@ -14,6 +14,7 @@ import (
 	"time"
 
 	"github.com/google/go-github/v41/github"
+	"github.com/shurcooL/githubv4"
 	"golang.org/x/oauth2"
 	"golang.org/x/vulndb/internal/derrors"
 )
@@ -27,6 +28,17 @@ type Issue struct {
 	Assignee  string
 	Labels    []string
 	CreatedAt time.Time
+	UpdatedAt time.Time
+	// Comments are the comments on the issue, oldest first, if they
+	// were fetched along with it (see IssuesByNumber), and nil if
+	// they were not.
+	Comments []*Comment
+}
+
+// A Comment is a comment on an issue.
+type Comment struct {
+	ID   int64
+	Body string
 }
 
 // IssuesOptions are options for Issues
@@ -37,6 +49,10 @@ type IssuesOptions struct {
 
 	// Labels filters issues based on their label.
 	Labels []string
+
+	// Since, if non-zero, filters out issues last updated
+	// before the given time.
+	Since time.Time
 }
 
 // Client is a shallow client for a github.Client.
@@ -44,6 +60,10 @@ type Client struct {
 	GitHub *github.Client
 	Owner  string
 	Repo   string
+
+	// gql is a client for the GitHub GraphQL API, used to
+	// batch requests that would take one REST call per issue.
+	gql *githubv4.Client
 }
 
 // Config is used to initialize a new Client.
@@ -71,6 +91,7 @@ func NewClient(ctx context.Context, cfg *Config) *Client {
 		GitHub: c,
 		Owner:  cfg.Owner,
 		Repo:   cfg.Repo,
+		gql:    githubv4.NewClient(tc),
 	}
 }
 
@@ -79,6 +100,7 @@ func NewTestClient(ctx context.Context, cfg *Config, baseURL *url.URL) *Client {
 	c := NewClient(ctx, cfg)
 	c.GitHub.BaseURL = baseURL
 	c.GitHub.UploadURL = baseURL
+	c.gql = githubv4.NewEnterpriseClient(baseURL.String()+"graphql", c.GitHub.Client())
 	return c
 }
 
@@ -125,6 +147,9 @@ func convertGithubIssueToIssue(ghIss *github.Issue) *Issue {
 	if ghIss.CreatedAt != nil {
 		iss.CreatedAt = *ghIss.CreatedAt
 	}
+	if ghIss.UpdatedAt != nil {
+		iss.UpdatedAt = *ghIss.UpdatedAt
+	}
 	if ghIss.State != nil {
 		iss.State = *ghIss.State
 	}
@@ -158,6 +183,7 @@ func (c *Client) Issues(ctx context.Context, opts IssuesOptions) (_ []*Issue, er
 	clientOpts := &github.IssueListByRepoOptions{
 		State:  opts.State,
 		Labels: opts.Labels,
+		Since:  opts.Since,
 		ListOptions: github.ListOptions{
 			PerPage: 100,
 		},
@@ -197,6 +223,9 @@ func (c *Client) CreateIssue(ctx context.Context, iss *Issue) (number int, err e
 	if len(iss.Labels) > 0 {
 		req.Labels = &iss.Labels
 	}
+	if iss.Assignee != "" {
+		req.Assignee = &iss.Assignee
+	}
 	giss, _, err := c.GitHub.Issues.Create(ctx, c.Owner, c.Repo, req)
 	if err != nil {
 		return 0, err
@@ -217,6 +246,29 @@ func (c *Client) SetLabels(ctx context.Context, issNum int, labels []string) (er
 	return nil
 }
 
+// SetBody replaces the body of the issue.
+func (c *Client) SetBody(ctx context.Context, issNum int, body string) (err error) {
+	defer derrors.Wrap(&err, "SetBody(%d)", issNum)
+
+	req := &github.IssueRequest{
+		Body: &body,
+	}
+	_, _, err = c.GitHub.Issues.Edit(ctx, c.Owner, c.Repo, issNum, req)
+	return err
+}
+
+// SetAssignee assigns the issue to the GitHub user with the
+// given login.
+func (c *Client) SetAssignee(ctx context.Context, issNum int, assignee string) (err error) {
+	defer derrors.Wrap(&err, "SetAssignee(%d, %s)", issNum, assignee)
+
+	req := &github.IssueRequest{
+		Assignee: &assignee,
+	}
+	_, _, err = c.GitHub.Issues.Edit(ctx, c.Owner, c.Repo, issNum, req)
+	return err
+}
+
 func (c *Client) AddComments(ctx context.Context, issNum int, comments []string) (err error) {
 	defer derrors.Wrap(&err, "AddComments(%d, %s)", issNum, comments)
 
@@ -232,6 +284,46 @@ func (c *Client) AddComments(ctx context.Context, issNum int, comments []string)
 	return nil
 }
 
+// Comments returns the comments on the issue, oldest first.
+func (c *Client) Comments(ctx context.Context, issNum int) (_ []*Comment, err error) {
+	defer derrors.Wrap(&err, "Comments(%d)", issNum)
+
+	opts := &github.IssueListCommentsOptions{
+		ListOptions: github.ListOptions{
+			PerPage: 100,
+		},
+	}
+	var comments []*Comment
+	page := 1
+	for {
+		opts.ListOptions.Page = page
+		gcs, resp, err := c.GitHub.Issues.ListComments(ctx, c.Owner, c.Repo, issNum, opts)
+		if err != nil {
+			return nil, err
+		}
+		for _, gc := range gcs {
+			comments = append(comments, &Comment{ID: gc.GetID(), Body: gc.GetBody()})
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		page = resp.NextPage
+	}
+	return comments, nil
+}
+
+// EditComment replaces the body of the comment with the given ID
+// on the issue.
+func (c *Client) EditComment(ctx context.Context, issNum int, id int64, body string) (err error) {
+	defer derrors.Wrap(&err, "EditComment(%d, %d)", issNum, id)
+
+	req := &github.IssueComment{
+		Body: &body,
+	}
+	_, _, err = c.GitHub.Issues.EditComment(ctx, c.Owner, c.Repo, id, req)
+	return err
+}
+
 // NewGoID creates a Go advisory ID based on the issue number
 // and time of issue creation.
 func (iss *Issue) NewGoID() string {
@@ -6,11 +6,13 @@ package issues_test
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
@@ -115,6 +117,59 @@ func TestIssues(t *testing.T) {
 	}
 }
 
+func TestIssuesSince(t *testing.T) {
+	c, mux := githubtest.Setup(context.Background(), t, testConfig)
+	since := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	mux.HandleFunc(fmt.Sprintf("/repos/%s/%s/issues", githubtest.TestOwner, githubtest.TestRepo), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if got, want := r.URL.Query().Get("since"), since.Format(time.RFC3339); got != want {
+			t.Errorf("since query param = %q, want %q", got, want)
+		}
+		fmt.Fprintf(w, `[{"number":1, "updated_at":%q}]`, since.Format(time.RFC3339))
+	})
+	got, err := c.Issues(context.Background(), issues.IssuesOptions{State: "open", Since: since})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*issues.Issue{{Number: 1, UpdatedAt: since}}
+	if diff := diffIssues(want, got); diff != "" {
+		t.Errorf("mismatch (-want, +got):\n%s", diff)
+	}
+}
+
+func TestComments(t *testing.T) {
+	c, mux := githubtest.Setup(context.Background(), t, testConfig)
+	mux.HandleFunc(fmt.Sprintf("/repos/%s/%s/issues/7/comments", githubtest.TestOwner, githubtest.TestRepo), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id":1, "body":"first"},{"id":2, "body":"second"}]`)
+	})
+	var edited string
+	mux.HandleFunc(fmt.Sprintf("/repos/%s/%s/issues/comments/2", githubtest.TestOwner, githubtest.TestRepo), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		var req struct{ Body string }
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatal(err)
+		}
+		edited = req.Body
+		fmt.Fprint(w, `{}`)
+	})
+	ctx := context.Background()
+	got, err := c.Comments(ctx, 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*issues.Comment{{ID: 1, Body: "first"}, {ID: 2, Body: "second"}}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("mismatch (-want, +got):\n%s", diff)
+	}
+	if err := c.EditComment(ctx, 7, 2, "edited"); err != nil {
+		t.Fatal(err)
+	}
+	if edited != "edited" {
+		t.Errorf("edited comment body = %q, want %q", edited, "edited")
+	}
+}
+
 func testMethod(t *testing.T, r *http.Request, want string) {
 	t.Helper()
 	if got := r.Method; got != want {
@@ -127,3 +182,58 @@ func diffIssues(want, got []*issues.Issue) string {
 	return cmp.Diff(want, got, cmpopts.SortSlices(byTitle),
 		cmpopts.IgnoreFields(issues.Issue{}, "CreatedAt"))
 }
+
+func TestIssuesByNumber(t *testing.T) {
+	c, mux := githubtest.Setup(context.Background(), t, testConfig)
+	var gotQueries []string
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		var req struct {
+			Query     string
+			Variables map[string]string
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatal(err)
+		}
+		gotQueries = append(gotQueries, req.Query)
+		if got, want := req.Variables["owner"], githubtest.TestOwner; got != want {
+			t.Errorf("owner = %q, want %q", got, want)
+		}
+		// Issue 3 is a pull request, and issue 4 has more comments
+		// than were fetched.
+		fmt.Fprint(w, `{"data": {"repository": {
+			"i1": {"number": 1, "title": "one", "state": "OPEN",
+				"assignees": {"nodes": [{"login": "alice"}]},
+				"labels": {"nodes": [{"name": "a"}, {"name": "b"}]},
+				"comments": {"totalCount": 1, "nodes": [{"fullDatabaseId": "3000000001", "body": "hello"}]}},
+			"i2": {"number": 2, "title": "two", "state": "CLOSED",
+				"assignees": {"nodes": []}, "labels": {"nodes": []},
+				"comments": {"totalCount": 0, "nodes": []}},
+			"i3": null,
+			"i4": {"number": 4, "title": "four", "state": "OPEN",
+				"assignees": {"nodes": []}, "labels": {"nodes": []},
+				"comments": {"totalCount": 51, "nodes": [{"fullDatabaseId": "3000000002", "body": "latest"}]}}}},
+			"errors": [{"message": "Could not resolve to an Issue with the number of 3."}]}`)
+	})
+	got, err := c.IssuesByNumber(context.Background(), []int{2, 1, 3, 1, 4})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[int]*issues.Issue{
+		1: {Number: 1, Title: "one", State: "open", Assignee: "alice", Labels: []string{"a", "b"},
+			Comments: []*issues.Comment{{ID: 3000000001, Body: "hello"}}},
+		2: {Number: 2, Title: "two", State: "closed", Comments: []*issues.Comment{}},
+		4: {Number: 4, Title: "four", State: "open"},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("mismatch (-want, +got):\n%s", diff)
+	}
+	if len(gotQueries) != 1 {
+		t.Fatalf("got %d queries, want 1", len(gotQueries))
+	}
+	for _, alias := range []string{"i1: issue(number: 1)", "i2: issue(number: 2)", "i3: issue(number: 3)", "comments(last: 50)"} {
+		if !strings.Contains(gotQueries[0], alias) {
+			t.Errorf("query %q does not contain %q", gotQueries[0], alias)
+		}
+	}
+}
@@ -0,0 +1,170 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package issues
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Meta is the structured data about a vulnerability that is embedded in
+// the body of the tracker issue filed for it, so that tools reading the
+// issue (like vulnreport triage and create) do not need to parse the
+// free-form parts of the body or the title, which only name one module.
+//
+// It is written as an HTML comment, which GitHub does not display:
+//
+//	<!-- vulndb-meta
+//	modules: example.com/a example.com/b
+//	aliases: CVE-2024-1234 GHSA-xxxx-yyyy-zzzz
+//	references: https://example.com/advisory
+//	-->
+//
+// If the section was refreshed because the advisory changed after the
+// issue was filed, it also records when and what changed, and is
+// followed by a visible copy of the current data, ending with
+// "<!-- /vulndb-meta -->".
+type Meta struct {
+	// Modules are the paths of the Go modules that might be affected,
+	// most likely first.
+	Modules []string
+	// Aliases are the IDs of the vulnerability, such as CVEs and GHSAs.
+	Aliases []string
+	// References are the URLs of the references of the advisory.
+	References []string
+
+	// Updated is the date (YYYY-MM-DD) the section was last refreshed,
+	// or empty if it has not been, and Changed are the fields
+	// ("modules", "aliases" or "references") that changed then.
+	Updated string
+	Changed []string
+}
+
+const (
+	metaStart    = "<!-- vulndb-meta"
+	metaEnd      = "-->"
+	metaBlockEnd = "<!-- /vulndb-meta -->"
+
+	metaModules    = "modules:"
+	metaAliases    = "aliases:"
+	metaReferences = "references:"
+	metaUpdated    = "updated:"
+)
+
+// Section returns m in the form embedded in issue bodies.
+func (m *Meta) Section() string {
+	var b strings.Builder
+	fmt.Fprintln(&b, metaStart)
+	fmt.Fprintln(&b, metaModules, strings.Join(m.Modules, " "))
+	fmt.Fprintln(&b, metaAliases, strings.Join(m.Aliases, " "))
+	fmt.Fprintln(&b, metaReferences, strings.Join(m.References, " "))
+	if m.Updated != "" {
+		fmt.Fprintln(&b, metaUpdated, strings.Join(append([]string{m.Updated}, m.Changed...), " "))
+	}
+	fmt.Fprint(&b, metaEnd)
+	if m.Updated != "" {
+		fmt.Fprintf(&b, "\n**Updated on %s:** the advisory changed since this issue was filed (%s). Current data:\n",
+			m.Updated, strings.Join(m.Changed, ", "))
+		fmt.Fprintf(&b, "- modules: %s\n", strings.Join(m.Modules, ", "))
+		fmt.Fprintf(&b, "- aliases: %s\n", strings.Join(m.Aliases, ", "))
+		fmt.Fprintln(&b, "- references:")
+		for _, r := range m.References {
+			fmt.Fprintf(&b, "  - %s\n", r)
+		}
+		fmt.Fprint(&b, metaBlockEnd)
+	}
+	return b.String()
+}
+
+// ReplaceMeta returns body with its first structured section (including
+// the visible copy of a refreshed section) replaced by m. It reports
+// false if body has no section.
+func ReplaceMeta(body string, m *Meta) (string, bool) {
+	start := strings.Index(body, metaStart)
+	if start < 0 {
+		return body, false
+	}
+	n := strings.Index(body[start:], metaEnd)
+	if n < 0 {
+		return body, false
+	}
+	end := start + n + len(metaEnd)
+	// A refreshed section continues up to the block end marker,
+	// unless another section starts first.
+	if i := strings.Index(body[end:], metaBlockEnd); i >= 0 && !strings.Contains(body[end:end+i], metaStart) {
+		end += i + len(metaBlockEnd)
+	}
+	return body[:start] + m.Section() + body[end:], true
+}
+
+// Diff returns the fields ("modules", "aliases" or "references") whose
+// values differ between old and m. References are only compared if
+// old has them, since sections written before they were recorded don't.
+func (m *Meta) Diff(old *Meta) []string {
+	var changed []string
+	if !slices.Equal(old.Modules, m.Modules) {
+		changed = append(changed, "modules")
+	}
+	if !slices.Equal(old.Aliases, m.Aliases) {
+		changed = append(changed, "aliases")
+	}
+	if len(old.References) > 0 && !slices.Equal(old.References, m.References) {
+		changed = append(changed, "references")
+	}
+	return changed
+}
+
+// ParseMeta returns the structured data embedded in an issue body, or
+// nil if there is none. If the body has more than one section (as
+// issues about several advisories do), their modules and aliases are
+// combined, in order, without duplicates.
+func ParseMeta(body string) *Meta {
+	var m *Meta
+	for {
+		_, rest, ok := strings.Cut(body, metaStart)
+		if !ok {
+			return m
+		}
+		section, after, ok := strings.Cut(rest, metaEnd)
+		if !ok {
+			return m
+		}
+		body = after
+		if m == nil {
+			m = &Meta{}
+		}
+		for _, line := range strings.Split(section, "\n") {
+			line = strings.TrimSpace(line)
+			if v, ok := strings.CutPrefix(line, metaModules); ok {
+				m.Modules = appendNew(m.Modules, strings.Fields(v)...)
+			} else if v, ok := strings.CutPrefix(line, metaAliases); ok {
+				m.Aliases = appendNew(m.Aliases, strings.Fields(v)...)
+			} else if v, ok := strings.CutPrefix(line, metaReferences); ok {
+				m.References = appendNew(m.References, strings.Fields(v)...)
+			} else if v, ok := strings.CutPrefix(line, metaUpdated); ok {
+				if fs := strings.Fields(v); len(fs) > 0 {
+					m.Updated, m.Changed = fs[0], fs[1:]
+				}
+			}
+		}
+	}
+}
+
+// Meta returns the structured data embedded in the body of iss,
+// or nil if there is none.
+func (iss *Issue) Meta() *Meta {
+	return ParseMeta(iss.Body)
+}
+
+// appendNew appends the elements of vs that are not in s to s.
+func appendNew(s []string, vs ...string) []string {
+	for _, v := range vs {
+		if !slices.Contains(s, v) {
+			s = append(s, v)
+		}
+	}
+	return s
+}
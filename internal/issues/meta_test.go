@@ -0,0 +1,126 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package issues
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestMeta(t *testing.T) {
+	m := &Meta{
+		Modules:    []string{"example.com/a", "example.com/b"},
+		Aliases:    []string{"CVE-2024-1234", "GHSA-xxxx-yyyy-zzzz"},
+		References: []string{"https://example.com/advisory"},
+	}
+	want := `<!-- vulndb-meta
+modules: example.com/a example.com/b
+aliases: CVE-2024-1234 GHSA-xxxx-yyyy-zzzz
+references: https://example.com/advisory
+-->`
+	if got := m.Section(); got != want {
+		t.Errorf("Section() = %q, want %q", got, want)
+	}
+	if diff := cmp.Diff(m, ParseMeta("Some text.\n\n"+m.Section()+"\nMore text.")); diff != "" {
+		t.Errorf("ParseMeta(Section()) mismatch (-want, +got):\n%s", diff)
+	}
+}
+
+func TestParseMeta(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		body string
+		want *Meta
+	}{
+		{
+			name: "none",
+			body: "Advisory CVE-2024-1234 references a vulnerability.",
+		},
+		{
+			name: "unterminated",
+			body: "<!-- vulndb-meta\nmodules: example.com/a",
+		},
+		{
+			name: "empty",
+			body: "<!-- vulndb-meta\nmodules:\naliases:\n-->",
+			want: &Meta{},
+		},
+		{
+			name: "several sections",
+			body: "<!-- vulndb-meta\nmodules: example.com/a\naliases: GHSA-xxxx-yyyy-zzzz CVE-2024-1234\n-->" +
+				"\n\n----------\n\n" +
+				"<!-- vulndb-meta\nmodules: example.com/b example.com/a\naliases: GHSA-aaaa-bbbb-cccc CVE-2024-1234\n-->",
+			want: &Meta{
+				Modules: []string{"example.com/a", "example.com/b"},
+				Aliases: []string{"GHSA-xxxx-yyyy-zzzz", "CVE-2024-1234", "GHSA-aaaa-bbbb-cccc"},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, ParseMeta(tc.body)); diff != "" {
+				t.Errorf("ParseMeta() mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestReplaceMeta(t *testing.T) {
+	old := &Meta{
+		Modules:    []string{"example.com/a"},
+		Aliases:    []string{"CVE-2024-1234"},
+		References: []string{"https://example.com/advisory"},
+	}
+	body := "Advisory CVE-2024-1234.\n\n" + old.Section() + "\n\nReport."
+
+	refreshed := &Meta{
+		Modules:    []string{"example.com/a", "example.com/b"},
+		Aliases:    []string{"CVE-2024-1234"},
+		References: []string{"https://example.com/advisory", "https://example.com/fix"},
+	}
+	refreshed.Changed = refreshed.Diff(old)
+	refreshed.Updated = "2026-01-02"
+	if diff := cmp.Diff([]string{"modules", "references"}, refreshed.Changed); diff != "" {
+		t.Errorf("Diff() mismatch (-want, +got):\n%s", diff)
+	}
+	got, ok := ReplaceMeta(body, refreshed)
+	if !ok {
+		t.Fatal("ReplaceMeta() = false, want true")
+	}
+	want := `Advisory CVE-2024-1234.
+
+<!-- vulndb-meta
+modules: example.com/a example.com/b
+aliases: CVE-2024-1234
+references: https://example.com/advisory https://example.com/fix
+updated: 2026-01-02 modules references
+-->
+**Updated on 2026-01-02:** the advisory changed since this issue was filed (modules, references). Current data:
+- modules: example.com/a, example.com/b
+- aliases: CVE-2024-1234
+- references:
+  - https://example.com/advisory
+  - https://example.com/fix
+<!-- /vulndb-meta -->
+
+Report.`
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("ReplaceMeta() mismatch (-want, +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(refreshed, ParseMeta(got)); diff != "" {
+		t.Errorf("ParseMeta() mismatch (-want, +got):\n%s", diff)
+	}
+
+	// Refreshing again replaces the visible copy too.
+	again := &Meta{Modules: []string{"example.com/b"}, Updated: "2026-01-03", Changed: []string{"modules"}}
+	got, _ = ReplaceMeta(got, again)
+	if want := "Advisory CVE-2024-1234.\n\n" + again.Section() + "\n\nReport."; got != want {
+		t.Errorf("ReplaceMeta(refreshed) = %q, want %q", got, want)
+	}
+
+	if _, ok := ReplaceMeta("no section", refreshed); ok {
+		t.Error("ReplaceMeta(no section) = true, want false")
+	}
+}
@@ -0,0 +1,44 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package issues
+
+import "context"
+
+// A Tracker is an issue tracker that issues for vulnerabilities are
+// filed in, such as the GitHub issues of github.com/golang/vulndb or
+// the GitLab issues of a private fork.
+//
+// Issues are identified by their number within the tracker's repo
+// (an "iid", in GitLab terms).
+type Tracker interface {
+	// Destination returns the URL of the tracker's repo.
+	Destination() string
+	// Reference returns the URL of the given issue.
+	Reference(num int) string
+
+	Issue(ctx context.Context, num int) (*Issue, error)
+	Issues(ctx context.Context, opts IssuesOptions) ([]*Issue, error)
+	// IssuesByNumber returns the issues with the given numbers,
+	// omitting any that don't exist.
+	IssuesByNumber(ctx context.Context, nums []int) (map[int]*Issue, error)
+
+	CreateIssue(ctx context.Context, iss *Issue) (num int, err error)
+	// SetLabels replaces the labels of the issue.
+	SetLabels(ctx context.Context, num int, labels []string) error
+	// SetBody replaces the body of the issue.
+	SetBody(ctx context.Context, num int, body string) error
+	// SetAssignee assigns the issue to the user with the given username.
+	SetAssignee(ctx context.Context, num int, assignee string) error
+	AddComments(ctx context.Context, num int, comments []string) error
+	// Comments returns the comments on the issue, oldest first.
+	Comments(ctx context.Context, num int) ([]*Comment, error)
+	// EditComment replaces the body of a comment on the issue.
+	EditComment(ctx context.Context, num int, id int64, body string) error
+}
+
+var (
+	_ Tracker = (*Client)(nil)
+	_ Tracker = (*GitLabClient)(nil)
+)
@@ -0,0 +1,127 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package issues
+
+import (
+	"fmt"
+	"strings"
+)
+
+// A TriageNote records the results of triaging an issue with
+// vulnreport triage. It is posted as a comment on the issue (and
+// updated in place when the issue is triaged again), so that people
+// can see why the issue was given its priority, and so that
+// vulnreport create can use the results instead of recomputing them.
+//
+// The comment looks like:
+//
+//	<!-- vulndb-triage -->
+//	Triage results (from `vulnreport triage`):
+//
+//	- module: example.com/a
+//	- priority: high
+//	- reason: example.com/a has 200 importers (>= 100) and ...
+//	- aliases: CVE-2024-1234, GHSA-xxxx-yyyy-zzzz
+//	- possible duplicates: #12, data/reports/GO-2024-0012.yaml
+type TriageNote struct {
+	// Module is the path of the module the issue is most likely about.
+	Module string
+	// Priority is the priority of the issue ("high", "low" or
+	// "unknown"), and Reason is why it was given that priority.
+	Priority, Reason string
+	// Aliases are the known IDs of the vulnerability.
+	Aliases []string
+	// Duplicates are references to issues or reports that
+	// the issue may be a duplicate of.
+	Duplicates []string
+}
+
+const (
+	triageNoteMarker = "<!-- vulndb-triage -->"
+
+	triageModule     = "module"
+	triagePriority   = "priority"
+	triageReason     = "reason"
+	triageAliases    = "aliases"
+	triageDuplicates = "possible duplicates"
+)
+
+// Comment returns n in the form posted as a comment.
+func (n *TriageNote) Comment() string {
+	var b strings.Builder
+	fmt.Fprintln(&b, triageNoteMarker)
+	fmt.Fprintln(&b, "Triage results (from `vulnreport triage`):")
+	fmt.Fprintln(&b)
+	item := func(key, value string) {
+		if value != "" {
+			fmt.Fprintf(&b, "- %s: %s\n", key, value)
+		}
+	}
+	item(triageModule, n.Module)
+	item(triagePriority, n.Priority)
+	item(triageReason, n.Reason)
+	item(triageAliases, strings.Join(n.Aliases, ", "))
+	item(triageDuplicates, strings.Join(n.Duplicates, ", "))
+	return b.String()
+}
+
+// IsTriageNote reports whether the comment body is a triage note.
+func IsTriageNote(body string) bool {
+	return strings.HasPrefix(strings.TrimSpace(body), triageNoteMarker)
+}
+
+// ParseTriageNote parses a comment body written by TriageNote.Comment.
+// It returns nil if the body is not a triage note.
+func ParseTriageNote(body string) *TriageNote {
+	if !IsTriageNote(body) {
+		return nil
+	}
+	n := &TriageNote{}
+	for _, line := range strings.Split(body, "\n") {
+		item, ok := strings.CutPrefix(strings.TrimSpace(line), "- ")
+		if !ok {
+			continue
+		}
+		key, value, ok := strings.Cut(item, ": ")
+		if !ok {
+			continue
+		}
+		value = strings.TrimSpace(value)
+		switch key {
+		case triageModule:
+			n.Module = value
+		case triagePriority:
+			n.Priority = value
+		case triageReason:
+			n.Reason = value
+		case triageAliases:
+			n.Aliases = splitList(value)
+		case triageDuplicates:
+			n.Duplicates = splitList(value)
+		}
+	}
+	return n
+}
+
+// LatestTriageNote returns the most recent triage note in the
+// comments, and the comment it is in, or nil if there is none.
+func LatestTriageNote(comments []*Comment) (*TriageNote, *Comment) {
+	for i := len(comments) - 1; i >= 0; i-- {
+		if n := ParseTriageNote(comments[i].Body); n != nil {
+			return n, comments[i]
+		}
+	}
+	return nil, nil
+}
+
+func splitList(s string) []string {
+	var vs []string
+	for _, v := range strings.Split(s, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			vs = append(vs, v)
+		}
+	}
+	return vs
+}
@@ -0,0 +1,66 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package issues
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestTriageNote(t *testing.T) {
+	n := &TriageNote{
+		Module:     "example.com/a",
+		Priority:   "high",
+		Reason:     "example.com/a has 200 importers (>= 100); example.com/b has 3 importers (< 100)",
+		Aliases:    []string{"CVE-2024-1234", "GHSA-xxxx-yyyy-zzzz"},
+		Duplicates: []string{"#12", "data/reports/GO-2024-0012.yaml"},
+	}
+	want := "<!-- vulndb-triage -->\n" +
+		"Triage results (from `vulnreport triage`):\n" +
+		"\n" +
+		"- module: example.com/a\n" +
+		"- priority: high\n" +
+		"- reason: example.com/a has 200 importers (>= 100); example.com/b has 3 importers (< 100)\n" +
+		"- aliases: CVE-2024-1234, GHSA-xxxx-yyyy-zzzz\n" +
+		"- possible duplicates: #12, data/reports/GO-2024-0012.yaml\n"
+	got := n.Comment()
+	if got != want {
+		t.Errorf("Comment() = %q, want %q", got, want)
+	}
+	if diff := cmp.Diff(n, ParseTriageNote(got)); diff != "" {
+		t.Errorf("ParseTriageNote(Comment()) mismatch (-want, +got):\n%s", diff)
+	}
+
+	// Empty fields are omitted.
+	n = &TriageNote{Module: "example.com/a", Priority: "unknown"}
+	if diff := cmp.Diff(n, ParseTriageNote(n.Comment())); diff != "" {
+		t.Errorf("ParseTriageNote(Comment()) mismatch (-want, +got):\n%s", diff)
+	}
+
+	if got := ParseTriageNote("- module: example.com/a"); got != nil {
+		t.Errorf("ParseTriageNote(not a note) = %v, want nil", got)
+	}
+}
+
+func TestLatestTriageNote(t *testing.T) {
+	old := &TriageNote{Module: "example.com/old", Priority: "low"}
+	latest := &TriageNote{Module: "example.com/new", Priority: "high"}
+	comments := []*Comment{
+		{ID: 1, Body: old.Comment()},
+		{ID: 2, Body: latest.Comment()},
+		{ID: 3, Body: "Duplicate of #12"},
+	}
+	n, c := LatestTriageNote(comments)
+	if diff := cmp.Diff(latest, n); diff != "" {
+		t.Errorf("note mismatch (-want, +got):\n%s", diff)
+	}
+	if c == nil || c.ID != 2 {
+		t.Errorf("comment = %v, want comment 2", c)
+	}
+	if n, c := LatestTriageNote(comments[2:]); n != nil || c != nil {
+		t.Errorf("LatestTriageNote(no notes) = %v, %v, want nil, nil", n, c)
+	}
+}
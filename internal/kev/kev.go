@@ -0,0 +1,127 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package kev supports the Known Exploited Vulnerabilities (KEV)
+// catalog of the Cybersecurity and Infrastructure Security Agency
+// (CISA).
+//
+// See https://www.cisa.gov/known-exploited-vulnerabilities-catalog.
+package kev
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+
+	"golang.org/x/vulndb/internal/derrors"
+)
+
+// URL is the location of the KEV catalog, as JSON.
+const URL = "https://www.cisa.gov/sites/default/files/feeds/known_exploited_vulnerabilities.json"
+
+// A Catalog is the KEV catalog.
+type Catalog struct {
+	Title          string `json:"title"`
+	CatalogVersion string `json:"catalogVersion"`
+	DateReleased   string `json:"dateReleased"`
+	Count          int    `json:"count"`
+
+	Vulnerabilities []*Vulnerability `json:"vulnerabilities"`
+}
+
+// A Vulnerability is an entry of the KEV catalog.
+// Only the fields used by this repo are included.
+type Vulnerability struct {
+	CVEID             string `json:"cveID"`
+	VendorProject     string `json:"vendorProject"`
+	Product           string `json:"product"`
+	VulnerabilityName string `json:"vulnerabilityName"`
+	// DateAdded is the date (YYYY-MM-DD) the vulnerability
+	// was added to the catalog.
+	DateAdded        string `json:"dateAdded"`
+	ShortDescription string `json:"shortDescription"`
+	// KnownRansomwareCampaignUse is "Known" or "Unknown".
+	KnownRansomwareCampaignUse string `json:"knownRansomwareCampaignUse"`
+}
+
+// ByCVE returns the vulnerabilities of the catalog by CVE ID.
+func (c *Catalog) ByCVE() map[string]*Vulnerability {
+	m := make(map[string]*Vulnerability, len(c.Vulnerabilities))
+	for _, v := range c.Vulnerabilities {
+		m[v.CVEID] = v
+	}
+	return m
+}
+
+// CVEs returns the set of CVE IDs in the catalog.
+func (c *Catalog) CVEs() map[string]bool {
+	m := make(map[string]bool, len(c.Vulnerabilities))
+	for _, v := range c.Vulnerabilities {
+		m[v.CVEID] = true
+	}
+	return m
+}
+
+// A Client is a client for the KEV catalog.
+type Client struct {
+	url        string
+	httpClient *http.Client
+}
+
+// NewClient returns a client that downloads the catalog from URL.
+func NewClient() *Client {
+	return newClient(URL)
+}
+
+func newClient(url string) *Client {
+	return &Client{
+		url:        url,
+		httpClient: http.DefaultClient,
+	}
+}
+
+// Fetch downloads the catalog.
+func (c *Client) Fetch(ctx context.Context) (_ *Catalog, err error) {
+	defer derrors.Wrap(&err, "kev.Fetch")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("GET %s: HTTP error: %s", c.url, resp.Status)
+	}
+	var cat Catalog
+	if err := json.NewDecoder(resp.Body).Decode(&cat); err != nil {
+		return nil, fmt.Errorf("GET %s: decoding response: %w", c.url, err)
+	}
+	return &cat, nil
+}
+
+// Read reads the catalog from src, which is either
+// an http(s) URL or a file.
+func Read(ctx context.Context, src string) (_ *Catalog, err error) {
+	if strings.HasPrefix(src, "https://") || strings.HasPrefix(src, "http://") {
+		return newClient(src).Fetch(ctx)
+	}
+
+	defer derrors.Wrap(&err, "kev.Read(%s)", src)
+	b, err := os.ReadFile(src)
+	if err != nil {
+		return nil, err
+	}
+	var cat Catalog
+	if err := json.Unmarshal(b, &cat); err != nil {
+		return nil, err
+	}
+	return &cat, nil
+}
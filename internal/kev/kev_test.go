@@ -0,0 +1,117 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package kev
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+const testCatalog = `{
+	"title": "CISA Catalog of Known Exploited Vulnerabilities",
+	"catalogVersion": "2024.03.01",
+	"dateReleased": "2024-03-01T15:00:00.000Z",
+	"count": 2,
+	"vulnerabilities": [
+		{
+			"cveID": "CVE-2023-44487",
+			"vendorProject": "IETF",
+			"product": "HTTP/2",
+			"vulnerabilityName": "HTTP/2 Rapid Reset Attack Vulnerability",
+			"dateAdded": "2023-10-10",
+			"shortDescription": "HTTP/2 contains a rapid reset vulnerability.",
+			"requiredAction": "Apply mitigations per vendor instructions.",
+			"dueDate": "2023-10-31",
+			"knownRansomwareCampaignUse": "Unknown",
+			"notes": ""
+		},
+		{
+			"cveID": "CVE-2024-0001",
+			"vendorProject": "Example",
+			"product": "Server",
+			"vulnerabilityName": "Example Server Vulnerability",
+			"dateAdded": "2024-03-01",
+			"shortDescription": "Example Server has a vulnerability.",
+			"knownRansomwareCampaignUse": "Known"
+		}
+	]
+}`
+
+var wantCatalog = &Catalog{
+	Title:          "CISA Catalog of Known Exploited Vulnerabilities",
+	CatalogVersion: "2024.03.01",
+	DateReleased:   "2024-03-01T15:00:00.000Z",
+	Count:          2,
+	Vulnerabilities: []*Vulnerability{
+		{
+			CVEID:                      "CVE-2023-44487",
+			VendorProject:              "IETF",
+			Product:                    "HTTP/2",
+			VulnerabilityName:          "HTTP/2 Rapid Reset Attack Vulnerability",
+			DateAdded:                  "2023-10-10",
+			ShortDescription:           "HTTP/2 contains a rapid reset vulnerability.",
+			KnownRansomwareCampaignUse: "Unknown",
+		},
+		{
+			CVEID:                      "CVE-2024-0001",
+			VendorProject:              "Example",
+			Product:                    "Server",
+			VulnerabilityName:          "Example Server Vulnerability",
+			DateAdded:                  "2024-03-01",
+			ShortDescription:           "Example Server has a vulnerability.",
+			KnownRansomwareCampaignUse: "Known",
+		},
+	},
+}
+
+func TestFetch(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(testCatalog))
+	}))
+	defer s.Close()
+
+	got, err := newClient(s.URL).Fetch(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(wantCatalog, got); diff != "" {
+		t.Errorf("Fetch() mismatch (-want, +got):\n%s", diff)
+	}
+	wantCVEs := map[string]bool{"CVE-2023-44487": true, "CVE-2024-0001": true}
+	if diff := cmp.Diff(wantCVEs, got.CVEs()); diff != "" {
+		t.Errorf("CVEs() mismatch (-want, +got):\n%s", diff)
+	}
+}
+
+func TestFetchError(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+	}))
+	defer s.Close()
+
+	if _, err := newClient(s.URL).Fetch(context.Background()); err == nil {
+		t.Error("Fetch() succeeded, want error")
+	}
+}
+
+func TestRead(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "kev.json")
+	if err := os.WriteFile(filename, []byte(testCatalog), 0644); err != nil {
+		t.Fatal(err)
+	}
+	got, err := Read(context.Background(), filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(wantCatalog, got); diff != "" {
+		t.Errorf("Read() mismatch (-want, +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,315 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package nvd supports the National Vulnerability Database (NVD)
+// CVE API, version 2.0.
+//
+// See https://nvd.nist.gov/developers/vulnerabilities.
+package nvd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/time/rate"
+	"golang.org/x/vulndb/internal/derrors"
+)
+
+// URL is the endpoint of the NVD CVE API.
+const URL = "https://services.nvd.nist.gov/rest/json/cves/2.0"
+
+const (
+	// The NVD allows 5 requests in a rolling 30 second window
+	// without an API key, and 50 with one.
+	rateWindow      = 30 * time.Second
+	requestsNoKey   = 5
+	requestsWithKey = 50
+
+	// The largest page and date range the API accepts.
+	resultsPerPage = 2000
+	maxDateRange   = 120 * 24 * time.Hour
+
+	// The format of timestamps in requests and responses.
+	timeFormat = "2006-01-02T15:04:05.000"
+)
+
+// A Client is a client for the NVD CVE API.
+type Client struct {
+	url        string
+	apiKey     string
+	httpClient *http.Client
+	limiter    *rate.Limiter
+}
+
+// NewClient returns a client for the NVD API.
+// The API key is optional, but without one requests are
+// throttled much more heavily.
+func NewClient(apiKey string) *Client {
+	return newClient(URL, apiKey)
+}
+
+func newClient(url, apiKey string) *Client {
+	n := requestsNoKey
+	if apiKey != "" {
+		n = requestsWithKey
+	}
+	return &Client{
+		url:        url,
+		apiKey:     apiKey,
+		httpClient: http.DefaultClient,
+		// A burst of 1 keeps us within the rolling window.
+		limiter: rate.NewLimiter(rate.Every(rateWindow/time.Duration(n)), 1),
+	}
+}
+
+// A CVE is a CVE record as represented by the NVD.
+// Only the fields used by this repo are included.
+type CVE struct {
+	ID             string          `json:"id"`
+	Published      string          `json:"published"`
+	LastModified   string          `json:"lastModified"`
+	VulnStatus     string          `json:"vulnStatus"`
+	Descriptions   []Description   `json:"descriptions"`
+	References     []Reference     `json:"references"`
+	Configurations []Configuration `json:"configurations"`
+}
+
+// A Description is a description of a CVE in a given language.
+type Description struct {
+	Lang  string `json:"lang"`
+	Value string `json:"value"`
+}
+
+// A Reference is a link from a CVE.
+type Reference struct {
+	URL  string   `json:"url"`
+	Tags []string `json:"tags,omitempty"`
+}
+
+// A Configuration describes the products affected by a CVE.
+type Configuration struct {
+	Nodes []Node `json:"nodes"`
+}
+
+// A Node is a set of CPE matches.
+type Node struct {
+	Operator string     `json:"operator"`
+	Negate   bool       `json:"negate"`
+	CPEMatch []CPEMatch `json:"cpeMatch"`
+}
+
+// A CPEMatch is a CPE pattern, optionally restricted to a version range.
+type CPEMatch struct {
+	Vulnerable            bool   `json:"vulnerable"`
+	Criteria              string `json:"criteria"`
+	VersionStartIncluding string `json:"versionStartIncluding,omitempty"`
+	VersionEndExcluding   string `json:"versionEndExcluding,omitempty"`
+}
+
+// LastModifiedTime returns the time the NVD last modified the CVE.
+func (c *CVE) LastModifiedTime() (time.Time, error) {
+	// The NVD's timestamps have no time zone, and are in UTC.
+	return time.Parse(timeFormat, c.LastModified)
+}
+
+// Description returns the English description of the CVE, or the
+// empty string if there is none.
+func (c *CVE) Description() string {
+	for _, d := range c.Descriptions {
+		if d.Lang == "en" {
+			return d.Value
+		}
+	}
+	return ""
+}
+
+// GoCPEs returns the vulnerable CPEs of the CVE that refer to Go:
+// products of the "golang" vendor, and software built for the
+// Go target.
+func (c *CVE) GoCPEs() []*CPE {
+	var cpes []*CPE
+	seen := make(map[string]bool)
+	for _, cfg := range c.Configurations {
+		for _, n := range cfg.Nodes {
+			for _, m := range n.CPEMatch {
+				if !m.Vulnerable || seen[m.Criteria] {
+					continue
+				}
+				cpe, err := ParseCPE(m.Criteria)
+				if err != nil || !cpe.IsGo() {
+					continue
+				}
+				seen[m.Criteria] = true
+				cpes = append(cpes, cpe)
+			}
+		}
+	}
+	return cpes
+}
+
+// A CPE is a parsed CPE 2.3 formatted string.
+// Only the fields of interest are included.
+type CPE struct {
+	Part     string
+	Vendor   string
+	Product  string
+	TargetSW string
+}
+
+// ParseCPE parses a CPE 2.3 formatted string, e.g.
+// "cpe:2.3:a:golang:go:*:*:*:*:*:*:*:*".
+func ParseCPE(s string) (*CPE, error) {
+	fields := splitCPE(s)
+	if len(fields) != 13 || fields[0] != "cpe" || fields[1] != "2.3" {
+		return nil, fmt.Errorf("invalid CPE 2.3 string %q", s)
+	}
+	return &CPE{
+		Part:     fields[2],
+		Vendor:   fields[3],
+		Product:  fields[4],
+		TargetSW: fields[10],
+	}, nil
+}
+
+// splitCPE splits a CPE string on colons not escaped with a backslash.
+func splitCPE(s string) []string {
+	var fields []string
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			if i+1 < len(s) {
+				i++
+				b.WriteByte(s[i])
+			}
+		case ':':
+			fields = append(fields, b.String())
+			b.Reset()
+		default:
+			b.WriteByte(s[i])
+		}
+	}
+	return append(fields, b.String())
+}
+
+// IsGo reports whether the CPE refers to Go.
+func (c *CPE) IsGo() bool {
+	return c.Vendor == "golang" || c.TargetSW == "go" || c.TargetSW == "golang"
+}
+
+func (c *CPE) String() string {
+	s := c.Vendor + ":" + c.Product
+	if c.TargetSW != "*" && c.TargetSW != "" {
+		s += " (target " + c.TargetSW + ")"
+	}
+	return s
+}
+
+type response struct {
+	ResultsPerPage  int `json:"resultsPerPage"`
+	StartIndex      int `json:"startIndex"`
+	TotalResults    int `json:"totalResults"`
+	Vulnerabilities []struct {
+		CVE *CVE `json:"cve"`
+	} `json:"vulnerabilities"`
+}
+
+// List returns all CVEs modified since the given time.
+func (c *Client) List(ctx context.Context, since time.Time) (_ []*CVE, err error) {
+	defer derrors.Wrap(&err, "nvd.List(%s)", since)
+
+	var cves []*CVE
+	// The API limits each query to a range of maxDateRange.
+	now := time.Now().UTC()
+	for start := since.UTC(); start.Before(now); start = start.Add(maxDateRange) {
+		end := start.Add(maxDateRange)
+		if end.After(now) {
+			end = now
+		}
+		cs, err := c.listRange(ctx, start, end)
+		if err != nil {
+			return nil, err
+		}
+		cves = append(cves, cs...)
+	}
+	return cves, nil
+}
+
+// Get returns the CVE with the given ID, or nil
+// if the NVD does not have it.
+func (c *Client) Get(ctx context.Context, id string) (_ *CVE, err error) {
+	defer derrors.Wrap(&err, "nvd.Get(%s)", id)
+
+	params := url.Values{}
+	params.Set("cveId", id)
+	resp, err := c.get(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range resp.Vulnerabilities {
+		if v.CVE != nil && v.CVE.ID == id {
+			return v.CVE, nil
+		}
+	}
+	return nil, nil
+}
+
+// listRange returns all CVEs last modified in the range [start, end],
+// requesting pages until all results have been read.
+func (c *Client) listRange(ctx context.Context, start, end time.Time) ([]*CVE, error) {
+	var cves []*CVE
+	for index := 0; ; {
+		params := url.Values{}
+		params.Set("lastModStartDate", start.Format(timeFormat)+"Z")
+		params.Set("lastModEndDate", end.Format(timeFormat)+"Z")
+		params.Set("resultsPerPage", strconv.Itoa(resultsPerPage))
+		params.Set("startIndex", strconv.Itoa(index))
+		resp, err := c.get(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range resp.Vulnerabilities {
+			if v.CVE != nil {
+				cves = append(cves, v.CVE)
+			}
+		}
+		index += len(resp.Vulnerabilities)
+		if len(resp.Vulnerabilities) == 0 || index >= resp.TotalResults {
+			return cves, nil
+		}
+	}
+}
+
+func (c *Client) get(ctx context.Context, params url.Values) (*response, error) {
+	if err := c.limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+	u := c.url + "?" + params.Encode()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	if c.apiKey != "" {
+		req.Header.Set("apiKey", c.apiKey)
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("GET %s: HTTP error: %s", u, resp.Status)
+	}
+	var r response
+	if err := json.NewDecoder(resp.Body).Decode(&r); err != nil {
+		return nil, fmt.Errorf("GET %s: decoding response: %w", u, err)
+	}
+	return &r, nil
+}
@@ -0,0 +1,161 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package nvd
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/time/rate"
+)
+
+func TestParseCPE(t *testing.T) {
+	for _, test := range []struct {
+		in      string
+		want    *CPE
+		wantErr bool
+	}{
+		{
+			in:   "cpe:2.3:a:golang:go:*:*:*:*:*:*:*:*",
+			want: &CPE{Part: "a", Vendor: "golang", Product: "go", TargetSW: "*"},
+		},
+		{
+			in:   "cpe:2.3:a:example:some\\:thing:1.0:*:*:*:*:go:*:*",
+			want: &CPE{Part: "a", Vendor: "example", Product: "some:thing", TargetSW: "go"},
+		},
+		{
+			in:      "cpe:/a:golang:go",
+			wantErr: true,
+		},
+	} {
+		got, err := ParseCPE(test.in)
+		if gotErr := err != nil; gotErr != test.wantErr {
+			t.Fatalf("ParseCPE(%q) error = %v, want error=%t", test.in, err, test.wantErr)
+		}
+		if diff := cmp.Diff(test.want, got); diff != "" {
+			t.Errorf("ParseCPE(%q) mismatch (-want, +got):\n%s", test.in, diff)
+		}
+	}
+}
+
+func TestGoCPEs(t *testing.T) {
+	cve := &CVE{
+		ID: "CVE-2000-0001",
+		Configurations: []Configuration{{
+			Nodes: []Node{{
+				CPEMatch: []CPEMatch{
+					{Vulnerable: true, Criteria: "cpe:2.3:a:golang:go:*:*:*:*:*:*:*:*"},
+					{Vulnerable: true, Criteria: "cpe:2.3:a:golang:go:*:*:*:*:*:*:*:*"},
+					{Vulnerable: true, Criteria: "cpe:2.3:a:example:lib:*:*:*:*:*:go:*:*"},
+					{Vulnerable: true, Criteria: "cpe:2.3:a:example:other:*:*:*:*:*:*:*:*"},
+					{Vulnerable: false, Criteria: "cpe:2.3:a:golang:crypto:*:*:*:*:*:*:*:*"},
+				},
+			}},
+		}},
+	}
+	want := []*CPE{
+		{Part: "a", Vendor: "golang", Product: "go", TargetSW: "*"},
+		{Part: "a", Vendor: "example", Product: "lib", TargetSW: "go"},
+	}
+	if diff := cmp.Diff(want, cve.GoCPEs()); diff != "" {
+		t.Errorf("GoCPEs() mismatch (-want, +got):\n%s", diff)
+	}
+}
+
+func TestList(t *testing.T) {
+	const total = 3
+	var gotKeys []string
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotKeys = append(gotKeys, r.Header.Get("apiKey"))
+		if r.URL.Query().Get("lastModStartDate") == "" || r.URL.Query().Get("lastModEndDate") == "" {
+			http.Error(w, "missing date range", http.StatusBadRequest)
+			return
+		}
+		// Serve one CVE per page.
+		index, err := strconv.Atoi(r.URL.Query().Get("startIndex"))
+		if err != nil || index >= total {
+			http.Error(w, "bad startIndex", http.StatusBadRequest)
+			return
+		}
+		var resp response
+		resp.TotalResults = total
+		resp.StartIndex = index
+		resp.ResultsPerPage = 1
+		resp.Vulnerabilities = append(resp.Vulnerabilities, struct {
+			CVE *CVE `json:"cve"`
+		}{&CVE{ID: "CVE-2000-000" + strconv.Itoa(index)}})
+		if err := json.NewEncoder(w).Encode(resp); err != nil {
+			t.Error(err)
+		}
+	}))
+	defer s.Close()
+
+	c := newClient(s.URL, "key")
+	c.limiter = rate.NewLimiter(rate.Inf, 1)
+	cves, err := c.List(context.Background(), time.Now().Add(-time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var gotIDs []string
+	for _, c := range cves {
+		gotIDs = append(gotIDs, c.ID)
+	}
+	wantIDs := []string{"CVE-2000-0000", "CVE-2000-0001", "CVE-2000-0002"}
+	if diff := cmp.Diff(wantIDs, gotIDs); diff != "" {
+		t.Errorf("IDs mismatch (-want, +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"key", "key", "key"}, gotKeys); diff != "" {
+		t.Errorf("API keys mismatch (-want, +got):\n%s", diff)
+	}
+}
+
+func TestGet(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var resp response
+		if id := r.URL.Query().Get("cveId"); id == "CVE-2000-0001" {
+			resp.TotalResults = 1
+			resp.ResultsPerPage = 1
+			resp.Vulnerabilities = append(resp.Vulnerabilities, struct {
+				CVE *CVE `json:"cve"`
+			}{&CVE{ID: id, LastModified: "2024-01-02T03:04:05.000"}})
+		}
+		if err := json.NewEncoder(w).Encode(resp); err != nil {
+			t.Error(err)
+		}
+	}))
+	defer s.Close()
+
+	c := newClient(s.URL, "key")
+	c.limiter = rate.NewLimiter(rate.Inf, 1)
+	ctx := context.Background()
+	got, err := c.Get(ctx, "CVE-2000-0001")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got == nil || got.ID != "CVE-2000-0001" {
+		t.Fatalf("Get(CVE-2000-0001) = %+v, want CVE-2000-0001", got)
+	}
+	lm, err := got.LastModifiedTime()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC); !lm.Equal(want) {
+		t.Errorf("LastModifiedTime() = %s, want %s", lm, want)
+	}
+
+	got, err = c.Get(ctx, "CVE-2000-0002")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != nil {
+		t.Errorf("Get(CVE-2000-0002) = %+v, want nil", got)
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package openvex contains the schema for OpenVEX documents, which
+// state whether products are affected by vulnerabilities. The package
+// implements the subset of the schema needed to publish the Go
+// vulnerability database's "not affected" decisions.
+//
+// https://github.com/openvex/spec/blob/main/OPENVEX-SPEC.md
+// contains the full specification.
+package openvex
+
+import (
+	"time"
+)
+
+const (
+	// Context is the JSON-LD context of OpenVEX v0.2.0 documents.
+	Context = "https://openvex.dev/ns/v0.2.0"
+
+	// GoAuthor is the author of the Go vulnerability database's
+	// VEX documents.
+	GoAuthor = "Go Vulnerability Management"
+)
+
+// Document is an OpenVEX document.
+type Document struct {
+	Context    string       `json:"@context"`
+	ID         string       `json:"@id"`
+	Author     string       `json:"author"`
+	Timestamp  string       `json:"timestamp"`
+	Version    int          `json:"version"`
+	Tooling    string       `json:"tooling,omitempty"`
+	Statements []*Statement `json:"statements"`
+}
+
+// NewDocument returns the first version of a document with the
+// given ID, authored by GoAuthor at the given time.
+func NewDocument(id, tooling string, timestamp time.Time, statements []*Statement) *Document {
+	return &Document{
+		Context:    Context,
+		ID:         id,
+		Author:     GoAuthor,
+		Timestamp:  timestamp.UTC().Format(time.RFC3339),
+		Version:    1,
+		Tooling:    tooling,
+		Statements: statements,
+	}
+}
+
+// A Statement asserts the status of a vulnerability in
+// a set of products.
+type Statement struct {
+	Vulnerability Vulnerability `json:"vulnerability"`
+	Products      []*Product    `json:"products"`
+	Status        Status        `json:"status"`
+	// Justification is required if Status is StatusNotAffected,
+	// unless ImpactStatement is set.
+	Justification   Justification `json:"justification,omitempty"`
+	ImpactStatement string        `json:"impact_statement,omitempty"`
+}
+
+type Vulnerability struct {
+	Name    string   `json:"name"`
+	Aliases []string `json:"aliases,omitempty"`
+}
+
+// A Product is identified by its package URL.
+type Product struct {
+	ID string `json:"@id"`
+}
+
+// Status is the status of a vulnerability in a product.
+type Status string
+
+const (
+	StatusNotAffected        Status = "not_affected"
+	StatusAffected           Status = "affected"
+	StatusFixed              Status = "fixed"
+	StatusUnderInvestigation Status = "under_investigation"
+)
+
+// Justification is the reason a product is not affected
+// by a vulnerability.
+type Justification string
+
+const (
+	ComponentNotPresent                         Justification = "component_not_present"
+	VulnerableCodeNotPresent                    Justification = "vulnerable_code_not_present"
+	VulnerableCodeNotInExecutePath              Justification = "vulnerable_code_not_in_execute_path"
+	VulnerableCodeCannotBeControlledByAdversary Justification = "vulnerable_code_cannot_be_controlled_by_adversary"
+	InlineMitigationsAlreadyExist               Justification = "inline_mitigations_already_exist"
+)
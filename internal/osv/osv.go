@@ -250,6 +250,55 @@ type Credit struct {
 	// Name is the name, label, or other identifier of the individual or
 	// entity being credited. Required.
 	Name string `json:"name"`
+	// Type is the type or role of the individual or entity being
+	// credited. Optional.
+	Type CreditType `json:"type,omitempty"`
+	// Contact is a list of fully qualified URLs (e.g. https:// or mailto:)
+	// for contacting the individual or entity being credited. Optional.
+	Contact []string `json:"contact,omitempty"`
+}
+
+// CreditType is the type or role of an individual or entity
+// being credited.
+type CreditType string
+
+const (
+	// CreditTypeFinder identified the vulnerability.
+	CreditTypeFinder = CreditType("FINDER")
+	// CreditTypeReporter notified the vendor of the vulnerability.
+	CreditTypeReporter = CreditType("REPORTER")
+	// CreditTypeAnalyst validated the vulnerability.
+	CreditTypeAnalyst = CreditType("ANALYST")
+	// CreditTypeCoordinator facilitated the coordinated response process.
+	CreditTypeCoordinator = CreditType("COORDINATOR")
+	// CreditTypeRemediationDeveloper prepared a code change or other
+	// remediation plan.
+	CreditTypeRemediationDeveloper = CreditType("REMEDIATION_DEVELOPER")
+	// CreditTypeRemediationReviewer reviewed the remediation.
+	CreditTypeRemediationReviewer = CreditType("REMEDIATION_REVIEWER")
+	// CreditTypeRemediationVerifier tested and verified the remediation.
+	CreditTypeRemediationVerifier = CreditType("REMEDIATION_VERIFIER")
+	// CreditTypeTool was used to find the vulnerability.
+	CreditTypeTool = CreditType("TOOL")
+	// CreditTypeSponsor supported the vulnerability identification
+	// or remediation activities.
+	CreditTypeSponsor = CreditType("SPONSOR")
+	// CreditTypeOther is any other type or role.
+	CreditTypeOther = CreditType("OTHER")
+)
+
+// CreditTypes is the set of credit types defined in OSV.
+var CreditTypes = []CreditType{
+	CreditTypeFinder,
+	CreditTypeReporter,
+	CreditTypeAnalyst,
+	CreditTypeCoordinator,
+	CreditTypeRemediationDeveloper,
+	CreditTypeRemediationReviewer,
+	CreditTypeRemediationVerifier,
+	CreditTypeTool,
+	CreditTypeSponsor,
+	CreditTypeOther,
 }
 
 // DatabaseSpecific contains additional information about the
@@ -262,4 +311,121 @@ type DatabaseSpecific struct {
 	URL string `json:"url,omitempty"`
 	// The review status of this report (UNREVIEWED or REVIEWED).
 	ReviewStatus ReviewStatus `json:"review_status,omitempty"`
+	// Affected symbols that users have reported as not vulnerable,
+	// pending review.
+	DisputedSymbols []DisputedSymbol `json:"disputed_symbols,omitempty"`
+	// The class of programming error that caused the vulnerability,
+	// for correlating it with the findings of static analysis tools.
+	PatternClass *PatternClass `json:"pattern_class,omitempty"`
+	// Preconditions for exploiting the vulnerability. Programs that
+	// do not meet one of them are not affected.
+	Conditions []Condition `json:"conditions,omitempty"`
+	// GODEBUG settings that mitigate the vulnerability.
+	Mitigations []Mitigation `json:"mitigations,omitempty"`
+	// The availability of fixes for affected packages, where it is not
+	// apparent from the fixed versions.
+	FixStatuses []FixStatus `json:"fix_statuses,omitempty"`
+	// Version ranges of affected modules that are known not to be
+	// affected, even though other sources may say they are.
+	Unaffected []Unaffected `json:"unaffected,omitempty"`
+	// The Exploit Prediction Scoring System (EPSS) score of the
+	// vulnerability, as of the date it was computed.
+	EPSS *EPSS `json:"epss,omitempty"`
+}
+
+// EPSS is the Exploit Prediction Scoring System (EPSS) score of one of
+// the CVEs of a vulnerability (the highest-scoring, if it has several).
+//
+// See https://www.first.org/epss.
+type EPSS struct {
+	// The CVE the score is for.
+	CVE string `json:"cve"`
+	// The probability, from 0 to 1, that the CVE will be exploited in
+	// the wild in the 30 days after the date.
+	Score float64 `json:"score"`
+	// The proportion, from 0 to 1, of all scored CVEs with the same or
+	// a lower score.
+	Percentile float64 `json:"percentile"`
+	// The date the score was computed, of the form YYYY-MM-DD.
+	Date string `json:"date"`
+}
+
+// Unaffected are the version ranges of a module that are known
+// not to be affected by the vulnerability.
+type Unaffected struct {
+	// The module path, as in Affected.
+	Module string `json:"module"`
+	// The unaffected version ranges. Each range is expressed like
+	// an affected range: its "introduced" event starts a range of
+	// unaffected versions and its "fixed" event ends it.
+	Ranges []Range `json:"ranges"`
+}
+
+// A FixStatus is the availability of a fix for an affected package.
+type FixStatus struct {
+	// The import path of the package.
+	Package string `json:"package"`
+	// The status: "none" (no fix exists), "pending" (a fix has not
+	// been released or analyzed) or "partial" (the fixed version only
+	// partly addresses the vulnerability).
+	Status string `json:"status"`
+	// A link to the discussion of the fix, if any.
+	URL string `json:"url,omitempty"`
+}
+
+// A Mitigation is a GODEBUG setting that mitigates a vulnerability.
+type Mitigation struct {
+	// The name of the setting, for example "httpmuxgo121".
+	GODEBUG string `json:"godebug"`
+	// The value of the setting that mitigates the vulnerability.
+	Value string `json:"value"`
+	// A further explanation of the setting, if any.
+	Description string `json:"description,omitempty"`
+	// The default values of the setting, in increasing order of the
+	// Go versions that introduced them.
+	Defaults []GODEBUGDefault `json:"defaults,omitempty"`
+}
+
+// A GODEBUGDefault is the default value of a GODEBUG setting,
+// starting at a Go version.
+type GODEBUGDefault struct {
+	// The Go version that introduced the default, as an unprefixed
+	// semantic version, for example "1.22.0".
+	Go string `json:"go"`
+	// The default value.
+	Value string `json:"value"`
+}
+
+// A Condition is a precondition for exploiting a vulnerability.
+type Condition struct {
+	// The kind of condition: CONFIG, INPUT or GODEBUG.
+	Type string `json:"type"`
+	// The configuration, API (as a qualified symbol, for example
+	// "net/http.Header.Get") or GODEBUG setting (for example
+	// "x509sha1=1") required, depending on the type.
+	Value string `json:"value"`
+	// A further explanation of the condition, if any.
+	Description string `json:"description,omitempty"`
+}
+
+// A PatternClass is a class of programming error.
+type PatternClass struct {
+	// The name of the class, for example "NIL_DEREFERENCE".
+	Class string `json:"class"`
+	// The IDs of the CWE weaknesses in the class, for example "CWE-476".
+	CWEs []string `json:"cwes,omitempty"`
+	// Static analysis checks that find instances of the class, of the
+	// form "tool:check", for example "govet:nilness" or
+	// "staticcheck:SA5011".
+	Analyzers []string `json:"analyzers,omitempty"`
+}
+
+// A DisputedSymbol is an affected symbol that has been reported
+// as not vulnerable. It is still listed in the affected symbols
+// of its package.
+type DisputedSymbol struct {
+	// The import path of the package containing the symbol.
+	Package string `json:"package"`
+	// The symbol, as listed in the package's symbols.
+	Symbol string `json:"symbol"`
 }
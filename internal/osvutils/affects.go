@@ -9,6 +9,7 @@ import (
 
 	"golang.org/x/vulndb/internal/osv"
 	"golang.org/x/vulndb/internal/version"
+	"golang.org/x/vulndb/internal/version/interval"
 )
 
 // AffectsSemver returns whether the version v is within the given ranges.
@@ -23,25 +24,9 @@ func AffectsSemver(ranges []osv.Range, v string) (bool, error) {
 	if !version.IsValid(v) {
 		return false, fmt.Errorf("%w: %s", errInvalidSemver, v)
 	}
-	for _, r := range ranges {
-		if containsSemver(r, v) {
-			return true, nil
-		}
-	}
-	return false, nil
-}
-
-// containsSemver checks if semver version v is in the
-// range encoded by ar.
-// The range must be sorted in ascending order.
-func containsSemver(ar osv.Range, v string) bool {
-	var affected bool
-	for _, e := range ar.Events {
-		if !affected && e.Introduced != "" {
-			affected = e.Introduced == "0" || !version.Before(v, e.Introduced)
-		} else if affected && e.Fixed != "" {
-			affected = version.Before(v, e.Fixed)
-		}
+	affected, err := interval.FromOSV(ranges)
+	if err != nil {
+		return false, err
 	}
-	return affected
+	return affected.Contains(v), nil
 }
@@ -0,0 +1,213 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package osvutils
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"golang.org/x/vulndb/internal/derrors"
+	"golang.org/x/vulndb/internal/osv"
+)
+
+// The parts of the files of a generated database (see package
+// database, which this package can't import) that ValidateDB checks.
+type (
+	dbVuln struct {
+		ID       string   `json:"id"`
+		Modified osv.Time `json:"modified"`
+	}
+	dbModule struct {
+		Path  string   `json:"path"`
+		Vulns []dbVuln `json:"vulns"`
+	}
+)
+
+// ValidateDB checks the invariants between the files of the generated
+// database in dir, which validating each entry on its own can't:
+//   - every ID in index/vulns.json has an entry in ID/, with the same
+//     modified time, and every entry is in index/vulns.json
+//   - no alias is an alias of more than one (non-withdrawn) entry
+//   - every module affected by an entry is in index/modules.json,
+//     with the entry among its vulns
+//
+// It returns all the problems it finds.
+func ValidateDB(dir string) (err error) {
+	defer derrors.Wrap(&err, "ValidateDB(%s)", dir)
+
+	entries, err := readDB(dir)
+	if err != nil {
+		return err
+	}
+	return errors.Join(entries.check()...)
+}
+
+// ValidateDBUpdate checks the database in dir like ValidateDB, and
+// also checks that it can replace the previous generation of the
+// database in prevDir: no entry was removed, and no modified time
+// (of an entry, or of the database) went back.
+func ValidateDBUpdate(dir, prevDir string) (err error) {
+	defer derrors.Wrap(&err, "ValidateDBUpdate(%s, %s)", dir, prevDir)
+
+	entries, err := readDB(dir)
+	if err != nil {
+		return err
+	}
+	prev, err := readDB(prevDir)
+	if err != nil {
+		return err
+	}
+	errs := entries.check()
+	if entries.modified.Before(prev.modified.Time) {
+		errs = append(errs, fmt.Errorf("index/db.json: modified time went back (from %s to %s)", prev.modified.Format(time.RFC3339), entries.modified.Format(time.RFC3339)))
+	}
+	for _, id := range sortedIDs(prev.vulns) {
+		old := prev.vulns[id]
+		v, ok := entries.vulns[id]
+		switch {
+		case !ok:
+			errs = append(errs, fmt.Errorf("%s: removed (entries must be withdrawn instead)", id))
+		case v.Modified.Before(old.Modified.Time):
+			errs = append(errs, fmt.Errorf("%s: modified time went back (from %s to %s)", id, old.Modified.Format(time.RFC3339), v.Modified.Format(time.RFC3339)))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// dbFiles is the contents of the files of a database.
+type dbFiles struct {
+	modified osv.Time
+	vulns    map[string]dbVuln
+	modules  map[string]*dbModule
+	entries  map[string]*osv.Entry
+}
+
+func readDB(dir string) (*dbFiles, error) {
+	f := &dbFiles{
+		vulns:   make(map[string]dbVuln),
+		modules: make(map[string]*dbModule),
+		entries: make(map[string]*osv.Entry),
+	}
+
+	var meta struct {
+		Modified osv.Time `json:"modified"`
+	}
+	if err := readJSON(filepath.Join(dir, "index", "db.json"), &meta); err != nil {
+		return nil, err
+	}
+	f.modified = meta.Modified
+
+	var vulns []dbVuln
+	if err := readJSON(filepath.Join(dir, "index", "vulns.json"), &vulns); err != nil {
+		return nil, err
+	}
+	for _, v := range vulns {
+		if _, ok := f.vulns[v.ID]; ok {
+			return nil, fmt.Errorf("index/vulns.json: %s is listed twice", v.ID)
+		}
+		f.vulns[v.ID] = v
+	}
+
+	var modules []*dbModule
+	if err := readJSON(filepath.Join(dir, "index", "modules.json"), &modules); err != nil {
+		return nil, err
+	}
+	for _, m := range modules {
+		if _, ok := f.modules[m.Path]; ok {
+			return nil, fmt.Errorf("index/modules.json: %s is listed twice", m.Path)
+		}
+		f.modules[m.Path] = m
+	}
+
+	files, err := filepath.Glob(filepath.Join(dir, "ID", "*.json"))
+	if err != nil {
+		return nil, err
+	}
+	for _, file := range files {
+		var e osv.Entry
+		if err := readJSON(file, &e); err != nil {
+			return nil, err
+		}
+		f.entries[strings.TrimSuffix(filepath.Base(file), ".json")] = &e
+	}
+	return f, nil
+}
+
+func readJSON(filename string, v any) error {
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(b, v); err != nil {
+		return fmt.Errorf("%s: %w", filename, err)
+	}
+	return nil
+}
+
+// check returns the problems with the invariants of f.
+func (f *dbFiles) check() (errs []error) {
+	for _, id := range sortedIDs(f.vulns) {
+		v := f.vulns[id]
+		e, ok := f.entries[id]
+		switch {
+		case !ok:
+			errs = append(errs, fmt.Errorf("%s: in index/vulns.json, but ID/%[1]s.json does not exist", id))
+		case !e.Modified.Equal(v.Modified.Time):
+			errs = append(errs, fmt.Errorf("%s: modified time is %s, but %s in index/vulns.json", id, e.Modified.Format(time.RFC3339), v.Modified.Format(time.RFC3339)))
+		}
+	}
+
+	aliasOf := make(map[string]string)
+	for _, id := range sortedIDs(f.entries) {
+		e := f.entries[id]
+		if e.ID != id {
+			errs = append(errs, fmt.Errorf("ID/%s.json: contains entry %s", id, e.ID))
+		}
+		if _, ok := f.vulns[id]; !ok {
+			errs = append(errs, fmt.Errorf("%s: not in index/vulns.json", id))
+		}
+		if e.Withdrawn == nil {
+			for _, a := range e.Aliases {
+				if other, ok := aliasOf[a]; ok {
+					errs = append(errs, fmt.Errorf("%s: alias %s is also an alias of %s", id, a, other))
+					continue
+				}
+				aliasOf[a] = id
+			}
+		}
+		var paths []string
+		for _, a := range e.Affected {
+			if !slices.Contains(paths, a.Module.Path) {
+				paths = append(paths, a.Module.Path)
+			}
+		}
+		for _, path := range paths {
+			m, ok := f.modules[path]
+			if !ok {
+				errs = append(errs, fmt.Errorf("%s: affected module %s is not in index/modules.json", id, path))
+				continue
+			}
+			if !slices.ContainsFunc(m.Vulns, func(v dbVuln) bool { return v.ID == id }) {
+				errs = append(errs, fmt.Errorf("%s: not among the vulns of affected module %s in index/modules.json", id, path))
+			}
+		}
+	}
+	return errs
+}
+
+func sortedIDs[V any](m map[string]V) []string {
+	ids := make([]string, 0, len(m))
+	for id := range m {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+	return ids
+}
@@ -0,0 +1,199 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package osvutils
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/vulndb/internal/osv"
+)
+
+// testDB is a database to write with writeTestDB.
+type testDB struct {
+	modified osv.Time
+	vulns    []dbVuln
+	modules  []*dbModule
+	entries  []*osv.Entry
+}
+
+func writeTestDB(t *testing.T, db *testDB) string {
+	t.Helper()
+	dir := t.TempDir()
+	write := func(name string, v any) {
+		b, err := json.Marshal(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, b, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("index/db.json", map[string]any{"modified": db.modified})
+	write("index/vulns.json", db.vulns)
+	write("index/modules.json", db.modules)
+	for _, e := range db.entries {
+		write("ID/"+e.ID+".json", e)
+	}
+	return dir
+}
+
+func dbEntry(id string, modified osv.Time, module string, aliases ...string) *osv.Entry {
+	return &osv.Entry{
+		ID:       id,
+		Modified: modified,
+		Aliases:  aliases,
+		Affected: []osv.Affected{{Module: osv.Module{Path: module, Ecosystem: osv.GoEcosystem}}},
+	}
+}
+
+// validTestDB returns a valid database, modified by f.
+func validTestDB(f func(*testDB)) *testDB {
+	db := &testDB{
+		modified: jan2000,
+		vulns: []dbVuln{
+			{ID: "GO-1999-0001", Modified: jan1999},
+			{ID: "GO-2000-0002", Modified: jan2000},
+		},
+		modules: []*dbModule{
+			{Path: "example.com/a", Vulns: []dbVuln{{ID: "GO-1999-0001"}, {ID: "GO-2000-0002"}}},
+			{Path: "example.com/b", Vulns: []dbVuln{{ID: "GO-2000-0002"}}},
+		},
+		entries: []*osv.Entry{
+			dbEntry("GO-1999-0001", jan1999, "example.com/a", "CVE-1999-0001"),
+			dbEntry("GO-2000-0002", jan2000, "example.com/a", "CVE-2000-0002", "GHSA-xxxx-yyyy-zzzz"),
+		},
+	}
+	db.entries[1].Affected = append(db.entries[1].Affected, osv.Affected{Module: osv.Module{Path: "example.com/b"}})
+	if f != nil {
+		f(db)
+	}
+	return db
+}
+
+func TestValidateDB(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		db   *testDB
+		// The errors, one per line.
+		want []string
+	}{
+		{
+			name: "valid",
+			db:   validTestDB(nil),
+		},
+		{
+			name: "valid_withdrawn_duplicate",
+			db: validTestDB(func(db *testDB) {
+				// A withdrawn entry may share aliases.
+				db.entries[0].Aliases = db.entries[1].Aliases
+				db.entries[0].Withdrawn = &jan2000
+			}),
+		},
+		{
+			name: "missing_entry",
+			db: validTestDB(func(db *testDB) {
+				db.entries = db.entries[:1]
+			}),
+			want: []string{"GO-2000-0002: in index/vulns.json, but ID/GO-2000-0002.json does not exist"},
+		},
+		{
+			name: "unlisted_entry",
+			db: validTestDB(func(db *testDB) {
+				db.vulns = db.vulns[:1]
+			}),
+			want: []string{"GO-2000-0002: not in index/vulns.json"},
+		},
+		{
+			name: "modified_mismatch",
+			db: validTestDB(func(db *testDB) {
+				db.vulns[0].Modified = jan2000
+			}),
+			want: []string{"GO-1999-0001: modified time is 1999-01-01T00:00:00Z, but 2000-01-01T00:00:00Z in index/vulns.json"},
+		},
+		{
+			name: "duplicate_alias",
+			db: validTestDB(func(db *testDB) {
+				db.entries[1].Aliases = append(db.entries[1].Aliases, "CVE-1999-0001")
+			}),
+			want: []string{"GO-2000-0002: alias CVE-1999-0001 is also an alias of GO-1999-0001"},
+		},
+		{
+			name: "missing_modules",
+			db: validTestDB(func(db *testDB) {
+				db.modules = db.modules[:1]
+				db.modules[0].Vulns = db.modules[0].Vulns[1:]
+			}),
+			want: []string{
+				"GO-1999-0001: not among the vulns of affected module example.com/a in index/modules.json",
+				"GO-2000-0002: affected module example.com/b is not in index/modules.json",
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateDB(writeTestDB(t, tc.db))
+			checkDBErrors(t, err, tc.want)
+		})
+	}
+}
+
+func TestValidateDBUpdate(t *testing.T) {
+	jan2001 := osv.Time{Time: time.Date(2001, 1, 1, 0, 0, 0, 0, time.UTC)}
+	prev := writeTestDB(t, validTestDB(nil))
+
+	t.Run("valid", func(t *testing.T) {
+		dir := writeTestDB(t, validTestDB(func(db *testDB) {
+			db.modified = jan2001
+			db.vulns[1].Modified = jan2001
+			db.entries[1].Modified = jan2001
+		}))
+		checkDBErrors(t, ValidateDBUpdate(dir, prev), nil)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		dir := writeTestDB(t, validTestDB(func(db *testDB) {
+			db.modified = jan1999
+			db.vulns = db.vulns[1:]
+			db.entries = db.entries[1:]
+			db.modules[0].Vulns = db.modules[0].Vulns[1:]
+			db.vulns[0].Modified = jan1999
+			db.entries[0].Modified = jan1999
+		}))
+		checkDBErrors(t, ValidateDBUpdate(dir, prev), []string{
+			"index/db.json: modified time went back (from 2000-01-01T00:00:00Z to 1999-01-01T00:00:00Z)",
+			"GO-1999-0001: removed (entries must be withdrawn instead)",
+			"GO-2000-0002: modified time went back (from 2000-01-01T00:00:00Z to 1999-01-01T00:00:00Z)",
+		})
+	})
+}
+
+func checkDBErrors(t *testing.T, err error, want []string) {
+	t.Helper()
+	if len(want) == 0 {
+		if err != nil {
+			t.Errorf("got error %v, want none", err)
+		}
+		return
+	}
+	if err == nil {
+		t.Fatalf("got no error, want %q", want)
+	}
+	// Skip the line with the wrapped function name.
+	got := strings.Split(err.Error(), "\n")
+	if i := strings.Index(got[0], ": "); i >= 0 {
+		got[0] = got[0][i+2:]
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("got errors:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
@@ -5,28 +5,42 @@
 package osvutils
 
 import (
+	"fmt"
+
 	"golang.org/x/vulndb/internal/osv"
 	"golang.org/x/vulndb/internal/version"
+	"golang.org/x/vulndb/internal/version/interval"
 )
 
+// LatestFixed returns the lowest version above all the versions
+// affected according to the SEMVER ranges, or "" if there is none
+// (because the latest versions are affected) or the ranges are invalid.
 func LatestFixed(ranges []osv.Range) string {
-	var latestFixed string
-	for _, r := range ranges {
-		if r.Type == osv.RangeTypeSemver {
-			for _, e := range r.Events {
-				if fixed := e.Fixed; fixed != "" && version.Before(latestFixed, fixed) {
-					latestFixed = fixed
-				}
-			}
-			// If the vulnerability was re-introduced after the latest fix
-			// we found, there is no latest fix for this range.
-			for _, e := range r.Events {
-				if introduced := e.Introduced; introduced != "" && introduced != "0" && version.Before(latestFixed, introduced) {
-					latestFixed = ""
-					break
-				}
-			}
+	affected, err := interval.FromOSV(ranges)
+	if err != nil {
+		return ""
+	}
+	return affected.Latest()
+}
+
+// FixedBetween returns the versions that fix the vulnerability
+// described by the SEMVER ranges for an upgrade from version from
+// to version to: the fixed versions v such that from < v <= to.
+// from and to must be unprefixed, valid semver, and ranges must be
+// sorted, non-overlapping, and contain only valid semver.
+// The function errors if any of the inputs is invalid.
+func FixedBetween(ranges []osv.Range, from, to string) ([]string, error) {
+	if err := ValidateRanges(ranges); err != nil {
+		return nil, err
+	}
+	for _, v := range []string{from, to} {
+		if !version.IsValid(v) {
+			return nil, fmt.Errorf("%w: %s", errInvalidSemver, v)
 		}
 	}
-	return string(latestFixed)
+	affected, err := interval.FromOSV(ranges)
+	if err != nil {
+		return nil, err
+	}
+	return affected.FixedBetween(from, to), nil
 }
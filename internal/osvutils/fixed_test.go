@@ -5,6 +5,8 @@
 package osvutils
 
 import (
+	"errors"
+	"slices"
 	"testing"
 
 	"golang.org/x/vulndb/internal/osv"
@@ -138,3 +140,60 @@ func TestLatestFixed(t *testing.T) {
 		})
 	}
 }
+
+func TestFixedBetween(t *testing.T) {
+	ranges := []osv.Range{{
+		Type: osv.RangeTypeSemver,
+		Events: []osv.RangeEvent{
+			{Introduced: "0"}, {Fixed: "1.2.1"},
+			{Introduced: "1.3.0"}, {Fixed: "1.3.4"},
+			{Introduced: "1.4.0"}, {Fixed: "1.4.2"},
+		},
+	}}
+	tests := []struct {
+		name     string
+		from, to string
+		want     []string
+	}{
+		{
+			name: "none",
+			from: "1.2.1",
+			to:   "1.3.2",
+			want: nil,
+		},
+		{
+			name: "one",
+			from: "1.2.0",
+			to:   "1.3.2",
+			want: []string{"1.2.1"},
+		},
+		{
+			name: "several",
+			from: "1.0.0",
+			to:   "1.5.0",
+			want: []string{"1.2.1", "1.3.4", "1.4.2"},
+		},
+		{
+			name: "fix at to",
+			from: "1.3.4",
+			to:   "1.4.2",
+			want: []string{"1.4.2"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := FixedBetween(ranges, test.from, test.to)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !slices.Equal(got, test.want) {
+				t.Errorf("FixedBetween(%s, %s) = %v, want %v", test.from, test.to, got, test.want)
+			}
+		})
+	}
+
+	if _, err := FixedBetween(ranges, "v1.0.0", "1.5.0"); !errors.Is(err, errInvalidSemver) {
+		t.Errorf("FixedBetween(v1.0.0, 1.5.0): got err %v, want %v", err, errInvalidSemver)
+	}
+}
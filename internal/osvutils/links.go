@@ -0,0 +1,69 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package osvutils
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+
+	"golang.org/x/vulndb/internal/derrors"
+	"golang.org/x/vulndb/internal/idstr"
+	"golang.org/x/vulndb/internal/osv"
+)
+
+// goAdvisoryLinkRE matches links to Go advisories anywhere in
+// a string, capturing the Go ID.
+var goAdvisoryLinkRE = regexp.MustCompile(`https://pkg\.go\.dev/vuln/(GO-\d{4}-\d{4,})`)
+
+// CheckLinks errors if any of the entry's internal links is broken.
+//
+// The entry's database_specific.url must link to its own advisory.
+// Go IDs in its related list, and in the Go advisory links in its
+// references and details, must be accepted by resolve, which should
+// return an error for IDs that are not live entries in the database
+// (for example, because they were excluded or withdrawn).
+// ADVISORY references to CVEs and GHSAs must be for one of
+// the entry's aliases.
+func CheckLinks(e *osv.Entry, resolve func(id string) error) (err error) {
+	defer derrors.Wrap(&err, "CheckLinks(%s)", e.ID)
+
+	var errs []error
+	if e.DatabaseSpecific == nil || e.DatabaseSpecific.URL != idstr.GoAdvisory(e.ID) {
+		errs = append(errs, fmt.Errorf("database_specific.url must be %q", idstr.GoAdvisory(e.ID)))
+	}
+	checkID := func(field, id string) {
+		if id == e.ID {
+			return
+		}
+		if err := resolve(id); err != nil {
+			errs = append(errs, fmt.Errorf("%s: link to %s is broken: %w", field, id, err))
+		}
+	}
+	for _, id := range e.Related {
+		if idstr.IsGoID(id) {
+			checkID("related", id)
+		}
+	}
+	for _, ref := range e.References {
+		for _, m := range goAdvisoryLinkRE.FindAllStringSubmatch(ref.URL, -1) {
+			checkID("references", m[1])
+		}
+		if ref.Type == osv.ReferenceTypeAdvisory && !idstr.IsGoAdvisory(ref.URL) {
+			if _, ok := idstr.IsAdvisoryForOneOf(ref.URL, e.Aliases); !ok && idstr.IsAdvisory(ref.URL) {
+				errs = append(errs, fmt.Errorf("references: advisory %q is not for one of the aliases %v", ref.URL, e.Aliases))
+			}
+		}
+	}
+	var seen []string
+	for _, m := range goAdvisoryLinkRE.FindAllStringSubmatch(e.Details, -1) {
+		if !slices.Contains(seen, m[1]) {
+			seen = append(seen, m[1])
+			checkID("details", m[1])
+		}
+	}
+	return errors.Join(errs...)
+}
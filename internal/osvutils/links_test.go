@@ -0,0 +1,90 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package osvutils
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"golang.org/x/vulndb/internal/osv"
+)
+
+func TestCheckLinks(t *testing.T) {
+	resolve := func(id string) error {
+		switch id {
+		case "GO-1999-0002":
+			return nil
+		case "GO-1999-0003":
+			return errors.New("is withdrawn")
+		}
+		return errors.New("does not exist")
+	}
+	for _, tc := range []struct {
+		name      string
+		transform func(e *osv.Entry)
+		wantErrs  []string
+	}{
+		{
+			name: "ok",
+			transform: func(e *osv.Entry) {
+				e.Related = []string{"GO-1999-0002", "CVE-1999-2222"}
+				e.Details = "See https://pkg.go.dev/vuln/GO-1999-0002 and https://pkg.go.dev/vuln/GO-1999-0001."
+				e.References = append(e.References,
+					osv.Reference{Type: osv.ReferenceTypeAdvisory, URL: "https://nvd.nist.gov/vuln/detail/CVE-1999-1111"},
+					osv.Reference{Type: osv.ReferenceTypeWeb, URL: "https://nvd.nist.gov/vuln/detail/CVE-1999-2222"},
+					osv.Reference{Type: osv.ReferenceTypeWeb, URL: "https://pkg.go.dev/vuln/GO-1999-0002"})
+			},
+		},
+		{
+			name: "bad_self_link",
+			transform: func(e *osv.Entry) {
+				e.DatabaseSpecific.URL = "https://pkg.go.dev/vuln/GO-1999-0002"
+			},
+			wantErrs: []string{"database_specific.url"},
+		},
+		{
+			name: "no_database_specific",
+			transform: func(e *osv.Entry) {
+				e.DatabaseSpecific = nil
+			},
+			wantErrs: []string{"database_specific.url"},
+		},
+		{
+			name: "broken",
+			transform: func(e *osv.Entry) {
+				e.Related = []string{"GO-1999-0003"}
+				e.Details = "Like https://pkg.go.dev/vuln/GO-1999-0004."
+				e.References = append(e.References,
+					osv.Reference{Type: osv.ReferenceTypeAdvisory, URL: "https://github.com/advisories/GHSA-xxxx-yyyy-zzzz"},
+					osv.Reference{Type: osv.ReferenceTypeWeb, URL: "https://pkg.go.dev/vuln/GO-1999-0005"})
+			},
+			wantErrs: []string{
+				"related: link to GO-1999-0003 is broken: is withdrawn",
+				"details: link to GO-1999-0004 is broken: does not exist",
+				"references: link to GO-1999-0005 is broken: does not exist",
+				"GHSA-xxxx-yyyy-zzzz\" is not for one of the aliases",
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := CheckLinks(testEntry(tc.transform), resolve)
+			if len(tc.wantErrs) == 0 {
+				if err != nil {
+					t.Fatalf("CheckLinks() = %v, want no error", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("CheckLinks() = nil, want errors %v", tc.wantErrs)
+			}
+			for _, want := range tc.wantErrs {
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("CheckLinks() = %v, want error containing %q", err, want)
+				}
+			}
+		})
+	}
+}
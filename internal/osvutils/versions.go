@@ -0,0 +1,110 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package osvutils
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"golang.org/x/mod/module"
+	"golang.org/x/vulndb/internal/derrors"
+	"golang.org/x/vulndb/internal/osv"
+	"golang.org/x/vulndb/internal/proxy"
+	"golang.org/x/vulndb/internal/version"
+)
+
+var (
+	errUnknownModule  = errors.New("module not known to proxy")
+	errUnknownVersion = errors.New("version does not exist")
+)
+
+// ValidateVersionsExist errors if any introduced or fixed version in the
+// ranges of the OSV entry, other than "0", is not a version of its module
+// known to the proxy. This catches typos (such as 1.2.30 for 1.2.3)
+// that are valid semver, and so pass Validate.
+//
+// Modules in the Go standard library and toolchain are not checked,
+// because they are not served by the proxy.
+func ValidateVersionsExist(e *osv.Entry, pc *proxy.Client) (err error) {
+	defer derrors.Wrap(&err, "ValidateVersionsExist(%s)", e.ID)
+
+	var errs []error
+	for _, a := range e.Affected {
+		if err := validateVersionsExist(&a, pc); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func validateVersionsExist(a *osv.Affected, pc *proxy.Client) error {
+	m := a.Module.Path
+	if m == osv.GoStdModulePath || m == osv.GoCmdModulePath {
+		return nil
+	}
+
+	var vs []string
+	for _, r := range a.Ranges {
+		for _, e := range r.Events {
+			for _, v := range []string{e.Introduced, e.Fixed} {
+				if v != "" && v != "0" {
+					vs = append(vs, v)
+				}
+			}
+		}
+	}
+	if len(vs) == 0 {
+		return nil
+	}
+
+	known, err := pc.Versions(m)
+	if err != nil || len(known) == 0 {
+		if !pc.ModuleExists(m) {
+			return fmt.Errorf("%w (module %s)", errUnknownModule, m)
+		}
+	}
+
+	var errs []error
+	for _, v := range vs {
+		if slices.Contains(known, v) {
+			continue
+		}
+		// Pseudo-versions are not listed, but the proxy
+		// can look them up.
+		if module.IsPseudoVersion("v" + v) {
+			if c, err := pc.CanonicalModuleVersion(m, v); err == nil && c == v {
+				continue
+			}
+		}
+		errs = append(errs, fmt.Errorf("%w (module %s, version %s%s)",
+			errUnknownVersion, m, v, nearest(known, v)))
+	}
+	return errors.Join(errs...)
+}
+
+// nearest returns a description of the known versions on either
+// side of v, which is not itself known, or "" if there are none.
+// known must be sorted.
+func nearest(known []string, v string) string {
+	i, _ := slices.BinarySearchFunc(known, v, func(k, v string) int {
+		if version.Before(k, v) {
+			return -1
+		}
+		return 1
+	})
+	var near []string
+	if i > 0 {
+		near = append(near, known[i-1])
+	}
+	if i < len(known) {
+		near = append(near, known[i])
+	}
+	if len(near) == 0 {
+		return ""
+	}
+	return "; nearest known: " + strings.Join(near, ", ")
+}
@@ -0,0 +1,77 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package osvutils
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"golang.org/x/vulndb/internal/osv"
+	"golang.org/x/vulndb/internal/proxy"
+)
+
+func TestValidateVersionsExist(t *testing.T) {
+	pc, err := proxy.NewTestClient(t, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	withEvents := func(module string, events ...osv.RangeEvent) *osv.Entry {
+		return testEntry(func(e *osv.Entry) {
+			e.Affected[0].Module.Path = module
+			e.Affected[0].Ranges[0].Events = events
+		})
+	}
+
+	for _, tc := range []struct {
+		name    string
+		entry   *osv.Entry
+		wantErr error
+		// Substrings of the error message.
+		wantMsgs []string
+	}{
+		{
+			name:  "ok",
+			entry: testEntry(nil),
+		},
+		{
+			name: "pseudo-version",
+			entry: withEvents("example.com/module",
+				osv.RangeEvent{Introduced: "0"}, osv.RangeEvent{Fixed: "0.0.0-20240101000000-abcdefabcdef"}),
+		},
+		{
+			name: "typo",
+			entry: withEvents("example.com/module",
+				osv.RangeEvent{Introduced: "1.1.0"}, osv.RangeEvent{Fixed: "1.2.30"},
+				osv.RangeEvent{Introduced: "1.3.0"}),
+			wantErr:  errUnknownVersion,
+			wantMsgs: []string{"version 1.2.30; nearest known: 1.2.3", "version 1.3.0; nearest known: 1.2.3)"},
+		},
+		{
+			name: "unknown module",
+			entry: withEvents("example.com/unknown",
+				osv.RangeEvent{Introduced: "0"}, osv.RangeEvent{Fixed: "1.0.0"}),
+			wantErr: errUnknownModule,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateVersionsExist(tc.entry, pc)
+			if tc.wantErr == nil {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if !errors.Is(err, tc.wantErr) {
+				t.Fatalf("got error %v, want %v", err, tc.wantErr)
+			}
+			for _, msg := range tc.wantMsgs {
+				if !strings.Contains(err.Error(), msg) {
+					t.Errorf("error %q does not contain %q", err, msg)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,248 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package parquet writes tables of strings as Apache Parquet files.
+//
+// It implements only what is needed to export the Go vulnerability
+// database for analytics: a single row group of required UTF-8
+// string columns, each written as one uncompressed data page with
+// PLAIN encoding. The output is only checked by decoding it again
+// in this package's tests, not with other Parquet implementations.
+//
+// https://parquet.apache.org/docs/file-format/ describes the format.
+package parquet
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+const magic = "PAR1"
+
+// CreatedBy is recorded as the writer of the files written by Write.
+const CreatedBy = "golang.org/x/vulndb/internal/parquet"
+
+// Write writes a Parquet file to w with a required UTF-8 string column
+// for each of the given column names, and the given rows.
+// Each row must have a value for each column.
+func Write(w io.Writer, columns []string, rows [][]string) error {
+	for i, row := range rows {
+		if len(row) != len(columns) {
+			return fmt.Errorf("parquet: row %d has %d values, want %d", i, len(row), len(columns))
+		}
+	}
+
+	var file bytes.Buffer
+	file.WriteString(magic)
+	var chunks []columnChunk
+	for c := range columns {
+		var data bytes.Buffer
+		for _, row := range rows {
+			binary.Write(&data, binary.LittleEndian, uint32(len(row[c])))
+			data.WriteString(row[c])
+		}
+		var header compactWriter
+		header.pageHeader(len(rows), data.Len())
+		offset := file.Len()
+		file.Write(header.Bytes())
+		file.Write(data.Bytes())
+		chunks = append(chunks, columnChunk{
+			name:   columns[c],
+			offset: int64(offset),
+			size:   int64(file.Len() - offset),
+		})
+	}
+
+	var footer compactWriter
+	footer.fileMetaData(columns, chunks, len(rows))
+	file.Write(footer.Bytes())
+	binary.Write(&file, binary.LittleEndian, uint32(footer.Len()))
+	file.WriteString(magic)
+	_, err := w.Write(file.Bytes())
+	return err
+}
+
+// A columnChunk describes where a column is stored in the file.
+type columnChunk struct {
+	name   string
+	offset int64 // of the data page
+	size   int64 // of the data page, including its header
+}
+
+// Values of the Parquet enums used by this package.
+const (
+	typeByteArray      = 6
+	repetitionRequired = 0
+	convertedTypeUTF8  = 0
+	encodingPlain      = 0
+	encodingRLE        = 3
+	codecUncompressed  = 0
+	pageTypeData       = 0
+)
+
+// fileMetaData writes the FileMetaData struct in the file's footer.
+func (w *compactWriter) fileMetaData(columns []string, chunks []columnChunk, numRows int) {
+	w.beginStruct()
+	w.i32Field(1, 1)                           // version
+	w.listField(2, typeStruct, len(columns)+1) // schema
+	// The root of the schema.
+	w.beginStruct()
+	w.binaryField(4, "schema")
+	w.i32Field(5, int32(len(columns))) // num_children
+	w.endStruct()
+	for _, name := range columns {
+		w.beginStruct()
+		w.i32Field(1, typeByteArray)
+		w.i32Field(3, repetitionRequired)
+		w.binaryField(4, name)
+		w.i32Field(6, convertedTypeUTF8)
+		// logicalType is a union; STRING is its first member,
+		// an empty struct.
+		w.fieldHeader(10, typeStruct)
+		w.beginStruct()
+		w.fieldHeader(1, typeStruct)
+		w.beginStruct()
+		w.endStruct()
+		w.endStruct()
+		w.endStruct()
+	}
+	w.i64Field(3, int64(numRows))
+	if numRows == 0 {
+		w.listField(4, typeStruct, 0) // row_groups
+	} else {
+		w.listField(4, typeStruct, 1)
+		w.rowGroup(chunks, numRows)
+	}
+	w.binaryField(6, CreatedBy)
+	w.endStruct()
+}
+
+func (w *compactWriter) rowGroup(chunks []columnChunk, numRows int) {
+	var total int64
+	for _, c := range chunks {
+		total += c.size
+	}
+	w.beginStruct()
+	w.listField(1, typeStruct, len(chunks)) // columns
+	for _, c := range chunks {
+		w.beginStruct()
+		w.i64Field(2, c.offset) // file_offset
+		w.fieldHeader(3, typeStruct)
+		w.beginStruct() // meta_data
+		w.i32Field(1, typeByteArray)
+		w.listField(2, typeI32, 2) // encodings
+		w.varint(zigzag(encodingPlain))
+		w.varint(zigzag(encodingRLE))
+		w.listField(3, typeBinary, 1) // path_in_schema
+		w.binary(c.name)
+		w.i32Field(4, codecUncompressed)
+		w.i64Field(5, int64(numRows))
+		w.i64Field(6, c.size) // total_uncompressed_size
+		w.i64Field(7, c.size) // total_compressed_size
+		w.i64Field(9, c.offset)
+		w.endStruct()
+		w.endStruct()
+	}
+	w.i64Field(2, total) // total_byte_size
+	w.i64Field(3, int64(numRows))
+	w.endStruct()
+}
+
+// pageHeader writes the PageHeader struct of a data page.
+func (w *compactWriter) pageHeader(numValues, size int) {
+	w.beginStruct()
+	w.i32Field(1, pageTypeData)
+	w.i32Field(2, int32(size)) // uncompressed_page_size
+	w.i32Field(3, int32(size)) // compressed_page_size
+	w.fieldHeader(5, typeStruct)
+	w.beginStruct() // data_page_header
+	w.i32Field(1, int32(numValues))
+	w.i32Field(2, encodingPlain)
+	w.i32Field(3, encodingRLE) // definition_level_encoding
+	w.i32Field(4, encodingRLE) // repetition_level_encoding
+	w.endStruct()
+	w.endStruct()
+}
+
+// Types of the Thrift compact protocol.
+const (
+	typeI32    = 5
+	typeI64    = 6
+	typeBinary = 8
+	typeList   = 9
+	typeStruct = 12
+)
+
+// A compactWriter encodes Thrift structs with the compact protocol,
+// in which Parquet's metadata is serialized.
+//
+// https://github.com/apache/thrift/blob/master/doc/specs/thrift-compact-protocol.md
+// describes the protocol.
+type compactWriter struct {
+	bytes.Buffer
+	// The ID of the last field written in each enclosing struct.
+	lastIDs []int
+}
+
+func (w *compactWriter) beginStruct() {
+	w.lastIDs = append(w.lastIDs, 0)
+}
+
+func (w *compactWriter) endStruct() {
+	w.WriteByte(0) // stop field
+	w.lastIDs = w.lastIDs[:len(w.lastIDs)-1]
+}
+
+func (w *compactWriter) fieldHeader(id int, typ byte) {
+	last := &w.lastIDs[len(w.lastIDs)-1]
+	if delta := id - *last; delta > 0 && delta <= 15 {
+		w.WriteByte(byte(delta)<<4 | typ)
+	} else {
+		w.WriteByte(typ)
+		w.varint(zigzag(int64(id)))
+	}
+	*last = id
+}
+
+func (w *compactWriter) i32Field(id int, v int32) {
+	w.fieldHeader(id, typeI32)
+	w.varint(zigzag(int64(v)))
+}
+
+func (w *compactWriter) i64Field(id int, v int64) {
+	w.fieldHeader(id, typeI64)
+	w.varint(zigzag(v))
+}
+
+func (w *compactWriter) binaryField(id int, s string) {
+	w.fieldHeader(id, typeBinary)
+	w.binary(s)
+}
+
+// listField writes the header of a list field, which must be
+// followed by its n elements.
+func (w *compactWriter) listField(id int, elemType byte, n int) {
+	w.fieldHeader(id, typeList)
+	if n < 15 {
+		w.WriteByte(byte(n)<<4 | elemType)
+	} else {
+		w.WriteByte(0xf0 | elemType)
+		w.varint(uint64(n))
+	}
+}
+
+func (w *compactWriter) binary(s string) {
+	w.varint(uint64(len(s)))
+	w.WriteString(s)
+}
+
+func (w *compactWriter) varint(v uint64) {
+	w.Write(binary.AppendUvarint(nil, v))
+}
+
+func zigzag(v int64) uint64 {
+	return uint64(v<<1) ^ uint64(v>>63)
+}
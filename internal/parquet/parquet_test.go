@@ -0,0 +1,197 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package parquet
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestWrite(t *testing.T) {
+	columns := []string{"id", "module"}
+	for _, tc := range []struct {
+		name string
+		rows [][]string
+	}{
+		{
+			name: "rows",
+			rows: [][]string{
+				{"GO-1999-0001", "example.com/a"},
+				{"GO-1999-0002", ""},
+				{"GO-1999-0003", "example.com/ü"},
+			},
+		},
+		{
+			name: "no rows",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := Write(&buf, columns, tc.rows); err != nil {
+				t.Fatal(err)
+			}
+			got, err := read(buf.Bytes())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.rows, got); diff != "" {
+				t.Errorf("mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestWriteError(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Write(&buf, []string{"a", "b"}, [][]string{{"1"}}); err == nil {
+		t.Error("Write() with a short row succeeded, want error")
+	}
+}
+
+// read returns the rows of a file written by Write, checking its
+// metadata along the way.
+func read(b []byte) ([][]string, error) {
+	if len(b) < 12 || string(b[:4]) != magic || string(b[len(b)-4:]) != magic {
+		return nil, fmt.Errorf("missing magic")
+	}
+	n := int(binary.LittleEndian.Uint32(b[len(b)-8:]))
+	r := &compactReader{b: b[len(b)-8-n : len(b)-8]}
+	meta := r.readStruct()
+	if r.err != nil {
+		return nil, r.err
+	}
+	schema := meta[2].([]any)
+	var names []string
+	for _, e := range schema[1:] {
+		e := e.(map[int]any)
+		if e[1] != int64(typeByteArray) || e[3] != int64(repetitionRequired) {
+			return nil, fmt.Errorf("column %v: unexpected type or repetition", e[4])
+		}
+		names = append(names, e[4].(string))
+	}
+	if schema[0].(map[int]any)[5] != int64(len(names)) {
+		return nil, fmt.Errorf("wrong num_children")
+	}
+	numRows := int(meta[3].(int64))
+	groups := meta[4].([]any)
+	if numRows == 0 {
+		if len(groups) != 0 {
+			return nil, fmt.Errorf("got %d row groups for no rows", len(groups))
+		}
+		return nil, nil
+	}
+
+	rows := make([][]string, numRows)
+	for i, c := range groups[0].(map[int]any)[1].([]any) {
+		md := c.(map[int]any)[3].(map[int]any)
+		if path := md[3].([]any); len(path) != 1 || path[0] != names[i] {
+			return nil, fmt.Errorf("column %d: got path %v, want [%s]", i, path, names[i])
+		}
+		offset := int(md[9].(int64))
+		pr := &compactReader{b: b[offset:]}
+		header := pr.readStruct()
+		if pr.err != nil {
+			return nil, pr.err
+		}
+		if header[5].(map[int]any)[1] != int64(numRows) {
+			return nil, fmt.Errorf("column %d: wrong number of values", i)
+		}
+		data := pr.b[:header[3].(int64)]
+		for j := range rows {
+			n := binary.LittleEndian.Uint32(data)
+			rows[j] = append(rows[j], string(data[4:4+n]))
+			data = data[4+n:]
+		}
+	}
+	return rows, nil
+}
+
+// A compactReader decodes Thrift structs written with the compact
+// protocol, as maps from field IDs to values.
+type compactReader struct {
+	b   []byte
+	err error
+}
+
+func (r *compactReader) readStruct() map[int]any {
+	m := make(map[int]any)
+	last := 0
+	for r.err == nil {
+		h := r.byte()
+		if h == 0 {
+			break
+		}
+		id := last + int(h>>4)
+		if h>>4 == 0 {
+			id = int(r.zigzag())
+		}
+		m[id] = r.value(h & 0x0f)
+		last = id
+	}
+	return m
+}
+
+func (r *compactReader) value(typ byte) any {
+	switch typ {
+	case typeI32, typeI64:
+		return r.zigzag()
+	case typeBinary:
+		n := r.uvarint()
+		if r.err != nil || uint64(len(r.b)) < n {
+			r.err = fmt.Errorf("short binary")
+			return nil
+		}
+		s := string(r.b[:n])
+		r.b = r.b[n:]
+		return s
+	case typeList:
+		h := r.byte()
+		n := uint64(h >> 4)
+		if n == 15 {
+			n = r.uvarint()
+		}
+		var l []any
+		for i := uint64(0); i < n && r.err == nil; i++ {
+			l = append(l, r.value(h&0x0f))
+		}
+		if l == nil {
+			l = []any{}
+		}
+		return l
+	case typeStruct:
+		return r.readStruct()
+	}
+	r.err = fmt.Errorf("unexpected type %d", typ)
+	return nil
+}
+
+func (r *compactReader) byte() byte {
+	if len(r.b) == 0 {
+		r.err = fmt.Errorf("unexpected end of input")
+		return 0
+	}
+	c := r.b[0]
+	r.b = r.b[1:]
+	return c
+}
+
+func (r *compactReader) uvarint() uint64 {
+	v, n := binary.Uvarint(r.b)
+	if n <= 0 {
+		r.err = fmt.Errorf("bad varint")
+		return 0
+	}
+	r.b = r.b[n:]
+	return v
+}
+
+func (r *compactReader) zigzag() int64 {
+	v := r.uvarint()
+	return int64(v>>1) ^ -int64(v&1)
+}
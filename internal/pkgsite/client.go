@@ -7,6 +7,7 @@ package pkgsite
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -19,6 +20,8 @@ import (
 	"time"
 
 	"golang.org/x/time/rate"
+	"golang.org/x/vulndb/internal/derrors"
+	"golang.org/x/vulndb/internal/proxy"
 	"golang.org/x/vulndb/internal/stdlib"
 	"golang.org/x/vulndb/internal/worker/log"
 )
@@ -26,17 +29,62 @@ import (
 type Client struct {
 	url   string
 	cache *cache
+	// If non-nil, the module proxy to consult when pkgsite
+	// is unreachable.
+	fallback *proxy.Client
 }
 
-func Default() *Client {
-	return New(URL)
+func Default(opts ...Option) *Client {
+	return New(URL, opts...)
 }
 
-func New(url string) *Client {
-	return &Client{
+func New(url string, opts ...Option) *Client {
+	c := &Client{
 		url:   url,
 		cache: newCache(),
 	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// An Option configures a Client.
+type Option func(*Client)
+
+// WithProxyFallback configures the client to consult the module proxy
+// when pkgsite is unreachable, so that triage can continue during a
+// pkgsite outage.
+//
+// The proxy only knows about modules, not packages, and does not
+// apply pkgsite's filtering (for example, of modules with no Go
+// files), so its answers are less reliable; see LookupModule.
+func WithProxyFallback(pxc *proxy.Client) Option {
+	return func(c *Client) {
+		c.fallback = pxc
+	}
+}
+
+// Confidence is how reliable the answer to a lookup is.
+type Confidence int
+
+const (
+	// The answer came from pkgsite.
+	HighConfidence Confidence = iota
+	// pkgsite was unreachable, so the answer came from the
+	// module proxy.
+	LowConfidence
+)
+
+func (c Confidence) String() string {
+	switch c {
+	case HighConfidence:
+		return "high"
+	case LowConfidence:
+		return "low"
+	default:
+		return fmt.Sprintf("Confidence(%d)", int(c))
+	}
 }
 
 func (pc *Client) SetKnownModules(known []string) {
@@ -58,7 +106,27 @@ var URL = "https://pkg.go.dev"
 // KnownModule reports whether pkgsite knows that path actually refers
 // to a module or package path.
 func (pc *Client) KnownModule(ctx context.Context, path string) (bool, error) {
-	return pc.lookupEndpoint(ctx, moduleEndpoint(path))
+	known, _, err := pc.LookupModule(ctx, path)
+	return known, err
+}
+
+// LookupModule is like KnownModule, but also reports the confidence
+// of the answer.
+//
+// If pkgsite is unreachable and the client was created with
+// WithProxyFallback, the path is known if the module proxy has a
+// latest version or a non-empty version list for it, and the answer
+// has LowConfidence.
+func (pc *Client) LookupModule(ctx context.Context, path string) (bool, Confidence, error) {
+	known, err := pc.lookupEndpoint(ctx, moduleEndpoint(path))
+	if err == nil {
+		return known, HighConfidence, nil
+	}
+	if pc.fallback == nil || !errors.Is(err, errUnreachable) || ctx.Err() != nil {
+		return false, HighConfidence, err
+	}
+	log.Warningf(ctx, "pkgsite is unreachable (%v); asking the module proxy about %s", err, path)
+	return pc.fallback.ModuleExists(path), LowConfidence, nil
 }
 
 // KnownAtVersion reports whether pkgsite knows that the path exists at the given
@@ -84,6 +152,9 @@ func (pc *Client) lookupEndpoint(ctx context.Context, endpoint string) (bool, er
 
 	start := time.Now()
 	res, err := http.Head(pc.url + endpoint)
+	if err == nil {
+		res.Body.Close()
+	}
 	var status string
 	if err == nil {
 		status = strconv.Quote(res.Status)
@@ -94,7 +165,12 @@ func (pc *Client) lookupEndpoint(ctx context.Context, endpoint string) (bool, er
 		"error", err,
 	).Debugf(ctx, "checked if %s is known to pkgsite", endpoint)
 	if err != nil {
-		return false, err
+		return false, fmt.Errorf("%w: %v", errUnreachable, err)
+	}
+	// A server error says nothing about the endpoint,
+	// so don't cache it.
+	if res.StatusCode >= http.StatusInternalServerError {
+		return false, fmt.Errorf("%w: HEAD %s returned status %s", errUnreachable, endpoint, res.Status)
 	}
 
 	known := res.StatusCode == http.StatusOK
@@ -102,6 +178,85 @@ func (pc *Client) lookupEndpoint(ctx context.Context, endpoint string) (bool, er
 	return known, nil
 }
 
+// Metadata is what pkgsite knows about a package or module at its
+// latest version.
+type Metadata struct {
+	// Path is the package (or module) path.
+	Path string `json:"path"`
+	// ModulePath is the path of the module containing the package.
+	ModulePath string `json:"modulePath"`
+	// LatestVersion is the latest version of the module, e.g. "v1.2.3".
+	LatestVersion string `json:"moduleVersion"`
+	// Name is the package name, or "" for a module
+	// with no package at its root.
+	Name string `json:"name"`
+	// Deprecated reports whether the latest go.mod file of the
+	// module has a deprecation comment, which is DeprecationComment.
+	Deprecated         bool   `json:"deprecated"`
+	DeprecationComment string `json:"deprecationComment"`
+	// Licenses are the license types detected in the module,
+	// e.g. "MIT".
+	Licenses []string `json:"licenses"`
+}
+
+// IsCommand reports whether the package is a command (package main).
+func (m *Metadata) IsCommand() bool {
+	return m.Name == "main"
+}
+
+// Metadata returns what pkgsite knows about the package or module
+// path at its latest version, from pkgsite's JSON API. It returns
+// nil and no error if pkgsite doesn't know path.
+//
+// Unlike LookupModule, Metadata does not fall back to the module
+// proxy, which only knows about versions.
+func (pc *Client) Metadata(ctx context.Context, path string) (_ *Metadata, err error) {
+	defer derrors.Wrap(&err, "pkgsite.Metadata(%q)", path)
+
+	if m, ok := pc.cache.lookupMetadata(path); ok {
+		return m, nil
+	}
+	if err := pkgsiteRateLimiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, pc.url+metadataEndpoint(path), nil)
+	if err != nil {
+		return nil, err
+	}
+	start := time.Now()
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errUnreachable, err)
+	}
+	defer res.Body.Close()
+	log.With(
+		"latency", time.Since(start),
+		"status", strconv.Quote(res.Status),
+	).Debugf(ctx, "fetched pkgsite metadata of %s", path)
+	switch {
+	case res.StatusCode == http.StatusNotFound:
+		pc.cache.addMetadata(path, nil)
+		return nil, nil
+	case res.StatusCode >= http.StatusInternalServerError:
+		return nil, fmt.Errorf("%w: GET returned status %s", errUnreachable, res.Status)
+	case res.StatusCode != http.StatusOK:
+		return nil, fmt.Errorf("GET returned status %s", res.Status)
+	}
+	var m Metadata
+	if err := json.NewDecoder(res.Body).Decode(&m); err != nil {
+		return nil, err
+	}
+	pc.cache.addMetadata(path, &m)
+	return &m, nil
+}
+
+func metadataEndpoint(path string) string {
+	return "/v1/package/" + path
+}
+
+// errUnreachable indicates that pkgsite could not answer a request.
+var errUnreachable = errors.New("pkgsite unreachable")
+
 func (pc *Client) URL() string {
 	return pc.url
 }
@@ -207,12 +362,16 @@ type cache struct {
 	seen map[string]bool
 	// Does the cache contain all known endpoints
 	complete bool
+	// Metadata already fetched, by path; nil if
+	// pkgsite doesn't know the path.
+	metadata map[string]*Metadata
 }
 
 func newCache() *cache {
 	return &cache{
 		seen:     make(map[string]bool),
 		complete: false,
+		metadata: make(map[string]*Metadata),
 	}
 }
 
@@ -255,3 +414,18 @@ func (c *cache) add(endpoint string, known bool) {
 
 	c.seen[endpoint] = known
 }
+
+func (c *cache) lookupMetadata(path string) (m *Metadata, ok bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	m, ok = c.metadata[path]
+	return m, ok
+}
+
+func (c *cache) addMetadata(path string, m *Metadata) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.metadata[path] = m
+}
@@ -6,8 +6,15 @@ package pkgsite
 
 import (
 	"context"
+	"errors"
 	"flag"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/vulndb/internal/proxy"
 )
 
 var usePkgsite = flag.Bool("pkgsite", false, "use pkg.go.dev for tests")
@@ -70,3 +77,105 @@ func TestKnownParallel(t *testing.T) {
 		})
 	}
 }
+
+func TestLookupModuleProxyFallback(t *testing.T) {
+	ctx := context.Background()
+
+	down := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+	}))
+	t.Cleanup(down.Close)
+	prx := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/golang.org/x/mod/@latest" {
+			http.Error(w, "not found", http.StatusNotFound)
+			return
+		}
+		fmt.Fprint(w, `{"Version":"v0.20.0"}`)
+	}))
+	t.Cleanup(prx.Close)
+
+	t.Run("no fallback", func(t *testing.T) {
+		pc := New(down.URL)
+		if _, _, err := pc.LookupModule(ctx, "golang.org/x/mod"); err == nil {
+			t.Error("got no error, want error")
+		}
+	})
+
+	pc := New(down.URL, WithProxyFallback(proxy.NewClient(http.DefaultClient, prx.URL)))
+	for _, test := range []struct {
+		in   string
+		want bool
+	}{
+		{in: "golang.org/x/mod", want: true},
+		{in: "github.com/something/something", want: false},
+	} {
+		t.Run(test.in, func(t *testing.T) {
+			got, conf, err := pc.LookupModule(ctx, test.in)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != test.want || conf != LowConfidence {
+				t.Errorf("LookupModule(%q) = (%t, %s), want (%t, %s)", test.in, got, conf, test.want, LowConfidence)
+			}
+		})
+	}
+}
+
+func TestMetadata(t *testing.T) {
+	ctx := context.Background()
+
+	requests := 0
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		switch r.URL.Path {
+		case "/v1/package/example.com/m/cmd/tool":
+			fmt.Fprint(w, `{"path": "example.com/m/cmd/tool", "modulePath": "example.com/m",
+  "moduleVersion": "v1.2.3", "name": "main", "deprecated": true,
+  "deprecationComment": "use example.com/m/v2", "licenses": ["MIT"]}`)
+		case "/v1/package/example.com/down":
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+		default:
+			http.Error(w, "not found", http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(s.Close)
+	pc := New(s.URL)
+
+	got, err := pc.Metadata(ctx, "example.com/m/cmd/tool")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &Metadata{
+		Path:               "example.com/m/cmd/tool",
+		ModulePath:         "example.com/m",
+		LatestVersion:      "v1.2.3",
+		Name:               "main",
+		Deprecated:         true,
+		DeprecationComment: "use example.com/m/v2",
+		Licenses:           []string{"MIT"},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("mismatch (-want, +got):\n%s", diff)
+	}
+	if !got.IsCommand() {
+		t.Error("IsCommand() = false, want true")
+	}
+
+	if got, err := pc.Metadata(ctx, "example.com/unknown"); err != nil || got != nil {
+		t.Errorf("unknown path: got (%v, %v), want (nil, nil)", got, err)
+	}
+	if _, err := pc.Metadata(ctx, "example.com/down"); !errors.Is(err, errUnreachable) {
+		t.Errorf("server error: got %v, want errUnreachable", err)
+	}
+
+	// Answers are cached.
+	before := requests
+	for _, path := range []string{"example.com/m/cmd/tool", "example.com/unknown"} {
+		if _, err := pc.Metadata(ctx, path); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if requests != before {
+		t.Errorf("made %d requests for cached answers", requests-before)
+	}
+}
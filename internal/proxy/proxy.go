@@ -7,10 +7,13 @@
 package proxy
 
 import (
+	"archive/zip"
+	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"maps"
 	"net/http"
 	"os"
 	urlpath "path"
@@ -27,6 +30,10 @@ import (
 
 // Client is a client for reading from the proxy.
 //
+// Like the go command, it accepts a list of proxies (as in GOPROXY)
+// and tries them in turn, and it never looks up private modules
+// (as in GONOPROXY and GOPRIVATE).
+//
 // It uses a simple in-memory cache that does not expire,
 // which is acceptable because we use this Client in a short-lived
 // context (~1 day at most, in the case of the worker, and a few seconds
@@ -34,35 +41,144 @@ import (
 // not change often enough to be a problem for our use cases.
 type Client struct {
 	*http.Client
-	url    string
-	cache  *cache
-	errLog *errLog // for testing
+	proxies []proxyEntry
+	// Patterns of the module path prefixes of private modules,
+	// in the comma-separated form of GOPRIVATE.
+	private string
+	cache   *cache
+	errLog  *errLog // for testing
+}
+
+// A proxyEntry is an element of a proxy list.
+type proxyEntry struct {
+	url string
+	// Whether to fall back to the next proxy after any error,
+	// rather than only after a 404 Not Found or 410 Gone.
+	fallBackOnError bool
 }
 
-func NewClient(c *http.Client, url string) *Client {
-	return &Client{
-		Client: c,
-		url:    url,
-		cache:  newCache(),
-		errLog: newErrLog(),
+// NewClient returns a client for the proxies in list, which is in the
+// form of GOPROXY: proxy URLs separated by commas (to fall back to the
+// next proxy only if a proxy responds with 404 Not Found or 410 Gone)
+// or pipes (to fall back after any error). As the client cannot
+// download modules directly, "direct" ends the list, as does "off",
+// which disables lookups altogether.
+func NewClient(c *http.Client, list string, opts ...Option) *Client {
+	pc := &Client{
+		Client:  c,
+		proxies: parseProxyList(list),
+		cache:   newCache(),
+		errLog:  newErrLog(),
+	}
+	for _, opt := range opts {
+		opt(pc)
+	}
+	return pc
+}
+
+// An Option configures a Client.
+type Option func(*Client)
+
+// WithPrivate returns an option to never look up the modules whose
+// paths match patterns, which are glob patterns of module path
+// prefixes separated by commas, as in GOPRIVATE (for example,
+// "*.corp.example.com,example.com/private"). Lookups for such modules
+// fail with an error wrapping ErrPrivateModule.
+func WithPrivate(patterns string) Option {
+	return func(c *Client) {
+		c.private = patterns
 	}
 }
 
 const ProxyURL = "https://proxy.golang.org"
 
+// NewDefaultClient returns a client for the proxies in GOPROXY
+// (or proxy.golang.org if it is unset) that skips the private
+// modules in GONOPROXY, or, if that is unset, GOPRIVATE.
 func NewDefaultClient() *Client {
 	proxyURL := ProxyURL
 	if proxy, ok := os.LookupEnv("GOPROXY"); ok {
 		proxyURL = proxy
 	}
-	return NewClient(http.DefaultClient, proxyURL)
+	private, ok := os.LookupEnv("GONOPROXY")
+	if !ok {
+		private = os.Getenv("GOPRIVATE")
+	}
+	return NewClient(http.DefaultClient, proxyURL, WithPrivate(private))
 }
 
+// parseProxyList parses a list of proxies in the form of GOPROXY.
+func parseProxyList(list string) []proxyEntry {
+	var entries []proxyEntry
+	for list != "" {
+		var (
+			url     string
+			onError bool
+		)
+		if i := strings.IndexAny(list, ",|"); i >= 0 {
+			url, onError, list = list[:i], list[i] == '|', list[i+1:]
+		} else {
+			url, list = list, ""
+		}
+		url = strings.TrimSpace(url)
+		switch url {
+		case "":
+			continue
+		case "direct", "off":
+			return entries
+		}
+		entries = append(entries, proxyEntry{url: strings.TrimSuffix(url, "/"), fallBackOnError: onError})
+	}
+	return entries
+}
+
+var (
+	// ErrPrivateModule indicates that a module was not looked up
+	// because it is private.
+	ErrPrivateModule = errors.New("module is private")
+
+	errNoProxy = errors.New("no proxy to look up modules in (GOPROXY is off, direct or empty)")
+)
+
+// IsPrivate reports whether the module at path is private,
+// and so is never looked up. A nil Client has no private modules.
+func (c *Client) IsPrivate(path string) bool {
+	return c != nil && c.private != "" && module.MatchPrefixPatterns(c.private, path)
+}
+
+// checkPrivate returns an error wrapping ErrPrivateModule
+// if the module at path is private.
+func (c *Client) checkPrivate(path string) error {
+	if c.IsPrivate(path) {
+		return fmt.Errorf("%s: %w", path, ErrPrivateModule)
+	}
+	return nil
+}
+
+// lookup returns the response of the first proxy that has urlSuffix,
+// falling back to the next proxy according to the proxy list.
 func (c *Client) lookup(urlSuffix string) ([]byte, error) {
-	url := fmt.Sprintf("%s/%s", c.url, urlSuffix)
 	if b, found := c.cache.get(urlSuffix); found {
 		return b, nil
 	}
+	err := errNoProxy
+	for _, p := range c.proxies {
+		var b []byte
+		b, err = c.lookupIn(p.url, urlSuffix)
+		if err == nil {
+			c.cache.set(urlSuffix, b)
+			return b, nil
+		}
+		if !p.fallBackOnError && !isNotFound(err) {
+			return nil, err
+		}
+	}
+	return nil, err
+}
+
+// lookupIn returns the response of the proxy at proxyURL for urlSuffix.
+func (c *Client) lookupIn(proxyURL, urlSuffix string) ([]byte, error) {
+	url := fmt.Sprintf("%s/%s", proxyURL, urlSuffix)
 	req, err := http.NewRequest(http.MethodGet, url, nil)
 	if err != nil {
 		return nil, err
@@ -74,17 +190,41 @@ func (c *Client) lookup(urlSuffix string) ([]byte, error) {
 	defer resp.Body.Close()
 	if resp.StatusCode != 200 {
 		c.errLog.set(urlSuffix, resp.StatusCode)
-		return nil, fmt.Errorf("HTTP GET /%s returned status %v", urlSuffix, resp.Status)
+		return nil, &httpError{urlSuffix: urlSuffix, status: resp.Status, code: resp.StatusCode}
 	}
-	b, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, err
-	}
-	c.cache.set(urlSuffix, b)
-	return b, nil
+	return io.ReadAll(resp.Body)
+}
+
+// An httpError is returned by lookup when the proxy responds
+// with a status other than 200 OK.
+type httpError struct {
+	urlSuffix string
+	status    string
+	code      int
+}
+
+func (e *httpError) Error() string {
+	return fmt.Sprintf("HTTP GET /%s returned status %v", e.urlSuffix, e.status)
+}
+
+// isGone reports whether err is a 410 Gone response from the proxy.
+func isGone(err error) bool {
+	var he *httpError
+	return errors.As(err, &he) && he.code == http.StatusGone
+}
+
+// isNotFound reports whether err is a 404 Not Found or 410 Gone
+// response from the proxy, after which the go command falls back
+// to the next proxy in a comma-separated list.
+func isNotFound(err error) bool {
+	var he *httpError
+	return errors.As(err, &he) && (he.code == http.StatusNotFound || he.code == http.StatusGone)
 }
 
 func (c *Client) list(path string) ([]byte, error) {
+	if err := c.checkPrivate(path); err != nil {
+		return nil, err
+	}
 	escaped, err := module.EscapePath(path)
 	if err != nil {
 		return nil, err
@@ -93,6 +233,9 @@ func (c *Client) list(path string) ([]byte, error) {
 }
 
 func (c *Client) latest(path string) ([]byte, error) {
+	if err := c.checkPrivate(path); err != nil {
+		return nil, err
+	}
 	escaped, err := module.EscapePath(path)
 	if err != nil {
 		return nil, err
@@ -100,7 +243,21 @@ func (c *Client) latest(path string) ([]byte, error) {
 	return c.lookup(fmt.Sprintf("%s/@latest", escaped))
 }
 
+func (c *Client) zip(path string, ver string) ([]byte, error) {
+	if err := c.checkPrivate(path); err != nil {
+		return nil, err
+	}
+	ep, ev, err := escapePathAndVersion(path, ver)
+	if err != nil {
+		return nil, err
+	}
+	return c.lookup(fmt.Sprintf("%s/@v/%v.zip", ep, ev))
+}
+
 func (c *Client) info(path string, ver string) ([]byte, error) {
+	if err := c.checkPrivate(path); err != nil {
+		return nil, err
+	}
 	// module.Check does not accept commit hash versions,
 	// but the proxy does (for "info" requests).
 	if !version.IsCommitHash(ver) {
@@ -116,6 +273,9 @@ func (c *Client) info(path string, ver string) ([]byte, error) {
 }
 
 func (c *Client) mod(path string, ver string) ([]byte, error) {
+	if err := c.checkPrivate(path); err != nil {
+		return nil, err
+	}
 	if err := module.Check(path, vv(ver)); err != nil {
 		return nil, err
 	}
@@ -280,6 +440,108 @@ func (c *Client) ModuleExists(path string) bool {
 	return true
 }
 
+var (
+	// ErrModuleGone indicates that the proxy no longer serves a module
+	// (for example, because it was deleted from its origin and removed
+	// from the proxy).
+	ErrModuleGone = errors.New("module is gone from the proxy (410 Gone)")
+
+	// ErrModuleCaseCollision indicates that a module path resolves to
+	// a module whose go.mod declares a path that differs only in case,
+	// which happens when a module is renamed or taken over by another
+	// module with a case-insensitively equal path.
+	ErrModuleCaseCollision = errors.New("module path differs in case from the path in its go.mod")
+)
+
+// CheckModuleResolves returns an error wrapping ErrModuleGone if the
+// proxy has stopped serving the module at path, or wrapping
+// ErrModuleCaseCollision if the go.mod at the module's latest version
+// declares a path that is equal to path only when compared
+// case-insensitively.
+//
+// Other errors (for example, if path was never a module)
+// are returned unwrapped.
+func (c *Client) CheckModuleResolves(path string) (err error) {
+	defer derrors.Wrap(&err, "CheckModuleResolves(%s)", path)
+
+	v, err := c.Latest(path)
+	if err != nil {
+		if isGone(err) {
+			return ErrModuleGone
+		}
+		// Fall back to the version list, as in ModuleExists.
+		vs, lerr := c.Versions(path)
+		if isGone(lerr) {
+			return ErrModuleGone
+		}
+		if lerr != nil || len(vs) == 0 {
+			return err
+		}
+		v = vs[len(vs)-1]
+	}
+	canonical, err := c.CanonicalModulePath(path, v)
+	if err != nil {
+		if isGone(err) {
+			return ErrModuleGone
+		}
+		return err
+	}
+	if canonical != path && strings.EqualFold(canonical, path) {
+		return fmt.Errorf("%w: go.mod at v%s declares %s", ErrModuleCaseCollision, v, canonical)
+	}
+	return nil
+}
+
+// Packages returns the import paths of the packages in the module at
+// path at the given version, sorted, according to the module's zip.
+// A package is a directory with a non-test .go file; the zip already
+// omits vendor directories and nested modules.
+func (c *Client) Packages(path, ver string) (_ []string, err error) {
+	defer derrors.Wrap(&err, "Packages(%s, %s)", path, ver)
+
+	b, err := c.zip(path, ver)
+	if err != nil {
+		return nil, err
+	}
+	zr, err := zip.NewReader(bytes.NewReader(b), int64(len(b)))
+	if err != nil {
+		return nil, err
+	}
+	prefix := path + "@" + vv(ver) + "/"
+	dirs := make(map[string]bool)
+	for _, f := range zr.File {
+		name, ok := strings.CutPrefix(f.Name, prefix)
+		if !ok || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+		dir := urlpath.Dir(name)
+		if isIgnoredDir(dir) {
+			continue
+		}
+		if dir == "." {
+			dirs[path] = true
+		} else {
+			dirs[path+"/"+dir] = true
+		}
+	}
+	return slices.Sorted(maps.Keys(dirs)), nil
+}
+
+// isIgnoredDir reports whether the go command ignores the directory
+// dir (relative to a module root) when matching packages: if any of its
+// elements starts with "." or "_", or is "testdata".
+func isIgnoredDir(dir string) bool {
+	if dir == "." {
+		return false
+	}
+	for _, elem := range strings.Split(dir, "/") {
+		if strings.HasPrefix(elem, ".") || strings.HasPrefix(elem, "_") || elem == "testdata" {
+			return true
+		}
+	}
+	return false
+}
+
 // A simple in-memory cache that never expires.
 type cache struct {
 	data map[string][]byte
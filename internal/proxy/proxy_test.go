@@ -8,6 +8,8 @@ import (
 	"errors"
 	"flag"
 	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -338,6 +340,104 @@ func TestModuleExists(t *testing.T) {
 	}
 }
 
+func TestCheckModuleResolves(t *testing.T) {
+	c, err := NewTestClient(t, *realProxy)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tcs := []struct {
+		name    string
+		path    string
+		wantErr error // nil for no error
+		wantAny bool  // some other error
+	}{
+		{
+			name: "resolves",
+			path: "golang.org/x/vulndb",
+		},
+		{
+			// A path that differs by more than case is
+			// not a collision.
+			name: "non-canonical",
+			path: "github.com/golang/vulndb",
+		},
+		{
+			name:    "gone",
+			path:    "example.com/deleted",
+			wantErr: ErrModuleGone,
+		},
+		{
+			name:    "case collision",
+			path:    "github.com/Sirupsen/logrus",
+			wantErr: ErrModuleCaseCollision,
+		},
+		{
+			name:    "does not exist",
+			path:    "example.com/not/a/module",
+			wantAny: true,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			err := c.CheckModuleResolves(tc.path)
+			switch {
+			case tc.wantErr != nil:
+				if !errors.Is(err, tc.wantErr) {
+					t.Errorf("CheckModuleResolves() = %v, want %v", err, tc.wantErr)
+				}
+			case tc.wantAny:
+				if err == nil || errors.Is(err, ErrModuleGone) || errors.Is(err, ErrModuleCaseCollision) {
+					t.Errorf("CheckModuleResolves() = %v, want other error", err)
+				}
+			case err != nil:
+				t.Errorf("CheckModuleResolves() = %v, want nil", err)
+			}
+		})
+	}
+}
+
+func TestPackages(t *testing.T) {
+	// The responses for TestPackages are hand-written zips
+	// for a fake module, so don't update them.
+	c, err := NewTestClient(t, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range []struct {
+		version string
+		want    []string
+		wantErr bool
+	}{
+		{
+			version: "1.0.0",
+			// Test-only packages, testdata and directories
+			// starting with "." or "_" are not packages.
+			want: []string{"example.com/mod", "example.com/mod/b"},
+		},
+		{
+			version: "1.1.0",
+			want:    []string{"example.com/mod", "example.com/mod/d"},
+		},
+		{
+			version: "1.2.0",
+			wantErr: true,
+		},
+	} {
+		t.Run(tc.version, func(t *testing.T) {
+			got, err := c.Packages("example.com/mod", tc.version)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("Packages() error = %v, want error: %t", err, tc.wantErr)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Packages() mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestCacheAndErrors(t *testing.T) {
 	okEndpoint, notFoundEndpoint := "endpoint", "not/found"
 	okResponse := "response"
@@ -377,3 +477,88 @@ func TestCacheAndErrors(t *testing.T) {
 		t.Errorf("Responses() unexpected diff (want-, got+):\n%s", diff)
 	}
 }
+
+func TestProxyList(t *testing.T) {
+	// Each server serves one endpoint, and fails the others
+	// with the given status.
+	newServer := func(endpoint string, status int) string {
+		s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/"+endpoint {
+				_, _ = w.Write([]byte(r.Host))
+				return
+			}
+			w.WriteHeader(status)
+		}))
+		t.Cleanup(s.Close)
+		return s.URL
+	}
+	notFound := newServer("a", http.StatusNotFound)
+	gone := newServer("b", http.StatusGone)
+	broken := newServer("c", http.StatusInternalServerError)
+	last := newServer("d", http.StatusNotFound)
+
+	for _, tc := range []struct {
+		list     string
+		endpoint string
+		want     string // URL of the server that should respond, or "" for an error
+	}{
+		{notFound + "," + last, "a", notFound},
+		{notFound + "," + last, "d", last},
+		{gone + "," + last, "d", last},
+		// A comma only falls back after 404 and 410.
+		{broken + "," + last, "d", ""},
+		// A pipe falls back after any error.
+		{broken + "|" + last, "d", last},
+		// The client can't download modules directly.
+		{notFound + ",direct," + last, "d", ""},
+		{"off", "a", ""},
+		{"", "a", ""},
+	} {
+		c := NewClient(http.DefaultClient, tc.list)
+		b, err := c.lookup(tc.endpoint)
+		if tc.want == "" {
+			if err == nil {
+				t.Errorf("%q: lookup(%q) = %s, want error", tc.list, tc.endpoint, b)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%q: lookup(%q): %v", tc.list, tc.endpoint, err)
+			continue
+		}
+		if got, want := string(b), strings.TrimPrefix(tc.want, "http://"); got != want {
+			t.Errorf("%q: lookup(%q) served by %s, want %s", tc.list, tc.endpoint, got, want)
+		}
+	}
+}
+
+func TestPrivate(t *testing.T) {
+	var requested []string
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = append(requested, r.URL.Path)
+		_, _ = w.Write([]byte(`{"Version":"v1.0.0"}`))
+	}))
+	t.Cleanup(s.Close)
+
+	c := NewClient(s.Client(), s.URL, WithPrivate("*.corp.example.com,example.com/private"))
+	for _, path := range []string{"git.corp.example.com/a", "example.com/private/b"} {
+		if !c.IsPrivate(path) {
+			t.Errorf("IsPrivate(%q) = false, want true", path)
+		}
+		if _, err := c.Latest(path); !errors.Is(err, ErrPrivateModule) {
+			t.Errorf("Latest(%q) = %v, want ErrPrivateModule", path, err)
+		}
+		if c.ModuleExists(path) {
+			t.Errorf("ModuleExists(%q) = true, want false", path)
+		}
+	}
+	if c.IsPrivate("example.com/public") {
+		t.Error(`IsPrivate("example.com/public") = true, want false`)
+	}
+	if _, err := c.Latest("example.com/public"); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{"/example.com/public/@latest"}, requested); diff != "" {
+		t.Errorf("requests mismatch (-want, +got):\n%s", diff)
+	}
+}
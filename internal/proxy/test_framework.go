@@ -12,6 +12,7 @@ import (
 	"path/filepath"
 	"sync"
 	"testing"
+	"unicode/utf8"
 )
 
 // NewTestClient creates a new client for testing.
@@ -59,7 +60,10 @@ func NewTestClient(t *testing.T, update bool) (*Client, error) {
 // response is a representation of an HTTP response used to
 // facilitate testing.
 type response struct {
-	Body       string `json:"body,omitempty"`
+	Body string `json:"body,omitempty"`
+	// BinaryBody is the body of a response that is not valid
+	// UTF-8 (such as a module zip), stored in base64.
+	BinaryBody []byte `json:"binary_body,omitempty"`
 	StatusCode int    `json:"status_code"`
 }
 
@@ -72,6 +76,10 @@ func fakeClient(endpointsToResponses map[string]*response) (c *Client, cleanup f
 			if r.Method == http.MethodGet &&
 				r.URL.Path == "/"+endpoint {
 				if response.StatusCode == http.StatusOK {
+					if response.BinaryBody != nil {
+						_, _ = w.Write(response.BinaryBody)
+						return
+					}
 					_, _ = w.Write([]byte(response.Body))
 				} else {
 					w.WriteHeader(response.StatusCode)
@@ -99,6 +107,10 @@ func (c *Client) responses() map[string]*response {
 		m[key] = &response{StatusCode: status}
 	}
 	for key, b := range c.cache.getData() {
+		if !utf8.Valid(b) {
+			m[key] = &response{BinaryBody: b, StatusCode: http.StatusOK}
+			continue
+		}
 		m[key] = &response{Body: string(b), StatusCode: http.StatusOK}
 	}
 	return m
@@ -0,0 +1,149 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"golang.org/x/exp/maps"
+)
+
+// AliasStatus is the database's coverage of a CVE or GHSA.
+type AliasStatus string
+
+const (
+	// A report in the database covers the alias.
+	AliasCovered AliasStatus = "covered"
+	// The alias's only reports that are not excluded are withdrawn.
+	AliasWithdrawn AliasStatus = "withdrawn"
+	// The alias's only reports are excluded, for the given reasons.
+	AliasExcluded AliasStatus = "excluded"
+	// The database has no report for the alias.
+	AliasUnknown AliasStatus = "unknown"
+)
+
+// An AliasResolution is the database's coverage of a CVE or GHSA.
+type AliasResolution struct {
+	Alias  string      `json:"alias"`
+	Status AliasStatus `json:"status"`
+	// IDs are the Go IDs of the reports for the alias, regular or excluded.
+	IDs []string `json:"ids,omitempty"`
+	// Reasons are the reasons the reports were excluded,
+	// if the status is AliasExcluded.
+	Reasons []ExcludedType `json:"reasons,omitempty"`
+}
+
+// AliasIndex is a map from CVE and GHSA IDs to their coverage by the
+// database. It marshals into and unmarshals from a JSON array of
+// AliasResolutions, sorted by alias.
+type AliasIndex map[string]*AliasResolution
+
+// AliasIndex returns the coverage of each alias of the
+// reports in the client.
+func (c *Client) AliasIndex() AliasIndex {
+	idx := make(AliasIndex)
+	for alias, fs := range c.byAlias {
+		var rs []*Report
+		for _, f := range fs {
+			rs = append(rs, f.Report)
+		}
+		idx[alias] = resolveAlias(alias, rs)
+	}
+	return idx
+}
+
+func resolveAlias(alias string, rs []*Report) *AliasResolution {
+	res := &AliasResolution{Alias: alias, Status: AliasUnknown}
+	var covered, withdrawn bool
+	for _, r := range rs {
+		res.IDs = append(res.IDs, r.ID)
+		switch {
+		case r.IsExcluded():
+			if !slices.Contains(res.Reasons, r.Excluded) {
+				res.Reasons = append(res.Reasons, r.Excluded)
+			}
+		case r.Withdrawn != nil:
+			withdrawn = true
+		default:
+			covered = true
+		}
+	}
+	slices.Sort(res.IDs)
+	res.IDs = slices.Compact(res.IDs)
+	switch {
+	case covered:
+		res.Status = AliasCovered
+	case withdrawn:
+		res.Status = AliasWithdrawn
+	case len(res.Reasons) > 0:
+		res.Status = AliasExcluded
+		slices.Sort(res.Reasons)
+	}
+	if res.Status != AliasExcluded {
+		res.Reasons = nil
+	}
+	return res
+}
+
+// Resolve returns the coverage of each of the given CVE or GHSA IDs,
+// in the order given. IDs are matched case-insensitively and may have
+// surrounding space. IDs that are not in the index (including ones
+// that are not CVE or GHSA IDs) have status AliasUnknown.
+func (idx AliasIndex) Resolve(aliases ...string) []*AliasResolution {
+	var results []*AliasResolution
+	for _, a := range aliases {
+		a = normalizeAlias(a)
+		if res, ok := idx[a]; ok {
+			results = append(results, res)
+		} else {
+			results = append(results, &AliasResolution{Alias: a, Status: AliasUnknown})
+		}
+	}
+	return results
+}
+
+// normalizeAlias returns alias in the canonical case used in
+// reports: "CVE-YYYY-NNNN" and "GHSA-xxxx-xxxx-xxxx".
+func normalizeAlias(alias string) string {
+	alias = strings.TrimSpace(alias)
+	switch u := strings.ToUpper(alias); {
+	case strings.HasPrefix(u, "CVE-"):
+		return u
+	case strings.HasPrefix(u, "GHSA-"):
+		return "GHSA-" + strings.ToLower(alias[len("GHSA-"):])
+	}
+	return alias
+}
+
+func (idx AliasIndex) MarshalJSON() ([]byte, error) {
+	results := maps.Values(idx)
+	if results == nil {
+		results = []*AliasResolution{}
+	}
+	slices.SortFunc(results, func(a, b *AliasResolution) int {
+		return strings.Compare(a.Alias, b.Alias)
+	})
+	return json.Marshal(results)
+}
+
+func (idx *AliasIndex) UnmarshalJSON(data []byte) error {
+	var results []*AliasResolution
+	if err := json.Unmarshal(data, &results); err != nil {
+		return err
+	}
+	if *idx == nil {
+		*idx = make(AliasIndex)
+	}
+	for _, res := range results {
+		if _, ok := (*idx)[res.Alias]; ok {
+			return fmt.Errorf("alias %q appears twice in alias index", res.Alias)
+		}
+		(*idx)[res.Alias] = res
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package report
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/vulndb/internal/osv"
+)
+
+func TestAliasIndex(t *testing.T) {
+	c, err := NewTestClient(map[string]*Report{
+		"data/reports/GO-1999-0001.yaml": {
+			ID:   "GO-1999-0001",
+			CVEs: []string{"CVE-1999-0001"},
+		},
+		"data/excluded/GO-1999-0002.yaml": {
+			ID:       "GO-1999-0002",
+			Excluded: ExcludedNotImportable,
+			CVEs:     []string{"CVE-1999-0001", "CVE-1999-0002"},
+		},
+		"data/reports/GO-1999-0003.yaml": {
+			ID:        "GO-1999-0003",
+			Withdrawn: &osv.Time{},
+			GHSAs:     []string{"GHSA-xxxx-yyyy-zzzz"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	idx := c.AliasIndex()
+	got := idx.Resolve("CVE-1999-0001", " cve-1999-0002", "GHSA-XXXX-YYYY-ZZZZ", "CVE-1999-0003", "not-an-id")
+	want := []*AliasResolution{
+		{Alias: "CVE-1999-0001", Status: AliasCovered, IDs: []string{"GO-1999-0001", "GO-1999-0002"}},
+		{Alias: "CVE-1999-0002", Status: AliasExcluded, IDs: []string{"GO-1999-0002"}, Reasons: []ExcludedType{ExcludedNotImportable}},
+		{Alias: "GHSA-xxxx-yyyy-zzzz", Status: AliasWithdrawn, IDs: []string{"GO-1999-0003"}},
+		{Alias: "CVE-1999-0003", Status: AliasUnknown},
+		{Alias: "not-an-id", Status: AliasUnknown},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Resolve() mismatch (-want, +got):\n%s", diff)
+	}
+
+	// The index round-trips through JSON.
+	b, err := json.Marshal(idx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var idx2 AliasIndex
+	if err := json.Unmarshal(b, &idx2); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(idx, idx2); diff != "" {
+		t.Errorf("JSON round trip mismatch (-want, +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,48 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package report
+
+import (
+	"errors"
+	"fmt"
+
+	"golang.org/x/vulndb/internal/stdlib"
+	"golang.org/x/vulndb/internal/version"
+)
+
+var (
+	errUnreleasedMinor = errors.New("minor version has not been released")
+	errNotGoRelease    = errors.New("not a Go release")
+)
+
+// CheckReleases checks that the fixed versions of the report's
+// standard library and toolchain modules are announced Go releases,
+// according to the schedule s.
+func (r *Report) CheckReleases(s *stdlib.Schedule) (errs error) {
+	for _, m := range r.Modules {
+		if !m.IsFirstParty() {
+			continue
+		}
+		for _, v := range m.Versions {
+			if !v.IsFixed() {
+				continue
+			}
+			if err := checkRelease(v.Version, s); err != nil {
+				errs = errors.Join(errs, fmt.Errorf("%s: fixed version %s: %w", m.Module, v.Version, err))
+			}
+		}
+	}
+	return errs
+}
+
+func checkRelease(v string, s *stdlib.Schedule) error {
+	if !version.IsValid(v) || s.Released(v) {
+		return nil
+	}
+	if minor := goMinor(v); !s.MinorReleased(minor) {
+		return fmt.Errorf("%w (Go %s; latest release is %s)", errUnreleasedMinor, minor, s.Latest())
+	}
+	return errNotGoRelease
+}
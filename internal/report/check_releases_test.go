@@ -0,0 +1,66 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package report
+
+import (
+	"errors"
+	"testing"
+
+	"golang.org/x/vulndb/internal/stdlib"
+)
+
+func TestCheckReleases(t *testing.T) {
+	s := stdlib.NewSchedule("1.21.0", "1.21.8", "1.22.0", "1.22.1")
+	for _, tc := range []struct {
+		name    string
+		m       *Module
+		wantErr error
+	}{
+		{
+			name: "ok",
+			m: &Module{
+				Module:   stdlib.ModulePath,
+				Versions: Versions{Introduced("1.21.0-0"), Fixed("1.21.8"), Introduced("1.22.0-0"), Fixed("1.22.1")},
+			},
+		},
+		{
+			name: "ok: third party",
+			m: &Module{
+				Module:   "example.com/module",
+				Versions: Versions{Fixed("1.23.0")},
+			},
+		},
+		{
+			name: "not a release",
+			m: &Module{
+				Module:   stdlib.ToolchainModulePath,
+				Versions: Versions{Fixed("1.22.5")},
+			},
+			wantErr: errNotGoRelease,
+		},
+		{
+			name: "unreleased minor",
+			m: &Module{
+				Module:   stdlib.ModulePath,
+				Versions: Versions{Fixed("1.22.1"), Introduced("1.23.0-0"), Fixed("1.23.0")},
+			},
+			wantErr: errUnreleasedMinor,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			r := &Report{Modules: []*Module{tc.m}}
+			err := r.CheckReleases(s)
+			if tc.wantErr == nil {
+				if err != nil {
+					t.Errorf("CheckReleases() = %v, want nil", err)
+				}
+				return
+			}
+			if !errors.Is(err, tc.wantErr) {
+				t.Errorf("CheckReleases() = %v, want %v", err, tc.wantErr)
+			}
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"path"
 	"path/filepath"
 	"slices"
 	"strings"
@@ -16,7 +17,8 @@ import (
 	"github.com/go-git/go-git/v5/plumbing/object"
 	"golang.org/x/exp/maps"
 	"golang.org/x/vulndb/internal/gitrepo"
-	"gopkg.in/yaml.v3"
+	"golang.org/x/vulndb/internal/osvutils"
+	"golang.org/x/vulndb/internal/version"
 )
 
 var (
@@ -29,6 +31,10 @@ var (
 	ExcludedDir = filepath.Join(dataFolder, excludedFolder)
 
 	cve5Dir = filepath.Join(dataFolder, "cve", "v5")
+
+	// CVE4Dir is the name of the directory in the vulndb repo that
+	// contains legacy CVE JSON 4.0 records.
+	CVE4Dir = filepath.Join(dataFolder, "cve", "v4")
 )
 
 const (
@@ -41,6 +47,13 @@ type Client struct {
 	byIssue  map[int]*Report
 	byAlias  map[string][]*File
 	byModule map[string][]*File
+	// byCommit maps commit hashes in references to files.
+	byCommit map[string][]*File
+	// bySymbol maps qualified symbol names to files.
+	bySymbol map[string][]*File
+	// index maps words to the filenames of the reports that
+	// contain them, with a score for each report. See Search.
+	index map[string]map[string]int
 }
 
 // NewClient returns a Client for accessing the reports in
@@ -99,6 +112,10 @@ type Xrefs struct {
 	Aliases map[string][]*File
 	// map from modules to files
 	Modules map[string][]*File
+	// map from commit hashes in references to files
+	Commits map[string][]*File
+	// map from qualified symbol names to files
+	Symbols map[string][]*File
 }
 
 func fprintMap(out io.Writer, m map[string][]*File) {
@@ -121,29 +138,45 @@ func fprintMap(out io.Writer, m map[string][]*File) {
 	}
 }
 
+// ToString returns a human-readable summary of the cross-references.
+// Shared aliases and fix commits are listed as possible duplicates
+// under aliasTitle; shared modules and symbols are listed under
+// moduleTitle.
 func (xs *Xrefs) ToString(aliasTitle, moduleTitle, noneMessage string) string {
-	if len(xs.Modules) == 0 && len(xs.Aliases) == 0 {
+	dupes := mergeFiles(xs.Aliases, xs.Commits)
+	related := mergeFiles(xs.Modules, xs.Symbols)
+	if len(related) == 0 && len(dupes) == 0 {
 		return noneMessage
 	}
 
 	out := &strings.Builder{}
 
-	if len(xs.Aliases) != 0 {
+	if len(dupes) != 0 {
 		fmt.Fprint(out, aliasTitle+"\n")
-		fprintMap(out, xs.Aliases)
-		if len(xs.Modules) != 0 {
+		fprintMap(out, dupes)
+		if len(related) != 0 {
 			fmt.Fprintf(out, "\n")
 		}
 	}
 
-	if len(xs.Modules) != 0 {
+	if len(related) != 0 {
 		fmt.Fprint(out, moduleTitle+"\n")
-		fprintMap(out, xs.Modules)
+		fprintMap(out, related)
 	}
 
 	return out.String()
 }
 
+func mergeFiles(ms ...map[string][]*File) map[string][]*File {
+	merged := make(map[string][]*File)
+	for _, m := range ms {
+		for k, v := range m {
+			merged[k] = append(merged[k], v...)
+		}
+	}
+	return merged
+}
+
 type File struct {
 	Filename string
 	IssNum   int
@@ -155,6 +188,8 @@ func (c *Client) XRef(r *Report) *Xrefs {
 	x := &Xrefs{
 		Aliases: make(map[string][]*File),
 		Modules: make(map[string][]*File),
+		Commits: make(map[string][]*File),
+		Symbols: make(map[string][]*File),
 	}
 
 	for _, alias := range r.Aliases() {
@@ -170,11 +205,32 @@ func (c *Client) XRef(r *Report) *Xrefs {
 		if m.IsFirstParty() {
 			continue
 		}
-		for _, f := range c.byModule[m.Module] {
+		for _, path := range m.paths() {
+			for _, f := range c.byModule[path] {
+				if r.ID == f.Report.ID {
+					continue
+				}
+				x.Modules[path] = append(x.Modules[path], f)
+			}
+		}
+	}
+
+	for _, hash := range r.commitHashes() {
+		for _, f := range c.commitFiles(hash) {
+			if r.ID == f.Report.ID {
+				continue
+			}
+			key := "commit " + hash
+			x.Commits[key] = append(x.Commits[key], f)
+		}
+	}
+
+	for _, sym := range r.qualifiedSymbols() {
+		for _, f := range c.bySymbol[sym] {
 			if r.ID == f.Report.ID {
 				continue
 			}
-			x.Modules[m.Module] = append(x.Modules[m.Module], f)
+			x.Symbols[sym] = append(x.Symbols[sym], f)
 		}
 	}
 
@@ -215,6 +271,114 @@ func (c *Client) ReportsByModule(module string) []*Report {
 	return rs
 }
 
+// ReportsFixedBetween returns the reports in vulndb, sorted by ID,
+// that are fixed in the given module (e.g., "std") by an upgrade from
+// version from to version to: the reports that are neither excluded
+// nor withdrawn, and that have a fixed version v for the module such
+// that from < v <= to.
+//
+// from and to are semantic versions, which may have a "v" or "go"
+// prefix, like "v1.2.0" or "go1.21.0" (see version.Normalize).
+func (c *Client) ReportsFixedBetween(module, from, to string) ([]*Report, error) {
+	from, to = version.Normalize(from), version.Normalize(to)
+	var rs []*Report
+	seen := make(map[*Report]bool)
+	for _, f := range c.byModule[module] {
+		r := f.Report
+		if seen[r] || r.IsExcluded() || r.Withdrawn != nil {
+			continue
+		}
+		seen[r] = true
+		fixed, err := r.fixedBetween(module, from, to)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", f.Filename, err)
+		}
+		if fixed {
+			rs = append(rs, r)
+		}
+	}
+	slices.SortFunc(rs, func(a, b *Report) int { return strings.Compare(a.ID, b.ID) })
+	return rs, nil
+}
+
+// fixedBetween reports whether r has a fixed version for the
+// given module after from and at or before to.
+func (r *Report) fixedBetween(module, from, to string) (bool, error) {
+	for _, m := range r.Modules {
+		if !slices.Contains(m.paths(), module) {
+			continue
+		}
+		ranges, err := m.Versions.ToSemverRanges()
+		if err != nil {
+			return false, err
+		}
+		fixed, err := osvutils.FixedBetween(ranges, from, to)
+		if err != nil {
+			return false, err
+		}
+		if len(fixed) > 0 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// ReportsByCommit returns a list of reports in vulndb with a reference
+// to the given commit. The commit may be given as a (possibly abbreviated)
+// hash, or as a URL containing one, such as
+// "https://github.com/owner/repo/commit/<hash>".
+func (c *Client) ReportsByCommit(commit string) []*Report {
+	hash, ok := commitHash(commit)
+	if !ok {
+		return nil
+	}
+	var rs []*Report
+	for _, f := range c.commitFiles(hash) {
+		rs = append(rs, f.Report)
+	}
+	slices.SortFunc(rs, func(a, b *Report) int { return strings.Compare(a.ID, b.ID) })
+	return rs
+}
+
+// commitFiles returns the files, sorted by name, of the reports that
+// reference the commit with the given hash, either in full or
+// abbreviated: a hash matches the hashes it is a prefix of, and
+// the ones that are prefixes of it.
+func (c *Client) commitFiles(hash string) []*File {
+	var fs []*File
+	seen := make(map[*File]bool)
+	for h, hfs := range c.byCommit {
+		if !strings.HasPrefix(h, hash) && !strings.HasPrefix(hash, h) {
+			continue
+		}
+		for _, f := range hfs {
+			if !seen[f] {
+				seen[f] = true
+				fs = append(fs, f)
+			}
+		}
+	}
+	slices.SortFunc(fs, func(a, b *File) int { return strings.Compare(a.Filename, b.Filename) })
+	return fs
+}
+
+// ReportsBySymbol returns a list of reports in vulndb that list
+// the given symbol as vulnerable. The symbol may be unqualified ("Type.Method"),
+// qualified by the package name ("pkg.Type.Method"), or qualified by the
+// full package path ("example.com/pkg.Type.Method").
+func (c *Client) ReportsBySymbol(symbol string) []*Report {
+	var rs []*Report
+	seen := make(map[*Report]bool)
+	for _, f := range c.bySymbol[symbol] {
+		if !seen[f.Report] {
+			seen[f.Report] = true
+			rs = append(rs, f.Report)
+		}
+	}
+	slices.SortFunc(rs, func(a, b *Report) int { return strings.Compare(a.ID, b.ID) })
+	return rs
+}
+
 // AliasHasReport returns whether the given alias exists in vulndb.
 func (c *Client) AliasHasReport(alias string) bool {
 	_, ok := c.byAlias[alias]
@@ -227,6 +391,9 @@ func newClient() *Client {
 		byFile:   make(map[string]*Report),
 		byAlias:  make(map[string][]*File),
 		byModule: make(map[string][]*File),
+		byCommit: make(map[string][]*File),
+		bySymbol: make(map[string][]*File),
+		index:    make(map[string]map[string]int),
 	}
 }
 
@@ -245,12 +412,12 @@ func (c *Client) addReports(repo *git.Repository) error {
 		if err != nil {
 			return err
 		}
-		var r Report
-		if err := yaml.Unmarshal([]byte(content), &r); err != nil {
-			return err
+		r, err := Unmarshal([]byte(content))
+		if err != nil {
+			return fmt.Errorf("%s: %w", f.Name, err)
 		}
 
-		return c.addReport(f.Name, &r)
+		return c.addReport(f.Name, r)
 	})
 }
 
@@ -277,8 +444,80 @@ func (c *Client) addReport(filename string, r *Report) error {
 		c.byAlias[alias] = append(c.byAlias[alias], f)
 	}
 	for _, m := range r.Modules {
-		c.byModule[m.Module] = append(c.byModule[m.Module], f)
+		for _, path := range m.paths() {
+			c.byModule[path] = append(c.byModule[path], f)
+		}
+	}
+	for _, hash := range r.commitHashes() {
+		c.byCommit[hash] = append(c.byCommit[hash], f)
+	}
+	for _, sym := range r.symbolKeys() {
+		c.bySymbol[sym] = append(c.bySymbol[sym], f)
 	}
+	c.addToIndex(filename, r)
 
 	return nil
 }
+
+// commitHashes returns the commit hashes appearing in the
+// report's reference URLs.
+func (r *Report) commitHashes() (hashes []string) {
+	for _, ref := range r.References {
+		if hash, ok := commitHash(ref.URL); ok && !slices.Contains(hashes, hash) {
+			hashes = append(hashes, hash)
+		}
+	}
+	return hashes
+}
+
+// commitHash returns the commit hash in s, which may be a hash
+// or a URL of a commit, e.g. "https://github.com/a/b/commit/<hash>"
+// or "https://go.googlesource.com/go/+/<hash>".
+func commitHash(s string) (string, bool) {
+	isHash := func(s string) bool {
+		return len(s) >= 7 && len(s) <= 40 && version.IsCommitHash(s)
+	}
+	s = strings.ToLower(s)
+	if isHash(s) {
+		return s, true
+	}
+	s, _, _ = strings.Cut(s, "#")
+	s, _, _ = strings.Cut(s, "?")
+	parts := strings.Split(strings.TrimSuffix(s, "/"), "/")
+	for i := 0; i < len(parts)-1; i++ {
+		switch parts[i] {
+		case "commit", "commits", "+":
+			if h := parts[i+1]; isHash(h) {
+				return h, true
+			}
+		}
+	}
+	return "", false
+}
+
+// qualifiedSymbols returns the vulnerable symbols in the report,
+// qualified by their full package path.
+func (r *Report) qualifiedSymbols() (syms []string) {
+	for _, m := range r.Modules {
+		for _, p := range m.Packages {
+			for _, s := range p.AllSymbols() {
+				syms = append(syms, p.Package+"."+s)
+			}
+		}
+	}
+	return syms
+}
+
+// symbolKeys returns all the names by which a vulnerable symbol
+// in the report can be looked up.
+func (r *Report) symbolKeys() (keys []string) {
+	for _, m := range r.Modules {
+		for _, p := range m.Packages {
+			for _, s := range p.AllSymbols() {
+				keys = append(keys, p.Package+"."+s, path.Base(p.Package)+"."+s, s)
+			}
+		}
+	}
+	slices.Sort(keys)
+	return slices.Compact(keys)
+}
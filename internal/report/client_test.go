@@ -12,6 +12,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"golang.org/x/vulndb/internal/gitrepo"
+	"golang.org/x/vulndb/internal/osv"
 )
 
 var (
@@ -114,6 +115,8 @@ func TestXRef(t *testing.T) {
 			wantXrefs: &Xrefs{
 				Aliases: map[string][]*File{},
 				Modules: map[string][]*File{},
+				Commits: map[string][]*File{},
+				Symbols: map[string][]*File{},
 			},
 		},
 		{
@@ -127,6 +130,8 @@ func TestXRef(t *testing.T) {
 			wantXrefs: &Xrefs{
 				Aliases: map[string][]*File{},
 				Modules: map[string][]*File{},
+				Commits: map[string][]*File{},
+				Symbols: map[string][]*File{},
 			},
 		},
 		{
@@ -144,6 +149,8 @@ func TestXRef(t *testing.T) {
 					},
 				},
 				Modules: map[string][]*File{},
+				Commits: map[string][]*File{},
+				Symbols: map[string][]*File{},
 			},
 		},
 		{
@@ -160,6 +167,8 @@ func TestXRef(t *testing.T) {
 						{Filename: fname6, IssNum: 6, Report: &r6},
 					},
 				},
+				Commits: map[string][]*File{},
+				Symbols: map[string][]*File{},
 			},
 		},
 	}
@@ -191,6 +200,149 @@ func TestReportsByAliases(t *testing.T) {
 	}
 }
 
+func TestReportsByCommitAndSymbol(t *testing.T) {
+	const hash = "0123456789abcdef0123456789abcdef01234567"
+	ra := &Report{
+		ID: "GO-9999-0010",
+		Modules: []*Module{{
+			Module: "example.com/mod",
+			Packages: []*Package{{
+				Package: "example.com/mod/pkg",
+				Symbols: []string{"Parse"},
+			}},
+		}},
+		References: []*Reference{
+			{Type: osv.ReferenceTypeFix, URL: "https://github.com/example/mod/commit/" + hash},
+		},
+	}
+	rb := &Report{
+		ID: "GO-9999-0011",
+		Modules: []*Module{{
+			Module: "example.com/mod",
+			Packages: []*Package{{
+				Package: "example.com/mod/pkg",
+				// Listing a symbol twice doesn't list the report twice.
+				Symbols:        []string{"Parse"},
+				DerivedSymbols: []string{"Parse"},
+			}},
+		}},
+	}
+	// rs cites the same commit as ra, by its abbreviated hash.
+	rs := &Report{
+		ID: "GO-9999-0012",
+		References: []*Reference{
+			{Type: osv.ReferenceTypeFix, URL: "https://github.com/example/mod/commit/" + hash[:7]},
+		},
+	}
+	rc, err := NewTestClient(map[string]*Report{
+		"data/reports/GO-9999-0010.yaml": ra,
+		"data/reports/GO-9999-0011.yaml": rb,
+		"data/reports/GO-9999-0012.yaml": rs,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, commit := range []string{
+		hash,
+		hash[:7],
+		"https://github.com/example/mod/commit/" + hash,
+		"https://go.googlesource.com/mod/+/" + hash[:12] + "?tab=diff",
+	} {
+		if diff := cmp.Diff([]*Report{ra, rs}, rc.ReportsByCommit(commit)); diff != "" {
+			t.Errorf("ReportsByCommit(%q) mismatch (-want, +got): %s", commit, diff)
+		}
+	}
+	for _, commit := range []string{"fedcba9", "https://go.dev/cl/12345", "123"} {
+		if got := rc.ReportsByCommit(commit); len(got) != 0 {
+			t.Errorf("ReportsByCommit(%q) = %v, want none", commit, got)
+		}
+	}
+
+	for _, sym := range []string{"Parse", "pkg.Parse", "example.com/mod/pkg.Parse"} {
+		if diff := cmp.Diff([]*Report{ra, rb}, rc.ReportsBySymbol(sym)); diff != "" {
+			t.Errorf("ReportsBySymbol(%q) mismatch (-want, +got): %s", sym, diff)
+		}
+	}
+
+	xrefs := rc.XRef(ra)
+	wantSymbols := map[string][]*File{
+		"example.com/mod/pkg.Parse": {{Filename: "data/reports/GO-9999-0011.yaml", IssNum: 11, Report: rb}},
+	}
+	if diff := cmp.Diff(wantSymbols, xrefs.Symbols); diff != "" {
+		t.Errorf("XRef().Symbols mismatch (-want, +got): %s", diff)
+	}
+	wantCommits := map[string][]*File{
+		"commit " + hash: {{Filename: "data/reports/GO-9999-0012.yaml", IssNum: 12, Report: rs}},
+	}
+	if diff := cmp.Diff(wantCommits, xrefs.Commits); diff != "" {
+		t.Errorf("XRef().Commits mismatch (-want, +got): %s", diff)
+	}
+	wantCommits = map[string][]*File{
+		"commit " + hash[:7]: {{Filename: "data/reports/GO-9999-0010.yaml", IssNum: 10, Report: ra}},
+	}
+	if diff := cmp.Diff(wantCommits, rc.XRef(rs).Commits); diff != "" {
+		t.Errorf("XRef(short hash).Commits mismatch (-want, +got): %s", diff)
+	}
+}
+
+func TestReportsFixedBetween(t *testing.T) {
+	std := func(id string, vs ...*Version) *Report {
+		return &Report{ID: id, Modules: []*Module{{Module: "std", Versions: vs}}}
+	}
+	r1 := std("GO-9999-0001", Fixed("1.20.10"), Introduced("1.21.0"), Fixed("1.21.3"))
+	r2 := std("GO-9999-0002", Fixed("1.21.5"))
+	// Fixed before the window.
+	r3 := std("GO-9999-0003", Fixed("1.21.0"))
+	// Not fixed.
+	r4 := std("GO-9999-0004", Introduced("1.21.0"))
+	r5 := std("GO-9999-0005", Fixed("1.21.1"))
+	r5.Withdrawn = &osv.Time{Time: time.Now()}
+	r6 := &Report{
+		ID: "GO-9999-0006",
+		Modules: []*Module{{
+			Module:      "example.com/mod",
+			FormerPaths: []string{"github.com/old/mod"},
+			Versions:    Versions{Introduced("1.2.0"), Fixed("1.3.1")},
+		}},
+	}
+	rc, err := NewTestClient(map[string]*Report{
+		"data/reports/GO-9999-0001.yaml": r1,
+		"data/reports/GO-9999-0002.yaml": r2,
+		"data/reports/GO-9999-0003.yaml": r3,
+		"data/reports/GO-9999-0004.yaml": r4,
+		"data/reports/GO-9999-0005.yaml": r5,
+		"data/reports/GO-9999-0006.yaml": r6,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, test := range []struct {
+		module, from, to string
+		want             []*Report
+	}{
+		{"std", "go1.21.0", "go1.21.5", []*Report{r1, r2}},
+		{"std", "go1.21", "1.21.4", []*Report{r1}},
+		{"std", "1.21.5", "1.22.0", nil},
+		{"example.com/mod", "v1.2.0", "v1.4.0", []*Report{r6}},
+		{"example.com/mod", "v1.3.1", "v1.4.0", nil},
+		{"github.com/old/mod", "v1.2.0", "v1.4.0", []*Report{r6}},
+	} {
+		got, err := rc.ReportsFixedBetween(test.module, test.from, test.to)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(test.want, got); diff != "" {
+			t.Errorf("ReportsFixedBetween(%s, %s, %s) mismatch (-want, +got): %s", test.module, test.from, test.to, diff)
+		}
+	}
+
+	if _, err := rc.ReportsFixedBetween("std", "latest", "1.21.5"); err == nil {
+		t.Error("ReportsFixedBetween(std, latest, 1.21.5): got nil error, want error")
+	}
+}
+
 func TestAliasHasReport(t *testing.T) {
 	repo, err := gitrepo.ReadTxtarRepo(txtarFile, time.Now())
 	if err != nil {
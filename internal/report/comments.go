@@ -0,0 +1,135 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package report
+
+import (
+	"gopkg.in/yaml.v3"
+)
+
+// YAML comments are not part of a report's content, but triagers use
+// them to annotate reports, for example:
+//
+//	modules:
+//	    - module: goauthentik.io
+//	      # Module deleted/moved, no longer responding to requests
+//	      skip_lint: true
+//
+// So that rewriting a report (as vulnreport fix does) doesn't lose
+// them, the YAML document of a report that has comments is kept in
+// Report.Comments when it is read, and its comments are copied to
+// the same places in the new document when the report is encoded.
+//
+// A comment is in the same place if it is on the same mapping key,
+// or on the same sequence item: items are matched by their first
+// value (for example, "module: goauthentik.io" or "fix: URL"), so
+// comments follow items that are reordered. Comments on keys or
+// items that were removed are dropped.
+
+// commentsOf returns the YAML document doc if it has any comments,
+// and nil otherwise.
+func commentsOf(doc *yaml.Node) *yaml.Node {
+	if hasComments(doc) {
+		return doc
+	}
+	return nil
+}
+
+func hasComments(n *yaml.Node) bool {
+	if n.HeadComment != "" || n.LineComment != "" || n.FootComment != "" {
+		return true
+	}
+	for _, c := range n.Content {
+		if hasComments(c) {
+			return true
+		}
+	}
+	return false
+}
+
+// withComments returns the YAML document with root node n, with
+// the comments of the document from (if not nil) copied to it.
+func withComments(n *yaml.Node, from *yaml.Node) *yaml.Node {
+	doc := &yaml.Node{Kind: yaml.DocumentNode, Content: []*yaml.Node{n}}
+	if from == nil || from.Kind != yaml.DocumentNode || len(from.Content) == 0 {
+		return doc
+	}
+	copyComment(doc, from)
+	copyComments(n, from.Content[0])
+	return doc
+}
+
+// copyComments copies the comments of the node from, and of the nodes
+// below it, to the matching nodes below to.
+func copyComments(to, from *yaml.Node) {
+	copyComment(to, from)
+	if to.Kind != from.Kind {
+		return
+	}
+	switch to.Kind {
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(from.Content); i += 2 {
+			j, v := mappingValue(to, from.Content[i].Value)
+			if v == nil {
+				continue
+			}
+			copyComment(to.Content[j-1], from.Content[i])
+			copyComments(v, from.Content[i+1])
+		}
+	case yaml.SequenceNode:
+		used := make([]bool, len(to.Content))
+		for i, f := range from.Content {
+			if j := matchItem(to.Content, used, f, i); j >= 0 {
+				used[j] = true
+				copyComments(to.Content[j], f)
+			}
+		}
+	}
+}
+
+// matchItem returns the index of the unused item of items that
+// matches the sequence item n at index i, or -1 if there is none.
+func matchItem(items []*yaml.Node, used []bool, n *yaml.Node, i int) int {
+	id := itemID(n)
+	if id == "" {
+		// Items with no identity can only be matched by position.
+		if i < len(items) && !used[i] && itemID(items[i]) == "" {
+			return i
+		}
+		return -1
+	}
+	for j, item := range items {
+		if !used[j] && itemID(item) == id {
+			return j
+		}
+	}
+	return -1
+}
+
+// itemID returns the identity of a sequence item: its value, if
+// it is a scalar, or its first key and value if it is a mapping
+// whose first value is a scalar.
+func itemID(n *yaml.Node) string {
+	switch n.Kind {
+	case yaml.ScalarNode:
+		return n.Value
+	case yaml.MappingNode:
+		if len(n.Content) >= 2 && n.Content[1].Kind == yaml.ScalarNode {
+			return n.Content[0].Value + ": " + n.Content[1].Value
+		}
+	}
+	return ""
+}
+
+func copyComment(to, from *yaml.Node) {
+	if to.HeadComment == "" {
+		to.HeadComment = from.HeadComment
+	}
+	if to.LineComment == "" {
+		to.LineComment = from.LineComment
+	}
+	if to.FootComment == "" {
+		to.FootComment = from.FootComment
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package report
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/vulndb/internal/osv"
+)
+
+func TestCommentsRoundTrip(t *testing.T) {
+	const in = `# Triaged by hand; see the notes below.
+id: GO-0000-0000
+modules:
+    - module: golang.org/x/text
+      # The tags before v0.3.0 are broken.
+      versions:
+        - fixed: 0.3.8
+    - module: example.com/m # renamed to example.com/n
+      skip_lint: true
+summary: A summary
+references:
+    - web: https://example.com/removed # dead link
+    - fix: https://example.com/fix
+    # The advisory is the best description.
+    - advisory: https://example.com/advisory
+review_status: REVIEWED
+`
+	// The modules and references are reordered by Normalize, and
+	// a reference is removed: the comments must follow the items
+	// they are on, or be dropped with them.
+	const want = `# Triaged by hand; see the notes below.
+id: GO-0000-0000
+modules:
+    - module: example.com/m # renamed to example.com/n
+      skip_lint: true
+    - module: golang.org/x/text
+      # The tags before v0.3.0 are broken.
+      versions:
+        - fixed: 0.3.8
+summary: A summary
+references:
+    # The advisory is the best description.
+    - advisory: https://example.com/advisory
+    - fix: https://example.com/fix
+    - report: https://example.com/report
+review_status: REVIEWED
+`
+	r, err := decodeStrict(strings.NewReader(in))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Comments == nil {
+		t.Fatal("Comments = nil, want comments")
+	}
+	r.References = append(r.References[1:], &Reference{Type: osv.ReferenceTypeReport, URL: "https://example.com/report"})
+	r.Normalize()
+	got, err := r.ToString()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("mismatch (-want, +got):\n%s", diff)
+	}
+}
+
+func TestCommentsNone(t *testing.T) {
+	// A '#' that is not a comment.
+	const in = `id: GO-0000-0000
+summary: 'Injection via #cgo directives'
+`
+	r, err := decodeStrict(strings.NewReader(in))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Comments != nil {
+		t.Errorf("Comments = %v, want nil", r.Comments)
+	}
+	got, err := r.ToString()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != in {
+		t.Errorf("ToString() = %q, want %q", got, in)
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package report
+
+import (
+	"fmt"
+	"slices"
+
+	"golang.org/x/vulndb/internal/proxy"
+	"golang.org/x/vulndb/internal/version/interval"
+)
+
+// A VersionCompression describes the compression of the version
+// ranges of a module by CompressVersions.
+type VersionCompression struct {
+	Module string
+	// Before and After are the number of ranges before
+	// and after the compression.
+	Before, After int
+	// Empty are the ranges (after the compression) that contain
+	// no version of the module known to the proxy, so they
+	// imply versions that never existed.
+	Empty []interval.Interval
+}
+
+// CompressVersions replaces the version ranges of m with as few ranges
+// as possible that affect the same versions of m known to the proxy:
+// overlapping and adjacent ranges are merged, and so are ranges that
+// are only separated by versions that were never published (which
+// happens when a fix is backported to many branches, some of which
+// never had a release with the fix).
+//
+// It returns nil if m has no versions, or is in the standard library
+// or toolchain (which the proxy does not serve).
+func (m *Module) CompressVersions(pc *proxy.Client) (*VersionCompression, error) {
+	if len(m.Versions) == 0 || m.IsFirstParty() || pc.IsPrivate(m.Module) {
+		return nil, nil
+	}
+	affected, err := moduleAffected(m)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", m.Module, err)
+	}
+	published, err := pc.Versions(m.Module)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", m.Module, err)
+	}
+	c := &VersionCompression{Module: m.Module, Before: countRanges(m.Versions)}
+	compressed := mergeUnpublishedGaps(affected, published)
+	for _, i := range compressed {
+		if !slices.ContainsFunc(published, i.Contains) {
+			c.Empty = append(c.Empty, i)
+		}
+	}
+	vs := versionsFromSet(compressed)
+	c.After = countRanges(vs)
+	if c.After < c.Before {
+		m.Versions = vs
+	}
+	return c, nil
+}
+
+// mergeUnpublishedGaps merges the consecutive intervals of s that are
+// only separated by versions that are not in published.
+func mergeUnpublishedGaps(s interval.Set, published []string) interval.Set {
+	var merged []interval.Interval
+	for _, i := range s {
+		if n := len(merged); n > 0 {
+			gap := interval.Interval{Introduced: merged[n-1].Fixed, Fixed: i.Introduced}
+			if !slices.ContainsFunc(published, gap.Contains) {
+				merged[n-1].Fixed = i.Fixed
+				continue
+			}
+		}
+		merged = append(merged, i)
+	}
+	return merged
+}
+
+// versionsFromSet returns the versions of a report that affect
+// the versions in s.
+func versionsFromSet(s []interval.Interval) Versions {
+	var vs Versions
+	for _, i := range s {
+		if i.Introduced != "" {
+			vs = append(vs, Introduced(i.Introduced))
+		}
+		if i.Fixed != "" {
+			vs = append(vs, Fixed(i.Fixed))
+		}
+	}
+	return vs
+}
+
+// countRanges returns the number of ranges of vs: the number of
+// introduced versions, plus one if vs starts with a fixed version.
+func countRanges(vs Versions) int {
+	n := 0
+	for i, v := range vs {
+		if v.IsIntroduced() || (i == 0 && v.IsFixed()) {
+			n++
+		}
+	}
+	return n
+}
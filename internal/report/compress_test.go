@@ -0,0 +1,91 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package report
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/vulndb/internal/proxy"
+	"golang.org/x/vulndb/internal/version/interval"
+)
+
+func TestCompressVersions(t *testing.T) {
+	// The responses are hand-written version lists
+	// for fake modules, so don't update them.
+	pc, err := proxy.NewTestClient(t, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tc := range []struct {
+		name         string
+		m            *Module
+		want         *VersionCompression
+		wantVersions Versions
+	}{
+		{
+			name: "compressed",
+			m: &Module{
+				Module: "example.com/branches",
+				Versions: Versions{
+					// 1.0.2 was never published, so this merges with the next range.
+					Fixed("1.0.2"),
+					Introduced("1.1.0"), Fixed("1.1.1"),
+					// These touch.
+					Introduced("1.2.0"), Fixed("1.2.1"),
+					Introduced("1.2.1"), Fixed("1.2.5"),
+					// No 3.x versions were published.
+					Introduced("3.0.0"), Fixed("3.0.1"),
+				},
+			},
+			want: &VersionCompression{
+				Module: "example.com/branches",
+				Before: 5,
+				After:  3,
+				Empty:  []interval.Interval{{Introduced: "3.0.0", Fixed: "3.0.1"}},
+			},
+			wantVersions: Versions{
+				Fixed("1.1.1"),
+				Introduced("1.2.0"), Fixed("1.2.5"),
+				Introduced("3.0.0"), Fixed("3.0.1"),
+			},
+		},
+		{
+			name: "not_compressed",
+			m: &Module{
+				Module:   "example.com/tight",
+				Versions: Versions{Introduced("1.0.0"), Fixed("1.1.0"), Introduced("1.2.0")},
+			},
+			want: &VersionCompression{
+				Module: "example.com/tight",
+				Before: 2,
+				After:  2,
+			},
+			wantVersions: Versions{Introduced("1.0.0"), Fixed("1.1.0"), Introduced("1.2.0")},
+		},
+		{
+			name: "stdlib",
+			m: &Module{
+				Module:   "std",
+				Versions: Versions{Fixed("1.21.1"), Introduced("1.22.0-0"), Fixed("1.22.1")},
+			},
+			want:         nil,
+			wantVersions: Versions{Fixed("1.21.1"), Introduced("1.22.0-0"), Fixed("1.22.1")},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := tc.m.CompressVersions(pc)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("CompressVersions() mismatch (-want, +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantVersions, tc.m.Versions); diff != "" {
+				t.Errorf("versions mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
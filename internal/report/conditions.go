@@ -0,0 +1,131 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package report
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"golang.org/x/vulndb/internal/osv"
+)
+
+// A Condition is a precondition for exploiting a vulnerability.
+// Programs that do not meet it are not affected, so vulnerability
+// scanners can use it to justify suppressing a finding.
+type Condition struct {
+	Type ConditionType `yaml:",omitempty"`
+	// Value is the configuration, API or GODEBUG setting required,
+	// depending on Type.
+	Value string `yaml:",omitempty"`
+	// Description optionally explains the condition further.
+	Description string `yaml:",omitempty"`
+}
+
+// ConditionType is the kind of a Condition.
+//
+// It must be one of the values in ConditionTypes.
+type ConditionType string
+
+const (
+	// The program must be configured in a specific way, described by
+	// the value (for example, "HTTP/2 is enabled on the server").
+	ConditionConfig ConditionType = "CONFIG"
+	// An attacker must control the input to an API, given by the value
+	// as a qualified symbol (for example, "net/http.Header.Get").
+	ConditionInput ConditionType = "INPUT"
+	// The program must run with a GODEBUG setting, given by the value
+	// (for example, "x509sha1=1").
+	ConditionGODEBUG ConditionType = "GODEBUG"
+)
+
+// ConditionTypes are the set of valid condition types.
+// These are described in detail at
+// https://go.googlesource.com/vulndb/+/refs/heads/master/doc/format.md.
+var ConditionTypes = []ConditionType{
+	ConditionConfig,
+	ConditionInput,
+	ConditionGODEBUG,
+}
+
+// IsValid reports whether c is one of the ConditionTypes.
+func (c ConditionType) IsValid() bool {
+	return slices.Contains(ConditionTypes, c)
+}
+
+// String returns a sentence describing the condition,
+// for use in descriptions.
+func (c *Condition) String() string {
+	var s string
+	switch c.Type {
+	case ConditionConfig:
+		s = fmt.Sprintf("Only programs with this configuration are affected: %s", strings.TrimSuffix(c.Value, "."))
+	case ConditionInput:
+		s = fmt.Sprintf("Exploitation requires attacker-controlled input to %s", c.Value)
+	case ConditionGODEBUG:
+		s = fmt.Sprintf("Only programs run with GODEBUG=%s are affected", c.Value)
+	default:
+		s = c.Value
+	}
+	s += "."
+	if c.Description != "" {
+		s += " " + c.Description
+	}
+	return s
+}
+
+// ConditionsText returns a paragraph describing the report's
+// conditions, to be appended to its description, or "" if it
+// has none.
+func (r *Report) ConditionsText() string {
+	if len(r.Conditions) == 0 {
+		return ""
+	}
+	var b strings.Builder
+	b.WriteString("NOTE: Not all programs using the affected symbols are vulnerable.")
+	for _, c := range r.Conditions {
+		b.WriteString("\n\n")
+		b.WriteString(c.String())
+	}
+	return b.String()
+}
+
+func (r *Report) osvConditions() []osv.Condition {
+	var cs []osv.Condition
+	for _, c := range r.Conditions {
+		cs = append(cs, osv.Condition{
+			Type:        string(c.Type),
+			Value:       c.Value,
+			Description: c.Description,
+		})
+	}
+	return cs
+}
+
+var (
+	// A GODEBUG setting, name=value.
+	godebugRegexp = regexp.MustCompile(`^[a-z0-9]+=[^\s,=]+$`)
+	// A qualified symbol: package path, then a dot and an
+	// identifier, optionally followed by a dot and a method.
+	qualifiedSymbolRegexp = regexp.MustCompile(`^[^\s]+\.[A-Za-z_][A-Za-z0-9_]*(\.[A-Za-z_][A-Za-z0-9_]*)?$`)
+)
+
+func (c *Condition) lint(l *linter) {
+	if !c.Type.IsValid() {
+		l.Group("type").Errorf("%q is not a valid condition type (accepted: %v)", c.Type, ConditionTypes)
+	}
+	vl := l.Group("value")
+	switch {
+	case c.Value == "":
+		vl.Error(missing)
+	case hasTODO(c.Value):
+		vl.Error(hasTODOErr)
+	case c.Type == ConditionGODEBUG && !godebugRegexp.MatchString(c.Value):
+		vl.Errorf("%q is not a GODEBUG setting of the form name=value", c.Value)
+	case c.Type == ConditionInput && !qualifiedSymbolRegexp.MatchString(c.Value):
+		vl.Errorf("%q is not a qualified symbol (for example, net/http.Header.Get)", c.Value)
+	}
+}
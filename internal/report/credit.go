@@ -0,0 +1,169 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package report
+
+import (
+	"fmt"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+
+	"golang.org/x/vulndb/internal/osv"
+	"gopkg.in/yaml.v3"
+)
+
+// A Credit gives credit to an individual or entity for the
+// discovery, report or fix of a vulnerability.
+//
+// In the YAML, a Credit with only a name is represented as a plain
+// string, and other credits as a mapping:
+//
+//	credits:
+//	    - Jane Doe
+//	    - name: John Doe
+//	      type: remediation-developer
+//	      contact:
+//	        - https://github.com/johndoe
+//
+// Types are written in lower case with dashes, and are otherwise
+// the OSV credit types.
+type Credit osv.Credit
+
+type credit struct {
+	Name    string   `yaml:"name"`
+	Type    string   `yaml:"type,omitempty"`
+	Contact []string `yaml:"contact,omitempty"`
+}
+
+func (c *Credit) MarshalYAML() (any, error) {
+	if c.Type == "" && len(c.Contact) == 0 {
+		return c.Name, nil
+	}
+	return &credit{
+		Name:    c.Name,
+		Type:    creditTypeToYAML(c.Type),
+		Contact: c.Contact,
+	}, nil
+}
+
+func (c *Credit) UnmarshalYAML(n *yaml.Node) error {
+	if n.Kind == yaml.ScalarNode {
+		*c = Credit{Name: n.Value}
+		return nil
+	}
+	if n.Kind != yaml.MappingNode {
+		return &yaml.TypeError{Errors: []string{
+			fmt.Sprintf("line %d: report.Credit must be a string or a mapping", n.Line),
+		}}
+	}
+	var cr credit
+	if err := n.Decode(&cr); err != nil {
+		return err
+	}
+	*c = Credit{
+		Name:    cr.Name,
+		Type:    creditTypeFromYAML(cr.Type),
+		Contact: cr.Contact,
+	}
+	return nil
+}
+
+func creditTypeToYAML(t osv.CreditType) string {
+	return strings.ReplaceAll(strings.ToLower(string(t)), "_", "-")
+}
+
+func creditTypeFromYAML(s string) osv.CreditType {
+	return osv.CreditType(strings.ReplaceAll(strings.ToUpper(s), "-", "_"))
+}
+
+func (c *Credit) lint(l *linter) {
+	if c.Name == "" {
+		l.Error("no name")
+	}
+	if c.Type != "" && !slices.Contains(osv.CreditTypes, c.Type) {
+		var types []string
+		for _, t := range osv.CreditTypes {
+			types = append(types, creditTypeToYAML(t))
+		}
+		l.Errorf("invalid type %q (must be one of: %s)", creditTypeToYAML(c.Type), strings.Join(types, ", "))
+	}
+	for _, contact := range c.Contact {
+		u, err := url.Parse(contact)
+		if err != nil || (u.Scheme != "https" && u.Scheme != "http" && u.Scheme != "mailto") {
+			l.Errorf("contact %q: must be an http(s) or mailto URL", contact)
+		}
+	}
+}
+
+func (r *Report) lintCredits(l *linter) {
+	for i, c := range r.Credits {
+		c.lint(l.Group(name("credits", i, c.Name)))
+	}
+}
+
+var (
+	// A trailing "(...)" or "<...>" annotation of a credit.
+	creditAnnotationRE = regexp.MustCompile(`^(.*\S)\s+(?:\(([^()]+)\)|<([^<>]+)>)$`)
+	githubHandleRE     = regexp.MustCompile(`^@([A-Za-z0-9](?:[A-Za-z0-9-]*[A-Za-z0-9])?)$`)
+)
+
+// ParseCredit makes a best-effort attempt to convert a legacy
+// free-form credit into a structured one.
+//
+// It recognizes GitHub handles ("@user"), and contact URLs, email
+// addresses, GitHub handles and credit types in a trailing
+// parenthesized or angle-bracketed annotation, as in
+// "Jane Doe (https://jane.example)", "Jane Doe <jane@example.com>",
+// "Jane Doe (@jane)" or "Jane Doe (reporter)". Other annotations,
+// such as affiliations, are kept as part of the name. Credits that
+// name more than one person are not split.
+func ParseCredit(s string) *Credit {
+	s = strings.TrimSpace(s)
+	c := &Credit{Name: s}
+	if m := creditAnnotationRE.FindStringSubmatch(s); m != nil {
+		annotation := strings.TrimSpace(m[2] + m[3])
+		if t := creditTypeFromYAML(annotation); slices.Contains(osv.CreditTypes, t) {
+			c.Name, c.Type = m[1], t
+		} else if contact := parseContact(annotation); contact != "" {
+			c.Name, c.Contact = m[1], []string{contact}
+		}
+	}
+	if len(c.Contact) == 0 {
+		if m := githubHandleRE.FindStringSubmatch(c.Name); m != nil {
+			c.Contact = []string{githubProfile(m[1])}
+		}
+	}
+	return c
+}
+
+// parseContact returns s as a contact URL, or "" if s is not a URL,
+// an email address or a GitHub handle.
+func parseContact(s string) string {
+	if u, err := url.Parse(s); err == nil && (u.Scheme == "https" || u.Scheme == "http") && u.Host != "" {
+		return s
+	}
+	if m := githubHandleRE.FindStringSubmatch(s); m != nil {
+		return githubProfile(m[1])
+	}
+	if a, err := mail.ParseAddress(s); err == nil && a.Address == s {
+		return "mailto:" + s
+	}
+	return ""
+}
+
+func githubProfile(user string) string {
+	return "https://github.com/" + user
+}
+
+// CreditNames returns the names of the credits of r.
+func (r *Report) CreditNames() []string {
+	var names []string
+	for _, c := range r.Credits {
+		names = append(names, c.Name)
+	}
+	return names
+}
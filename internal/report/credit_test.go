@@ -0,0 +1,72 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package report
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/vulndb/internal/osv"
+	"gopkg.in/yaml.v3"
+)
+
+func TestParseCredit(t *testing.T) {
+	for _, test := range []struct {
+		in   string
+		want *Credit
+	}{
+		{"Jane Doe", &Credit{Name: "Jane Doe"}},
+		{"@jdoe", &Credit{Name: "@jdoe", Contact: []string{"https://github.com/jdoe"}}},
+		{"Jane Doe (@jdoe)", &Credit{Name: "Jane Doe", Contact: []string{"https://github.com/jdoe"}}},
+		{"Jane Doe (https://jane.example/)", &Credit{Name: "Jane Doe", Contact: []string{"https://jane.example/"}}},
+		{"@jdoe <jane@example.com>", &Credit{Name: "@jdoe", Contact: []string{"mailto:jane@example.com"}}},
+		{"Jane Doe (reporter)", &Credit{Name: "Jane Doe", Type: osv.CreditTypeReporter}},
+		{"Jane Doe (Remediation-Developer)", &Credit{Name: "Jane Doe", Type: osv.CreditTypeRemediationDeveloper}},
+		// Affiliations and lists of people are kept as is.
+		{"Jane Doe (Example Corp)", &Credit{Name: "Jane Doe (Example Corp)"}},
+		{"Jane Doe of Example Corp", &Credit{Name: "Jane Doe of Example Corp"}},
+		{"@jdoe and @jroe", &Credit{Name: "@jdoe and @jroe"}},
+		{"Jiahua (Joe) Chen", &Credit{Name: "Jiahua (Joe) Chen"}},
+	} {
+		t.Run(test.in, func(t *testing.T) {
+			got := ParseCredit(test.in)
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreditYAML(t *testing.T) {
+	const in = `- Jane Doe
+- name: John Doe
+  type: remediation-developer
+  contact:
+    - https://github.com/johndoe
+`
+	want := []*Credit{
+		{Name: "Jane Doe"},
+		{Name: "John Doe", Type: osv.CreditTypeRemediationDeveloper, Contact: []string{"https://github.com/johndoe"}},
+	}
+	var got []*Credit
+	if err := yaml.Unmarshal([]byte(in), &got); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Unmarshal mismatch (-want, +got):\n%s", diff)
+	}
+
+	b, err := yaml.Marshal(got)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var rt []*Credit
+	if err := yaml.Unmarshal(b, &rt); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(want, rt); diff != "" {
+		t.Errorf("round trip mismatch (-want, +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package report
+
+import (
+	"regexp"
+	"strings"
+)
+
+// fixDescription returns a copy of the description s with Markdown
+// formatting removed (see stripMarkdown) and its paragraphs reflowed
+// to lines of at most n characters.
+func fixDescription(s string, n int) string {
+	return fixLineLength(stripMarkdown(s), n)
+}
+
+var (
+	// A fenced code block delimiter, e.g. "```go".
+	fenceRE = regexp.MustCompile("^(```|~~~)")
+	// An ATX heading, e.g. "## Impact ##".
+	atxHeadingRE = regexp.MustCompile(`^#{1,6}[ \t]+(.*?)(?:[ \t]+#+)?[ \t]*$`)
+	// An inline code span, e.g. "`net/http.Server`".
+	codeSpanRE = regexp.MustCompile("`+([^`]+)`+")
+	// An inline link or image, e.g. "[the fix](https://go.dev/cl/1234)".
+	mdLinkRE = regexp.MustCompile(`!?\[([^\]]+)\]\(([^)\s]+)\)`)
+	// An autolink, e.g. "<https://go.dev/issue/1234>".
+	autolinkRE = regexp.MustCompile(`<(https?://[^>\s]+)>`)
+	// Strong and emphasized text, e.g. "**not**", "*not*" or "_not_".
+	// The delimiters must be at word boundaries so that identifiers
+	// such as "*T", "snake_case" and "__init__" are left alone.
+	emphasisREs = []*regexp.Regexp{
+		regexp.MustCompile(`(^|[\s(])\*\*([^\s*](?:[^*]*?[^\s*])?)\*\*($|[\s).,;:!?])`),
+		regexp.MustCompile(`(^|[\s(])\*([^\s*](?:[^*]*?[^\s*])?)\*($|[\s).,;:!?])`),
+		regexp.MustCompile(`(^|[\s(])_([^\s_](?:[^_]*?[^\s_])?)_($|[\s).,;:!?])`),
+	}
+)
+
+// stripMarkdown returns a copy of s with Markdown formatting, which is
+// not rendered by pkg.go.dev or in the OSV entries, removed:
+//
+//   - fenced code block delimiters are removed;
+//   - headings become paragraphs of their own;
+//   - links are replaced by their text followed by the URL in parentheses;
+//   - code spans are replaced by their code, which is preserved verbatim;
+//   - strong and emphasized text lose their delimiters.
+//
+// List markers are kept, because the line breaks before them are
+// preserved when the description is reflowed.
+func stripMarkdown(s string) string {
+	var lines []string
+	for _, line := range strings.Split(s, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if fenceRE.MatchString(trimmed) {
+			continue
+		}
+		if m := atxHeadingRE.FindStringSubmatch(trimmed); m != nil {
+			lines = append(lines, "", m[1], "")
+			continue
+		}
+		lines = append(lines, line)
+	}
+	// Join the lines of each paragraph and list item first, so that
+	// formatting that was wrapped across lines is found.
+	paragraphs := strings.Split(toParagraphs(strings.Join(lines, "\n")), "\n")
+	for i, p := range paragraphs {
+		paragraphs[i] = stripInline(p)
+	}
+	return strings.Join(paragraphs, "\n")
+}
+
+// stripInline removes the inline Markdown formatting from s, without
+// changing the text of its code spans.
+func stripInline(s string) string {
+	s = mdLinkRE.ReplaceAllStringFunc(s, func(link string) string {
+		m := mdLinkRE.FindStringSubmatch(link)
+		text, url := m[1], m[2]
+		if text == url {
+			return url
+		}
+		return text + " (" + url + ")"
+	})
+	s = autolinkRE.ReplaceAllString(s, "$1")
+
+	var b strings.Builder
+	prev := 0
+	for _, loc := range codeSpanRE.FindAllStringSubmatchIndex(s, -1) {
+		b.WriteString(stripEmphasis(s[prev:loc[0]]))
+		b.WriteString(s[loc[2]:loc[3]])
+		prev = loc[1]
+	}
+	b.WriteString(stripEmphasis(s[prev:]))
+	return b.String()
+}
+
+func stripEmphasis(s string) string {
+	for _, re := range emphasisREs {
+		// Repeat, because adjacent matches share the whitespace
+		// between them.
+		for {
+			t := re.ReplaceAllString(s, "$1$2$3")
+			if t == s {
+				break
+			}
+			s = t
+		}
+	}
+	return s
+}
@@ -0,0 +1,83 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package report
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestFixDescription(t *testing.T) {
+	tcs := []struct {
+		name    string
+		unfixed string
+		want    string
+	}{
+		{
+			name:    "reflow",
+			unfixed: "Some text\nthat was broken\nup too early.\n\nA second paragraph that is much too long to fit on a single line of the final report.",
+			want:    "Some text that was broken up too early.\n\nA second paragraph that is much too long to fit on a single line of the final\nreport.",
+		},
+		{
+			name:    "heading",
+			unfixed: "## Impact\nA panic in the server.\n### Patches ###\nUpgrade.",
+			want:    "Impact\n\nA panic in the server.\n\nPatches\n\nUpgrade.",
+		},
+		{
+			name:    "code identifiers",
+			unfixed: "Calling `(*Server).Serve` or `__init__` with `**opts` can panic.",
+			want:    "Calling (*Server).Serve or __init__ with **opts can panic.",
+		},
+		{
+			name:    "identifiers outside code spans",
+			unfixed: "Values of type *T passed to __init__ or snake_case_func.",
+			want:    "Values of type *T passed to __init__ or snake_case_func.",
+		},
+		{
+			name:    "emphasis",
+			unfixed: "This is **not** safe, and *never* was (_really_).",
+			want:    "This is not safe, and never was (really).",
+		},
+		{
+			name:    "adjacent emphasis",
+			unfixed: "**very** **bad**",
+			want:    "very bad",
+		},
+		{
+			name:    "links",
+			unfixed: "See [the fix](https://go.dev/cl/1234), [https://go.dev/issue/5](https://go.dev/issue/5) and <https://example.com/a>.",
+			want:    "See the fix (https://go.dev/cl/1234), https://go.dev/issue/5 and\nhttps://example.com/a.",
+		},
+		{
+			name:    "wrapped link",
+			unfixed: "See [the\n`Fix` commit](https://go.dev/cl/1234).",
+			want:    "See the Fix commit (https://go.dev/cl/1234).",
+		},
+		{
+			name:    "list",
+			unfixed: "Affected:\n\n* `Parse`\n- **Read**",
+			want:    "Affected:\n\n* Parse\n- Read",
+		},
+		{
+			name:    "code block",
+			unfixed: "For example:\n\n```go\nx := f()\n```",
+			want:    "For example:\n\nx := f()",
+		},
+		{
+			name:    "html is left for lint",
+			unfixed: "A line<br>break.",
+			want:    "A line<br>break.",
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			got := fixDescription(tc.unfixed, maxLineLength)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("fixDescription() mismatch (-want +got):\n%s\n%s", diff, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package report
+
+import (
+	"math"
+	"slices"
+	"time"
+
+	"golang.org/x/vulndb/internal/epss"
+	"golang.org/x/vulndb/internal/osv"
+)
+
+// EPSS is the Exploit Prediction Scoring System (EPSS) score of one of
+// a report's CVEs: the probability, estimated by FIRST, that it will be
+// exploited in the wild in the next 30 days. If the report has several
+// CVEs, it is the highest of their scores.
+//
+// It is set by vulnreport enrich, and published in the OSV
+// database_specific field so that consumers can weigh findings by how
+// likely they are to be exploited.
+type EPSS struct {
+	// CVE is the CVE the score is for.
+	CVE string `yaml:"cve,omitempty"`
+	// Score is the probability of exploitation, from 0 to 1.
+	Score float64 `yaml:"score,omitempty"`
+	// Percentile is the proportion, from 0 to 1, of all scored CVEs
+	// with the same or a lower score.
+	Percentile float64 `yaml:"percentile,omitempty"`
+	// Date is the date (YYYY-MM-DD) the score was computed.
+	Date string `yaml:"date,omitempty"`
+}
+
+// NewEPSS returns the EPSS field for the given score.
+func NewEPSS(s *epss.Score) *EPSS {
+	return &EPSS{
+		CVE:        s.CVE,
+		Score:      s.EPSS,
+		Percentile: s.Percentile,
+		Date:       s.Date,
+	}
+}
+
+// Changes below these thresholds are noise: scores are recomputed
+// daily, and re-recording every small move would churn the reports.
+const (
+	minScoreChange      = 0.01
+	minPercentileChange = 0.05
+)
+
+// Outdated reports whether e should be replaced by the current score s,
+// that is, if e is not set, is for another CVE, or differs significantly
+// from s.
+func (e *EPSS) Outdated(s *epss.Score) bool {
+	if e == nil {
+		return true
+	}
+	return e.CVE != s.CVE ||
+		math.Abs(e.Score-s.EPSS) >= minScoreChange ||
+		math.Abs(e.Percentile-s.Percentile) >= minPercentileChange
+}
+
+func (e *EPSS) toOSV() *osv.EPSS {
+	if e == nil {
+		return nil
+	}
+	return &osv.EPSS{
+		CVE:        e.CVE,
+		Score:      e.Score,
+		Percentile: e.Percentile,
+		Date:       e.Date,
+	}
+}
+
+func (e *EPSS) lint(l *linter, r *Report) {
+	if e == nil {
+		return
+	}
+	if e.CVE == "" {
+		l.Group("cve").Error(missing)
+	} else if !slices.Contains(r.AllCVEs(), e.CVE) {
+		l.Group("cve").Errorf("%s is not one of the report's CVEs", e.CVE)
+	}
+	if e.Score < 0 || e.Score > 1 {
+		l.Group("score").Errorf("%v is not between 0 and 1", e.Score)
+	}
+	if e.Percentile < 0 || e.Percentile > 1 {
+		l.Group("percentile").Errorf("%v is not between 0 and 1", e.Percentile)
+	}
+	if _, err := time.Parse(time.DateOnly, e.Date); err != nil {
+		l.Group("date").Errorf("%q is not a date of the form YYYY-MM-DD", e.Date)
+	}
+}
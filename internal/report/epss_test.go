@@ -0,0 +1,58 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package report
+
+import (
+	"testing"
+
+	"golang.org/x/vulndb/internal/epss"
+)
+
+func TestEPSSOutdated(t *testing.T) {
+	recorded := &EPSS{CVE: "CVE-1999-0001", Score: 0.1, Percentile: 0.8, Date: "2024-03-01"}
+	for _, tc := range []struct {
+		name string
+		e    *EPSS
+		s    *epss.Score
+		want bool
+	}{
+		{
+			name: "unset",
+			e:    nil,
+			s:    &epss.Score{CVE: "CVE-1999-0001", EPSS: 0.1, Percentile: 0.8},
+			want: true,
+		},
+		{
+			name: "small_change",
+			e:    recorded,
+			s:    &epss.Score{CVE: "CVE-1999-0001", EPSS: 0.105, Percentile: 0.82, Date: "2024-04-01"},
+			want: false,
+		},
+		{
+			name: "score_change",
+			e:    recorded,
+			s:    &epss.Score{CVE: "CVE-1999-0001", EPSS: 0.2, Percentile: 0.82},
+			want: true,
+		},
+		{
+			name: "percentile_change",
+			e:    recorded,
+			s:    &epss.Score{CVE: "CVE-1999-0001", EPSS: 0.1, Percentile: 0.9},
+			want: true,
+		},
+		{
+			name: "other_cve",
+			e:    recorded,
+			s:    &epss.Score{CVE: "CVE-1999-0002", EPSS: 0.1, Percentile: 0.8},
+			want: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.e.Outdated(tc.s); got != tc.want {
+				t.Errorf("Outdated() = %t, want %t", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package report
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// ExportColumns are the names of the columns of the rows
+// returned by ExportRows.
+//
+// There is no CVSS column, because the Go vulnerability database
+// does not score vulnerabilities.
+var ExportColumns = []string{"id", "module", "introduced", "fixed", "cwes", "review_status", "published"}
+
+var cweIDRegex = regexp.MustCompile(`CWE-\d+`)
+
+// ExportRows returns the report flattened into rows for analytics,
+// with the columns in ExportColumns: one row for each range of
+// affected versions of each module.
+//
+// Versions are semver without a "v" prefix; an introduced version of
+// "0" means all versions before the fixed version are affected, and an
+// empty fixed version means there is no fix. CWEs are given by their
+// IDs ("CWE-NNN") and separated by semicolons, and the published date
+// is in YYYY-MM-DD form.
+func (r *Report) ExportRows() ([][]string, error) {
+	var cwes []string
+	if r.CVEMetadata != nil && r.CVEMetadata.CWE != "" {
+		// Keep only the ID of a CWE given with its name.
+		if id := cweIDRegex.FindString(r.CVEMetadata.CWE); id != "" {
+			cwes = append(cwes, id)
+		}
+	}
+	for _, cwe := range r.PatternClass.CWEs() {
+		if !slices.Contains(cwes, cwe) {
+			cwes = append(cwes, cwe)
+		}
+	}
+	var published string
+	if !r.Published.IsZero() {
+		published = r.Published.Format("2006-01-02")
+	}
+
+	var rows [][]string
+	for _, m := range r.Modules {
+		affected, err := toAffected(m)
+		if err != nil {
+			return nil, err
+		}
+		row := func(introduced, fixed string) []string {
+			return []string{r.ID, affected.Module.Path, introduced, fixed,
+				strings.Join(cwes, ";"), r.ReviewStatus.String(), published}
+		}
+		for _, rng := range affected.Ranges {
+			introduced := ""
+			for _, e := range rng.Events {
+				switch {
+				case e.Introduced != "":
+					introduced = e.Introduced
+				case e.Fixed != "":
+					rows = append(rows, row(introduced, e.Fixed))
+					introduced = ""
+				}
+			}
+			if introduced != "" {
+				rows = append(rows, row(introduced, ""))
+			}
+		}
+	}
+	return rows, nil
+}
@@ -0,0 +1,58 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package report
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/vulndb/internal/stdlib"
+)
+
+func TestExportRows(t *testing.T) {
+	r := &Report{
+		ID: "GO-1999-0001",
+		Modules: []*Module{
+			{
+				Module: "example.com/a",
+				Versions: Versions{
+					Introduced("1.0.0"), Fixed("1.2.0"),
+					Introduced("2.0.0"),
+				},
+			},
+			{
+				Module:   stdlib.ModulePath,
+				Versions: Versions{Fixed("1.21.5")},
+			},
+			{
+				Module: "example.com/b",
+			},
+		},
+		CVEMetadata:  &CVEMeta{ID: "CVE-1999-0001", CWE: "CWE-476 NULL Pointer Dereference"},
+		PatternClass: PatternNilDereference,
+		ReviewStatus: Reviewed,
+		Published:    time.Date(1999, 1, 2, 3, 4, 5, 0, time.UTC),
+	}
+	got, err := r.ExportRows()
+	if err != nil {
+		t.Fatal(err)
+	}
+	const cwes = "CWE-476"
+	want := [][]string{
+		{"GO-1999-0001", "example.com/a", "1.0.0", "1.2.0", cwes, "REVIEWED", "1999-01-02"},
+		{"GO-1999-0001", "example.com/a", "2.0.0", "", cwes, "REVIEWED", "1999-01-02"},
+		{"GO-1999-0001", "stdlib", "0", "1.21.5", cwes, "REVIEWED", "1999-01-02"},
+		{"GO-1999-0001", "example.com/b", "0", "", cwes, "REVIEWED", "1999-01-02"},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("ExportRows() mismatch (-want, +got):\n%s", diff)
+	}
+	for _, row := range got {
+		if len(row) != len(ExportColumns) {
+			t.Errorf("got row with %d columns, want %d", len(row), len(ExportColumns))
+		}
+	}
+}
@@ -29,16 +29,20 @@ func (r *Report) Fix(pc *proxy.Client) {
 	_ = r.FixModules(pc)
 	r.FixText()
 	r.FixReferences()
+	r.Normalize()
 }
 
 func (r *Report) FixText() {
 	fixLines := func(sp *string) {
 		*sp = fixLineLength(*sp, maxLineLength)
 	}
+	fixDesc := func(sp *string) {
+		*sp = fixDescription(*sp, maxLineLength)
+	}
 	fixLines((*string)(&r.Summary))
-	fixLines((*string)(&r.Description))
+	fixDesc((*string)(&r.Description))
 	if r.CVEMetadata != nil {
-		fixLines(&r.CVEMetadata.Description)
+		fixDesc(&r.CVEMetadata.Description)
 	}
 
 	r.fixSummary()
@@ -95,9 +99,11 @@ func (m *Module) FixVersions(pc *proxy.Client) {
 
 	m.Versions.fix()
 	m.UnsupportedVersions.fix()
+	m.UnaffectedVersions.fix()
 	m.VulnerableAt.fix()
 
-	if pc != nil && !m.IsFirstParty() {
+	// The versions of private modules can't be checked.
+	if pc != nil && !m.IsFirstParty() && !pc.IsPrivate(m.Module) {
 		found, notFound, _ := m.classifyVersions(pc)
 		if len(notFound) != 0 {
 			m.Versions = found
@@ -138,6 +144,11 @@ func (m *Module) fixVulnerableAt(pc *proxy.Client) error {
 	if m.IsFirstParty() {
 		return fmt.Errorf("not implemented for std/cmd")
 	}
+	// Private modules can't be looked up, so vulnerable_at
+	// must be set by hand.
+	if pc.IsPrivate(m.Module) {
+		return nil
+	}
 	// Don't attempt to guess if the given version ranges don't make sense.
 	if err := m.checkModVersions(pc); err != nil {
 		return err
@@ -316,6 +327,7 @@ func (r *Report) FixModules(pc *proxy.Client) (errs error) {
 	// Fix the versions *after* the modules have been merged.
 	for _, m := range r.Modules {
 		m.FixVersions(pc)
+		m.fixFixMatrix()
 		if err := m.fixVulnerableAt(pc); err != nil {
 			r.AddNote(NoteTypeFix, "%s: could not add vulnerable_at: %v", m.Module, err)
 			errs = errors.Join(errs, err)
@@ -513,9 +525,12 @@ func modulePath(prefix, pathMajor string) string {
 func (m *Module) copy() *Module {
 	return &Module{
 		Module:               m.Module,
+		FormerPaths:          slices.Clone(m.FormerPaths),
 		Versions:             m.Versions.copy(),
 		NonGoVersions:        m.NonGoVersions.copy(),
 		UnsupportedVersions:  m.UnsupportedVersions.copy(),
+		UnaffectedVersions:   m.UnaffectedVersions.copy(),
+		PlatformVersions:     copyPlatformVersions(m.PlatformVersions),
 		VulnerableAt:         m.VulnerableAt.copy(),
 		VulnerableAtRequires: slices.Clone(m.VulnerableAtRequires),
 		Packages:             copyPackages(m.Packages),
@@ -567,7 +582,16 @@ func (p *Package) copy() *Package {
 		DerivedSymbols:  slices.Clone(p.DerivedSymbols),
 		ExcludedSymbols: slices.Clone(p.ExcludedSymbols),
 		SkipFixSymbols:  p.SkipFixSymbols,
+		FixStatus:       p.FixStatus.copy(),
+	}
+}
+
+func (fs *FixStatus) copy() *FixStatus {
+	if fs == nil {
+		return nil
 	}
+	c := *fs
+	return &c
 }
 
 const (
@@ -697,56 +721,60 @@ func addIncompatible(m *Module, pc *proxy.Client) {
 
 func sortModules(ms []*Module) {
 	sort.SliceStable(ms, func(i, j int) bool {
-		m1, m2 := ms[i], ms[j]
-
-		// Break ties by versions, assuming the version list is sorted.
-		// If needed, further break ties by packages.
-		if m1.Module == m2.Module {
-			byPackage := func(m1, m2 *Module) bool {
-				pkgs1, pkgs2 := m1.Packages, m2.Packages
-				if len(pkgs1) == 0 {
-					return true
-				} else if len(pkgs2) == 0 {
-					return false
-				}
-				return pkgs1[0].Package < pkgs2[0].Package
-			}
+		return moduleLess(ms[i], ms[j])
+	})
+}
 
-			vr1, vr2 := m1.Versions, m2.Versions
-			if len(vr1) == 0 && len(vr2) == 0 {
-				return byPackage(m1, m2)
-			} else if len(vr1) == 0 {
-				return true
-			} else if len(vr2) == 0 {
+// moduleLess reports whether m1 comes before m2 in the canonical
+// order of modules.
+func moduleLess(m1, m2 *Module) bool {
+	// Break ties by versions, assuming the version list is sorted.
+	// If needed, further break ties by packages.
+	if m1.Module == m2.Module {
+		byPackage := func(m1, m2 *Module) bool {
+			pkgs1, pkgs2 := m1.Packages, m2.Packages
+			if len(pkgs2) == 0 {
 				return false
+			} else if len(pkgs1) == 0 {
+				return true
 			}
+			return pkgs1[0].Package < pkgs2[0].Package
+		}
 
-			v1, v2 := vr1[0], vr2[0]
-			if v1.Version == v2.Version {
-				return byPackage(m1, m2)
-			}
-
-			return version.Before(v1.Version, v2.Version)
+		vr1, vr2 := m1.Versions, m2.Versions
+		if len(vr1) == 0 && len(vr2) == 0 {
+			return byPackage(m1, m2)
+		} else if len(vr1) == 0 {
+			return true
+		} else if len(vr2) == 0 {
+			return false
 		}
 
-		// Sort by module base name then major version.
-		base1, major1, ok1 := module.SplitPathVersion(m1.Module)
-		base2, major2, ok2 := module.SplitPathVersion(m2.Module)
-		if !ok1 || !ok2 {
-			return m1.Module < m2.Module
+		v1, v2 := vr1[0], vr2[0]
+		if v1.Version == v2.Version {
+			return byPackage(m1, m2)
 		}
 
-		if base1 == base2 {
-			i1, ok1 := majorToInt(major1)
-			i2, ok2 := majorToInt(major2)
-			if ok1 && ok2 {
-				return i1 < i2
-			}
-			return major1 < major2
+		return version.Before(v1.Version, v2.Version)
+	}
+
+	// Sort by module base name then major version.
+	base1, major1, ok1 := module.SplitPathVersion(m1.Module)
+	base2, major2, ok2 := module.SplitPathVersion(m2.Module)
+	if !ok1 || !ok2 {
+		return m1.Module < m2.Module
+	}
+
+	if base1 == base2 {
+		i1, ok1 := majorToInt(major1)
+		i2, ok2 := majorToInt(major2)
+		if ok1 && ok2 {
+			return i1 < i2
 		}
+		return major1 < major2
+	}
 
-		return base1 < base2
-	})
+	return base1 < base2
 }
 
 // merge merges all modules with the same module & package info
@@ -777,6 +805,7 @@ func merge(ms []*Module) ([]*Module, error) {
 		}
 		return &Module{
 			Module:              m1.Module,
+			FormerPaths:         slices.Compact(slices.Sorted(slices.Values(append(slices.Clone(m1.FormerPaths), m2.FormerPaths...)))),
 			Versions:            merged,
 			UnsupportedVersions: m1.UnsupportedVersions.merge(m2.UnsupportedVersions),
 			NonGoVersions:       m1.NonGoVersions.merge(m2.NonGoVersions),
@@ -880,12 +909,7 @@ func (r *Report) FixReferences() {
 		}
 	}
 
-	slices.SortFunc(r.References, func(a *Reference, b *Reference) int {
-		if a.Type == b.Type {
-			return strings.Compare(a.URL, b.URL)
-		}
-		return strings.Compare(string(a.Type), string(b.Type))
-	})
+	slices.SortFunc(r.References, compareReferences)
 
 	if len(r.References) == 0 {
 		r.References = nil
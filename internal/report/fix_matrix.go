@@ -0,0 +1,204 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package report
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"golang.org/x/mod/semver"
+	"golang.org/x/vulndb/internal/osvutils"
+	"golang.org/x/vulndb/internal/proxy"
+	"golang.org/x/vulndb/internal/stdlib"
+	"golang.org/x/vulndb/internal/version"
+)
+
+// A FixedRelease is the first release of a Go minor version that
+// fixes a vulnerability.
+type FixedRelease struct {
+	// Go is the minor version, for example "1.22".
+	Go string `yaml:"go,omitempty"`
+	// Fixed is the unprefixed release in that minor version with the
+	// fix, for example "1.22.1".
+	Fixed string `yaml:",omitempty"`
+}
+
+// fixMatrix returns the fixed release of each Go minor version that has
+// one, according to the versions of a standard library or toolchain
+// module, in increasing order.
+func (m *Module) fixMatrix() []*FixedRelease {
+	byMinor := make(map[string]string)
+	for _, v := range m.Versions {
+		if !v.IsFixed() || !version.IsValid(v.Version) {
+			continue
+		}
+		minor := goMinor(v.Version)
+		// If a minor version was fixed more than once, the vulnerability
+		// was reintroduced, so only the last fix protects users.
+		if prev, ok := byMinor[minor]; !ok || version.Before(prev, v.Version) {
+			byMinor[minor] = v.Version
+		}
+	}
+	var matrix []*FixedRelease
+	for minor, fixed := range byMinor {
+		matrix = append(matrix, &FixedRelease{Go: minor, Fixed: fixed})
+	}
+	slices.SortFunc(matrix, func(a, b *FixedRelease) int {
+		return semver.Compare("v"+a.Go, "v"+b.Go)
+	})
+	return matrix
+}
+
+// goMinor returns the minor version ("1.22") of an unprefixed
+// Go release ("1.22.1").
+func goMinor(v string) string {
+	return strings.TrimPrefix(semver.MajorMinor("v"+v), "v")
+}
+
+func (m *Module) fixFixMatrix() {
+	if !m.IsFirstParty() {
+		return
+	}
+	m.FixMatrix = m.fixMatrix()
+}
+
+// FixMatrixText returns a paragraph listing the fixed release of each
+// Go minor version, to be appended to the report's description, or ""
+// if the report has no fix matrix.
+//
+// If the standard library and the toolchain are both affected, users
+// must upgrade to the later of their fixed releases.
+func (r *Report) FixMatrixText() string {
+	byMinor := make(map[string]string)
+	for _, m := range r.Modules {
+		for _, fr := range m.FixMatrix {
+			if prev, ok := byMinor[fr.Go]; !ok || version.Before(prev, fr.Fixed) {
+				byMinor[fr.Go] = fr.Fixed
+			}
+		}
+	}
+	if len(byMinor) == 0 {
+		return ""
+	}
+	minors := make([]string, 0, len(byMinor))
+	for minor := range byMinor {
+		minors = append(minors, minor)
+	}
+	slices.SortFunc(minors, func(a, b string) int {
+		return semver.Compare("v"+a, "v"+b)
+	})
+	var lines []string
+	for _, minor := range minors {
+		tag, err := version.SemverToGoTag(byMinor[minor])
+		if err != nil {
+			tag = byMinor[minor]
+		}
+		lines = append(lines, fmt.Sprintf("Go %s is fixed in %s.", minor, tag))
+	}
+	return "FIXED RELEASES: " + strings.Join(lines, " ")
+}
+
+func (m *Module) lintFixMatrix(l *linter, pc *proxy.Client) {
+	if len(m.FixMatrix) == 0 {
+		return
+	}
+	fl := l.Group("fix_matrix")
+	if !m.IsFirstParty() {
+		fl.Error("only allowed for the standard library and toolchain")
+		return
+	}
+	if want := m.fixMatrix(); !slices.EqualFunc(want, m.FixMatrix, func(a, b *FixedRelease) bool {
+		return *a == *b
+	}) {
+		fl.Errorf("does not match versions (want %s; run vulnreport fix)", fixMatrixString(want))
+		return
+	}
+	if pc == nil {
+		return
+	}
+	releases, err := goReleases(pc)
+	if err != nil {
+		fl.Errorf("could not validate against Go releases: %v", err)
+		return
+	}
+	for _, fr := range m.FixMatrix {
+		// The releases module does not have versions for
+		// releases older than its first (Go 1.21.0).
+		if len(releases) > 0 && version.Before(fr.Fixed, releases[0]) {
+			continue
+		}
+		if !slices.Contains(releases, fr.Fixed) {
+			fl.Errorf("%s is not a Go release", fr.Fixed)
+		}
+	}
+
+	// Security fixes are made to both supported minor versions, so the
+	// one before the latest fixed minor version must have a fix if it
+	// is affected.
+	ranges, err := m.Versions.ToSemverRanges()
+	if err != nil {
+		return // reported by lintVersions
+	}
+	latest := m.FixMatrix[len(m.FixMatrix)-1]
+	prev := previousMinor(latest.Go)
+	if prev == "" || slices.ContainsFunc(m.FixMatrix, func(fr *FixedRelease) bool { return fr.Go == prev }) {
+		return
+	}
+	if last := latestRelease(releases, prev); last != "" {
+		if affected, err := osvutils.AffectsSemver(ranges, last); err == nil && affected {
+			fl.Errorf("Go %s is affected (at %s) but has no fixed release", prev, last)
+		}
+	}
+}
+
+func fixMatrixString(matrix []*FixedRelease) string {
+	var s []string
+	for _, fr := range matrix {
+		s = append(s, fr.Go+": "+fr.Fixed)
+	}
+	return "[" + strings.Join(s, ", ") + "]"
+}
+
+// goReleases returns the stable Go releases, as unprefixed semantic
+// versions in increasing order, according to the module proxy.
+func goReleases(pc *proxy.Client) ([]string, error) {
+	vs, err := pc.Versions(stdlib.ReleasesModulePath)
+	if err != nil {
+		return nil, err
+	}
+	var releases []string
+	for _, v := range vs {
+		if rel, ok := stdlib.ReleaseFromVersion(v); ok {
+			releases = append(releases, rel)
+		}
+	}
+	slices.SortFunc(releases, func(a, b string) int {
+		return semver.Compare("v"+a, "v"+b)
+	})
+	return releases, nil
+}
+
+// previousMinor returns the minor version before minor ("1.21" for
+// "1.22"), or "" if there is none.
+func previousMinor(minor string) string {
+	var major, n int
+	if _, err := fmt.Sscanf(minor, "%d.%d", &major, &n); err != nil || n == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%d.%d", major, n-1)
+}
+
+// latestRelease returns the latest of the releases in the given
+// minor version, or "" if there are none.
+func latestRelease(releases []string, minor string) string {
+	var latest string
+	for _, rel := range releases {
+		if goMinor(rel) == minor && (latest == "" || version.Before(latest, rel)) {
+			latest = rel
+		}
+	}
+	return latest
+}
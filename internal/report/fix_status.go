@@ -0,0 +1,108 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package report
+
+import (
+	"net/url"
+	"regexp"
+	"slices"
+
+	"golang.org/x/vulndb/internal/osv"
+)
+
+// A FixStatus records whether a fix is available for a package, when
+// this is not apparent from its module's fixed versions.
+//
+// Unlike skip_fix, which only controls whether vulnreport derives the
+// package's symbols, it is published in the OSV database_specific
+// field, so that tools can tell "no fix exists" apart from "fix not
+// yet analyzed".
+type FixStatus struct {
+	Status FixStatusType `yaml:",omitempty"`
+	// URL is a link to the discussion of the fix, for example
+	// an upstream issue or pull request.
+	URL string `yaml:"url,omitempty"`
+}
+
+// FixStatusType is the availability of a fix.
+//
+// It must be one of the values in FixStatusTypes.
+type FixStatusType string
+
+const (
+	// No fix exists, and none is planned.
+	FixStatusNone FixStatusType = "none"
+	// A fix may exist or be in progress, but has not yet been released
+	// or analyzed, so the module has no fixed version yet.
+	FixStatusPending FixStatusType = "pending"
+	// The module's fixed version addresses the vulnerability only in
+	// some configurations or for some APIs of the package.
+	FixStatusPartial FixStatusType = "partial"
+)
+
+// FixStatusTypes are the set of valid fix statuses.
+var FixStatusTypes = []FixStatusType{
+	FixStatusNone,
+	FixStatusPending,
+	FixStatusPartial,
+}
+
+// IsValid reports whether s is one of the FixStatusTypes.
+func (s FixStatusType) IsValid() bool {
+	return slices.Contains(FixStatusTypes, s)
+}
+
+func (fs *FixStatus) lint(l *linter, m *Module) {
+	if !fs.Status.IsValid() {
+		l.Group("status").Errorf("%q is not a valid fix status (accepted: %v)", fs.Status, FixStatusTypes)
+		return
+	}
+	hasFixed := slices.ContainsFunc(m.Versions, (*Version).IsFixed)
+	switch fs.Status {
+	case FixStatusNone, FixStatusPending:
+		if hasFixed {
+			l.Errorf("status %s not allowed for a module with a fixed version", fs.Status)
+		}
+	case FixStatusPartial:
+		if !hasFixed {
+			l.Errorf("status %s requires the module to have a fixed version", fs.Status)
+		}
+	}
+	ul := l.Group("url")
+	switch {
+	case fs.URL == "":
+		if fs.Status != FixStatusNone {
+			ul.Errorf("required for status %s", fs.Status)
+		}
+	case !isHTTPURL(fs.URL):
+		ul.Errorf("%q is not an http(s) URL", fs.URL)
+	}
+}
+
+func isHTTPURL(s string) bool {
+	u, err := url.Parse(s)
+	return err == nil && (u.Scheme == "https" || u.Scheme == "http") && u.Host != ""
+}
+
+// skipFixAvailabilityRegexp matches skip_fix reasons that are about
+// whether a fix exists, which should be recorded with fix_status.
+var skipFixAvailabilityRegexp = regexp.MustCompile(`(?i)\b(no fix|not (yet )?fixed|unfixed|fix (is )?(pending|not available|unavailable))\b`)
+
+func (r *Report) osvFixStatuses() []osv.FixStatus {
+	var fss []osv.FixStatus
+	for _, m := range r.Modules {
+		for _, p := range m.Packages {
+			if p.FixStatus == nil {
+				continue
+			}
+			fss = append(fss, osv.FixStatus{
+				Package: p.Package,
+				Status:  string(p.FixStatus.Status),
+				URL:     p.FixStatus.URL,
+			})
+		}
+	}
+	return fss
+}
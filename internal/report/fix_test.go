@@ -62,6 +62,11 @@ func TestFix(t *testing.T) {
 					Fixed("1.20.1"),
 				},
 				VulnerableAt: VulnerableAt("1.20.0"),
+				FixMatrix: []*FixedRelease{
+					{Go: "1.18", Fixed: "1.18.5"},
+					{Go: "1.19", Fixed: "1.19.5"},
+					{Go: "1.20", Fixed: "1.20.1"},
+				},
 			},
 		},
 		Description: "A long form description of the problem that will be broken up into multiple\nlines so it is more readable.",
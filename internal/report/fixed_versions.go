@@ -0,0 +1,176 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package report
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+	"golang.org/x/vulndb/internal/gitrepo"
+	"golang.org/x/vulndb/internal/osv"
+	"golang.org/x/vulndb/internal/proxy"
+	"golang.org/x/vulndb/internal/version"
+)
+
+// A RepoCloner returns a clone of the git repo at a URL,
+// with its tags, like gitrepo.CloneWithTags.
+type RepoCloner func(ctx context.Context, url string) (*git.Repository, error)
+
+// AddMissingFixedVersions adds a fixed version to each module of r
+// without one whose repo has a fix commit in the references of r:
+// the earliest version of the module known to the proxy (after its
+// latest introduced version, if any) whose tag contains the commit.
+// A note records the commit each version was found from.
+//
+// It returns the number of fixed versions added.
+func (r *Report) AddMissingFixedVersions(ctx context.Context, pc *proxy.Client, clone RepoCloner) (added int, _ error) {
+	commits := r.fixCommits()
+	if len(commits) == 0 {
+		return 0, nil
+	}
+	repos := make(map[string]*git.Repository)
+	var errs []error
+	for _, m := range r.Modules {
+		if !m.missingFixedVersion() {
+			continue
+		}
+		introduced, _ := m.Versions.latestVersions()
+		for _, c := range commits {
+			root, dir, ok := c.moduleDir(m.Module)
+			if !ok {
+				continue
+			}
+			repo, ok := repos[root]
+			if !ok {
+				var err error
+				repo, err = clone(ctx, "https://"+root)
+				if err != nil {
+					errs = append(errs, err)
+				}
+				repos[root] = repo
+			}
+			if repo == nil {
+				continue
+			}
+			v, err := earliestFixedVersion(repo, pc, m.Module, dir, c.hash, introduced)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", m.Module, err))
+				continue
+			}
+			if v == "" {
+				continue
+			}
+			m.Versions = append(m.Versions, Fixed(v))
+			m.Versions.fix()
+			r.AddNote(NoteTypeNone, "%s: fixed version %s added automatically: it is the earliest tagged version containing fix commit %s", m.Module, v, c.url)
+			added++
+			break
+		}
+	}
+	return added, errors.Join(errs...)
+}
+
+// NeedsFixedVersions reports whether AddMissingFixedVersions
+// may add fixed versions to r: that is, whether r has a fix commit
+// on github.com and a module without a fixed version.
+func (r *Report) NeedsFixedVersions() bool {
+	return len(r.fixCommits()) > 0 && slices.ContainsFunc(r.Modules, (*Module).missingFixedVersion)
+}
+
+// missingFixedVersion reports whether m is a third-party module
+// whose latest version range has no fixed version.
+func (m *Module) missingFixedVersion() bool {
+	if m.IsFirstParty() {
+		return false
+	}
+	_, fixed := m.Versions.latestVersions()
+	return fixed == nil
+}
+
+// A fixCommit is a fix commit in a repo on github.com.
+type fixCommit struct {
+	url  string
+	root string // the repo, e.g. "github.com/owner/repo"
+	hash string
+}
+
+// fixCommits returns the commits of the FIX references of r
+// that are in repos on github.com.
+func (r *Report) fixCommits() []*fixCommit {
+	var cs []*fixCommit
+	for _, ref := range r.References {
+		if ref.Type != osv.ReferenceTypeFix {
+			continue
+		}
+		rest, ok := strings.CutPrefix(ref.URL, "https://github.com/")
+		if !ok {
+			continue
+		}
+		parts := strings.Split(rest, "/")
+		if len(parts) < 4 || parts[2] != "commit" {
+			continue
+		}
+		hash, ok := commitHash(ref.URL)
+		if !ok {
+			continue
+		}
+		cs = append(cs, &fixCommit{
+			url:  ref.URL,
+			root: "github.com/" + strings.ToLower(parts[0]+"/"+parts[1]),
+			hash: hash,
+		})
+	}
+	return cs
+}
+
+// moduleDir returns the root of the repo of c and the directory
+// in it of the module with the given path, if the module is in
+// the repo of c.
+func (c *fixCommit) moduleDir(modulePath string) (root, dir string, ok bool) {
+	base := stripMajor(modulePath)
+	if len(base) < len(c.root) || !strings.EqualFold(base[:len(c.root)], c.root) {
+		return "", "", false
+	}
+	switch rest := base[len(c.root):]; {
+	case rest == "":
+		return c.root, "", true
+	case rest[0] == '/':
+		return c.root, rest[1:], true
+	}
+	return "", "", false
+}
+
+// earliestFixedVersion returns the earliest version of the module
+// with the given path (in directory dir of repo) after introduced (if
+// not nil) whose tag contains the commit with the given hash, or ""
+// if there is none.
+func earliestFixedVersion(repo *git.Repository, pc *proxy.Client, modulePath, dir, hash string, introduced *Version) (string, error) {
+	vs, err := pc.Versions(modulePath)
+	if err != nil {
+		return "", err
+	}
+	var tags []string
+	byTag := make(map[string]string)
+	for _, v := range vs {
+		if introduced != nil && !version.Before(introduced.Version, v) {
+			continue
+		}
+		tag := "v" + strings.TrimSuffix(v, "+incompatible")
+		if dir != "" {
+			tag = dir + "/" + tag
+		}
+		tags = append(tags, tag)
+		byTag[tag] = v
+	}
+	tag, err := gitrepo.EarliestTagContaining(repo, hash, tags)
+	if err != nil {
+		return "", err
+	}
+	return byTag[tag], nil
+}
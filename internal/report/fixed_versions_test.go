@@ -0,0 +1,116 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package report
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-git/go-billy/v5/memfs"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/storage/memory"
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/vulndb/internal/osv"
+	"golang.org/x/vulndb/internal/proxy"
+)
+
+func TestAddMissingFixedVersions(t *testing.T) {
+	// The responses are hand-written version lists
+	// for a fake repo, so don't update them.
+	pc, err := proxy.NewTestClient(t, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The repo github.com/a/b has the modules github.com/a/b
+	// and github.com/a/b/sub, fixed between their first and
+	// second versions.
+	fs := memfs.New()
+	repo, err := git.Init(memory.NewStorage(), fs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wt, err := repo.Worktree()
+	if err != nil {
+		t.Fatal(err)
+	}
+	when := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	commit := func(msg string, tags ...string) plumbing.Hash {
+		f, err := fs.Create("file")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := f.Write([]byte(msg)); err != nil {
+			t.Fatal(err)
+		}
+		f.Close()
+		if _, err := wt.Add("file"); err != nil {
+			t.Fatal(err)
+		}
+		when = when.Add(time.Hour)
+		h, err := wt.Commit(msg, &git.CommitOptions{Author: &object.Signature{Name: "Author", Email: "author@example.com", When: when}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, tag := range tags {
+			if _, err := repo.CreateTag(tag, h, nil); err != nil {
+				t.Fatal(err)
+			}
+		}
+		return h
+	}
+	commit("first", "v1.0.0", "sub/v0.1.0")
+	fix := commit("fix")
+	commit("second", "v1.1.0", "sub/v0.2.0")
+	commit("third", "v1.2.0")
+
+	var cloned []string
+	clone := func(_ context.Context, url string) (*git.Repository, error) {
+		cloned = append(cloned, url)
+		return repo, nil
+	}
+
+	fixURL := "https://github.com/a/b/commit/" + fix.String()
+	r := &Report{
+		Modules: []*Module{
+			{Module: "github.com/a/b", Versions: Versions{Introduced("1.0.0")}},
+			{Module: "github.com/a/b/sub"},
+			// Not in the repo of the fix.
+			{Module: "example.com/other"},
+			// Already fixed.
+			{Module: "github.com/a/c", Versions: Versions{Fixed("1.0.0")}},
+		},
+		References: []*Reference{{Type: osv.ReferenceTypeFix, URL: fixURL}},
+	}
+	added, err := r.AddMissingFixedVersions(context.Background(), pc, clone)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if added != 2 {
+		t.Errorf("added %d versions, want 2", added)
+	}
+	if want := []string{"https://github.com/a/b"}; !cmp.Equal(cloned, want) {
+		t.Errorf("cloned %v, want %v", cloned, want)
+	}
+	want := []*Module{
+		{Module: "github.com/a/b", Versions: Versions{Introduced("1.0.0"), Fixed("1.1.0")}},
+		{Module: "github.com/a/b/sub", Versions: Versions{Fixed("0.2.0")}},
+		{Module: "example.com/other"},
+		{Module: "github.com/a/c", Versions: Versions{Fixed("1.0.0")}},
+	}
+	if diff := cmp.Diff(want, r.Modules); diff != "" {
+		t.Errorf("modules mismatch (-want, +got):\n%s", diff)
+	}
+	wantNotes := []*Note{
+		{Body: "github.com/a/b: fixed version 1.1.0 added automatically: it is the earliest tagged version containing fix commit " + fixURL},
+		{Body: "github.com/a/b/sub: fixed version 0.2.0 added automatically: it is the earliest tagged version containing fix commit " + fixURL},
+	}
+	if diff := cmp.Diff(wantNotes, r.Notes); diff != "" {
+		t.Errorf("notes mismatch (-want, +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package report
+
+import (
+	"slices"
+	"strings"
+
+	"golang.org/x/vulndb/internal/osv"
+	"golang.org/x/vulndb/internal/proxy"
+)
+
+// paths returns the current path of m followed by its former paths.
+func (m *Module) paths() []string {
+	return append([]string{m.Module}, m.FormerPaths...)
+}
+
+// formerAffected returns copies of a, the affected entry for m, for
+// each of the former paths of m, with the paths of its packages moved
+// from the module path to the former path.
+func (m *Module) formerAffected(a osv.Affected) []osv.Affected {
+	var as []osv.Affected
+	for _, fp := range m.FormerPaths {
+		fa := a
+		fa.Module.Path = fp
+		if es := a.EcosystemSpecific; es != nil {
+			fes := *es
+			fes.Packages = nil
+			for _, p := range es.Packages {
+				if rest, ok := strings.CutPrefix(p.Path, m.Module); ok {
+					p.Path = fp + rest
+				}
+				fes.Packages = append(fes.Packages, p)
+			}
+			fa.EcosystemSpecific = &fes
+		}
+		as = append(as, fa)
+	}
+	return as
+}
+
+// lintFormerPaths checks that the former paths of m are valid module
+// paths, distinct from the paths of the modules of r, and, if pc is
+// non-nil, that the proxy knows about them.
+//
+// With a proxy, it also checks the forwarding relationships the proxy
+// knows about: a module that declares a new path in its go.mod file at
+// its latest version has moved, so it should be listed as a former
+// path of the module at the new path, and a former path must not have
+// moved to a module other than m.
+func (m *Module) lintFormerPaths(l *linter, r *Report, pc *proxy.Client) {
+	if pc != nil && !m.IsFirstParty() && !pc.IsPrivate(m.Module) {
+		if moved := movedTo(pc, m.Module); moved != "" {
+			l.Errorf("module %s has moved to %s (use module %s, with former_paths: [%s])", m.Module, moved, moved, m.Module)
+		}
+	}
+	if len(m.FormerPaths) == 0 {
+		return
+	}
+	fl := l.Group("former_paths")
+	if m.IsFirstParty() {
+		fl.Error("not allowed for the standard library or toolchain")
+		return
+	}
+	seen := make(map[string]bool)
+	for _, fp := range m.FormerPaths {
+		if err := CheckModulePath(fp); err != nil {
+			fl.Error(err)
+			continue
+		}
+		if seen[fp] {
+			fl.Errorf("%s is listed more than once", fp)
+			continue
+		}
+		seen[fp] = true
+		if slices.ContainsFunc(r.Modules, func(m2 *Module) bool { return m2.Module == fp }) {
+			fl.Errorf("%s is a module of the report", fp)
+			continue
+		}
+		if pc == nil || pc.IsPrivate(fp) {
+			continue
+		}
+		if !pc.ModuleExists(fp) {
+			fl.Errorf("module %s not known to proxy", fp)
+			continue
+		}
+		if moved := movedTo(pc, fp); moved != "" && moved != m.Module {
+			fl.Errorf("%s has moved to %s, not %s", fp, moved, m.Module)
+		}
+	}
+}
+
+// movedTo returns the path that the module with the given path
+// declares in its go.mod file at its latest version, if that path is
+// different and known to the proxy, or "" otherwise.
+func movedTo(pc *proxy.Client, path string) string {
+	c, err := pc.CanonicalAtLatest(path)
+	if err != nil || c == "" || c == path || !pc.ModuleExists(c) {
+		return ""
+	}
+	return c
+}
@@ -0,0 +1,119 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package report
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/vulndb/internal/osv"
+	"golang.org/x/vulndb/internal/proxy"
+)
+
+func TestFormerPathsToOSV(t *testing.T) {
+	r := &Report{
+		ID: "GO-9999-0001",
+		Modules: []*Module{{
+			Module:      "example.com/new",
+			FormerPaths: []string{"github.com/old/mod"},
+			Versions:    Versions{Fixed("1.1.0")},
+			Packages: []*Package{{
+				Package: "example.com/new/pkg",
+				Symbols: []string{"F"},
+			}},
+		}},
+	}
+	entry, err := r.ToOSV(time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ranges := []osv.Range{{
+		Type:   osv.RangeTypeSemver,
+		Events: []osv.RangeEvent{{Introduced: "0"}, {Fixed: "1.1.0"}},
+	}}
+	affected := func(modulePath string) osv.Affected {
+		return osv.Affected{
+			Module: osv.Module{Path: modulePath, Ecosystem: osv.GoEcosystem},
+			Ranges: ranges,
+			EcosystemSpecific: &osv.EcosystemSpecific{
+				Packages: []osv.Package{{Path: modulePath + "/pkg", Symbols: []string{"F"}}},
+			},
+		}
+	}
+	want := []osv.Affected{affected("example.com/new"), affected("github.com/old/mod")}
+	if diff := cmp.Diff(want, entry.Affected); diff != "" {
+		t.Errorf("Affected mismatch (-want, +got):\n%s", diff)
+	}
+}
+
+func TestLintFormerPaths(t *testing.T) {
+	// The responses are hand-written, as the proxy does not know
+	// about the modules, so don't update them.
+	pc, err := proxy.NewTestClient(t, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, test := range []struct {
+		name        string
+		module      string
+		formerPaths []string
+		pc          *proxy.Client
+		want        []string
+	}{
+		{
+			name:        "ok",
+			module:      "example.com/new",
+			formerPaths: []string{"github.com/old/mod"},
+			pc:          pc,
+		},
+		{
+			name:        "offline",
+			module:      "example.com/new",
+			formerPaths: []string{"github.com/old/mod", "github.com/old/mod", "example.com/new", "github.com/old/mod/"},
+			want: []string{
+				"former_paths: github.com/old/mod is listed more than once",
+				"former_paths: example.com/new is a module of the report",
+				`former_paths: module path "github.com/old/mod/": trailing slash (did you mean "github.com/old/mod"?)`,
+			},
+		},
+		{
+			name:   "moved",
+			module: "github.com/old/mod",
+			pc:     pc,
+			want:   []string{"module github.com/old/mod has moved to example.com/new (use module example.com/new, with former_paths: [github.com/old/mod])"},
+		},
+		{
+			name:        "moved_elsewhere",
+			module:      "example.com/new",
+			formerPaths: []string{"github.com/other/mod"},
+			pc:          pc,
+			want:        []string{"former_paths: github.com/other/mod has moved to example.com/elsewhere, not example.com/new"},
+		},
+		{
+			name:        "unknown",
+			module:      "example.com/new",
+			formerPaths: []string{"github.com/unknown/mod"},
+			pc:          pc,
+			want:        []string{"former_paths: module github.com/unknown/mod not known to proxy"},
+		},
+		{
+			name:        "stdlib",
+			module:      "std",
+			formerPaths: []string{"github.com/old/mod"},
+			want:        []string{"former_paths: not allowed for the standard library or toolchain"},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			m := &Module{Module: test.module, FormerPaths: test.formerPaths}
+			l := NewLinter("")
+			m.lintFormerPaths(l, &Report{Modules: []*Module{m}}, test.pc)
+			if diff := cmp.Diff(test.want, l.Errors()); diff != "" {
+				t.Errorf("lints mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,255 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package report
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// The fields of REVIEWED reports that are frozen: curated by a person,
+// so that automated tooling (like the fixers of vulnreport fix) must
+// not change them without being forced to. Each is identified by
+// its lint rule ID (see LintSuppression).
+const (
+	frozenModules        = "modules"
+	frozenVersions       = "modules.versions"
+	frozenPackages       = "modules.packages"
+	frozenSymbols        = "modules.packages.symbols"
+	frozenDerivedSymbols = "modules.packages.derived_symbols"
+)
+
+// A FrozenSnapshot records the frozen fields of a REVIEWED report (the
+// version ranges and symbols of its modules), so that changes to them
+// can be found and undone.
+type FrozenSnapshot struct {
+	modules []*Module
+}
+
+// FrozenSnapshot returns a snapshot of the frozen fields of r,
+// or nil if r is not REVIEWED, in which case no fields are frozen.
+func (r *Report) FrozenSnapshot() *FrozenSnapshot {
+	if !r.IsReviewed() {
+		return nil
+	}
+	s := &FrozenSnapshot{}
+	for _, m := range r.Modules {
+		s.modules = append(s.modules, m.copy())
+	}
+	return s
+}
+
+// A FrozenChange is a change to a frozen field.
+type FrozenChange struct {
+	// Rule is the rule ID of the field, e.g. "modules.versions".
+	Rule string
+	// Description describes the change.
+	Description string
+}
+
+func (c *FrozenChange) String() string {
+	return c.Description
+}
+
+// Changes returns the changes to the frozen fields of r since the
+// snapshot was taken. It returns nil if s is nil.
+func (s *FrozenSnapshot) Changes(r *Report) []*FrozenChange {
+	if s == nil {
+		return nil
+	}
+	var changes []*FrozenChange
+	add := func(rule, format string, args ...any) {
+		changes = append(changes, &FrozenChange{Rule: rule, Description: fmt.Sprintf(format, args...)})
+	}
+	for _, old := range s.modules {
+		m := findModule(r.Modules, old.Module)
+		if m == nil {
+			add(frozenModules, "removed module %s", old.Module)
+			continue
+		}
+		if ovs, vs := describeVersions(old.Versions), describeVersions(m.Versions); ovs != vs {
+			add(frozenVersions, "%s: versions [%s] -> [%s]", m.Module, ovs, vs)
+		}
+		if ovs, vs := describePlatformVersions(old.PlatformVersions), describePlatformVersions(m.PlatformVersions); ovs != vs {
+			add(frozenVersions, "%s: platform_versions [%s] -> [%s]", m.Module, ovs, vs)
+		}
+		for _, op := range old.Packages {
+			p := findPackage(m.Packages, op.Package)
+			if p == nil {
+				add(frozenPackages, "%s: removed package %s", m.Module, op.Package)
+				continue
+			}
+			if !slices.Equal(op.Symbols, p.Symbols) {
+				add(frozenSymbols, "%s: symbols [%s] -> [%s]", p.Package, strings.Join(op.Symbols, ", "), strings.Join(p.Symbols, ", "))
+			}
+			if !slices.Equal(op.DerivedSymbols, p.DerivedSymbols) {
+				add(frozenDerivedSymbols, "%s: derived_symbols [%s] -> [%s]", p.Package, strings.Join(op.DerivedSymbols, ", "), strings.Join(p.DerivedSymbols, ", "))
+			}
+		}
+		for _, p := range m.Packages {
+			if findPackage(old.Packages, p.Package) == nil {
+				add(frozenPackages, "%s: added package %s", m.Module, p.Package)
+			}
+		}
+	}
+	for _, m := range r.Modules {
+		if findModule(s.modules, m.Module) == nil {
+			add(frozenModules, "added module %s", m.Module)
+		}
+	}
+	return changes
+}
+
+// Restore undoes the changes to the frozen fields of r since the
+// snapshot was taken, leaving the other fields as they are: modules
+// and packages that were added are removed, those that were removed
+// are added back, and the versions and symbols of the others are
+// restored.
+func (s *FrozenSnapshot) Restore(r *Report) {
+	if s == nil {
+		return
+	}
+	var modules []*Module
+	for _, old := range s.modules {
+		m := findModule(r.Modules, old.Module)
+		if m == nil {
+			modules = append(modules, old.copy())
+			continue
+		}
+		m.Versions = old.Versions.copy()
+		m.PlatformVersions = copyPlatformVersions(old.PlatformVersions)
+		var packages []*Package
+		for _, op := range old.Packages {
+			p := findPackage(m.Packages, op.Package)
+			if p == nil {
+				packages = append(packages, op.copy())
+				continue
+			}
+			p.Symbols = slices.Clone(op.Symbols)
+			p.DerivedSymbols = slices.Clone(op.DerivedSymbols)
+			packages = append(packages, p)
+		}
+		m.Packages = packages
+		modules = append(modules, m)
+	}
+	r.Modules = modules
+}
+
+// describeVersions describes vs with the type of each version,
+// e.g. "introduced 1.0.0, fixed 1.2.0".
+func describeVersions(vs Versions) string {
+	var s []string
+	for _, v := range vs {
+		s = append(s, fmt.Sprintf("%s %s", v.Type, v.Version))
+	}
+	return strings.Join(s, ", ")
+}
+
+func findModule(ms []*Module, path string) *Module {
+	for _, m := range ms {
+		if m.Module == path {
+			return m
+		}
+	}
+	return nil
+}
+
+func findPackage(ps []*Package, path string) *Package {
+	for _, p := range ps {
+		if p.Package == path {
+			return p
+		}
+	}
+	return nil
+}
+
+// A FrozenOverride records that the frozen fields of a REVIEWED report
+// were changed by forcing automated tooling to change them. Overrides
+// are written as notes of type force-reviewed, in the form
+// "rule-id[,rule-id...] reason on=YYYY-MM-DD", for example:
+//
+//	notes:
+//	    - force-reviewed: modules.versions add fixed version from the fix commit on=2025-06-01
+type FrozenOverride struct {
+	// Rules are the rule IDs of the fields that were changed.
+	Rules  []string
+	Reason string
+	// On is the date of the change.
+	On time.Time
+}
+
+// NewFrozenOverride returns an override of the frozen fields changed
+// by the given changes, with the given reason, on date t.
+func NewFrozenOverride(changes []*FrozenChange, reason string, t time.Time) *FrozenOverride {
+	o := &FrozenOverride{Reason: reason, On: t.UTC().Truncate(24 * time.Hour)}
+	for _, c := range changes {
+		o.Rules = append(o.Rules, c.Rule)
+	}
+	slices.Sort(o.Rules)
+	o.Rules = slices.Compact(o.Rules)
+	return o
+}
+
+const onPrefix = "on="
+
+// ParseFrozenOverride parses the body of a force-reviewed note.
+func ParseFrozenOverride(body string) (*FrozenOverride, error) {
+	fields := strings.Fields(body)
+	if len(fields) == 0 {
+		return nil, errors.New("missing rule IDs")
+	}
+	o := &FrozenOverride{}
+	for _, rule := range strings.Split(fields[0], ",") {
+		if !slices.Contains([]string{frozenModules, frozenVersions, frozenPackages, frozenSymbols, frozenDerivedSymbols}, rule) {
+			return nil, fmt.Errorf("%q is not a frozen field", rule)
+		}
+		o.Rules = append(o.Rules, rule)
+	}
+	var reason []string
+	for _, f := range fields[1:] {
+		d, ok := strings.CutPrefix(f, onPrefix)
+		if !ok {
+			reason = append(reason, f)
+			continue
+		}
+		if !o.On.IsZero() {
+			return nil, errors.New("more than one date")
+		}
+		on, err := time.Parse(time.DateOnly, d)
+		if err != nil {
+			return nil, fmt.Errorf("invalid date %q (want %s%s)", d, onPrefix, "YYYY-MM-DD")
+		}
+		o.On = on
+	}
+	if len(reason) == 0 {
+		return nil, errors.New("missing reason")
+	}
+	if o.On.IsZero() {
+		return nil, fmt.Errorf("missing date (want %s%s)", onPrefix, "YYYY-MM-DD")
+	}
+	o.Reason = strings.Join(reason, " ")
+	return o, nil
+}
+
+func (o *FrozenOverride) String() string {
+	return fmt.Sprintf("%s %s %s%s", strings.Join(o.Rules, ","), o.Reason, onPrefix, o.On.Format(time.DateOnly))
+}
+
+// lintFrozenOverrides checks that the force-reviewed notes of r
+// are well-formed.
+func (r *Report) lintFrozenOverrides(l *linter) {
+	nl := l.Group("notes")
+	for _, n := range r.Notes {
+		if n.Type != NoteTypeForceReviewed {
+			continue
+		}
+		if _, err := ParseFrozenOverride(n.Body); err != nil {
+			nl.Errorf("force-reviewed %q: %v", n.Body, err)
+		}
+	}
+}
@@ -0,0 +1,169 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package report
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestFrozenSnapshot(t *testing.T) {
+	reviewed := func() *Report {
+		return &Report{
+			ID:           "GO-9999-0001",
+			ReviewStatus: Reviewed,
+			Summary:      "Vulnerability in example.com/a",
+			Modules: []*Module{{
+				Module:   "example.com/a",
+				Versions: Versions{Introduced("1.0.0"), Fixed("1.2.0")},
+				Packages: []*Package{{
+					Package: "example.com/a/p",
+					Symbols: []string{"F"},
+				}},
+			}},
+		}
+	}
+
+	t.Run("unreviewed", func(t *testing.T) {
+		r := reviewed()
+		r.ReviewStatus = Unreviewed
+		s := r.FrozenSnapshot()
+		if s != nil {
+			t.Fatalf("FrozenSnapshot() = %v, want nil", s)
+		}
+		r.Modules = nil
+		if got := s.Changes(r); got != nil {
+			t.Errorf("Changes() = %v, want nil", got)
+		}
+	})
+
+	t.Run("unchanged fields", func(t *testing.T) {
+		r := reviewed()
+		s := r.FrozenSnapshot()
+		r.Summary = "Changed summary"
+		r.Modules[0].VulnerableAt = VulnerableAt("1.1.0")
+		if got := s.Changes(r); got != nil {
+			t.Errorf("Changes() = %v, want nil", got)
+		}
+	})
+
+	t.Run("changed", func(t *testing.T) {
+		r := reviewed()
+		s := r.FrozenSnapshot()
+		r.Summary = "Changed summary"
+		r.Modules[0].Versions = Versions{Fixed("1.2.0")}
+		r.Modules[0].Packages[0].Symbols = []string{"F", "G"}
+		r.Modules[0].Packages = append(r.Modules[0].Packages, &Package{Package: "example.com/a/q"})
+		r.Modules = append(r.Modules, &Module{Module: "example.com/b"})
+
+		var got []string
+		for _, c := range s.Changes(r) {
+			got = append(got, c.Rule+": "+c.String())
+		}
+		want := []string{
+			"modules.versions: example.com/a: versions [introduced 1.0.0, fixed 1.2.0] -> [fixed 1.2.0]",
+			"modules.packages.symbols: example.com/a/p: symbols [F] -> [F, G]",
+			"modules.packages: example.com/a: added package example.com/a/q",
+			"modules: added module example.com/b",
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("Changes() mismatch (-want, +got):\n%s", diff)
+		}
+
+		s.Restore(r)
+		wantReport := reviewed()
+		wantReport.Summary = "Changed summary"
+		if diff := cmp.Diff(wantReport, r); diff != "" {
+			t.Errorf("Restore() mismatch (-want, +got):\n%s", diff)
+		}
+	})
+
+	t.Run("removed", func(t *testing.T) {
+		r := reviewed()
+		s := r.FrozenSnapshot()
+		r.Modules = nil
+		var got []string
+		for _, c := range s.Changes(r) {
+			got = append(got, c.String())
+		}
+		if want := []string{"removed module example.com/a"}; !cmp.Equal(want, got) {
+			t.Errorf("Changes() = %v, want %v", got, want)
+		}
+		s.Restore(r)
+		if diff := cmp.Diff(reviewed(), r); diff != "" {
+			t.Errorf("Restore() mismatch (-want, +got):\n%s", diff)
+		}
+	})
+}
+
+func TestParseFrozenOverride(t *testing.T) {
+	on := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	for _, test := range []struct {
+		body    string
+		want    *FrozenOverride
+		wantErr string
+	}{
+		{
+			body: "modules.versions,modules.packages.symbols fixed version was wrong on=2025-06-01",
+			want: &FrozenOverride{
+				Rules:  []string{"modules.versions", "modules.packages.symbols"},
+				Reason: "fixed version was wrong",
+				On:     on,
+			},
+		},
+		{
+			body:    "",
+			wantErr: "missing rule IDs",
+		},
+		{
+			body:    "summary reason on=2025-06-01",
+			wantErr: `"summary" is not a frozen field`,
+		},
+		{
+			body:    "modules.versions on=2025-06-01",
+			wantErr: "missing reason",
+		},
+		{
+			body:    "modules.versions reason",
+			wantErr: "missing date (want on=YYYY-MM-DD)",
+		},
+		{
+			body:    "modules.versions reason on=June",
+			wantErr: `invalid date "June" (want on=YYYY-MM-DD)`,
+		},
+	} {
+		t.Run(test.body, func(t *testing.T) {
+			got, err := ParseFrozenOverride(test.body)
+			if test.wantErr != "" {
+				if err == nil || err.Error() != test.wantErr {
+					t.Fatalf("ParseFrozenOverride() error = %v, want %q", err, test.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("ParseFrozenOverride() mismatch (-want, +got):\n%s", diff)
+			}
+			if s := got.String(); s != test.body {
+				t.Errorf("String() = %q, want %q", s, test.body)
+			}
+		})
+	}
+}
+
+func TestNewFrozenOverride(t *testing.T) {
+	changes := []*FrozenChange{
+		{Rule: frozenVersions}, {Rule: frozenSymbols}, {Rule: frozenVersions},
+	}
+	o := NewFrozenOverride(changes, "fixed version was wrong", time.Date(2025, 6, 1, 15, 4, 5, 0, time.UTC))
+	want := "modules.packages.symbols,modules.versions fixed version was wrong on=2025-06-01"
+	if got := o.String(); got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+}
@@ -26,6 +26,9 @@ import (
 
 func (m *Module) checkModVersions(pc *proxy.Client) error {
 	if ok := pc.ModuleExists(m.Module); !ok {
+		if s := suggestCasing(pc, m.Module); s != "" {
+			return fmt.Errorf("module %s not known to proxy (did you mean %s?)", m.Module, s)
+		}
 		return fmt.Errorf("module %s not known to proxy", m.Module)
 	}
 
@@ -321,6 +324,40 @@ func (r *Report) lintSource(l *linter) {
 	if !r.IsReviewed() && r.SourceMeta.ID == sourceGoTeam {
 		l.Errorf("source: if id=%s, report must be %s", sourceGoTeam, Reviewed)
 	}
+	if o := r.SourceMeta.Origin; o != "" {
+		if !slices.Contains(sourceOrigins, o) {
+			l.Errorf("source: %q is not a valid origin (must be one of %s)", o, strings.Join(sourceOriginValues(), ", "))
+			return
+		}
+		if implied := r.impliedOrigin(); implied != o {
+			l.Errorf("source: origin %s does not match id %s", o, r.SourceMeta.ID)
+			return
+		}
+	}
+
+	// Only the Go CNA assigns the CVEs in cve_metadata, so reports
+	// from other sources must list their source among their aliases.
+	switch o := r.Origin(); o {
+	case OriginCVE, OriginGHSA:
+		if r.CVEMetadata != nil {
+			l.Group("cve_metadata").Errorf("not allowed if source.origin=%s (the Go CNA did not assign the CVE)", o)
+		}
+		aliases := r.CVEs
+		if o == OriginGHSA {
+			aliases = r.GHSAs
+		}
+		if !slices.Contains(aliases, r.SourceMeta.ID) {
+			l.Errorf("source: id %s is not listed in %ss", r.SourceMeta.ID, strings.ToLower(string(o)))
+		}
+	}
+}
+
+func sourceOriginValues() []string {
+	var vs []string
+	for _, o := range sourceOrigins {
+		vs = append(vs, string(o))
+	}
+	return vs
 }
 
 func (r *Report) countAdvisories() int {
@@ -347,6 +384,7 @@ func (d *Description) lint(l *linter, r *Report) {
 	desc := d.String()
 
 	checkNoMarkdown(l, desc)
+	checkNoHTML(l, desc)
 	r.lintLineLength(l, desc)
 	if !r.IsExcluded() && desc == "" {
 		if r.CVEMetadata != nil {
@@ -492,11 +530,19 @@ func (r *Report) CheckFilename(filename string) (err error) {
 // TODO: It might make sense to include warnings or informational things
 // alongside errors, especially during for use during the triage process.
 func (r *Report) Lint(pc *proxy.Client) []string {
-	result := r.lint(pc)
+	result, _, _ := r.LintWithSuppressed(pc)
+	return result
+}
+
+// LintWithSuppressed works like Lint, but also returns the lints
+// that the lint policy downgraded to warnings, and those that were
+// suppressed by the report's lint-ignore notes.
+func (r *Report) LintWithSuppressed(pc *proxy.Client) (lints, warnings, suppressed []string) {
+	result, warnings, suppressed := r.lintSuppressing(pc)
 	if pc == nil {
 		result = append(result, "proxy client is nil; cannot perform all lint checks")
 	}
-	return result
+	return result, warnings, suppressed
 }
 
 // LintAsNotes works like Lint, but modifies r by adding any lints found
@@ -543,6 +589,14 @@ func (r *Report) LintOffline() []string {
 }
 
 func (r *Report) lint(pc *proxy.Client) []string {
+	lints, _, _ := r.lintSuppressing(pc)
+	return lints
+}
+
+// runLinter performs all lint checks on r, skipping those that
+// need the proxy if pc is nil, and returns the linter holding the
+// lints found.
+func (r *Report) runLinter(pc *proxy.Client) *linter {
 	l := NewLinter("")
 
 	if r.ID == "" {
@@ -552,6 +606,16 @@ func (r *Report) lint(pc *proxy.Client) []string {
 	r.Summary.lint(l.Group("summary"), r)
 	r.Description.lint(l.Group("description"), r)
 	r.Excluded.lint(l.Group("excluded"))
+	r.lintWithdrawn(l)
+	r.lintFrozenOverrides(l)
+	r.lintPatternClass(l)
+	for i, c := range r.Conditions {
+		c.lint(l.Group(name("conditions", i, "")))
+	}
+	for i, m := range r.Mitigations {
+		m.lint(l.Group(name("mitigations", i, m.GODEBUG)), r)
+	}
+	r.EPSS.lint(l.Group("epss"), r)
 
 	r.lintModules(l, pc)
 
@@ -565,15 +629,17 @@ func (r *Report) lint(pc *proxy.Client) []string {
 	r.lintGHSAs(l)
 	r.lintRelated(l)
 
+	r.lintCredits(l)
 	r.lintReferences(l)
 	r.lintReviewStatus(l)
 	r.lintSource(l)
+	r.lintOrder(l)
 
 	if r.hasTODOs() {
 		l.Error("contains one or more TODOs")
 	}
 
-	return l.Errors()
+	return l
 }
 
 func (m *Module) lint(l *linter, r *Report, pc *proxy.Client) {
@@ -583,9 +649,11 @@ func (m *Module) lint(l *linter, r *Report, pc *proxy.Client) {
 
 	if m.Module == "" {
 		l.Error("no module name")
+	} else if !r.IsExcluded() && !m.IsFirstParty() {
+		m.lintPath(l)
 	}
 
-	if !r.IsExcluded() && !m.IsFirstParty() && pc != nil {
+	if !r.IsExcluded() && !m.IsFirstParty() && pc != nil && !pc.IsPrivate(m.Module) {
 		if err := m.checkModVersions(pc); err != nil {
 			l.Error(err)
 		}
@@ -605,7 +673,13 @@ func (m *Module) lint(l *linter, r *Report, pc *proxy.Client) {
 		}
 	}
 
+	if !r.IsExcluded() {
+		m.lintFormerPaths(l, r, pc)
+	}
 	m.lintVersions(l, r)
+	m.lintUnaffectedVersions(l)
+	m.lintPlatformVersions(l)
+	m.lintFixMatrix(l, pc)
 }
 
 func (p *Package) lint(l *linter, m *Module, r *Report) {
@@ -614,7 +688,11 @@ func (p *Package) lint(l *linter, m *Module, r *Report) {
 	} else {
 		if m.Module != stdlib.ModulePath {
 			if !strings.HasPrefix(p.Package, m.Module) {
-				l.Error("module must be a prefix of package")
+				if len(p.Package) >= len(m.Module) && strings.EqualFold(p.Package[:len(m.Module)], m.Module) {
+					l.Error("module must be a prefix of package (they differ in case)")
+				} else {
+					l.Error("module must be a prefix of package")
+				}
 			}
 		} else {
 			if p.Package == "runtime" && len(p.Symbols) != 0 {
@@ -639,6 +717,20 @@ func (p *Package) lint(l *linter, m *Module, r *Report) {
 			l.Error("at least one of vulnerable_at and skip_fix must be set")
 		}
 	}
+
+	if p.SkipFixSymbols != "" && skipFixAvailabilityRegexp.MatchString(p.SkipFixSymbols) {
+		l.Group("skip_fix").Error("must not record whether a fix exists (use fix_status)")
+	}
+	if p.FixStatus != nil {
+		p.FixStatus.lint(l.Group("fix_status"), m)
+	}
+
+	all := p.AllSymbols()
+	for _, s := range p.DisputedSymbols {
+		if !slices.Contains(all, s) {
+			l.Group("disputed_symbols").Errorf("%s is not an affected symbol", s)
+		}
+	}
 }
 
 func (r *Report) lintModules(l *linter, pc *proxy.Client) {
@@ -649,6 +741,7 @@ func (r *Report) lintModules(l *linter, pc *proxy.Client) {
 	for i, m := range r.Modules {
 		m.lint(l.Group(name("modules", i, m.Module)), r, pc)
 	}
+	r.lintCaseCollisions(l)
 }
 
 func (r *Report) IsFirstParty() bool {
@@ -673,6 +766,40 @@ func (e *ExcludedType) lint(l *linter) {
 	}
 }
 
+func (r *Report) lintWithdrawn(l *linter) {
+	if r.WithdrawnReason == "" {
+		return
+	}
+	wl := l.Group("withdrawn_reason")
+	if !r.WithdrawnReason.IsValid() {
+		wl.Errorf("%q is not a valid withdrawn reason (accepted: %v)", r.WithdrawnReason, WithdrawnReasons)
+	}
+	if r.Withdrawn == nil {
+		wl.Error("set, but report is not withdrawn")
+	}
+	if r.IsExcluded() {
+		wl.Error("excluded reports cannot be withdrawn")
+	}
+}
+
+func (r *Report) lintPatternClass(l *linter) {
+	if r.PatternClass == "" {
+		return
+	}
+	pl := l.Group("pattern_class")
+	if !r.PatternClass.IsValid() {
+		pl.Errorf("%q is not a valid pattern class (accepted: %v)", r.PatternClass, PatternClasses)
+		return
+	}
+	if r.CVEMetadata == nil {
+		return
+	}
+	// A CWE in the vocabulary must belong to the pattern class.
+	if pc, ok := PatternClassForCWE(r.CVEMetadata.CWE); ok && pc != r.PatternClass {
+		pl.Errorf("%s does not match cve_metadata.cwe (%q is in class %s)", r.PatternClass, r.CVEMetadata.CWE, pc)
+	}
+}
+
 func (m *CVEMeta) lint(l *linter, r *Report) {
 	if m == nil {
 		return
@@ -683,6 +810,10 @@ func (m *CVEMeta) lint(l *linter, r *Report) {
 		il.Error(missing)
 	} else if !idstr.IsCVE(m.ID) {
 		il.Error("not a valid CVE")
+	} else if slices.Contains(r.CVEs, m.ID) {
+		// cve_metadata is for CVEs assigned by the Go CNA, and
+		// cves for CVEs referenced from other CNAs.
+		il.Errorf("%s is also listed in cves (a CVE is either Go-assigned or referenced, not both)", m.ID)
 	}
 
 	cl := l.Group("cwe")
@@ -693,7 +824,12 @@ func (m *CVEMeta) lint(l *linter, r *Report) {
 		cl.Error(hasTODOErr)
 	}
 
+	checkNoHTML(l.Group("description"), m.Description)
 	r.lintLineLength(l.Group("description"), m.Description)
+
+	if m.State != "" && !slices.Contains(CVEStates, m.State) {
+		l.Group("state").Errorf("%q is not a valid state (must be one of %s)", m.State, strings.Join(CVEStates, ", "))
+	}
 }
 
 var hasTODOErr = "contains a TODO"
@@ -719,6 +855,16 @@ func checkNoMarkdown(l *linter, s string) {
 	}
 }
 
+// htmlRE matches HTML tags, which are shown as-is
+// rather than rendered.
+var htmlRE = regexp.MustCompile(`(</?(?:a|b|blockquote|br|code|details|div|em|h[1-6]|hr|i|img|kbd|li|ol|p|pre|span|strong|sub|summary|sup|table|tbody|td|th|thead|tr|tt|u|ul)(?:\s[^<>]*)?/?>)`)
+
+func checkNoHTML(l *linter, s string) {
+	if m := htmlRE.FindStringSubmatch(s); m != nil {
+		l.Errorf("possible raw HTML (found %s)", m[1])
+	}
+}
+
 func (r *Report) hasTODOs() bool {
 	is := hasTODO
 	any := func(ss []string) bool { return slices.IndexFunc(ss, is) >= 0 }
@@ -753,5 +899,5 @@ func (r *Report) hasTODOs() bool {
 	if any(r.CVEs) || any(r.GHSAs) {
 		return true
 	}
-	return is(r.Description.String()) || any(r.Credits)
+	return is(r.Description.String()) || any(r.CreditNames())
 }
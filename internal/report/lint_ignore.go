@@ -0,0 +1,144 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package report
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"golang.org/x/vulndb/internal/proxy"
+)
+
+// A LintSuppression suppresses the lints of a report matching a rule
+// until a given date. Suppressions are written as notes of type
+// lint-ignore, in the form "rule-id reason until=YYYY-MM-DD", for
+// example:
+//
+//	notes:
+//	    - lint-ignore: modules.versions no fix upstream until=2025-06-01
+//
+// The ID of the rule of a lint is the path of the field it was found
+// in, without indexes or names, joined by dots: the lint
+// `modules[0] "example.com/m": versions: ...` has rule ID
+// "modules.versions". Lints that are not about a particular field
+// have rule ID "report". A suppression matches the lints of its rule
+// and of the rules below it, so "modules" matches "modules.versions".
+type LintSuppression struct {
+	Rule   string
+	Reason string
+	// Until is the date on which the suppression expires.
+	Until time.Time
+}
+
+const untilPrefix = "until="
+
+// ParseLintSuppression parses the body of a lint-ignore note.
+func ParseLintSuppression(body string) (*LintSuppression, error) {
+	fields := strings.Fields(body)
+	if len(fields) == 0 {
+		return nil, errors.New("missing rule ID")
+	}
+	s := &LintSuppression{Rule: fields[0]}
+	if strings.HasPrefix(s.Rule, untilPrefix) {
+		return nil, errors.New("missing rule ID")
+	}
+	var reason []string
+	for _, f := range fields[1:] {
+		d, ok := strings.CutPrefix(f, untilPrefix)
+		if !ok {
+			reason = append(reason, f)
+			continue
+		}
+		if !s.Until.IsZero() {
+			return nil, errors.New("more than one expiry date")
+		}
+		until, err := time.Parse(time.DateOnly, d)
+		if err != nil {
+			return nil, fmt.Errorf("invalid expiry date %q (want %s%s)", d, untilPrefix, "YYYY-MM-DD")
+		}
+		s.Until = until
+	}
+	if len(reason) == 0 {
+		return nil, errors.New("missing reason")
+	}
+	if s.Until.IsZero() {
+		return nil, fmt.Errorf("missing expiry date (want %s%s)", untilPrefix, "YYYY-MM-DD")
+	}
+	s.Reason = strings.Join(reason, " ")
+	return s, nil
+}
+
+func (s *LintSuppression) String() string {
+	return fmt.Sprintf("%s %s %s%s", s.Rule, s.Reason, untilPrefix, s.Until.Format(time.DateOnly))
+}
+
+// Expired reports whether the suppression has expired at time t.
+func (s *LintSuppression) Expired(t time.Time) bool {
+	return !t.Before(s.Until)
+}
+
+func (s *LintSuppression) matches(e *lintError) bool {
+	id := e.rule()
+	return id == s.Rule || strings.HasPrefix(id, s.Rule+".")
+}
+
+// rule returns the rule ID of the lint.
+func (e *lintError) rule() string {
+	if len(e.path) == 0 {
+		return "report"
+	}
+	var parts []string
+	for _, p := range e.path {
+		p, _, _ = strings.Cut(p, "[")
+		parts = append(parts, strings.TrimSpace(p))
+	}
+	return strings.Join(parts, ".")
+}
+
+// lintSuppressing lints r, and separates the lints suppressed by
+// the lint-ignore notes of r from those that are not. Of the lints
+// that are not suppressed, those that the lint policy downgrades
+// are returned as warnings, and those it turns off are dropped.
+// Malformed and expired suppressions are themselves lints, and
+// cannot be suppressed or downgraded.
+func (r *Report) lintSuppressing(pc *proxy.Client) (lints, warnings, suppressed []string) {
+	l := r.runLinter(pc)
+
+	nl := NewLinter("notes")
+	now := time.Now()
+	var active []*LintSuppression
+	for _, n := range r.Notes {
+		if n.Type != NoteTypeLintIgnore {
+			continue
+		}
+		s, err := ParseLintSuppression(n.Body)
+		if err != nil {
+			nl.Errorf("lint-ignore %q: %v", n.Body, err)
+			continue
+		}
+		if s.Expired(now) {
+			nl.Errorf("lint-ignore %s: expired on %s (fix the lints or extend the suppression)", s.Rule, s.Until.Format(time.DateOnly))
+			continue
+		}
+		active = append(active, s)
+	}
+
+	policy := currentLintPolicy()
+	for _, e := range l.lints() {
+		switch {
+		case slices.ContainsFunc(active, func(s *LintSuppression) bool { return s.matches(e) }):
+			suppressed = append(suppressed, e.String())
+		case policy.level(e.rule()) == LintOff:
+		case policy.level(e.rule()) == LintWarning:
+			warnings = append(warnings, e.String())
+		default:
+			lints = append(lints, e.String())
+		}
+	}
+	return append(lints, nl.Errors()...), warnings, suppressed
+}
@@ -0,0 +1,76 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package report
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseLintSuppression(t *testing.T) {
+	for _, tc := range []struct {
+		body    string
+		want    *LintSuppression
+		wantErr bool
+	}{
+		{
+			body: "modules.versions no fix upstream until=2025-06-01",
+			want: &LintSuppression{
+				Rule:   "modules.versions",
+				Reason: "no fix upstream",
+				Until:  time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			body: "  references  until=2025-06-01 legacy  ",
+			want: &LintSuppression{
+				Rule:   "references",
+				Reason: "legacy",
+				Until:  time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{body: "", wantErr: true},
+		{body: "until=2025-06-01 reason", wantErr: true},
+		{body: "summary until=2025-06-01", wantErr: true},
+		{body: "summary reason", wantErr: true},
+		{body: "summary reason until=06/01/2025", wantErr: true},
+		{body: "summary reason until=2025-06-01 until=2025-07-01", wantErr: true},
+	} {
+		got, err := ParseLintSuppression(tc.body)
+		if (err != nil) != tc.wantErr {
+			t.Errorf("ParseLintSuppression(%q) error = %v, want error: %t", tc.body, err, tc.wantErr)
+			continue
+		}
+		if diff := cmp.Diff(tc.want, got); diff != "" {
+			t.Errorf("ParseLintSuppression(%q) mismatch (-want, +got):\n%s", tc.body, diff)
+		}
+	}
+}
+
+func TestLintWithSuppressed(t *testing.T) {
+	r := validReport(func(r *Report) {
+		r.Summary = ""
+		r.Modules[0].Packages[0].Package = ""
+		r.Notes = []*Note{
+			{Type: NoteTypeLintIgnore, Body: "modules legacy report until=2999-01-01"},
+		}
+	})
+	lints, _, suppressed := r.LintWithSuppressed(nil)
+	wantLints := []string{
+		"summary: missing",
+		"proxy client is nil; cannot perform all lint checks",
+	}
+	if diff := cmp.Diff(wantLints, lints); diff != "" {
+		t.Errorf("lints mismatch (-want, +got):\n%s", diff)
+	}
+	wantSuppressed := []string{
+		`modules[0] "golang.org/x/net": packages[0]: no package name`,
+	}
+	if diff := cmp.Diff(wantSuppressed, suppressed); diff != "" {
+		t.Errorf("suppressed mismatch (-want, +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,116 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package report
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"maps"
+	"slices"
+	"strings"
+	"sync/atomic"
+
+	"gopkg.in/yaml.v3"
+)
+
+// LintPolicyFile is the name of the file in the root of a vulndb
+// repo that holds its lint policy, if it has one.
+const LintPolicyFile = "lint_policy.yaml"
+
+// A LintPolicy sets the level of lint rules, so that forks of
+// the database can be more or less strict than the Go vulnerability
+// database. It is written in YAML, for example:
+//
+//	rules:
+//	    cve_metadata.cwe: off
+//	    references: warning
+//	    references.advisory: error
+//
+// The keys are rule IDs, as described in LintSuppression. Like a
+// suppression, a rule applies to the lints of its rule ID and of the
+// rules below it; if more than one rule applies to a lint, the most
+// specific one wins. Lints that no rule applies to are errors, so the
+// zero policy (which is the default) is the Go vulnerability database's.
+type LintPolicy struct {
+	Rules map[string]LintLevel `yaml:"rules,omitempty"`
+}
+
+// A LintLevel is what happens to the lints of a rule.
+type LintLevel string
+
+const (
+	// LintError lints make a report invalid.
+	LintError LintLevel = "error"
+	// LintWarning lints are reported, but don't make a report invalid.
+	LintWarning LintLevel = "warning"
+	// LintOff lints are not reported.
+	LintOff LintLevel = "off"
+)
+
+var lintLevels = []LintLevel{LintError, LintWarning, LintOff}
+
+// ParseLintPolicy parses and validates a lint policy file.
+func ParseLintPolicy(b []byte) (*LintPolicy, error) {
+	d := yaml.NewDecoder(bytes.NewReader(b))
+	d.KnownFields(true)
+	var p LintPolicy
+	if err := d.Decode(&p); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("%s: %w", LintPolicyFile, err)
+	}
+	for _, rule := range slices.Sorted(maps.Keys(p.Rules)) {
+		if rule == "" || strings.ContainsAny(rule, " []") {
+			return nil, fmt.Errorf("%s: invalid rule ID %q", LintPolicyFile, rule)
+		}
+		if lvl := p.Rules[rule]; !slices.Contains(lintLevels, lvl) {
+			return nil, fmt.Errorf("%s: rule %s: invalid level %q (must be one of %s)", LintPolicyFile, rule, lvl, lintLevels)
+		}
+	}
+	return &p, nil
+}
+
+// ReadLintPolicy reads the lint policy of the vulndb repo in fsys,
+// returning the default policy if it doesn't have one.
+func ReadLintPolicy(fsys fs.FS) (*LintPolicy, error) {
+	b, err := fs.ReadFile(fsys, LintPolicyFile)
+	if errors.Is(err, fs.ErrNotExist) {
+		return new(LintPolicy), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return ParseLintPolicy(b)
+}
+
+// level returns the level of a lint with the given rule ID.
+func (p *LintPolicy) level(rule string) LintLevel {
+	for {
+		if lvl, ok := p.Rules[rule]; ok {
+			return lvl
+		}
+		i := strings.LastIndexByte(rule, '.')
+		if i < 0 {
+			return LintError
+		}
+		rule = rule[:i]
+	}
+}
+
+var lintPolicy atomic.Pointer[LintPolicy]
+
+// SetLintPolicy sets the policy used by Lint and the other lint
+// functions. A nil policy restores the default.
+func SetLintPolicy(p *LintPolicy) {
+	lintPolicy.Store(p)
+}
+
+func currentLintPolicy() *LintPolicy {
+	if p := lintPolicy.Load(); p != nil {
+		return p
+	}
+	return new(LintPolicy)
+}
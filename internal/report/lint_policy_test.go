@@ -0,0 +1,105 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package report
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseLintPolicy(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		in      string
+		want    *LintPolicy
+		wantErr bool
+	}{
+		{name: "empty", in: "", want: &LintPolicy{}},
+		{
+			name: "ok",
+			in:   "rules:\n  cve_metadata.cwe: off\n  references: warning\n  references.advisory: error\n",
+			want: &LintPolicy{Rules: map[string]LintLevel{
+				"cve_metadata.cwe":    LintOff,
+				"references":          LintWarning,
+				"references.advisory": LintError,
+			}},
+		},
+		{name: "bad level", in: "rules:\n  summary: ignore\n", wantErr: true},
+		{name: "bad rule", in: "rules:\n  modules[0]: off\n", wantErr: true},
+		{name: "unknown field", in: "rule:\n  summary: off\n", wantErr: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := ParseLintPolicy([]byte(tc.in))
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("ParseLintPolicy() error = %v, want error: %t", err, tc.wantErr)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestReadLintPolicy(t *testing.T) {
+	got, err := ReadLintPolicy(fstest.MapFS{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(&LintPolicy{}, got); diff != "" {
+		t.Errorf("missing file: mismatch (-want, +got):\n%s", diff)
+	}
+}
+
+func TestLintPolicyLevel(t *testing.T) {
+	p := &LintPolicy{Rules: map[string]LintLevel{
+		"modules":          LintOff,
+		"modules.versions": LintError,
+		"summary":          LintWarning,
+	}}
+	for rule, want := range map[string]LintLevel{
+		"modules":                   LintOff,
+		"modules.packages":          LintOff,
+		"modules.versions":          LintError,
+		"modules.versions.fixed":    LintError,
+		"summary":                   LintWarning,
+		"summary_extra":             LintError,
+		"report":                    LintError,
+		"cve_metadata.cwe":          LintError,
+		"modules.vulnerable_at.foo": LintOff,
+	} {
+		if got := p.level(rule); got != want {
+			t.Errorf("level(%q) = %s, want %s", rule, got, want)
+		}
+	}
+}
+
+func TestLintWithPolicy(t *testing.T) {
+	SetLintPolicy(&LintPolicy{Rules: map[string]LintLevel{
+		"summary":   LintWarning,
+		"modules":   LintOff,
+		"notes":     LintOff, // can't be turned off
+		"unrelated": LintOff,
+	}})
+	defer SetLintPolicy(nil)
+
+	r := validReport(func(r *Report) {
+		r.Summary = ""
+		r.Modules[0].Packages[0].Package = ""
+		r.Notes = []*Note{{Type: NoteTypeLintIgnore, Body: "malformed"}}
+	})
+	lints, warnings, _ := r.LintWithSuppressed(nil)
+	wantLints := []string{
+		`notes: lint-ignore "malformed": missing reason`,
+		"proxy client is nil; cannot perform all lint checks",
+	}
+	if diff := cmp.Diff(wantLints, lints); diff != "" {
+		t.Errorf("lints mismatch (-want, +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"summary: missing"}, warnings); diff != "" {
+		t.Errorf("warnings mismatch (-want, +got):\n%s", diff)
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
@@ -31,8 +32,8 @@ var (
 var (
 	validStdLibReferences = []*Reference{
 		{Type: osv.ReferenceTypeFix, URL: "https://go.dev/cl/12345"},
-		{Type: osv.ReferenceTypeWeb, URL: "https://groups.google.com/g/golang-announce/c/12345"},
 		{Type: osv.ReferenceTypeReport, URL: "https://go.dev/issue/12345"},
+		{Type: osv.ReferenceTypeWeb, URL: "https://groups.google.com/g/golang-announce/c/12345"},
 	}
 	validCVEMetadata = &CVEMeta{
 		ID:  "CVE-0000-1111",
@@ -147,16 +148,68 @@ func TestLint(t *testing.T) {
 			pc:           pc,
 			wantNumLints: 1,
 		},
+		{
+			name: "fix_matrix_ok",
+			desc: "Fix matrices whose fixed versions are Go releases are OK.",
+			report: validStdReport(func(r *Report) {
+				r.Modules[0].Versions = Versions{Fixed("1.21.8"), Introduced("1.22.0"), Fixed("1.22.1")}
+				r.Modules[0].VulnerableAt = VulnerableAt("1.22.0")
+				r.Modules[0].FixMatrix = []*FixedRelease{
+					{Go: "1.21", Fixed: "1.21.8"},
+					{Go: "1.22", Fixed: "1.22.1"},
+				}
+			}),
+			pc: pc,
+			// No lints.
+		},
+		{
+			name: "fix_matrix_not_release",
+			desc: "The fixed versions in a fix matrix must be Go releases.",
+			report: validStdReport(func(r *Report) {
+				r.Modules[0].Versions = Versions{Fixed("1.21.9"), Introduced("1.22.0"), Fixed("1.22.1")}
+				r.Modules[0].VulnerableAt = VulnerableAt("1.22.0")
+				r.Modules[0].FixMatrix = []*FixedRelease{
+					{Go: "1.21", Fixed: "1.21.9"},
+					{Go: "1.22", Fixed: "1.22.1"},
+				}
+			}),
+			pc:           pc,
+			wantNumLints: 1,
+		},
+		{
+			name: "fix_matrix_missing_supported",
+			desc: "If the minor version before the latest fixed one is affected, it must have a fix.",
+			report: validStdReport(func(r *Report) {
+				r.Modules[0].Versions = Versions{Fixed("1.22.1")}
+				r.Modules[0].VulnerableAt = VulnerableAt("1.22.0")
+				r.Modules[0].FixMatrix = []*FixedRelease{{Go: "1.22", Fixed: "1.22.1"}}
+			}),
+			pc:           pc,
+			wantNumLints: 1,
+		},
 		{
 			name: "module_non_canonical",
 			desc: "Module names must be canonical.",
 			report: validReport(func(r *Report) {
-				r.Modules = append(r.Modules, &Module{
+				r.Modules = append([]*Module{{
 					Module: "github.com/golang/vuln",
 					Versions: Versions{
 
 						Introduced("0.1.0"),
-					}})
+					}}}, r.Modules...)
+			}),
+			pc:           pc,
+			wantNumLints: 1,
+		},
+		{
+			name: "module_wrong_case",
+			desc: "Module paths must match the case of a module known to the proxy.",
+			report: validReport(func(r *Report) {
+				r.Modules = append([]*Module{{
+					Module: "github.com/Golang/vuln",
+					Versions: Versions{
+						Introduced("0.1.0"),
+					}}}, r.Modules...)
 			}),
 			pc:           pc,
 			wantNumLints: 1,
@@ -165,13 +218,13 @@ func TestLint(t *testing.T) {
 			name: "multiple_problems",
 			desc: "A test for a report with multiple module-version issues at once.",
 			report: validReport(func(r *Report) {
-				r.Modules = append(r.Modules, &Module{
+				r.Modules = append([]*Module{{
 					Module: "github.com/golang/vuln",
 					Versions: Versions{
 						Introduced("0.1.0"),
 						Fixed("0.2.5"),      // does not exist
 						Introduced("0.2.6"), // does not exist
-					}})
+					}}}, r.Modules...)
 			}),
 			pc:           pc,
 			wantNumLints: 1,
@@ -187,10 +240,10 @@ func TestLint(t *testing.T) {
 			name: "bad_module_skip_lint",
 			desc: "Module does not exist but skip lint is set.",
 			report: validReport(func(r *Report) {
-				r.Modules = append(r.Modules, &Module{
+				r.Modules = append([]*Module{{
 					Module:   "golang.org/x/doesnotexist",
 					SkipLint: true,
-				})
+				}}, r.Modules...)
 			}),
 			pc: pc,
 			// No lints.
@@ -234,9 +287,9 @@ func TestLintOffline(t *testing.T) {
 			name: "no_module_path",
 			desc: "Every module must have a path.",
 			report: validReport(func(r *Report) {
-				r.Modules = append(r.Modules, &Module{
+				r.Modules = append([]*Module{{
 					// no path
-				})
+				}}, r.Modules...)
 			}),
 			wantNumLints: 1,
 		},
@@ -365,14 +418,13 @@ func TestLintOffline(t *testing.T) {
 			name: "summary_path_prefix",
 			desc: "Summary may contain a prefix of a module or package path that is mentioned at least twice in a report. This is a workaround for reports that affect many modules.",
 			report: validReport(func(r *Report) {
-				r.Modules = append(r.Modules, &Module{
+				r.Modules = append([]*Module{{
 					Module:       "example.com/module/example/v2",
 					VulnerableAt: VulnerableAt("1.0.0"),
-				})
-				r.Modules = append(r.Modules, &Module{
+				}, {
 					Module:       "example.com/module/example/v3",
 					VulnerableAt: VulnerableAt("1.0.0"),
-				})
+				}}, r.Modules...)
 				r.Summary = "This summary is about example.com/module/example"
 			}),
 			// No lints.
@@ -466,13 +518,13 @@ func TestLintOffline(t *testing.T) {
 			name: "module_package_prefix",
 			desc: "In third party reports, module names must be prefixes of package names.",
 			report: validReport(func(r *Report) {
-				r.Modules = append(r.Modules, &Module{
+				r.Modules = append([]*Module{{
 					Module:       "example.com/module",
 					VulnerableAt: VulnerableAt("1.0.0"),
 					Packages: []*Package{{
 						Package: "example.com/package",
 					}},
-				})
+				}}, r.Modules...)
 			}),
 			wantNumLints: 1,
 		},
@@ -487,8 +539,63 @@ func TestLintOffline(t *testing.T) {
 						Package: "invalid.",
 					}}})
 			}),
+			wantNumLints: 2,
+		},
+		{
+			name: "module_path_confusable",
+			desc: "Module paths must be ASCII; look-alike characters get a suggestion.",
+			report: validReport(func(r *Report) {
+				r.Modules = append([]*Module{{
+					Module:       "github.com/gоlang/vuln", // Cyrillic "о"
+					VulnerableAt: VulnerableAt("1.0.0"),
+					Packages: []*Package{{
+						Package: "github.com/gоlang/vuln",
+					}}}}, r.Modules...)
+			}),
+			wantNumLints: 2,
+		},
+		{
+			name: "module_path_host_case",
+			desc: "The host of a module path must be in lower case.",
+			report: validReport(func(r *Report) {
+				r.Modules = append([]*Module{{
+					Module:       "GitHub.com/golang/vuln",
+					VulnerableAt: VulnerableAt("1.0.0"),
+					Packages: []*Package{{
+						Package: "GitHub.com/golang/vuln",
+					}}}}, r.Modules...)
+			}),
 			wantNumLints: 1,
 		},
+		{
+			name: "module_path_punycode",
+			desc: "The host of a module path must not be an internationalized domain name.",
+			report: validReport(func(r *Report) {
+				r.Modules = append(r.Modules, &Module{
+					Module:       "xn--gthub-n4a.com/golang/vuln",
+					VulnerableAt: VulnerableAt("1.0.0"),
+					Packages: []*Package{{
+						Package: "xn--gthub-n4a.com/golang/vuln",
+					}}})
+			}),
+			wantNumLints: 1,
+		},
+		{
+			name: "module_paths_differ_in_case",
+			desc: "Modules of a report must not differ only in case, and packages must match the case of their module.",
+			report: validReport(func(r *Report) {
+				r.Modules = append([]*Module{{
+					Module:       "github.com/Sirupsen/logrus",
+					VulnerableAt: VulnerableAt("1.0.0"),
+					Packages: []*Package{{
+						Package: "github.com/sirupsen/logrus/hooks",
+					}}}, {
+					Module:       "github.com/sirupsen/logrus",
+					VulnerableAt: VulnerableAt("1.0.0"),
+				}}, r.Modules...)
+			}),
+			wantNumLints: 2,
+		},
 		{
 			name: "no_package_path_stdlib",
 			desc: "All packages must have a path.",
@@ -501,13 +608,11 @@ func TestLintOffline(t *testing.T) {
 			name: "no_package_stdlib",
 			desc: "In standard library reports, all modules must contain at least one package.",
 			report: validStdReport(func(r *Report) {
-				r.Modules = append(r.Modules,
-					&Module{
-						Module:       "std",
-						VulnerableAt: VulnerableAt("1.0.0"),
-						// No packages.
-					},
-				)
+				r.Modules = append([]*Module{{
+					Module:       "std",
+					VulnerableAt: VulnerableAt("1.0.0"),
+					// No packages.
+				}}, r.Modules...)
 			}),
 			wantNumLints: 1,
 		},
@@ -532,13 +637,13 @@ func TestLintOffline(t *testing.T) {
 			name: "wrong_module_cmd",
 			desc: "Packages beginning with 'cmd' should be in the 'cmd' module.",
 			report: validStdReport(func(r *Report) {
-				r.Modules = append(r.Modules, &Module{
+				r.Modules = append([]*Module{{
 					Module:       "std",
 					VulnerableAt: VulnerableAt("1.0.0"),
 					Packages: []*Package{{
 						Package: "cmd/go",
 					}},
-				})
+				}}, r.Modules...)
 			}),
 			wantNumLints: 1,
 		},
@@ -627,13 +732,22 @@ func TestLintOffline(t *testing.T) {
 		},
 		{
 			name: "cve_and_cve_metadata_ok",
-			desc: "It is OK to set both cves and cve_metadata.",
+			desc: "It is OK to set both cves and cve_metadata, if the CVEs are different.",
 			report: validReport(func(r *Report) {
-				r.CVEs = []string{"CVE-0000-1111"}
+				r.CVEs = []string{"CVE-0000-2222"}
 				r.CVEMetadata = validCVEMetadata
 			}),
 			// No lints.
 		},
+		{
+			name: "cve_metadata_also_in_cves",
+			desc: "The CVE in cve_metadata (assigned by the Go CNA) must not also be in cves (assigned by other CNAs).",
+			report: validReport(func(r *Report) {
+				r.CVEs = []string{validCVEMetadata.ID}
+				r.CVEMetadata = validCVEMetadata
+			}),
+			wantNumLints: 1,
+		},
 		{
 			name: "cve_metadata_missing_fields",
 			desc: "Field cve_metadata (if not nil), must have an ID and CWE.",
@@ -657,6 +771,19 @@ func TestLintOffline(t *testing.T) {
 			}),
 			wantNumLints: 2,
 		},
+		{
+			name: "cve_metadata_bad_state",
+			desc: "Field cve_metadata.state, if set, must be a state of a CVE record.",
+			report: validReport(func(r *Report) {
+				r.CVEs = nil
+				r.CVEMetadata = &CVEMeta{
+					ID:    "CVE-0000-1111",
+					CWE:   "CWE-000: Description",
+					State: "DRAFT",
+				}
+			}),
+			wantNumLints: 1,
+		},
 		{
 			name: "reference_invalid_type",
 			desc: "Reference type must be one of the pre-defined types in osv.ReferenceTypes.",
@@ -693,16 +820,16 @@ func TestLintOffline(t *testing.T) {
 					URL:  "https://cve.mitre.org/cgi-bin/cvename.cgi?name=CVE-0000-0000",
 				}, &Reference{
 					Type: "WEB",
-					URL:  "https://nvd.nist.gov/vuln/detail/CVE-0000-0001",
+					URL:  "https://github.com/advisories/GHSA-0000-0000-0000",
 				}, &Reference{
 					Type: "WEB",
-					URL:  "https://nvd.nist.gov/vuln/detail/CVE-0000-0002", // ok
+					URL:  "https://github.com/advisories/GHSA-0000-0000-0001", // ok
 				}, &Reference{
 					Type: "WEB",
-					URL:  "https://github.com/advisories/GHSA-0000-0000-0000",
+					URL:  "https://nvd.nist.gov/vuln/detail/CVE-0000-0001",
 				}, &Reference{
 					Type: "WEB",
-					URL:  "https://github.com/advisories/GHSA-0000-0000-0001", // ok
+					URL:  "https://nvd.nist.gov/vuln/detail/CVE-0000-0002", // ok
 				})
 			}),
 			wantNumLints: 3,
@@ -726,8 +853,8 @@ func TestLintOffline(t *testing.T) {
 			report: validStdReport(func(r *Report) {
 				r.References = []*Reference{
 					{Type: osv.ReferenceTypeAdvisory, URL: "http://www.example.com"},
-					{Type: osv.ReferenceTypeFix, URL: "https://go-review.googlesource.com/c/go/+/12345"},
 					{Type: osv.ReferenceTypeFix, URL: "https://github.com/golang/go/commit/12345"},
+					{Type: osv.ReferenceTypeFix, URL: "https://go-review.googlesource.com/c/go/+/12345"},
 					{Type: osv.ReferenceTypeReport, URL: "https://github.com/golang/go/issues/12345"},
 					{Type: osv.ReferenceTypeWeb, URL: "https://go.dev/"},
 					// no announce link
@@ -775,15 +902,31 @@ func TestLintOffline(t *testing.T) {
 			report: validReport(func(r *Report) {
 				r.CVEs = []string{"CVE-0000-1111"}
 				r.Related = []string{
-					"not-an-id",           // bad
 					"CVE-0000-1111",       // bad (duplicate)
 					"CVE-0000-1112",       // ok
 					"GHSA-0000-0000-0000", // ok
 					"GO-1990-0001",        // ok
+					"not-an-id",           // bad
 				}
 			}),
 			wantNumLints: 2,
 		},
+		{
+			name: "not_canonical_order",
+			desc: "Modules, references and IDs must be in canonical order (can be auto-fixed).",
+			report: validReport(func(r *Report) {
+				r.Modules = append(r.Modules, &Module{
+					Module:       "example.com/module",
+					VulnerableAt: VulnerableAt("1.0.0"),
+				})
+				r.CVEs = []string{"CVE-1234-0001", "CVE-1234-0000"}
+				r.References = []*Reference{
+					{Type: osv.ReferenceTypeWeb, URL: "https://example.com/advisory"},
+					{Type: osv.ReferenceTypeFix, URL: "https://example.com/module/commit/12345"},
+				}
+			}),
+			wantNumLints: 3,
+		},
 		{
 			name: "module_version_offline",
 			desc: "In offline mode, module-version consistency is not checked because it requires a call to the module proxy.",
@@ -803,6 +946,336 @@ func TestLintOffline(t *testing.T) {
 			report: validExcludedReport(noop),
 			// No lints.
 		},
+		{
+			name: "bad_withdrawn_reason",
+			desc: "The withdrawn_reason field must be a valid reason, and only set for withdrawn reports.",
+			report: validReport(func(r *Report) {
+				r.WithdrawnReason = "not a real reason"
+			}),
+			wantNumLints: 2,
+		},
+		{
+			name: "lint_ignore",
+			desc: "Lints matching an unexpired lint-ignore note are suppressed.",
+			report: validReport(func(r *Report) {
+				r.Summary = ""
+				r.Notes = []*Note{
+					{Type: NoteTypeLintIgnore, Body: "summary legacy report until=2999-01-01"},
+				}
+			}),
+			wantNumLints: 0,
+		},
+		{
+			name: "lint_ignore_expired",
+			desc: "Expired lint-ignore notes are lints, and no longer suppress lints.",
+			report: validReport(func(r *Report) {
+				r.Summary = ""
+				r.Notes = []*Note{
+					{Type: NoteTypeLintIgnore, Body: "summary legacy report until=2000-01-01"},
+				}
+			}),
+			wantNumLints: 2,
+		},
+		{
+			name: "bad_lint_ignore",
+			desc: "Lint-ignore notes must have a rule ID, a reason and an expiry date.",
+			report: validReport(func(r *Report) {
+				r.Notes = []*Note{
+					{Type: NoteTypeLintIgnore, Body: "summary until=2999-01-01"},
+					{Type: NoteTypeLintIgnore, Body: "summary legacy report"},
+					{Type: NoteTypeLintIgnore, Body: "summary legacy report until=soon"},
+				}
+			}),
+			wantNumLints: 3,
+		},
+		{
+			name: "structured_credits",
+			desc: "Credits may have a type and contact URLs.",
+			report: validReport(func(r *Report) {
+				r.Credits = []*Credit{
+					{Name: "Jane Doe"},
+					{
+						Name:    "John Doe",
+						Type:    osv.CreditTypeRemediationDeveloper,
+						Contact: []string{"https://github.com/johndoe", "mailto:john@example.com"},
+					},
+				}
+			}),
+			wantNumLints: 0,
+		},
+		{
+			name: "bad_credits",
+			desc: "Credits must have a name, an OSV credit type and http(s) or mailto contact URLs.",
+			report: validReport(func(r *Report) {
+				r.Credits = []*Credit{
+					{Type: osv.CreditTypeFinder},
+					{Name: "Jane Doe", Type: "DISCOVERER"},
+					{Name: "John Doe", Contact: []string{"john@example.com"}},
+				}
+			}),
+			wantNumLints: 3,
+		},
+		{
+			name: "bad_disputed_symbols",
+			desc: "Disputed symbols must be affected symbols of the package.",
+			report: validReport(func(r *Report) {
+				r.Modules[0].Packages[0].Symbols = []string{"A"}
+				r.Modules[0].Packages[0].DerivedSymbols = []string{"B"}
+				r.Modules[0].Packages[0].DisputedSymbols = []string{"A", "B", "C"}
+			}),
+			wantNumLints: 1,
+		},
+		{
+			name: "valid_fix_status",
+			desc: "A fix status of none needs no URL, and partial goes with a fixed version.",
+			report: validReport(func(r *Report) {
+				r.Modules[0].Packages[0].FixStatus = &FixStatus{Status: FixStatusNone}
+				r.Modules = append(r.Modules, &Module{
+					Module:       "golang.org/x/text",
+					Versions:     Versions{Fixed("0.3.8")},
+					VulnerableAt: VulnerableAt("0.3.7"),
+					Packages: []*Package{{
+						Package:   "golang.org/x/text/language",
+						FixStatus: &FixStatus{Status: FixStatusPartial, URL: "https://go.dev/issue/12345"},
+					}},
+				})
+			}),
+			// No lints.
+		},
+		{
+			name: "bad_fix_status",
+			desc: "The fix status must be valid and consistent with the module's fixed versions.",
+			report: validReport(func(r *Report) {
+				r.Modules[0].Versions = Versions{Fixed("1.2.4")}
+				r.Modules[0].Packages = []*Package{
+					{
+						Package:   "golang.org/x/net/http2",
+						FixStatus: &FixStatus{Status: "unknown"},
+					},
+					{
+						// Not allowed with a fixed version,
+						// and needs a URL.
+						Package:   "golang.org/x/net/html",
+						FixStatus: &FixStatus{Status: FixStatusPending},
+					},
+					{
+						Package:   "golang.org/x/net/proxy",
+						FixStatus: &FixStatus{Status: FixStatusPartial, URL: "go.dev/issue/12345"},
+					},
+				}
+			}),
+			wantNumLints: 4,
+		},
+		{
+			name: "valid_unaffected_versions",
+			desc: "Unaffected versions may be any ranges outside the affected ones.",
+			report: validReport(func(r *Report) {
+				r.Modules[0].Versions = Versions{Introduced("1.2.0"), Fixed("1.2.4")}
+				r.Modules[0].UnaffectedVersions = Versions{Fixed("1.2.0"), Introduced("1.2.4")}
+			}),
+			// No lints.
+		},
+		{
+			name: "bad_unaffected_versions",
+			desc: "Unaffected versions must be valid, and must not overlap the affected versions.",
+			report: validReport(func(r *Report) {
+				r.Modules[0].Versions = Versions{Introduced("1.2.0"), Fixed("1.2.4")}
+				r.Modules[0].UnaffectedVersions = Versions{Fixed("1.2.4")}
+				r.Modules = append(r.Modules, &Module{
+					Module:             "golang.org/x/text",
+					Versions:           Versions{Fixed("0.3.8")},
+					UnaffectedVersions: Versions{Introduced("0.3.8"), Fixed("0.3.1")},
+					VulnerableAt:       VulnerableAt("0.3.7"),
+					Packages:           []*Package{{Package: "golang.org/x/text/language"}},
+				})
+			}),
+			wantNumLints: 3,
+		},
+		{
+			name: "valid_platform_versions",
+			desc: "Platform versions may contain more versions than the module's.",
+			report: validReport(func(r *Report) {
+				r.Modules[0].Versions = Versions{Fixed("1.2.4")}
+				r.Modules[0].PlatformVersions = []*PlatformVersions{
+					{GOOS: []string{"windows"}, Versions: Versions{Fixed("1.2.5")}},
+					// Not fixed on linux/arm64.
+					{GOOS: []string{"linux"}, GOARCH: []string{"arm64"}},
+				}
+			}),
+			// No lints.
+		},
+		{
+			name: "bad_platform_versions",
+			desc: "Platform versions must be valid, contain the module's versions, not overlap, and have packages on their platforms.",
+			report: validReport(func(r *Report) {
+				r.Modules[0].Versions = Versions{Fixed("1.2.4")}
+				r.Modules[0].Packages[0].GOOS = []string{"linux", "windows"}
+				r.Modules[0].PlatformVersions = []*PlatformVersions{
+					{GOOS: []string{"windows"}, Versions: Versions{Fixed("1.2.0")}},
+					{GOOS: []string{"windows"}, Versions: Versions{Fixed("1.2.5")}},
+					{GOOS: []string{"darwin"}, Versions: Versions{Introduced("1.3.0"), Introduced("1.4.0")}},
+				}
+			}),
+			wantNumLints: 4,
+		},
+		{
+			name: "skip_fix_availability",
+			desc: "The skip_fix reason must not be used to record that no fix exists.",
+			report: validReport(func(r *Report) {
+				r.Modules[0].Packages[0].SkipFixSymbols = "no fix available upstream"
+			}),
+			wantNumLints: 1,
+		},
+		{
+			name: "valid_withdrawn",
+			desc: "No lints are generated for valid withdrawn reports.",
+			report: validReport(func(r *Report) {
+				r.Withdrawn = &osv.Time{Time: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+				r.WithdrawnReason = WithdrawnDuplicate
+			}),
+			// No lints.
+		},
+		{
+			name: "bad_pattern_class",
+			desc: "The pattern class must be one of the accepted values.",
+			report: validReport(func(r *Report) {
+				r.PatternClass = "SQL"
+			}),
+			wantNumLints: 1,
+		},
+		{
+			name: "pattern_class_cwe_mismatch",
+			desc: "The pattern class must contain the CWE of the report's CVE metadata, if it is in the vocabulary.",
+			report: validReport(func(r *Report) {
+				r.CVEs = nil
+				r.CVEMetadata = &CVEMeta{
+					ID:          "CVE-0000-1111",
+					CWE:         "CWE 400: Uncontrolled Resource Consumption",
+					Description: "description",
+				}
+				r.PatternClass = PatternNilDereference
+			}),
+			wantNumLints: 1,
+		},
+		{
+			name: "valid_pattern_class",
+			desc: "No lints are generated for a pattern class containing the report's CWE.",
+			report: validReport(func(r *Report) {
+				r.CVEs = nil
+				r.CVEMetadata = &CVEMeta{
+					ID:          "CVE-0000-1111",
+					CWE:         "CWE-476: NULL Pointer Dereference",
+					Description: "description",
+				}
+				r.PatternClass = PatternNilDereference
+			}),
+			// No lints.
+		},
+		{
+			name: "bad_conditions",
+			desc: "Conditions must have a valid type and a value of the form required by the type.",
+			report: validReport(func(r *Report) {
+				r.Conditions = []*Condition{
+					{Type: "RUNTIME", Value: "Linux only"},
+					{Type: ConditionInput, Value: "the request headers"},
+					{Type: ConditionGODEBUG, Value: "x509sha1"},
+					{Type: ConditionConfig},
+				}
+			}),
+			wantNumLints: 4,
+		},
+		{
+			name: "valid_conditions",
+			desc: "No lints are generated for well-formed conditions.",
+			report: validReport(func(r *Report) {
+				r.Conditions = []*Condition{
+					{Type: ConditionConfig, Value: "The server has HTTP/2 enabled"},
+					{
+						Type:        ConditionInput,
+						Value:       "golang.org/x/net/http2.Server.ServeConn",
+						Description: "Only servers that accept connections from untrusted clients are affected.",
+					},
+					{Type: ConditionGODEBUG, Value: "http2debug=2"},
+				}
+			}),
+			// No lints.
+		},
+		{
+			name: "bad_mitigations",
+			desc: "Mitigations must name a GODEBUG setting and value, and list defaults in increasing Go version order.",
+			report: validStdReport(func(r *Report) {
+				r.Mitigations = []*Mitigation{
+					{GODEBUG: "x509sha1=1"},
+					{
+						GODEBUG: "httpmuxgo121",
+						Value:   "1",
+						Defaults: []*GODEBUGDefault{
+							{Go: "1.22.0", Value: "0"},
+							{Go: "1.21.0", Value: "1"},
+							{Go: "go1.23", Value: "0"},
+						},
+					},
+				}
+			}),
+			wantNumLints: 4,
+		},
+		{
+			name: "mitigations_third_party",
+			desc: "Mitigations are only supported for the standard library and toolchain.",
+			report: validReport(func(r *Report) {
+				r.Mitigations = []*Mitigation{{GODEBUG: "http2debug", Value: "1"}}
+			}),
+			wantNumLints: 1,
+		},
+		{
+			name: "valid_mitigations",
+			desc: "No lints are generated for well-formed mitigations.",
+			report: validStdReport(func(r *Report) {
+				r.Mitigations = []*Mitigation{{
+					GODEBUG: "httpmuxgo121",
+					Value:   "1",
+					Defaults: []*GODEBUGDefault{
+						{Go: "1.21.0", Value: "1"},
+						{Go: "1.22.0", Value: "0"},
+					},
+				}}
+			}),
+			// No lints.
+		},
+		{
+			name: "bad_epss",
+			desc: "The EPSS score must be for one of the report's CVEs, and have a valid score, percentile and date.",
+			report: validReport(func(r *Report) {
+				r.EPSS = &EPSS{CVE: "CVE-1234-9999", Score: 1.5, Percentile: 0.5, Date: "March 1, 2024"}
+			}),
+			wantNumLints: 3,
+		},
+		{
+			name: "valid_epss",
+			desc: "No lints are generated for a well-formed EPSS score.",
+			report: validReport(func(r *Report) {
+				r.EPSS = &EPSS{CVE: "CVE-1234-0000", Score: 0.01234, Percentile: 0.85, Date: "2024-03-01"}
+			}),
+			// No lints.
+		},
+		{
+			name: "fix_matrix_mismatch",
+			desc: "The fix matrix must match the module's versions.",
+			report: validStdReport(func(r *Report) {
+				r.Modules[0].Versions = Versions{Fixed("1.21.8"), Introduced("1.22.0"), Fixed("1.22.1")}
+				r.Modules[0].VulnerableAt = VulnerableAt("1.22.0")
+				r.Modules[0].FixMatrix = []*FixedRelease{{Go: "1.22", Fixed: "1.22.1"}}
+			}),
+			wantNumLints: 1,
+		},
+		{
+			name: "fix_matrix_third_party",
+			desc: "Fix matrices are only allowed for the standard library and toolchain.",
+			report: validReport(func(r *Report) {
+				r.Modules[0].FixMatrix = []*FixedRelease{{Go: "1.22", Fixed: "1.22.1"}}
+			}),
+			wantNumLints: 1,
+		},
 		{
 			name: "markdown",
 			desc: "Descriptions and summaries should not contain Markdown formatting.",
@@ -814,6 +1287,57 @@ func TestLintOffline(t *testing.T) {
 			),
 			wantNumLints: 3,
 		},
+		{
+			name: "html",
+			desc: "Descriptions should not contain raw HTML.",
+			report: validReport(
+				func(r *Report) {
+					r.Description = "A crafted request<br>can cause a <code>panic</code>."
+				},
+			),
+			wantNumLints: 1,
+		},
+		{
+			name: "html_cve_metadata",
+			desc: "CVE metadata descriptions should not contain raw HTML.",
+			report: validReport(func(r *Report) {
+				r.CVEs = nil
+				r.CVEMetadata = &CVEMeta{
+					ID:          validCVEMetadata.ID,
+					CWE:         validCVEMetadata.CWE,
+					Description: "A crafted request can cause a panic.<p>",
+				}
+			}),
+			wantNumLints: 1,
+		},
+		{
+			name: "ghsa_origin",
+			desc: "No lints are generated for a report from a GHSA that has no CVE.",
+			report: validReport(func(r *Report) {
+				r.CVEs = nil
+				r.GHSAs = []string{"GHSA-xxxx-yyyy-zzzz"}
+				r.SourceMeta = &SourceMeta{ID: "GHSA-xxxx-yyyy-zzzz", Origin: OriginGHSA}
+			}),
+			// No lints.
+		},
+		{
+			name: "ghsa_origin_cve_metadata",
+			desc: "Reports from GHSAs can't have Go CNA CVEs, and must list their source GHSA.",
+			report: validReport(func(r *Report) {
+				r.CVEs = nil
+				r.CVEMetadata = validCVEMetadata
+				r.SourceMeta = &SourceMeta{ID: "GHSA-xxxx-yyyy-zzzz"}
+			}),
+			wantNumLints: 2,
+		},
+		{
+			name: "source_origin_mismatch",
+			desc: "The source origin must be valid, and match the source ID.",
+			report: validReport(func(r *Report) {
+				r.SourceMeta = &SourceMeta{ID: "CVE-1234-0000", Origin: OriginGHSA}
+			}),
+			wantNumLints: 1,
+		},
 	} {
 		test := test
 		t.Run(test.name, func(t *testing.T) {
@@ -6,6 +6,8 @@ package report
 
 import (
 	"fmt"
+	"slices"
+	"strings"
 	"sync"
 )
 
@@ -57,22 +59,45 @@ func (l *linter) Errorf(format string, a ...any) {
 // Errors returns all the lints added to the linter
 // and its groups so far, formatted as strings.
 func (l *linter) Errors() []string {
+	var result []string
+	for _, lt := range l.lints() {
+		result = append(result, lt.String())
+	}
+	return result
+}
+
+// A lintError is a lint found by a linter, with the prefixes
+// of the groups it was found in.
+type lintError struct {
+	path []string
+	msg  string
+}
+
+func (e *lintError) String() string {
+	return strings.Join(append(slices.Clip(e.path), e.msg), ": ")
+}
+
+// lints returns all the lints added to the linter
+// and its groups so far.
+func (l *linter) lints() []*lintError {
 	l.mu.Lock()
 	defer l.mu.Unlock()
 
-	result := make([]string, 0, len(l.errors))
-	addErrs := func(errs []string) {
+	result := make([]*lintError, 0, len(l.errors))
+	addErrs := func(errs []*lintError) {
 		for _, err := range errs {
 			if l.prefix != "" {
-				err = fmt.Sprintf("%s: %s", l.prefix, err)
+				err.path = append([]string{l.prefix}, err.path...)
 			}
 			result = append(result, err)
 		}
 	}
 
-	addErrs(l.errors)
+	for _, msg := range l.errors {
+		addErrs([]*lintError{{msg: msg}})
+	}
 	for _, g := range l.groups {
-		addErrs(g.Errors())
+		addErrs(g.lints())
 	}
 
 	return result
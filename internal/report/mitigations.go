@@ -0,0 +1,132 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package report
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"golang.org/x/vulndb/internal/osv"
+	"golang.org/x/vulndb/internal/version"
+)
+
+// A Mitigation is a GODEBUG setting that disables or mitigates
+// a vulnerability, without upgrading.
+type Mitigation struct {
+	// GODEBUG is the name of the setting, for example "httpmuxgo121".
+	GODEBUG string `yaml:"godebug,omitempty"`
+	// Value is the value of the setting that mitigates the
+	// vulnerability, for example "1".
+	Value string `yaml:",omitempty"`
+	// Description optionally explains the effect of the setting.
+	Description string `yaml:",omitempty"`
+	// Defaults are the default values of the setting, in order of
+	// the Go versions that introduced them.
+	Defaults []*GODEBUGDefault `yaml:",omitempty"`
+}
+
+// A GODEBUGDefault is the default value of a GODEBUG setting,
+// starting at a Go version.
+type GODEBUGDefault struct {
+	// Go is the unprefixed Go version that introduced the default,
+	// for example "1.22.0". The default applies to programs whose
+	// main module's go line is at this version or later.
+	Go string `yaml:"go,omitempty"`
+	// Value is the default value of the setting.
+	Value string `yaml:",omitempty"`
+}
+
+// Setting returns the mitigating setting, in the form name=value.
+func (m *Mitigation) Setting() string {
+	return m.GODEBUG + "=" + m.Value
+}
+
+// String returns a sentence describing the mitigation and the
+// defaults of its setting, for use in descriptions.
+func (m *Mitigation) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Setting GODEBUG=%s mitigates this vulnerability.", m.Setting())
+	if m.Description != "" {
+		fmt.Fprintf(&b, " %s", m.Description)
+	}
+	var ds []string
+	for _, d := range m.Defaults {
+		tag, err := version.SemverToGoTag(d.Go)
+		if err != nil {
+			tag = d.Go
+		}
+		ds = append(ds, fmt.Sprintf("%s=%s from %s", m.GODEBUG, d.Value, tag))
+	}
+	if len(ds) > 0 {
+		fmt.Fprintf(&b, " The default is %s.", strings.Join(ds, ", "))
+	}
+	return b.String()
+}
+
+// MitigationsText returns a paragraph describing the report's
+// mitigations, to be appended to its description, or "" if it
+// has none.
+func (r *Report) MitigationsText() string {
+	var ps []string
+	for _, m := range r.Mitigations {
+		ps = append(ps, "MITIGATION: "+m.String())
+	}
+	return strings.Join(ps, "\n\n")
+}
+
+func (r *Report) osvMitigations() []osv.Mitigation {
+	var ms []osv.Mitigation
+	for _, m := range r.Mitigations {
+		om := osv.Mitigation{
+			GODEBUG:     m.GODEBUG,
+			Value:       m.Value,
+			Description: m.Description,
+		}
+		for _, d := range m.Defaults {
+			om.Defaults = append(om.Defaults, osv.GODEBUGDefault{
+				Go:    d.Go,
+				Value: d.Value,
+			})
+		}
+		ms = append(ms, om)
+	}
+	return ms
+}
+
+// The name of a GODEBUG setting, for example "x509sha1".
+var godebugNameRegexp = regexp.MustCompile(`^[a-z][a-z0-9]*$`)
+
+func (m *Mitigation) lint(l *linter, r *Report) {
+	gl := l.Group("godebug")
+	switch {
+	case m.GODEBUG == "":
+		gl.Error(missing)
+	case !godebugNameRegexp.MatchString(m.GODEBUG):
+		gl.Errorf("%q is not a GODEBUG setting name", m.GODEBUG)
+	}
+	if m.Value == "" {
+		l.Group("value").Error(missing)
+	}
+	if !r.IsFirstParty() {
+		l.Error("mitigations are only supported for the standard library and toolchain")
+	}
+
+	var prev string
+	for i, d := range m.Defaults {
+		dl := l.Group(name("defaults", i, d.Go))
+		if d.Value == "" {
+			dl.Group("value").Error(missing)
+		}
+		if !version.IsValid(d.Go) {
+			dl.Group("go").Errorf("%q is not a valid unprefixed Go version (for example, 1.22.0)", d.Go)
+			continue
+		}
+		if prev != "" && !version.Before(prev, d.Go) {
+			dl.Group("go").Errorf("%s is not after %s; defaults must be in increasing version order", d.Go, prev)
+		}
+		prev = d.Go
+	}
+}
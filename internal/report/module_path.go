@@ -0,0 +1,163 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package report
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/mod/module"
+	"golang.org/x/net/idna"
+	"golang.org/x/vulndb/internal/proxy"
+)
+
+// CheckModulePath returns an error if path is not a valid module path
+// for a report, suggesting a corrected path where possible.
+//
+// In addition to the rules of x/mod/module.CheckPath (which, among
+// other things, require the host to be in lower case), the host must
+// not be in punycode (an internationalized domain name), as it can be
+// confusable with an ASCII host. Non-ASCII characters are reported
+// with the ASCII characters they look like, if known.
+func CheckModulePath(path string) error {
+	reason := checkModulePath(path)
+	if reason == "" {
+		return nil
+	}
+	msg := fmt.Sprintf("module path %q: %s", path, reason)
+	if s := SuggestModulePath(path); s != path && checkModulePath(s) == "" {
+		msg += fmt.Sprintf(" (did you mean %q?)", s)
+	}
+	return errors.New(msg)
+}
+
+// checkModulePath returns why path is invalid, or "" if it is valid.
+func checkModulePath(path string) string {
+	if i := strings.IndexFunc(path, func(r rune) bool { return r >= utf8.RuneSelf }); i >= 0 {
+		r, _ := utf8.DecodeRuneInString(path[i:])
+		return fmt.Sprintf("non-ASCII character %q (%U)", r, r)
+	}
+	if err := module.CheckPath(path); err != nil {
+		// Use the reason only, as the error
+		// starts with the (quoted) path.
+		var perr *module.InvalidPathError
+		if errors.As(err, &perr) {
+			return perr.Err.Error()
+		}
+		return err.Error()
+	}
+	host, _, _ := strings.Cut(path, "/")
+	for _, label := range strings.Split(host, ".") {
+		if strings.HasPrefix(label, "xn--") {
+			u, err := idna.ToUnicode(host)
+			if err != nil {
+				return fmt.Sprintf("host is an invalid internationalized domain name: %v", err)
+			}
+			return fmt.Sprintf("host is an internationalized domain name (%s), which may be confusable with an ASCII one", u)
+		}
+	}
+	return ""
+}
+
+// SuggestModulePath returns a normalized version of path, for use
+// as a suggestion when path is invalid. It removes a URL scheme
+// (and a pkg.go.dev host, for a pkg.go.dev URL), a trailing ".git" or slash and invisible characters, decodes and
+// lower-cases the host, and replaces non-ASCII characters that are commonly
+// confused with ASCII ones (like the Cyrillic "а" or the full-width
+// "ａ") with those ASCII characters. Other non-ASCII characters are
+// left as they are.
+func SuggestModulePath(path string) string {
+	s := strings.Map(func(r rune) rune {
+		if r < utf8.RuneSelf {
+			return r
+		}
+		if unicode.Is(unicode.Cf, r) {
+			return -1 // invisible, like U+200B ZERO WIDTH SPACE
+		}
+		if r >= '！' && r <= '～' {
+			return r - '！' + '!' // full-width forms of ASCII
+		}
+		if c, ok := confusables[r]; ok {
+			return c
+		}
+		return r
+	}, strings.TrimSpace(path))
+	for _, prefix := range []string{"https://", "http://", "pkg.go.dev/"} {
+		s = strings.TrimPrefix(s, prefix)
+	}
+	s = strings.TrimSuffix(strings.TrimSuffix(s, "/"), ".git")
+	host, rest, ok := strings.Cut(s, "/")
+	if u, err := idna.ToUnicode(host); err == nil && u != host {
+		// Suggest the ASCII host that the punycode one looks like.
+		host = SuggestModulePath(u)
+	}
+	s = strings.ToLower(host)
+	if ok {
+		s += "/" + rest
+	}
+	return s
+}
+
+// confusables maps non-ASCII letters and punctuation that look like
+// ASCII ones to those ASCII characters.
+//
+// It is not exhaustive (see https://www.unicode.org/Public/security/latest/confusables.txt),
+// but covers the characters most likely to be seen in a module path.
+var confusables = map[rune]rune{
+	// Cyrillic.
+	'а': 'a', 'с': 'c', 'ԁ': 'd', 'е': 'e', 'һ': 'h', 'і': 'i', 'ј': 'j',
+	'о': 'o', 'р': 'p', 'ԛ': 'q', 'ѕ': 's', 'у': 'y', 'ѵ': 'v', 'ԝ': 'w',
+	'х': 'x',
+	'А': 'A', 'В': 'B', 'С': 'C', 'Е': 'E', 'Н': 'H', 'І': 'I', 'Ј': 'J',
+	'К': 'K', 'М': 'M', 'О': 'O', 'Р': 'P', 'Ѕ': 'S', 'Т': 'T', 'Х': 'X',
+	// Greek.
+	'α': 'a', 'ε': 'e', 'ι': 'i', 'κ': 'k', 'ν': 'v', 'ο': 'o', 'ρ': 'p',
+	'τ': 't', 'υ': 'u', 'χ': 'x',
+	'Α': 'A', 'Β': 'B', 'Ε': 'E', 'Η': 'H', 'Ι': 'I', 'Κ': 'K', 'Μ': 'M',
+	'Ν': 'N', 'Ο': 'O', 'Ρ': 'P', 'Τ': 'T', 'Χ': 'X', 'Υ': 'Y', 'Ζ': 'Z',
+	// Latin.
+	'ı': 'i', 'ɡ': 'g', 'ℓ': 'l',
+	// Punctuation.
+	'‐': '-', '‑': '-', '‒': '-', '–': '-', '—': '-', '−': '-',
+	'。': '.', '․': '.', '⁄': '/', '∕': '/', '＿': '_',
+}
+
+// lintPath lints the module path of m, which must not be
+// a first-party module. It is not used for excluded reports,
+// whose modules are often not real module paths.
+func (m *Module) lintPath(l *linter) {
+	if err := CheckModulePath(m.Module); err != nil {
+		l.Error(err)
+	}
+}
+
+// suggestCasing returns a module known to the proxy whose path
+// is equal to path under case folding, or "" if there is none.
+//
+// The proxy can't look up a module case-insensitively, so only
+// the most likely spelling (in all lower case) is tried.
+func suggestCasing(pc *proxy.Client, path string) string {
+	if lower := strings.ToLower(path); lower != path && pc.ModuleExists(lower) {
+		return lower
+	}
+	return ""
+}
+
+// lintCaseCollisions lints the modules of r whose paths differ
+// only in case, as all but one of them is probably misspelled.
+func (r *Report) lintCaseCollisions(l *linter) {
+	seen := make(map[string]string)
+	for _, m := range r.Modules {
+		folded := strings.ToLower(m.Module)
+		if prev, ok := seen[folded]; ok && prev != m.Module {
+			l.Group("modules").Errorf("%s and %s differ only in case", prev, m.Module)
+			continue
+		}
+		seen[folded] = m.Module
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package report
+
+import "testing"
+
+func TestCheckModulePath(t *testing.T) {
+	for _, tc := range []struct {
+		path    string
+		wantErr string
+	}{
+		{path: "github.com/golang/vuln"},
+		{path: "github.com/Sirupsen/logrus"},
+		{
+			path:    "github.com/gоlang/vuln", // Cyrillic "о"
+			wantErr: `module path "github.com/gоlang/vuln": non-ASCII character 'о' (U+043E) (did you mean "github.com/golang/vuln"?)`,
+		},
+		{
+			path:    "github.com/golang/vuln\u200b",
+			wantErr: `module path "github.com/golang/vuln\u200b": non-ASCII character '\u200b' (U+200B) (did you mean "github.com/golang/vuln"?)`,
+		},
+		{
+			path:    "GitHub.com/golang/vuln",
+			wantErr: `module path "GitHub.com/golang/vuln": invalid char 'G' in first path element (did you mean "github.com/golang/vuln"?)`,
+		},
+		{
+			path:    "https://github.com/golang/vuln.git",
+			wantErr: `module path "https://github.com/golang/vuln.git": double slash (did you mean "github.com/golang/vuln"?)`,
+		},
+		{
+			path:    "xn--gthub-n4a.com/golang/vuln",
+			wantErr: `module path "xn--gthub-n4a.com/golang/vuln": host is an internationalized domain name (gıthub.com), which may be confusable with an ASCII one (did you mean "github.com/golang/vuln"?)`,
+		},
+		{
+			path:    "https://pkg.go.dev/github.com/golang/vuln",
+			wantErr: `module path "https://pkg.go.dev/github.com/golang/vuln": double slash (did you mean "github.com/golang/vuln"?)`,
+		},
+		{
+			path:    "github.com/golang/vuln/",
+			wantErr: `module path "github.com/golang/vuln/": trailing slash (did you mean "github.com/golang/vuln"?)`,
+		},
+	} {
+		t.Run(tc.path, func(t *testing.T) {
+			err := CheckModulePath(tc.path)
+			var got string
+			if err != nil {
+				got = err.Error()
+			}
+			if got != tc.wantErr {
+				t.Errorf("CheckModulePath(%q) = %q, want %q", tc.path, got, tc.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package report
+
+import (
+	"slices"
+	"strings"
+
+	"golang.org/x/vulndb/internal/proxy"
+)
+
+// ExpandMonorepo moves each package of the modules of r that is
+// actually in a nested module (a module published from a subdirectory
+// of the same repo, like the service modules of
+// github.com/aws/aws-sdk-go-v2) to an entry for that module.
+// It returns the paths of the modules it added.
+//
+// The proxy can't list the modules published from a repo, so the
+// nested modules to consider are given as candidates (for example,
+// from the module map). The modules that the proxy says contain the
+// packages of r are also considered. Only candidates known to the
+// proxy are used.
+//
+// A nested module usually has its own versions, so the versions of
+// the module a package moves from are copied only if they are all
+// tags of the nested module. Otherwise, the nested module is added
+// without versions, and a note says they need to be filled in.
+func (r *Report) ExpandMonorepo(pc *proxy.Client, candidates []string) (added []string) {
+	var expanded []*Module
+	for _, m := range r.Modules {
+		if m.IsFirstParty() || len(m.Packages) == 0 {
+			expanded = append(expanded, m)
+			continue
+		}
+		nested := m.nestedModules(pc, candidates)
+		var (
+			keep  []*Package
+			moved = make(map[string]*Module)
+		)
+		for _, p := range m.Packages {
+			i := slices.IndexFunc(nested, func(mod string) bool { return isPathPrefix(mod, p.Package) })
+			if i < 0 {
+				keep = append(keep, p)
+				continue
+			}
+			mod := nested[i]
+			nm, ok := moved[mod]
+			if !ok {
+				nm = &Module{Module: mod}
+				if m.versionsAreTagsOf(pc, mod) {
+					for _, v := range m.Versions {
+						nm.Versions = append(nm.Versions, &Version{Version: v.Version, Type: v.Type})
+					}
+				} else if len(m.Versions) > 0 {
+					r.AddNote(NoteTypeFix, "%s: add versions (its packages were moved from %s, whose versions are not all tags of %s)", mod, m.Module, mod)
+				}
+				moved[mod] = nm
+				expanded = append(expanded, nm)
+				added = append(added, mod)
+			}
+			nm.Packages = append(nm.Packages, p)
+		}
+		if len(keep) > 0 {
+			m.Packages = keep
+			expanded = append(expanded, m)
+		}
+	}
+	sortModules(expanded)
+	r.Modules = expanded
+	slices.Sort(added)
+	return added
+}
+
+// nestedModules returns the modules known to the proxy, among the
+// candidates and the modules containing the packages of m, that are
+// nested in m, longest first (so that the first one that contains a
+// package is the one it is in).
+func (m *Module) nestedModules(pc *proxy.Client, candidates []string) []string {
+	all := slices.Clone(candidates)
+	for _, p := range m.Packages {
+		if mod, err := pc.FindModule(p.Package); err == nil {
+			all = append(all, mod)
+		}
+	}
+	var nested []string
+	for _, mod := range all {
+		if mod != m.Module && isPathPrefix(m.Module, mod) && !slices.Contains(nested, mod) && pc.ModuleExists(mod) {
+			nested = append(nested, mod)
+		}
+	}
+	slices.SortFunc(nested, func(a, b string) int {
+		return len(b) - len(a)
+	})
+	return nested
+}
+
+// versionsAreTagsOf reports whether all the versions of m are tagged
+// versions of module mod.
+func (m *Module) versionsAreTagsOf(pc *proxy.Client, mod string) bool {
+	for _, v := range m.Versions {
+		if !pc.ModuleExistsAtTaggedVersion(mod, v.Version) {
+			return false
+		}
+	}
+	return true
+}
+
+// isPathPrefix reports whether prefix is path or a parent
+// directory of path.
+func isPathPrefix(prefix, path string) bool {
+	return path == prefix || strings.HasPrefix(path, prefix+"/")
+}
@@ -0,0 +1,95 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package report
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/vulndb/internal/proxy"
+)
+
+func TestExpandMonorepo(t *testing.T) {
+	const root = "github.com/aws/aws-sdk-go-v2"
+	for _, tc := range []struct {
+		name       string
+		desc       string
+		in         []*Module
+		candidates []string
+		want       []*Module
+		wantAdded  []string
+		wantNotes  []*Note
+	}{
+		{
+			name: "ok",
+			desc: "packages are moved to the nested modules they are in",
+			in: []*Module{{
+				Module:   root,
+				Versions: []*Version{Introduced("1.0.0"), Fixed("1.2.0")},
+				Packages: []*Package{
+					{Package: root + "/aws"},
+					{Package: root + "/config"},
+					{Package: root + "/service/s3/internal/customizations"},
+				},
+			}},
+			// sqs has none of the packages, and other is not nested.
+			candidates: []string{root + "/service/sqs", "github.com/other/mod"},
+			want: []*Module{
+				{
+					Module:   root,
+					Versions: []*Version{Introduced("1.0.0"), Fixed("1.2.0")},
+					Packages: []*Package{{Package: root + "/aws"}},
+				},
+				{
+					Module:   root + "/config",
+					Versions: []*Version{Introduced("1.0.0"), Fixed("1.2.0")},
+					Packages: []*Package{{Package: root + "/config"}},
+				},
+				{
+					Module:   root + "/service/s3",
+					Packages: []*Package{{Package: root + "/service/s3/internal/customizations"}},
+				},
+			},
+			wantAdded: []string{root + "/config", root + "/service/s3"},
+			wantNotes: []*Note{{
+				Body: root + "/service/s3: add versions (its packages were moved from " + root + ", whose versions are not all tags of " + root + "/service/s3)",
+				Type: NoteTypeFix,
+			}},
+		},
+		{
+			name: "no_nested",
+			desc: "a module without nested modules is unchanged",
+			in: []*Module{{
+				Module:   "github.com/pkg/mono",
+				Packages: []*Package{{Package: "github.com/pkg/mono/a"}},
+			}},
+			want: []*Module{{
+				Module:   "github.com/pkg/mono",
+				Packages: []*Package{{Package: "github.com/pkg/mono/a"}},
+			}},
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			pc, err := proxy.NewTestClient(t, *realProxy)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			r := &Report{Modules: tc.in}
+			added := r.ExpandMonorepo(pc, tc.candidates)
+			if diff := cmp.Diff(tc.want, r.Modules); diff != "" {
+				t.Errorf("%s: ExpandMonorepo() modules mismatch (-want +got)\n%s", tc.desc, diff)
+			}
+			if diff := cmp.Diff(tc.wantAdded, added); diff != "" {
+				t.Errorf("%s: ExpandMonorepo() added mismatch (-want +got)\n%s", tc.desc, diff)
+			}
+			if diff := cmp.Diff(tc.wantNotes, r.Notes); diff != "" {
+				t.Errorf("%s: ExpandMonorepo() notes mismatch (-want +got)\n%s", tc.desc, diff)
+			}
+		})
+	}
+}
@@ -28,9 +28,11 @@ func New(src Source, pc *proxy.Client, opts ...NewOption) *Report {
 	r.AddAliases(cfg.Aliases)
 
 	r.SourceMeta = &SourceMeta{
-		ID: src.SourceID(),
+		ID:        src.SourceID(),
+		CreatedBy: cfg.CreatedBy,
 	}
 	r.SourceMeta.Created = &cfg.Created
+	r.SourceMeta.Origin = r.impliedOrigin()
 	r.ReviewStatus = cfg.ReviewStatus
 	r.Unexcluded = cfg.Unexcluded
 
@@ -109,10 +111,17 @@ func WithUnexcluded(reason ExcludedType) NewOption {
 	}
 }
 
+func WithCreatedBy(user string) NewOption {
+	return func(h *cfg) {
+		h.CreatedBy = user
+	}
+}
+
 type cfg struct {
 	ModulePath   string
 	Aliases      []string
 	Created      time.Time
+	CreatedBy    string
 	GoID         string
 	ReviewStatus ReviewStatus
 	Unexcluded   ExcludedType
@@ -0,0 +1,123 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package report
+
+import (
+	"cmp"
+	"slices"
+	"sort"
+	"strings"
+
+	"golang.org/x/vulndb/internal/idstr"
+)
+
+// Normalize puts the lists of r in their canonical order, so that
+// the same report is written the same way no matter who (or which
+// command) wrote it:
+//   - modules by path, then major version, then versions and packages
+//   - references by type, then URL
+//   - aliases and related IDs by kind (CVE, GHSA, Go ID), then number
+//
+// Normalize only reorders; it does not add, remove or change
+// any values. It is part of Fix, and lintOrder checks that
+// a report is normalized.
+//
+// Version events are never reordered: their order is what defines
+// the ranges, so an out-of-order list is an error for lintVersions
+// to report, not something to fix.
+func (r *Report) Normalize() {
+	sortModules(r.Modules)
+	slices.SortStableFunc(r.References, compareReferences)
+	slices.SortStableFunc(r.CVEs, compareIDs)
+	slices.SortStableFunc(r.GHSAs, compareIDs)
+	slices.SortStableFunc(r.Related, compareIDs)
+}
+
+// lintOrder checks that the lists of r are in the order Normalize
+// puts them in. (The order of versions is checked by lintVersions,
+// as part of checking that they form valid ranges.)
+func (r *Report) lintOrder(l *linter) {
+	const unsorted = "not in canonical order (can be auto-fixed)"
+	if !sort.SliceIsSorted(r.Modules, func(i, j int) bool {
+		return moduleLess(r.Modules[i], r.Modules[j])
+	}) {
+		l.Group("modules").Error(unsorted)
+	}
+	for _, f := range []struct {
+		name   string
+		sorted bool
+	}{
+		{"references", slices.IsSortedFunc(r.References, compareReferences)},
+		{"cves", slices.IsSortedFunc(r.CVEs, compareIDs)},
+		{"ghsas", slices.IsSortedFunc(r.GHSAs, compareIDs)},
+		{"related", slices.IsSortedFunc(r.Related, compareIDs)},
+	} {
+		if !f.sorted {
+			l.Group(f.name).Error(unsorted)
+		}
+	}
+}
+
+// compareReferences orders references by type, then URL.
+func compareReferences(a, b *Reference) int {
+	if a.Type == b.Type {
+		return strings.Compare(a.URL, b.URL)
+	}
+	return strings.Compare(string(a.Type), string(b.Type))
+}
+
+// compareIDs orders vulnerability IDs by kind (CVEs, then GHSAs,
+// then Go IDs, then anything else), then by their parts, comparing
+// numeric parts by value so that, for example, CVE-2024-9999 comes
+// before CVE-2024-10000.
+func compareIDs(a, b string) int {
+	if c := cmp.Compare(idKind(a), idKind(b)); c != 0 {
+		return c
+	}
+	pa, pb := strings.Split(a, "-"), strings.Split(b, "-")
+	for i := 0; i < len(pa) && i < len(pb); i++ {
+		if c := compareIDPart(pa[i], pb[i]); c != 0 {
+			return c
+		}
+	}
+	return cmp.Compare(len(pa), len(pb))
+}
+
+func idKind(id string) int {
+	switch {
+	case idstr.IsCVE(id):
+		return 0
+	case idstr.IsGHSA(id):
+		return 1
+	case idstr.IsGoID(id):
+		return 2
+	default:
+		return 3
+	}
+}
+
+// compareIDPart compares two parts of an ID, by value if they
+// are both numbers.
+func compareIDPart(a, b string) int {
+	if isDigits(a) && isDigits(b) {
+		a, b = strings.TrimLeft(a, "0"), strings.TrimLeft(b, "0")
+		if c := cmp.Compare(len(a), len(b)); c != 0 {
+			return c
+		}
+	}
+	return strings.Compare(a, b)
+}
+
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
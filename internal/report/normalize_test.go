@@ -0,0 +1,73 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package report
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/vulndb/internal/osv"
+)
+
+func TestNormalize(t *testing.T) {
+	r := &Report{
+		Modules: []*Module{
+			{Module: "golang.org/x/net/v2"},
+			{Module: "golang.org/x/net", Versions: Versions{Introduced("1.2.0"), Fixed("1.0.0")}},
+			{Module: "example.com/m"},
+		},
+		CVEs:    []string{"CVE-2024-10000", "CVE-2024-9999", "CVE-2023-20000"},
+		GHSAs:   []string{"GHSA-xxxx-yyyy-zzzz", "GHSA-aaaa-bbbb-cccc"},
+		Related: []string{"GO-2024-0002", "GHSA-aaaa-bbbb-cccc", "CVE-2024-0001"},
+		References: []*Reference{
+			{Type: osv.ReferenceTypeWeb, URL: "https://example.com/a"},
+			{Type: osv.ReferenceTypeFix, URL: "https://example.com/fix/2"},
+			{Type: osv.ReferenceTypeFix, URL: "https://example.com/fix/1"},
+		},
+	}
+	want := &Report{
+		Modules: []*Module{
+			{Module: "example.com/m"},
+			// Versions are left for lintVersions to check.
+			{Module: "golang.org/x/net", Versions: Versions{Introduced("1.2.0"), Fixed("1.0.0")}},
+			{Module: "golang.org/x/net/v2"},
+		},
+		CVEs:    []string{"CVE-2023-20000", "CVE-2024-9999", "CVE-2024-10000"},
+		GHSAs:   []string{"GHSA-aaaa-bbbb-cccc", "GHSA-xxxx-yyyy-zzzz"},
+		Related: []string{"CVE-2024-0001", "GHSA-aaaa-bbbb-cccc", "GO-2024-0002"},
+		References: []*Reference{
+			{Type: osv.ReferenceTypeFix, URL: "https://example.com/fix/1"},
+			{Type: osv.ReferenceTypeFix, URL: "https://example.com/fix/2"},
+			{Type: osv.ReferenceTypeWeb, URL: "https://example.com/a"},
+		},
+	}
+
+	l := NewLinter("")
+	r.lintOrder(l)
+	wantLints := []string{
+		"cves: not in canonical order (can be auto-fixed)",
+		"ghsas: not in canonical order (can be auto-fixed)",
+		"modules: not in canonical order (can be auto-fixed)",
+		"references: not in canonical order (can be auto-fixed)",
+		"related: not in canonical order (can be auto-fixed)",
+	}
+	gotLints := l.Errors()
+	slices.Sort(gotLints)
+	if diff := cmp.Diff(wantLints, gotLints); diff != "" {
+		t.Errorf("lintOrder() mismatch (-want, +got):\n%s", diff)
+	}
+
+	r.Normalize()
+	if diff := cmp.Diff(want, r); diff != "" {
+		t.Errorf("Normalize() mismatch (-want, +got):\n%s", diff)
+	}
+
+	l = NewLinter("")
+	r.lintOrder(l)
+	if got := l.Errors(); len(got) != 0 {
+		t.Errorf("lintOrder() after Normalize() = %v, want no lints", got)
+	}
+}
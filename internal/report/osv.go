@@ -10,6 +10,7 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
+	"slices"
 	"sort"
 	"strings"
 	"time"
@@ -129,9 +130,7 @@ func (vs Versions) collectRangePairs() []pair {
 func (r *Report) ToOSV(lastModified time.Time) (osv.Entry, error) {
 	var credits []osv.Credit
 	for _, credit := range r.Credits {
-		credits = append(credits, osv.Credit{
-			Name: credit,
-		})
+		credits = append(credits, osv.Credit(*credit))
 	}
 
 	entry := osv.Entry{
@@ -144,8 +143,15 @@ func (r *Report) ToOSV(lastModified time.Time) (osv.Entry, error) {
 		Credits:       credits,
 		SchemaVersion: SchemaVersion,
 		DatabaseSpecific: &osv.DatabaseSpecific{
-			URL:          idstr.GoAdvisory(r.ID),
-			ReviewStatus: r.ReviewStatus.ToOSV(),
+			URL:             idstr.GoAdvisory(r.ID),
+			ReviewStatus:    r.ReviewStatus.ToOSV(),
+			DisputedSymbols: r.disputedSymbols(),
+			PatternClass:    r.PatternClass.toOSV(),
+			Conditions:      r.osvConditions(),
+			Mitigations:     r.osvMitigations(),
+			FixStatuses:     r.osvFixStatuses(),
+			Unaffected:      r.osvUnaffected(),
+			EPSS:            r.EPSS.toOSV(),
 		},
 	}
 
@@ -155,10 +161,25 @@ func (r *Report) ToOSV(lastModified time.Time) (osv.Entry, error) {
 		if err != nil {
 			return osv.Entry{}, err
 		}
-		entry.Affected = append(entry.Affected, affected)
-		if len(m.NonGoVersions) != 0 {
+		if r.Withdrawn != nil {
+			tombstone(&affected)
+		} else if len(m.NonGoVersions) != 0 {
 			hasNonGoVersions = true
 		}
+		entry.Affected = append(entry.Affected, affected)
+		entry.Affected = append(entry.Affected, m.formerAffected(affected)...)
+		if r.Withdrawn != nil {
+			// The tombstone is enough to recognize the entry.
+			continue
+		}
+		pas, err := m.platformAffected(affected)
+		if err != nil {
+			return osv.Entry{}, err
+		}
+		for _, pa := range pas {
+			entry.Affected = append(entry.Affected, pa)
+			entry.Affected = append(entry.Affected, m.formerAffected(pa)...)
+		}
 	}
 	for _, ref := range r.References {
 		entry.References = append(entry.References, osv.Reference{
@@ -183,11 +204,35 @@ func (r *Report) ToOSV(lastModified time.Time) (osv.Entry, error) {
 		}
 		details = fmt.Sprintf("%s\n\n%s", details, r.nonGoExplanation())
 	}
+	for _, text := range []string{r.FixMatrixText(), r.ConditionsText(), r.MitigationsText()} {
+		if text != "" {
+			details = fmt.Sprintf("%s\n\n%s", details, text)
+		}
+	}
 	entry.Details = toParagraphs(details)
 
 	return entry, nil
 }
 
+// tombstone strips the affected data of a withdrawn report that
+// is only useful for matching, keeping enough for the entry to be
+// valid and recognizable.
+//
+// Symbols are kept so that clients which do not understand withdrawn
+// entries do not report more widely than they did before, and the GOOS
+// and GOARCH lists and non-Go versions are dropped.
+func tombstone(a *osv.Affected) {
+	es := a.EcosystemSpecific
+	if es == nil {
+		return
+	}
+	es.CustomRanges = nil
+	for i := range es.Packages {
+		es.Packages[i].GOOS = nil
+		es.Packages[i].GOARCH = nil
+	}
+}
+
 func (r *Report) OSVFilename() string {
 	return filepath.Join(OSVDir, r.ID+".json")
 }
@@ -301,6 +346,20 @@ func toParagraphs(s string) string {
 	return paragraphBreak.ReplaceAllString(result.String(), "\n\n")
 }
 
+func (r *Report) disputedSymbols() []osv.DisputedSymbol {
+	var ds []osv.DisputedSymbol
+	for _, m := range r.Modules {
+		for _, p := range m.Packages {
+			syms := slices.Clone(p.DisputedSymbols)
+			sort.Strings(syms)
+			for _, s := range syms {
+				ds = append(ds, osv.DisputedSymbol{Package: p.Package, Symbol: s})
+			}
+		}
+	}
+	return ds
+}
+
 func toOSVPackages(pkgs []*Package) (imps []osv.Package) {
 	for _, p := range pkgs {
 		syms := append([]string{}, p.Symbols...)
@@ -316,14 +375,19 @@ func toOSVPackages(pkgs []*Package) (imps []osv.Package) {
 	return imps
 }
 
-func toAffected(m *Module) (osv.Affected, error) {
-	name := m.Module
-	switch name {
+// osvModulePath returns the path of module m in OSV entries.
+func osvModulePath(m string) string {
+	switch m {
 	case stdlib.ModulePath:
-		name = osv.GoStdModulePath
+		return osv.GoStdModulePath
 	case stdlib.ToolchainModulePath:
-		name = osv.GoCmdModulePath
+		return osv.GoCmdModulePath
 	}
+	return m
+}
+
+func toAffected(m *Module) (osv.Affected, error) {
+	name := osvModulePath(m.Module)
 	ranges, err := m.Versions.ToSemverRanges()
 	if err != nil {
 		return osv.Affected{}, err
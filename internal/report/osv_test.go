@@ -70,7 +70,7 @@ func TestToOSV(t *testing.T) {
 		CVEs:        []string{"CVE-0000-0000"},
 		GHSAs:       []string{"GHSA-abcd-efgh"},
 		Related:     []string{"CVE-0000-0002"},
-		Credits:     []string{"gopherbot"},
+		Credits:     []*Credit{{Name: "gopherbot"}},
 		References: []*Reference{
 			{Type: osv.ReferenceTypeAdvisory, URL: "advisory"},
 			{Type: osv.ReferenceTypeReport, URL: "issue"},
@@ -215,6 +215,371 @@ func TestToOSV(t *testing.T) {
 	}
 }
 
+func TestToOSVWithdrawn(t *testing.T) {
+	withdrawn := &osv.Time{Time: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	r := &Report{
+		ID: "GO-1991-0001",
+		Modules: []*Module{
+			{
+				Module:        "example.com/vulnerable",
+				Versions:      Versions{Fixed("1.2.3")},
+				NonGoVersions: Versions{Fixed("2.0.0")},
+				Packages: []*Package{
+					{
+						Package: "example.com/vulnerable/a",
+						GOOS:    []string{"windows"},
+						GOARCH:  []string{"arm64"},
+						Symbols: []string{"A"},
+					},
+				},
+			},
+		},
+		Description:     "Withdrawn advisory: a vulnerability.",
+		Withdrawn:       withdrawn,
+		WithdrawnReason: WithdrawnNotAVulnerability,
+	}
+	wantEntry := osv.Entry{
+		SchemaVersion: SchemaVersion,
+		ID:            "GO-1991-0001",
+		Withdrawn:     withdrawn,
+		Details:       "Withdrawn advisory: a vulnerability.",
+		Affected: []osv.Affected{
+			{
+				Module: osv.Module{
+					Path:      "example.com/vulnerable",
+					Ecosystem: "Go",
+				},
+				Ranges: []osv.Range{
+					{
+						Type: "SEMVER",
+						Events: []osv.RangeEvent{
+							{Introduced: "0"},
+							{Fixed: "1.2.3"},
+						},
+					},
+				},
+				EcosystemSpecific: &osv.EcosystemSpecific{
+					Packages: []osv.Package{
+						{
+							Path:    "example.com/vulnerable/a",
+							Symbols: []string{"A"},
+						},
+					},
+				},
+			},
+		},
+		DatabaseSpecific: &osv.DatabaseSpecific{URL: "https://pkg.go.dev/vuln/GO-1991-0001"},
+	}
+
+	gotEntry, err := r.ToOSV(time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(wantEntry, gotEntry, cmp.Comparer(func(a, b time.Time) bool { return a.Equal(b) })); diff != "" {
+		t.Errorf("ToOSV() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestToOSVDisputedSymbols(t *testing.T) {
+	r := &Report{
+		ID: "GO-1991-0001",
+		Modules: []*Module{
+			{
+				Module: "example.com/vulnerable",
+				Packages: []*Package{
+					{
+						Package:         "example.com/vulnerable/a",
+						Symbols:         []string{"A", "B", "C"},
+						DisputedSymbols: []string{"C", "A"},
+					},
+					{
+						Package: "example.com/vulnerable/b",
+						Symbols: []string{"D"},
+					},
+				},
+			},
+		},
+	}
+	entry, err := r.ToOSV(time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []osv.DisputedSymbol{
+		{Package: "example.com/vulnerable/a", Symbol: "A"},
+		{Package: "example.com/vulnerable/a", Symbol: "C"},
+	}
+	if diff := cmp.Diff(want, entry.DatabaseSpecific.DisputedSymbols); diff != "" {
+		t.Errorf("DisputedSymbols mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestToOSVFixStatuses(t *testing.T) {
+	r := &Report{
+		ID: "GO-1991-0001",
+		Modules: []*Module{
+			{
+				Module: "example.com/vulnerable",
+				Packages: []*Package{
+					{
+						Package:   "example.com/vulnerable/a",
+						FixStatus: &FixStatus{Status: FixStatusPending, URL: "https://example.com/issue/1"},
+					},
+					{
+						Package: "example.com/vulnerable/b",
+					},
+				},
+			},
+		},
+	}
+	entry, err := r.ToOSV(time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []osv.FixStatus{
+		{Package: "example.com/vulnerable/a", Status: "pending", URL: "https://example.com/issue/1"},
+	}
+	if diff := cmp.Diff(want, entry.DatabaseSpecific.FixStatuses); diff != "" {
+		t.Errorf("FixStatuses mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestToOSVUnaffected(t *testing.T) {
+	r := &Report{
+		ID: "GO-1991-0001",
+		Modules: []*Module{
+			{
+				Module:             "std",
+				Versions:           Versions{Introduced("1.21.0"), Fixed("1.21.5")},
+				UnaffectedVersions: Versions{Fixed("1.21.0")},
+			},
+			{
+				Module:   "example.com/vulnerable",
+				Versions: Versions{Fixed("1.0.1")},
+			},
+		},
+	}
+	entry, err := r.ToOSV(time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []osv.Unaffected{
+		{
+			Module: osv.GoStdModulePath,
+			Ranges: []osv.Range{{
+				Type:   osv.RangeTypeSemver,
+				Events: []osv.RangeEvent{{Introduced: "0"}, {Fixed: "1.21.0"}},
+			}},
+		},
+	}
+	if diff := cmp.Diff(want, entry.DatabaseSpecific.Unaffected); diff != "" {
+		t.Errorf("Unaffected mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestToOSVPlatformVersions(t *testing.T) {
+	r := &Report{
+		ID: "GO-1991-0001",
+		Modules: []*Module{
+			{
+				Module:   "golang.org/x/sys",
+				Versions: Versions{Fixed("0.5.0")},
+				PlatformVersions: []*PlatformVersions{
+					{GOOS: []string{"windows"}, Versions: Versions{Fixed("0.6.0")}},
+				},
+				Packages: []*Package{
+					{Package: "golang.org/x/sys/unix", GOOS: []string{"linux", "darwin"}},
+					{Package: "golang.org/x/sys/execabs", Symbols: []string{"Command"}},
+				},
+			},
+		},
+	}
+	entry, err := r.ToOSV(time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	semver := func(fixed string) []osv.Range {
+		return []osv.Range{{
+			Type:   osv.RangeTypeSemver,
+			Events: []osv.RangeEvent{{Introduced: "0"}, {Fixed: fixed}},
+		}}
+	}
+	mod := osv.Module{Path: "golang.org/x/sys", Ecosystem: osv.GoEcosystem}
+	want := []osv.Affected{
+		{
+			Module: mod,
+			Ranges: semver("0.5.0"),
+			EcosystemSpecific: &osv.EcosystemSpecific{
+				Packages: []osv.Package{
+					{Path: "golang.org/x/sys/unix", GOOS: []string{"linux", "darwin"}, Symbols: []string{}},
+					{Path: "golang.org/x/sys/execabs", Symbols: []string{"Command"}},
+				},
+			},
+		},
+		{
+			// Only the packages on windows.
+			Module: mod,
+			Ranges: semver("0.6.0"),
+			EcosystemSpecific: &osv.EcosystemSpecific{
+				Packages: []osv.Package{
+					{Path: "golang.org/x/sys/execabs", GOOS: []string{"windows"}, Symbols: []string{"Command"}},
+				},
+			},
+		},
+	}
+	if diff := cmp.Diff(want, entry.Affected); diff != "" {
+		t.Errorf("Affected mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestToOSVPatternClass(t *testing.T) {
+	r := &Report{
+		ID:           "GO-1991-0001",
+		PatternClass: PatternNilDereference,
+	}
+	entry, err := r.ToOSV(time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &osv.PatternClass{
+		Class:     "NIL_DEREFERENCE",
+		CWEs:      []string{"CWE-476"},
+		Analyzers: []string{"govet:nilness", "staticcheck:SA5011"},
+	}
+	if diff := cmp.Diff(want, entry.DatabaseSpecific.PatternClass); diff != "" {
+		t.Errorf("PatternClass mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestToOSVConditions(t *testing.T) {
+	r := &Report{
+		ID:          "GO-1991-0001",
+		Description: "A description.",
+		Conditions: []*Condition{
+			{Type: ConditionGODEBUG, Value: "x509sha1=1"},
+			{Type: ConditionInput, Value: "net/http.Header.Get", Description: "Header values set by the server are trusted."},
+		},
+	}
+	entry, err := r.ToOSV(time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantConditions := []osv.Condition{
+		{Type: "GODEBUG", Value: "x509sha1=1"},
+		{Type: "INPUT", Value: "net/http.Header.Get", Description: "Header values set by the server are trusted."},
+	}
+	if diff := cmp.Diff(wantConditions, entry.DatabaseSpecific.Conditions); diff != "" {
+		t.Errorf("Conditions mismatch (-want +got):\n%s", diff)
+	}
+	wantDetails := "A description.\n\n" +
+		"NOTE: Not all programs using the affected symbols are vulnerable.\n\n" +
+		"Only programs run with GODEBUG=x509sha1=1 are affected.\n\n" +
+		"Exploitation requires attacker-controlled input to net/http.Header.Get. Header values set by the server are trusted."
+	if entry.Details != wantDetails {
+		t.Errorf("Details = %q, want %q", entry.Details, wantDetails)
+	}
+}
+
+func TestToOSVMitigations(t *testing.T) {
+	r := &Report{
+		ID:          "GO-1991-0001",
+		Description: "A description.",
+		Mitigations: []*Mitigation{{
+			GODEBUG:     "httpmuxgo121",
+			Value:       "1",
+			Description: "It restores the pattern syntax of Go 1.21.",
+			Defaults: []*GODEBUGDefault{
+				{Go: "1.21.0", Value: "1"},
+				{Go: "1.22.0", Value: "0"},
+			},
+		}},
+	}
+	entry, err := r.ToOSV(time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantMitigations := []osv.Mitigation{{
+		GODEBUG:     "httpmuxgo121",
+		Value:       "1",
+		Description: "It restores the pattern syntax of Go 1.21.",
+		Defaults: []osv.GODEBUGDefault{
+			{Go: "1.21.0", Value: "1"},
+			{Go: "1.22.0", Value: "0"},
+		},
+	}}
+	if diff := cmp.Diff(wantMitigations, entry.DatabaseSpecific.Mitigations); diff != "" {
+		t.Errorf("Mitigations mismatch (-want +got):\n%s", diff)
+	}
+	wantDetails := "A description.\n\n" +
+		"MITIGATION: Setting GODEBUG=httpmuxgo121=1 mitigates this vulnerability. " +
+		"It restores the pattern syntax of Go 1.21. " +
+		"The default is httpmuxgo121=1 from go1.21, httpmuxgo121=0 from go1.22."
+	if entry.Details != wantDetails {
+		t.Errorf("Details = %q, want %q", entry.Details, wantDetails)
+	}
+}
+
+func TestToOSVEPSS(t *testing.T) {
+	r := &Report{
+		ID:   "GO-1991-0001",
+		CVEs: []string{"CVE-1999-0001"},
+		EPSS: &EPSS{CVE: "CVE-1999-0001", Score: 0.01234, Percentile: 0.85, Date: "2024-03-01"},
+	}
+	entry, err := r.ToOSV(time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &osv.EPSS{CVE: "CVE-1999-0001", Score: 0.01234, Percentile: 0.85, Date: "2024-03-01"}
+	if diff := cmp.Diff(want, entry.DatabaseSpecific.EPSS); diff != "" {
+		t.Errorf("EPSS mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestToOSVFixMatrix(t *testing.T) {
+	r := &Report{
+		ID:          "GO-1991-0001",
+		Description: "A description.",
+		Modules: []*Module{
+			{
+				Module:    "std",
+				FixMatrix: []*FixedRelease{{Go: "1.21", Fixed: "1.21.8"}, {Go: "1.22", Fixed: "1.22.1"}},
+			},
+			{
+				Module:    "cmd",
+				FixMatrix: []*FixedRelease{{Go: "1.22", Fixed: "1.22.2"}},
+			},
+		},
+	}
+	entry, err := r.ToOSV(time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "A description.\n\n" +
+		"FIXED RELEASES: Go 1.21 is fixed in go1.21.8. Go 1.22 is fixed in go1.22.2."
+	if entry.Details != want {
+		t.Errorf("Details = %q, want %q", entry.Details, want)
+	}
+}
+
+func TestPatternClassForCWE(t *testing.T) {
+	for _, test := range []struct {
+		cwe    string
+		want   PatternClass
+		wantOK bool
+	}{
+		{"CWE-476", PatternNilDereference, true},
+		{"CWE-400: Uncontrolled Resource Consumption", PatternResourceExhaustion, true},
+		{"CWE 22: Improper Limitation of a Pathname", PatternPathTraversal, true},
+		{"cwe-79: XSS", PatternInjection, true},
+		{"CWE-1333: Inefficient Regular Expression Complexity", "", false},
+		{"", "", false},
+	} {
+		got, ok := PatternClassForCWE(test.cwe)
+		if got != test.want || ok != test.wantOK {
+			t.Errorf("PatternClassForCWE(%q) = (%q, %t), want (%q, %t)", test.cwe, got, ok, test.want, test.wantOK)
+		}
+	}
+}
+
 func TestOSVFilename(t *testing.T) {
 	want := filepath.FromSlash("data/osv/GO-1999-0001.json")
 	r := &Report{ID: "GO-1999-0001"}
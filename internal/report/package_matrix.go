@@ -0,0 +1,206 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package report
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"text/tabwriter"
+
+	"golang.org/x/vulndb/internal/proxy"
+)
+
+// A PackageMatrix records whether each affected package of a module
+// existed at each endpoint of the module's version ranges.
+type PackageMatrix struct {
+	Module string
+	// Versions are the endpoints of the version ranges, in the
+	// order they appear in the report (omitting an introduced
+	// version of "0", at which no package exists).
+	Versions []string
+	// Exists maps each package to whether it existed
+	// at each of Versions.
+	Exists map[string][]bool
+}
+
+// PackageMatrix returns the package matrix of m, according to the
+// module zips served by the proxy. It is not supported for the
+// modules of the standard library and toolchain, which the proxy
+// does not serve.
+func (m *Module) PackageMatrix(pc *proxy.Client) (*PackageMatrix, error) {
+	if m.IsFirstParty() {
+		return nil, fmt.Errorf("%s: package matrix not supported for first-party modules", m.Module)
+	}
+	pm := &PackageMatrix{
+		Module: m.Module,
+		Exists: make(map[string][]bool),
+	}
+	for _, v := range m.Versions {
+		if v.Version != "" && v.Version != "0" && !slices.Contains(pm.Versions, v.Version) {
+			pm.Versions = append(pm.Versions, v.Version)
+		}
+	}
+	for _, v := range pm.Versions {
+		pkgs, err := pc.Packages(m.Module, v)
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range m.Packages {
+			pm.Exists[p.Package] = append(pm.Exists[p.Package], slices.Contains(pkgs, p.Package))
+		}
+	}
+	return pm, nil
+}
+
+// exists reports whether the package existed at version v. It reports
+// true for versions not in the matrix (such as an introduced version
+// of "0"), so that ranges with such endpoints are never dropped.
+func (pm *PackageMatrix) exists(pkg, v string) bool {
+	i := slices.Index(pm.Versions, v)
+	if i < 0 {
+		return true
+	}
+	return pm.Exists[pkg][i]
+}
+
+// String returns the matrix as a table, with a row for each package
+// and a column for each version.
+func (pm *PackageMatrix) String() string {
+	var b strings.Builder
+	tw := tabwriter.NewWriter(&b, 2, 4, 2, ' ', 0)
+	fmt.Fprintf(tw, "%s\t%s\n", pm.Module, strings.Join(pm.Versions, "\t"))
+	for _, pkg := range slices.Sorted(maps.Keys(pm.Exists)) {
+		cells := make([]string, len(pm.Versions))
+		for i, ok := range pm.Exists[pkg] {
+			cells[i] = "-"
+			if ok {
+				cells[i] = "x"
+			}
+		}
+		fmt.Fprintf(tw, "%s\t%s\n", pkg, strings.Join(cells, "\t"))
+	}
+	tw.Flush()
+	return b.String()
+}
+
+// SplitByPackages splits m into one module entry for each set of its
+// packages that existed at the same endpoints of its version ranges,
+// according to the matrix. Each entry keeps only the ranges in which
+// its packages existed at the introduced or fixed version; a package
+// that existed at neither may have been added and removed within the
+// range, but more likely was not yet added, or was moved, so the range
+// is dropped. (A package that existed at none of the endpoints keeps
+// all the ranges, as it probably has the wrong path.)
+//
+// SplitByPackages returns []*Module{m} if the module does not need
+// to be split.
+func (m *Module) SplitByPackages(pm *PackageMatrix) []*Module {
+	ranges := m.Versions.ranges()
+	var (
+		split  []*Module
+		byKept = make(map[string]*Module)
+	)
+	for _, p := range m.Packages {
+		var kept []int
+		for i, r := range ranges {
+			if r.intro == nil || pm.exists(p.Package, r.intro.Version) ||
+				(r.fixed != nil && pm.exists(p.Package, r.fixed.Version)) {
+				kept = append(kept, i)
+			}
+		}
+		if len(kept) == 0 {
+			for i := range ranges {
+				kept = append(kept, i)
+			}
+		}
+		key := fmt.Sprint(kept)
+		nm, ok := byKept[key]
+		if !ok {
+			c := *m
+			nm = &c
+			nm.Versions, nm.Packages = nil, nil
+			for _, i := range kept {
+				nm.Versions = append(nm.Versions, ranges[i].versions()...)
+			}
+			byKept[key] = nm
+			split = append(split, nm)
+		}
+		nm.Packages = append(nm.Packages, p)
+	}
+	if len(split) == 1 && len(split[0].Versions) == len(m.Versions) {
+		return []*Module{m}
+	}
+	return split
+}
+
+// A versionRange is an introduced version (nil if the range
+// starts at the beginning of time) and a fixed version (nil if
+// the range is not fixed).
+type versionRange struct {
+	intro, fixed *Version
+}
+
+func (r versionRange) versions() Versions {
+	var vs Versions
+	if r.intro != nil {
+		vs = append(vs, r.intro.copy())
+	}
+	if r.fixed != nil {
+		vs = append(vs, r.fixed.copy())
+	}
+	return vs
+}
+
+// ranges returns the version ranges of vs.
+func (vs Versions) ranges() []versionRange {
+	var (
+		rs []versionRange
+		r  versionRange
+	)
+	for _, v := range vs {
+		switch {
+		case v.IsIntroduced():
+			if r.intro != nil {
+				rs = append(rs, r)
+			}
+			r = versionRange{intro: v}
+		case v.IsFixed():
+			r.fixed = v
+			rs = append(rs, r)
+			r = versionRange{}
+		}
+	}
+	if r.intro != nil {
+		rs = append(rs, r)
+	}
+	return rs
+}
+
+// SplitModulesByPackages splits the modules of r whose packages did
+// not all exist at the same endpoints of the module's version ranges,
+// as described in SplitByPackages, and returns the paths of the
+// modules it split.
+func (r *Report) SplitModulesByPackages(pc *proxy.Client) (split []string, err error) {
+	var modules []*Module
+	for _, m := range r.Modules {
+		if m.IsFirstParty() || len(m.Packages) < 2 {
+			modules = append(modules, m)
+			continue
+		}
+		pm, err := m.PackageMatrix(pc)
+		if err != nil {
+			return nil, err
+		}
+		ms := m.SplitByPackages(pm)
+		if len(ms) > 1 || ms[0] != m {
+			split = append(split, m.Module)
+		}
+		modules = append(modules, ms...)
+	}
+	r.Modules = modules
+	return split, nil
+}
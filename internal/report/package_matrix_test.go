@@ -0,0 +1,127 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package report
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/vulndb/internal/proxy"
+)
+
+// testMatrixModule is a module whose package b was removed in 1.2.0,
+// and whose package d was added in 1.2.0.
+var testMatrixModule = &Module{
+	Module: "example.com/mod",
+	Versions: []*Version{
+		Introduced("1.0.0"), Fixed("1.1.0"),
+		Introduced("1.2.0"), Fixed("1.3.0"),
+	},
+	Packages: []*Package{
+		{Package: "example.com/mod"},
+		{Package: "example.com/mod/b"},
+		{Package: "example.com/mod/d"},
+	},
+}
+
+func TestPackageMatrix(t *testing.T) {
+	// The responses are hand-written zips for
+	// a fake module, so don't update them.
+	pc, err := proxy.NewTestClient(t, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := testMatrixModule.PackageMatrix(pc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &PackageMatrix{
+		Module:   "example.com/mod",
+		Versions: []string{"1.0.0", "1.1.0", "1.2.0", "1.3.0"},
+		Exists: map[string][]bool{
+			"example.com/mod":   {true, true, true, true},
+			"example.com/mod/b": {true, true, false, false},
+			"example.com/mod/d": {false, false, true, true},
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("PackageMatrix() mismatch (-want, +got):\n%s", diff)
+	}
+
+	wantString := `example.com/mod    1.0.0  1.1.0  1.2.0  1.3.0
+example.com/mod    x      x      x      x
+example.com/mod/b  x      x      -      -
+example.com/mod/d  -      -      x      x
+`
+	if diff := cmp.Diff(wantString, got.String()); diff != "" {
+		t.Errorf("String() mismatch (-want, +got):\n%s", diff)
+	}
+}
+
+func TestSplitByPackages(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		exists map[string][]bool
+		want   []*Module
+	}{
+		{
+			name: "split",
+			exists: map[string][]bool{
+				"example.com/mod":   {true, true, true, true},
+				"example.com/mod/b": {true, true, false, false},
+				"example.com/mod/d": {false, false, true, true},
+			},
+			want: []*Module{
+				{
+					Module:   "example.com/mod",
+					Versions: testMatrixModule.Versions,
+					Packages: []*Package{{Package: "example.com/mod"}},
+				},
+				{
+					Module:   "example.com/mod",
+					Versions: []*Version{Introduced("1.0.0"), Fixed("1.1.0")},
+					Packages: []*Package{{Package: "example.com/mod/b"}},
+				},
+				{
+					Module:   "example.com/mod",
+					Versions: []*Version{Introduced("1.2.0"), Fixed("1.3.0")},
+					Packages: []*Package{{Package: "example.com/mod/d"}},
+				},
+			},
+		},
+		{
+			name: "added mid-range",
+			// d exists at the fixed version of the first
+			// range, so it keeps the range.
+			exists: map[string][]bool{
+				"example.com/mod":   {true, true, true, true},
+				"example.com/mod/b": {true, true, true, true},
+				"example.com/mod/d": {false, true, true, true},
+			},
+			want: []*Module{testMatrixModule},
+		},
+		{
+			name: "never existed",
+			exists: map[string][]bool{
+				"example.com/mod":   {true, true, true, true},
+				"example.com/mod/b": {false, false, false, false},
+				"example.com/mod/d": {true, true, true, true},
+			},
+			want: []*Module{testMatrixModule},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			pm := &PackageMatrix{
+				Module:   "example.com/mod",
+				Versions: []string{"1.0.0", "1.1.0", "1.2.0", "1.3.0"},
+				Exists:   tc.exists,
+			}
+			got := testMatrixModule.SplitByPackages(pm)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("SplitByPackages() mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,164 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package report
+
+import (
+	"slices"
+	"strings"
+
+	"golang.org/x/vulndb/internal/osv"
+)
+
+// PatternClass is the class of programming error that caused a
+// vulnerability, for correlating reports with the findings of static
+// analysis tools.
+//
+// It must be one of the values in PatternClasses.
+type PatternClass string
+
+const (
+	PatternNilDereference       PatternClass = "NIL_DEREFERENCE"
+	PatternIntegerOverflow      PatternClass = "INTEGER_OVERFLOW"
+	PatternOutOfBounds          PatternClass = "OUT_OF_BOUNDS"
+	PatternUncheckedError       PatternClass = "UNCHECKED_ERROR"
+	PatternFormatString         PatternClass = "FORMAT_STRING"
+	PatternUnsafePointer        PatternClass = "UNSAFE_POINTER"
+	PatternDataRace             PatternClass = "DATA_RACE"
+	PatternResourceLeak         PatternClass = "RESOURCE_LEAK"
+	PatternResourceExhaustion   PatternClass = "RESOURCE_EXHAUSTION"
+	PatternInfiniteLoop         PatternClass = "INFINITE_LOOP"
+	PatternPathTraversal        PatternClass = "PATH_TRAVERSAL"
+	PatternInjection            PatternClass = "INJECTION"
+	PatternImproperValidation   PatternClass = "IMPROPER_VALIDATION"
+	PatternWeakCryptography     PatternClass = "WEAK_CRYPTOGRAPHY"
+	PatternTimingSideChannel    PatternClass = "TIMING_SIDE_CHANNEL"
+	PatternAuthenticationBypass PatternClass = "AUTHENTICATION_BYPASS"
+)
+
+// A patternInfo describes a pattern class.
+type patternInfo struct {
+	// CWEs are the IDs of the CWE weaknesses in the class.
+	cwes []string
+	// analyzers are the static analysis checks that can find instances
+	// of the class, of the form "tool:check" (for example,
+	// "govet:nilness" or "staticcheck:SA5011"). Classes that
+	// no analyzer finds have none.
+	analyzers []string
+}
+
+// patterns holds the vocabulary of pattern classes.
+// Keep it in sync with doc/format.md.
+var patterns = map[PatternClass]patternInfo{
+	PatternNilDereference: {
+		cwes:      []string{"CWE-476"},
+		analyzers: []string{"govet:nilness", "staticcheck:SA5011"},
+	},
+	PatternIntegerOverflow: {
+		cwes: []string{"CWE-190", "CWE-191", "CWE-681"},
+	},
+	PatternOutOfBounds: {
+		cwes: []string{"CWE-125", "CWE-129", "CWE-787"},
+	},
+	PatternUncheckedError: {
+		cwes:      []string{"CWE-252", "CWE-703"},
+		analyzers: []string{"govet:unusedresult", "staticcheck:SA5001"},
+	},
+	PatternFormatString: {
+		cwes:      []string{"CWE-134"},
+		analyzers: []string{"govet:printf"},
+	},
+	PatternUnsafePointer: {
+		cwes:      []string{"CWE-119", "CWE-843"},
+		analyzers: []string{"govet:unsafeptr"},
+	},
+	PatternDataRace: {
+		cwes:      []string{"CWE-362", "CWE-366", "CWE-667"},
+		analyzers: []string{"govet:atomic", "govet:copylocks"},
+	},
+	PatternResourceLeak: {
+		cwes:      []string{"CWE-401", "CWE-404", "CWE-772"},
+		analyzers: []string{"govet:httpresponse", "govet:lostcancel"},
+	},
+	PatternResourceExhaustion: {
+		cwes: []string{"CWE-400", "CWE-674", "CWE-770", "CWE-789"},
+	},
+	PatternInfiniteLoop: {
+		cwes:      []string{"CWE-835"},
+		analyzers: []string{"staticcheck:SA5002"},
+	},
+	PatternPathTraversal: {
+		cwes: []string{"CWE-22", "CWE-23", "CWE-29", "CWE-59"},
+	},
+	PatternInjection: {
+		cwes: []string{"CWE-74", "CWE-78", "CWE-79", "CWE-89", "CWE-93", "CWE-94", "CWE-113"},
+	},
+	PatternImproperValidation: {
+		cwes: []string{"CWE-20", "CWE-295", "CWE-347"},
+	},
+	PatternWeakCryptography: {
+		cwes: []string{"CWE-326", "CWE-327", "CWE-328", "CWE-338"},
+	},
+	PatternTimingSideChannel: {
+		cwes: []string{"CWE-203", "CWE-208"},
+	},
+	PatternAuthenticationBypass: {
+		cwes: []string{"CWE-287", "CWE-288", "CWE-862", "CWE-863"},
+	},
+}
+
+// PatternClasses are the set of valid pattern classes, in order.
+var PatternClasses = func() []PatternClass {
+	var pcs []PatternClass
+	for pc := range patterns {
+		pcs = append(pcs, pc)
+	}
+	slices.Sort(pcs)
+	return pcs
+}()
+
+// IsValid reports whether p is one of the PatternClasses.
+func (p PatternClass) IsValid() bool {
+	_, ok := patterns[p]
+	return ok
+}
+
+// CWEs returns the IDs of the CWE weaknesses in the class.
+func (p PatternClass) CWEs() []string {
+	return slices.Clone(patterns[p].cwes)
+}
+
+// Analyzers returns the static analysis checks that can find
+// instances of the class, of the form "tool:check".
+func (p PatternClass) Analyzers() []string {
+	return slices.Clone(patterns[p].analyzers)
+}
+
+// PatternClassForCWE returns the pattern class that contains the
+// CWE weakness cwe, which may be an ID ("CWE-476") or an ID followed
+// by a name ("CWE-476: NULL Pointer Dereference"), as in
+// CVEMeta.CWE. The dash may be written as a space ("CWE 476").
+func PatternClassForCWE(cwe string) (PatternClass, bool) {
+	id, _, _ := strings.Cut(cwe, ":")
+	id = strings.Replace(strings.ToUpper(strings.TrimSpace(id)), "CWE ", "CWE-", 1)
+	for _, pc := range PatternClasses {
+		if slices.Contains(patterns[pc].cwes, id) {
+			return pc, true
+		}
+	}
+	return "", false
+}
+
+// toOSV returns the pattern class in the form used in the
+// database_specific field of an OSV entry, or nil if it is not set.
+func (p PatternClass) toOSV() *osv.PatternClass {
+	if !p.IsValid() {
+		return nil
+	}
+	return &osv.PatternClass{
+		Class:     string(p),
+		CWEs:      p.CWEs(),
+		Analyzers: p.Analyzers(),
+	}
+}
@@ -0,0 +1,187 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package report
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"golang.org/x/vulndb/internal/osv"
+	"golang.org/x/vulndb/internal/osvutils"
+	"golang.org/x/vulndb/internal/version/interval"
+)
+
+// PlatformVersions are the affected versions of a module on some
+// platforms, for vulnerabilities that were fixed (or introduced) in
+// different versions on different platforms, for example:
+//
+//	versions:
+//	    - fixed: 0.5.0
+//	platform_versions:
+//	    - goos:
+//	        - windows
+//	      versions:
+//	        - fixed: 0.6.0
+//
+// On the platforms that match GOOS and GOARCH (either of which may be
+// empty, to match any), the affected versions are Versions instead of
+// the module's versions.
+//
+// The module's versions must be affected on every platform, so they must
+// be contained in Versions. This way, the OSV entry, which lists
+// Versions as another affected module limited to the platforms, means
+// the same thing to clients that don't know about platform versions.
+type PlatformVersions struct {
+	GOOS     []string `yaml:"goos,omitempty"`
+	GOARCH   []string `yaml:"goarch,omitempty"`
+	Versions Versions `yaml:",omitempty"`
+}
+
+// Packages returns the packages of m on the platforms of pv: the
+// packages that are built for any of the platforms, limited to
+// those of their GOOS and GOARCH that are among the platforms.
+func (pv *PlatformVersions) Packages(m *Module) []*Package {
+	var ps []*Package
+	for _, p := range m.Packages {
+		goos, ok := restrictPlatforms(p.GOOS, pv.GOOS)
+		if !ok {
+			continue
+		}
+		goarch, ok := restrictPlatforms(p.GOARCH, pv.GOARCH)
+		if !ok {
+			continue
+		}
+		pc := p.copy()
+		pc.GOOS, pc.GOARCH = goos, goarch
+		ps = append(ps, pc)
+	}
+	return ps
+}
+
+// restrictPlatforms returns the platforms (GOOS or GOARCH values)
+// of a package, which are all if empty, restricted to to (also all if
+// empty), and whether any are left.
+func restrictPlatforms(ps, to []string) ([]string, bool) {
+	switch {
+	case len(to) == 0:
+		return ps, true
+	case len(ps) == 0:
+		return to, true
+	}
+	var both []string
+	for _, p := range ps {
+		if slices.Contains(to, p) {
+			both = append(both, p)
+		}
+	}
+	return both, len(both) > 0
+}
+
+// String returns the platforms of pv, e.g. "windows/amd64".
+func (pv *PlatformVersions) String() string {
+	all := func(ps []string) string {
+		if len(ps) == 0 {
+			return "*"
+		}
+		return strings.Join(ps, ",")
+	}
+	return all(pv.GOOS) + "/" + all(pv.GOARCH)
+}
+
+// overlaps reports whether some platform matches both pv and pv2.
+func (pv *PlatformVersions) overlaps(pv2 *PlatformVersions) bool {
+	_, goos := restrictPlatforms(pv.GOOS, pv2.GOOS)
+	_, goarch := restrictPlatforms(pv.GOARCH, pv2.GOARCH)
+	return goos && goarch
+}
+
+func (m *Module) lintPlatformVersions(l *linter) {
+	if len(m.PlatformVersions) == 0 {
+		return
+	}
+	if len(m.Packages) == 0 {
+		l.Group("platform_versions").Error("require packages, to limit to the platforms")
+	}
+	// Errors in the module's versions are reported by lintVersions.
+	affected, affectedErr := moduleAffected(m)
+	for i, pv := range m.PlatformVersions {
+		pl := l.Group(name("platform_versions", i, pv.String()))
+		if len(pv.GOOS) == 0 && len(pv.GOARCH) == 0 {
+			pl.Error("must set goos or goarch")
+		}
+		for _, pv2 := range m.PlatformVersions[:i] {
+			if pv.overlaps(pv2) {
+				pl.Errorf("overlaps the platforms of %s", pv2)
+			}
+		}
+		if len(m.Packages) != 0 && len(pv.Packages(m)) == 0 {
+			pl.Error("no package is built for these platforms")
+		}
+
+		vl := pl.Group("versions")
+		ranges, err := pv.Versions.ToSemverRanges()
+		if err != nil {
+			vl.Errorf("invalid version(s): %s", err)
+			continue
+		}
+		if err := osvutils.ValidateRanges(ranges); err != nil {
+			vl.Error(err)
+			continue
+		}
+		platform, err := interval.FromOSV(ranges)
+		if err != nil {
+			vl.Error(err)
+			continue
+		}
+		if affectedErr == nil && !platform.ContainsSet(affected) {
+			vl.Errorf("must contain the module's versions (missing %s)", affected.Difference(platform))
+		}
+	}
+}
+
+// platformAffected returns the affected modules for the platform
+// versions of m, which are like a, the affected module for m.
+func (m *Module) platformAffected(a osv.Affected) ([]osv.Affected, error) {
+	var as []osv.Affected
+	for _, pv := range m.PlatformVersions {
+		ranges, err := pv.Versions.ToSemverRanges()
+		if err != nil {
+			return nil, err
+		}
+		pa := a
+		pa.Ranges = ranges
+		es := *a.EcosystemSpecific
+		es.Packages = toOSVPackages(pv.Packages(m))
+		pa.EcosystemSpecific = &es
+		as = append(as, pa)
+	}
+	return as, nil
+}
+
+// describePlatformVersions describes pvs like describeVersions,
+// e.g. "windows/*: fixed 0.6.0".
+func describePlatformVersions(pvs []*PlatformVersions) string {
+	var s []string
+	for _, pv := range pvs {
+		s = append(s, fmt.Sprintf("%s: %s", pv, describeVersions(pv.Versions)))
+	}
+	return strings.Join(s, "; ")
+}
+
+func copyPlatformVersions(pvs []*PlatformVersions) []*PlatformVersions {
+	if pvs == nil {
+		return nil
+	}
+	c := make([]*PlatformVersions, len(pvs))
+	for i, pv := range pvs {
+		c[i] = &PlatformVersions{
+			GOOS:     slices.Clone(pv.GOOS),
+			GOARCH:   slices.Clone(pv.GOARCH),
+			Versions: pv.Versions.copy(),
+		}
+	}
+	return c
+}
@@ -0,0 +1,150 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package report
+
+import (
+	"fmt"
+	"strings"
+
+	"golang.org/x/vulndb/internal/proxy"
+	"golang.org/x/vulndb/internal/version/interval"
+)
+
+// A RangeImpact describes how a change to the version ranges of a
+// module changes which of its versions known to the proxy are
+// flagged as vulnerable.
+type RangeImpact struct {
+	Module string
+	// Versions is the number of versions of the module
+	// known to the proxy.
+	Versions int
+	// OldAffected and NewAffected are the number of versions
+	// of the module affected before and after the change.
+	OldAffected, NewAffected int
+	// Flagged are the versions affected after the change,
+	// but not before, in ascending order.
+	Flagged []string
+	// Unflagged are the versions affected before the change,
+	// but not after, in ascending order.
+	Unflagged []string
+	// Latest is the latest version of the module, if it is
+	// affected after the change but was not before. Users of it
+	// are flagged with no fixed version to upgrade to.
+	Latest string
+}
+
+// RangeImpacts returns the impact of changing the version ranges of the
+// modules of report old to those of report new, for each module whose
+// affected versions (among those known to the proxy) differ. A module
+// added to the report flags all its affected versions, and a removed
+// module unflags them.
+//
+// The modules of the standard library and toolchain are skipped, as
+// the proxy does not serve them.
+func RangeImpacts(old, new *Report, pc *proxy.Client) ([]*RangeImpact, error) {
+	var (
+		paths   []string
+		oldMods = make(map[string]*Module)
+		newMods = make(map[string]*Module)
+	)
+	add := func(mods map[string]*Module, ms []*Module) {
+		for _, m := range ms {
+			if m.IsFirstParty() {
+				continue
+			}
+			if _, ok := oldMods[m.Module]; !ok {
+				if _, ok := newMods[m.Module]; !ok {
+					paths = append(paths, m.Module)
+				}
+			}
+			mods[m.Module] = m
+		}
+	}
+	add(oldMods, old.Modules)
+	add(newMods, new.Modules)
+
+	var impacts []*RangeImpact
+	for _, path := range paths {
+		ri, err := rangeImpact(path, oldMods[path], newMods[path], pc)
+		if err != nil {
+			return nil, err
+		}
+		if ri != nil {
+			impacts = append(impacts, ri)
+		}
+	}
+	return impacts, nil
+}
+
+// rangeImpact returns the impact of changing the module with the
+// given path from old to new (either of which may be nil, if the
+// module is not in the report), or nil if there is none.
+func rangeImpact(path string, old, new *Module, pc *proxy.Client) (*RangeImpact, error) {
+	oldSet, err := moduleAffected(old)
+	if err != nil {
+		return nil, fmt.Errorf("%s: old versions: %w", path, err)
+	}
+	newSet, err := moduleAffected(new)
+	if err != nil {
+		return nil, fmt.Errorf("%s: new versions: %w", path, err)
+	}
+	vs, err := pc.Versions(path)
+	if err != nil {
+		return nil, err
+	}
+	ri := &RangeImpact{Module: path, Versions: len(vs)}
+	for _, v := range vs {
+		wasAffected := oldSet.Contains(v)
+		isAffected := newSet.Contains(v)
+		if wasAffected {
+			ri.OldAffected++
+		}
+		if isAffected {
+			ri.NewAffected++
+		}
+		switch {
+		case isAffected && !wasAffected:
+			ri.Flagged = append(ri.Flagged, v)
+		case wasAffected && !isAffected:
+			ri.Unflagged = append(ri.Unflagged, v)
+		}
+	}
+	if len(ri.Flagged) == 0 && len(ri.Unflagged) == 0 {
+		return nil, nil
+	}
+	if n := len(vs); n > 0 && len(ri.Flagged) > 0 && ri.Flagged[len(ri.Flagged)-1] == vs[n-1] {
+		ri.Latest = vs[n-1]
+	}
+	return ri, nil
+}
+
+// moduleAffected returns the versions of m that are affected,
+// or nil if m is nil.
+func moduleAffected(m *Module) (interval.Set, error) {
+	if m == nil {
+		return nil, nil
+	}
+	ranges, err := m.Versions.ToSemverRanges()
+	if err != nil {
+		return nil, err
+	}
+	return interval.FromOSV(ranges)
+}
+
+// String returns a summary of the impact, for a review.
+func (ri *RangeImpact) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s: %d of %d versions affected (was %d)", ri.Module, ri.NewAffected, ri.Versions, ri.OldAffected)
+	if n := len(ri.Flagged); n > 0 {
+		fmt.Fprintf(&b, "\n  newly flagged (%d): %s", n, strings.Join(ri.Flagged, ", "))
+	}
+	if n := len(ri.Unflagged); n > 0 {
+		fmt.Fprintf(&b, "\n  unflagged (%d): %s", n, strings.Join(ri.Unflagged, ", "))
+	}
+	if ri.Latest != "" {
+		fmt.Fprintf(&b, "\n  the latest version, %s, is now affected, so its users have no fixed version to upgrade to", ri.Latest)
+	}
+	return b.String()
+}
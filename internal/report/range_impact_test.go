@@ -0,0 +1,65 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package report
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/vulndb/internal/proxy"
+)
+
+func TestRangeImpacts(t *testing.T) {
+	// The responses are hand-written version lists
+	// for fake modules, so don't update them.
+	pc, err := proxy.NewTestClient(t, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	old := &Report{Modules: []*Module{
+		{Module: "example.com/mod", Versions: Versions{Introduced("1.0.0"), Fixed("1.2.0")}},
+		{Module: "example.com/same", Versions: Versions{Fixed("1.1.0")}},
+		{Module: "std", Versions: Versions{Fixed("1.21.0")}},
+	}}
+	new := &Report{Modules: []*Module{
+		{Module: "example.com/mod", Versions: Versions{Introduced("1.1.0")}},
+		{Module: "example.com/same", Versions: Versions{Fixed("1.2.0")}},
+		{Module: "example.com/added", Versions: Versions{Fixed("0.2.0")}},
+		{Module: "std", Versions: Versions{Fixed("1.22.0")}},
+	}}
+	got, err := RangeImpacts(old, new, pc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*RangeImpact{
+		{
+			Module:      "example.com/mod",
+			Versions:    5,
+			OldAffected: 2,
+			NewAffected: 4,
+			Flagged:     []string{"1.2.0", "1.2.1", "1.3.0"},
+			Unflagged:   []string{"1.0.0"},
+			Latest:      "1.3.0",
+		},
+		{
+			Module:      "example.com/added",
+			Versions:    2,
+			NewAffected: 1,
+			Flagged:     []string{"0.1.0"},
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("RangeImpacts mismatch (-want, +got):\n%s", diff)
+	}
+
+	wantString := `example.com/mod: 4 of 5 versions affected (was 2)
+  newly flagged (3): 1.2.0, 1.2.1, 1.3.0
+  unflagged (1): 1.0.0
+  the latest version, 1.3.0, is now affected, so its users have no fixed version to upgrade to`
+	if diff := cmp.Diff(wantString, got[0].String()); diff != "" {
+		t.Errorf("String mismatch (-want, +got):\n%s", diff)
+	}
+}
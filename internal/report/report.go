@@ -27,8 +27,14 @@ import (
 )
 
 type Module struct {
-	Module   string   `yaml:",omitempty"`
-	Versions Versions `yaml:",omitempty"`
+	Module string `yaml:",omitempty"`
+	// Paths the module was previously published at, such as its
+	// path before it moved to a vanity domain. The vulnerable
+	// versions and packages are published for these paths too, so
+	// that users still importing the module by an old path are
+	// matched.
+	FormerPaths []string `yaml:"former_paths,omitempty"`
+	Versions    Versions `yaml:",omitempty"`
 	// Versions that are not known to the module proxy, but
 	// that may be useful to display to humans.
 	NonGoVersions Versions `yaml:"non_go_versions,omitempty"`
@@ -36,6 +42,16 @@ type Module struct {
 	// These may be added when automatically creating a report,
 	// but must be deleted in order to pass lint checks.
 	UnsupportedVersions Versions `yaml:"unsupported_versions,omitempty"`
+	// Versions that are known not to be affected, even though
+	// another source (such as the upstream advisory) may say they
+	// are, for example because the vulnerable feature was introduced
+	// later than claimed. They are written like Versions, but each
+	// "introduced" starts a range of unaffected versions and each
+	// "fixed" ends one. They must not overlap Versions.
+	UnaffectedVersions Versions `yaml:"unaffected_versions,omitempty"`
+	// Versions that are affected on some platforms, when they differ
+	// from Versions. See PlatformVersions.
+	PlatformVersions []*PlatformVersions `yaml:"platform_versions,omitempty"`
 	// Known-vulnerable version, to use when performing static analysis or
 	// other techniques on a vulnerable version of the package.
 	//
@@ -51,6 +67,11 @@ type Module struct {
 	// the fix links found in the report's References field will be used.
 	// Only auto-added if the -update flag is passed to vulnreport.
 	FixLinks []string `yaml:"fix_links,omitempty"`
+	// For the standard library and toolchain, the fixed release of each
+	// Go minor version with a fix, derived from Versions by
+	// vulnreport fix. It is included in the advisory text so that users
+	// of older minor versions can see what to upgrade to.
+	FixMatrix []*FixedRelease `yaml:"fix_matrix,omitempty"`
 	// Do not lint this module.
 	// Only for use in exceptional circumstances, such as when a malicious
 	// module has been deleted from the proxy entirely.
@@ -182,9 +203,17 @@ type Package struct {
 	// symbols, but is not published to OSV or elsewhere (so, for example,
 	// govulncheck cannot consume it).
 	ExcludedSymbols []string `yaml:"excluded_symbols,omitempty"`
+	// Symbols (from Symbols or DerivedSymbols) that users have reported
+	// as not actually vulnerable, and whose feedback has not yet been
+	// resolved. They remain vulnerable, but are marked as disputed in
+	// the OSV database_specific field.
+	DisputedSymbols []string `yaml:"disputed_symbols,omitempty"`
 	// Reason the package's symbols are already considered fixed and should not
 	// be checked or automatically updated.
 	SkipFixSymbols string `yaml:"skip_fix,omitempty"`
+	// Whether a fix is available, if the module's versions
+	// do not say.
+	FixStatus *FixStatus `yaml:"fix_status,omitempty"`
 }
 
 type CVEMeta struct {
@@ -196,8 +225,18 @@ type CVEMeta struct {
 	// added to a CVE by the CVE program that the Go team does not want
 	// to display via OSV. An example that uses this is GO-2022-0476.
 	References []string `yaml:",omitempty"`
+	// State is the state of the CVE record in CVE Services
+	// (RESERVED, PUBLISHED or REJECTED), as of the last time
+	// it was published with "vulnreport cve publish".
+	State string `yaml:",omitempty"`
+	// Updated is the time CVE Services last updated the record,
+	// as of the last time it was published.
+	Updated time.Time `yaml:",omitempty"`
 }
 
+// CVEStates are the valid values of CVEMeta.State.
+var CVEStates = []string{"RESERVED", "PUBLISHED", "REJECTED"}
+
 // ExcludedType is the reason a report is excluded from the database.
 //
 // It must be one of the values in ExcludedTypes.
@@ -226,6 +265,55 @@ var ExcludedTypes = []ExcludedType{
 	ExcludedWithdrawn,
 }
 
+// WithdrawnReason is the reason a report is withdrawn.
+//
+// It must be one of the values in WithdrawnReasons.
+type WithdrawnReason string
+
+const (
+	WithdrawnDuplicate         WithdrawnReason = "DUPLICATE"
+	WithdrawnNotAVulnerability WithdrawnReason = "NOT_A_VULNERABILITY"
+	WithdrawnUpstream          WithdrawnReason = "UPSTREAM_WITHDRAWN"
+	WithdrawnCreatedInError    WithdrawnReason = "CREATED_IN_ERROR"
+)
+
+// WithdrawnReasons are the set of reasons a report may be withdrawn.
+// These are described in detail at
+// https://go.googlesource.com/vulndb/+/refs/heads/master/doc/format.md.
+var WithdrawnReasons = []WithdrawnReason{
+	WithdrawnDuplicate,
+	WithdrawnNotAVulnerability,
+	WithdrawnUpstream,
+	WithdrawnCreatedInError,
+}
+
+var withdrawnReasonText = map[WithdrawnReason]string{
+	WithdrawnDuplicate:         "it duplicates another report",
+	WithdrawnNotAVulnerability: "the issue is not a security vulnerability",
+	WithdrawnUpstream:          "the source advisory was withdrawn or rejected",
+	WithdrawnCreatedInError:    "it was created in error",
+}
+
+func (w WithdrawnReason) IsValid() bool {
+	return slices.Contains(WithdrawnReasons, w)
+}
+
+// Text returns an explanation of the reason, for use in a sentence of
+// the form "This report was withdrawn because ...", or "" if the
+// reason is not valid.
+func (w WithdrawnReason) Text() string {
+	return withdrawnReasonText[w]
+}
+
+// ToWithdrawnReason converts s, in any case, to a WithdrawnReason.
+func ToWithdrawnReason(s string) (WithdrawnReason, bool) {
+	w := WithdrawnReason(strings.ToUpper(s))
+	if !w.IsValid() {
+		return "", false
+	}
+	return w, true
+}
+
 func (e *ExcludedType) IsValid() bool {
 	return slices.Contains(ExcludedTypes, *e)
 }
@@ -289,6 +377,11 @@ const (
 	NoteTypeLint   NoteType = "LINT"
 	NoteTypeFix    NoteType = "FIX"
 	NoteTypeCreate NoteType = "CREATE"
+	// A lint-ignore note suppresses lints; see LintSuppression.
+	NoteTypeLintIgnore NoteType = "LINT-IGNORE"
+	// A force-reviewed note records a forced change to the frozen
+	// fields of a REVIEWED report; see FrozenOverride.
+	NoteTypeForceReviewed NoteType = "FORCE-REVIEWED"
 )
 
 func (n *Note) MarshalYAML() (any, error) {
@@ -336,7 +429,13 @@ type Report struct {
 	// assigning a CVE ID ourselves, use CVEMetadata.Description instead.
 	Description Description `yaml:",omitempty"`
 	Published   time.Time   `yaml:",omitempty"`
-	Withdrawn   *osv.Time   `yaml:",omitempty"`
+	// Withdrawn is the time the report was withdrawn,
+	// for reports that should no longer be considered valid.
+	Withdrawn *osv.Time `yaml:",omitempty"`
+	// WithdrawnReason is the reason the report was withdrawn.
+	// It is required for reports withdrawn by vulnreport withdraw,
+	// but may be missing from reports withdrawn before it was added.
+	WithdrawnReason WithdrawnReason `yaml:"withdrawn_reason,omitempty"`
 
 	// CVE are CVE IDs for existing CVEs.
 	// If we are assigning a CVE ID ourselves, use CVEMetadata.ID instead.
@@ -353,9 +452,32 @@ type Report struct {
 	// that are related to, but are not direct aliases of, this report.
 	Related []string `yaml:",omitempty"`
 
-	Credits    []string     `yaml:",omitempty"`
+	Credits    []*Credit    `yaml:",omitempty"`
 	References []*Reference `yaml:",omitempty"`
 
+	// PatternClass is the class of programming error that caused the
+	// vulnerability, if known. It is published in the OSV
+	// database_specific field with its CWEs and the static analysis
+	// checks that find it.
+	PatternClass PatternClass `yaml:"pattern_class,omitempty"`
+
+	// Conditions are preconditions for exploiting the vulnerability,
+	// such as a specific configuration. They are rendered into the
+	// description and published in the OSV database_specific field,
+	// so that users who do not meet them can suppress findings.
+	Conditions []*Condition `yaml:",omitempty"`
+
+	// Mitigations are GODEBUG settings that disable or mitigate the
+	// vulnerability, with their defaults by Go version. They are
+	// rendered into the description and published in the OSV
+	// database_specific field.
+	Mitigations []*Mitigation `yaml:",omitempty"`
+
+	// EPSS is the EPSS score of the report's CVEs, as of the last run
+	// of vulnreport enrich. It is published in the OSV
+	// database_specific field.
+	EPSS *EPSS `yaml:"epss,omitempty"`
+
 	// CVEMetadata is used to capture CVE information when we want to assign a
 	// CVE ourselves. If a CVE already exists for an issue, use the CVE field
 	// to fill in the ID string.
@@ -379,6 +501,16 @@ type Report struct {
 	// (For unexcluded reports) The reason this report was previously
 	// excluded. Not published to OSV.
 	Unexcluded ExcludedType `yaml:"unexcluded,omitempty"`
+
+	// The version of the report schema the report was last migrated to
+	// (see CurrentSchemaVersion). Reports are upgraded to the current
+	// schema when read, and rewritten with it by vulnreport migrate.
+	SchemaVersion int `yaml:"schema_version,omitempty"`
+
+	// Comments is the YAML document the report was read from, if it
+	// has comments, so that Encode can keep them. It is not part of
+	// the report's content.
+	Comments *yaml.Node `yaml:"-"`
 }
 
 type ReviewStatus int
@@ -455,9 +587,63 @@ type SourceMeta struct {
 	// The ID (GHSA or CVE) of the original source of this report.
 	// If created by a human, this is "go-security-team".
 	ID string `yaml:",omitempty"`
+	// The kind of the original source of this report. If empty, it is
+	// implied by ID (see Report.Origin).
+	Origin SourceOrigin `yaml:",omitempty"`
 	// The time the auto-generated report was created (or re-generated
 	// from source).
 	Created *time.Time `yaml:",omitempty"`
+	// The GitHub user who created the report, if known.
+	CreatedBy string `yaml:"created_by,omitempty"`
+}
+
+// A SourceOrigin is the kind of the original source of a report,
+// which decides how the report's CVEs and GHSAs must relate to it.
+type SourceOrigin string
+
+const (
+	// The report was written by the Go security team, which, as the
+	// Go CNA, may assign it a CVE (in cve_metadata).
+	OriginGoCNA SourceOrigin = "GO_CNA"
+	// The report was converted from a CVE assigned by another CNA.
+	OriginCVE SourceOrigin = "CVE"
+	// The report was converted from a GHSA. The Go CNA is not
+	// involved, and the vulnerability may have no CVE at all.
+	OriginGHSA SourceOrigin = "GHSA"
+)
+
+var sourceOrigins = []SourceOrigin{OriginGoCNA, OriginCVE, OriginGHSA}
+
+// impliedOrigin returns the origin implied by the report's source ID,
+// or "" if there is none. A CVE that the Go CNA assigned to the report
+// (in cve_metadata) implies OriginGoCNA.
+func (r *Report) impliedOrigin() SourceOrigin {
+	id := r.SourceMeta.ID
+	switch {
+	case id == sourceGoTeam:
+		return OriginGoCNA
+	case r.CVEMetadata != nil && r.CVEMetadata.ID == id:
+		return OriginGoCNA
+	case idstr.IsCVE(id):
+		return OriginCVE
+	case idstr.IsGHSA(id):
+		return OriginGHSA
+	}
+	return ""
+}
+
+// Origin returns the origin of the report's source: source.origin if
+// it is set, or else the origin implied by source.id (see
+// impliedOrigin). It returns "" if the report has no source, or its
+// source is unknown.
+func (r *Report) Origin() SourceOrigin {
+	if r.SourceMeta == nil {
+		return ""
+	}
+	if r.SourceMeta.Origin != "" {
+		return r.SourceMeta.Origin
+	}
+	return r.impliedOrigin()
 }
 
 type Summary string
@@ -584,18 +770,6 @@ func Read(filename string) (_ *Report, err error) {
 	return decodeStrict(f)
 }
 
-func decodeStrict(f io.Reader) (*Report, error) {
-	d := yaml.NewDecoder(f)
-	// Require that all fields in the file are in the struct.
-	// This corresponds to v2's UnmarshalStrict.
-	d.KnownFields(true)
-	var r Report
-	if err := d.Decode(&r); err != nil {
-		return nil, fmt.Errorf("yaml.Decode: %v", err)
-	}
-	return &r, nil
-}
-
 func ReadStrict(fsys fs.FS, filename string) (*Report, error) {
 	r, err := readFS(fsys, filename)
 	if err != nil {
@@ -627,6 +801,12 @@ func (r *Report) CVEFilename() string {
 	return filepath.Join(cve5Dir, r.ID+".json")
 }
 
+// CVE4Filename returns the filename of the legacy CVE JSON 4.0 record
+// for the report, which exists only for some older reports.
+func (r *Report) CVE4Filename() string {
+	return filepath.Join(CVE4Dir, r.ID+".json")
+}
+
 func (r *Report) folder() string {
 	if r.IsExcluded() {
 		return excludedFolder
@@ -659,11 +839,20 @@ func (r *Report) ToString() (string, error) {
 	return b.String(), nil
 }
 
+// Encode writes r to w in YAML format, keeping the comments
+// of the document r was read from (see Comments).
 func (r *Report) Encode(w io.Writer) error {
 	e := yaml.NewEncoder(w)
 	defer e.Close()
 	e.SetIndent(4)
-	return e.Encode(r)
+	if r.Comments == nil {
+		return e.Encode(r)
+	}
+	var n yaml.Node
+	if err := n.Encode(r); err != nil {
+		return err
+	}
+	return e.Encode(withComments(&n, r.Comments))
 }
 
 func Vendor(modulePath string) string {
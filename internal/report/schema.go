@@ -0,0 +1,172 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package report
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+
+	"gopkg.in/yaml.v3"
+)
+
+// CurrentSchemaVersion is the version of the report YAML schema
+// described by the Report type. It is the number of migrations.
+//
+// Reports with no schema_version are at version 0.
+const CurrentSchemaVersion = 1
+
+// A migration upgrades the YAML of a report from one schema version
+// to the next, by editing the mapping node of the report in place.
+//
+// Reports are migrated whenever they are read, but their schema_version
+// is only updated when they are rewritten by vulnreport migrate, so a
+// report may be migrated more than once. Migrations must therefore be
+// no-ops on reports that already have the new shape.
+type migration struct {
+	// A description of the change, for logging.
+	desc    string
+	migrate func(report *yaml.Node) error
+}
+
+// migrations is the registry of migrations: migrations[i] upgrades
+// reports from schema version i to i+1.
+//
+// To make a breaking change to the schema, add a migration to the end
+// of this list that converts the old shape to the new one.
+var migrations = []migration{
+	{
+		desc:    "convert the legacy credit string to a credits list",
+		migrate: migrateCredit,
+	},
+}
+
+func init() {
+	if len(migrations) != CurrentSchemaVersion {
+		panic(fmt.Sprintf("report: %d migrations for schema version %d", len(migrations), CurrentSchemaVersion))
+	}
+}
+
+// migrate upgrades the YAML document b to the current schema version.
+// It returns the upgraded document, and the schema version of b.
+func migrate(b []byte) (_ []byte, from int, err error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(b, &doc); err != nil {
+		return nil, 0, fmt.Errorf("yaml.Unmarshal: %v", err)
+	}
+	if len(doc.Content) == 0 {
+		return b, 0, nil
+	}
+	root := doc.Content[0]
+	if root.Kind != yaml.MappingNode {
+		return b, 0, nil
+	}
+	if _, v := mappingValue(root, "schema_version"); v != nil {
+		from, err = strconv.Atoi(v.Value)
+		if err != nil {
+			return nil, 0, fmt.Errorf("invalid schema_version %q", v.Value)
+		}
+	}
+	switch {
+	case from > CurrentSchemaVersion:
+		return nil, 0, fmt.Errorf("schema_version %d is newer than the latest supported version (%d); update vulnreport", from, CurrentSchemaVersion)
+	case from < 0:
+		return nil, 0, fmt.Errorf("invalid schema_version %d", from)
+	case from == CurrentSchemaVersion:
+		return b, from, nil
+	}
+	for i := from; i < CurrentSchemaVersion; i++ {
+		if err := migrations[i].migrate(root); err != nil {
+			return nil, 0, fmt.Errorf("migrating to schema version %d (%s): %w", i+1, migrations[i].desc, err)
+		}
+	}
+	var buf bytes.Buffer
+	e := yaml.NewEncoder(&buf)
+	e.SetIndent(4)
+	if err := e.Encode(&doc); err != nil {
+		return nil, 0, err
+	}
+	if err := e.Close(); err != nil {
+		return nil, 0, err
+	}
+	return buf.Bytes(), from, nil
+}
+
+// Unmarshal parses a report in YAML format, upgrading it to the
+// current schema version. Unlike Read, it ignores unknown fields.
+func Unmarshal(b []byte) (*Report, error) {
+	b, _, err := migrate(b)
+	if err != nil {
+		return nil, err
+	}
+	var r Report
+	if err := yaml.Unmarshal(b, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+func decodeStrict(f io.Reader) (*Report, error) {
+	b, err := io.ReadAll(f)
+	if err != nil {
+		return nil, err
+	}
+	b, _, err = migrate(b)
+	if err != nil {
+		return nil, err
+	}
+	d := yaml.NewDecoder(bytes.NewReader(b))
+	// Require that all fields in the file are in the struct.
+	// This corresponds to v2's UnmarshalStrict.
+	d.KnownFields(true)
+	var r Report
+	if err := d.Decode(&r); err != nil {
+		return nil, fmt.Errorf("yaml.Decode: %v", err)
+	}
+	// Keep the comments (if any) so that they survive rewrites.
+	if bytes.Contains(b, []byte("#")) {
+		var doc yaml.Node
+		if err := yaml.Unmarshal(b, &doc); err != nil {
+			return nil, fmt.Errorf("yaml.Unmarshal: %v", err)
+		}
+		r.Comments = commentsOf(&doc)
+	}
+	return &r, nil
+}
+
+// mappingValue returns the index of the value of key in the mapping
+// node m, and the value, or -1 and nil if m has no such key.
+func mappingValue(m *yaml.Node, key string) (int, *yaml.Node) {
+	for i := 0; i+1 < len(m.Content); i += 2 {
+		if m.Content[i].Value == key {
+			return i + 1, m.Content[i+1]
+		}
+	}
+	return -1, nil
+}
+
+// migrateCredit converts the legacy "credit" field, a single string,
+// to the "credits" list.
+func migrateCredit(r *yaml.Node) error {
+	i, credit := mappingValue(r, "credit")
+	if credit == nil {
+		return nil
+	}
+	if credit.Kind != yaml.ScalarNode {
+		return fmt.Errorf("credit is not a string")
+	}
+	if _, credits := mappingValue(r, "credits"); credits != nil {
+		return fmt.Errorf("report has both credit and credits")
+	}
+	key := r.Content[i-1]
+	key.Value = "credits"
+	r.Content[i] = &yaml.Node{
+		Kind:    yaml.SequenceNode,
+		Tag:     "!!seq",
+		Content: []*yaml.Node{credit},
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package report
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestUnmarshalMigrates(t *testing.T) {
+	for _, test := range []struct {
+		name string
+		in   string
+		want *Report
+	}{
+		{
+			name: "legacy credit",
+			in:   "id: GO-9999-0001\ncredit: Jane Doe\n",
+			want: &Report{ID: "GO-9999-0001", Credits: []*Credit{{Name: "Jane Doe"}}},
+		},
+		{
+			name: "current",
+			in:   "id: GO-9999-0001\ncredits:\n    - Jane Doe\nschema_version: 1\n",
+			want: &Report{ID: "GO-9999-0001", Credits: []*Credit{{Name: "Jane Doe"}}, SchemaVersion: 1},
+		},
+		{
+			name: "no-op",
+			in:   "id: GO-9999-0001\ncredits:\n    - Jane Doe\n",
+			want: &Report{ID: "GO-9999-0001", Credits: []*Credit{{Name: "Jane Doe"}}},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := Unmarshal([]byte(test.in))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUnmarshalMigrateError(t *testing.T) {
+	for _, in := range []string{
+		fmt.Sprintf("id: GO-9999-0001\nschema_version: %d\n", CurrentSchemaVersion+1),
+		"id: GO-9999-0001\nschema_version: one\n",
+		"id: GO-9999-0001\ncredit: Jane Doe\ncredits:\n    - John Doe\n",
+	} {
+		if _, err := Unmarshal([]byte(in)); err == nil {
+			t.Errorf("Unmarshal(%q): got no error, want error", in)
+		}
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package report
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"unicode"
+
+	"golang.org/x/exp/maps"
+)
+
+// Weights of the fields of a report in search results.
+// A match in an identifying field (alias or module) or the
+// summary counts for more than a match in the description.
+const (
+	weightDescription = 1
+	weightSummary     = 3
+	weightModule      = 4
+	weightAlias       = 5
+)
+
+// Search returns the reports (regular and excluded) that contain
+// all the words in the query, ranked from best to worst match.
+//
+// The summary, description, module paths and aliases of each report are
+// searched. Matching is case-insensitive and on whole words, where a word is a
+// run of letters and digits. For example, "golang.org/x/net" is the four words
+// "golang", "org", "x" and "net", so the query "x/net HTTP/2" matches a report
+// for module golang.org/x/net whose summary mentions HTTP/2.
+func (c *Client) Search(query string) []*Report {
+	words := tokenize(query)
+	if len(words) == 0 {
+		return nil
+	}
+	// scores maps the filenames of the reports that
+	// match all words seen so far to their scores.
+	scores := make(map[string]int)
+	for i, w := range words {
+		next := make(map[string]int)
+		for fname, weight := range c.index[w] {
+			if _, ok := scores[fname]; i == 0 || ok {
+				next[fname] = scores[fname] + weight
+			}
+		}
+		scores = next
+	}
+	fnames := maps.Keys(scores)
+	slices.SortFunc(fnames, func(a, b string) int {
+		if n := cmp.Compare(scores[b], scores[a]); n != 0 {
+			return n
+		}
+		return strings.Compare(c.byFile[a].ID, c.byFile[b].ID)
+	})
+	var rs []*Report
+	for _, fname := range fnames {
+		rs = append(rs, c.byFile[fname])
+	}
+	return rs
+}
+
+// addToIndex adds the searchable fields of r to the index.
+func (c *Client) addToIndex(filename string, r *Report) {
+	add := func(s string, weight int) {
+		for _, w := range tokenize(s) {
+			if c.index[w] == nil {
+				c.index[w] = make(map[string]int)
+			}
+			c.index[w][filename] += weight
+		}
+	}
+	add(string(r.Summary), weightSummary)
+	add(string(r.Description), weightDescription)
+	if r.CVEMetadata != nil {
+		add(r.CVEMetadata.Description, weightDescription)
+	}
+	for _, m := range r.Modules {
+		add(m.Module, weightModule)
+	}
+	for _, a := range r.Aliases() {
+		add(a, weightAlias)
+	}
+	add(r.ID, weightAlias)
+}
+
+// tokenize splits s into lower-case words, dropping common
+// English words that would match almost every report.
+func tokenize(s string) []string {
+	words := strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	return slices.DeleteFunc(words, func(w string) bool {
+		return stopWords[w]
+	})
+}
+
+var stopWords = map[string]bool{
+	"a": true, "an": true, "and": true, "are": true, "as": true,
+	"be": true, "by": true, "can": true, "for": true, "from": true,
+	"in": true, "is": true, "it": true, "of": true, "on": true,
+	"or": true, "that": true, "the": true, "this": true, "to": true,
+	"when": true, "which": true, "with": true,
+}
@@ -0,0 +1,56 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package report
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestSearch(t *testing.T) {
+	ra := &Report{
+		ID:          "GO-9999-0010",
+		Modules:     []*Module{{Module: "golang.org/x/net"}},
+		Summary:     "Excessive memory growth in HTTP/2 server in golang.org/x/net",
+		Description: "An attacker can cause excessive memory growth.",
+		CVEs:        []string{"CVE-9999-1234"},
+	}
+	rb := &Report{
+		ID:          "GO-9999-0011",
+		Modules:     []*Module{{Module: "example.com/mod"}},
+		Summary:     "Denial of service in example.com/mod",
+		Description: "Parsing HTTP/2 frames with a malicious payload causes a panic.",
+		GHSAs:       []string{"GHSA-9999-abcd-efgh"},
+	}
+	rc, err := NewTestClient(map[string]*Report{
+		"data/reports/GO-9999-0010.yaml":  ra,
+		"data/excluded/GO-9999-0011.yaml": rb,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range []struct {
+		query string
+		want  []*Report
+	}{
+		{query: "x/net", want: []*Report{ra}},
+		{query: "HTTP2", want: nil},
+		// Summary matches are ranked above description matches.
+		{query: "http/2", want: []*Report{ra, rb}},
+		{query: "HTTP/2 panic", want: []*Report{rb}},
+		{query: "cve-9999-1234", want: []*Report{ra}},
+		{query: "GHSA-9999-ABCD-EFGH", want: []*Report{rb}},
+		{query: "GO-9999-0011", want: []*Report{rb}},
+		{query: "denial of the service", want: []*Report{rb}},
+		{query: "the", want: nil},
+		{query: "", want: nil},
+	} {
+		if diff := cmp.Diff(tc.want, rc.Search(tc.query)); diff != "" {
+			t.Errorf("Search(%q) mismatch (-want, +got):\n%s", tc.query, diff)
+		}
+	}
+}
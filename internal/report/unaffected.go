@@ -0,0 +1,69 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package report
+
+import (
+	"golang.org/x/vulndb/internal/osv"
+	"golang.org/x/vulndb/internal/osvutils"
+	"golang.org/x/vulndb/internal/version/interval"
+)
+
+// UnaffectedSet returns the versions of m that are known not to be
+// affected, according to its unaffected_versions.
+func (m *Module) UnaffectedSet() (interval.Set, error) {
+	ranges, err := m.UnaffectedVersions.ToRangesWithType(osv.RangeTypeSemver)
+	if err != nil {
+		return nil, err
+	}
+	return interval.FromOSV(ranges)
+}
+
+func (m *Module) lintUnaffectedVersions(l *linter) {
+	if len(m.UnaffectedVersions) == 0 {
+		return
+	}
+	ul := l.Group("unaffected_versions")
+	ranges, err := m.UnaffectedVersions.ToRangesWithType(osv.RangeTypeSemver)
+	if err != nil {
+		ul.Errorf("invalid version(s): %s", err)
+		return
+	}
+	if err := osvutils.ValidateRanges(ranges); err != nil {
+		ul.Error(err)
+		return
+	}
+	unaffected, err := interval.FromOSV(ranges)
+	if err != nil {
+		ul.Error(err)
+		return
+	}
+	affected, err := moduleAffected(m)
+	if err != nil {
+		// Reported by lintVersions.
+		return
+	}
+	if both := affected.Intersect(unaffected); !both.IsEmpty() {
+		ul.Errorf("overlap with versions: %s", both)
+	}
+	if v := m.VulnerableAt; v != nil && unaffected.Contains(v.Version) {
+		ul.Errorf("contain vulnerable_at version %s", v.Version)
+	}
+}
+
+func (r *Report) osvUnaffected() []osv.Unaffected {
+	var us []osv.Unaffected
+	for _, m := range r.Modules {
+		ranges, err := m.UnaffectedVersions.ToRangesWithType(osv.RangeTypeSemver)
+		if err != nil || len(ranges) == 0 {
+			// Invalid versions are reported by lint.
+			continue
+		}
+		us = append(us, osv.Unaffected{
+			Module: osvModulePath(m.Module),
+			Ranges: ranges,
+		})
+	}
+	return us
+}
@@ -0,0 +1,92 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package report
+
+import (
+	"fmt"
+
+	"golang.org/x/vulndb/internal/derrors"
+	"golang.org/x/vulndb/internal/openvex"
+)
+
+// vexDecision is the OpenVEX equivalent of an excluded reason.
+type vexDecision struct {
+	justification openvex.Justification
+	impact        string
+}
+
+// vexDecisions maps the excluded reasons that are "not affected"
+// judgments to their OpenVEX justifications.
+//
+// WITHDRAWN is missing because it records that the source advisory
+// was withdrawn, not a judgment about the affected modules.
+var vexDecisions = map[ExcludedType]vexDecision{
+	ExcludedNotImportable: {
+		openvex.VulnerableCodeNotInExecutePath,
+		"The vulnerable code is in a package that cannot be imported by other modules.",
+	},
+	ExcludedEffectivelyPrivate: {
+		openvex.VulnerableCodeNotInExecutePath,
+		"The vulnerable code is in a package that is not intended to be imported by other modules.",
+	},
+	ExcludedNotGoCode: {
+		openvex.ComponentNotPresent,
+		"The vulnerability does not affect Go code.",
+	},
+	ExcludedNotAVulnerability: {
+		openvex.VulnerableCodeNotPresent,
+		"The reported issue is not a vulnerability.",
+	},
+	ExcludedDependentVulnerabilty: {
+		openvex.VulnerableCodeNotPresent,
+		"The vulnerable code is in a dependency of the module, which is covered by another report.",
+	},
+	ExcludedLegacyFalsePositive: {
+		openvex.VulnerableCodeNotPresent,
+		"The report was triaged as a false positive.",
+	},
+}
+
+// HasVEX returns whether the report is excluded for a reason that
+// can be expressed as an OpenVEX "not affected" statement.
+func (r *Report) HasVEX() bool {
+	_, ok := vexDecisions[r.Excluded]
+	return ok
+}
+
+// ToOpenVEX converts an excluded report to an OpenVEX statement
+// that its modules are not affected by the vulnerability, with the
+// justification for the excluded reason.
+//
+// The vulnerability is identified by the report ID, with the CVEs
+// and GHSAs of the report as aliases, and each module by its package URL.
+func (r *Report) ToOpenVEX() (_ *openvex.Statement, err error) {
+	defer derrors.Wrap(&err, "ToOpenVEX(%q)", r.ID)
+
+	if !r.IsExcluded() {
+		return nil, fmt.Errorf("report is not excluded")
+	}
+	d, ok := vexDecisions[r.Excluded]
+	if !ok {
+		return nil, fmt.Errorf("excluded reason %s has no OpenVEX justification", r.Excluded)
+	}
+	if len(r.Modules) == 0 {
+		return nil, fmt.Errorf("report has no modules")
+	}
+
+	s := &openvex.Statement{
+		Vulnerability: openvex.Vulnerability{
+			Name:    r.ID,
+			Aliases: r.Aliases(),
+		},
+		Status:          openvex.StatusNotAffected,
+		Justification:   d.justification,
+		ImpactStatement: d.impact,
+	}
+	for _, m := range r.Modules {
+		s.Products = append(s.Products, &openvex.Product{ID: "pkg:golang/" + m.Module})
+	}
+	return s, nil
+}
@@ -0,0 +1,115 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package report
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/vulndb/internal/openvex"
+)
+
+func TestToOpenVEX(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		r    *Report
+		want *openvex.Statement
+	}{
+		{
+			name: "not_importable",
+			r: &Report{
+				ID:       "GO-9999-0001",
+				Excluded: ExcludedNotImportable,
+				Modules: []*Module{
+					{Module: "example.com/a"},
+					{Module: "example.com/b"},
+				},
+				CVEs:  []string{"CVE-9999-0001"},
+				GHSAs: []string{"GHSA-xxxx-yyyy-zzzz"},
+			},
+			want: &openvex.Statement{
+				Vulnerability: openvex.Vulnerability{
+					Name:    "GO-9999-0001",
+					Aliases: []string{"CVE-9999-0001", "GHSA-xxxx-yyyy-zzzz"},
+				},
+				Products: []*openvex.Product{
+					{ID: "pkg:golang/example.com/a"},
+					{ID: "pkg:golang/example.com/b"},
+				},
+				Status:          openvex.StatusNotAffected,
+				Justification:   openvex.VulnerableCodeNotInExecutePath,
+				ImpactStatement: "The vulnerable code is in a package that cannot be imported by other modules.",
+			},
+		},
+		{
+			name: "not_go_code",
+			r: &Report{
+				ID:       "GO-9999-0002",
+				Excluded: ExcludedNotGoCode,
+				Modules:  []*Module{{Module: "example.com/c"}},
+				CVEs:     []string{"CVE-9999-0002"},
+			},
+			want: &openvex.Statement{
+				Vulnerability: openvex.Vulnerability{
+					Name:    "GO-9999-0002",
+					Aliases: []string{"CVE-9999-0002"},
+				},
+				Products:        []*openvex.Product{{ID: "pkg:golang/example.com/c"}},
+				Status:          openvex.StatusNotAffected,
+				Justification:   openvex.ComponentNotPresent,
+				ImpactStatement: "The vulnerability does not affect Go code.",
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if !tc.r.HasVEX() {
+				t.Errorf("HasVEX() = false, want true")
+			}
+			got, err := tc.r.ToOpenVEX()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("ToOpenVEX() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestToOpenVEXError(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		r    *Report
+	}{
+		{
+			name: "not_excluded",
+			r: &Report{
+				ID:      "GO-9999-0001",
+				Modules: []*Module{{Module: "example.com/a"}},
+			},
+		},
+		{
+			name: "withdrawn",
+			r: &Report{
+				ID:       "GO-9999-0001",
+				Excluded: ExcludedWithdrawn,
+				Modules:  []*Module{{Module: "example.com/a"}},
+			},
+		},
+		{
+			name: "no_modules",
+			r: &Report{
+				ID:       "GO-9999-0001",
+				Excluded: ExcludedNotAVulnerability,
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := tc.r.ToOpenVEX(); err == nil {
+				t.Error("ToOpenVEX() = nil error, want error")
+			}
+		})
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package secrets
+
+import (
+	"context"
+	"fmt"
+
+	secretmanager "cloud.google.com/go/secretmanager/apiv1"
+	smpb "cloud.google.com/go/secretmanager/apiv1/secretmanagerpb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SecretManager is a Provider that reads the latest version of
+// each secret from GCP Secret Manager.
+type SecretManager struct {
+	project string
+	client  *secretmanager.Client
+}
+
+// NewSecretManager returns a SecretManager for the secrets
+// in the given GCP project.
+func NewSecretManager(ctx context.Context, project string) (*SecretManager, error) {
+	client, err := secretmanager.NewClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &SecretManager{project: project, client: client}, nil
+}
+
+// Get returns the latest version of the secret
+// projects/PROJECT/secrets/NAME.
+func (s *SecretManager) Get(ctx context.Context, name string) (string, error) {
+	fullName := fmt.Sprintf("projects/%s/secrets/%s", s.project, name)
+	result, err := s.client.AccessSecretVersion(ctx, &smpb.AccessSecretVersionRequest{
+		Name: fullName + "/versions/latest",
+	})
+	if status.Code(err) == codes.NotFound {
+		return "", fmt.Errorf("%s: %s does not exist: %w", name, fullName, ErrNotFound)
+	}
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", name, err)
+	}
+	if len(result.Payload.Data) == 0 {
+		return "", fmt.Errorf("%s: %s is empty: %w", name, fullName, ErrNotFound)
+	}
+	return string(result.Payload.Data), nil
+}
+
+// Close closes the connection to Secret Manager.
+func (s *SecretManager) Close() error {
+	return s.client.Close()
+}
@@ -0,0 +1,263 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package secrets provides access to the tokens and keys that
+// commands use to talk to external services.
+//
+// Secrets are looked up by name (for example, GitHubToken) from a
+// Provider. Providers can read secrets from environment variables,
+// from files in a directory, or from GCP Secret Manager.
+package secrets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/vulndb/internal/derrors"
+)
+
+// The names of the secrets used by the commands in this repo.
+const (
+	// GitHubToken is a GitHub access token, used for the
+	// issue tracker and the GHSA API.
+	GitHubToken = "github-token"
+	// GitLabToken is a GitLab access token, used for the issue
+	// tracker of a vulndb fork hosted on GitLab.
+	GitLabToken = "gitlab-token"
+	// CVEAPIKey and CVEAPIUser are the credentials for
+	// the production CVE Services API.
+	CVEAPIKey  = "cve-api-key"
+	CVEAPIUser = "cve-api-user"
+	// TestCVEAPIKey and TestCVEAPIUser are the credentials for
+	// the test CVE Services API.
+	TestCVEAPIKey  = "test-cve-api-key"
+	TestCVEAPIUser = "test-cve-api-user"
+	// GeminiAPIKey is the key for the Gemini API.
+	GeminiAPIKey = "gemini-api-key"
+	// NVDAPIKey is the key for the NVD API.
+	NVDAPIKey = "nvd-api-key"
+	// WorkerAPIToken is the token that clients of the
+	// worker's JSON API must present.
+	WorkerAPIToken = "worker-api-token"
+)
+
+// envVars maps secret names to the environment variables
+// that have historically held them.
+var envVars = map[string]string{
+	GitHubToken:    "VULN_GITHUB_ACCESS_TOKEN",
+	GitLabToken:    "VULN_GITLAB_ACCESS_TOKEN",
+	CVEAPIKey:      "CVE_API_KEY",
+	CVEAPIUser:     "CVE_API_USER",
+	TestCVEAPIKey:  "TEST_CVE_API_KEY",
+	TestCVEAPIUser: "TEST_CVE_API_USER",
+	GeminiAPIKey:   "GEMINI_API_KEY",
+	NVDAPIKey:      "VULN_NVD_API_KEY",
+	WorkerAPIToken: "VULN_WORKER_API_TOKEN",
+}
+
+// EnvVar returns the environment variable that holds the named secret
+// for the Env provider. Names without a historical variable map to
+// the upper-cased name with dashes replaced by underscores
+// ("my-key" becomes "MY_KEY").
+func EnvVar(name string) string {
+	if v, ok := envVars[name]; ok {
+		return v
+	}
+	return strings.ToUpper(strings.ReplaceAll(name, "-", "_"))
+}
+
+// ErrNotFound is returned (wrapped) by a Provider that
+// does not have a requested secret.
+var ErrNotFound = errors.New("secret not found")
+
+// A Provider looks up secrets by name.
+type Provider interface {
+	// Get returns the value of the named secret.
+	// If the secret does not exist or is empty, the error wraps ErrNotFound.
+	Get(ctx context.Context, name string) (string, error)
+}
+
+// Env is a Provider that reads secrets from environment
+// variables, as named by EnvVar.
+var Env Provider = envProvider{}
+
+type envProvider struct{}
+
+func (envProvider) Get(_ context.Context, name string) (string, error) {
+	v := os.Getenv(EnvVar(name))
+	if v == "" {
+		return "", fmt.Errorf("%s: env var %s not set: %w", name, EnvVar(name), ErrNotFound)
+	}
+	return v, nil
+}
+
+// Dir returns a Provider that reads each secret from the file
+// with the secret's name in dir. Leading and trailing
+// whitespace is removed from the file contents.
+func Dir(dir string) Provider {
+	return dirProvider(dir)
+}
+
+type dirProvider string
+
+func (d dirProvider) Get(_ context.Context, name string) (string, error) {
+	if name == "" || strings.ContainsAny(name, `/\`) || name == "." || name == ".." {
+		return "", fmt.Errorf("invalid secret name %q", name)
+	}
+	filename := filepath.Join(string(d), name)
+	data, err := os.ReadFile(filename)
+	if errors.Is(err, os.ErrNotExist) {
+		return "", fmt.Errorf("%s: %s does not exist: %w", name, filename, ErrNotFound)
+	}
+	if err != nil {
+		return "", err
+	}
+	v := strings.TrimSpace(string(data))
+	if v == "" {
+		return "", fmt.Errorf("%s: %s is empty: %w", name, filename, ErrNotFound)
+	}
+	return v, nil
+}
+
+// Chain returns a Provider that tries each of ps in order,
+// returning the first secret found.
+func Chain(ps ...Provider) Provider {
+	return chain(ps)
+}
+
+type chain []Provider
+
+func (c chain) Get(ctx context.Context, name string) (string, error) {
+	var errs []error
+	for _, p := range c {
+		v, err := p.Get(ctx, name)
+		if err == nil {
+			return v, nil
+		}
+		if !errors.Is(err, ErrNotFound) {
+			return "", err
+		}
+		errs = append(errs, err)
+	}
+	if len(errs) == 0 {
+		return "", fmt.Errorf("%s: no secret providers: %w", name, ErrNotFound)
+	}
+	return "", errors.Join(errs...)
+}
+
+// Static returns a Provider that serves the given secrets, keyed by
+// name. It is useful for secrets given as flags, and in tests.
+// Empty values are treated as missing.
+func Static(m map[string]string) Provider {
+	return static(m)
+}
+
+type static map[string]string
+
+func (s static) Get(_ context.Context, name string) (string, error) {
+	if v := s[name]; v != "" {
+		return v, nil
+	}
+	return "", fmt.Errorf("%s: not set: %w", name, ErrNotFound)
+}
+
+// FromSpec returns the Provider described by spec, which is one of:
+//
+//   - "" or "env": read secrets from environment variables (see EnvVar)
+//   - "file:DIR": read each secret from the file DIR/NAME
+//   - "gcp:PROJECT": read secrets from GCP Secret Manager in PROJECT
+//
+// The returned cleanup function releases any resources held by the
+// provider and must be called when it is no longer needed.
+func FromSpec(ctx context.Context, spec string) (_ Provider, cleanup func() error, err error) {
+	defer derrors.Wrap(&err, "secrets.FromSpec(%q)", spec)
+
+	nop := func() error { return nil }
+	kind, arg, _ := strings.Cut(spec, ":")
+	switch kind {
+	case "", "env":
+		if arg != "" {
+			break
+		}
+		return Env, nop, nil
+	case "file":
+		if arg == "" {
+			break
+		}
+		return Dir(arg), nop, nil
+	case "gcp":
+		if arg == "" {
+			break
+		}
+		sm, err := NewSecretManager(ctx, arg)
+		if err != nil {
+			return nil, nil, err
+		}
+		return sm, sm.Close, nil
+	}
+	return nil, nil, errors.New(`want "env", "file:DIR" or "gcp:PROJECT"`)
+}
+
+// Require looks up the named secrets from p, returning their values
+// in the same order.
+//
+// If any of the secrets are missing, Require returns a *MissingError
+// naming all of them, so that users can supply them in one go.
+func Require(ctx context.Context, p Provider, names ...string) ([]string, error) {
+	vals := make([]string, len(names))
+	var missing []string
+	for i, name := range names {
+		v, err := p.Get(ctx, name)
+		if errors.Is(err, ErrNotFound) {
+			missing = append(missing, name)
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		vals[i] = v
+	}
+	if len(missing) > 0 {
+		return nil, &MissingError{Names: missing}
+	}
+	return vals, nil
+}
+
+// A MissingError reports secrets that are needed but could
+// not be found.
+type MissingError struct {
+	// Names are the names of the missing secrets.
+	Names []string
+	// Hint, if non-empty, explains other ways to provide the
+	// secrets or how to obtain them.
+	Hint string
+}
+
+func (e *MissingError) Error() string {
+	var b strings.Builder
+	if len(e.Names) == 1 {
+		b.WriteString("missing secret ")
+	} else {
+		b.WriteString("missing secrets ")
+	}
+	for i, name := range e.Names {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		fmt.Fprintf(&b, "%s (env var %s)", name, EnvVar(name))
+	}
+	if e.Hint != "" {
+		b.WriteString("; ")
+		b.WriteString(e.Hint)
+	}
+	return b.String()
+}
+
+func (e *MissingError) Is(target error) bool {
+	return target == ErrNotFound
+}
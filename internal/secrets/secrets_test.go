@@ -0,0 +1,116 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package secrets
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestEnv(t *testing.T) {
+	ctx := context.Background()
+	t.Setenv("VULN_GITHUB_ACCESS_TOKEN", "gh")
+	t.Setenv("MY_KEY", "mine")
+	t.Setenv("CVE_API_KEY", "")
+
+	for _, tc := range []struct {
+		name, want string
+	}{
+		{GitHubToken, "gh"},
+		{"my-key", "mine"},
+	} {
+		got, err := Env.Get(ctx, tc.name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tc.want {
+			t.Errorf("Get(%q) = %q, want %q", tc.name, got, tc.want)
+		}
+	}
+	if _, err := Env.Get(ctx, CVEAPIKey); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Get(%q): got error %v, want ErrNotFound", CVEAPIKey, err)
+	}
+}
+
+func TestDir(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, GitHubToken), []byte("gh\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, CVEAPIKey), []byte("  \n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	p := Dir(dir)
+
+	got, err := p.Get(ctx, GitHubToken)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "gh"; got != want {
+		t.Errorf("Get(%q) = %q, want %q", GitHubToken, got, want)
+	}
+	for _, name := range []string{CVEAPIKey, CVEAPIUser} {
+		if _, err := p.Get(ctx, name); !errors.Is(err, ErrNotFound) {
+			t.Errorf("Get(%q): got error %v, want ErrNotFound", name, err)
+		}
+	}
+	if _, err := p.Get(ctx, "../x"); err == nil || errors.Is(err, ErrNotFound) {
+		t.Errorf("Get(../x): got error %v, want invalid name", err)
+	}
+}
+
+func TestChainAndRequire(t *testing.T) {
+	ctx := context.Background()
+	p := Chain(
+		Static(map[string]string{GitHubToken: "flag"}),
+		Static(map[string]string{GitHubToken: "other", CVEAPIKey: "key"}),
+	)
+
+	got, err := Require(ctx, p, GitHubToken, CVEAPIKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{"flag", "key"}, got); diff != "" {
+		t.Errorf("Require mismatch (-want, +got):\n%s", diff)
+	}
+
+	_, err = Require(ctx, p, CVEAPIKey, CVEAPIUser, GeminiAPIKey)
+	var me *MissingError
+	if !errors.As(err, &me) {
+		t.Fatalf("Require: got error %v, want *MissingError", err)
+	}
+	if diff := cmp.Diff([]string{CVEAPIUser, GeminiAPIKey}, me.Names); diff != "" {
+		t.Errorf("missing names mismatch (-want, +got):\n%s", diff)
+	}
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("errors.Is(%v, ErrNotFound) = false, want true", err)
+	}
+	want := "missing secrets cve-api-user (env var CVE_API_USER), gemini-api-key (env var GEMINI_API_KEY)"
+	if got := err.Error(); got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+}
+
+func TestFromSpec(t *testing.T) {
+	ctx := context.Background()
+	for _, spec := range []string{"", "env", "file:/tmp"} {
+		if _, cleanup, err := FromSpec(ctx, spec); err != nil {
+			t.Errorf("FromSpec(%q): %v", spec, err)
+		} else if err := cleanup(); err != nil {
+			t.Error(err)
+		}
+	}
+	for _, spec := range []string{"env:x", "file:", "gcp:", "vault:x"} {
+		if _, _, err := FromSpec(ctx, spec); err == nil {
+			t.Errorf("FromSpec(%q): got nil error, want error", spec)
+		}
+	}
+}
@@ -0,0 +1,41 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stdlib
+
+import (
+	"regexp"
+	"strings"
+)
+
+// ReleasesModulePath is the module on the module proxy whose versions
+// correspond to Go releases, with versions of the form
+// v0.0.1-go1.22.1.linux-amd64. Its version list is the source of
+// truth for which Go releases exist.
+const ReleasesModulePath = "golang.org/toolchain"
+
+// The suffix of one platform's version of ReleasesModulePath. Each
+// release has a version for every platform, so just one is used.
+const releaseSuffix = ".linux-amd64"
+
+// A stable release (not a beta or release candidate) in the
+// unprefixed, three-component form used by the toolchain module.
+var stableReleaseRegexp = regexp.MustCompile(`^1\.[0-9]+\.[0-9]+$`)
+
+// ReleaseFromVersion returns the Go release, as an unprefixed semantic
+// version (for example "1.22.1"), of a version of ReleasesModulePath.
+// It reports false for versions that are not for linux/amd64, and for
+// betas and release candidates.
+func ReleaseFromVersion(v string) (string, bool) {
+	v = strings.TrimPrefix(v, "v")
+	rel, ok := strings.CutPrefix(v, "0.0.1-go")
+	if !ok {
+		return "", false
+	}
+	rel, ok = strings.CutSuffix(rel, releaseSuffix)
+	if !ok || !stableReleaseRegexp.MatchString(rel) {
+		return "", false
+	}
+	return rel, true
+}
@@ -0,0 +1,138 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stdlib
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+
+	"golang.org/x/mod/semver"
+	"golang.org/x/vulndb/internal/derrors"
+)
+
+// DownloadsURL is the go.dev endpoint listing every Go release,
+// including betas and release candidates, as JSON.
+const DownloadsURL = "https://go.dev/dl/?mode=json&include=all"
+
+// A Schedule is the set of announced stable Go releases.
+type Schedule struct {
+	// Unprefixed semantic versions, in increasing order.
+	releases []string
+}
+
+// NewSchedule returns a Schedule of the given releases, which are
+// unprefixed semantic versions (for example "1.22.1").
+func NewSchedule(releases ...string) *Schedule {
+	s := &Schedule{releases: slices.Clone(releases)}
+	slices.SortFunc(s.releases, func(a, b string) int {
+		return semver.Compare("v"+a, "v"+b)
+	})
+	s.releases = slices.Compact(s.releases)
+	return s
+}
+
+// FetchSchedule returns the Schedule described by the go.dev/dl JSON
+// at source, which is a URL (usually DownloadsURL) or a file path.
+func FetchSchedule(ctx context.Context, source string) (_ *Schedule, err error) {
+	defer derrors.Wrap(&err, "FetchSchedule(%q)", source)
+
+	var data []byte
+	if strings.HasPrefix(source, "http://") || strings.HasPrefix(source, "https://") {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, source, nil)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("HTTP error: %s", resp.Status)
+		}
+		if data, err = io.ReadAll(resp.Body); err != nil {
+			return nil, err
+		}
+	} else {
+		if data, err = os.ReadFile(source); err != nil {
+			return nil, err
+		}
+	}
+	return ParseSchedule(data)
+}
+
+// ParseSchedule parses the JSON served by DownloadsURL.
+// Betas and release candidates are ignored.
+func ParseSchedule(data []byte) (*Schedule, error) {
+	var downloads []struct {
+		Version string `json:"version"`
+		Stable  bool   `json:"stable"`
+	}
+	if err := json.Unmarshal(data, &downloads); err != nil {
+		return nil, fmt.Errorf("parsing Go downloads: %w", err)
+	}
+	var releases []string
+	for _, d := range downloads {
+		if v, ok := semverForStableTag(d.Version); ok {
+			releases = append(releases, v)
+		}
+	}
+	if len(releases) == 0 {
+		return nil, fmt.Errorf("no stable Go releases in downloads")
+	}
+	return NewSchedule(releases...), nil
+}
+
+// A stable Go tag, such as "go1", "go1.20" or "go1.21.0".
+var stableTagRegexp = regexp.MustCompile(`^go(\d+)(?:\.(\d+))?(?:\.(\d+))?$`)
+
+// semverForStableTag returns the unprefixed semantic version
+// of a stable release tag ("1.20.0" for "go1.20").
+func semverForStableTag(tag string) (string, bool) {
+	m := stableTagRegexp.FindStringSubmatch(tag)
+	if m == nil {
+		return "", false
+	}
+	minor, patch := m[2], m[3]
+	if minor == "" {
+		minor = "0"
+	}
+	if patch == "" {
+		patch = "0"
+	}
+	return m[1] + "." + minor + "." + patch, true
+}
+
+// Released reports whether v, an unprefixed semantic version,
+// is an announced Go release.
+func (s *Schedule) Released(v string) bool {
+	_, ok := slices.BinarySearchFunc(s.releases, v, func(a, b string) int {
+		return semver.Compare("v"+a, "v"+b)
+	})
+	return ok
+}
+
+// MinorReleased reports whether any release of the given
+// minor version (for example "1.22") has been announced.
+func (s *Schedule) MinorReleased(minor string) bool {
+	return slices.ContainsFunc(s.releases, func(rel string) bool {
+		return strings.TrimPrefix(semver.MajorMinor("v"+rel), "v") == minor
+	})
+}
+
+// Latest returns the latest announced Go release.
+func (s *Schedule) Latest() string {
+	if len(s.releases) == 0 {
+		return ""
+	}
+	return s.releases[len(s.releases)-1]
+}
@@ -22,3 +22,74 @@ func TestContains(t *testing.T) {
 		}
 	}
 }
+
+func TestReleaseFromVersion(t *testing.T) {
+	for _, test := range []struct {
+		in     string
+		want   string
+		wantOK bool
+	}{
+		{"v0.0.1-go1.22.1.linux-amd64", "1.22.1", true},
+		{"0.0.1-go1.21.0.linux-amd64", "1.21.0", true},
+		{"v0.0.1-go1.22.1.darwin-arm64", "", false},
+		{"v0.0.1-go1.23rc1.linux-amd64", "", false},
+		{"v1.2.3", "", false},
+	} {
+		got, ok := ReleaseFromVersion(test.in)
+		if got != test.want || ok != test.wantOK {
+			t.Errorf("ReleaseFromVersion(%q) = (%q, %t), want (%q, %t)", test.in, got, ok, test.want, test.wantOK)
+		}
+	}
+}
+
+func TestParseSchedule(t *testing.T) {
+	const downloads = `[
+  {"version": "go1.23rc1", "stable": false, "files": []},
+  {"version": "go1.22.1", "stable": true, "files": []},
+  {"version": "go1.22.0", "stable": true, "files": []},
+  {"version": "go1.20", "stable": true, "files": []},
+  {"version": "go1", "stable": true, "files": []}
+]`
+	s, err := ParseSchedule([]byte(downloads))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := s.Latest(), "1.22.1"; got != want {
+		t.Errorf("Latest() = %q, want %q", got, want)
+	}
+	for _, test := range []struct {
+		v    string
+		want bool
+	}{
+		{"1.22.1", true},
+		{"1.20.0", true},
+		{"1.0.0", true},
+		{"1.22.2", false},
+		{"1.23.0", false},
+		{"1.23.0-rc.1", false},
+	} {
+		if got := s.Released(test.v); got != test.want {
+			t.Errorf("Released(%q) = %t, want %t", test.v, got, test.want)
+		}
+	}
+	for _, test := range []struct {
+		minor string
+		want  bool
+	}{
+		{"1.22", true},
+		{"1.21", false},
+		{"1.23", false},
+	} {
+		if got := s.MinorReleased(test.minor); got != test.want {
+			t.Errorf("MinorReleased(%q) = %t, want %t", test.minor, got, test.want)
+		}
+	}
+}
+
+func TestParseScheduleError(t *testing.T) {
+	for _, data := range []string{`{}`, `[{"version": "go1.23rc1"}]`} {
+		if _, err := ParseSchedule([]byte(data)); err == nil {
+			t.Errorf("ParseSchedule(%s) succeeded, want error", data)
+		}
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"strings"
 
 	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
 	"golang.org/x/vulndb/internal/gitrepo"
 	"golang.org/x/vulndb/internal/report"
 )
@@ -29,10 +30,10 @@ type repository struct {
 // Populate attempts to populate the report with symbols derived
 // from the patch link(s) in the report.
 func Populate(r *report.Report, update bool) error {
-	return populate(r, update, gitrepo.PlainClone, Patched)
+	return populate(r, update, cloneFixes, Patched)
 }
 
-func populate(r *report.Report, update bool, clone func(context.Context, string, string) (*git.Repository, error), patched func(string, string, *repository) (map[string][]string, error)) error {
+func populate(r *report.Report, update bool, clone cloneFunc, patched func(string, string, *repository) (map[string][]string, error)) error {
 	commits := r.CommitLinks()
 	reportFixRepos, errs := getFixRepos(commits, clone)
 	for _, mod := range r.Modules {
@@ -113,33 +114,54 @@ func populateFromFixHash(repo *repository, fixHash string, m *report.Module, pat
 }
 
 // getFixRepos takes a list of fix links and returns the repositories and hashes of those fix links.
-func getFixRepos(links []string, clone func(context.Context, string, string) (*git.Repository, error)) (fixRepos map[string]*repository, errs []error) {
+func getFixRepos(links []string, clone cloneFunc) (fixRepos map[string]*repository, errs []error) {
 	fixRepos = make(map[string]*repository)
+	// Group the hashes by repo, so that each repo is
+	// cloned once, with all of its fix commits.
+	var repoURLs []string
 	for _, fixLink := range links {
 		fixHash := filepath.Base(fixLink)
 		repoURL := strings.TrimSuffix(fixLink, "/commit/"+fixHash)
-		if _, found := fixRepos[repoURL]; !found {
-			repoRoot, err := os.MkdirTemp("", fixHash)
-			if err != nil {
-				errs = append(errs, fmt.Errorf("error making temp dir for repo %s: %v", repoURL, err))
-				continue
-			}
-			ctx := context.Background()
-			r, err := clone(ctx, repoRoot, repoURL)
-			if err != nil {
-				errs = append(errs, fmt.Errorf("error cloning repo: %v", err.Error()))
-				continue
-			}
-			fixRepos[repoURL] = &repository{
-				repo:      r,
-				url:       repoURL,
-				root:      repoRoot,
-				fixHashes: []string{fixHash},
-			}
-		} else {
-			r := fixRepos[repoURL]
+		if r, found := fixRepos[repoURL]; found {
 			r.fixHashes = append(r.fixHashes, fixHash)
+			continue
+		}
+		fixRepos[repoURL] = &repository{url: repoURL, fixHashes: []string{fixHash}}
+		repoURLs = append(repoURLs, repoURL)
+	}
+	for _, repoURL := range repoURLs {
+		r := fixRepos[repoURL]
+		repoRoot, err := os.MkdirTemp("", r.fixHashes[0])
+		if err != nil {
+			errs = append(errs, fmt.Errorf("error making temp dir for repo %s: %v", repoURL, err))
+			delete(fixRepos, repoURL)
+			continue
+		}
+		ctx := context.Background()
+		repo, err := clone(ctx, repoRoot, repoURL, r.fixHashes)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("error cloning repo: %v", err.Error()))
+			delete(fixRepos, repoURL)
+			continue
 		}
+		r.repo, r.root = repo, repoRoot
 	}
 	return fixRepos, errs
 }
+
+// A cloneFunc clones the repo at repoURL into dir, with at least
+// the given commits and their parents.
+type cloneFunc func(ctx context.Context, dir, repoURL string, hashes []string) (*git.Repository, error)
+
+// cloneFixes is a cloneFunc that fetches only the fix commits and their
+// parents, which is all Patched needs, unless some hashes are
+// abbreviated, in which case it clones the whole history.
+func cloneFixes(ctx context.Context, dir, repoURL string, hashes []string) (*git.Repository, error) {
+	if !slices.ContainsFunc(hashes, func(h string) bool { return !plumbing.IsHash(h) }) {
+		return gitrepo.PlainCloneShallow(ctx, dir, repoURL, &gitrepo.ShallowOptions{
+			Commits: hashes,
+			Depth:   2,
+		})
+	}
+	return gitrepo.PlainClone(ctx, dir, repoURL)
+}
@@ -176,6 +176,6 @@ func patchedFake(module string, hash string, repo *repository) (map[string][]str
 	return nil, fmt.Errorf("unrecognized inputs: module=%s,repo=%s,hash=%s", module, repo.url, hash)
 }
 
-func mockClone(ctx context.Context, dir, repoURL string) (repo *git.Repository, err error) {
+func mockClone(ctx context.Context, dir, repoURL string, hashes []string) (repo *git.Repository, err error) {
 	return nil, err
 }
@@ -29,7 +29,9 @@ var stdlibReferenceDataKeywords = []string{
 	"golang-nuts",
 }
 
-const unknownPath = "Path is unknown"
+// UnknownPath is the module path reported for vulns that refer to Go
+// but whose affected module could not be determined.
+const UnknownPath = "Path is unknown"
 
 // RefersToGoModule reports whether the vuln refers to a Go module or package in its references.
 func RefersToGoModule(ctx context.Context, v Vuln, pc *pkgsite.Client) (_ *Result, err error) {
@@ -133,7 +135,15 @@ type Vuln interface {
 	ReferenceURLs() []string
 }
 
-func refersToGoModule(ctx context.Context, v Vuln, pc *pkgsite.Client) (result *Result, err error) {
+// A moduleChecker reports whether module paths are known to pkgsite.
+// It is implemented by *pkgsite.Client, and by the recorders and
+// replayers of triage inputs.
+type moduleChecker interface {
+	KnownModule(ctx context.Context, path string) (bool, error)
+	URL() string
+}
+
+func refersToGoModule(ctx context.Context, v Vuln, pc moduleChecker) (result *Result, err error) {
 	defer func() {
 		if err != nil {
 			return
@@ -201,7 +211,7 @@ func refersToGoModule(ctx context.Context, v Vuln, pc *pkgsite.Client) (result *
 		// https://github.com/CVEProject/cvelist/blob/899bba20d62eb73e04d1841a5ff04cd6225e1618/2020/7xxx/CVE-2020-7668.json#L52.
 		if strings.Contains(rurl, snykIdentifier) {
 			return &Result{
-				ModulePath: unknownPath,
+				ModulePath: UnknownPath,
 				Reason:     fmt.Sprintf("Reference data URL %q contains %q", rurl, snykIdentifier),
 			}, nil
 		}
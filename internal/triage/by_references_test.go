@@ -156,7 +156,7 @@ func TestRefersToGoModuleV4CVE(t *testing.T) {
 				},
 			},
 			want: &Result{
-				ModulePath: unknownPath,
+				ModulePath: UnknownPath,
 			},
 		},
 	} {
@@ -325,7 +325,7 @@ func TestRefersToGoModuleV5CVE(t *testing.T) {
 				},
 			},
 			want: &Result{
-				ModulePath: unknownPath,
+				ModulePath: UnknownPath,
 			},
 		},
 	} {
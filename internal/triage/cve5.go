@@ -90,7 +90,7 @@ func (t *CVE5Triager) AffectsGo(ctx context.Context, cve *cve5.CVERecord) (resul
 		// https://github.com/CVEProject/cvelist/blob/899bba20d62eb73e04d1841a5ff04cd6225e1618/2020/7xxx/CVE-2020-7668.json#L52.
 		if strings.Contains(rurl, snykIdentifier) {
 			return &Result{
-				ModulePath: unknownPath,
+				ModulePath: UnknownPath,
 				Reason:     fmt.Sprintf("Reference data URL %q contains %q", rurl, snykIdentifier),
 			}, nil
 		}
@@ -0,0 +1,140 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package owners maps module paths to the areas that own them
+// and their preferred reviewers, so that issues can be routed to
+// subject-matter experts.
+package owners
+
+import (
+	_ "embed"
+	"fmt"
+	"hash/fnv"
+	"os"
+	"strings"
+	"sync"
+
+	"golang.org/x/vulndb/internal/derrors"
+	"gopkg.in/yaml.v3"
+)
+
+// Owners is a mapping of module path prefixes to areas.
+type Owners struct {
+	Areas []*Area `yaml:"areas"`
+}
+
+// An Area is a set of modules with the same preferred reviewers.
+type Area struct {
+	// Name is a short name for the area, such as "crypto".
+	Name string `yaml:"name"`
+	// Prefixes are the module or package path prefixes in the area.
+	// A prefix matches whole path elements.
+	Prefixes []string `yaml:"prefixes"`
+	// Reviewers are the GitHub usernames of the preferred reviewers
+	// of the area, if any.
+	Reviewers []string `yaml:"reviewers,omitempty"`
+}
+
+const labelPrefix = "area: "
+
+// Label returns the issue label for the area.
+func (a *Area) Label() string {
+	return labelPrefix + a.Name
+}
+
+// Reviewer returns one of the reviewers of the area, chosen by key
+// (for example, an issue number or CVE ID), so that the same key
+// always gets the same reviewer and the load is spread among them.
+// It returns "" if the area has no reviewers.
+func (a *Area) Reviewer(key string) string {
+	if len(a.Reviewers) == 0 {
+		return ""
+	}
+	h := fnv.New32a()
+	h.Write([]byte(key))
+	return a.Reviewers[h.Sum32()%uint32(len(a.Reviewers))]
+}
+
+//go:embed data/owners.yaml
+var ownersYAML []byte
+
+// Default returns the checked-in owners (see data/owners.yaml).
+// The result must not be modified.
+var Default = sync.OnceValue(func() *Owners {
+	o, err := Parse(ownersYAML)
+	if err != nil {
+		panic(fmt.Sprintf("owners: invalid data/owners.yaml: %v", err))
+	}
+	return o
+})
+
+// Read reads owners from a YAML file in the format of data/owners.yaml.
+func Read(filename string) (_ *Owners, err error) {
+	defer derrors.Wrap(&err, "owners.Read(%q)", filename)
+
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	return Parse(b)
+}
+
+// Parse parses owners in the format of data/owners.yaml.
+func Parse(b []byte) (*Owners, error) {
+	var o Owners
+	if err := yaml.Unmarshal(b, &o); err != nil {
+		return nil, err
+	}
+	names := make(map[string]bool)
+	for i, a := range o.Areas {
+		if a.Name == "" {
+			return nil, fmt.Errorf("area %d: missing name", i)
+		}
+		if names[a.Name] {
+			return nil, fmt.Errorf("area %s: duplicate name", a.Name)
+		}
+		names[a.Name] = true
+		if len(a.Prefixes) == 0 {
+			return nil, fmt.Errorf("area %s: no prefixes", a.Name)
+		}
+		for _, r := range a.Reviewers {
+			if r == "" || strings.HasPrefix(r, "@") {
+				return nil, fmt.Errorf("area %s: invalid reviewer %q (want a GitHub username without @)", a.Name, r)
+			}
+		}
+	}
+	return &o, nil
+}
+
+// Lookup returns the area of the first of paths, which are module or
+// package paths, that is in an area, or nil if none are.
+// If several areas match a path, the one with the longest prefix wins.
+func (o *Owners) Lookup(paths ...string) *Area {
+	if o == nil {
+		return nil
+	}
+	for _, p := range paths {
+		var (
+			best    *Area
+			bestLen int
+		)
+		for _, a := range o.Areas {
+			for _, prefix := range a.Prefixes {
+				if hasPathPrefix(p, prefix) && len(prefix) > bestLen {
+					best, bestLen = a, len(prefix)
+				}
+			}
+		}
+		if best != nil {
+			return best
+		}
+	}
+	return nil
+}
+
+// hasPathPrefix reports whether p is prefix or a path below it.
+func hasPathPrefix(p, prefix string) bool {
+	rest, ok := strings.CutPrefix(p, prefix)
+	return ok && (rest == "" || rest[0] == '/')
+}
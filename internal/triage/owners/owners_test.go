@@ -0,0 +1,82 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package owners
+
+import (
+	"testing"
+)
+
+func TestDefault(t *testing.T) {
+	// Default panics if the checked-in file is invalid.
+	if o := Default(); len(o.Areas) == 0 {
+		t.Error("Default() has no areas")
+	}
+}
+
+func TestLookup(t *testing.T) {
+	o, err := Parse([]byte(`
+areas:
+  - name: crypto
+    prefixes: [crypto, golang.org/x/crypto]
+    reviewers: [alice, bob]
+  - name: net
+    prefixes: [net, golang.org/x/net]
+  - name: http2
+    prefixes: [golang.org/x/net/http2]
+    reviewers: [carol]
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, test := range []struct {
+		paths []string
+		want  string
+	}{
+		{[]string{"golang.org/x/crypto"}, "crypto"},
+		{[]string{"golang.org/x/crypto/ssh"}, "crypto"},
+		{[]string{"crypto/tls"}, "crypto"},
+		{[]string{"golang.org/x/cryptography"}, ""},
+		{[]string{"golang.org/x/net/html"}, "net"},
+		{[]string{"golang.org/x/net/http2/hpack"}, "http2"},
+		{[]string{"std", "net/http"}, "net"},
+		{[]string{"example.com/m"}, ""},
+		{nil, ""},
+	} {
+		var got string
+		if a := o.Lookup(test.paths...); a != nil {
+			got = a.Name
+		}
+		if got != test.want {
+			t.Errorf("Lookup(%q) = %q, want %q", test.paths, got, test.want)
+		}
+	}
+
+	a := o.Lookup("crypto")
+	if got, want := a.Label(), "area: crypto"; got != want {
+		t.Errorf("Label() = %q, want %q", got, want)
+	}
+	if r := a.Reviewer("CVE-2024-0001"); r != "alice" && r != "bob" {
+		t.Errorf("Reviewer() = %q, want alice or bob", r)
+	}
+	if a.Reviewer("CVE-2024-0001") != a.Reviewer("CVE-2024-0001") {
+		t.Error("Reviewer() is not deterministic")
+	}
+	if r := o.Lookup("net").Reviewer("1"); r != "" {
+		t.Errorf("Reviewer() = %q for area without reviewers, want empty", r)
+	}
+}
+
+func TestParseError(t *testing.T) {
+	for _, in := range []string{
+		`areas: [{prefixes: [crypto]}]`,
+		`areas: [{name: crypto}]`,
+		`areas: [{name: crypto, prefixes: [crypto]}, {name: crypto, prefixes: [net]}]`,
+		`areas: [{name: crypto, prefixes: [crypto], reviewers: ["@alice"]}]`,
+	} {
+		if _, err := Parse([]byte(in)); err == nil {
+			t.Errorf("Parse(%q): got nil error, want error", in)
+		}
+	}
+}
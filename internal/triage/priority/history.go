@@ -0,0 +1,238 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package priority
+
+import (
+	"bytes"
+	"cmp"
+	"compress/gzip"
+	_ "embed"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/exp/maps"
+	"golang.org/x/vulndb/internal/report"
+)
+
+//go:embed data/history.csv.gz
+var history []byte
+
+const (
+	// Modules with fewer importers than this are not recorded
+	// in the history, to keep it small.
+	historyMinImporters = 10
+	// The number of refreshes of the module map kept in the history.
+	historyMaxRefreshes = 8
+	// How far back a trend looks.
+	trendWindow = 365 * 24 * time.Hour
+	// A module is growing rapidly if its importer count at least
+	// doubled within the trend window, by at least this many importers.
+	rapidGrowthMinImporters = 20
+
+	dateFormat = "2006-01-02"
+)
+
+// A Sample is the importer count of a module at a refresh
+// of the module map.
+type Sample struct {
+	Date      time.Time
+	Importers int
+}
+
+// A History holds the importer counts of modules at past refreshes of
+// the module map, oldest first.
+type History map[string][]Sample
+
+// LoadHistory returns the importer count history in the checked-in
+// data/history.csv.gz (see data/README.md).
+func LoadHistory() (History, error) {
+	return ReadHistory(bytes.NewReader(history))
+}
+
+// ReadHistory reads a History from a gzipped CSV file written
+// by WriteHistory.
+func ReadHistory(r io.Reader) (History, error) {
+	gzr, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+	records, err := csv.NewReader(gzr).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	h := make(History)
+	if len(records) == 0 {
+		return h, nil
+	}
+	for _, record := range records[1:] {
+		if len(record) != 3 {
+			return nil, fmt.Errorf("invalid history record %q", record)
+		}
+		date, err := time.Parse(dateFormat, record[0])
+		if err != nil {
+			return nil, err
+		}
+		n, err := strconv.Atoi(record[2])
+		if err != nil {
+			return nil, err
+		}
+		h[record[1]] = append(h[record[1]], Sample{Date: date, Importers: n})
+	}
+	for _, ss := range h {
+		slices.SortFunc(ss, func(a, b Sample) int { return a.Date.Compare(b.Date) })
+	}
+	return h, nil
+}
+
+// WriteHistory writes h to w as a gzipped CSV file with a
+// "date,module_path,imported_by" header, sorted by date and module.
+func WriteHistory(w io.Writer, h History) error {
+	type row struct {
+		mod string
+		s   Sample
+	}
+	var rows []row
+	for mod, ss := range h {
+		for _, s := range ss {
+			rows = append(rows, row{mod, s})
+		}
+	}
+	slices.SortFunc(rows, func(a, b row) int {
+		if c := a.s.Date.Compare(b.s.Date); c != 0 {
+			return c
+		}
+		return strings.Compare(a.mod, b.mod)
+	})
+	gzw := gzip.NewWriter(w)
+	cw := csv.NewWriter(gzw)
+	if err := cw.Write([]string{"date", "module_path", "imported_by"}); err != nil {
+		return err
+	}
+	for _, r := range rows {
+		if err := cw.Write([]string{r.s.Date.Format(dateFormat), r.mod, strconv.Itoa(r.s.Importers)}); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	if err := cw.Error(); err != nil {
+		return err
+	}
+	return gzw.Close()
+}
+
+// Add returns a new History with the importer counts in m recorded at
+// the given date, forgetting the oldest refreshes if there are too many.
+// A refresh already recorded at that date is replaced.
+func (h History) Add(date time.Time, m map[string]int) History {
+	date = date.UTC().Truncate(24 * time.Hour)
+	dates := map[time.Time]bool{date: true}
+	for _, ss := range h {
+		for _, s := range ss {
+			dates[s.Date] = true
+		}
+	}
+	keep := maps.Keys(dates)
+	slices.SortFunc(keep, func(a, b time.Time) int { return b.Compare(a) })
+	if len(keep) > historyMaxRefreshes {
+		keep = keep[:historyMaxRefreshes]
+	}
+	oldest := keep[len(keep)-1]
+
+	nh := make(History)
+	for mod, ss := range h {
+		for _, s := range ss {
+			if !s.Date.Before(oldest) && !s.Date.Equal(date) {
+				nh[mod] = append(nh[mod], s)
+			}
+		}
+	}
+	for mod, n := range m {
+		if n >= historyMinImporters {
+			nh[mod] = append(nh[mod], Sample{Date: date, Importers: n})
+		}
+	}
+	for _, ss := range nh {
+		slices.SortFunc(ss, func(a, b Sample) int { return a.Date.Compare(b.Date) })
+	}
+	return nh
+}
+
+// A Trend is the change in a module's importer count over time.
+type Trend struct {
+	From, To int
+	Since    time.Time
+}
+
+// Rapid reports whether the trend shows rapid growth.
+func (t *Trend) Rapid() bool {
+	return t != nil && t.To >= 2*t.From && t.To-t.From >= rapidGrowthMinImporters
+}
+
+func (t *Trend) String() string {
+	return fmt.Sprintf("from %d importers on %s", t.From, t.Since.Format(dateFormat))
+}
+
+// Trend compares current, the current importer count of the module, with
+// the earliest count in the history within a year of the latest sample.
+// It returns nil if there is no earlier count.
+func (h History) Trend(modulePath string, current int) *Trend {
+	ss := h[modulePath]
+	if len(ss) == 0 {
+		return nil
+	}
+	start := ss[len(ss)-1].Date.Add(-trendWindow)
+	for _, s := range ss {
+		if !s.Date.Before(start) {
+			return &Trend{From: s.Importers, To: current, Since: s.Date}
+		}
+	}
+	return nil
+}
+
+// A PopularityChange is a module whose popularity has grown enough
+// that its excluded reports should be re-reviewed.
+type PopularityChange struct {
+	Module string
+	Trend  *Trend
+	// The module's reports that were excluded as likely binaries
+	// or private code.
+	Excluded []*report.Report
+}
+
+// PopularityChanges returns the modules with reports excluded as likely
+// binaries or private code (see state) that have since become popular:
+// their importer count in current has crossed the threshold of the
+// importers factor of the checked-in model, or is growing rapidly,
+// compared to the history.
+// The result is sorted by module path.
+func PopularityChanges(h History, current map[string]int, rc *report.Client) []*PopularityChange {
+	excluded := make(map[string][]*report.Report)
+	for _, r := range rc.List() {
+		if state(r) != excludedBinary {
+			continue
+		}
+		for _, m := range r.Modules {
+			excluded[m.Module] = append(excluded[m.Module], r)
+		}
+	}
+	minImporters := Default().MinImporters()
+	var pcs []*PopularityChange
+	for mod, rs := range excluded {
+		t := h.Trend(mod, current[mod])
+		if t == nil {
+			continue
+		}
+		if t.Rapid() || (minImporters > 0 && t.From < minImporters && t.To >= minImporters) {
+			pcs = append(pcs, &PopularityChange{Module: mod, Trend: t, Excluded: rs})
+		}
+	}
+	slices.SortFunc(pcs, func(a, b *PopularityChange) int { return cmp.Compare(a.Module, b.Module) })
+	return pcs
+}
@@ -0,0 +1,117 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package priority
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/vulndb/internal/report"
+)
+
+func day(n int) time.Time {
+	return time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC).AddDate(0, 0, n)
+}
+
+func TestHistoryAdd(t *testing.T) {
+	h := History{}
+	for i := range historyMaxRefreshes + 2 {
+		h = h.Add(day(i), map[string]int{"example.com/a": 10 + i, "example.com/small": 1})
+	}
+	// Re-adding a date replaces its counts.
+	h = h.Add(day(historyMaxRefreshes+1), map[string]int{"example.com/a": 100})
+
+	var want []Sample
+	for i := 2; i < historyMaxRefreshes+1; i++ {
+		want = append(want, Sample{Date: day(i), Importers: 10 + i})
+	}
+	want = append(want, Sample{Date: day(historyMaxRefreshes + 1), Importers: 100})
+	if diff := cmp.Diff(History{"example.com/a": want}, h); diff != "" {
+		t.Errorf("mismatch (-want, +got):\n%s", diff)
+	}
+
+	var b bytes.Buffer
+	if err := WriteHistory(&b, h); err != nil {
+		t.Fatal(err)
+	}
+	got, err := ReadHistory(&b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(h, got); diff != "" {
+		t.Errorf("round trip mismatch (-want, +got):\n%s", diff)
+	}
+}
+
+func TestLoadHistory(t *testing.T) {
+	h, err := LoadHistory()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(h) == 0 {
+		t.Error("checked-in history is empty")
+	}
+}
+
+func TestTrend(t *testing.T) {
+	h := History{"example.com/a": {
+		{Date: day(0), Importers: 5},
+		{Date: day(100), Importers: 30},
+		{Date: day(400), Importers: 50},
+	}}
+	got := h.Trend("example.com/a", 70)
+	want := &Trend{From: 30, To: 70, Since: day(100)}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Trend mismatch (-want, +got):\n%s", diff)
+	}
+	if !got.Rapid() {
+		t.Errorf("%v: Rapid() = false, want true", got)
+	}
+	if got := h.Trend("example.com/b", 70); got != nil {
+		t.Errorf("Trend of unknown module = %v, want nil", got)
+	}
+	for _, tr := range []*Trend{nil, {From: 30, To: 59}, {From: 10, To: 29}} {
+		if tr.Rapid() {
+			t.Errorf("%v: Rapid() = true, want false", tr)
+		}
+	}
+}
+
+func TestPopularityChanges(t *testing.T) {
+	rc, err := report.NewTestClient(map[string]*report.Report{
+		"data/excluded/GO-2024-0001.yaml": {ID: "GO-2024-0001", Modules: []*report.Module{{Module: "example.com/popular"}}, Excluded: report.ExcludedEffectivelyPrivate},
+		"data/excluded/GO-2024-0002.yaml": {ID: "GO-2024-0002", Modules: []*report.Module{{Module: "example.com/steady"}}, Excluded: report.ExcludedNotImportable},
+		"data/excluded/GO-2024-0003.yaml": {ID: "GO-2024-0003", Modules: []*report.Module{{Module: "example.com/growing"}}, Excluded: report.ExcludedNotImportable},
+		"data/excluded/GO-2024-0004.yaml": {ID: "GO-2024-0004", Modules: []*report.Module{{Module: "example.com/notgo"}}, Excluded: report.ExcludedNotGoCode},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := History{
+		"example.com/popular": {{Date: day(0), Importers: 90}},
+		"example.com/steady":  {{Date: day(0), Importers: 50}},
+		"example.com/growing": {{Date: day(0), Importers: 15}},
+		"example.com/notgo":   {{Date: day(0), Importers: 10}},
+	}
+	current := map[string]int{
+		"example.com/popular": 110,
+		"example.com/steady":  60,
+		"example.com/growing": 45,
+		"example.com/notgo":   500,
+	}
+	var got []string
+	for _, pc := range PopularityChanges(h, current, rc) {
+		got = append(got, pc.Module+" "+pc.Trend.String()+" "+pc.Excluded[0].ID)
+	}
+	want := []string{
+		"example.com/growing from 15 importers on 2024-01-01 GO-2024-0003",
+		"example.com/popular from 90 importers on 2024-01-01 GO-2024-0001",
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("PopularityChanges mismatch (-want, +got):\n%s", diff)
+	}
+}
@@ -5,21 +5,119 @@
 package priority
 
 import (
+	"bufio"
 	"bytes"
+	"cmp"
 	"compress/gzip"
+	"context"
 	_ "embed"
 	"encoding/csv"
+	"fmt"
 	"io"
+	"net/http"
+	"os"
+	"slices"
 	"strconv"
+	"strings"
+
+	"golang.org/x/exp/maps"
+	"golang.org/x/vulndb/internal/derrors"
 )
 
 //go:embed data/importers-*.csv.gz
 var importers []byte
 
+// LoadModuleMap returns the importer counts in the checked-in
+// snapshot (see data/README.md).
 func LoadModuleMap() (map[string]int, error) {
 	return gzCSVToMap(importers)
 }
 
+// FetchModuleMap reads current importer counts from source, which is
+// either an HTTP(S) URL or a local file. The source must hold a CSV file,
+// optionally gzipped, in the same format as the checked-in snapshot:
+// a "module_path,imported_by" header followed by one record per module.
+//
+// Such a file can be exported from the pkgsite database (or a BigQuery copy
+// of it) by taking, for each module, the maximum imported_by_count of
+// its packages in search_documents.
+func FetchModuleMap(ctx context.Context, source string) (_ map[string]int, err error) {
+	defer derrors.Wrap(&err, "FetchModuleMap(%q)", source)
+
+	var r io.ReadCloser
+	if strings.HasPrefix(source, "http://") || strings.HasPrefix(source, "https://") {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, source, nil)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		if resp.StatusCode != http.StatusOK {
+			resp.Body.Close()
+			return nil, fmt.Errorf("HTTP error: %s", resp.Status)
+		}
+		r = resp.Body
+	} else {
+		f, err := os.Open(source)
+		if err != nil {
+			return nil, err
+		}
+		r = f
+	}
+	defer r.Close()
+
+	br := bufio.NewReader(r)
+	if magic, _ := br.Peek(2); bytes.Equal(magic, []byte{0x1f, 0x8b}) {
+		gzr, err := gzip.NewReader(br)
+		if err != nil {
+			return nil, err
+		}
+		return checkModuleMap(CSVToMap(gzr))
+	}
+	return checkModuleMap(CSVToMap(br))
+}
+
+// checkModuleMap rejects empty module maps, which most likely
+// come from a source in the wrong format.
+func checkModuleMap(m map[string]int, err error) (map[string]int, error) {
+	if err != nil {
+		return nil, err
+	}
+	if len(m) == 0 {
+		return nil, fmt.Errorf("no module importer counts found")
+	}
+	return m, nil
+}
+
+// WriteModuleMap writes m to w as a gzipped CSV file in the format
+// of the checked-in snapshot, with the most-imported modules first.
+func WriteModuleMap(w io.Writer, m map[string]int) error {
+	mods := maps.Keys(m)
+	slices.SortFunc(mods, func(a, b string) int {
+		if c := cmp.Compare(m[b], m[a]); c != 0 {
+			return c
+		}
+		return strings.Compare(a, b)
+	})
+	gzw := gzip.NewWriter(w)
+	cw := csv.NewWriter(gzw)
+	if err := cw.Write([]string{"module_path", "imported_by"}); err != nil {
+		return err
+	}
+	for _, mod := range mods {
+		if err := cw.Write([]string{mod, strconv.Itoa(m[mod])}); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	if err := cw.Error(); err != nil {
+		return err
+	}
+	return gzw.Close()
+}
+
 func gzCSVToMap(b []byte) (map[string]int, error) {
 	gzr, err := gzip.NewReader(bytes.NewReader(b))
 	if err != nil {
@@ -36,6 +134,9 @@ func CSVToMap(r io.Reader) (map[string]int, error) {
 	}
 
 	m := make(map[string]int)
+	if len(records) == 0 {
+		return m, nil
+	}
 	for _, record := range records[1:] {
 		if len(record) != 2 {
 			continue
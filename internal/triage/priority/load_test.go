@@ -0,0 +1,75 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package priority
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestWriteAndFetchModuleMap(t *testing.T) {
+	ctx := context.Background()
+	m := map[string]int{
+		"golang.org/x/net":      100,
+		"github.com/pkg/errors": 300,
+		"example.com/b":         0,
+		"example.com/a":         0,
+	}
+
+	var gz bytes.Buffer
+	if err := WriteModuleMap(&gz, m); err != nil {
+		t.Fatal(err)
+	}
+	got, err := gzCSVToMap(gz.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(m, got); diff != "" {
+		t.Errorf("round trip mismatch (-want, +got):\n%s", diff)
+	}
+
+	plain := "module_path,imported_by\ngolang.org/x/net,100\ngithub.com/pkg/errors,300\nexample.com/a,0\nexample.com/b,0\n"
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/importers.csv.gz":
+			w.Write(gz.Bytes())
+		case "/importers.csv":
+			w.Write([]byte(plain))
+		case "/empty.csv":
+			w.Write([]byte("module_path,imported_by\n"))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	dir := t.TempDir()
+	file := filepath.Join(dir, "importers.csv")
+	if err := os.WriteFile(file, []byte(plain), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, source := range []string{srv.URL + "/importers.csv.gz", srv.URL + "/importers.csv", file} {
+		got, err := FetchModuleMap(ctx, source)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(m, got); diff != "" {
+			t.Errorf("FetchModuleMap(%s) mismatch (-want, +got):\n%s", source, diff)
+		}
+	}
+	for _, source := range []string{srv.URL + "/empty.csv", srv.URL + "/missing", filepath.Join(dir, "missing")} {
+		if _, err := FetchModuleMap(ctx, source); err == nil {
+			t.Errorf("FetchModuleMap(%s): got nil error, want error", source)
+		}
+	}
+}
@@ -0,0 +1,244 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package priority
+
+import (
+	"bytes"
+	_ "embed"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+
+	"golang.org/x/vulndb/internal/derrors"
+	"golang.org/x/vulndb/internal/stdlib"
+	"gopkg.in/yaml.v3"
+)
+
+// A Model is a scoring model for the priority of modules.
+//
+// Each factor of the model that applies to a module adds its weight
+// (which may be negative) to the module's score. Modules with a score
+// of at least HighScore get high priority, and others low priority,
+// unless their priority is overridden.
+type Model struct {
+	// HighScore is the lowest score of a high-priority module.
+	HighScore int `yaml:"high_score"`
+	// Factors are the factors that can contribute to a score.
+	Factors []*Factor `yaml:"factors"`
+	// Overrides maps module paths to priorities that take
+	// precedence over their scores.
+	Overrides map[string]Priority `yaml:"overrides,omitempty"`
+}
+
+// A Factor is a property of a module that contributes to its score.
+type Factor struct {
+	// Name is the name of the factor; it must be one of the names
+	// in factorNames, which determines when the factor applies.
+	Name string `yaml:"name"`
+	// Weight is what the factor adds to the score of a module
+	// it applies to.
+	Weight int `yaml:"weight"`
+	// MinImporters is the number of importers at which
+	// the importers factor applies.
+	MinImporters int `yaml:"min_importers,omitempty"`
+}
+
+// The names of the factors, and when they apply to a module.
+const (
+	// The module has at least MinImporters importers.
+	factorImporters = "importers"
+	// The module's importer count is growing rapidly (see Trend.Rapid).
+	factorRapidGrowth = "rapid-growth"
+	// Fewer of the module's earlier reports were reviewed than are
+	// likely binaries (excluded as, or unexcluded from, not importable
+	// or effectively private), so its code is likely mostly binaries.
+	factorBinaryOnly = "binary-only"
+	// The module is the standard library or the toolchain.
+	factorStdlib = "stdlib"
+	// The vulnerability is in CISA's Known Exploited Vulnerabilities
+	// catalog.
+	factorKEV = "kev"
+)
+
+var factorNames = []string{factorImporters, factorRapidGrowth, factorBinaryOnly, factorStdlib, factorKEV}
+
+//go:embed data/model.yaml
+var modelYAML []byte
+
+// Default returns the checked-in model (see data/model.yaml).
+// The result must not be modified.
+var Default = sync.OnceValue(func() *Model {
+	m, err := ParseModel(modelYAML)
+	if err != nil {
+		panic(fmt.Sprintf("priority: invalid data/model.yaml: %v", err))
+	}
+	return m
+})
+
+// ReadModel reads a model from a YAML file in the format of
+// data/model.yaml.
+func ReadModel(filename string) (_ *Model, err error) {
+	defer derrors.Wrap(&err, "priority.ReadModel(%q)", filename)
+
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	return ParseModel(b)
+}
+
+// ParseModel parses a model in the format of data/model.yaml.
+func ParseModel(b []byte) (*Model, error) {
+	var m Model
+	dec := yaml.NewDecoder(bytes.NewReader(b))
+	dec.KnownFields(true)
+	if err := dec.Decode(&m); err != nil {
+		return nil, err
+	}
+	if m.HighScore <= 0 {
+		return nil, fmt.Errorf("high_score must be positive")
+	}
+	seen := make(map[string]bool)
+	for i, f := range m.Factors {
+		if !slices.Contains(factorNames, f.Name) {
+			return nil, fmt.Errorf("factor %d: unknown name %q (must be one of: %s)", i, f.Name, strings.Join(factorNames, ", "))
+		}
+		if seen[f.Name] {
+			return nil, fmt.Errorf("factor %s: duplicate", f.Name)
+		}
+		seen[f.Name] = true
+		if f.Weight == 0 {
+			return nil, fmt.Errorf("factor %s: missing weight", f.Name)
+		}
+		if (f.Name == factorImporters) != (f.MinImporters > 0) {
+			return nil, fmt.Errorf("factor %s: min_importers must be positive for %s, and only set for it", f.Name, factorImporters)
+		}
+	}
+	return &m, nil
+}
+
+// MinImporters returns the number of importers at which the
+// importers factor applies, or 0 if the model doesn't have it.
+func (m *Model) MinImporters() int {
+	for _, f := range m.Factors {
+		if f.Name == factorImporters {
+			return f.MinImporters
+		}
+	}
+	return 0
+}
+
+// UnmarshalYAML parses a priority from its name.
+func (p *Priority) UnmarshalYAML(n *yaml.Node) error {
+	for _, q := range []Priority{Unknown, Low, High} {
+		if n.Value == q.String() {
+			*p = q
+			return nil
+		}
+	}
+	return fmt.Errorf("line %d: invalid priority %q (must be high, low or unknown)", n.Line, n.Value)
+}
+
+// A FactorScore is a factor that applies to a module,
+// and what it adds to the module's score.
+type FactorScore struct {
+	Module string
+	Factor string
+	Weight int
+	// Detail is why the factor applies.
+	Detail string
+}
+
+func (fs *FactorScore) String() string {
+	return fmt.Sprintf("%s %+d (%s)", fs.Factor, fs.Weight, fs.Detail)
+}
+
+// signals are what the factors look at to score a module.
+type signals struct {
+	module string
+	// importers is the module's importer count, if known.
+	importers      int
+	knownImporters bool
+	states         map[reportState]int
+	trend          *Trend
+	// aliases are the IDs of the vulnerability, and kev the
+	// set of CVE IDs in the KEV catalog.
+	aliases []string
+	kev     map[string]bool
+}
+
+// applies returns why f applies to the module with signals s,
+// and false if it doesn't.
+func (f *Factor) applies(s *signals) (string, bool) {
+	switch f.Name {
+	case factorImporters:
+		if s.knownImporters && s.importers >= f.MinImporters {
+			return fmt.Sprintf("at least %d importers", f.MinImporters), true
+		}
+	case factorRapidGrowth:
+		if s.trend.Rapid() {
+			return fmt.Sprintf("growing rapidly %s", s.trend), true
+		}
+	case factorBinaryOnly:
+		if rev, binary := s.states[reviewed], s.states[excludedBinary]+s.states[unreviewedUnexcluded]; binary > rev {
+			return fmt.Sprintf("fewer reviewed (%d) than likely-binary reports (%d)", rev, binary), true
+		}
+	case factorStdlib:
+		if stdlib.IsStdModule(s.module) || stdlib.IsCmdModule(s.module) {
+			return "first-party module", true
+		}
+	case factorKEV:
+		var ids []string
+		for _, a := range s.aliases {
+			if s.kev[a] {
+				ids = append(ids, a)
+			}
+		}
+		if len(ids) > 0 {
+			return fmt.Sprintf("%s in the CISA KEV catalog", strings.Join(ids, ", ")), true
+		}
+	}
+	return "", false
+}
+
+// score returns the result of scoring the module with signals s.
+func (m *Model) score(s *signals) *Result {
+	if pr, ok := m.Overrides[s.module]; ok {
+		return &Result{Priority: pr, Reason: fmt.Sprintf("%s is in the override list (priority=%s)", s.module, pr)}
+	}
+
+	r := &Result{Priority: Low}
+	for _, f := range m.Factors {
+		if detail, ok := f.applies(s); ok {
+			r.Score += f.Weight
+			r.Factors = append(r.Factors, &FactorScore{Module: s.module, Factor: f.Name, Weight: f.Weight, Detail: detail})
+		}
+	}
+	comp := "<"
+	if r.Score >= m.HighScore {
+		r.Priority, comp = High, ">="
+	}
+
+	var b strings.Builder
+	b.WriteString(s.module)
+	if s.knownImporters {
+		fmt.Fprintf(&b, " has %d importers and", s.importers)
+	} else {
+		b.WriteString(" has")
+	}
+	fmt.Fprintf(&b, " score %d (%s %d)", r.Score, comp, m.HighScore)
+	for i, fs := range r.Factors {
+		if i == 0 {
+			b.WriteString(": ")
+		} else {
+			b.WriteString(", ")
+		}
+		b.WriteString(fs.String())
+	}
+	r.Reason = b.String()
+	return r
+}
@@ -14,11 +14,18 @@ import (
 	"strings"
 
 	"golang.org/x/vulndb/internal/report"
+	"golang.org/x/vulndb/internal/stdlib"
 )
 
 type Result struct {
 	Priority Priority
 	Reason   string
+	// Score is the score of the module in the model, and Factors
+	// are the factors that contributed to it (see Model). For several
+	// modules, Score is the highest of their scores, and Factors are
+	// the factors of all of them.
+	Score   int
+	Factors []*FactorScore
 }
 
 type NotGoResult struct {
@@ -46,39 +53,77 @@ const (
 	High
 )
 
+// Inputs are the data, besides reports, that priorities are based on.
+type Inputs struct {
+	// Model is the scoring model. If nil, the checked-in model
+	// (see Default) is used.
+	Model *Model
+	// ModulesToImports maps module paths to their importer counts.
+	ModulesToImports map[string]int
+	// History is the history of importer counts. It may be nil,
+	// in which case no module is growing rapidly.
+	History History
+	// KEV is the set of CVE IDs in CISA's Known Exploited
+	// Vulnerabilities catalog. It may be nil.
+	KEV map[string]bool
+}
+
+func (in *Inputs) model() *Model {
+	if in.Model == nil {
+		return Default()
+	}
+	return in.Model
+}
+
 // AnalyzeReport returns the results for a report as a whole:
 //   - priority is the priority of its highest-priority module
 //   - not Go if all modules are not Go
-func AnalyzeReport(r *report.Report, rc *report.Client, modulesToImports map[string]int) (*Result, *NotGoResult) {
-	var overall Priority
-	var reasons []string
-	var notGoReasons []string
+func AnalyzeReport(r *report.Report, rc *report.Client, in *Inputs) (*Result, *NotGoResult) {
+	var mps []string
 	for _, m := range r.Modules {
-		mp := m.Module
-		result, notGo := Analyze(mp, issueID(r), rc.ReportsByModule(mp), modulesToImports)
-		if result.Priority > overall {
-			overall = result.Priority
+		mps = append(mps, m.Module)
+	}
+	return AnalyzeModules(mps, r.Aliases(), issueID(r), rc, in)
+}
+
+// AnalyzeModules is like AnalyzeReport, but returns the results for a
+// new report with the given issue ID, ghID, and aliases, for the modules
+// with the given paths (for example, the candidate modules of a tracker
+// issue).
+func AnalyzeModules(mps, aliases []string, ghID int, rc *report.Client, in *Inputs) (*Result, *NotGoResult) {
+	var (
+		result       = &Result{}
+		reasons      []string
+		notGoReasons []string
+	)
+	for i, mp := range mps {
+		r, notGo := Analyze(mp, aliases, ghID, rc.ReportsByModule(mp), in)
+		if r.Priority > result.Priority {
+			result.Priority = r.Priority
+		}
+		if i == 0 || r.Score > result.Score {
+			result.Score = r.Score
 		}
-		reasons = append(reasons, result.Reason)
+		result.Factors = append(result.Factors, r.Factors...)
+		reasons = append(reasons, r.Reason)
 		if notGo != nil {
 			notGoReasons = append(notGoReasons, notGo.Reason)
 		}
 	}
-
-	result := &Result{
-		Priority: overall, Reason: strings.Join(reasons, "; "),
-	}
+	result.Reason = strings.Join(reasons, "; ")
 
 	// If all modules are not Go, the report is not Go.
-	if len(notGoReasons) == len(r.Modules) {
+	if len(notGoReasons) == len(mps) {
 		return result, &NotGoResult{Reason: strings.Join(notGoReasons, "; ")}
 	}
 
 	return result, nil
 }
 
-func Analyze(mp string, ghID int, reportsForModule []*report.Report, modulesToImports map[string]int) (*Result, *NotGoResult) {
-	//rs := slices.Clone(reportsForModule)
+// Analyze returns the priority of a new report with the given issue ID,
+// ghID, and aliases, for the module mp, and whether the module is
+// possibly not Go.
+func Analyze(mp string, aliases []string, ghID int, reportsForModule []*report.Report, in *Inputs) (*Result, *NotGoResult) {
 	reportsForModule = slices.Clone(reportsForModule)
 	sort.Slice(reportsForModule, func(i, j int) bool {
 		return issueID(reportsForModule[i]) < issueID(reportsForModule[j])
@@ -89,57 +134,26 @@ func Analyze(mp string, ghID int, reportsForModule []*report.Report, modulesToIm
 	sc := stateCounts(reportsForModule[:idx])
 
 	notGo := isPossiblyNotGo(len(reportsForModule), sc)
-	importers, ok := modulesToImports[mp]
-	if !ok {
+	importers, ok := in.ModulesToImports[mp]
+	if !ok && !stdlib.IsStdModule(mp) && !stdlib.IsCmdModule(mp) {
 		return &Result{
 			Priority: Unknown,
 			Reason:   fmt.Sprintf("module %s not found", mp),
 		}, notGo
 	}
 
-	return priority(mp, importers, sc), notGo
-}
-
-// override takes precedence over all other metrics in determining
-// a module's priority.
-var override map[string]Priority = map[string]Priority{
-	// argo-cd is primarily a binary and usually has correct version
-	// information without intervention.
-	"github.com/argoproj/argo-cd":    Low,
-	"github.com/argoproj/argo-cd/v2": Low,
-
-	// Based on golang/vulndb#3317.
-	"github.com/canonical/lxd": High,
-}
-
-func priority(mp string, importers int, sc map[reportState]int) *Result {
-	if pr, ok := override[mp]; ok {
-		return &Result{pr, fmt.Sprintf("%s is in the override list (priority=%s)", mp, pr)}
-	}
-
-	const highPriority = 100
-	importersStr := func(comp string) string {
-		return fmt.Sprintf("%s has %d importers (%s %d)", mp, importers, comp, highPriority)
+	s := &signals{
+		module:         mp,
+		importers:      importers,
+		knownImporters: ok,
+		states:         sc,
+		aliases:        aliases,
+		kev:            in.KEV,
 	}
-
-	if importers >= highPriority {
-		rev := sc[reviewed]
-		binary := sc[excludedBinary] + sc[unreviewedUnexcluded]
-		getReason := func(conj1, conj2 string) string {
-			return fmt.Sprintf("%s %s reviewed (%d) %s likely-binary reports (%d)",
-				importersStr(">="), conj1, rev, conj2, binary)
-		}
-
-		if rev > binary {
-			return &Result{High, getReason("and more", "than")}
-		} else if rev == binary {
-			return &Result{High, getReason("and as many", "as")}
-		}
-
-		return &Result{Low, getReason("but fewer", "than")}
+	if ok {
+		s.trend = in.History.Trend(mp, importers)
 	}
-
-	return &Result{Low, importersStr("<")}
+	return in.model().score(s), notGo
 }
 
 func isPossiblyNotGo(numReports int, sc map[reportState]int) *NotGoResult {
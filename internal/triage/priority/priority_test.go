@@ -6,7 +6,9 @@ package priority
 
 import (
 	"math"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"golang.org/x/vulndb/internal/report"
@@ -61,12 +63,20 @@ var (
 	}
 )
 
+var importersFactor = &FactorScore{
+	Module: "example.com/module", Factor: "importers", Weight: 100,
+	Detail: "at least 100 importers",
+}
+
 func TestAnalyze(t *testing.T) {
 	for _, tc := range []struct {
 		name             string
 		module           string
+		aliases          []string
 		reportsForModule []*report.Report
 		modulesToImports map[string]int
+		history          History
+		kev              map[string]bool
 		want             *Result
 		wantNotGo        *NotGoResult
 	}{
@@ -86,7 +96,7 @@ func TestAnalyze(t *testing.T) {
 			modulesToImports: map[string]int{"example.com/module": 99},
 			want: &Result{
 				Priority: Low,
-				Reason:   "example.com/module has 99 importers (< 100)",
+				Reason:   "example.com/module has 99 importers and score 0 (< 100)",
 			},
 		},
 		{
@@ -96,7 +106,9 @@ func TestAnalyze(t *testing.T) {
 			modulesToImports: map[string]int{"example.com/module": 100},
 			want: &Result{
 				Priority: High,
-				Reason:   "example.com/module has 100 importers (>= 100) and as many reviewed (0) as likely-binary reports (0)",
+				Reason:   "example.com/module has 100 importers and score 100 (>= 100): importers +100 (at least 100 importers)",
+				Score:    100,
+				Factors:  []*FactorScore{importersFactor},
 			},
 		},
 		{
@@ -106,7 +118,9 @@ func TestAnalyze(t *testing.T) {
 			modulesToImports: map[string]int{"example.com/module": 101},
 			want: &Result{
 				Priority: High,
-				Reason:   "example.com/module has 101 importers (>= 100) and more reviewed (2) than likely-binary reports (1)",
+				Reason:   "example.com/module has 101 importers and score 100 (>= 100): importers +100 (at least 100 importers)",
+				Score:    100,
+				Factors:  []*FactorScore{importersFactor},
 			},
 		},
 		{
@@ -120,7 +134,11 @@ func TestAnalyze(t *testing.T) {
 			modulesToImports: map[string]int{"example.com/module": 101},
 			want: &Result{
 				Priority: Low,
-				Reason:   "example.com/module has 101 importers (>= 100) but fewer reviewed (1) than likely-binary reports (3)",
+				Reason:   "example.com/module has 101 importers and score 0 (< 100): importers +100 (at least 100 importers), binary-only -100 (fewer reviewed (1) than likely-binary reports (3))",
+				Factors: []*FactorScore{importersFactor, {
+					Module: "example.com/module", Factor: "binary-only", Weight: -100,
+					Detail: "fewer reviewed (1) than likely-binary reports (3)",
+				}},
 			},
 		},
 		{
@@ -134,7 +152,44 @@ func TestAnalyze(t *testing.T) {
 			modulesToImports: map[string]int{"example.com/module": 101},
 			want: &Result{
 				Priority: Low,
-				Reason:   "example.com/module has 101 importers (>= 100) but fewer reviewed (2) than likely-binary reports (3)",
+				Reason:   "example.com/module has 101 importers and score 0 (< 100): importers +100 (at least 100 importers), binary-only -100 (fewer reviewed (2) than likely-binary reports (3))",
+				Factors: []*FactorScore{importersFactor, {
+					Module: "example.com/module", Factor: "binary-only", Weight: -100,
+					Detail: "fewer reviewed (2) than likely-binary reports (3)",
+				}},
+			},
+		},
+		{
+			name:             "high priority growing rapidly",
+			module:           "example.com/module",
+			reportsForModule: []*report.Report{},
+			modulesToImports: map[string]int{"example.com/module": 60},
+			history: History{"example.com/module": {
+				{Date: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC), Importers: 10},
+				{Date: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), Importers: 20},
+				{Date: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC), Importers: 40},
+			}},
+			want: &Result{
+				Priority: High,
+				Reason:   "example.com/module has 60 importers and score 100 (>= 100): rapid-growth +100 (growing rapidly from 20 importers on 2024-01-01)",
+				Score:    100,
+				Factors: []*FactorScore{{
+					Module: "example.com/module", Factor: "rapid-growth", Weight: 100,
+					Detail: "growing rapidly from 20 importers on 2024-01-01",
+				}},
+			},
+		},
+		{
+			name:             "low priority growing slowly",
+			module:           "example.com/module",
+			reportsForModule: []*report.Report{},
+			modulesToImports: map[string]int{"example.com/module": 60},
+			history: History{"example.com/module": {
+				{Date: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), Importers: 40},
+			}},
+			want: &Result{
+				Priority: Low,
+				Reason:   "example.com/module has 60 importers and score 0 (< 100)",
 			},
 		},
 		{
@@ -144,15 +199,62 @@ func TestAnalyze(t *testing.T) {
 			modulesToImports: map[string]int{"example.com/module": 99},
 			want: &Result{
 				Priority: Low,
-				Reason:   "example.com/module has 99 importers (< 100)",
+				Reason:   "example.com/module has 99 importers and score 0 (< 100)",
 			},
 			wantNotGo: &NotGoResult{
 				Reason: "more than 20 percent of reports (1 of 4) with this module are NOT_GO_CODE",
 			},
 		},
+		{
+			name:             "high priority stdlib",
+			module:           "std",
+			modulesToImports: map[string]int{},
+			want: &Result{
+				Priority: High,
+				Reason:   "std has score 100 (>= 100): stdlib +100 (first-party module)",
+				Score:    100,
+				Factors: []*FactorScore{{
+					Module: "std", Factor: "stdlib", Weight: 100,
+					Detail: "first-party module",
+				}},
+			},
+		},
+		{
+			name:             "high priority known exploited",
+			module:           "example.com/module",
+			aliases:          []string{"CVE-1999-0001", "GHSA-xxxx-yyyy-zzzz"},
+			reportsForModule: []*report.Report{binary1},
+			modulesToImports: map[string]int{"example.com/module": 10},
+			kev:              map[string]bool{"CVE-1999-0001": true},
+			want: &Result{
+				Priority: High,
+				Reason:   "example.com/module has 10 importers and score 100 (>= 100): binary-only -100 (fewer reviewed (0) than likely-binary reports (1)), kev +200 (CVE-1999-0001 in the CISA KEV catalog)",
+				Score:    100,
+				Factors: []*FactorScore{
+					{
+						Module: "example.com/module", Factor: "binary-only", Weight: -100,
+						Detail: "fewer reviewed (0) than likely-binary reports (1)",
+					},
+					{
+						Module: "example.com/module", Factor: "kev", Weight: 200,
+						Detail: "CVE-1999-0001 in the CISA KEV catalog",
+					},
+				},
+			},
+		},
+		{
+			name:             "override",
+			module:           "github.com/canonical/lxd",
+			modulesToImports: map[string]int{"github.com/canonical/lxd": 1},
+			want: &Result{
+				Priority: High,
+				Reason:   "github.com/canonical/lxd is in the override list (priority=high)",
+			},
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
-			got, gotNotGo := Analyze(tc.module, math.MaxInt, tc.reportsForModule, tc.modulesToImports)
+			in := &Inputs{ModulesToImports: tc.modulesToImports, History: tc.history, KEV: tc.kev}
+			got, gotNotGo := Analyze(tc.module, tc.aliases, math.MaxInt, tc.reportsForModule, in)
 			want := tc.want
 			if diff := cmp.Diff(want, got); diff != "" {
 				t.Errorf("result mismatch (-want, +got):\n%s", diff)
@@ -163,3 +265,103 @@ func TestAnalyze(t *testing.T) {
 		})
 	}
 }
+
+func TestAnalyzeModules(t *testing.T) {
+	rc, err := report.NewTestClient(map[string]*report.Report{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	modulesToImports := map[string]int{
+		"example.com/low":  10,
+		"example.com/high": 200,
+	}
+	got, gotNotGo := AnalyzeModules([]string{"example.com/low", "example.com/high"}, nil, math.MaxInt, rc, &Inputs{ModulesToImports: modulesToImports})
+	want := &Result{
+		Priority: High,
+		Reason:   "example.com/low has 10 importers and score 0 (< 100); example.com/high has 200 importers and score 100 (>= 100): importers +100 (at least 100 importers)",
+		Score:    100,
+		Factors: []*FactorScore{{
+			Module: "example.com/high", Factor: "importers", Weight: 100,
+			Detail: "at least 100 importers",
+		}},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("result mismatch (-want, +got):\n%s", diff)
+	}
+	if gotNotGo != nil {
+		t.Errorf("got not Go %v, want nil", gotNotGo)
+	}
+}
+
+func TestParseModel(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		yaml    string
+		wantErr string
+	}{
+		{
+			name: "ok",
+			yaml: `
+high_score: 10
+factors:
+  - name: importers
+    min_importers: 50
+    weight: 10
+  - name: kev
+    weight: 20
+overrides:
+  example.com/module: low
+`,
+		},
+		{
+			name:    "no high score",
+			yaml:    "factors: []",
+			wantErr: "high_score must be positive",
+		},
+		{
+			name:    "unknown factor",
+			yaml:    "high_score: 10\nfactors:\n  - name: stars\n    weight: 10",
+			wantErr: `unknown name "stars"`,
+		},
+		{
+			name:    "duplicate factor",
+			yaml:    "high_score: 10\nfactors:\n  - name: kev\n    weight: 10\n  - name: kev\n    weight: 20",
+			wantErr: "factor kev: duplicate",
+		},
+		{
+			name:    "missing min importers",
+			yaml:    "high_score: 10\nfactors:\n  - name: importers\n    weight: 10",
+			wantErr: "min_importers must be positive",
+		},
+		{
+			name:    "invalid override",
+			yaml:    "high_score: 10\noverrides:\n  example.com/module: urgent",
+			wantErr: `invalid priority "urgent"`,
+		},
+		{
+			name:    "unknown field",
+			yaml:    "high_score: 10\nthreshold: 5",
+			wantErr: "field threshold not found",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ParseModel([]byte(tc.yaml))
+			if tc.wantErr == "" {
+				if err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Errorf("got error %v, want error containing %q", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestDefaultModel(t *testing.T) {
+	// Default panics if the checked-in model is invalid.
+	if got, want := Default().MinImporters(), 100; got != want {
+		t.Errorf("MinImporters() = %d, want %d", got, want)
+	}
+}
@@ -0,0 +1,116 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package triage
+
+import (
+	"context"
+	"sync"
+
+	"golang.org/x/vulndb/internal/derrors"
+	"golang.org/x/vulndb/internal/pkgsite"
+	"golang.org/x/vulndb/internal/worker/log"
+)
+
+// Inputs are the inputs to a decision made by RefersToGoModule: the
+// vuln's reference URLs, and pkgsite's answers to the lookups made
+// while examining them. They are enough to replay the decision
+// without access to the vuln or to pkgsite.
+type Inputs struct {
+	ReferenceURLs []string
+	Lookups       []*Lookup
+}
+
+// A Lookup records whether pkgsite knew a module path.
+type Lookup struct {
+	ModulePath string
+	Known      bool
+}
+
+// RefersToGoModuleWithInputs is like RefersToGoModule, but also returns
+// the inputs to the decision, so that it can be replayed with Replay.
+func RefersToGoModuleWithInputs(ctx context.Context, v Vuln, pc *pkgsite.Client) (_ *Result, _ *Inputs, err error) {
+	defer derrors.Wrap(&err, "triage.RefersToGoModuleWithInputs(%q)", v.SourceID())
+
+	rec := &recorder{pc: pc}
+	result, err := refersToGoModule(ctx, v, rec)
+	if err != nil {
+		return nil, nil, err
+	}
+	return result, &Inputs{
+		ReferenceURLs: v.ReferenceURLs(),
+		Lookups:       rec.lookups,
+	}, nil
+}
+
+// Replay repeats the decision for the vuln with the given ID, using the
+// recorded inputs in place of the vuln and pkgsite. Because it runs the
+// current triage logic, it can be used to check a fix for a
+// misclassification.
+//
+// If the current logic looks up a module path that was not recorded,
+// Replay asks pc, or, if pc is nil, treats the path as unknown.
+func Replay(ctx context.Context, id string, in *Inputs, pc *pkgsite.Client) (_ *Result, err error) {
+	defer derrors.Wrap(&err, "triage.Replay(%q)", id)
+
+	r := &replayer{recorded: make(map[string]bool), pc: pc}
+	for _, l := range in.Lookups {
+		r.recorded[l.ModulePath] = l.Known
+	}
+	return refersToGoModule(ctx, &replayVuln{id: id, refs: in.ReferenceURLs}, r)
+}
+
+// recorder is a moduleChecker that records the lookups it makes.
+type recorder struct {
+	pc *pkgsite.Client
+
+	mu      sync.Mutex
+	lookups []*Lookup
+}
+
+func (r *recorder) KnownModule(ctx context.Context, path string) (bool, error) {
+	known, err := r.pc.KnownModule(ctx, path)
+	if err != nil {
+		return false, err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.lookups = append(r.lookups, &Lookup{ModulePath: path, Known: known})
+	return known, nil
+}
+
+func (r *recorder) URL() string { return r.pc.URL() }
+
+// replayer is a moduleChecker that answers from recorded lookups.
+type replayer struct {
+	recorded map[string]bool
+	pc       *pkgsite.Client
+}
+
+func (r *replayer) KnownModule(ctx context.Context, path string) (bool, error) {
+	if known, ok := r.recorded[path]; ok {
+		return known, nil
+	}
+	if r.pc == nil {
+		log.Warningf(ctx, "replay: lookup of %q was not recorded; treating it as unknown", path)
+		return false, nil
+	}
+	log.Infof(ctx, "replay: lookup of %q was not recorded; asking pkgsite", path)
+	return r.pc.KnownModule(ctx, path)
+}
+
+func (r *replayer) URL() string {
+	if r.pc == nil {
+		return pkgsite.URL
+	}
+	return r.pc.URL()
+}
+
+type replayVuln struct {
+	id   string
+	refs []string
+}
+
+func (v *replayVuln) SourceID() string        { return v.id }
+func (v *replayVuln) ReferenceURLs() []string { return v.refs }
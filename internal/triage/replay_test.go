@@ -0,0 +1,69 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package triage
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/vulndb/internal/cve4"
+	"golang.org/x/vulndb/internal/pkgsite"
+)
+
+func TestReplay(t *testing.T) {
+	ctx := context.Background()
+	pc, err := pkgsite.TestClient(t, *usePkgsite)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cve := &cve4.CVE{
+		Metadata: cve4.Metadata{ID: "CVE-2000-0001"},
+		References: cve4.References{
+			Data: []cve4.Reference{
+				{URL: "https://bitbucket.org/foo/bar"},
+				{URL: "https://github.com/something/something/404"},
+			},
+		},
+	}
+
+	result, in, err := RefersToGoModuleWithInputs(ctx, cve, pc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result != nil {
+		t.Fatalf("got result %+v, want nil", result)
+	}
+	if diff := cmp.Diff(cve.ReferenceURLs(), in.ReferenceURLs); diff != "" {
+		t.Errorf("reference URLs mismatch (-want, +got):\n%s", diff)
+	}
+	if len(in.Lookups) == 0 {
+		t.Fatal("no lookups recorded")
+	}
+	for _, l := range in.Lookups {
+		if l.Known {
+			t.Errorf("lookup of %s recorded as known", l.ModulePath)
+		}
+	}
+
+	// With the same inputs, the decision is the same, without pkgsite.
+	got, err := Replay(ctx, cve.ID, in, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != nil {
+		t.Errorf("Replay = %+v, want nil", got)
+	}
+
+	// With different recorded answers, the decision changes.
+	in.Lookups[0].Known = true
+	got, err = Replay(ctx, cve.ID, in, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got == nil || got.ModulePath != in.Lookups[0].ModulePath {
+		t.Errorf("Replay = %+v, want module %s", got, in.Lookups[0].ModulePath)
+	}
+}
@@ -0,0 +1,308 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package interval provides an algebra of sets of unprefixed semantic
+// versions, represented as unions of half-open intervals, for working
+// with affected version ranges.
+package interval
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"golang.org/x/vulndb/internal/osv"
+	"golang.org/x/vulndb/internal/version"
+)
+
+// An Interval is the half-open range of versions [Introduced, Fixed):
+// the versions v such that Introduced <= v < Fixed.
+// An empty Introduced means the interval has no lower bound, and an
+// empty Fixed means it has no upper bound.
+type Interval struct {
+	Introduced string
+	Fixed      string
+}
+
+func (i Interval) String() string {
+	lo, hi := i.Introduced, i.Fixed
+	if lo == "" {
+		lo = "0"
+	}
+	if hi == "" {
+		hi = "∞"
+	}
+	return fmt.Sprintf("[%s, %s)", lo, hi)
+}
+
+// Contains reports whether v is in i.
+func (i Interval) Contains(v string) bool {
+	return compareLower(i.Introduced, v) <= 0 && compareUpper(v, i.Fixed) < 0
+}
+
+// isEmpty reports whether i contains no versions.
+func (i Interval) isEmpty() bool {
+	return i.Introduced != "" && i.Fixed != "" && !version.Before(i.Introduced, i.Fixed)
+}
+
+// A Set is a set of versions, represented as a union of intervals
+// that are non-empty, sorted, and neither overlap nor touch, so
+// that each set has a single representation.
+//
+// The zero Set is empty. Sets should be created with Normalize or
+// the other functions of the package, and are not modified by
+// its methods.
+type Set []Interval
+
+// All is the set of all versions.
+func All() Set { return Set{{}} }
+
+// Normalize returns the set of versions in any of the intervals.
+// The versions of the intervals must be valid semver.
+func Normalize(is ...Interval) Set {
+	var s Set
+	for _, i := range is {
+		if !i.isEmpty() {
+			s = append(s, i)
+		}
+	}
+	slices.SortFunc(s, func(a, b Interval) int {
+		return compareLower(a.Introduced, b.Introduced)
+	})
+	var merged Set
+	for _, i := range s {
+		if n := len(merged); n > 0 && compareLowerUpper(i.Introduced, merged[n-1].Fixed) <= 0 {
+			// i overlaps or touches the last interval.
+			if compareUpper(merged[n-1].Fixed, i.Fixed) < 0 {
+				merged[n-1].Fixed = i.Fixed
+			}
+			continue
+		}
+		merged = append(merged, i)
+	}
+	return merged
+}
+
+func (s Set) String() string {
+	if s.IsEmpty() {
+		return "∅"
+	}
+	var strs []string
+	for _, i := range s {
+		strs = append(strs, i.String())
+	}
+	return strings.Join(strs, " ∪ ")
+}
+
+// IsEmpty reports whether s contains no versions.
+func (s Set) IsEmpty() bool {
+	return len(s) == 0
+}
+
+// Contains reports whether v is in s.
+func (s Set) Contains(v string) bool {
+	return slices.ContainsFunc(s, func(i Interval) bool { return i.Contains(v) })
+}
+
+// Union returns the versions in s or t.
+func (s Set) Union(t Set) Set {
+	return Normalize(append(slices.Clone(s), t...)...)
+}
+
+// Complement returns the versions not in s.
+func (s Set) Complement() Set {
+	var c Set
+	// lo is the lower bound of the next interval of c,
+	// and ok reports whether there is one.
+	lo, ok := "", true
+	for _, i := range s {
+		if i.Introduced != "" {
+			c = append(c, Interval{Introduced: lo, Fixed: i.Introduced})
+		}
+		lo, ok = i.Fixed, i.Fixed != ""
+	}
+	if ok {
+		c = append(c, Interval{Introduced: lo})
+	}
+	return c
+}
+
+// Intersect returns the versions in both s and t.
+func (s Set) Intersect(t Set) Set {
+	return s.Complement().Union(t.Complement()).Complement()
+}
+
+// Difference returns the versions in s but not in t.
+func (s Set) Difference(t Set) Set {
+	return s.Intersect(t.Complement())
+}
+
+// Overlaps reports whether s and t have a version in common.
+func (s Set) Overlaps(t Set) bool {
+	return !s.Intersect(t).IsEmpty()
+}
+
+// ContainsSet reports whether every version in t is in s.
+func (s Set) ContainsSet(t Set) bool {
+	return t.Difference(s).IsEmpty()
+}
+
+// Equal reports whether s and t contain the same versions.
+func (s Set) Equal(t Set) bool {
+	return slices.EqualFunc(s, t, func(a, b Interval) bool {
+		return compareLower(a.Introduced, b.Introduced) == 0 && compareUpper(a.Fixed, b.Fixed) == 0
+	})
+}
+
+// Latest returns the upper bound of s: the lowest version above all
+// the versions in s. It returns "" if s has no upper bound, or is empty.
+func (s Set) Latest() string {
+	if s.IsEmpty() {
+		return ""
+	}
+	return s[len(s)-1].Fixed
+}
+
+// FixedBetween returns the upper bounds of the intervals of s that are
+// after from and at or before to. If s is a set of affected versions,
+// these are the versions that fix a vulnerability for an upgrade
+// from version from to version to.
+func (s Set) FixedBetween(from, to string) []string {
+	var fixed []string
+	for _, i := range s {
+		if i.Fixed != "" && version.Before(from, i.Fixed) && !version.Before(to, i.Fixed) {
+			fixed = append(fixed, i.Fixed)
+		}
+	}
+	return fixed
+}
+
+// FromOSV returns the versions affected according to the SEMVER ranges,
+// evaluating the events of each range in version order, as described
+// in the OSV schema. Ranges of other types are ignored.
+// It errors if any event is invalid.
+func FromOSV(ranges []osv.Range) (Set, error) {
+	var is []Interval
+	for _, r := range ranges {
+		if r.Type != osv.RangeTypeSemver {
+			continue
+		}
+		ris, err := fromEvents(r.Events)
+		if err != nil {
+			return nil, err
+		}
+		is = append(is, ris...)
+	}
+	return Normalize(is...), nil
+}
+
+func fromEvents(events []osv.RangeEvent) ([]Interval, error) {
+	type event struct {
+		v          string // "" for introduced "0"
+		introduced bool
+	}
+	var es []event
+	for _, e := range events {
+		switch {
+		case e.Introduced != "" && e.Fixed != "":
+			return nil, fmt.Errorf("event has both introduced (%s) and fixed (%s) versions", e.Introduced, e.Fixed)
+		case e.Introduced == "0":
+			es = append(es, event{introduced: true})
+		case e.Introduced != "":
+			es = append(es, event{v: e.Introduced, introduced: true})
+		case e.Fixed != "":
+			es = append(es, event{v: e.Fixed})
+		default:
+			return nil, fmt.Errorf("event has neither introduced nor fixed version")
+		}
+		if v := es[len(es)-1].v; v != "" && !version.IsValid(v) {
+			return nil, fmt.Errorf("invalid semver version %q", v)
+		}
+	}
+	slices.SortStableFunc(es, func(a, b event) int {
+		return compareLower(a.v, b.v)
+	})
+
+	var (
+		is      []Interval
+		current *Interval
+	)
+	for _, e := range es {
+		switch {
+		case e.introduced && current == nil:
+			current = &Interval{Introduced: e.v}
+		case !e.introduced && current != nil:
+			current.Fixed = e.v
+			is = append(is, *current)
+			current = nil
+		}
+	}
+	if current != nil {
+		is = append(is, *current)
+	}
+	return is, nil
+}
+
+// Events returns the events of a SEMVER range affecting the versions
+// in s. If s is empty, it returns nil.
+func (s Set) Events() []osv.RangeEvent {
+	var es []osv.RangeEvent
+	for _, i := range s {
+		introduced := i.Introduced
+		if introduced == "" {
+			introduced = "0"
+		}
+		es = append(es, osv.RangeEvent{Introduced: introduced})
+		if i.Fixed != "" {
+			es = append(es, osv.RangeEvent{Fixed: i.Fixed})
+		}
+	}
+	return es
+}
+
+// compareLower compares versions or lower bounds, where "" is
+// lower than all versions.
+func compareLower(v, w string) int {
+	switch {
+	case v == w:
+		return 0
+	case v == "":
+		return -1
+	case w == "":
+		return 1
+	}
+	return compare(v, w)
+}
+
+// compareUpper compares versions or upper bounds, where "" is
+// higher than all versions.
+func compareUpper(v, w string) int {
+	switch {
+	case v == w:
+		return 0
+	case v == "":
+		return 1
+	case w == "":
+		return -1
+	}
+	return compare(v, w)
+}
+
+// compareLowerUpper compares the lower bound lo with the upper bound hi.
+func compareLowerUpper(lo, hi string) int {
+	if lo == "" || hi == "" {
+		return -1
+	}
+	return compare(lo, hi)
+}
+
+func compare(v, w string) int {
+	switch {
+	case version.Before(v, w):
+		return -1
+	case version.Before(w, v):
+		return 1
+	}
+	return 0
+}
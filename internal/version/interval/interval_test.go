@@ -0,0 +1,320 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package interval
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/vulndb/internal/osv"
+	"golang.org/x/vulndb/internal/version"
+)
+
+// bounds are the versions used as interval bounds in the tests,
+// in ascending order.
+var bounds = []string{"1.0.0-rc.1", "1.0.0", "1.2.0", "1.4.0", "2.0.0", "2.1.0"}
+
+// probes are versions below, at and between all the bounds, so that
+// two sets built from the bounds are equal if and only if they
+// contain the same probes.
+var probes = []string{
+	"0.0.1",
+	"1.0.0-rc.1", "1.0.0-rc.2",
+	"1.0.0", "1.1.0",
+	"1.2.0", "1.3.0",
+	"1.4.0", "1.5.0",
+	"2.0.0", "2.0.1",
+	"2.1.0", "3.0.0",
+}
+
+// members returns the probes in s, as a bit set.
+func members(s Set) uint64 {
+	var m uint64
+	for i, p := range probes {
+		if s.Contains(p) {
+			m |= 1 << i
+		}
+	}
+	return m
+}
+
+// allProbes is the bit set of all the probes.
+var allProbes uint64 = 1<<len(probes) - 1
+
+// intervals returns all the intervals with bounds from bounds,
+// including unbounded and empty ones.
+func intervals() []Interval {
+	ends := append([]string{""}, bounds...)
+	var is []Interval
+	for _, lo := range ends {
+		for _, hi := range ends {
+			is = append(is, Interval{Introduced: lo, Fixed: hi})
+		}
+	}
+	return is
+}
+
+// randomSet returns the union of up to 4 random intervals.
+func randomSet(r *rand.Rand) (Set, []Interval) {
+	all := intervals()
+	is := make([]Interval, r.IntN(5))
+	for i := range is {
+		is[i] = all[r.IntN(len(all))]
+	}
+	return Normalize(is...), is
+}
+
+// checkNormalized errors if s is not in normal form.
+func checkNormalized(t *testing.T, s Set) {
+	t.Helper()
+	for i, iv := range s {
+		if iv.isEmpty() {
+			t.Fatalf("%v: interval %d is empty", s, i)
+		}
+		if i > 0 && compareLowerUpper(iv.Introduced, s[i-1].Fixed) <= 0 {
+			t.Fatalf("%v: interval %d overlaps or touches interval %d", s, i, i-1)
+		}
+		if i > 0 && iv.Introduced == "" || i < len(s)-1 && iv.Fixed == "" {
+			t.Fatalf("%v: unbounded interval %d is not at an end", s, i)
+		}
+	}
+}
+
+func TestNormalizeExhaustive(t *testing.T) {
+	is := intervals()
+	for _, a := range is {
+		for _, b := range is {
+			s := Normalize(a, b)
+			checkNormalized(t, s)
+			for _, p := range probes {
+				if got, want := s.Contains(p), a.Contains(p) || b.Contains(p); got != want {
+					t.Errorf("Normalize(%v, %v) = %v: Contains(%s) = %t, want %t", a, b, s, p, got, want)
+				}
+			}
+		}
+	}
+}
+
+func TestAlgebraExhaustive(t *testing.T) {
+	// All the sets that are unions of at most two intervals.
+	var sets []Set
+	for _, a := range intervals() {
+		for _, b := range intervals() {
+			sets = append(sets, Normalize(a, b))
+		}
+	}
+	for _, s := range sets {
+		ms := members(s)
+		checkAlgebra(t, s, ms, s.Complement(), allProbes&^ms)
+	}
+	// Binary operations on a sample of pairs, as all pairs take
+	// too long.
+	r := rand.New(rand.NewPCG(1, 2))
+	for range 20000 {
+		s, t2 := sets[r.IntN(len(sets))], sets[r.IntN(len(sets))]
+		checkBinary(t, s, t2)
+	}
+}
+
+func TestAlgebraRandom(t *testing.T) {
+	r := rand.New(rand.NewPCG(3, 4))
+	for range 5000 {
+		s, is := randomSet(r)
+		checkNormalized(t, s)
+		var want uint64
+		for _, iv := range is {
+			want |= members(Normalize(iv))
+		}
+		if got := members(s); got != want {
+			t.Fatalf("Normalize(%v) = %v: members %b, want %b", is, s, got, want)
+		}
+		u, _ := randomSet(r)
+		checkBinary(t, s, u)
+	}
+}
+
+// checkAlgebra checks the unary properties of s, whose members are ms,
+// and of its complement c, whose members should be mc.
+func checkAlgebra(t *testing.T, s Set, ms uint64, c Set, mc uint64) {
+	t.Helper()
+	checkNormalized(t, c)
+	if got := members(c); got != mc {
+		t.Fatalf("%v.Complement() = %v: members %b, want %b", s, c, got, mc)
+	}
+	if cc := c.Complement(); !cc.Equal(s) {
+		t.Fatalf("%v.Complement().Complement() = %v", s, cc)
+	}
+	if !s.Union(c).Equal(All()) {
+		t.Fatalf("%v ∪ %v != All", s, c)
+	}
+	if !s.Intersect(c).IsEmpty() {
+		t.Fatalf("%v ∩ %v is not empty", s, c)
+	}
+	got, err := FromOSV([]osv.Range{{Type: osv.RangeTypeSemver, Events: s.Events()}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !got.Equal(s) {
+		t.Fatalf("FromOSV(%v.Events()) = %v", s, got)
+	}
+}
+
+// checkBinary checks the binary operations on s and t against the
+// operations on their members.
+func checkBinary(t *testing.T, s, u Set) {
+	t.Helper()
+	ms, mu := members(s), members(u)
+	for _, op := range []struct {
+		name string
+		got  Set
+		want uint64
+	}{
+		{"∪", s.Union(u), ms | mu},
+		{"∩", s.Intersect(u), ms & mu},
+		{"\\", s.Difference(u), ms &^ mu},
+	} {
+		checkNormalized(t, op.got)
+		if got := members(op.got); got != op.want {
+			t.Fatalf("%v %s %v = %v: members %b, want %b", s, op.name, u, op.got, got, op.want)
+		}
+	}
+	if got, want := s.Overlaps(u), ms&mu != 0; got != want {
+		t.Fatalf("%v.Overlaps(%v) = %t, want %t", s, u, got, want)
+	}
+	if got, want := s.ContainsSet(u), mu&^ms == 0; got != want {
+		t.Fatalf("%v.ContainsSet(%v) = %t, want %t", s, u, got, want)
+	}
+	if got, want := s.Equal(u), ms == mu; got != want {
+		t.Fatalf("%v.Equal(%v) = %t, want %t", s, u, got, want)
+	}
+	// De Morgan's laws.
+	if !s.Union(u).Complement().Equal(s.Complement().Intersect(u.Complement())) {
+		t.Fatalf("¬(%v ∪ %v) != ¬%v ∩ ¬%v", s, u, s, u)
+	}
+}
+
+func TestFromOSV(t *testing.T) {
+	for _, test := range []struct {
+		name   string
+		ranges []osv.Range
+		want   Set
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name: "no fix",
+			ranges: []osv.Range{{Type: osv.RangeTypeSemver, Events: []osv.RangeEvent{
+				{Introduced: "0"},
+			}}},
+			want: All(),
+		},
+		{
+			name: "unsorted",
+			ranges: []osv.Range{{Type: osv.RangeTypeSemver, Events: []osv.RangeEvent{
+				{Fixed: "1.0.4"},
+				{Introduced: "0"},
+				{Introduced: "1.5.0"},
+				{Introduced: "1.1.2"},
+				{Fixed: "1.1.4"},
+			}}},
+			want: Set{{Fixed: "1.0.4"}, {Introduced: "1.1.2", Fixed: "1.1.4"}, {Introduced: "1.5.0"}},
+		},
+		{
+			name: "multiple ranges",
+			ranges: []osv.Range{
+				{Type: osv.RangeTypeSemver, Events: []osv.RangeEvent{{Introduced: "0"}, {Fixed: "0.1.0"}}},
+				{Type: osv.RangeTypeSemver, Events: []osv.RangeEvent{{Introduced: "0.1.0"}, {Fixed: "0.2.0"}}},
+				{Type: osv.RangeTypeEcosystem, Events: []osv.RangeEvent{{Introduced: "0"}}},
+			},
+			want: Set{{Fixed: "0.2.0"}},
+		},
+		{
+			name: "redundant events",
+			ranges: []osv.Range{{Type: osv.RangeTypeSemver, Events: []osv.RangeEvent{
+				{Introduced: "1.0.0"},
+				{Introduced: "1.1.0"},
+				{Fixed: "1.2.0"},
+				{Fixed: "1.3.0"},
+			}}},
+			want: Set{{Introduced: "1.0.0", Fixed: "1.2.0"}},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := FromOSV(test.ranges)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFromOSVError(t *testing.T) {
+	for _, events := range [][]osv.RangeEvent{
+		{{Introduced: "1.0.0", Fixed: "1.1.0"}},
+		{{}},
+		{{Introduced: "v1.0.0"}},
+	} {
+		if _, err := FromOSV([]osv.Range{{Type: osv.RangeTypeSemver, Events: events}}); err == nil {
+			t.Errorf("FromOSV(%v): got nil error, want error", events)
+		}
+	}
+}
+
+func TestString(t *testing.T) {
+	s := Normalize(Interval{Fixed: "1.0.0"}, Interval{Introduced: "1.2.0"})
+	if got, want := s.String(), "[0, 1.0.0) ∪ [1.2.0, ∞)"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+	if got, want := Set(nil).String(), "∅"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+}
+
+func TestLatest(t *testing.T) {
+	for _, test := range []struct {
+		s    Set
+		want string
+	}{
+		{nil, ""},
+		{All(), ""},
+		{Normalize(Interval{Fixed: "1.0.0"}, Interval{Introduced: "1.2.0", Fixed: "1.3.0"}), "1.3.0"},
+	} {
+		if got := test.s.Latest(); got != test.want {
+			t.Errorf("%v.Latest() = %q, want %q", test.s, got, test.want)
+		}
+	}
+}
+
+func TestFixedBetween(t *testing.T) {
+	s := Normalize(Interval{Fixed: "1.20.12"}, Interval{Introduced: "1.21.0-0", Fixed: "1.21.5"}, Interval{Introduced: "1.22.0"})
+	for _, test := range []struct {
+		from, to string
+		want     []string
+	}{
+		{"1.21.0", "1.21.5", []string{"1.21.5"}},
+		{"1.21.0", "1.21.4", nil},
+		{"1.21.5", "1.22.1", nil},
+		{"1.20.0", "1.21.5", []string{"1.20.12", "1.21.5"}},
+	} {
+		if got := s.FixedBetween(test.from, test.to); !slices.Equal(got, test.want) {
+			t.Errorf("%v.FixedBetween(%s, %s) = %v, want %v", s, test.from, test.to, got, test.want)
+		}
+	}
+}
+
+func TestProbes(t *testing.T) {
+	// The probes must be sorted for the tests to be meaningful.
+	for i := 1; i < len(probes); i++ {
+		if !version.Before(probes[i-1], probes[i]) {
+			t.Fatalf("probes not sorted: %s >= %s", probes[i-1], probes[i])
+		}
+	}
+}
@@ -49,6 +49,18 @@ func TrimPrefix(v string) string {
 	return v
 }
 
+// Normalize returns the canonical, unprefixed form of v, which may
+// have a 'v' or 'go' prefix, and may omit its minor and patch numbers
+// (e.g., "go1.21" becomes "1.21.0"). If v is not a valid semantic
+// version, it is returned without its prefix.
+func Normalize(v string) string {
+	v = TrimPrefix(v)
+	if c := Canonical(v); c != "" {
+		return c
+	}
+	return v
+}
+
 var commitHashRegex = regexp.MustCompile(`^[a-f0-9]+$`)
 
 func IsCommitHash(v string) bool {
@@ -63,7 +75,7 @@ func SemverToGoTag(v string) (string, error) {
 	// Add the "v" prefix back in, as the copied function relies
 	// on it.
 	// TODO(tatianabradley): Edit function body to not expect "v" prefix.
-	if !strings.HasPrefix("v", v) {
+	if !strings.HasPrefix(v, "v") {
 		v = "v" + v
 	}
 	// Rest of function copied from
@@ -85,7 +97,7 @@ func SemverToGoTag(v string) (string, error) {
 	if patch == "0" {
 		versionWithoutPrerelease = strings.TrimSuffix(versionWithoutPrerelease, ".0")
 	}
-	goVersion = fmt.Sprintf("go%s", versionWithoutPrerelease)
+	goVersion = fmt.Sprintf("go%s", strings.TrimPrefix(versionWithoutPrerelease, "v"))
 	if prerelease != "" {
 		// Go prereleases look like  "beta1" instead of "beta.1".
 		// "beta1" is bad for sorting (since beta10 comes before beta9), so
@@ -0,0 +1,239 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package worker
+
+import (
+	"bytes"
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+// apiTokenHeader is the request header that holds the API token.
+// The Authorization header is not used, because Cloud Run consumes it
+// for its own authentication.
+const apiTokenHeader = "X-Vuln-Worker-Token"
+
+// Bounds on the number of update records returned by /api/updates.
+const (
+	apiDefaultUpdates = 10
+	apiMaxUpdates     = 100
+)
+
+// registerAPI registers the JSON API, which exposes the triage state
+// shown on the home page to scripts and external dashboards.
+func (s *Server) registerAPI(ctx context.Context) {
+	// api/updates: The most recent updates, most recent first.
+	// The limit query parameter sets how many.
+	s.handle(ctx, "/api/updates", s.api(s.apiUpdates))
+	// api/cves: The CVEs in the triage state given by the
+	// triage_state query parameter.
+	s.handle(ctx, "/api/cves", s.api(s.apiCVEs))
+	// api/issues/pending: The CVEs and GHSAs that need issues.
+	s.handle(ctx, "/api/issues/pending", s.api(s.apiPendingIssues))
+}
+
+// api returns a handler for a JSON API endpoint that checks the request
+// and writes the value returned by f as JSON.
+func (s *Server) api(f func(r *http.Request) (any, error)) func(http.ResponseWriter, *http.Request) error {
+	return func(w http.ResponseWriter, r *http.Request) error {
+		if r.Method != http.MethodGet {
+			return &serverError{
+				status: http.StatusMethodNotAllowed,
+				err:    fmt.Errorf("%s required", http.MethodGet),
+			}
+		}
+		if err := s.checkAPIToken(r); err != nil {
+			return err
+		}
+		v, err := f(r)
+		if err != nil {
+			return err
+		}
+		var buf bytes.Buffer
+		enc := json.NewEncoder(&buf)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(v); err != nil {
+			return err
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, err = buf.WriteTo(w)
+		return err
+	}
+}
+
+// checkAPIToken returns an error if the request does not carry the
+// configured API token. If no token is configured, the API is disabled.
+func (s *Server) checkAPIToken(r *http.Request) error {
+	if s.cfg.APIToken == "" {
+		return &serverError{
+			status: http.StatusForbidden,
+			err:    errors.New("API disabled: no API token configured"),
+		}
+	}
+	got := r.Header.Get(apiTokenHeader)
+	if subtle.ConstantTimeCompare([]byte(got), []byte(s.cfg.APIToken)) != 1 {
+		return &serverError{
+			status: http.StatusUnauthorized,
+			err:    fmt.Errorf("missing or invalid %s header", apiTokenHeader),
+		}
+	}
+	return nil
+}
+
+func (s *Server) apiUpdates(r *http.Request) (any, error) {
+	limit := apiDefaultUpdates
+	if l := r.FormValue("limit"); l != "" {
+		n, err := strconv.Atoi(l)
+		if err != nil || n <= 0 || n > apiMaxUpdates {
+			return nil, &serverError{
+				status: http.StatusBadRequest,
+				err:    fmt.Errorf("limit must be an integer between 1 and %d, got %q", apiMaxUpdates, l),
+			}
+		}
+		limit = n
+	}
+	urs, err := s.cfg.Store.ListCommitUpdateRecords(r.Context(), limit)
+	if err != nil {
+		return nil, err
+	}
+	updates := []*apiUpdate{}
+	for _, ur := range urs {
+		updates = append(updates, &apiUpdate{
+			StartedAt:    ur.StartedAt,
+			EndedAt:      nonZeroTime(ur.EndedAt),
+			CommitHash:   ur.CommitHash,
+			CommitTime:   nonZeroTime(ur.CommitTime),
+			NumTotal:     ur.NumTotal,
+			NumProcessed: ur.NumProcessed,
+			NumAdded:     ur.NumAdded,
+			NumModified:  ur.NumModified,
+			Error:        ur.Error,
+		})
+	}
+	return updates, nil
+}
+
+func (s *Server) apiCVEs(r *http.Request) (any, error) {
+	ts := store.TriageState(r.FormValue("triage_state"))
+	if err := ts.Validate(); err != nil {
+		return nil, &serverError{status: http.StatusBadRequest, err: err}
+	}
+	crs, err := s.cfg.Store.ListCVE4RecordsWithTriageState(r.Context(), ts)
+	if err != nil {
+		return nil, err
+	}
+	cves := []*apiCVE{}
+	for _, cr := range crs {
+		cves = append(cves, newAPICVE(cr))
+	}
+	return cves, nil
+}
+
+func (s *Server) apiPendingIssues(r *http.Request) (any, error) {
+	ctx := r.Context()
+	pending := []*apiPendingIssue{}
+	crs, err := s.cfg.Store.ListCVE4RecordsWithTriageState(ctx, store.TriageStateNeedsIssue)
+	if err != nil {
+		return nil, err
+	}
+	for _, cr := range crs {
+		pending = append(pending, &apiPendingIssue{
+			ID:                cr.ID,
+			Module:            cr.Module,
+			TriageState:       cr.TriageState,
+			TriageStateReason: cr.TriageStateReason,
+		})
+	}
+	var grs []*store.LegacyGHSARecord
+	if err := s.cfg.Store.RunTransaction(ctx, func(ctx context.Context, tx store.Transaction) error {
+		var err error
+		grs, err = tx.GetLegacyGHSARecords()
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	for _, gr := range grs {
+		if gr.TriageState != store.TriageStateNeedsIssue {
+			continue
+		}
+		var module string
+		if len(gr.GHSA.Vulns) > 0 {
+			module = gr.GetUnit()
+		}
+		pending = append(pending, &apiPendingIssue{
+			ID:                gr.GetID(),
+			Module:            module,
+			TriageState:       gr.TriageState,
+			TriageStateReason: gr.TriageStateReason,
+		})
+	}
+	return pending, nil
+}
+
+// An apiUpdate is the summary of a CommitUpdateRecord returned
+// by /api/updates. EndedAt is unset for an update in progress.
+type apiUpdate struct {
+	StartedAt    time.Time  `json:"started_at"`
+	EndedAt      *time.Time `json:"ended_at,omitempty"`
+	CommitHash   string     `json:"commit_hash"`
+	CommitTime   *time.Time `json:"commit_time,omitempty"`
+	NumTotal     int        `json:"num_total"`
+	NumProcessed int        `json:"num_processed"`
+	NumAdded     int        `json:"num_added"`
+	NumModified  int        `json:"num_modified"`
+	Error        string     `json:"error,omitempty"`
+}
+
+// An apiCVE is the summary of a CVE4Record returned by /api/cves.
+type apiCVE struct {
+	ID                string            `json:"id"`
+	CVEState          string            `json:"cve_state"`
+	TriageState       store.TriageState `json:"triage_state"`
+	TriageStateReason string            `json:"triage_state_reason,omitempty"`
+	Module            string            `json:"module,omitempty"`
+	Package           string            `json:"package,omitempty"`
+	CommitHash        string            `json:"commit_hash"`
+	CommitTime        *time.Time        `json:"commit_time,omitempty"`
+	IssueReference    string            `json:"issue_reference,omitempty"`
+	IssueCreatedAt    *time.Time        `json:"issue_created_at,omitempty"`
+}
+
+func newAPICVE(r *store.CVE4Record) *apiCVE {
+	return &apiCVE{
+		ID:                r.ID,
+		CVEState:          r.CVEState,
+		TriageState:       r.TriageState,
+		TriageStateReason: r.TriageStateReason,
+		Module:            r.Module,
+		Package:           r.Package,
+		CommitHash:        r.CommitHash,
+		CommitTime:        nonZeroTime(r.CommitTime),
+		IssueReference:    r.IssueReference,
+		IssueCreatedAt:    nonZeroTime(r.IssueCreatedAt),
+	}
+}
+
+// An apiPendingIssue is a CVE or GHSA returned by /api/issues/pending.
+type apiPendingIssue struct {
+	ID                string            `json:"id"`
+	Module            string            `json:"module,omitempty"`
+	TriageState       store.TriageState `json:"triage_state"`
+	TriageStateReason string            `json:"triage_state_reason,omitempty"`
+}
+
+func nonZeroTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
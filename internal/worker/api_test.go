@@ -0,0 +1,146 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/vulndb/internal/ghsa"
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+func TestAPI(t *testing.T) {
+	ctx := context.Background()
+	mstore := store.NewMemStore()
+	commitTime := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	cve := func(id string, ts store.TriageState) *store.CVE4Record {
+		return &store.CVE4Record{
+			ID:          id,
+			Path:        id + ".json",
+			BlobHash:    "bh",
+			CommitHash:  "ch",
+			CommitTime:  commitTime,
+			CVEState:    "PUBLIC",
+			Module:      "example.com/" + id,
+			TriageState: ts,
+		}
+	}
+	createCVE4Records(t, mstore, []*store.CVE4Record{
+		cve("CVE-2026-0001", store.TriageStateNeedsIssue),
+		cve("CVE-2026-0002", store.TriageStateNoActionNeeded),
+	})
+	createLegacyGHSARecords(t, mstore, []*store.LegacyGHSARecord{
+		{
+			GHSA:        &ghsa.SecurityAdvisory{ID: "GHSA-xxxx-yyyy-zzzz", Vulns: []*ghsa.Vuln{{Package: "example.com/g"}}},
+			TriageState: store.TriageStateNeedsIssue,
+		},
+		{
+			GHSA:        &ghsa.SecurityAdvisory{ID: "GHSA-aaaa-bbbb-cccc"},
+			TriageState: store.TriageStateIssueCreated,
+		},
+	})
+	for i, hash := range []string{"c1", "c2"} {
+		if err := mstore.CreateCommitUpdateRecord(ctx, &store.CommitUpdateRecord{
+			StartedAt:  commitTime.Add(time.Duration(i) * time.Hour),
+			CommitHash: hash,
+			CommitTime: commitTime,
+			NumTotal:   2,
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	const token = "secret"
+	s := &Server{cfg: Config{Store: mstore, APIToken: token}}
+	serve := func(s *Server, f func(*http.Request) (any, error), method, target, tok string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, target, nil)
+		if tok != "" {
+			req.Header.Set(apiTokenHeader, tok)
+		}
+		w := httptest.NewRecorder()
+		if err := s.api(f)(w, req); err != nil {
+			s.serveError(ctx, w, req, err)
+		}
+		return w
+	}
+	get := func(t *testing.T, f func(*http.Request) (any, error), target string, v any) {
+		t.Helper()
+		w := serve(s, f, http.MethodGet, target, token)
+		if w.Code != http.StatusOK {
+			t.Fatalf("GET %s: got status %d, want 200: %s", target, w.Code, w.Body)
+		}
+		if got := w.Header().Get("Content-Type"); got != "application/json" {
+			t.Errorf("GET %s: Content-Type = %q", target, got)
+		}
+		if err := json.Unmarshal(w.Body.Bytes(), v); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	t.Run("updates", func(t *testing.T) {
+		var got []*apiUpdate
+		get(t, s.apiUpdates, "/api/updates?limit=1", &got)
+		if len(got) != 1 || got[0].CommitHash != "c2" || got[0].EndedAt != nil {
+			t.Errorf("got %+v, want the most recent update, in progress", got)
+		}
+	})
+
+	t.Run("cves", func(t *testing.T) {
+		var got []*apiCVE
+		get(t, s.apiCVEs, "/api/cves?triage_state=NoActionNeeded", &got)
+		want := []*apiCVE{{
+			ID:          "CVE-2026-0002",
+			CVEState:    "PUBLIC",
+			TriageState: store.TriageStateNoActionNeeded,
+			Module:      "example.com/CVE-2026-0002",
+			CommitHash:  "ch",
+			CommitTime:  &commitTime,
+		}}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("mismatch (-want, +got):\n%s", diff)
+		}
+	})
+
+	t.Run("pending", func(t *testing.T) {
+		var got []*apiPendingIssue
+		get(t, s.apiPendingIssues, "/api/issues/pending", &got)
+		want := []*apiPendingIssue{
+			{ID: "CVE-2026-0001", Module: "example.com/CVE-2026-0001", TriageState: store.TriageStateNeedsIssue},
+			{ID: "GHSA-xxxx-yyyy-zzzz", Module: "example.com/g", TriageState: store.TriageStateNeedsIssue},
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("mismatch (-want, +got):\n%s", diff)
+		}
+	})
+
+	for _, test := range []struct {
+		name   string
+		s      *Server
+		f      func(*http.Request) (any, error)
+		method string
+		target string
+		token  string
+		want   int
+	}{
+		{"no token", s, s.apiCVEs, http.MethodGet, "/api/cves?triage_state=NeedsIssue", "", http.StatusUnauthorized},
+		{"wrong token", s, s.apiCVEs, http.MethodGet, "/api/cves?triage_state=NeedsIssue", "wrong", http.StatusUnauthorized},
+		{"disabled", &Server{cfg: Config{Store: mstore}}, s.apiCVEs, http.MethodGet, "/api/cves?triage_state=NeedsIssue", token, http.StatusForbidden},
+		{"post", s, s.apiCVEs, http.MethodPost, "/api/cves?triage_state=NeedsIssue", token, http.StatusMethodNotAllowed},
+		{"bad triage state", s, s.apiCVEs, http.MethodGet, "/api/cves?triage_state=Bogus", token, http.StatusBadRequest},
+		{"bad limit", s, s.apiUpdates, http.MethodGet, "/api/updates?limit=0", token, http.StatusBadRequest},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			if w := serve(test.s, test.f, test.method, test.target, test.token); w.Code != test.want {
+				t.Errorf("got status %d, want %d", w.Code, test.want)
+			}
+		})
+	}
+}
@@ -31,6 +31,29 @@ type Config struct {
 	// GitHubAccessToken is the token needed to authorize to the GitHub API.
 	GitHubAccessToken string
 
+	// NVDAPIKey is the key for the NVD API. It is optional, but
+	// requests made without it are heavily rate limited.
+	NVDAPIKey string
+
+	// APIToken is the token that requests to the JSON API under /api/
+	// must present. An empty string disables the API.
+	APIToken string
+
+	// ConfigFile, if non-empty, is a JSON file holding a
+	// store.WorkerConfig. The server loads it into the store at
+	// startup and on each request to /reload-config.
+	ConfigFile string
+
+	// VulnDBURL is the URL of the Go vulnerability database whose
+	// added and modified entries are sent to the notification targets.
+	// If empty, DefaultVulnDBURL is used.
+	VulnDBURL string
+
+	// DBBucket is the Cloud Storage bucket that /regenerate-db publishes
+	// the vulnerability database to. An empty string disables publishing;
+	// the database is still generated and validated.
+	DBBucket string
+
 	// Store is the implementation of store.Store used by the server.
 	Store store.Store
 }
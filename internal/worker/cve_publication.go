@@ -0,0 +1,128 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package worker
+
+import (
+	"context"
+	"time"
+
+	"golang.org/x/vulndb/internal/cve5"
+	"golang.org/x/vulndb/internal/derrors"
+	"golang.org/x/vulndb/internal/observe"
+	"golang.org/x/vulndb/internal/report"
+	"golang.org/x/vulndb/internal/worker/log"
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+type SyncCVEPublicationsStats struct {
+	// Number of CVEs synced.
+	NumSynced int
+	// Number of CVEs whose publication state changed.
+	NumChanged int
+	// Number of CVEs that need attention (see
+	// store.CVEPublicationRecord.NeedsAttention).
+	NumNeedsAttention int
+}
+
+// SyncCVEPublications records, in a CVEPublicationRecord for each
+// report with cve_metadata, the state of the publication of the
+// report's CVE record according to published: whether the CVE is
+// reserved, rejected or published and, if it is published, whether the
+// record matches the record generated from the report (ignoring fields
+// set by CVE Services, as ReconcileCVEs does).
+func SyncCVEPublications(ctx context.Context, published CVERecordFunc, rc *report.Client, st store.Store) (stats SyncCVEPublicationsStats, err error) {
+	defer derrors.Wrap(&err, "SyncCVEPublications")
+	ctx, span := observe.Start(ctx, "SyncCVEPublications")
+	defer span.End()
+
+	old, err := st.ListCVEPublicationRecords(ctx)
+	if err != nil {
+		return stats, err
+	}
+	prev := make(map[string]*store.CVEPublicationRecord)
+	for _, r := range old {
+		prev[r.CVE] = r
+	}
+
+	now := time.Now()
+	var rs []*store.CVEPublicationRecord
+	for _, r := range rc.List() {
+		if r.CVEMetadata == nil {
+			continue
+		}
+		current, err := published(ctx, r.CVEMetadata.ID)
+		if err != nil {
+			return stats, err
+		}
+		pr, err := newCVEPublicationRecord(r, current)
+		if err != nil {
+			return stats, err
+		}
+		pr.SyncedAt = now
+		pr.StateChangedAt = now
+		if p, ok := prev[pr.CVE]; ok && p.State == pr.State {
+			pr.StateChangedAt = p.StateChangedAt
+		} else {
+			stats.NumChanged++
+		}
+		if pr.NeedsAttention() {
+			log.Warningf(ctx, "%s (%s): %s", pr.CVE, pr.ReportID, pr.State)
+			stats.NumNeedsAttention++
+		}
+		rs = append(rs, pr)
+	}
+	if err := st.SetCVEPublicationRecords(ctx, rs); err != nil {
+		return stats, err
+	}
+	stats.NumSynced = len(rs)
+	log.Infof(ctx, "CVE publication sync succeeded: %+v", stats)
+	return stats, nil
+}
+
+// newCVEPublicationRecord returns the CVEPublicationRecord of the
+// CVE of r, whose current record (nil if it has none) is current.
+// Only the times are left unset.
+func newCVEPublicationRecord(r *report.Report, current *cve5.CVERecord) (*store.CVEPublicationRecord, error) {
+	pr := &store.CVEPublicationRecord{
+		CVE:       r.CVEMetadata.ID,
+		ReportID:  r.ID,
+		Withdrawn: r.Withdrawn != nil,
+	}
+	if current == nil {
+		pr.State = store.CVEPublicationReserved
+		return pr, nil
+	}
+	updated, err := current.Metadata.UpdatedTime()
+	if err != nil {
+		return nil, err
+	}
+	pr.RecordUpdated = updated
+	switch current.Metadata.State {
+	case cve5.StateReserved:
+		pr.State = store.CVEPublicationReserved
+		return pr, nil
+	case cve5.StateRejected:
+		pr.State = store.CVEPublicationRejected
+		return pr, nil
+	}
+	pr.State = store.CVEPublicationPublished
+	if !current.IsGoAssigned() {
+		// Don't compare the record with the one generated from
+		// the report, which should not exist.
+		pr.Owner = current.OwnerOrgID()
+		return pr, nil
+	}
+	generated, err := cve5.FromReport(r)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range cve5.Drift(generated, current) {
+		pr.DraftParts = append(pr.DraftParts, p.Part)
+	}
+	if len(pr.DraftParts) > 0 {
+		pr.State = store.CVEPublicationDraft
+	}
+	return pr, nil
+}
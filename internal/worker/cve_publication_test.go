@@ -0,0 +1,109 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package worker
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"golang.org/x/vulndb/internal/cve5"
+	"golang.org/x/vulndb/internal/osv"
+	"golang.org/x/vulndb/internal/report"
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+func TestSyncCVEPublications(t *testing.T) {
+	ctx := context.Background()
+
+	newReport := func(id, cve, description string) *report.Report {
+		return &report.Report{
+			ID:          id,
+			Summary:     "A problem with example.com/module",
+			Description: report.Description(description),
+			Modules:     []*report.Module{{Module: "example.com/module", Packages: []*report.Package{{Package: "example.com/module"}}}},
+			CVEMetadata: &report.CVEMeta{ID: cve, CWE: "CWE-400: Uncontrolled Resource Consumption"},
+		}
+	}
+	withdrawn := newReport("GO-1999-0005", "CVE-1999-0005", "A description.")
+	withdrawn.Withdrawn = &osv.Time{Time: time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)}
+	reports := map[string]*report.Report{
+		"data/reports/GO-1999-0001.yaml": newReport("GO-1999-0001", "CVE-1999-0001", "A description."),
+		"data/reports/GO-1999-0002.yaml": newReport("GO-1999-0002", "CVE-1999-0002", "A new description."),
+		"data/reports/GO-1999-0003.yaml": newReport("GO-1999-0003", "CVE-1999-0003", "A description."),
+		"data/reports/GO-1999-0004.yaml": newReport("GO-1999-0004", "CVE-1999-0004", "A description."),
+		"data/reports/GO-1999-0005.yaml": withdrawn,
+		// Not a Go CNA report.
+		"data/reports/GO-1999-0006.yaml": {ID: "GO-1999-0006", CVEs: []string{"CVE-1999-0006"}},
+	}
+	rc, err := report.NewTestClient(reports)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const dateUpdated = "2024-01-01T00:00:00"
+	updated := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	published := map[string]*cve5.CVERecord{}
+	for _, fname := range []string{"data/reports/GO-1999-0001.yaml", "data/reports/GO-1999-0002.yaml"} {
+		r := *reports[fname]
+		r.Description = "A description."
+		c, err := cve5.FromReport(&r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		c.Metadata.OrgID, c.Metadata.Serial, c.Metadata.State = cve5.GoOrgUUID, 2, cve5.StatePublished
+		c.Metadata.DateUpdated = dateUpdated
+		published[c.Metadata.ID] = c
+	}
+	// CVE-1999-0003 is reserved, CVE-1999-0004 has no record
+	// and CVE-1999-0005 is rejected.
+	published["CVE-1999-0003"] = &cve5.CVERecord{Metadata: cve5.Metadata{ID: "CVE-1999-0003", State: cve5.StateReserved}}
+	published["CVE-1999-0005"] = &cve5.CVERecord{Metadata: cve5.Metadata{ID: "CVE-1999-0005", State: cve5.StateRejected, DateUpdated: dateUpdated}}
+	fetch := func(_ context.Context, id string) (*cve5.CVERecord, error) {
+		return published[id], nil
+	}
+
+	// CVE-1999-0001 was already published at the last sync.
+	mstore := store.NewMemStore()
+	lastSync := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+	if err := mstore.SetCVEPublicationRecords(ctx, []*store.CVEPublicationRecord{
+		{CVE: "CVE-1999-0001", ReportID: "GO-1999-0001", State: store.CVEPublicationPublished, StateChangedAt: lastSync, SyncedAt: lastSync},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	start := time.Now()
+	gotStats, err := SyncCVEPublications(ctx, fetch, rc, mstore)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (SyncCVEPublicationsStats{NumSynced: 5, NumChanged: 4, NumNeedsAttention: 3}); gotStats != want {
+		t.Errorf("got stats %+v, want %+v", gotStats, want)
+	}
+	got, err := mstore.ListCVEPublicationRecords(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, r := range got {
+		if r.SyncedAt.Before(start) {
+			t.Errorf("%s: SyncedAt = %s, want after %s", r.CVE, r.SyncedAt, start)
+		}
+	}
+	if got[0].StateChangedAt != lastSync {
+		t.Errorf("%s: StateChangedAt = %s, want unchanged (%s)", got[0].CVE, got[0].StateChangedAt, lastSync)
+	}
+	want := []*store.CVEPublicationRecord{
+		{CVE: "CVE-1999-0001", ReportID: "GO-1999-0001", State: store.CVEPublicationPublished, RecordUpdated: updated},
+		{CVE: "CVE-1999-0002", ReportID: "GO-1999-0002", State: store.CVEPublicationDraft, DraftParts: []string{"descriptions"}, RecordUpdated: updated},
+		{CVE: "CVE-1999-0003", ReportID: "GO-1999-0003", State: store.CVEPublicationReserved},
+		{CVE: "CVE-1999-0004", ReportID: "GO-1999-0004", State: store.CVEPublicationReserved},
+		{CVE: "CVE-1999-0005", ReportID: "GO-1999-0005", Withdrawn: true, State: store.CVEPublicationRejected, RecordUpdated: updated},
+	}
+	if diff := cmp.Diff(want, got, cmpopts.IgnoreFields(store.CVEPublicationRecord{}, "StateChangedAt", "SyncedAt")); diff != "" {
+		t.Errorf("mismatch (-want, +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package worker
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"golang.org/x/vulndb/internal/derrors"
+	"golang.org/x/vulndb/internal/epss"
+	"golang.org/x/vulndb/internal/observe"
+	"golang.org/x/vulndb/internal/report"
+	"golang.org/x/vulndb/internal/worker/log"
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+// An EPSSFetchFunc fetches the current EPSS scores of the given CVEs.
+type EPSSFetchFunc func(context.Context, []string) (map[string]*epss.Score, error)
+
+// RefreshEPSSStats are statistics about a run of RefreshEPSS.
+type RefreshEPSSStats struct {
+	// Number of reports with CVEs.
+	NumReports int
+	// Number of those reports with a CVE that EPSS has scored.
+	NumScored int
+	// Number of scored reports whose recorded score is missing
+	// or out of date.
+	NumStale int
+}
+
+// RefreshEPSS fetches the current EPSS scores of the CVEs of the reports
+// in rc that are not excluded or withdrawn, and records the highest score
+// of each report in an EPSSRecord, along with whether the score recorded
+// in the report (see report.EPSS) is out of date. Stale reports should be
+// updated with vulnreport enrich.
+func RefreshEPSS(ctx context.Context, fetch EPSSFetchFunc, rc *report.Client, st store.Store) (stats RefreshEPSSStats, err error) {
+	defer derrors.Wrap(&err, "RefreshEPSS")
+	ctx, span := observe.Start(ctx, "RefreshEPSS")
+	defer span.End()
+
+	var (
+		reports []*report.Report
+		cves    []string
+	)
+	for _, r := range rc.List() {
+		if r.IsExcluded() || r.Withdrawn != nil || len(r.AllCVEs()) == 0 {
+			continue
+		}
+		reports = append(reports, r)
+		cves = append(cves, r.AllCVEs()...)
+	}
+	stats.NumReports = len(reports)
+	slices.Sort(cves)
+	scores, err := fetch(ctx, slices.Compact(cves))
+	if err != nil {
+		return stats, err
+	}
+
+	now := time.Now()
+	var rs []*store.EPSSRecord
+	for _, r := range reports {
+		s := epss.Max(scores, r.AllCVEs())
+		if s == nil {
+			continue
+		}
+		stats.NumScored++
+		er := &store.EPSSRecord{
+			ReportID:   r.ID,
+			CVE:        s.CVE,
+			Score:      s.EPSS,
+			Percentile: s.Percentile,
+			Date:       s.Date,
+			Stale:      r.EPSS.Outdated(s),
+			SyncedAt:   now,
+		}
+		if er.Stale {
+			stats.NumStale++
+		}
+		rs = append(rs, er)
+	}
+	if err := st.SetEPSSRecords(ctx, rs); err != nil {
+		return stats, err
+	}
+	log.Infof(ctx, "EPSS refresh succeeded: %+v", stats)
+	return stats, nil
+}
@@ -0,0 +1,92 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package worker
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"golang.org/x/vulndb/internal/epss"
+	"golang.org/x/vulndb/internal/osv"
+	"golang.org/x/vulndb/internal/report"
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+func TestRefreshEPSS(t *testing.T) {
+	ctx := context.Background()
+
+	newReport := func(id string, cves ...string) *report.Report {
+		return &report.Report{
+			ID:      id,
+			CVEs:    cves,
+			Modules: []*report.Module{{Module: "example.com/module"}},
+		}
+	}
+	enriched := newReport("GO-1999-0002", "CVE-1999-0002")
+	enriched.EPSS = &report.EPSS{CVE: "CVE-1999-0002", Score: 0.5, Percentile: 0.95, Date: "2024-02-01"}
+	outdated := newReport("GO-1999-0003", "CVE-1999-0003")
+	outdated.EPSS = &report.EPSS{CVE: "CVE-1999-0003", Score: 0.01, Percentile: 0.5, Date: "2024-02-01"}
+	withdrawn := newReport("GO-1999-0005", "CVE-1999-0005")
+	withdrawn.Withdrawn = &osv.Time{Time: time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)}
+	rc, err := report.NewTestClient(map[string]*report.Report{
+		"data/reports/GO-1999-0001.yaml": newReport("GO-1999-0001", "CVE-1999-0001", "CVE-1999-0011"),
+		"data/reports/GO-1999-0002.yaml": enriched,
+		"data/reports/GO-1999-0003.yaml": outdated,
+		// Not scored.
+		"data/reports/GO-1999-0004.yaml": newReport("GO-1999-0004", "CVE-1999-0004"),
+		"data/reports/GO-1999-0005.yaml": withdrawn,
+		// No CVEs.
+		"data/reports/GO-1999-0006.yaml": newReport("GO-1999-0006"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	score := func(cve string, s, p float64) *epss.Score {
+		return &epss.Score{CVE: cve, EPSS: s, Percentile: p, Date: "2024-03-01"}
+	}
+	scores := map[string]*epss.Score{
+		"CVE-1999-0001": score("CVE-1999-0001", 0.1, 0.8),
+		"CVE-1999-0011": score("CVE-1999-0011", 0.2, 0.9),
+		"CVE-1999-0002": score("CVE-1999-0002", 0.505, 0.96),
+		"CVE-1999-0003": score("CVE-1999-0003", 0.3, 0.97),
+		"CVE-1999-0005": score("CVE-1999-0005", 0.9, 0.99),
+	}
+	var requested []string
+	fetch := func(_ context.Context, cves []string) (map[string]*epss.Score, error) {
+		requested = cves
+		return scores, nil
+	}
+
+	mstore := store.NewMemStore()
+	stats, err := RefreshEPSS(ctx, fetch, rc, mstore)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantStats := RefreshEPSSStats{NumReports: 4, NumScored: 3, NumStale: 2}
+	if stats != wantStats {
+		t.Errorf("stats = %+v, want %+v", stats, wantStats)
+	}
+	wantRequested := []string{"CVE-1999-0001", "CVE-1999-0002", "CVE-1999-0003", "CVE-1999-0004", "CVE-1999-0011"}
+	if diff := cmp.Diff(wantRequested, requested); diff != "" {
+		t.Errorf("requested CVEs mismatch (-want, +got):\n%s", diff)
+	}
+
+	got, err := mstore.ListEPSSRecords(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*store.EPSSRecord{
+		{ReportID: "GO-1999-0001", CVE: "CVE-1999-0011", Score: 0.2, Percentile: 0.9, Date: "2024-03-01", Stale: true},
+		{ReportID: "GO-1999-0002", CVE: "CVE-1999-0002", Score: 0.505, Percentile: 0.96, Date: "2024-03-01"},
+		{ReportID: "GO-1999-0003", CVE: "CVE-1999-0003", Score: 0.3, Percentile: 0.97, Date: "2024-03-01", Stale: true},
+	}
+	if diff := cmp.Diff(want, got, cmpopts.IgnoreFields(store.EPSSRecord{}, "SyncedAt")); diff != "" {
+		t.Errorf("records mismatch (-want, +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,282 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package worker
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"golang.org/x/vulndb/internal/derrors"
+	"golang.org/x/vulndb/internal/issues"
+	"golang.org/x/vulndb/internal/observe"
+	"golang.org/x/vulndb/internal/report"
+	"golang.org/x/vulndb/internal/worker/log"
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+// FindingStats are aggregated statistics of the findings of govulncheck
+// runs during a period, as reported by govulncheck telemetry.
+type FindingStats struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+	// Runs is the number of govulncheck runs aggregated.
+	Runs int `json:"runs"`
+	// Vulns are the findings for each vulnerability. Vulnerabilities
+	// that were never found may be missing.
+	Vulns []*VulnFindings `json:"vulns"`
+}
+
+// VulnFindings are the number of govulncheck runs that found a
+// vulnerability at each scan level.
+type VulnFindings struct {
+	// ID is the ID of the vulnerability, e.g. "GO-2024-0001".
+	ID string `json:"id"`
+	// Module is the number of runs that found the vulnerable module
+	// versions in the build list.
+	Module int `json:"module"`
+	// Package is the number of runs that found a vulnerable
+	// package imported.
+	Package int `json:"package"`
+	// Symbol is the number of runs that found a vulnerable
+	// symbol called.
+	Symbol int `json:"symbol"`
+}
+
+// ReadFindingStats reads FindingStats from src, which is either
+// an http(s) URL or a file.
+func ReadFindingStats(ctx context.Context, hc *http.Client, src string) (_ *FindingStats, err error) {
+	defer derrors.Wrap(&err, "ReadFindingStats(%s)", src)
+
+	var data []byte
+	if strings.HasPrefix(src, "https://") || strings.HasPrefix(src, "http://") {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, src, nil)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := hc.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("HTTP status %s", resp.Status)
+		}
+		if data, err = io.ReadAll(resp.Body); err != nil {
+			return nil, err
+		}
+	} else if data, err = os.ReadFile(src); err != nil {
+		return nil, err
+	}
+	var fs FindingStats
+	if err := json.Unmarshal(data, &fs); err != nil {
+		return nil, err
+	}
+	if fs.Start.IsZero() || !fs.End.After(fs.Start) {
+		return nil, fmt.Errorf("invalid period %s to %s", fs.Start, fs.End)
+	}
+	return &fs, nil
+}
+
+const (
+	// A report is over-matched if its module versions were found
+	// more than overMatchFactor times as often as the median report's
+	// (among those found at all), and at least minFindings times.
+	overMatchFactor = 10
+	// A report with symbols is never called if its packages were
+	// imported at least minFindings times, but its symbols never called.
+	minFindings = 100
+)
+
+// ImportFindingStatsStats are statistics about a run of ImportFindingStats.
+type ImportFindingStatsStats struct {
+	// Number of reports whose findings were imported.
+	NumReports int
+	// Number of reports with an anomaly, by anomaly.
+	NumAnomalies map[store.FindingAnomaly]int
+	// Number of reports with an anomaly they did not have before.
+	NumNewAnomalies int
+	// Number of re-review issues filed.
+	NumIssues int
+}
+
+// ImportFindingStats records the findings in fs for each report that is
+// published, not excluded or withdrawn, and was published before the
+// period of fs started, in a FindingStatsRecord. It flags reports whose
+// findings suggest they are wrong (see store.FindingAnomaly): reports
+// that are found unusually often may have version ranges that are too
+// broad, and reports that are never found, or never called, may have
+// the wrong ranges or symbols.
+//
+// If client is not nil, ImportFindingStats files an issue, labeled
+// "needs re-review", for each flagged report that does not have one,
+// up to maxIssues (if positive). The most often found reports get their
+// issues first, so that the most consumed reports get the most scrutiny.
+func ImportFindingStats(ctx context.Context, fs *FindingStats, rc *report.Client, st store.Store, client *issues.Client, maxIssues int) (stats ImportFindingStatsStats, err error) {
+	defer derrors.Wrap(&err, "ImportFindingStats")
+	ctx, span := observe.Start(ctx, "ImportFindingStats")
+	defer span.End()
+
+	old, err := st.ListFindingStatsRecords(ctx)
+	if err != nil {
+		return stats, err
+	}
+	prev := make(map[string]*store.FindingStatsRecord)
+	for _, r := range old {
+		if r.PeriodEnd.After(fs.End) {
+			return stats, fmt.Errorf("already imported statistics up to %s, after the end of these (%s)",
+				r.PeriodEnd.Format(time.DateOnly), fs.End.Format(time.DateOnly))
+		}
+		prev[r.ReportID] = r
+	}
+	findings := make(map[string]*VulnFindings)
+	for _, f := range fs.Vulns {
+		findings[f.ID] = f
+	}
+
+	now := time.Now()
+	var (
+		rs     []*store.FindingStatsRecord
+		counts []int
+	)
+	reports := make(map[string]*report.Report)
+	for _, r := range rc.List() {
+		if r.Published.IsZero() || !r.Published.Before(fs.Start) || r.IsExcluded() || r.Withdrawn != nil {
+			continue
+		}
+		reports[r.ID] = r
+		fr := &store.FindingStatsRecord{
+			ReportID:    r.ID,
+			PeriodStart: fs.Start,
+			PeriodEnd:   fs.End,
+			ImportedAt:  now,
+		}
+		if f := findings[r.ID]; f != nil {
+			fr.ModuleFindings, fr.PackageFindings, fr.SymbolFindings = f.Module, f.Package, f.Symbol
+		}
+		if fr.ModuleFindings > 0 {
+			counts = append(counts, fr.ModuleFindings)
+		}
+		rs = append(rs, fr)
+	}
+	median := 0
+	if len(counts) > 0 {
+		slices.Sort(counts)
+		median = counts[len(counts)/2]
+	}
+
+	stats.NumReports = len(rs)
+	stats.NumAnomalies = make(map[store.FindingAnomaly]int)
+	var flagged []*store.FindingStatsRecord
+	for _, fr := range rs {
+		fr.Anomaly = findingAnomaly(fr, reports[fr.ReportID], median)
+		if fr.Anomaly == store.FindingAnomalyNone {
+			continue
+		}
+		stats.NumAnomalies[fr.Anomaly]++
+		if p := prev[fr.ReportID]; p != nil && p.Anomaly == fr.Anomaly {
+			fr.FlaggedAt = p.FlaggedAt
+			fr.IssueReference = p.IssueReference
+		} else {
+			fr.FlaggedAt = now
+			stats.NumNewAnomalies++
+			log.Warningf(ctx, "%s: findings anomaly %s (module %d, package %d, symbol %d)",
+				fr.ReportID, fr.Anomaly, fr.ModuleFindings, fr.PackageFindings, fr.SymbolFindings)
+		}
+		if fr.IssueReference == "" {
+			flagged = append(flagged, fr)
+		}
+	}
+
+	if client != nil {
+		slices.SortStableFunc(flagged, func(a, b *store.FindingStatsRecord) int {
+			return cmp.Compare(b.ModuleFindings, a.ModuleFindings)
+		})
+		for _, fr := range flagged {
+			if maxIssues > 0 && stats.NumIssues >= maxIssues {
+				break
+			}
+			ref, err := createFindingsIssue(ctx, client, fr, median)
+			if err != nil {
+				return stats, err
+			}
+			fr.IssueReference = ref
+			stats.NumIssues++
+		}
+	}
+	if err := st.SetFindingStatsRecords(ctx, rs); err != nil {
+		return stats, err
+	}
+	log.Infof(ctx, "finding stats import succeeded: %+v", stats)
+	return stats, nil
+}
+
+// findingAnomaly returns the anomaly of the findings fr of r,
+// given the median of the module findings of all reports.
+func findingAnomaly(fr *store.FindingStatsRecord, r *report.Report, median int) store.FindingAnomaly {
+	switch {
+	case fr.ModuleFindings == 0:
+		return store.FindingAnomalyNeverMatched
+	case fr.ModuleFindings >= minFindings && fr.ModuleFindings > overMatchFactor*median:
+		return store.FindingAnomalyOverMatched
+	case fr.PackageFindings >= minFindings && fr.SymbolFindings == 0 && hasSymbols(r):
+		return store.FindingAnomalyNeverCalled
+	}
+	return store.FindingAnomalyNone
+}
+
+func hasSymbols(r *report.Report) bool {
+	for _, m := range r.Modules {
+		for _, p := range m.Packages {
+			if len(p.AllSymbols()) > 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// createFindingsIssue files an issue asking for the report of fr to be
+// re-reviewed, and returns a reference to it.
+func createFindingsIssue(ctx context.Context, client *issues.Client, fr *store.FindingStatsRecord, median int) (ref string, err error) {
+	defer derrors.Wrap(&err, "createFindingsIssue(%s)", fr.ReportID)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "From %s to %s, govulncheck found %s in %d runs at the module level, %d at the package level and %d at the symbol level.\n\n",
+		fr.PeriodStart.Format(time.DateOnly), fr.PeriodEnd.Format(time.DateOnly), fr.ReportID,
+		fr.ModuleFindings, fr.PackageFindings, fr.SymbolFindings)
+	switch fr.Anomaly {
+	case store.FindingAnomalyOverMatched:
+		fmt.Fprintf(&b, "That is more than %d times as often as the median report (%d runs), so its version ranges may be too broad.\n", overMatchFactor, median)
+	case store.FindingAnomalyNeverMatched:
+		fmt.Fprintf(&b, "Its module versions were never found, so its module path or version ranges may be wrong.\n")
+	case store.FindingAnomalyNeverCalled:
+		fmt.Fprintf(&b, "Its packages were imported, but its symbols never called, so its symbols may be wrong.\n")
+	}
+	fmt.Fprintf(&b, "\nUpdate the report if needed, or close this issue if it is right.\n")
+
+	iss := &issues.Issue{
+		Title:  fmt.Sprintf("x/vulndb: re-review %s: %s findings", fr.ReportID, strings.ToLower(strings.ReplaceAll(string(fr.Anomaly), "_", "-"))),
+		Body:   b.String(),
+		Labels: []string{labelNeedsReReview},
+	}
+	if err := issueRateLimiter.Wait(ctx); err != nil {
+		return "", err
+	}
+	num, err := client.CreateIssue(ctx, iss)
+	if err != nil {
+		return "", err
+	}
+	ref = client.Reference(num)
+	log.Infof(ctx, "created issue %s to re-review %s", ref, fr.ReportID)
+	return ref, nil
+}
@@ -0,0 +1,209 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/vulndb/internal/issues"
+	"golang.org/x/vulndb/internal/issues/githubtest"
+	"golang.org/x/vulndb/internal/report"
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+func TestImportFindingStats(t *testing.T) {
+	ctx := context.Background()
+
+	published := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	newReport := func(id string, symbols ...string) *report.Report {
+		return &report.Report{
+			ID:        id,
+			Published: published,
+			Modules: []*report.Module{{
+				Module:   "example.com/module",
+				Versions: report.Versions{report.Fixed("1.2.0")},
+				Packages: []*report.Package{{Package: "example.com/module/p", Symbols: symbols}},
+			}},
+		}
+	}
+	recent := newReport("GO-1999-0006")
+	recent.Published = published.AddDate(0, 3, 0)
+	rc, err := report.NewTestClient(map[string]*report.Report{
+		"data/reports/GO-1999-0001.yaml": newReport("GO-1999-0001"),
+		"data/reports/GO-1999-0002.yaml": newReport("GO-1999-0002"),
+		"data/reports/GO-1999-0003.yaml": newReport("GO-1999-0003"),
+		"data/reports/GO-1999-0004.yaml": newReport("GO-1999-0004", "F"),
+		"data/reports/GO-1999-0005.yaml": newReport("GO-1999-0005"),
+		"data/reports/GO-1999-0006.yaml": recent,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	start := published.AddDate(0, 1, 0)
+	end := start.AddDate(0, 1, 0)
+	fs := &FindingStats{
+		Start: start,
+		End:   end,
+		Runs:  100000,
+		Vulns: []*VulnFindings{
+			{ID: "GO-1999-0001", Module: 200, Package: 100, Symbol: 10},
+			{ID: "GO-1999-0002", Module: 300, Package: 0, Symbol: 0},
+			// Found far more often than the others.
+			{ID: "GO-1999-0003", Module: 50000, Package: 40000, Symbol: 100},
+			// Imported, but never called.
+			{ID: "GO-1999-0004", Module: 250, Package: 200, Symbol: 0},
+			// GO-1999-0005 is never found, and GO-1999-0006
+			// was published during the period.
+		},
+	}
+
+	ic, mux := githubtest.Setup(ctx, t, &issues.Config{
+		Owner: githubtest.TestOwner,
+		Repo:  githubtest.TestRepo,
+		Token: githubtest.TestToken,
+	})
+	var filed []*issues.Issue
+	mux.HandleFunc(fmt.Sprintf("/repos/%s/%s/issues", githubtest.TestOwner, githubtest.TestRepo), func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			return
+		}
+		var iss issues.Issue
+		if err := json.NewDecoder(r.Body).Decode(&iss); err != nil {
+			t.Error(err)
+		}
+		filed = append(filed, &iss)
+		fmt.Fprintf(w, `{"number":%d}`, 100+len(filed))
+	})
+
+	mstore := store.NewMemStore()
+	stats, err := ImportFindingStats(ctx, fs, rc, mstore, ic, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantStats := ImportFindingStatsStats{
+		NumReports: 5,
+		NumAnomalies: map[store.FindingAnomaly]int{
+			store.FindingAnomalyOverMatched:  1,
+			store.FindingAnomalyNeverCalled:  1,
+			store.FindingAnomalyNeverMatched: 1,
+		},
+		NumNewAnomalies: 3,
+		NumIssues:       2,
+	}
+	if diff := cmp.Diff(wantStats, stats); diff != "" {
+		t.Errorf("stats mismatch (-want, +got):\n%s", diff)
+	}
+	// The most often found reports get their issues first.
+	var titles []string
+	for _, iss := range filed {
+		titles = append(titles, iss.Title)
+	}
+	wantTitles := []string{
+		"x/vulndb: re-review GO-1999-0003: over-matched findings",
+		"x/vulndb: re-review GO-1999-0004: never-called findings",
+	}
+	if diff := cmp.Diff(wantTitles, titles); diff != "" {
+		t.Errorf("filed issues mismatch (-want, +got):\n%s", diff)
+	}
+
+	got, err := mstore.ListFindingStatsRecords(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	imported := got[0].ImportedAt
+	for _, r := range got {
+		// Anomalies are flagged when they are imported.
+		wantFlagged := imported
+		if r.Anomaly == store.FindingAnomalyNone {
+			wantFlagged = time.Time{}
+		}
+		if r.ImportedAt != imported || r.FlaggedAt != wantFlagged {
+			t.Errorf("%s: ImportedAt = %v, FlaggedAt = %v, want %v and %v", r.ReportID, r.ImportedAt, r.FlaggedAt, imported, wantFlagged)
+		}
+		r.ImportedAt, r.FlaggedAt = time.Time{}, time.Time{}
+	}
+	record := func(id string, module, pkg, symbol int, a store.FindingAnomaly, ref string) *store.FindingStatsRecord {
+		return &store.FindingStatsRecord{
+			ReportID:        id,
+			PeriodStart:     start,
+			PeriodEnd:       end,
+			ModuleFindings:  module,
+			PackageFindings: pkg,
+			SymbolFindings:  symbol,
+			Anomaly:         a,
+			IssueReference:  ref,
+		}
+	}
+	want := []*store.FindingStatsRecord{
+		record("GO-1999-0001", 200, 100, 10, store.FindingAnomalyNone, ""),
+		record("GO-1999-0002", 300, 0, 0, store.FindingAnomalyNone, ""),
+		record("GO-1999-0003", 50000, 40000, 100, store.FindingAnomalyOverMatched, ic.Reference(101)),
+		record("GO-1999-0004", 250, 200, 0, store.FindingAnomalyNeverCalled, ic.Reference(102)),
+		record("GO-1999-0005", 0, 0, 0, store.FindingAnomalyNeverMatched, ""),
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("records mismatch (-want, +got):\n%s", diff)
+	}
+
+	// Importing the same statistics again files the
+	// remaining issue, and no others.
+	stats, err = ImportFindingStats(ctx, fs, rc, mstore, ic, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats.NumNewAnomalies != 0 || stats.NumIssues != 1 {
+		t.Errorf("second import: stats = %+v, want no new anomalies and 1 issue", stats)
+	}
+	if len(filed) != 3 || filed[2].Title != "x/vulndb: re-review GO-1999-0005: never-matched findings" {
+		t.Errorf("second import: filed %d issues, want 1 for GO-1999-0005", len(filed)-2)
+	}
+
+	// Older statistics can't be imported.
+	older := *fs
+	older.Start, older.End = published, start
+	if _, err := ImportFindingStats(ctx, &older, rc, mstore, nil, 0); err == nil {
+		t.Error("importing older statistics: got no error")
+	}
+}
+
+func TestReadFindingStats(t *testing.T) {
+	ctx := context.Background()
+	file := filepath.Join(t.TempDir(), "stats.json")
+	data := `{"start": "2024-01-01T00:00:00Z", "end": "2024-02-01T00:00:00Z", "runs": 10,
+  "vulns": [{"id": "GO-1999-0001", "module": 3, "package": 2, "symbol": 1}]}`
+	if err := os.WriteFile(file, []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+	got, err := ReadFindingStats(ctx, http.DefaultClient, file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &FindingStats{
+		Start: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		End:   time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+		Runs:  10,
+		Vulns: []*VulnFindings{{ID: "GO-1999-0001", Module: 3, Package: 2, Symbol: 1}},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("mismatch (-want, +got):\n%s", diff)
+	}
+
+	if err := os.WriteFile(file, []byte(`{"start": "2024-02-01T00:00:00Z", "end": "2024-01-01T00:00:00Z"}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ReadFindingStats(ctx, http.DefaultClient, file); err == nil {
+		t.Error("invalid period: got no error")
+	}
+}
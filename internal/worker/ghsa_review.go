@@ -0,0 +1,163 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package worker
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"golang.org/x/vulndb/internal/derrors"
+	"golang.org/x/vulndb/internal/ghsa"
+	"golang.org/x/vulndb/internal/issues"
+	"golang.org/x/vulndb/internal/observe"
+	"golang.org/x/vulndb/internal/report"
+	"golang.org/x/vulndb/internal/worker/log"
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+// labelNeedsReReview marks issues filed by SyncGHSAReviews.
+const labelNeedsReReview = "needs re-review"
+
+// GHSAFetchFunc is the type of a function that fetches
+// the GitHub security advisory with the given ID.
+type GHSAFetchFunc func(_ context.Context, ghsaID string) (*ghsa.SecurityAdvisory, error)
+
+// SyncGHSAReviewsStats are statistics about a run of SyncGHSAReviews.
+type SyncGHSAReviewsStats struct {
+	// Number of GHSAs of reviewed reports checked.
+	NumChecked int
+	// Number of GHSAs not updated since their report was published.
+	NumUnchanged int
+	// Number of GHSAs that say more than their report.
+	NumDiverging int
+	// Number of GHSAs that newly diverge from their report.
+	NumNewlyDiverging int
+	// Number of re-review issues filed.
+	NumIssues int
+}
+
+// SyncGHSAReviews compares each GHSA of each published REVIEWED report
+// with the report, and records the result in a GHSAReviewRecord. A GHSA
+// diverges from its report if it has vulnerable version ranges that
+// include versions the report does not consider affected, or links to
+// references the report does not have (see ghsa.Divergences). GHSAs
+// that GitHub has not updated since the report was published are
+// assumed to have been considered when it was reviewed, and are not
+// compared.
+//
+// If client is not nil, SyncGHSAReviews files an issue, labeled
+// "needs re-review", for each diverging GHSA that does not have one.
+func SyncGHSAReviews(ctx context.Context, fetch GHSAFetchFunc, rc *report.Client, st store.Store, client *issues.Client) (stats SyncGHSAReviewsStats, err error) {
+	defer derrors.Wrap(&err, "SyncGHSAReviews")
+	ctx, span := observe.Start(ctx, "SyncGHSAReviews")
+	defer span.End()
+
+	old, err := st.ListGHSAReviewRecords(ctx)
+	if err != nil {
+		return stats, err
+	}
+	prev := make(map[string]*store.GHSAReviewRecord)
+	for _, r := range old {
+		prev[r.Key()] = r
+	}
+
+	now := time.Now()
+	var rs []*store.GHSAReviewRecord
+	for _, r := range rc.List() {
+		if !r.IsReviewed() || r.IsExcluded() || r.Withdrawn != nil {
+			continue
+		}
+		for _, id := range r.GHSAs {
+			stats.NumChecked++
+			sa, err := fetch(ctx, id)
+			if err != nil {
+				return stats, err
+			}
+			gr := &store.GHSAReviewRecord{
+				ReportID:    r.ID,
+				GHSA:        id,
+				GHSAUpdated: sa.UpdatedAt,
+				SyncedAt:    now,
+			}
+			p := prev[gr.Key()]
+			if !r.Published.IsZero() && !sa.UpdatedAt.After(r.Published) {
+				stats.NumUnchanged++
+			} else {
+				d, err := ghsa.Divergences(sa, r)
+				if err != nil {
+					return stats, fmt.Errorf("%s: %w", r.ID, err)
+				}
+				gr.AddedVersions = d.AddedVersions
+				gr.AddedReferences = d.AddedReferences
+			}
+			if gr.Diverges() {
+				stats.NumDiverging++
+				if p != nil && p.Diverges() {
+					gr.DivergedAt = p.DivergedAt
+					gr.IssueReference = p.IssueReference
+				} else {
+					gr.DivergedAt = now
+					stats.NumNewlyDiverging++
+					log.Warningf(ctx, "%s: %s diverges: versions %v, references %v", r.ID, id, gr.AddedVersions, gr.AddedReferences)
+				}
+				if client != nil && gr.IssueReference == "" {
+					ref, err := createReReviewIssue(ctx, client, gr)
+					if err != nil {
+						return stats, err
+					}
+					gr.IssueReference = ref
+					stats.NumIssues++
+				}
+			}
+			rs = append(rs, gr)
+		}
+	}
+	if err := st.SetGHSAReviewRecords(ctx, rs); err != nil {
+		return stats, err
+	}
+	log.Infof(ctx, "GHSA review sync succeeded: %+v", stats)
+	return stats, nil
+}
+
+// createReReviewIssue files an issue asking for the report of gr to be
+// re-reviewed, and returns a reference to it.
+func createReReviewIssue(ctx context.Context, client *issues.Client, gr *store.GHSAReviewRecord) (ref string, err error) {
+	defer derrors.Wrap(&err, "createReReviewIssue(%s)", gr.Key())
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s was updated on %s, after %s was reviewed, and now says more about the vulnerability than the report.\n",
+		gr.GHSA, gr.GHSAUpdated.Format(time.DateOnly), gr.ReportID)
+	if len(gr.AddedVersions) > 0 {
+		fmt.Fprintf(&b, "\nVulnerable version ranges that include versions the report does not consider affected:\n")
+		for _, v := range gr.AddedVersions {
+			fmt.Fprintf(&b, "- `%s`\n", v)
+		}
+	}
+	if len(gr.AddedReferences) > 0 {
+		fmt.Fprintf(&b, "\nReferences that are not in the report:\n")
+		for _, u := range gr.AddedReferences {
+			fmt.Fprintf(&b, "- %s\n", u)
+		}
+	}
+	fmt.Fprintf(&b, "\nUpdate the report if needed, or close this issue if the GHSA is wrong.\n")
+
+	iss := &issues.Issue{
+		Title:  fmt.Sprintf("x/vulndb: re-review %s: %s changed", gr.ReportID, gr.GHSA),
+		Body:   b.String(),
+		Labels: []string{labelNeedsReReview},
+	}
+	if err := issueRateLimiter.Wait(ctx); err != nil {
+		return "", err
+	}
+	num, err := client.CreateIssue(ctx, iss)
+	if err != nil {
+		return "", err
+	}
+	ref = client.Reference(num)
+	log.Infof(ctx, "created issue %s to re-review %s", ref, gr.ReportID)
+	return ref, nil
+}
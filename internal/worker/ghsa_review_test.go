@@ -0,0 +1,166 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/vulndb/internal/ghsa"
+	"golang.org/x/vulndb/internal/issues"
+	"golang.org/x/vulndb/internal/issues/githubtest"
+	"golang.org/x/vulndb/internal/report"
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+func TestSyncGHSAReviews(t *testing.T) {
+	ctx := context.Background()
+
+	published := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	newReport := func(id, ghsaID string) *report.Report {
+		return &report.Report{
+			ID:           id,
+			ReviewStatus: report.Reviewed,
+			Published:    published,
+			GHSAs:        []string{ghsaID},
+			Modules: []*report.Module{{
+				Module:   "example.com/module",
+				Versions: report.Versions{report.Fixed("1.2.0")},
+			}},
+		}
+	}
+	unreviewed := newReport("GO-1999-0004", "GHSA-4444-4444-4444")
+	unreviewed.ReviewStatus = report.Unreviewed
+	rc, err := report.NewTestClient(map[string]*report.Report{
+		"data/reports/GO-1999-0001.yaml": newReport("GO-1999-0001", "GHSA-1111-1111-1111"),
+		"data/reports/GO-1999-0002.yaml": newReport("GO-1999-0002", "GHSA-2222-2222-2222"),
+		"data/reports/GO-1999-0003.yaml": newReport("GO-1999-0003", "GHSA-3333-3333-3333"),
+		"data/reports/GO-1999-0004.yaml": unreviewed,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	later := published.AddDate(0, 1, 0)
+	sas := map[string]*ghsa.SecurityAdvisory{
+		// Agrees with the report.
+		"GHSA-1111-1111-1111": {
+			ID:        "GHSA-1111-1111-1111",
+			UpdatedAt: later,
+			Vulns:     []*ghsa.Vuln{{Package: "example.com/module", VulnerableVersionRange: "< 1.2.0"}},
+		},
+		// Affects more versions than the report, but has not
+		// changed since the report was published.
+		"GHSA-2222-2222-2222": {
+			ID:        "GHSA-2222-2222-2222",
+			UpdatedAt: published,
+			Vulns:     []*ghsa.Vuln{{Package: "example.com/module", VulnerableVersionRange: "< 1.3.0"}},
+		},
+		// Affects more versions than the report, since it was published.
+		"GHSA-3333-3333-3333": {
+			ID:         "GHSA-3333-3333-3333",
+			UpdatedAt:  later,
+			Vulns:      []*ghsa.Vuln{{Package: "example.com/module", VulnerableVersionRange: "< 1.3.0"}},
+			References: []ghsa.Reference{{URL: "https://example.com/issue/1"}},
+		},
+	}
+	fetch := func(_ context.Context, id string) (*ghsa.SecurityAdvisory, error) {
+		sa, ok := sas[id]
+		if !ok {
+			return nil, fmt.Errorf("unexpected fetch of %s", id)
+		}
+		return sa, nil
+	}
+
+	ic, mux := githubtest.Setup(ctx, t, &issues.Config{
+		Owner: githubtest.TestOwner,
+		Repo:  githubtest.TestRepo,
+		Token: githubtest.TestToken,
+	})
+	var filed []*issues.Issue
+	mux.HandleFunc(fmt.Sprintf("/repos/%s/%s/issues", githubtest.TestOwner, githubtest.TestRepo), func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			return
+		}
+		var iss issues.Issue
+		if err := json.NewDecoder(r.Body).Decode(&iss); err != nil {
+			t.Error(err)
+		}
+		filed = append(filed, &iss)
+		fmt.Fprintf(w, `{"number":%d}`, 100+len(filed))
+	})
+
+	mstore := store.NewMemStore()
+	start := time.Now()
+	stats, err := SyncGHSAReviews(ctx, fetch, rc, mstore, ic)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantStats := SyncGHSAReviewsStats{NumChecked: 3, NumUnchanged: 1, NumDiverging: 1, NumNewlyDiverging: 1, NumIssues: 1}
+	if stats != wantStats {
+		t.Errorf("stats = %+v, want %+v", stats, wantStats)
+	}
+	if len(filed) != 1 || filed[0].Title != "x/vulndb: re-review GO-1999-0003: GHSA-3333-3333-3333 changed" {
+		t.Fatalf("filed issues %+v, want one for GO-1999-0003", filed)
+	}
+
+	got, err := mstore.ListGHSAReviewRecords(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, r := range got {
+		if r.SyncedAt.Before(start) {
+			t.Errorf("%s: SyncedAt = %v, want after %v", r.Key(), r.SyncedAt, start)
+		}
+		r.SyncedAt = time.Time{}
+	}
+	diverged := got[2].DivergedAt
+	if diverged.Before(start) {
+		t.Errorf("DivergedAt = %v, want after %v", diverged, start)
+	}
+	want := []*store.GHSAReviewRecord{
+		{ReportID: "GO-1999-0001", GHSA: "GHSA-1111-1111-1111", GHSAUpdated: later},
+		{ReportID: "GO-1999-0002", GHSA: "GHSA-2222-2222-2222", GHSAUpdated: published},
+		{
+			ReportID:        "GO-1999-0003",
+			GHSA:            "GHSA-3333-3333-3333",
+			GHSAUpdated:     later,
+			AddedVersions:   []string{"example.com/module < 1.3.0"},
+			AddedReferences: []string{"https://example.com/issue/1"},
+			IssueReference:  ic.Reference(101),
+			DivergedAt:      diverged,
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("records mismatch (-want, +got):\n%s", diff)
+	}
+
+	// A second sync keeps the time of the divergence
+	// and does not file another issue.
+	stats, err = SyncGHSAReviews(ctx, fetch, rc, mstore, ic)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantStats = SyncGHSAReviewsStats{NumChecked: 3, NumUnchanged: 1, NumDiverging: 1}
+	if stats != wantStats {
+		t.Errorf("second sync: stats = %+v, want %+v", stats, wantStats)
+	}
+	if len(filed) != 1 {
+		t.Errorf("second sync filed %d issues, want none", len(filed)-1)
+	}
+	got, err = mstore.ListGHSAReviewRecords(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got[2].DivergedAt != diverged || got[2].IssueReference != ic.Reference(101) {
+		t.Errorf("second sync: got %+v, want DivergedAt %v and IssueReference %s", got[2], diverged, ic.Reference(101))
+	}
+}
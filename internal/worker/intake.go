@@ -0,0 +1,210 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package worker
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+
+	"golang.org/x/vulndb/internal/derrors"
+	"golang.org/x/vulndb/internal/idstr"
+	"golang.org/x/vulndb/internal/issues"
+	"golang.org/x/vulndb/internal/observe"
+	"golang.org/x/vulndb/internal/report"
+	"golang.org/x/vulndb/internal/worker/log"
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+// Labels used by the intake of public "missing vulnerability" reports.
+const (
+	// labelDirectReport is applied by the "Missing CVE or GHSA"
+	// issue template (.github/ISSUE_TEMPLATE/missing_id.yml).
+	labelDirectReport = "Direct External Report"
+	// labelTemplateTriage is the triage label applied by the template.
+	labelTemplateTriage = "Needs Triage"
+	// labelNeedsTriage is the triage label used by the rest of the worker.
+	labelNeedsTriage = "NeedsTriage"
+	// labelIntakeChecked marks issues that ProcessIntake has answered.
+	labelIntakeChecked = "IntakeChecked"
+)
+
+// IntakeStats are statistics about a run of ProcessIntake.
+type IntakeStats struct {
+	// Number of intake issues checked.
+	NumChecked int
+	// Number of issues whose IDs are all already covered
+	// by a report or an existing triage issue.
+	NumCovered int
+	// Number of issues left open for triage.
+	NumNeedsTriage int
+	// Number of issues in which no CVE or GHSA ID was found.
+	NumNoIDs int
+}
+
+// ProcessIntake answers public reports of vulnerabilities missing from
+// the database. These are open issues in the tracker filed with the
+// "Missing CVE or GHSA" template that have not been checked before.
+//
+// For each issue, ProcessIntake finds the CVE and GHSA IDs it mentions,
+// and checks each one against the reports in rc and the records in st.
+// It comments on the issue with the status of each ID. If any ID is
+// not yet covered by a report or a triage issue, the issue itself
+// becomes the triage issue for it: it is labeled NeedsTriage, and any
+// record for the ID in st is marked as having an issue, so that
+// CreateIssues does not file a duplicate.
+func ProcessIntake(ctx context.Context, st store.Store, client *issues.Client, rc *report.Client) (stats IntakeStats, err error) {
+	defer derrors.Wrap(&err, "ProcessIntake(%q)", client.Destination())
+	ctx, span := observe.Start(ctx, "ProcessIntake")
+	defer span.End()
+
+	iss, err := client.Issues(ctx, issues.IssuesOptions{
+		State:  "open",
+		Labels: []string{labelDirectReport},
+	})
+	if err != nil {
+		return stats, err
+	}
+	for _, is := range iss {
+		if is.HasLabel(labelIntakeChecked) {
+			continue
+		}
+		if err := processIntakeIssue(ctx, st, client, rc, is, &stats); err != nil {
+			return stats, err
+		}
+	}
+	log.Infof(ctx, "ProcessIntake done: %+v", stats)
+	return stats, nil
+}
+
+func processIntakeIssue(ctx context.Context, st store.Store, client *issues.Client, rc *report.Client, is *issues.Issue, stats *IntakeStats) error {
+	stats.NumChecked++
+	ref := client.Reference(is.Number)
+	ids := idstr.FindAliases(is.Title + "\n" + is.Body)
+
+	var (
+		statuses  []string
+		untracked []string
+	)
+	for _, id := range ids {
+		s, covered, err := intakeStatus(ctx, st, rc, id)
+		if err != nil {
+			return err
+		}
+		statuses = append(statuses, s)
+		if !covered {
+			untracked = append(untracked, id)
+		}
+	}
+
+	labels := slices.DeleteFunc(slices.Clone(is.Labels), func(l string) bool {
+		return l == labelTemplateTriage || l == labelNeedsTriage
+	})
+	var comment string
+	switch {
+	case len(ids) == 0:
+		stats.NumNoIDs++
+		comment = "Thanks for the report. We could not find a CVE or GHSA ID in this issue. " +
+			"Please edit the issue to include one (for example, CVE-2024-12345 or GHSA-xxxx-yyyy-zzzz) " +
+			"and a maintainer will take a look."
+		labels = append(labels, labelNeedsTriage)
+	case len(untracked) == 0:
+		stats.NumCovered++
+		comment = intakeComment("Thanks for the report. Everything mentioned here is already known to us:", statuses,
+			"If you think a report is wrong or incomplete, please say so in a comment.")
+	default:
+		stats.NumNeedsTriage++
+		comment = intakeComment("Thanks for the report. We checked the IDs mentioned here:", statuses,
+			"This issue will be triaged by a maintainer.")
+		labels = append(labels, labelNeedsTriage)
+		for _, id := range untracked {
+			if err := markIntakeRecord(ctx, st, id, ref, is.CreatedAt); err != nil {
+				return err
+			}
+		}
+	}
+	labels = append(labels, labelIntakeChecked)
+
+	if err := client.AddComments(ctx, is.Number, []string{comment}); err != nil {
+		return err
+	}
+	if err := client.SetLabels(ctx, is.Number, labels); err != nil {
+		return err
+	}
+	log.With("issue", ref, "IDs", ids, "untracked", untracked).Infof(ctx, "answered intake issue %s", ref)
+	return nil
+}
+
+// intakeStatus returns a sentence describing what is known about the
+// vulnerability with the given CVE or GHSA ID, and reports whether it
+// is covered, that is, whether it has a report or a triage issue.
+func intakeStatus(ctx context.Context, st store.Store, rc *report.Client, id string) (status string, covered bool, err error) {
+	if rs := rc.ReportsByAlias(id); len(rs) > 0 {
+		var parts []string
+		for _, r := range rs {
+			if r.IsExcluded() {
+				parts = append(parts, fmt.Sprintf("was reviewed as %s and excluded from the database (%s)", r.ID, r.Excluded))
+			} else {
+				parts = append(parts, fmt.Sprintf("is in the database as [%s](%s)", r.ID, idstr.GoAdvisory(r.ID)))
+			}
+		}
+		return fmt.Sprintf("%s %s.", id, strings.Join(parts, ", and ")), true, nil
+	}
+	rec, err := st.GetRecord(ctx, id)
+	if err != nil {
+		return "", false, err
+	}
+	if rec != nil && !reflect.ValueOf(rec).IsNil() && rec.GetIssueReference() != "" {
+		return fmt.Sprintf("%s is being triaged in %s.", id, rec.GetIssueReference()), true, nil
+	}
+	return fmt.Sprintf("%s is not yet in the database.", id), false, nil
+}
+
+func intakeComment(intro string, statuses []string, outro string) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s\n\n", intro)
+	for _, s := range statuses {
+		fmt.Fprintf(&b, "- %s\n", s)
+	}
+	fmt.Fprintf(&b, "\n%s\n\n_This is an automated response from the vulndb worker._", outro)
+	return b.String()
+}
+
+// markIntakeRecord records that the intake issue ref is the triage
+// issue for the vulnerability with the given ID, if the vulnerability
+// has a record in st that does not have an issue yet.
+func markIntakeRecord(ctx context.Context, st store.Store, id, ref string, createdAt time.Time) error {
+	if createdAt.IsZero() {
+		createdAt = time.Now()
+	}
+	return st.RunTransaction(ctx, func(ctx context.Context, tx store.Transaction) error {
+		rec, err := tx.GetRecord(id)
+		if err != nil {
+			return err
+		}
+		switch r := rec.(type) {
+		case *store.CVE4Record:
+			if r == nil || r.IssueReference != "" {
+				return nil
+			}
+			r.TriageState = store.TriageStateIssueCreated
+			r.IssueReference = ref
+			r.IssueCreatedAt = createdAt
+			return tx.SetRecord(r)
+		case *store.LegacyGHSARecord:
+			if r == nil || r.IssueReference != "" {
+				return nil
+			}
+			r.TriageState = store.TriageStateIssueCreated
+			r.IssueReference = ref
+			r.IssueCreatedAt = createdAt
+			return tx.SetRecord(r)
+		}
+		return nil
+	})
+}
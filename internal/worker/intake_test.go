@@ -0,0 +1,152 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/vulndb/internal/issues"
+	"golang.org/x/vulndb/internal/issues/githubtest"
+	"golang.org/x/vulndb/internal/report"
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+func TestProcessIntake(t *testing.T) {
+	ctx := context.Background()
+	mstore := store.NewMemStore()
+
+	ic, mux := githubtest.Setup(ctx, t, &issues.Config{
+		Owner: githubtest.TestOwner,
+		Repo:  githubtest.TestRepo,
+		Token: githubtest.TestToken,
+	})
+	prefix := fmt.Sprintf("/repos/%s/%s/issues", githubtest.TestOwner, githubtest.TestRepo)
+	mux.HandleFunc(prefix, func(w http.ResponseWriter, r *http.Request) {
+		if got, want := r.URL.Query().Get("labels"), labelDirectReport; got != want {
+			t.Errorf("labels query = %q, want %q", got, want)
+		}
+		fmt.Fprint(w, `[
+{"number": 10, "title": "x/vulndb: potential Go vuln in example.com/a: CVE-2000-0001", "labels": [{"name": "Needs Triage"}, {"name": "Direct External Report"}]},
+{"number": 11, "title": "x/vulndb: potential Go vuln in <package>: <CVE ID>, <GHSA ID>",
+ "body": "### CVE ID\n\nCVE-2000-0003\n\n### GHSA ID\n\n_No response_\n\n### Additional information\n\nSee also CVE-2000-0002 and CVE-2000-0004.",
+ "labels": [{"name": "Needs Triage"}, {"name": "Direct External Report"}], "created_at": "2020-01-02T00:00:00Z"},
+{"number": 12, "title": "x/vulndb: potential Go vuln in <package>: <CVE ID>, <GHSA ID>", "body": "please add this", "labels": [{"name": "Direct External Report"}]},
+{"number": 13, "title": "CVE-2000-0005", "labels": [{"name": "Direct External Report"}, {"name": "IntakeChecked"}]}
+]`)
+	})
+	var (
+		mu       sync.Mutex
+		comments = map[int][]string{}
+		labels   = map[int][]string{}
+	)
+	mux.HandleFunc(prefix+"/", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		var num int
+		rest := strings.TrimPrefix(r.URL.Path, prefix+"/")
+		var body struct {
+			Body   string
+			Labels []string
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Error(err)
+			return
+		}
+		switch {
+		case r.Method == http.MethodPost && strings.HasSuffix(rest, "/comments"):
+			fmt.Sscanf(rest, "%d/comments", &num)
+			comments[num] = append(comments[num], body.Body)
+			fmt.Fprint(w, `{}`)
+		case r.Method == http.MethodPatch:
+			fmt.Sscanf(rest, "%d", &num)
+			labels[num] = body.Labels
+			fmt.Fprintf(w, `{"number": %d}`, num)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	record := func(id string, ts store.TriageState, ref string) *store.CVE4Record {
+		return &store.CVE4Record{
+			ID:             id,
+			Path:           "path/" + id,
+			BlobHash:       "bh",
+			CommitHash:     "ch",
+			CommitTime:     time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+			TriageState:    ts,
+			IssueReference: ref,
+		}
+	}
+	createCVE4Records(t, mstore, []*store.CVE4Record{
+		record("CVE-2000-0003", store.TriageStateNoActionNeeded, ""),
+		record("CVE-2000-0004", store.TriageStateIssueCreated, "https://github.com/test-owner/test-repo/issues/4"),
+	})
+	rc, err := report.NewTestClient(map[string]*report.Report{
+		"data/reports/GO-2000-0001.yaml":  {ID: "GO-2000-0001", CVEs: []string{"CVE-2000-0001"}},
+		"data/excluded/GO-2000-0002.yaml": {ID: "GO-2000-0002", CVEs: []string{"CVE-2000-0002"}, Excluded: report.ExcludedNotGoCode},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	stats, err := ProcessIntake(ctx, mstore, ic, rc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (IntakeStats{NumChecked: 3, NumCovered: 1, NumNeedsTriage: 1, NumNoIDs: 1}); stats != want {
+		t.Errorf("got stats %+v, want %+v", stats, want)
+	}
+
+	wantLabels := map[int][]string{
+		10: {"Direct External Report", "IntakeChecked"},
+		11: {"Direct External Report", "NeedsTriage", "IntakeChecked"},
+		12: {"Direct External Report", "NeedsTriage", "IntakeChecked"},
+	}
+	if diff := cmp.Diff(wantLabels, labels); diff != "" {
+		t.Errorf("labels mismatch (-want, +got):\n%s", diff)
+	}
+	for num, wants := range map[int][]string{
+		10: {"CVE-2000-0001 is in the database as [GO-2000-0001](https://pkg.go.dev/vuln/GO-2000-0001)."},
+		11: {
+			"CVE-2000-0003 is not yet in the database.",
+			"CVE-2000-0002 was reviewed as GO-2000-0002 and excluded from the database (NOT_GO_CODE).",
+			"CVE-2000-0004 is being triaged in https://github.com/test-owner/test-repo/issues/4.",
+			"This issue will be triaged",
+		},
+		12: {"could not find a CVE or GHSA ID"},
+	} {
+		if len(comments[num]) != 1 {
+			t.Errorf("issue %d: got %d comments, want 1", num, len(comments[num]))
+			continue
+		}
+		for _, want := range wants {
+			if !strings.Contains(comments[num][0], want) {
+				t.Errorf("issue %d: comment\n%s\ndoes not contain %q", num, comments[num][0], want)
+			}
+		}
+	}
+	if _, ok := comments[13]; ok {
+		t.Error("issue 13 was already checked, but got a comment")
+	}
+
+	// The untracked CVE is now tracked by the intake issue.
+	rec, err := mstore.GetRecord(ctx, "CVE-2000-0003")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := record("CVE-2000-0003", store.TriageStateIssueCreated, "https://github.com/test-owner/test-repo/issues/11")
+	want.IssueCreatedAt = time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC)
+	if diff := cmp.Diff(want, rec); diff != "" {
+		t.Errorf("record mismatch (-want, +got):\n%s", diff)
+	}
+}
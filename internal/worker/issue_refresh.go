@@ -0,0 +1,140 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package worker
+
+import (
+	"context"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/vulndb/internal/derrors"
+	"golang.org/x/vulndb/internal/issues"
+	"golang.org/x/vulndb/internal/observe"
+	"golang.org/x/vulndb/internal/proxy"
+	"golang.org/x/vulndb/internal/worker/log"
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+// RefreshIssuesStats are statistics about a run of RefreshIssues.
+type RefreshIssuesStats struct {
+	// Number of CVEs and GHSAs updated since their issue was filed.
+	NumChecked int
+	// Number of issues skipped because they are closed, or have
+	// no structured section to refresh.
+	NumSkipped int
+	// Number of issues whose structured section was already current.
+	NumUnchanged int
+	// Number of issues whose structured section was refreshed.
+	NumRefreshed int
+}
+
+// RefreshIssues refreshes the tracker issues of the CVEs and GHSAs that
+// were updated after their issue was filed (those in the
+// UpdatedSinceIssueCreation triage state), so that triagers see the
+// current data of the advisory.
+//
+// For each such issue that is open, it computes the structured section
+// of the issue body (see issues.Meta) from the current advisory, and if
+// its modules, aliases or references differ from those in the body,
+// replaces the section in place. The new section is marked with the date
+// and the fields that changed, and is followed by a visible copy of the
+// data. Issues filed before the body had a structured section are left
+// alone.
+func RefreshIssues(ctx context.Context, st store.Store, client *issues.Client, pc *proxy.Client) (stats RefreshIssuesStats, err error) {
+	defer derrors.Wrap(&err, "RefreshIssues")
+	ctx, span := observe.Start(ctx, "RefreshIssues")
+	defer span.End()
+
+	rs, err := updatedSinceIssueCreation(ctx, st)
+	if err != nil {
+		return stats, err
+	}
+	today := time.Now().UTC().Format(time.DateOnly)
+	for _, r := range rs {
+		id := r.GetID()
+		num, ok := issueNumber(r.GetIssueReference())
+		if !ok {
+			log.Warningf(ctx, "%s: can't find issue number in reference %q", id, r.GetIssueReference())
+			continue
+		}
+		stats.NumChecked++
+		// The source of a record whose advisory was cleared
+		// is a typed nil.
+		if src := r.GetSource(); src == nil || reflect.ValueOf(src).IsNil() {
+			stats.NumSkipped++
+			continue
+		}
+		iss, err := client.Issue(ctx, num)
+		if err != nil {
+			return stats, err
+		}
+		old := iss.Meta()
+		if iss.State != "open" || old == nil {
+			stats.NumSkipped++
+			continue
+		}
+		rep := newIssueReport(r, pc)
+		m := issueMeta(rep, candidateModules(rep, r.GetUnits(), pc))
+		m.Changed = m.Diff(old)
+		if len(m.Changed) == 0 {
+			stats.NumUnchanged++
+			continue
+		}
+		m.Updated = today
+		body, _ := issues.ReplaceMeta(iss.Body, m)
+		if err := issueRateLimiter.Wait(ctx); err != nil {
+			return stats, err
+		}
+		if err := client.SetBody(ctx, num, body); err != nil {
+			return stats, err
+		}
+		stats.NumRefreshed++
+		log.With("ID", id).Infof(ctx, "refreshed issue %s for %s: %s changed", r.GetIssueReference(), id, strings.Join(m.Changed, ", "))
+	}
+	log.Infof(ctx, "RefreshIssues done: %+v", stats)
+	return stats, nil
+}
+
+// updatedSinceIssueCreation returns the CVE and GHSA records that were
+// updated after their issue was filed.
+func updatedSinceIssueCreation(ctx context.Context, st store.Store) ([]store.Record, error) {
+	crs, err := st.ListCVE4RecordsWithTriageState(ctx, store.TriageStateUpdatedSinceIssueCreation)
+	if err != nil {
+		return nil, err
+	}
+	grs, err := getGHSARecords(ctx, st)
+	if err != nil {
+		return nil, err
+	}
+	var rs []store.Record
+	for _, r := range crs {
+		if r.IssueReference != "" {
+			rs = append(rs, r)
+		}
+	}
+	for _, r := range grs {
+		if r.TriageState == store.TriageStateUpdatedSinceIssueCreation && r.IssueReference != "" {
+			rs = append(rs, r)
+		}
+	}
+	return rs, nil
+}
+
+// issueNumber returns the number of the issue with the given reference,
+// which is either a URL ending in the number or of the form
+// "owner/repo#number".
+func issueNumber(ref string) (int, bool) {
+	i := strings.LastIndexAny(ref, "/#")
+	if i < 0 {
+		return 0, false
+	}
+	n, err := strconv.Atoi(ref[i+1:])
+	if err != nil || n <= 0 {
+		return 0, false
+	}
+	return n, true
+}
@@ -0,0 +1,152 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/vulndb/internal/cve4"
+	"golang.org/x/vulndb/internal/issues"
+	"golang.org/x/vulndb/internal/issues/githubtest"
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+func TestRefreshIssues(t *testing.T) {
+	ctx := context.Background()
+
+	newCVE := func(id string, refs ...string) *cve4.CVE {
+		c := &cve4.CVE{Metadata: cve4.Metadata{ID: id}}
+		for _, u := range refs {
+			c.References.Data = append(c.References.Data, cve4.Reference{URL: u})
+		}
+		return c
+	}
+	newRecord := func(id string, ts store.TriageState, num int, refs ...string) *store.CVE4Record {
+		r := &store.CVE4Record{ID: id, Path: "cves/" + id + ".json", BlobHash: "123", CommitHash: "abc", CommitTime: time.Now(), CVE: newCVE(id, refs...)}
+		r.Module = "std"
+		r.TriageState = ts
+		if num > 0 {
+			r.IssueReference = fmt.Sprintf("https://github.com/%s/%s/issues/%d", githubtest.TestOwner, githubtest.TestRepo, num)
+		}
+		return r
+	}
+	const ref1, ref2 = "https://example.com/advisory", "https://example.com/commit/1"
+	nvd := func(id string) string { return "https://nvd.nist.gov/vuln/detail/" + id }
+	withMeta := func(m *issues.Meta) string {
+		return "Some text.\n\n" + m.Section() + "\n\nMore text.\n"
+	}
+	bodies := map[int]string{
+		// The CVE has a new reference.
+		1: withMeta(&issues.Meta{Modules: []string{"std"}, Aliases: []string{"CVE-1999-0001"}, References: []string{nvd("CVE-1999-0001"), ref1}}),
+		// Closed.
+		2: withMeta(&issues.Meta{Modules: []string{"std"}, Aliases: []string{"CVE-1999-0002"}}),
+		// Filed before issues had a structured section.
+		3: "Some text.\n",
+		// Already current.
+		4: withMeta(&issues.Meta{Modules: []string{"std"}, Aliases: []string{"CVE-1999-0004"}, References: []string{nvd("CVE-1999-0004"), ref1}}),
+	}
+	states := map[int]string{1: "open", 2: "closed", 3: "open", 4: "open"}
+	want1 := withMeta(&issues.Meta{
+		Modules:    []string{"std"},
+		Aliases:    []string{"CVE-1999-0001"},
+		References: []string{nvd("CVE-1999-0001"), ref2, ref1},
+		Updated:    time.Now().UTC().Format(time.DateOnly),
+		Changed:    []string{"references"},
+	})
+
+	ic, mux := githubtest.Setup(ctx, t, &issues.Config{
+		Owner: githubtest.TestOwner,
+		Repo:  githubtest.TestRepo,
+		Token: githubtest.TestToken,
+	})
+	var edited []int
+	mux.HandleFunc(fmt.Sprintf("/repos/%s/%s/issues/", githubtest.TestOwner, githubtest.TestRepo), func(w http.ResponseWriter, r *http.Request) {
+		num, err := strconv.Atoi(r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:])
+		if err != nil {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+		case http.MethodPatch:
+			var req struct{ Body string }
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				t.Error(err)
+			}
+			bodies[num] = req.Body
+			edited = append(edited, num)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			return
+		}
+		b, err := json.Marshal(map[string]any{"number": num, "state": states[num], "body": bodies[num]})
+		if err != nil {
+			t.Error(err)
+		}
+		w.Write(b)
+	})
+
+	mstore := store.NewMemStore()
+	createCVE4Records(t, mstore, []*store.CVE4Record{
+		newRecord("CVE-1999-0001", store.TriageStateUpdatedSinceIssueCreation, 1, ref1, ref2),
+		newRecord("CVE-1999-0002", store.TriageStateUpdatedSinceIssueCreation, 2, ref1),
+		newRecord("CVE-1999-0003", store.TriageStateUpdatedSinceIssueCreation, 3, ref1),
+		newRecord("CVE-1999-0004", store.TriageStateUpdatedSinceIssueCreation, 4, ref1),
+		// Not updated since its issue was filed.
+		newRecord("CVE-1999-0005", store.TriageStateIssueCreated, 5, ref1),
+		// No issue yet.
+		newRecord("CVE-1999-0006", store.TriageStateNeedsIssue, 0, ref1),
+	})
+
+	stats, err := RefreshIssues(ctx, mstore, ic, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantStats := RefreshIssuesStats{NumChecked: 4, NumSkipped: 2, NumUnchanged: 1, NumRefreshed: 1}
+	if stats != wantStats {
+		t.Errorf("stats = %+v, want %+v", stats, wantStats)
+	}
+	if diff := cmp.Diff([]int{1}, edited); diff != "" {
+		t.Errorf("edited issues mismatch (-want, +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(want1, bodies[1]); diff != "" {
+		t.Errorf("body mismatch (-want, +got):\n%s", diff)
+	}
+
+	// A second refresh finds the refreshed issue current.
+	stats, err = RefreshIssues(ctx, mstore, ic, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantStats = RefreshIssuesStats{NumChecked: 4, NumSkipped: 2, NumUnchanged: 2}
+	if stats != wantStats {
+		t.Errorf("second refresh: stats = %+v, want %+v", stats, wantStats)
+	}
+}
+
+func TestIssueNumber(t *testing.T) {
+	for _, test := range []struct {
+		ref  string
+		want int
+	}{
+		{"https://github.com/golang/vulndb/issues/12", 12},
+		{"golang/vulndb#34", 34},
+		{"https://github.com/golang/vulndb/issues/", 0},
+		{"12", 0},
+	} {
+		got, ok := issueNumber(test.ref)
+		if got != test.want || ok != (test.want > 0) {
+			t.Errorf("issueNumber(%q) = %d, %t, want %d", test.ref, got, ok, test.want)
+		}
+	}
+}
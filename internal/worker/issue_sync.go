@@ -0,0 +1,63 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package worker
+
+import (
+	"context"
+	"time"
+
+	"golang.org/x/vulndb/internal/derrors"
+	"golang.org/x/vulndb/internal/issues"
+	"golang.org/x/vulndb/internal/observe"
+	"golang.org/x/vulndb/internal/worker/log"
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+// An IssuesListFunc returns the tracker issues that match opts.
+type IssuesListFunc func(context.Context, issues.IssuesOptions) ([]*issues.Issue, error)
+
+type SyncIssuesStats struct {
+	// The time from which issues were requested.
+	// Zero for a full sync.
+	Since time.Time
+	// Number of issues written to the store.
+	NumSynced int
+}
+
+// SyncIssues mirrors the issues in the tracker into the store.
+//
+// The sync is incremental: only issues updated since the most recently
+// updated issue in the store are requested, unless full is true,
+// in which case all issues are requested.
+func SyncIssues(ctx context.Context, list IssuesListFunc, st store.Store, full bool) (stats SyncIssuesStats, err error) {
+	defer derrors.Wrap(&err, "SyncIssues(full=%t)", full)
+	ctx, span := observe.Start(ctx, "SyncIssues")
+	defer span.End()
+
+	if !full {
+		// The tracker's notion of "since" is inclusive, so the most
+		// recently updated issue will be fetched again. That's harmless,
+		// and it ensures we don't miss other issues updated at the same time.
+		stats.Since, err = st.LatestIssueUpdate(ctx)
+		if err != nil {
+			return stats, err
+		}
+	}
+	log.Infof(ctx, "Starting issue sync, looking at issues updated since=%s", stats.Since)
+	iss, err := list(ctx, issues.IssuesOptions{State: "all", Since: stats.Since})
+	if err != nil {
+		return stats, err
+	}
+	var irs []*store.IssueRecord
+	for _, i := range iss {
+		irs = append(irs, store.NewIssueRecord(i))
+	}
+	if err := st.SetIssueRecords(ctx, irs); err != nil {
+		return stats, err
+	}
+	stats.NumSynced = len(irs)
+	log.Infof(ctx, "Issue sync succeeded: %+v", stats)
+	return stats, nil
+}
@@ -0,0 +1,79 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package worker
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"golang.org/x/vulndb/internal/issues"
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+func TestSyncIssues(t *testing.T) {
+	ctx := context.Background()
+	date := func(day int) time.Time {
+		return time.Date(2024, time.March, day, 0, 0, 0, 0, time.UTC)
+	}
+	tracker := []*issues.Issue{
+		{Number: 1, Title: "one", State: "open", UpdatedAt: date(1)},
+		{Number: 2, Title: "two", State: "closed", Labels: []string{"excluded: NOT_GO_CODE"}, UpdatedAt: date(3)},
+	}
+	var gotOpts []issues.IssuesOptions
+	list := func(_ context.Context, opts issues.IssuesOptions) ([]*issues.Issue, error) {
+		gotOpts = append(gotOpts, opts)
+		var result []*issues.Issue
+		for _, iss := range tracker {
+			if !iss.UpdatedAt.Before(opts.Since) {
+				result = append(result, iss)
+			}
+		}
+		return result, nil
+	}
+
+	mstore := store.NewMemStore()
+	check := func(wantStats SyncIssuesStats, want []*issues.Issue) {
+		t.Helper()
+		stats, err := SyncIssues(ctx, list, mstore, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if stats != wantStats {
+			t.Errorf("got stats %+v, want %+v", stats, wantStats)
+		}
+		irs, err := mstore.ListIssueRecords(ctx, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got []*issues.Issue
+		for _, ir := range irs {
+			got = append(got, ir.Issue())
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("mismatch (-want, +got):\n%s", diff)
+		}
+	}
+
+	// The first sync copies everything.
+	check(SyncIssuesStats{NumSynced: 2}, tracker)
+
+	// Later syncs only request issues updated since the last one.
+	tracker[0] = &issues.Issue{Number: 1, Title: "one", State: "closed", UpdatedAt: date(4)}
+	tracker = append(tracker, &issues.Issue{Number: 3, Title: "three", State: "open", UpdatedAt: date(5)})
+	check(SyncIssuesStats{Since: date(3), NumSynced: 3}, tracker)
+	check(SyncIssuesStats{Since: date(5), NumSynced: 1}, tracker)
+
+	wantOpts := []issues.IssuesOptions{
+		{State: "all"},
+		{State: "all", Since: date(3)},
+		{State: "all", Since: date(5)},
+	}
+	if diff := cmp.Diff(wantOpts, gotOpts, cmpopts.EquateEmpty()); diff != "" {
+		t.Errorf("options mismatch (-want, +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,189 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/jba/metrics"
+	"golang.org/x/vulndb/internal/derrors"
+	"golang.org/x/vulndb/internal/worker/log"
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+// A job is work that the server does when its endpoint, "/" followed by
+// the job's name, gets a POST request, usually from a scheduler.
+//
+// The server runs the jobs the same way (see runJob): only one run of a
+// job is in progress at a time, across all instances of the server;
+// the state of each job's last run is recorded in the store and shown
+// on the dashboard; and each run is counted and timed in the metrics,
+// and its failure reported.
+type job struct {
+	name string
+	// interval is how often the deployment schedules the job, or zero
+	// if it only runs on demand. A scheduled job that has not
+	// succeeded for twice its interval is shown as stale.
+	interval time.Duration
+	// lockTimeout is how long a run may hold the job's lock before
+	// another run may start. If zero, defaultJobLockTimeout.
+	lockTimeout time.Duration
+	// lock, if set, is the name of another job whose lock a run of
+	// the job also holds, because both jobs write the same records.
+	// The run also counts as a run of that job.
+	lock string
+	// run does the work of the job for the request, and writes
+	// a summary of it to the response.
+	run func(w http.ResponseWriter, r *http.Request) error
+}
+
+// defaultJobLockTimeout is longer than any job should take.
+const defaultJobLockTimeout = 2 * time.Hour
+
+// errJobRunning is the error of a run of a job that
+// didn't start because another run is in progress.
+var errJobRunning = errors.New("a run is already in progress")
+
+type jobOutcome struct {
+	Job     string
+	Outcome string // "success", "failure" or "skipped"
+}
+
+type jobName struct {
+	Job string
+}
+
+var (
+	jobCounters  = metrics.NewCounterGroup[int64, jobOutcome]("jobs", "runs of worker jobs")
+	jobDurations = metrics.NewHistogramGroup[float64, jobName]("job-durations",
+		[]float64{1, 10, 60, 300, 900, 1800, 3600}, "durations of worker job runs, in seconds")
+)
+
+// handleJob registers the handler for j.
+func (s *Server) handleJob(ctx context.Context, j *job) {
+	s.jobs = append(s.jobs, j)
+	s.handle(ctx, "/"+j.name, func(w http.ResponseWriter, r *http.Request) error {
+		if r.Method != http.MethodPost {
+			return &serverError{
+				status: http.StatusMethodNotAllowed,
+				err:    fmt.Errorf("%s required", http.MethodPost),
+			}
+		}
+		err := runJob(r.Context(), s.cfg.Store, j, func(context.Context) error {
+			return j.run(w, r)
+		})
+		if errors.Is(err, errJobRunning) {
+			return &serverError{status: http.StatusPreconditionFailed, err: err}
+		}
+		return err
+	})
+}
+
+// runJob runs f as a run of j, holding j's lock (and that of j.lock, if
+// set) in st, and records how it went. It returns errJobRunning, without
+// calling f, if another run of j or of j.lock is in progress.
+func runJob(ctx context.Context, st store.Store, j *job, f func(context.Context) error) (err error) {
+	defer derrors.Wrap(&err, "job %s", j.name)
+
+	timeout := j.lockTimeout
+	if timeout == 0 {
+		timeout = defaultJobLockTimeout
+	}
+	if j.lock != "" {
+		lr, lstarted, lerr := st.StartJob(ctx, j.lock, time.Now(), timeout)
+		if lerr != nil {
+			return lerr
+		}
+		if !lstarted {
+			jobCounters.At(jobOutcome{j.name, "skipped"}).Add(1)
+			log.Warningf(ctx, "job %s: run of %s started at %s still in progress", j.name, j.lock, FormatTime(lr.StartedAt))
+			return errJobRunning
+		}
+		defer func() {
+			_, lerr := st.EndJob(ctx, j.lock, lr.StartedAt, time.Now(), err)
+			if err == nil {
+				err = lerr
+			}
+		}()
+	}
+	jr, started, err := st.StartJob(ctx, j.name, time.Now(), timeout)
+	if err != nil {
+		return err
+	}
+	if !started {
+		jobCounters.At(jobOutcome{j.name, "skipped"}).Add(1)
+		log.Warningf(ctx, "job %s: run started at %s still in progress", j.name, FormatTime(jr.StartedAt))
+		return errJobRunning
+	}
+
+	runErr := f(ctx)
+	end := time.Now()
+	jobDurations.At(jobName{j.name}).Record(end.Sub(jr.StartedAt).Seconds())
+	outcome := "success"
+	if runErr != nil {
+		outcome = "failure"
+	}
+	jobCounters.At(jobOutcome{j.name, outcome}).Add(1)
+
+	jr, err = st.EndJob(ctx, j.name, jr.StartedAt, end, runErr)
+	if runErr != nil {
+		if jr != nil {
+			log.Errorf(ctx, "job %s failed (%d consecutive failures): %v", j.name, jr.ConsecutiveFailures, runErr)
+		}
+		derrors.Report(fmt.Errorf("job %s: %w", j.name, runErr))
+		return runErr
+	}
+	return err
+}
+
+// A jobStatus is the state of a job, for the dashboard.
+type jobStatus struct {
+	Name     string
+	Interval string
+	Record   *store.JobRecord
+	// Stale reports whether a scheduled job has not
+	// succeeded for twice its interval.
+	Stale bool
+}
+
+// jobStatuses returns the state of each of jobs at now, given
+// the JobRecords in the store.
+func jobStatuses(jobs []*job, rs []*store.JobRecord, now time.Time) []*jobStatus {
+	records := make(map[string]*store.JobRecord)
+	for _, r := range rs {
+		records[r.Name] = r
+	}
+	var ss []*jobStatus
+	for _, j := range jobs {
+		s := &jobStatus{Name: j.name, Interval: "on demand", Record: records[j.name]}
+		if s.Record == nil {
+			s.Record = &store.JobRecord{Name: j.name}
+		}
+		if j.interval > 0 {
+			s.Interval = "every " + shortDuration(j.interval)
+			s.Stale = now.Sub(s.Record.LastSucceededAt) > 2*j.interval
+		}
+		ss = append(ss, s)
+	}
+	return ss
+}
+
+// shortDuration formats d without zero minutes and seconds,
+// e.g. "6h" instead of "6h0m0s".
+func shortDuration(d time.Duration) string {
+	s := d.String()
+	if strings.HasSuffix(s, "m0s") {
+		s = s[:len(s)-2]
+	}
+	if strings.HasSuffix(s, "h0m") {
+		s = s[:len(s)-2]
+	}
+	return s
+}
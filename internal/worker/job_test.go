@@ -0,0 +1,145 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package worker
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+func TestRunJob(t *testing.T) {
+	ctx := context.Background()
+	mstore := store.NewMemStore()
+	j := &job{name: "test"}
+
+	// While a run is in progress, another can't start.
+	var nested error
+	if err := runJob(ctx, mstore, j, func(ctx context.Context) error {
+		nested = runJob(ctx, mstore, j, func(context.Context) error {
+			t.Error("nested run started")
+			return nil
+		})
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if !errors.Is(nested, errJobRunning) {
+		t.Errorf("nested run: got %v, want errJobRunning", nested)
+	}
+	jr := getJobRecord(ctx, t, mstore, j.name)
+	if jr.LastSucceededAt.IsZero() || jr.Error != "" || jr.ConsecutiveFailures != 0 || jr.Running(time.Now()) {
+		t.Errorf("after success: got %+v", jr)
+	}
+	succeeded := jr.LastSucceededAt
+
+	// Failures are recorded and counted.
+	errFail := errors.New("fail")
+	for i := 1; i <= 2; i++ {
+		if err := runJob(ctx, mstore, j, func(context.Context) error { return errFail }); !errors.Is(err, errFail) {
+			t.Fatalf("got %v, want %v", err, errFail)
+		}
+		jr = getJobRecord(ctx, t, mstore, j.name)
+		if jr.Error != errFail.Error() || jr.ConsecutiveFailures != i || !jr.LastSucceededAt.Equal(succeeded) {
+			t.Errorf("after failure %d: got %+v", i, jr)
+		}
+	}
+}
+
+func TestRunJobLock(t *testing.T) {
+	ctx := context.Background()
+	mstore := store.NewMemStore()
+	update := &job{name: "update"}
+	both := &job{name: "update-and-issues", lock: "update"}
+
+	// Each job keeps the other from starting.
+	for _, test := range []struct {
+		outer, inner *job
+	}{
+		{update, both},
+		{both, update},
+	} {
+		var nested error
+		if err := runJob(ctx, mstore, test.outer, func(ctx context.Context) error {
+			nested = runJob(ctx, mstore, test.inner, func(context.Context) error {
+				t.Errorf("%s started during %s", test.inner.name, test.outer.name)
+				return nil
+			})
+			return nil
+		}); err != nil {
+			t.Fatal(err)
+		}
+		if !errors.Is(nested, errJobRunning) {
+			t.Errorf("%s during %s: got %v, want errJobRunning", test.inner.name, test.outer.name, nested)
+		}
+	}
+
+	// A failed run of both is also a failed run of update,
+	// and neither lock is held after it.
+	errFail := errors.New("fail")
+	if err := runJob(ctx, mstore, both, func(context.Context) error { return errFail }); !errors.Is(err, errFail) {
+		t.Fatalf("got %v, want %v", err, errFail)
+	}
+	for _, name := range []string{update.name, both.name} {
+		jr := getJobRecord(ctx, t, mstore, name)
+		if jr.Error != errFail.Error() || jr.ConsecutiveFailures != 1 || jr.Running(time.Now()) {
+			t.Errorf("%s after failure: got %+v", name, jr)
+		}
+	}
+}
+
+func getJobRecord(ctx context.Context, t *testing.T, st store.Store, name string) *store.JobRecord {
+	t.Helper()
+	rs, err := st.ListJobRecords(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, r := range rs {
+		if r.Name == name {
+			return r
+		}
+	}
+	t.Fatalf("no record for job %s", name)
+	return nil
+}
+
+func TestJobStatuses(t *testing.T) {
+	now := time.Date(2024, 1, 2, 12, 0, 0, 0, time.UTC)
+	jobs := []*job{
+		{name: "fresh", interval: time.Hour},
+		{name: "stale", interval: time.Hour},
+		{name: "never-run", interval: 10 * time.Minute},
+		{name: "on-demand"},
+	}
+	rs := []*store.JobRecord{
+		{Name: "fresh", LastSucceededAt: now.Add(-90 * time.Minute)},
+		{Name: "stale", LastSucceededAt: now.Add(-3 * time.Hour)},
+		{Name: "on-demand", LastSucceededAt: now.AddDate(0, -1, 0)},
+	}
+	var got []string
+	for _, s := range jobStatuses(jobs, rs, now) {
+		if s.Record == nil || s.Record.Name != s.Name {
+			t.Errorf("%s: bad record %+v", s.Name, s.Record)
+		}
+		g := s.Name + " " + s.Interval
+		if s.Stale {
+			g += " stale"
+		}
+		got = append(got, g)
+	}
+	want := []string{
+		"fresh every 1h",
+		"stale every 1h stale",
+		"never-run every 10m stale",
+		"on-demand on demand",
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("mismatch (-want, +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,260 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package worker
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"golang.org/x/vulndb/internal/derrors"
+	"golang.org/x/vulndb/internal/idstr"
+	"golang.org/x/vulndb/internal/issues"
+	"golang.org/x/vulndb/internal/kev"
+	"golang.org/x/vulndb/internal/observe"
+	"golang.org/x/vulndb/internal/report"
+	"golang.org/x/vulndb/internal/worker/log"
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+// Labels used to escalate known-exploited vulnerabilities.
+const (
+	labelKnownExploited = "known exploited"
+	// labelHighPriority is the label vulnreport triage gives
+	// high-priority issues.
+	labelHighPriority = "high priority"
+)
+
+// A KEVFetchFunc fetches the CISA KEV catalog.
+type KEVFetchFunc func(context.Context) (*kev.Catalog, error)
+
+// SyncKEVStats are statistics about a run of SyncKEV.
+type SyncKEVStats struct {
+	// Number of CVEs in the catalog.
+	NumCVEs int
+	// Number of CVEs in the catalog that match a report or open issue.
+	NumMatched int
+	// Number of CVEs that match something they did not before.
+	NumNewlyMatched int
+	// Number of open issues labeled as known exploited.
+	NumEscalated int
+	// Number of review issues filed for UNREVIEWED reports.
+	NumIssues int
+}
+
+// SyncKEV matches the CVEs in CISA's catalog of Known Exploited
+// Vulnerabilities against the aliases of the reports in rc and of the
+// open issues mirrored in st (see SyncIssues), and records what each
+// CVE matches in a KEVRecord. The aliases of an issue are those in its
+// structured section (see issues.Meta), or else the IDs in its title.
+//
+// If client is not nil, SyncKEV escalates each matching open issue by
+// labeling it "known exploited" and "high priority", with a comment
+// saying why, and files an issue, labeled the same way, for each
+// matching UNREVIEWED report that does not have one, so that the
+// report gets reviewed.
+func SyncKEV(ctx context.Context, fetch KEVFetchFunc, rc *report.Client, st store.Store, client *issues.Client) (stats SyncKEVStats, err error) {
+	defer derrors.Wrap(&err, "SyncKEV")
+	ctx, span := observe.Start(ctx, "SyncKEV")
+	defer span.End()
+
+	cat, err := fetch(ctx)
+	if err != nil {
+		return stats, err
+	}
+	stats.NumCVEs = len(cat.Vulnerabilities)
+
+	old, err := st.ListKEVRecords(ctx)
+	if err != nil {
+		return stats, err
+	}
+	prev := make(map[string]*store.KEVRecord)
+	for _, r := range old {
+		prev[r.CVE] = r
+	}
+	open, err := st.ListIssueRecords(ctx, "open")
+	if err != nil {
+		return stats, err
+	}
+	issuesByCVE := make(map[string][]*store.IssueRecord)
+	for _, ir := range open {
+		for _, a := range issueAliases(ir) {
+			if idstr.IsCVE(a) {
+				issuesByCVE[a] = append(issuesByCVE[a], ir)
+			}
+		}
+	}
+
+	now := time.Now()
+	var (
+		rs       []*store.KEVRecord
+		escalate = make(map[*store.IssueRecord][]*kev.Vulnerability)
+	)
+	for _, v := range cat.Vulnerabilities {
+		reports := rc.ReportsByAlias(v.CVEID)
+		matched := issuesByCVE[v.CVEID]
+		if len(reports) == 0 && len(matched) == 0 {
+			continue
+		}
+		stats.NumMatched++
+		kr := &store.KEVRecord{
+			CVE:               v.CVEID,
+			DateAdded:         v.DateAdded,
+			VulnerabilityName: v.VulnerabilityName,
+			SyncedAt:          now,
+		}
+		for _, r := range reports {
+			kr.ReportIDs = append(kr.ReportIDs, r.ID)
+		}
+		for _, ir := range matched {
+			kr.Issues = append(kr.Issues, ir.Number)
+			if !slices.Contains(ir.Labels, labelKnownExploited) {
+				escalate[ir] = append(escalate[ir], v)
+			}
+		}
+		if p := prev[v.CVEID]; p != nil {
+			kr.FirstSeen = p.FirstSeen
+			kr.IssueReference = p.IssueReference
+			if !isSubset(kr.ReportIDs, p.ReportIDs) || !isSubset(kr.Issues, p.Issues) {
+				stats.NumNewlyMatched++
+			}
+		} else {
+			kr.FirstSeen = now
+			stats.NumNewlyMatched++
+			log.Warningf(ctx, "%s is known to be exploited: reports %v, open issues %v", v.CVEID, kr.ReportIDs, kr.Issues)
+		}
+		if client != nil && kr.IssueReference == "" {
+			if r := firstUnreviewed(reports); r != nil {
+				ref, err := createKEVReviewIssue(ctx, client, r, v)
+				if err != nil {
+					return stats, err
+				}
+				kr.IssueReference = ref
+				stats.NumIssues++
+			}
+		}
+		rs = append(rs, kr)
+	}
+
+	if client != nil {
+		var escalated []*store.IssueRecord
+		// Escalate in issue order, for reproducible logs.
+		for _, ir := range open {
+			vs, ok := escalate[ir]
+			if !ok {
+				continue
+			}
+			if err := escalateIssue(ctx, client, ir, vs); err != nil {
+				return stats, err
+			}
+			escalated = append(escalated, ir)
+			stats.NumEscalated++
+		}
+		// Record the new labels now, rather than waiting for
+		// the next sync of the issues.
+		if err := st.SetIssueRecords(ctx, escalated); err != nil {
+			return stats, err
+		}
+	}
+	if err := st.SetKEVRecords(ctx, rs); err != nil {
+		return stats, err
+	}
+	log.Infof(ctx, "KEV sync succeeded: %+v", stats)
+	return stats, nil
+}
+
+// issueAliases returns the IDs of the vulnerabilities the issue of ir
+// is about.
+func issueAliases(ir *store.IssueRecord) []string {
+	if m := issues.ParseMeta(ir.Body); m != nil && len(m.Aliases) > 0 {
+		return m.Aliases
+	}
+	return idstr.FindAliases(ir.Title)
+}
+
+// firstUnreviewed returns the first of the reports that is UNREVIEWED
+// and not withdrawn, or nil if there is none. Excluded reports are not
+// UNREVIEWED.
+func firstUnreviewed(rs []*report.Report) *report.Report {
+	for _, r := range rs {
+		if r.IsUnreviewed() && r.Withdrawn == nil {
+			return r
+		}
+	}
+	return nil
+}
+
+// isSubset reports whether every element of s is in t.
+func isSubset[T comparable](s, t []T) bool {
+	for _, v := range s {
+		if !slices.Contains(t, v) {
+			return false
+		}
+	}
+	return true
+}
+
+// escalateIssue labels the issue of ir as known exploited and high
+// priority, and comments on it with the vulnerabilities vs of the KEV
+// catalog that it is about. It updates the labels of ir to match.
+func escalateIssue(ctx context.Context, client *issues.Client, ir *store.IssueRecord, vs []*kev.Vulnerability) (err error) {
+	defer derrors.Wrap(&err, "escalateIssue(%d)", ir.Number)
+
+	var b strings.Builder
+	for _, v := range vs {
+		fmt.Fprintf(&b, "%s (%s) was added to CISA's catalog of Known Exploited Vulnerabilities on %s.\n",
+			v.CVEID, v.VulnerabilityName, v.DateAdded)
+	}
+	b.WriteString("\nThis issue is now high priority.\n")
+
+	labels := slices.Clone(ir.Labels)
+	for _, l := range []string{labelKnownExploited, labelHighPriority} {
+		if !slices.Contains(labels, l) {
+			labels = append(labels, l)
+		}
+	}
+	if err := issueRateLimiter.Wait(ctx); err != nil {
+		return err
+	}
+	if err := client.AddComments(ctx, ir.Number, []string{b.String()}); err != nil {
+		return err
+	}
+	if err := client.SetLabels(ctx, ir.Number, labels); err != nil {
+		return err
+	}
+	ir.Labels = labels
+	log.Infof(ctx, "escalated issue %s: known exploited", client.Reference(ir.Number))
+	return nil
+}
+
+// createKEVReviewIssue files an issue asking for the UNREVIEWED report
+// r of the known-exploited vulnerability v to be reviewed, and returns
+// a reference to it.
+func createKEVReviewIssue(ctx context.Context, client *issues.Client, r *report.Report, v *kev.Vulnerability) (ref string, err error) {
+	defer derrors.Wrap(&err, "createKEVReviewIssue(%s)", r.ID)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s (%s) was added to CISA's catalog of Known Exploited Vulnerabilities on %s, but its report %s is %s.\n",
+		v.CVEID, v.VulnerabilityName, v.DateAdded, r.ID, report.Unreviewed)
+	fmt.Fprintf(&b, "\nReview the report, so that its symbols and version ranges are accurate.\n")
+
+	iss := &issues.Issue{
+		Title:  fmt.Sprintf("x/vulndb: review %s: %s is known to be exploited", r.ID, v.CVEID),
+		Body:   b.String(),
+		Labels: []string{labelKnownExploited, labelHighPriority},
+	}
+	if err := issueRateLimiter.Wait(ctx); err != nil {
+		return "", err
+	}
+	num, err := client.CreateIssue(ctx, iss)
+	if err != nil {
+		return "", err
+	}
+	ref = client.Reference(num)
+	log.Infof(ctx, "created issue %s to review %s", ref, r.ID)
+	return ref, nil
+}
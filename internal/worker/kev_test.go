@@ -0,0 +1,197 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/vulndb/internal/issues"
+	"golang.org/x/vulndb/internal/issues/githubtest"
+	"golang.org/x/vulndb/internal/kev"
+	"golang.org/x/vulndb/internal/report"
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+func TestSyncKEV(t *testing.T) {
+	ctx := context.Background()
+
+	newReport := func(id, cve string, rs report.ReviewStatus) *report.Report {
+		return &report.Report{
+			ID:           id,
+			ReviewStatus: rs,
+			CVEs:         []string{cve},
+			Modules:      []*report.Module{{Module: "example.com/module"}},
+		}
+	}
+	rc, err := report.NewTestClient(map[string]*report.Report{
+		"data/reports/GO-1999-0001.yaml": newReport("GO-1999-0001", "CVE-1999-0001", report.Reviewed),
+		"data/reports/GO-1999-0002.yaml": newReport("GO-1999-0002", "CVE-1999-0002", report.Unreviewed),
+		"data/reports/GO-1999-0003.yaml": newReport("GO-1999-0003", "CVE-1999-0003", report.Reviewed),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	vuln := func(cve string) *kev.Vulnerability {
+		return &kev.Vulnerability{CVEID: cve, VulnerabilityName: "Example Vulnerability", DateAdded: "2024-03-01"}
+	}
+	cat := &kev.Catalog{
+		Vulnerabilities: []*kev.Vulnerability{
+			vuln("CVE-1999-0001"),
+			vuln("CVE-1999-0002"),
+			vuln("CVE-2000-0010"),
+			vuln("CVE-2000-0011"),
+			// Not in vulndb.
+			vuln("CVE-2000-9999"),
+		},
+	}
+	fetch := func(context.Context) (*kev.Catalog, error) { return cat, nil }
+
+	mstore := store.NewMemStore()
+	meta := &issues.Meta{Aliases: []string{"CVE-2000-0011", "GHSA-xxxx-yyyy-zzzz"}}
+	if err := mstore.SetIssueRecords(ctx, []*store.IssueRecord{
+		{Number: 10, State: "open", Title: "x/vulndb: potential Go vuln in example.com/a: CVE-2000-0010", Labels: []string{"NeedsTriage"}},
+		{Number: 11, State: "open", Title: "x/vulndb: potential Go vuln in example.com/b: GHSA-xxxx-yyyy-zzzz", Body: meta.Section()},
+		// Already escalated.
+		{Number: 12, State: "open", Title: "x/vulndb: potential Go vuln in example.com/c: CVE-2000-0011", Labels: []string{labelKnownExploited}},
+		{Number: 13, State: "closed", Title: "x/vulndb: potential Go vuln in example.com/d: CVE-1999-0001"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	ic, mux := githubtest.Setup(ctx, t, &issues.Config{
+		Owner: githubtest.TestOwner,
+		Repo:  githubtest.TestRepo,
+		Token: githubtest.TestToken,
+	})
+	prefix := fmt.Sprintf("/repos/%s/%s/issues", githubtest.TestOwner, githubtest.TestRepo)
+	var (
+		mu       sync.Mutex
+		filed    []*issues.Issue
+		comments = map[int][]string{}
+		labels   = map[int][]string{}
+	)
+	mux.HandleFunc(prefix, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			return
+		}
+		var iss issues.Issue
+		if err := json.NewDecoder(r.Body).Decode(&iss); err != nil {
+			t.Error(err)
+		}
+		filed = append(filed, &iss)
+		fmt.Fprintf(w, `{"number":%d}`, 100+len(filed))
+	})
+	mux.HandleFunc(prefix+"/", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		var num int
+		rest := strings.TrimPrefix(r.URL.Path, prefix+"/")
+		var body struct {
+			Body   string
+			Labels []string
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Error(err)
+			return
+		}
+		switch {
+		case r.Method == http.MethodPost && strings.HasSuffix(rest, "/comments"):
+			fmt.Sscanf(rest, "%d/comments", &num)
+			comments[num] = append(comments[num], body.Body)
+			fmt.Fprint(w, `{}`)
+		case r.Method == http.MethodPatch:
+			fmt.Sscanf(rest, "%d", &num)
+			labels[num] = body.Labels
+			fmt.Fprintf(w, `{"number": %d}`, num)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	start := time.Now()
+	stats, err := SyncKEV(ctx, fetch, rc, mstore, ic)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantStats := SyncKEVStats{NumCVEs: 5, NumMatched: 4, NumNewlyMatched: 4, NumEscalated: 2, NumIssues: 1}
+	if stats != wantStats {
+		t.Errorf("stats = %+v, want %+v", stats, wantStats)
+	}
+	if len(filed) != 1 || filed[0].Title != "x/vulndb: review GO-1999-0002: CVE-1999-0002 is known to be exploited" {
+		t.Fatalf("filed issues %+v, want one for GO-1999-0002", filed)
+	}
+	wantLabels := map[int][]string{
+		10: {"NeedsTriage", labelKnownExploited, labelHighPriority},
+		11: {labelKnownExploited, labelHighPriority},
+	}
+	if diff := cmp.Diff(wantLabels, labels); diff != "" {
+		t.Errorf("labels mismatch (-want, +got):\n%s", diff)
+	}
+	for num, cve := range map[int]string{10: "CVE-2000-0010", 11: "CVE-2000-0011"} {
+		if len(comments[num]) != 1 || !strings.Contains(comments[num][0], cve) {
+			t.Errorf("issue %d: comments %q, want one mentioning %s", num, comments[num], cve)
+		}
+	}
+	// The store has the new labels.
+	ir, err := mstore.GetIssueRecord(ctx, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(wantLabels[10], ir.Labels); diff != "" {
+		t.Errorf("issue record labels mismatch (-want, +got):\n%s", diff)
+	}
+
+	got, err := mstore.ListKEVRecords(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, r := range got {
+		if r.FirstSeen.Before(start) || r.SyncedAt.Before(start) {
+			t.Errorf("%s: FirstSeen = %v, SyncedAt = %v, want after %v", r.CVE, r.FirstSeen, r.SyncedAt, start)
+		}
+		r.FirstSeen, r.SyncedAt = time.Time{}, time.Time{}
+	}
+	record := func(cve string) *store.KEVRecord {
+		return &store.KEVRecord{CVE: cve, DateAdded: "2024-03-01", VulnerabilityName: "Example Vulnerability"}
+	}
+	want := []*store.KEVRecord{
+		record("CVE-1999-0001"),
+		record("CVE-1999-0002"),
+		record("CVE-2000-0010"),
+		record("CVE-2000-0011"),
+	}
+	want[0].ReportIDs = []string{"GO-1999-0001"}
+	want[1].ReportIDs = []string{"GO-1999-0002"}
+	want[1].IssueReference = ic.Reference(101)
+	want[2].Issues = []int{10}
+	want[3].Issues = []int{11, 12}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("KEV records mismatch (-want, +got):\n%s", diff)
+	}
+
+	// A second sync neither escalates nor files anything again.
+	filed, comments, labels = nil, map[int][]string{}, map[int][]string{}
+	stats, err = SyncKEV(ctx, fetch, rc, mstore, ic)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantStats = SyncKEVStats{NumCVEs: 5, NumMatched: 4}
+	if stats != wantStats {
+		t.Errorf("second sync: stats = %+v, want %+v", stats, wantStats)
+	}
+	if len(filed) != 0 || len(comments) != 0 || len(labels) != 0 {
+		t.Errorf("second sync: filed %v, commented %v, labeled %v; want nothing", filed, comments, labels)
+	}
+}
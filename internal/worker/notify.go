@@ -0,0 +1,287 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package worker
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"golang.org/x/oauth2/google"
+	"golang.org/x/vulndb/internal/database"
+	"golang.org/x/vulndb/internal/derrors"
+	"golang.org/x/vulndb/internal/observe"
+	"golang.org/x/vulndb/internal/worker/log"
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+// DefaultVulnDBURL is the URL of the Go vulnerability database
+// whose entries notifications are sent about.
+const DefaultVulnDBURL = "https://vuln.go.dev"
+
+// OSVEventType is the type of the events sent by NotifyOSVChanges.
+const OSVEventType = "osv.modified"
+
+// An OSVEvent is a notification that OSV entries were added to
+// or modified in the Go vulnerability database.
+type OSVEvent struct {
+	Type string `json:"type"`
+	// Entries are the added or modified entries, ordered by ID.
+	Entries []*OSVEventEntry `json:"entries"`
+}
+
+// An OSVEventEntry identifies an added or modified OSV entry.
+type OSVEventEntry struct {
+	ID       string    `json:"id"`
+	Modified time.Time `json:"modified"`
+}
+
+// A Notifier sends OSVEvents to a notification target.
+type Notifier interface {
+	// Target is the notification target, as in the worker config.
+	Target() string
+	Notify(ctx context.Context, e *OSVEvent) error
+}
+
+// NewNotifiers returns a Notifier for each of the notification
+// targets. Webhooks are called with hc, or http.DefaultClient if hc is
+// nil. Pub/Sub topics are published to with the default Google
+// credentials.
+func NewNotifiers(ctx context.Context, targets []string, hc *http.Client) (_ []Notifier, err error) {
+	defer derrors.Wrap(&err, "NewNotifiers")
+
+	if hc == nil {
+		hc = http.DefaultClient
+	}
+	var (
+		ns       []Notifier
+		pubsubHC *http.Client
+	)
+	for _, t := range targets {
+		if err := store.ValidateNotificationTarget(t); err != nil {
+			return nil, fmt.Errorf("%q: %v", t, err)
+		}
+		topic, ok := strings.CutPrefix(t, store.PubSubTargetPrefix)
+		if !ok {
+			ns = append(ns, &webhookNotifier{url: t, hc: hc})
+			continue
+		}
+		if pubsubHC == nil {
+			pubsubHC, err = google.DefaultClient(ctx, pubsubScope)
+			if err != nil {
+				return nil, err
+			}
+		}
+		ns = append(ns, &pubsubNotifier{topic: topic, endpoint: pubsubEndpoint, hc: pubsubHC})
+	}
+	return ns, nil
+}
+
+// webhookNotifier POSTs events as JSON to a URL.
+type webhookNotifier struct {
+	url string
+	hc  *http.Client
+}
+
+func (n *webhookNotifier) Target() string { return n.url }
+
+func (n *webhookNotifier) Notify(ctx context.Context, e *OSVEvent) (err error) {
+	defer derrors.Wrap(&err, "webhookNotifier.Notify(%s)", n.url)
+
+	body, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	return post(ctx, n.hc, n.url, body)
+}
+
+const (
+	pubsubEndpoint = "https://pubsub.googleapis.com/v1/"
+	pubsubScope    = "https://www.googleapis.com/auth/pubsub"
+)
+
+// pubsubNotifier publishes events to a Pub/Sub topic, with the
+// Pub/Sub REST API. Each event is a message whose data is the
+// event as JSON, and whose "type" attribute is the event type.
+type pubsubNotifier struct {
+	// topic is "projects/PROJECT/topics/TOPIC".
+	topic    string
+	endpoint string
+	hc       *http.Client
+}
+
+func (n *pubsubNotifier) Target() string { return store.PubSubTargetPrefix + n.topic }
+
+func (n *pubsubNotifier) Notify(ctx context.Context, e *OSVEvent) (err error) {
+	defer derrors.Wrap(&err, "pubsubNotifier.Notify(%s)", n.topic)
+
+	data, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	type message struct {
+		Data       []byte            `json:"data"` // base64-encoded by encoding/json
+		Attributes map[string]string `json:"attributes"`
+	}
+	body, err := json.Marshal(struct {
+		Messages []message `json:"messages"`
+	}{
+		Messages: []message{{Data: data, Attributes: map[string]string{"type": e.Type}}},
+	})
+	if err != nil {
+		return err
+	}
+	return post(ctx, n.hc, n.endpoint+n.topic+":publish", body)
+}
+
+func post(ctx context.Context, hc *http.Client, url string, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := hc.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("POST %s: HTTP error: %s: %s", url, resp.Status, bytes.TrimSpace(b))
+	}
+	return nil
+}
+
+// NotifyStats are statistics about a run of NotifyOSVChanges.
+type NotifyStats struct {
+	// Number of entries in the database.
+	NumEntries int
+	// Number of entries added or modified since the last run.
+	NumChanged int
+	// Number of targets notified.
+	NumNotified int
+}
+
+// NotifyOSVChanges notifies the notification targets of the worker
+// config in st of the entries of the vulnerability database at dbURL
+// (DefaultVulnDBURL if empty) that were added or modified since the
+// last call, according to the NotificationRecord in st. It does
+// nothing if there are no targets.
+//
+// The first call notifies no one, and only records the state of the
+// database. Notifications are sent at least once: if any target
+// cannot be notified, the state is not updated, so the next call sends
+// the same entries again, and more, to all the targets.
+func NotifyOSVChanges(ctx context.Context, st store.Store, dbURL string) (NotifyStats, error) {
+	if dbURL == "" {
+		dbURL = DefaultVulnDBURL
+	}
+	lc, err := loadLiveConfig(ctx, st)
+	if err != nil {
+		return NotifyStats{}, err
+	}
+	if len(lc.notificationTargets) == 0 {
+		log.Infof(ctx, "NotifyOSVChanges: no notification targets")
+		return NotifyStats{}, nil
+	}
+	notifiers, err := NewNotifiers(ctx, lc.notificationTargets, nil)
+	if err != nil {
+		return NotifyStats{}, err
+	}
+	return notifyOSVChanges(ctx, st, dbURL, http.DefaultClient, notifiers, time.Now())
+}
+
+func notifyOSVChanges(ctx context.Context, st store.Store, dbURL string, hc *http.Client, notifiers []Notifier, now time.Time) (stats NotifyStats, err error) {
+	defer derrors.Wrap(&err, "NotifyOSVChanges(%s)", dbURL)
+	ctx, span := observe.Start(ctx, "NotifyOSVChanges")
+	defer span.End()
+
+	vulns, err := fetchVulnsIndex(ctx, hc, dbURL)
+	if err != nil {
+		return stats, err
+	}
+	stats.NumEntries = len(vulns)
+
+	var latest time.Time
+	for _, v := range vulns {
+		if m := v.Modified.Time; m.After(latest) {
+			latest = m
+		}
+	}
+	nr, err := st.GetNotificationRecord(ctx)
+	if err != nil {
+		return stats, err
+	}
+	if nr == nil {
+		log.Infof(ctx, "NotifyOSVChanges: first run; recording latest modified time %s", latest)
+		return stats, st.SetNotificationRecord(ctx, &store.NotificationRecord{LatestModified: latest, NotifiedAt: now})
+	}
+
+	e := &OSVEvent{Type: OSVEventType}
+	for _, v := range vulns {
+		if v.Modified.Time.After(nr.LatestModified) {
+			e.Entries = append(e.Entries, &OSVEventEntry{ID: v.ID, Modified: v.Modified.Time})
+		}
+	}
+	stats.NumChanged = len(e.Entries)
+	if len(e.Entries) == 0 {
+		return stats, nil
+	}
+	slices.SortFunc(e.Entries, func(a, b *OSVEventEntry) int {
+		return strings.Compare(a.ID, b.ID)
+	})
+
+	var errs []error
+	for _, n := range notifiers {
+		if err := n.Notify(ctx, e); err != nil {
+			log.Errorf(ctx, "NotifyOSVChanges: notifying %s: %v", n.Target(), err)
+			errs = append(errs, err)
+			continue
+		}
+		stats.NumNotified++
+	}
+	if err := errors.Join(errs...); err != nil {
+		return stats, err
+	}
+	if err := st.SetNotificationRecord(ctx, &store.NotificationRecord{LatestModified: latest, NotifiedAt: now}); err != nil {
+		return stats, err
+	}
+	log.Infof(ctx, "NotifyOSVChanges done: %+v", stats)
+	return stats, nil
+}
+
+// fetchVulnsIndex fetches index/vulns.json from the vulnerability
+// database at dbURL.
+func fetchVulnsIndex(ctx context.Context, hc *http.Client, dbURL string) (database.VulnsIndex, error) {
+	u := strings.TrimSuffix(dbURL, "/") + "/index/vulns.json"
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := hc.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("GET %s: HTTP error: %s", u, resp.Status)
+	}
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	vulns := make(database.VulnsIndex)
+	if err := json.Unmarshal(data, &vulns); err != nil {
+		return nil, fmt.Errorf("%s: %w", u, err)
+	}
+	return vulns, nil
+}
@@ -0,0 +1,161 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+func TestNotifyOSVChanges(t *testing.T) {
+	ctx := context.Background()
+	t1 := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	t2 := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+	now := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	var (
+		mu        sync.Mutex
+		vulnsJSON string
+		webhook   []*OSVEvent
+		published []*OSVEvent
+		failHook  bool
+	)
+	mux := http.NewServeMux()
+	mux.HandleFunc("/db/index/vulns.json", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		io.WriteString(w, vulnsJSON)
+	})
+	mux.HandleFunc("/hook", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		if failHook {
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+			return
+		}
+		var e OSVEvent
+		if err := json.NewDecoder(r.Body).Decode(&e); err != nil {
+			t.Error(err)
+		}
+		webhook = append(webhook, &e)
+	})
+	mux.HandleFunc("/pubsub/projects/p/topics/t:publish", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		var body struct {
+			Messages []struct {
+				Data       []byte
+				Attributes map[string]string
+			}
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Error(err)
+		}
+		for _, m := range body.Messages {
+			if got := m.Attributes["type"]; got != OSVEventType {
+				t.Errorf("message type = %q, want %q", got, OSVEventType)
+			}
+			var e OSVEvent
+			if err := json.Unmarshal(m.Data, &e); err != nil {
+				t.Error(err)
+			}
+			published = append(published, &e)
+		}
+		io.WriteString(w, `{"messageIds": ["1"]}`)
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	notifiers := []Notifier{
+		&webhookNotifier{url: srv.URL + "/hook", hc: srv.Client()},
+		&pubsubNotifier{topic: "projects/p/topics/t", endpoint: srv.URL + "/pubsub/", hc: srv.Client()},
+	}
+	mstore := store.NewMemStore()
+	run := func(vulns string, fail bool, wantStats NotifyStats, wantErr bool) {
+		t.Helper()
+		mu.Lock()
+		vulnsJSON = vulns
+		failHook = fail
+		webhook, published = nil, nil
+		mu.Unlock()
+		stats, err := notifyOSVChanges(ctx, mstore, srv.URL+"/db", srv.Client(), notifiers, now)
+		if (err != nil) != wantErr {
+			t.Fatalf("got error %v, want error: %t", err, wantErr)
+		}
+		if stats != wantStats {
+			t.Errorf("got stats %+v, want %+v", stats, wantStats)
+		}
+	}
+
+	// The first run only records the state of the DB.
+	run(`[{"id":"GO-2024-0001","modified":"2024-01-01T00:00:00Z"}]`, false, NotifyStats{NumEntries: 1}, false)
+	if len(webhook) != 0 || len(published) != 0 {
+		t.Errorf("first run sent notifications")
+	}
+	nr, err := mstore.GetNotificationRecord(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(&store.NotificationRecord{LatestModified: t1, NotifiedAt: now}, nr); diff != "" {
+		t.Errorf("notification record mismatch (-want, +got):\n%s", diff)
+	}
+
+	// An entry is modified and another is added.
+	changed := `[{"id":"GO-2024-0002","modified":"2024-02-01T00:00:00Z"},
+	{"id":"GO-2024-0001","modified":"2024-02-01T00:00:00Z"},
+	{"id":"GO-2023-0001","modified":"2023-01-01T00:00:00Z"}]`
+	want := []*OSVEvent{{
+		Type: OSVEventType,
+		Entries: []*OSVEventEntry{
+			{ID: "GO-2024-0001", Modified: t2},
+			{ID: "GO-2024-0002", Modified: t2},
+		},
+	}}
+
+	// If a target fails, the others are notified but the state
+	// is not updated.
+	run(changed, true, NotifyStats{NumEntries: 3, NumChanged: 2, NumNotified: 1}, true)
+	if diff := cmp.Diff(want, published); diff != "" {
+		t.Errorf("published mismatch (-want, +got):\n%s", diff)
+	}
+
+	// So the next run sends the same entries again.
+	run(changed, false, NotifyStats{NumEntries: 3, NumChanged: 2, NumNotified: 2}, false)
+	if diff := cmp.Diff(want, webhook); diff != "" {
+		t.Errorf("webhook mismatch (-want, +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(want, published); diff != "" {
+		t.Errorf("published mismatch (-want, +got):\n%s", diff)
+	}
+
+	// Nothing changed.
+	run(changed, false, NotifyStats{NumEntries: 3}, false)
+	if len(webhook) != 0 || len(published) != 0 {
+		t.Errorf("run without changes sent notifications")
+	}
+}
+
+func TestNewNotifiers(t *testing.T) {
+	ctx := context.Background()
+	ns, err := NewNotifiers(ctx, []string{"https://example.com/hook"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ns) != 1 || ns[0].Target() != "https://example.com/hook" {
+		t.Errorf("got %v, want one notifier for https://example.com/hook", ns)
+	}
+	if _, err := NewNotifiers(ctx, []string{"pubsub:topics/t"}, nil); err == nil {
+		t.Error("invalid target: got nil error, want error")
+	}
+}
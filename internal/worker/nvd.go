@@ -0,0 +1,167 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package worker
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"golang.org/x/mod/module"
+	"golang.org/x/vulndb/internal/cve4"
+	"golang.org/x/vulndb/internal/derrors"
+	"golang.org/x/vulndb/internal/nvd"
+	"golang.org/x/vulndb/internal/observe"
+	"golang.org/x/vulndb/internal/proxy"
+	"golang.org/x/vulndb/internal/report"
+	"golang.org/x/vulndb/internal/stdlib"
+	"golang.org/x/vulndb/internal/triage"
+	"golang.org/x/vulndb/internal/worker/log"
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+// An NVDListFunc returns the NVD CVEs modified since the given time.
+type NVDListFunc func(_ context.Context, since time.Time) ([]*nvd.CVE, error)
+
+type ScanNVDStats struct {
+	// Number of NVD CVEs seen.
+	NumProcessed int
+	// Number of NVD CVEs with CPEs that refer to Go.
+	NumGo int
+	// Number of CVE4Records changed to NeedsIssue.
+	NumNeedsIssue int
+}
+
+// ScanNVD cross-checks the CVEs modified in the NVD since the given time
+// against the store. CVEs whose CPE data refers to Go, but which
+// the cvelist triage decided needed no action, are marked as needing
+// an issue, so that a subsequent call to CreateIssues files one.
+//
+// CVEs that are not yet in the store are skipped; they will be triaged
+// when they appear in the cvelist repo.
+//
+// The module of each CVE is guessed from its CPEs, using pc to check
+// that guessed golang.org/x modules exist.
+func ScanNVD(ctx context.Context, list NVDListFunc, since time.Time, st store.Store, pc *proxy.Client, rc *report.Client) (stats ScanNVDStats, err error) {
+	defer derrors.Wrap(&err, "ScanNVD(%s)", since)
+	ctx, span := observe.Start(ctx, "ScanNVD")
+	defer span.End()
+
+	log.Infof(ctx, "Starting NVD scan, looking at CVEs modified since=%s", since)
+	cves, err := list(ctx, since)
+	if err != nil {
+		return stats, err
+	}
+	stats.NumProcessed = len(cves)
+
+	var goCVEs []*nvd.CVE
+	for _, c := range cves {
+		if len(c.GoCPEs()) > 0 && !rc.AliasHasReport(c.ID) {
+			goCVEs = append(goCVEs, c)
+		}
+	}
+	stats.NumGo = len(goCVEs)
+	if len(goCVEs) > maxTransactionWrites {
+		return stats, errors.New("number of Go CVEs exceeds maxTransactionWrites")
+	}
+	// Look up modules outside the transaction, which may be retried.
+	modules := make(map[string]string)
+	for _, c := range goCVEs {
+		modules[c.ID] = modulePathFromCPEs(c.GoCPEs(), pc)
+	}
+
+	numNeedsIssue := 0
+	err = st.RunTransaction(ctx, func(ctx context.Context, tx store.Transaction) error {
+		numNeedsIssue = 0
+		for _, c := range goCVEs {
+			r, err := tx.GetRecord(c.ID)
+			if err != nil {
+				return err
+			}
+			if r == nil {
+				log.Debugf(ctx, "%s: in NVD but not yet in store; skipping", c.ID)
+				continue
+			}
+			cr, ok := r.(*store.CVE4Record)
+			if !ok || cr.TriageState != store.TriageStateNoActionNeeded || cr.CVEState != cve4.StatePublic {
+				continue
+			}
+			cpes := c.GoCPEs()
+			mod := *cr
+			mod.TriageState = store.TriageStateNeedsIssue
+			mod.Module = modules[c.ID]
+			mod.TriageStateReason = "NVD CPE data refers to Go: " + cpeStrings(cpes)
+			// Keep the record from the cvelist repo, which has the
+			// description and references the issue is made from.
+			if mod.CVE == nil && mod.CVE5 == nil {
+				mod.CVE = cve4FromNVD(c)
+			}
+			mod.History = append([]*store.CVE4RecordSnapshot{cr.Snapshot()}, mod.History...)
+			log.Infof(ctx, "%s: marked NeedsIssue from NVD (%s)", c.ID, mod.TriageStateReason)
+			if err := tx.SetRecord(&mod); err != nil {
+				return err
+			}
+			numNeedsIssue++
+		}
+		return nil
+	})
+	stats.NumNeedsIssue = numNeedsIssue
+	if err != nil {
+		return stats, err
+	}
+	log.Infof(ctx, "NVD scan succeeded with since=%s: %+v", since, stats)
+	return stats, nil
+}
+
+// modulePathFromCPEs guesses the affected Go module from CPE data.
+// The golang:go product is the standard library, and another golang
+// product is a golang.org/x module only if the proxy knows it: not all
+// of them are (for example, golang:protobuf is google.golang.org/protobuf).
+// If there is no such product, it returns triage.UnknownPath.
+func modulePathFromCPEs(cpes []*nvd.CPE, pc *proxy.Client) string {
+	for _, c := range cpes {
+		if c.Vendor != "golang" {
+			continue
+		}
+		if c.Product == "go" {
+			return stdlib.ModulePath
+		}
+		mp := "golang.org/x/" + c.Product
+		if module.CheckPath(mp) == nil && pc.ModuleExists(mp) {
+			return mp
+		}
+	}
+	return triage.UnknownPath
+}
+
+func cpeStrings(cpes []*nvd.CPE) string {
+	var ss []string
+	for _, c := range cpes {
+		ss = append(ss, c.String())
+	}
+	return strings.Join(ss, ", ")
+}
+
+// cve4FromNVD converts the fields of an NVD CVE needed to file
+// an issue into a CVE record, for store records that have none.
+func cve4FromNVD(c *nvd.CVE) *cve4.CVE {
+	cve := &cve4.CVE{
+		Metadata: cve4.Metadata{
+			ID:    c.ID,
+			State: cve4.StatePublic,
+		},
+		DataType:    "CVE",
+		DataFormat:  "MITRE",
+		DataVersion: "4.0",
+	}
+	if d := c.Description(); d != "" {
+		cve.Description.Data = []cve4.LangString{{Lang: "eng", Value: d}}
+	}
+	for _, r := range c.References {
+		cve.References.Data = append(cve.References.Data, cve4.Reference{URL: r.URL})
+	}
+	return cve
+}
@@ -0,0 +1,157 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package worker
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/vulndb/internal/cve4"
+	"golang.org/x/vulndb/internal/nvd"
+	"golang.org/x/vulndb/internal/proxy"
+	"golang.org/x/vulndb/internal/report"
+	"golang.org/x/vulndb/internal/triage"
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+func TestScanNVD(t *testing.T) {
+	ctx := context.Background()
+
+	goCPE := []nvd.Configuration{{Nodes: []nvd.Node{{CPEMatch: []nvd.CPEMatch{
+		{Vulnerable: true, Criteria: "cpe:2.3:a:golang:go:*:*:*:*:*:*:*:*"},
+	}}}}}
+	cpe := func(product string) []nvd.Configuration {
+		return []nvd.Configuration{{Nodes: []nvd.Node{{CPEMatch: []nvd.CPEMatch{
+			{Vulnerable: true, Criteria: "cpe:2.3:a:golang:" + product + ":*:*:*:*:*:*:*:*"},
+		}}}}}
+	}
+	otherCPE := []nvd.Configuration{{Nodes: []nvd.Node{{CPEMatch: []nvd.CPEMatch{
+		{Vulnerable: true, Criteria: "cpe:2.3:a:example:other:*:*:*:*:*:*:*:*"},
+	}}}}}
+	cves := []*nvd.CVE{
+		{
+			ID:             "CVE-2000-0001",
+			Descriptions:   []nvd.Description{{Lang: "en", Value: "a Go vuln"}},
+			References:     []nvd.Reference{{URL: "https://go.dev/issue/1"}},
+			Configurations: goCPE,
+		},
+		{ID: "CVE-2000-0002", Configurations: goCPE},
+		{ID: "CVE-2000-0003", Configurations: goCPE},
+		{ID: "CVE-2000-0004", Configurations: otherCPE},
+		{ID: "CVE-2000-0005", Configurations: goCPE},
+		{ID: "CVE-2000-0006", Configurations: cpe("crypto")},
+		// There is no golang.org/x/protobuf.
+		{ID: "CVE-2000-0007", Configurations: cpe("protobuf")},
+	}
+	list := func(context.Context, time.Time) ([]*nvd.CVE, error) { return cves, nil }
+
+	mstore := store.NewMemStore()
+	record := func(id string, ts store.TriageState) *store.CVE4Record {
+		return &store.CVE4Record{
+			ID:          id,
+			Path:        "path/" + id,
+			BlobHash:    "bh",
+			CommitHash:  "ch",
+			CommitTime:  time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC),
+			CVEState:    cve4.StatePublic,
+			TriageState: ts,
+		}
+	}
+	// CVE-2000-0006 already has a CVE record from the cvelist repo.
+	cvelistCVE := &cve4.CVE{
+		Metadata:    cve4.Metadata{ID: "CVE-2000-0006", State: cve4.StatePublic},
+		Description: cve4.Description{Data: []cve4.LangString{{Lang: "eng", Value: "from cvelist"}}},
+	}
+	withCVE := record("CVE-2000-0006", store.TriageStateNoActionNeeded)
+	withCVE.CVE = cvelistCVE
+	// CVE-2000-0003 is not in the store.
+	createCVE4Records(t, mstore, []*store.CVE4Record{
+		record("CVE-2000-0001", store.TriageStateNoActionNeeded),
+		record("CVE-2000-0002", store.TriageStateIssueCreated),
+		record("CVE-2000-0004", store.TriageStateNoActionNeeded),
+		record("CVE-2000-0005", store.TriageStateNoActionNeeded),
+		withCVE,
+		record("CVE-2000-0007", store.TriageStateNoActionNeeded),
+	})
+	rc, err := report.NewTestClient(map[string]*report.Report{
+		"data/reports/GO-1999-0001.yaml": {CVEs: []string{"CVE-2000-0005"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pc, err := proxy.NewTestClient(t, *realProxy)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	gotStats, err := ScanNVD(ctx, list, time.Time{}, mstore, pc, rc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (ScanNVDStats{NumProcessed: 7, NumGo: 5, NumNeedsIssue: 3}); gotStats != want {
+		t.Errorf("got stats %+v, want %+v", gotStats, want)
+	}
+
+	want := record("CVE-2000-0001", store.TriageStateNeedsIssue)
+	want.Module = "std"
+	want.TriageStateReason = "NVD CPE data refers to Go: golang:go"
+	want.CVE = &cve4.CVE{
+		Metadata:    cve4.Metadata{ID: "CVE-2000-0001", State: cve4.StatePublic},
+		DataType:    "CVE",
+		DataFormat:  "MITRE",
+		DataVersion: "4.0",
+		Description: cve4.Description{Data: []cve4.LangString{{Lang: "eng", Value: "a Go vuln"}}},
+		References:  cve4.References{Data: []cve4.Reference{{URL: "https://go.dev/issue/1"}}},
+	}
+	want.History = []*store.CVE4RecordSnapshot{{
+		CommitHash:  "ch",
+		CVEState:    cve4.StatePublic,
+		TriageState: store.TriageStateNoActionNeeded,
+	}}
+	got, err := mstore.GetRecord(ctx, "CVE-2000-0001")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("mismatch (-want, +got):\n%s", diff)
+	}
+
+	// Only golang.org/x modules that exist are guessed.
+	for id, want := range map[string]string{
+		"CVE-2000-0006": "golang.org/x/crypto",
+		"CVE-2000-0007": triage.UnknownPath,
+	} {
+		r, err := mstore.GetRecord(ctx, id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := r.(*store.CVE4Record).Module; got != want {
+			t.Errorf("%s: got module %q, want %q", id, got, want)
+		}
+	}
+
+	// An existing CVE record is kept.
+	r, err := mstore.GetRecord(ctx, "CVE-2000-0006")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(cvelistCVE, r.(*store.CVE4Record).CVE); diff != "" {
+		t.Errorf("CVE-2000-0006: CVE mismatch (-want, +got):\n%s", diff)
+	}
+
+	// The other records are unchanged.
+	for _, id := range []string{"CVE-2000-0002", "CVE-2000-0004", "CVE-2000-0005"} {
+		r, err := mstore.GetRecord(ctx, id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if r.(*store.CVE4Record).TriageStateReason != "" {
+			t.Errorf("%s: got reason %q, want unchanged record", id, r.(*store.CVE4Record).TriageStateReason)
+		}
+	}
+}
@@ -0,0 +1,210 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package worker
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"golang.org/x/vulndb/internal/cve4"
+	"golang.org/x/vulndb/internal/derrors"
+	"golang.org/x/vulndb/internal/genericosv"
+	"golang.org/x/vulndb/internal/idstr"
+	"golang.org/x/vulndb/internal/observe"
+	"golang.org/x/vulndb/internal/pkgsite"
+	"golang.org/x/vulndb/internal/report"
+	"golang.org/x/vulndb/internal/triage"
+	"golang.org/x/vulndb/internal/worker/log"
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+// An OSVListFunc returns all the OSV.dev entries of an ecosystem.
+type OSVListFunc func(context.Context, genericosv.Ecosystem) ([]*genericosv.Entry, error)
+
+// DefaultOSVScanEcosystems are the ecosystems that ScanOSV scans
+// unless told otherwise. Their advisories (including the RustSec
+// advisories of crates.io) are the most likely to also affect Go
+// bindings of the vulnerable packages.
+var DefaultOSVScanEcosystems = []genericosv.Ecosystem{
+	genericosv.EcosystemPyPI,
+	genericosv.EcosystemCratesIO,
+}
+
+type ScanOSVStats struct {
+	// Number of OSV entries modified in the scan window.
+	NumProcessed int
+	// Number of entries, of CVEs that needed no action,
+	// whose references refer to Go modules.
+	NumGo int
+	// Number of CVE4Records changed to NeedsIssue.
+	NumNeedsIssue int
+}
+
+// ScanOSV scans the entries of other ecosystems in OSV.dev that were
+// modified since the given time for advisories that also affect Go:
+// entries whose references point to Go modules (as decided by the
+// triage of CVE references), like the repo of the Go bindings of a
+// Python or Rust package.
+//
+// The CVE aliases of such an entry that the cvelist triage decided
+// needed no action are marked as needing an issue, so that a subsequent
+// call to CreateIssues files one. The entry's other aliases (its own ID,
+// and any GHSAs) are recorded, to be filled in the report of the issue.
+//
+// Entries affecting the Go ecosystem are skipped, as they are triaged
+// from their GHSAs, and so are entries without a CVE alias in the
+// store, or with an alias that already has a report.
+func ScanOSV(ctx context.Context, list OSVListFunc, ecosystems []genericosv.Ecosystem, since time.Time, st store.Store, pc *pkgsite.Client, rc *report.Client) (ScanOSVStats, error) {
+	return scanOSV(ctx, list, ecosystems, since, st, func(ctx context.Context, v triage.Vuln) (*triage.Result, *triage.Inputs, error) {
+		r, err := triage.RefersToGoModule(ctx, v, pc)
+		return r, nil, err
+	}, rc)
+}
+
+func scanOSV(ctx context.Context, list OSVListFunc, ecosystems []genericosv.Ecosystem, since time.Time, st store.Store, affectsGo vulnTriageFunc, rc *report.Client) (stats ScanOSVStats, err error) {
+	defer derrors.Wrap(&err, "ScanOSV(%s)", since)
+	ctx, span := observe.Start(ctx, "ScanOSV")
+	defer span.End()
+
+	for _, eco := range ecosystems {
+		log.Infof(ctx, "Starting OSV scan of %s, looking at entries modified since=%s", eco, since)
+		entries, err := list(ctx, eco)
+		if err != nil {
+			return stats, err
+		}
+		for _, e := range entries {
+			if e.Modified.Before(since) || e.IsWithdrawn() || e.AffectsGo() {
+				continue
+			}
+			stats.NumProcessed++
+			n, err := scanOSVEntry(ctx, e, st, affectsGo, rc, &stats)
+			if err != nil {
+				return stats, err
+			}
+			stats.NumNeedsIssue += n
+		}
+	}
+	log.Infof(ctx, "OSV scan succeeded with since=%s: %+v", since, stats)
+	return stats, nil
+}
+
+// scanOSVEntry marks the CVEs of e as needing issues if e refers to
+// Go modules, and returns the number of CVEs marked.
+func scanOSVEntry(ctx context.Context, e *genericosv.Entry, st store.Store, affectsGo vulnTriageFunc, rc *report.Client, stats *ScanOSVStats) (int, error) {
+	aliases := osvAliases(e)
+	var cves []string
+	for _, a := range aliases {
+		if rc.AliasHasReport(a) {
+			return 0, nil
+		}
+		if idstr.IsCVE(a) {
+			cves = append(cves, a)
+		}
+	}
+
+	// Check the store first, so that only the entries
+	// that could change a record are triaged.
+	var dismissed []string
+	for _, id := range cves {
+		r, err := st.GetRecord(ctx, id)
+		if err != nil {
+			return 0, err
+		}
+		if cr, ok := r.(*store.CVE4Record); ok && needsNoAction(cr) {
+			dismissed = append(dismissed, id)
+		}
+	}
+	if len(dismissed) == 0 {
+		return 0, nil
+	}
+	result, _, err := affectsGo(ctx, e)
+	if err != nil {
+		return 0, err
+	}
+	if result == nil {
+		return 0, nil
+	}
+	stats.NumGo++
+
+	n := 0
+	err = st.RunTransaction(ctx, func(ctx context.Context, tx store.Transaction) error {
+		n = 0
+		for _, id := range dismissed {
+			r, err := tx.GetRecord(id)
+			if err != nil {
+				return err
+			}
+			cr, ok := r.(*store.CVE4Record)
+			if !ok || !needsNoAction(cr) {
+				continue
+			}
+			mod := *cr
+			mod.TriageState = store.TriageStateNeedsIssue
+			mod.TriageStateReason = fmt.Sprintf("OSV entry %s (%s) refers to Go: %s", e.ID, ecosystemStrings(e), result.Reason)
+			mod.Module = result.ModulePath
+			mod.Package = result.PackagePath
+			mod.Aliases = slices.DeleteFunc(slices.Clone(aliases), func(a string) bool { return a == id })
+			if mod.CVE == nil && mod.CVE5 == nil {
+				mod.CVE = cve4FromOSV(id, e)
+			}
+			mod.History = append([]*store.CVE4RecordSnapshot{cr.Snapshot()}, mod.History...)
+			log.Infof(ctx, "%s: marked NeedsIssue from OSV (%s)", id, mod.TriageStateReason)
+			if err := tx.SetRecord(&mod); err != nil {
+				return err
+			}
+			n++
+		}
+		return nil
+	})
+	return n, err
+}
+
+func needsNoAction(cr *store.CVE4Record) bool {
+	return cr != nil && cr.TriageState == store.TriageStateNoActionNeeded && cr.CVEState == cve4.StatePublic
+}
+
+// osvAliases returns the ID and the aliases of e, and the GHSAs
+// its references refer to, without duplicates.
+func osvAliases(e *genericosv.Entry) []string {
+	var aliases []string
+	for _, a := range append(append([]string{e.ID}, e.Aliases...), triage.AliasGHSAs(e)...) {
+		if !slices.Contains(aliases, a) {
+			aliases = append(aliases, a)
+		}
+	}
+	return aliases
+}
+
+func ecosystemStrings(e *genericosv.Entry) string {
+	var ss []string
+	for _, eco := range e.Ecosystems() {
+		ss = append(ss, string(eco))
+	}
+	return strings.Join(ss, ", ")
+}
+
+// cve4FromOSV converts the fields of an OSV entry needed to file
+// an issue into a record for the CVE with the given ID.
+func cve4FromOSV(id string, e *genericosv.Entry) *cve4.CVE {
+	cve := &cve4.CVE{
+		Metadata: cve4.Metadata{
+			ID:    id,
+			State: cve4.StatePublic,
+		},
+		DataType:    "CVE",
+		DataFormat:  "MITRE",
+		DataVersion: "4.0",
+	}
+	if d := e.Details; d != "" {
+		cve.Description.Data = []cve4.LangString{{Lang: "eng", Value: d}}
+	}
+	for _, u := range e.ReferenceURLs() {
+		cve.References.Data = append(cve.References.Data, cve4.Reference{URL: u})
+	}
+	return cve
+}
@@ -0,0 +1,123 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package worker
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/vulndb/internal/cve4"
+	"golang.org/x/vulndb/internal/genericosv"
+	"golang.org/x/vulndb/internal/report"
+	"golang.org/x/vulndb/internal/triage"
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+func TestScanOSV(t *testing.T) {
+	ctx := context.Background()
+	since := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	modified := since.Add(time.Hour)
+
+	base := store.CVE4Record{
+		Path:        "path",
+		BlobHash:    "hash",
+		CommitHash:  "commit",
+		CommitTime:  since,
+		CVEState:    cve4.StatePublic,
+		TriageState: store.TriageStateNoActionNeeded,
+	}
+	dismissed, reported, needsIssue, old := base, base, base, base
+	dismissed.ID = "CVE-2000-0001"
+	reported.ID = "CVE-2000-0002"
+	needsIssue.ID = "CVE-2000-0003"
+	needsIssue.TriageState = store.TriageStateNeedsIssue
+	old.ID = "CVE-2000-0004"
+	mstore := store.NewMemStore()
+	createCVE4Records(t, mstore, []*store.CVE4Record{&dismissed, &reported, &needsIssue, &old})
+
+	entry := func(id, cve string, eco genericosv.Ecosystem, modified time.Time, refs ...string) *genericosv.Entry {
+		e := &genericosv.Entry{
+			ID:       id,
+			Aliases:  []string{cve},
+			Modified: modified,
+			Details:  "A problem with " + id,
+			Affected: []genericosv.Affected{{Package: genericosv.Package{Ecosystem: eco, Name: "pkg"}}},
+		}
+		for _, r := range refs {
+			e.References = append(e.References, genericosv.Reference{Type: "WEB", URL: r})
+		}
+		return e
+	}
+	entries := map[genericosv.Ecosystem][]*genericosv.Entry{
+		genericosv.EcosystemPyPI: {
+			entry("PYSEC-2000-1", "CVE-2000-0001", genericosv.EcosystemPyPI, modified,
+				"https://github.com/x/y", "https://github.com/advisories/GHSA-xxxx-yyyy-zzzz"),
+			// Already has a report.
+			entry("PYSEC-2000-2", "CVE-2000-0002", genericosv.EcosystemPyPI, modified, "https://github.com/x/y"),
+			// Already needs an issue.
+			entry("PYSEC-2000-3", "CVE-2000-0003", genericosv.EcosystemPyPI, modified, "https://github.com/x/y"),
+			// Modified before the scan window.
+			entry("PYSEC-2000-4", "CVE-2000-0004", genericosv.EcosystemPyPI, since.Add(-time.Hour), "https://github.com/x/y"),
+		},
+		genericosv.EcosystemCratesIO: {
+			// Not in the store.
+			entry("RUSTSEC-2000-0001", "CVE-2000-0005", genericosv.EcosystemCratesIO, modified, "https://github.com/x/y"),
+			// Doesn't refer to a Go module.
+			entry("RUSTSEC-2000-0002", "CVE-2000-0004", genericosv.EcosystemCratesIO, modified, "https://example.com/a"),
+		},
+	}
+	list := func(_ context.Context, eco genericosv.Ecosystem) ([]*genericosv.Entry, error) {
+		return entries[eco], nil
+	}
+	// Module paths on github.com are Go modules.
+	var triaged []string
+	affectsGo := func(_ context.Context, v triage.Vuln) (*triage.Result, *triage.Inputs, error) {
+		triaged = append(triaged, v.SourceID())
+		for _, u := range v.ReferenceURLs() {
+			if mp, ok := strings.CutPrefix(u, "https://"); ok && strings.HasPrefix(mp, "github.com/x/") {
+				return &triage.Result{ModulePath: mp, Reason: "refers to " + mp}, nil, nil
+			}
+		}
+		return nil, nil, nil
+	}
+	rc, err := report.NewTestClient(map[string]*report.Report{
+		"data/reports/GO-2000-0001.yaml": {ID: "GO-2000-0001", CVEs: []string{"CVE-2000-0002"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	stats, err := scanOSV(ctx, list, DefaultOSVScanEcosystems, since, mstore, affectsGo, rc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (ScanOSVStats{NumProcessed: 5, NumGo: 1, NumNeedsIssue: 1}); stats != want {
+		t.Errorf("got stats %+v, want %+v", stats, want)
+	}
+	// Only entries that could change a record are triaged.
+	if want := []string{"PYSEC-2000-1", "RUSTSEC-2000-0002"}; !cmp.Equal(triaged, want) {
+		t.Errorf("triaged %v, want %v", triaged, want)
+	}
+
+	got := getCVE4Record(t, mstore, dismissed.ID)
+	if got.TriageState != store.TriageStateNeedsIssue || got.Module != "github.com/x/y" || got.CVE == nil {
+		t.Errorf("%s: got state %s, module %q, CVE %v; want NeedsIssue, github.com/x/y, non-nil",
+			got.ID, got.TriageState, got.Module, got.CVE)
+	}
+	if want := []string{"PYSEC-2000-1", "GHSA-xxxx-yyyy-zzzz"}; !cmp.Equal(got.Aliases, want) {
+		t.Errorf("%s: got aliases %v, want %v", got.ID, got.Aliases, want)
+	}
+	if len(got.History) != 1 || got.History[0].TriageState != store.TriageStateNoActionNeeded {
+		t.Errorf("%s: got history %v, want previous state", got.ID, got.History)
+	}
+	for _, id := range []string{reported.ID, old.ID} {
+		if got := getCVE4Record(t, mstore, id); got.TriageState != store.TriageStateNoActionNeeded {
+			t.Errorf("%s: got state %s, want unchanged", id, got.TriageState)
+		}
+	}
+}
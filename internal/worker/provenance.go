@@ -0,0 +1,125 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package worker
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"golang.org/x/vulndb/internal/cvelistrepo"
+	"golang.org/x/vulndb/internal/derrors"
+	"golang.org/x/vulndb/internal/nvd"
+	"golang.org/x/vulndb/internal/observe"
+	"golang.org/x/vulndb/internal/worker/log"
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+// ProvenanceStats are statistics about a run of UpdateProvenance.
+type ProvenanceStats struct {
+	// Number of CVEs checked.
+	NumChecked int
+	// Number of copies of CVE records fetched from all sources.
+	NumFetched int
+	// Number of fetches that failed.
+	NumErrors int
+	// Number of CVEs whose provenance changed.
+	NumChanged int
+}
+
+// UpdateProvenance fetches the copies of the records of the CVEs that
+// need issues from each of the sources, and records in st where each
+// copy came from and when it was last updated, so that triage can see
+// which copy is freshest.
+//
+// A source that fails to serve a record is logged and skipped, so that
+// an outage of one CNA's feed does not hold up the others.
+func UpdateProvenance(ctx context.Context, st store.Store, sources []cvelistrepo.Source) (ProvenanceStats, error) {
+	return updateProvenance(ctx, st, sources, time.Now())
+}
+
+func updateProvenance(ctx context.Context, st store.Store, sources []cvelistrepo.Source, now time.Time) (stats ProvenanceStats, err error) {
+	defer derrors.Wrap(&err, "UpdateProvenance")
+	ctx, span := observe.Start(ctx, "UpdateProvenance")
+	defer span.End()
+
+	needsIssue, err := st.ListCVE4RecordsWithTriageState(ctx, store.TriageStateNeedsIssue)
+	if err != nil {
+		return stats, err
+	}
+	for _, cr := range needsIssue {
+		stats.NumChecked++
+		var ps []*store.CVEProvenance
+		for _, src := range sources {
+			r, err := src.Fetch(ctx, cr.ID)
+			if err != nil {
+				log.Warningf(ctx, "%s: skipping source %s: %v", cr.ID, src.Name(), err)
+				stats.NumErrors++
+				continue
+			}
+			if r == nil {
+				continue
+			}
+			stats.NumFetched++
+			ps = append(ps, &store.CVEProvenance{
+				Source:    r.Source,
+				Updated:   r.Updated,
+				Version:   r.Version,
+				URL:       r.URL,
+				FetchedAt: now,
+			})
+		}
+		if len(ps) == 0 {
+			continue
+		}
+		changed := false
+		if err := st.RunTransaction(ctx, func(_ context.Context, tx store.Transaction) error {
+			changed = false
+			rec, err := tx.GetRecord(cr.ID)
+			if err != nil {
+				return err
+			}
+			cr, ok := rec.(*store.CVE4Record)
+			if !ok {
+				return nil
+			}
+			for _, p := range ps {
+				if cr.SetProvenance(p) {
+					changed = true
+				}
+			}
+			return tx.SetRecord(cr)
+		}); err != nil {
+			return stats, err
+		}
+		if changed {
+			stats.NumChanged++
+		}
+	}
+	log.Infof(ctx, "UpdateProvenance done: %+v", stats)
+	return stats, nil
+}
+
+// ProvenanceSources returns the sources of CVE records for
+// UpdateProvenance: the cvelistV5 repo at the given commit, if it is
+// non-nil, then the NVD, then the CNA feeds of the worker config in st,
+// sorted by name.
+func ProvenanceSources(ctx context.Context, st store.Store, nc *nvd.Client, cvelistCommit *object.Commit) ([]cvelistrepo.Source, error) {
+	lc, err := loadLiveConfig(ctx, st)
+	if err != nil {
+		return nil, err
+	}
+	var sources []cvelistrepo.Source
+	if cvelistCommit != nil {
+		sources = append(sources, cvelistrepo.NewRepoSource(cvelistCommit))
+	}
+	sources = append(sources, cvelistrepo.NewNVDSource(nc))
+	for _, name := range slices.Sorted(maps.Keys(lc.cnaFeeds)) {
+		sources = append(sources, cvelistrepo.NewFeedSource(name, lc.cnaFeeds[name], nil))
+	}
+	return sources, nil
+}
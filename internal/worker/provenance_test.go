@@ -0,0 +1,129 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package worker
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/vulndb/internal/cvelistrepo"
+	"golang.org/x/vulndb/internal/report"
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+// fakeSource is a cvelistrepo.Source that serves records from a map.
+// A nil record means the source fails.
+type fakeSource struct {
+	name    string
+	records map[string]*cvelistrepo.Record
+}
+
+func (s *fakeSource) Name() string { return s.name }
+
+func (s *fakeSource) Fetch(_ context.Context, id string) (*cvelistrepo.Record, error) {
+	r, ok := s.records[id]
+	if !ok {
+		return nil, nil
+	}
+	if r == nil {
+		return nil, errors.New("source unavailable")
+	}
+	r.ID, r.Source = id, s.name
+	return r, nil
+}
+
+func TestUpdateProvenance(t *testing.T) {
+	ctx := context.Background()
+	t1 := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	t2 := t1.Add(time.Hour)
+	now := t2.Add(time.Hour)
+
+	base := store.CVE4Record{
+		Path:        "path",
+		BlobHash:    "hash",
+		CommitHash:  "commit",
+		CommitTime:  t1,
+		TriageState: store.TriageStateNeedsIssue,
+	}
+	needsIssue, noSources, dismissed := base, base, base
+	needsIssue.ID = "CVE-2000-0001"
+	noSources.ID = "CVE-2000-0002"
+	dismissed.ID = "CVE-2000-0003"
+	dismissed.TriageState = store.TriageStateNoActionNeeded
+	mstore := store.NewMemStore()
+	createCVE4Records(t, mstore, []*store.CVE4Record{&needsIssue, &noSources, &dismissed})
+
+	sources := []cvelistrepo.Source{
+		&fakeSource{name: "cvelist", records: map[string]*cvelistrepo.Record{
+			"CVE-2000-0001": {Updated: t1, Version: "blob1", URL: "https://example.com/cvelist"},
+			"CVE-2000-0003": {Updated: t1, Version: "blob3"},
+		}},
+		&fakeSource{name: "example-cna", records: map[string]*cvelistrepo.Record{
+			"CVE-2000-0001": {Updated: t2, Version: "v2", URL: "https://cna.example.com/CVE-2000-0001"},
+		}},
+		&fakeSource{name: "broken", records: map[string]*cvelistrepo.Record{
+			"CVE-2000-0001": nil,
+		}},
+	}
+	stats, err := updateProvenance(ctx, mstore, sources, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantStats := ProvenanceStats{NumChecked: 2, NumFetched: 2, NumErrors: 1, NumChanged: 1}
+	if diff := cmp.Diff(wantStats, stats); diff != "" {
+		t.Errorf("stats mismatch (-want, +got):\n%s", diff)
+	}
+
+	want := []*store.CVEProvenance{
+		{Source: "cvelist", Updated: t1, Version: "blob1", URL: "https://example.com/cvelist", FetchedAt: now},
+		{Source: "example-cna", Updated: t2, Version: "v2", URL: "https://cna.example.com/CVE-2000-0001", FetchedAt: now},
+	}
+	got := mstore.CVE4Records()["CVE-2000-0001"]
+	if diff := cmp.Diff(want, got.Provenance); diff != "" {
+		t.Errorf("provenance mismatch (-want, +got):\n%s", diff)
+	}
+	if f := got.FreshestProvenance(); f == nil || f.Source != "example-cna" {
+		t.Errorf("FreshestProvenance() = %+v, want example-cna", f)
+	}
+	for _, id := range []string{"CVE-2000-0002", "CVE-2000-0003"} {
+		if ps := mstore.CVE4Records()[id].Provenance; ps != nil {
+			t.Errorf("%s: got provenance %+v, want none", id, ps)
+		}
+	}
+
+	// Fetching the same copies again changes nothing.
+	stats, err = updateProvenance(ctx, mstore, sources, now.Add(time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats.NumChanged != 0 {
+		t.Errorf("second run: NumChanged = %d, want 0", stats.NumChanged)
+	}
+
+	// The issue body lists the sources, marking the freshest.
+	r := &report.Report{
+		Modules:    []*report.Module{{Module: "golang.org/x/vulndb"}},
+		SourceMeta: &report.SourceMeta{ID: "CVE-2000-0001"},
+	}
+	rc, err := report.NewTestClient(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, err := newIssueBody(r, "a description", rc, mstore.CVE4Records()["CVE-2000-0001"], nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantSources := `Sources:
+- [cvelist](https://example.com/cvelist), updated 2025-01-01T00:00:00Z
+- [example-cna](https://cna.example.com/CVE-2000-0001), updated 2025-01-01T01:00:00Z (freshest)
+`
+	if !strings.Contains(body, wantSources) {
+		t.Errorf("issue body does not list sources; got:\n%s\nwant it to contain:\n%s", body, wantSources)
+	}
+}
@@ -0,0 +1,231 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package worker
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"golang.org/x/vulndb/internal/cve4"
+	"golang.org/x/vulndb/internal/derrors"
+	"golang.org/x/vulndb/internal/observe"
+	"golang.org/x/vulndb/internal/pkgsite"
+	"golang.org/x/vulndb/internal/report"
+	"golang.org/x/vulndb/internal/triage"
+	"golang.org/x/vulndb/internal/worker/log"
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+// A TriageChange is a stored triage decision that the
+// current triage logic would make differently.
+type TriageChange struct {
+	// ID is the ID of the CVE or GHSA.
+	ID string
+	// OldState and OldModule are the stored decision.
+	OldState  store.TriageState
+	OldModule string
+	// NewState and NewModule are the decision the
+	// current triage logic would make.
+	NewState  store.TriageState
+	NewModule string
+	// Reason explains the new decision.
+	Reason string
+}
+
+// RecomputeTriageStats are statistics about a run of RecomputeTriage.
+type RecomputeTriageStats struct {
+	// Number of CVE and GHSA records whose triage was recomputed.
+	NumChecked int
+	// Number of records that were not triaged by the worker's logic
+	// (for example, because a person decided they are false
+	// positives), or whose triage inputs were not recorded.
+	NumSkipped int
+	// Number of records whose triage would change.
+	NumChanged int
+}
+
+// goTriageStates are the triage states of CVEs that
+// triage decided refer to a Go module.
+var goTriageStates = []store.TriageState{
+	store.TriageStateNeedsIssue,
+	store.TriageStateIssueCreated,
+	store.TriageStateUpdatedSinceIssueCreation,
+	store.TriageStateAlias,
+}
+
+// RecomputeTriage repeats the triage of every CVE and GHSA in the
+// store with the current triage logic, and returns the records whose
+// disposition would change today, ordered by ID. It does not change the
+// store.
+//
+// A CVE is triaged again from the references in its stored copy of the
+// CVE record, or, if there is none, from its recorded triage inputs
+// (see triage.Replay). Module paths that were not looked up when the
+// CVE was triaged are looked up with pc, unless it is nil. A GHSA is
+// triaged again from the current triage states of its CVE aliases.
+//
+// CVEs that triage did not decide (those in the HasVuln and
+// FalsePositive states, and those that are not public) are skipped,
+// as are GHSAs that already have issues.
+func RecomputeTriage(ctx context.Context, st store.Store, pc *pkgsite.Client, rc *report.Client) (_ []*TriageChange, stats RecomputeTriageStats, err error) {
+	defer derrors.Wrap(&err, "RecomputeTriage")
+	ctx, span := observe.Start(ctx, "RecomputeTriage")
+	defer span.End()
+
+	var changes []*TriageChange
+	states := append([]store.TriageState{
+		store.TriageStateNoActionNeeded,
+		store.TriageStateFalsePositive,
+		store.TriageStateHasVuln,
+	}, goTriageStates...)
+	for _, ts := range states {
+		crs, err := st.ListCVE4RecordsWithTriageState(ctx, ts)
+		if err != nil {
+			return nil, stats, err
+		}
+		for _, cr := range crs {
+			c, ok, err := recomputeCVETriage(ctx, cr, pc, rc)
+			if err != nil {
+				return nil, stats, err
+			}
+			if !ok {
+				stats.NumSkipped++
+				continue
+			}
+			stats.NumChecked++
+			if c != nil {
+				changes = append(changes, c)
+			}
+		}
+	}
+
+	var sars []*store.LegacyGHSARecord
+	if err := st.RunTransaction(ctx, func(_ context.Context, tx store.Transaction) error {
+		sars, err = tx.GetLegacyGHSARecords()
+		return err
+	}); err != nil {
+		return nil, stats, err
+	}
+	for _, sar := range sars {
+		c, ok, err := recomputeGHSATriage(ctx, sar, st, rc)
+		if err != nil {
+			return nil, stats, err
+		}
+		if !ok {
+			stats.NumSkipped++
+			continue
+		}
+		stats.NumChecked++
+		if c != nil {
+			changes = append(changes, c)
+		}
+	}
+
+	slices.SortFunc(changes, func(a, b *TriageChange) int {
+		return strings.Compare(a.ID, b.ID)
+	})
+	stats.NumChanged = len(changes)
+	log.Infof(ctx, "Recomputed triage: %+v", stats)
+	return changes, stats, nil
+}
+
+// recomputeCVETriage returns the change to the triage of cr, which is
+// nil if there is none, and whether the triage could be recomputed.
+func recomputeCVETriage(ctx context.Context, cr *store.CVE4Record, pc *pkgsite.Client, rc *report.Client) (_ *TriageChange, ok bool, err error) {
+	switch {
+	case cr.TriageState == store.TriageStateFalsePositive, cr.TriageState == store.TriageStateHasVuln:
+		return nil, false, nil
+	case cr.CVEState != "" && cr.CVEState != cve4.StatePublic:
+		return nil, false, nil
+	}
+	var refs []string
+	switch {
+	case cr.CVE != nil:
+		refs = cr.CVE.ReferenceURLs()
+	case cr.CVE5 != nil:
+		refs = cr.CVE5.ReferenceURLs()
+	case cr.TriageInputs != nil:
+		refs = cr.TriageInputs.ReferenceURLs
+	default:
+		return nil, false, nil
+	}
+	in := &triage.Inputs{ReferenceURLs: refs}
+	if cr.TriageInputs != nil {
+		in.Lookups = cr.TriageInputs.Lookups
+	}
+
+	c := &TriageChange{
+		ID:        cr.ID,
+		OldState:  cr.TriageState,
+		OldModule: cr.Module,
+		NewState:  cr.TriageState,
+	}
+	if rc.AliasHasReport(cr.ID) {
+		c.NewState = store.TriageStateHasVuln
+		c.Reason = "has a report"
+		return c, true, nil
+	}
+	result, err := triage.Replay(ctx, cr.ID, in, pc)
+	if err != nil {
+		return nil, false, err
+	}
+	wasGo := slices.Contains(goTriageStates, cr.TriageState)
+	switch {
+	case result == nil && !wasGo:
+		return nil, true, nil
+	case result == nil:
+		c.NewState = store.TriageStateNoActionNeeded
+		c.Reason = "no reference refers to a Go module"
+	case result.ModulePath == cr.Module:
+		return nil, true, nil
+	default:
+		if !wasGo {
+			c.NewState = store.TriageStateNeedsIssue
+		}
+		c.NewModule = result.ModulePath
+		c.Reason = result.Reason
+	}
+	return c, true, nil
+}
+
+// recomputeGHSATriage returns the change to the triage of sar, which is
+// nil if there is none, and whether the triage could be recomputed.
+func recomputeGHSATriage(ctx context.Context, sar *store.LegacyGHSARecord, st store.Store, rc *report.Client) (_ *TriageChange, ok bool, err error) {
+	if sar.TriageState != store.TriageStateNeedsIssue && sar.TriageState != store.TriageStateAlias {
+		return nil, false, nil
+	}
+	newState := store.TriageStateNeedsIssue
+	reason := "no CVE alias was triaged"
+	if rc.AliasHasReport(sar.GHSA.ID) {
+		newState, reason = store.TriageStateHasVuln, "has a report"
+	} else {
+		for _, id := range sar.GHSA.Identifiers {
+			if id.Type != "CVE" {
+				continue
+			}
+			r, err := st.GetRecord(ctx, id.Value)
+			if err != nil {
+				return nil, false, err
+			}
+			if r == nil {
+				continue
+			}
+			newState = getTriageStateFromAlias(r.GetTriageState())
+			reason = fmt.Sprintf("alias %s is %s", id.Value, r.GetTriageState())
+			break
+		}
+	}
+	if newState == sar.TriageState {
+		return nil, true, nil
+	}
+	return &TriageChange{
+		ID:       sar.GHSA.ID,
+		OldState: sar.TriageState,
+		NewState: newState,
+		Reason:   reason,
+	}, true, nil
+}
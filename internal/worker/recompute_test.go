@@ -0,0 +1,125 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package worker
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/vulndb/internal/cve4"
+	"golang.org/x/vulndb/internal/ghsa"
+	"golang.org/x/vulndb/internal/report"
+	"golang.org/x/vulndb/internal/triage"
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+func TestRecomputeTriage(t *testing.T) {
+	ctx := context.Background()
+	mstore := store.NewMemStore()
+	base := store.CVE4Record{
+		Path:        "path",
+		BlobHash:    "hash",
+		CommitHash:  "commit",
+		CommitTime:  time.Now(),
+		CVEState:    cve4.StatePublic,
+		TriageState: store.TriageStateNoActionNeeded,
+	}
+	inputs := func(known bool) *triage.Inputs {
+		return &triage.Inputs{
+			ReferenceURLs: []string{"https://example.com/a/b"},
+			Lookups:       []*triage.Lookup{{ModulePath: "example.com/a/b", Known: known}},
+		}
+	}
+	// Same decision.
+	unchanged := base
+	unchanged.ID = "CVE-2000-0001"
+	unchanged.TriageInputs = inputs(false)
+	// Now a Go vuln, as if pkgsite now knew the module.
+	nowGo := base
+	nowGo.ID = "CVE-2000-0002"
+	nowGo.TriageInputs = inputs(true)
+	// No longer a Go vuln.
+	noLongerGo := base
+	noLongerGo.ID = "CVE-2000-0003"
+	noLongerGo.TriageState = store.TriageStateNeedsIssue
+	noLongerGo.Module = "example.com/c"
+	noLongerGo.CVE = &cve4.CVE{
+		Metadata:   cve4.Metadata{ID: noLongerGo.ID, State: cve4.StatePublic},
+		References: cve4.References{Data: []cve4.Reference{{URL: "https://example.com/advisory"}}},
+	}
+	// Decided by a person.
+	falsePositive := base
+	falsePositive.ID = "CVE-2000-0004"
+	falsePositive.TriageState = store.TriageStateFalsePositive
+	// No inputs recorded.
+	noInputs := base
+	noInputs.ID = "CVE-2000-0005"
+	createCVE4Records(t, mstore, []*store.CVE4Record{&unchanged, &nowGo, &noLongerGo, &falsePositive, &noInputs})
+
+	createLegacyGHSARecords(t, mstore, []*store.LegacyGHSARecord{
+		{
+			// An alias of a CVE that needs an issue.
+			GHSA: &ghsa.SecurityAdvisory{
+				ID:          "GHSA-aaaa-bbbb-cccc",
+				Identifiers: []ghsa.Identifier{{Type: "CVE", Value: noLongerGo.ID}},
+			},
+			TriageState: store.TriageStateNeedsIssue,
+		},
+		{
+			GHSA:        &ghsa.SecurityAdvisory{ID: "GHSA-xxxx-yyyy-zzzz"},
+			TriageState: store.TriageStateIssueCreated,
+		},
+	})
+
+	rc, err := report.NewTestClient(map[string]*report.Report{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, stats, err := RecomputeTriage(ctx, mstore, nil, rc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantStats := RecomputeTriageStats{NumChecked: 4, NumSkipped: 3, NumChanged: 3}
+	if stats != wantStats {
+		t.Errorf("stats = %+v, want %+v", stats, wantStats)
+	}
+	want := []*TriageChange{
+		{
+			ID:        nowGo.ID,
+			OldState:  store.TriageStateNoActionNeeded,
+			NewState:  store.TriageStateNeedsIssue,
+			NewModule: "example.com/a/b",
+		},
+		{
+			ID:        noLongerGo.ID,
+			OldState:  store.TriageStateNeedsIssue,
+			OldModule: "example.com/c",
+			NewState:  store.TriageStateNoActionNeeded,
+			Reason:    "no reference refers to a Go module",
+		},
+		{
+			ID:       "GHSA-aaaa-bbbb-cccc",
+			OldState: store.TriageStateNeedsIssue,
+			NewState: store.TriageStateAlias,
+			Reason:   "alias CVE-2000-0003 is NeedsIssue",
+		},
+	}
+	// The reasons of triage itself are tested elsewhere.
+	got[0].Reason = ""
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("mismatch (-want, +got):\n%s", diff)
+	}
+
+	// The store is unchanged.
+	rec, err := mstore.GetRecord(ctx, nowGo.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ts := rec.GetTriageState(); ts != store.TriageStateNoActionNeeded {
+		t.Errorf("%s: triage state = %s, want unchanged", nowGo.ID, ts)
+	}
+}
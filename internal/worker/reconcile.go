@@ -0,0 +1,195 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"golang.org/x/vulndb/internal/cve5"
+	"golang.org/x/vulndb/internal/cvelistrepo"
+	"golang.org/x/vulndb/internal/derrors"
+	"golang.org/x/vulndb/internal/gitrepo"
+	"golang.org/x/vulndb/internal/observe"
+	"golang.org/x/vulndb/internal/report"
+	"golang.org/x/vulndb/internal/worker/log"
+)
+
+// A CVERecordFunc returns the currently published record of a CVE,
+// or nil if the CVE has no published record.
+type CVERecordFunc func(_ context.Context, cveID string) (*cve5.CVERecord, error)
+
+// CVEServicesRecordFunc returns a CVERecordFunc that fetches records
+// from CVE Services.
+func CVEServicesRecordFunc(c *cve5.Client) CVERecordFunc {
+	return func(_ context.Context, cveID string) (*cve5.CVERecord, error) {
+		return c.RetrievePublishedRecord(cveID)
+	}
+}
+
+// CVEListRecordFunc returns a CVERecordFunc that reads records from
+// the given commit of the cvelistV5 repo.
+func CVEListRecordFunc(commit *object.Commit) CVERecordFunc {
+	return func(_ context.Context, cveID string) (*cve5.CVERecord, error) {
+		p, err := cvelistrepo.PathV5(cveID)
+		if err != nil {
+			return nil, err
+		}
+		f, err := commit.File(p)
+		if errors.Is(err, object.ErrFileNotFound) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		contents, err := f.Contents()
+		if err != nil {
+			return nil, err
+		}
+		var c cve5.CVERecord
+		if err := json.Unmarshal([]byte(contents), &c); err != nil {
+			return nil, fmt.Errorf("%s: %v", p, err)
+		}
+		return &c, nil
+	}
+}
+
+// Sources of published CVE records.
+const (
+	CVESourceServices = "cve-services"
+	CVESourceList     = "cvelist"
+)
+
+// NewCVERecordFunc returns a CVERecordFunc that reads records from the
+// given source: CVESourceServices (the default, if source is empty), or
+// CVESourceList, in which case the cvelistV5 repo is cloned, unless
+// repoPath is the path of a local clone.
+func NewCVERecordFunc(ctx context.Context, source, repoPath string) (CVERecordFunc, error) {
+	switch source {
+	case "", CVESourceServices:
+		return CVEServicesRecordFunc(cve5.NewClient(cve5.Config{Endpoint: cve5.ProdEndpoint})), nil
+	case CVESourceList:
+		var (
+			repo *git.Repository
+			err  error
+		)
+		if repoPath != "" {
+			repo, err = gitrepo.Open(ctx, repoPath)
+		} else {
+			repo, err = cvelistrepo.Clone(ctx, cvelistrepo.URLv5, "")
+		}
+		if err != nil {
+			return nil, err
+		}
+		commit, err := gitrepo.HeadCommit(repo)
+		if err != nil {
+			return nil, err
+		}
+		return CVEListRecordFunc(commit), nil
+	default:
+		return nil, fmt.Errorf("%w %q (want %s or %s)", errUnknownCVESource, source, CVESourceServices, CVESourceList)
+	}
+}
+
+var errUnknownCVESource = errors.New("unknown source of CVE records")
+
+// A CVEDiscrepancy is a CVE of the Go CNA whose published record
+// does not match the record generated from its report.
+type CVEDiscrepancy struct {
+	ReportID string
+	CVE      string
+	// Unpublished is true if the CVE has no published record,
+	// for example because its publication failed.
+	Unpublished bool
+	// Owner is the UUID of the CNA that owns the CVE, if it is
+	// not the Go CNA. The report should list the CVE in cves
+	// instead of cve_metadata, and its record must not be published.
+	Owner string
+	// Parts are the parts of the published record that differ from
+	// the generated record, for example because of an out-of-band edit.
+	Parts []string
+}
+
+func (d *CVEDiscrepancy) String() string {
+	if d.Unpublished {
+		return fmt.Sprintf("%s (%s): not published", d.CVE, d.ReportID)
+	}
+	if d.Owner != "" {
+		return fmt.Sprintf("%s (%s): owned by another CNA (%s)", d.CVE, d.ReportID, d.Owner)
+	}
+	return fmt.Sprintf("%s (%s): published record differs in: %s", d.CVE, d.ReportID, strings.Join(d.Parts, ", "))
+}
+
+type ReconcileCVEsStats struct {
+	// Number of CVEs checked.
+	NumChecked int
+	// Number of CVEs whose published record matches the report.
+	NumOK int
+	// Number of CVEs with no published record.
+	NumUnpublished int
+	// Number of CVEs owned by another CNA.
+	NumOtherCNA int
+	// Number of CVEs whose published record differs from the report.
+	NumDiffering int
+}
+
+// ReconcileCVEs compares the published record of each CVE assigned by
+// the Go CNA (that is, each report with cve_metadata) with the record
+// generated from the report, ignoring fields set by CVE Services,
+// and returns the CVEs whose records do not match, sorted by CVE.
+func ReconcileCVEs(ctx context.Context, published CVERecordFunc, rc *report.Client) (stats ReconcileCVEsStats, ds []*CVEDiscrepancy, err error) {
+	defer derrors.Wrap(&err, "ReconcileCVEs")
+	ctx, span := observe.Start(ctx, "ReconcileCVEs")
+	defer span.End()
+
+	for _, r := range rc.List() {
+		if r.CVEMetadata == nil {
+			continue
+		}
+		stats.NumChecked++
+		generated, err := cve5.FromReport(r)
+		if err != nil {
+			return stats, nil, err
+		}
+		current, err := published(ctx, r.CVEMetadata.ID)
+		if err != nil {
+			return stats, nil, err
+		}
+		d := &CVEDiscrepancy{ReportID: r.ID, CVE: r.CVEMetadata.ID}
+		switch {
+		case current == nil || current.Metadata.State == cve5.StateReserved:
+			d.Unpublished = true
+			stats.NumUnpublished++
+		case !current.IsGoAssigned():
+			// Don't compare the record with the one generated from
+			// the report, which should not exist.
+			d.Owner = current.OwnerOrgID()
+			stats.NumOtherCNA++
+		default:
+			for _, p := range cve5.Drift(generated, current) {
+				d.Parts = append(d.Parts, p.Part)
+			}
+			if len(d.Parts) == 0 {
+				stats.NumOK++
+				continue
+			}
+			stats.NumDiffering++
+		}
+		log.Warningf(ctx, "%s", d)
+		ds = append(ds, d)
+	}
+	slices.SortFunc(ds, func(a, b *CVEDiscrepancy) int {
+		return strings.Compare(a.CVE, b.CVE)
+	})
+	log.Infof(ctx, "Reconciled %d CVEs: %d ok, %d unpublished, %d owned by other CNAs, %d differing",
+		stats.NumChecked, stats.NumOK, stats.NumUnpublished, stats.NumOtherCNA, stats.NumDiffering)
+	return stats, ds, nil
+}
@@ -0,0 +1,127 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/tools/txtar"
+	"golang.org/x/vulndb/internal/cve5"
+	"golang.org/x/vulndb/internal/gitrepo"
+	"golang.org/x/vulndb/internal/report"
+)
+
+func TestReconcileCVEs(t *testing.T) {
+	ctx := context.Background()
+
+	newReport := func(id, cve, description string) *report.Report {
+		return &report.Report{
+			ID:          id,
+			Summary:     "A problem with example.com/module",
+			Description: report.Description(description),
+			Modules:     []*report.Module{{Module: "example.com/module", Packages: []*report.Package{{Package: "example.com/module"}}}},
+			CVEMetadata: &report.CVEMeta{ID: cve, CWE: "CWE-400: Uncontrolled Resource Consumption"},
+		}
+	}
+	reports := map[string]*report.Report{
+		"data/reports/GO-1999-0001.yaml": newReport("GO-1999-0001", "CVE-1999-0001", "A description."),
+		"data/reports/GO-1999-0002.yaml": newReport("GO-1999-0002", "CVE-1999-0002", "A new description."),
+		"data/reports/GO-1999-0003.yaml": newReport("GO-1999-0003", "CVE-1999-0003", "A description."),
+		"data/reports/GO-1999-0004.yaml": newReport("GO-1999-0004", "CVE-1999-0004", "A description."),
+		// Not a Go CNA report.
+		"data/reports/GO-1999-0005.yaml": {ID: "GO-1999-0005", CVEs: []string{"CVE-1999-0005"}},
+		// A CVE of another CNA, wrongly listed in cve_metadata.
+		"data/reports/GO-1999-0006.yaml": newReport("GO-1999-0006", "CVE-1999-0006", "A description."),
+	}
+	rc, err := report.NewTestClient(reports)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	published := map[string]*cve5.CVERecord{}
+	for _, fname := range []string{"data/reports/GO-1999-0001.yaml", "data/reports/GO-1999-0002.yaml"} {
+		r := *reports[fname]
+		r.Description = "A description."
+		c, err := cve5.FromReport(&r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		c.Metadata.OrgID, c.Metadata.Serial, c.Metadata.State = cve5.GoOrgUUID, 2, cve5.StatePublished
+		published[c.Metadata.ID] = c
+	}
+	// CVE-1999-0003 is reserved but has no published record,
+	// and CVE-1999-0004 has no record at all.
+	published["CVE-1999-0003"] = &cve5.CVERecord{Metadata: cve5.Metadata{ID: "CVE-1999-0003", State: cve5.StateReserved}}
+	const otherOrg = "00000000-0000-0000-0000-000000000000"
+	published["CVE-1999-0006"] = &cve5.CVERecord{
+		Metadata: cve5.Metadata{ID: "CVE-1999-0006", OrgID: otherOrg, State: cve5.StatePublished},
+		Containers: cve5.Containers{CNAContainer: cve5.CNAPublishedContainer{
+			ProviderMetadata: cve5.ProviderMetadata{OrgID: otherOrg},
+		}},
+	}
+	fetch := func(_ context.Context, id string) (*cve5.CVERecord, error) {
+		return published[id], nil
+	}
+
+	gotStats, got, err := ReconcileCVEs(ctx, fetch, rc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (ReconcileCVEsStats{NumChecked: 5, NumOK: 1, NumUnpublished: 2, NumOtherCNA: 1, NumDiffering: 1}); gotStats != want {
+		t.Errorf("got stats %+v, want %+v", gotStats, want)
+	}
+	want := []*CVEDiscrepancy{
+		{ReportID: "GO-1999-0002", CVE: "CVE-1999-0002", Parts: []string{"descriptions"}},
+		{ReportID: "GO-1999-0003", CVE: "CVE-1999-0003", Unpublished: true},
+		{ReportID: "GO-1999-0004", CVE: "CVE-1999-0004", Unpublished: true},
+		{ReportID: "GO-1999-0006", CVE: "CVE-1999-0006", Owner: otherOrg},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("mismatch (-want, +got):\n%s", diff)
+	}
+}
+
+func TestCVEListRecordFunc(t *testing.T) {
+	c := &cve5.CVERecord{
+		DataType:    "CVE_RECORD",
+		DataVersion: "5.1",
+		Metadata:    cve5.Metadata{ID: "CVE-2023-45283", State: cve5.StatePublished},
+	}
+	b, err := json.Marshal(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	repo, err := gitrepo.FromTxtarArchive(&txtar.Archive{Files: []txtar.File{
+		{Name: "cves/2023/45xxx/CVE-2023-45283.json", Data: b},
+	}}, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	commit, err := gitrepo.HeadCommit(repo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fetch := CVEListRecordFunc(commit)
+
+	got, err := fetch(context.Background(), "CVE-2023-45283")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(c, got); diff != "" {
+		t.Errorf("mismatch (-want, +got):\n%s", diff)
+	}
+
+	got, err = fetch(context.Background(), "CVE-2023-45284")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != nil {
+		t.Errorf("fetch(missing CVE) = %v, want nil", got)
+	}
+}
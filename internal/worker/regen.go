@@ -0,0 +1,368 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"golang.org/x/sync/errgroup"
+	"golang.org/x/vulndb/internal/database"
+	"golang.org/x/vulndb/internal/derrors"
+	"golang.org/x/vulndb/internal/gitrepo"
+	"golang.org/x/vulndb/internal/observe"
+	"golang.org/x/vulndb/internal/osvutils"
+	"golang.org/x/vulndb/internal/worker/log"
+	"golang.org/x/vulndb/internal/worker/store"
+	gcs "google.golang.org/api/storage/v1"
+)
+
+// dbZipFile is the name of the zipped copy of the database,
+// in the database's top-level directory.
+const dbZipFile = "vulndb.zip"
+
+type RegenerateDBStats struct {
+	// Skipped is true if the database was not regenerated, because
+	// it was already published from the repo's HEAD commit.
+	Skipped bool
+	// Number of entries in the database.
+	NumEntries int
+	// Number of files, and their total size in bytes.
+	NumFiles int
+	Size     int64
+	// Digest of the database's manifest.
+	Digest string
+	// Number of anomalies found (see database.FindAnomalies).
+	NumAnomalies int
+	// Published is true if the database was published.
+	Published bool
+}
+
+// A DBPublisher publishes generated databases.
+type DBPublisher interface {
+	// Publish publishes the files of the database in dir,
+	// which are listed in m.
+	Publish(ctx context.Context, dir string, m *database.Manifest) error
+}
+
+// An ExistingDBFunc writes a copy of the deployed database to dir.
+type ExistingDBFunc func(ctx context.Context, dir string) error
+
+// RegenerateDB generates the vulnerability database from the HEAD
+// commit of repo, checks that it can be deployed on top of the
+// database written by existing, and publishes it with pub. If pub is
+// nil, the database is generated and checked but not published.
+//
+// The database is not published if it has anomalies compared with
+// the deployed database, unless allowAnomalies is true.
+//
+// Each regeneration is recorded in a DBRegenRecord in st, with the
+// manifest of the generated database. Unless force is true, nothing is
+// done if the last successful regeneration was from the same commit.
+func RegenerateDB(ctx context.Context, repo *git.Repository, existing ExistingDBFunc, pub DBPublisher, st store.Store, force, allowAnomalies bool) (stats RegenerateDBStats, err error) {
+	defer derrors.Wrap(&err, "RegenerateDB")
+	ctx, span := observe.Start(ctx, "RegenerateDB")
+	defer span.End()
+
+	head, err := gitrepo.HeadCommit(repo)
+	if err != nil {
+		return stats, err
+	}
+	if !force {
+		last, err := lastDBRegen(ctx, st)
+		if err != nil {
+			return stats, err
+		}
+		if last != nil && last.CommitHash == head.Hash.String() {
+			log.Infof(ctx, "RegenerateDB: database already generated from commit %s (at %s); skipping", last.CommitHash, last.EndedAt)
+			stats.Skipped = true
+			return stats, nil
+		}
+	}
+
+	rr := &store.DBRegenRecord{
+		StartedAt:  time.Now(),
+		CommitHash: head.Hash.String(),
+		CommitTime: head.Committer.When,
+		Stage:      store.DBRegenGenerate,
+	}
+	if err := st.CreateDBRegenRecord(ctx, rr); err != nil {
+		return stats, err
+	}
+	defer func() {
+		rr.EndedAt = time.Now()
+		if err != nil {
+			rr.Error = err.Error()
+		}
+		if err2 := st.SetDBRegenRecord(ctx, rr); err == nil {
+			err = err2
+		}
+	}()
+	// setStage records the start of a new stage of the regeneration.
+	setStage := func(stage store.DBRegenStage) error {
+		rr.Stage = stage
+		return st.SetDBRegenRecord(ctx, rr)
+	}
+
+	tmp, err := os.MkdirTemp("", "regen-db")
+	if err != nil {
+		return stats, err
+	}
+	defer os.RemoveAll(tmp)
+	newDir, oldDir := filepath.Join(tmp, "new"), filepath.Join(tmp, "old")
+
+	m, err := generateDB(ctx, repo, newDir)
+	if err != nil {
+		return stats, err
+	}
+	rr.ManifestDigest = m.Digest
+	rr.Manifest = m.Uncompressed()
+	for _, f := range rr.Manifest {
+		if path.Dir(f.Path) == "ID" {
+			rr.NumEntries++
+		}
+	}
+	stats.NumEntries = rr.NumEntries
+	stats.NumFiles = len(m.Files)
+	stats.Size = m.Size()
+	stats.Digest = m.Digest
+
+	if err := setStage(store.DBRegenValidate); err != nil {
+		return stats, err
+	}
+	if err := existing(ctx, oldDir); err != nil {
+		return stats, fmt.Errorf("fetching existing database: %w", err)
+	}
+	if err := database.ValidateDeploy(newDir, oldDir); err != nil {
+		return stats, err
+	}
+	if err := osvutils.ValidateDBUpdate(newDir, oldDir); err != nil {
+		return stats, err
+	}
+	anomalies, err := database.FindAnomalies(newDir, oldDir)
+	if err != nil {
+		return stats, err
+	}
+	for _, a := range anomalies {
+		log.Warningf(ctx, "RegenerateDB: anomaly: %s", a)
+		rr.Anomalies = append(rr.Anomalies, a.String())
+	}
+	stats.NumAnomalies = len(anomalies)
+	if len(anomalies) > 0 && !allowAnomalies {
+		return stats, database.AnomaliesError(anomalies)
+	}
+
+	if pub != nil {
+		if err := setStage(store.DBRegenPublish); err != nil {
+			return stats, err
+		}
+		if err := pub.Publish(ctx, newDir, m); err != nil {
+			return stats, err
+		}
+		rr.Published = true
+		stats.Published = true
+	} else {
+		log.Infof(ctx, "RegenerateDB: no publisher; not publishing")
+	}
+	rr.Stage = store.DBRegenDone
+	log.Infof(ctx, "RegenerateDB(%s) succeeded: %+v", rr.CommitHash, stats)
+	return stats, nil
+}
+
+// lastDBRegen returns the most recent successful DBRegenRecord,
+// or nil if none of the most recent records is successful.
+func lastDBRegen(ctx context.Context, st store.Store) (*store.DBRegenRecord, error) {
+	rs, err := st.ListDBRegenRecords(ctx, 10)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range rs {
+		if r.Stage == store.DBRegenDone {
+			return r, nil
+		}
+	}
+	return nil, nil
+}
+
+// generateDB writes the database generated from repo to dir, as
+// cmd/gendb does (with a zipped copy), and returns its manifest.
+func generateDB(ctx context.Context, repo *git.Repository, dir string) (_ *database.Manifest, err error) {
+	defer derrors.Wrap(&err, "generateDB")
+
+	db, err := database.FromRepo(ctx, repo)
+	if err != nil {
+		return nil, err
+	}
+	if err := db.Write(dir); err != nil {
+		return nil, err
+	}
+	if err := db.WriteZip(filepath.Join(dir, dbZipFile)); err != nil {
+		return nil, err
+	}
+	return database.NewManifest(dir)
+}
+
+// DownloadDB returns an ExistingDBFunc that downloads the zipped
+// copy of the database at dbURL (DefaultVulnDBURL if empty).
+func DownloadDB(hc *http.Client, dbURL string) ExistingDBFunc {
+	if dbURL == "" {
+		dbURL = DefaultVulnDBURL
+	}
+	return func(ctx context.Context, dir string) (err error) {
+		u := strings.TrimSuffix(dbURL, "/") + "/" + dbZipFile
+		defer derrors.Wrap(&err, "DownloadDB(%s)", u)
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+		if err != nil {
+			return err
+		}
+		resp, err := hc.Do(req)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return fmt.Errorf("HTTP status %s", resp.Status)
+		}
+		f, err := os.CreateTemp("", dbZipFile)
+		if err != nil {
+			return err
+		}
+		defer os.Remove(f.Name())
+		defer f.Close()
+		if _, err := io.Copy(f, resp.Body); err != nil {
+			return err
+		}
+		return database.Unzip(f.Name(), dir)
+	}
+}
+
+// LocalDB returns an ExistingDBFunc that copies the database in
+// the directory src, for testing and the command line.
+func LocalDB(src string) ExistingDBFunc {
+	return func(_ context.Context, dir string) error {
+		return os.CopyFS(dir, os.DirFS(src))
+	}
+}
+
+// publishOrder returns the files of m in the order they should be
+// published: entries first, then the indexes (which refer to the
+// entries), then everything else, like the zipped copy. That way,
+// the files served never refer to an entry that is not yet served.
+func publishOrder(m *database.Manifest) [][]*database.ManifestFile {
+	var entries, indexes, others []*database.ManifestFile
+	for _, f := range m.Files {
+		switch {
+		case strings.HasPrefix(f.Path, "ID/"):
+			entries = append(entries, f)
+		case strings.HasPrefix(f.Path, "index/"):
+			indexes = append(indexes, f)
+		default:
+			others = append(others, f)
+		}
+	}
+	return [][]*database.ManifestFile{entries, indexes, others}
+}
+
+// DirPublisher is a DBPublisher that copies databases
+// to a local directory.
+type DirPublisher string
+
+// Publish implements DBPublisher.Publish.
+func (d DirPublisher) Publish(_ context.Context, dir string, m *database.Manifest) (err error) {
+	defer derrors.Wrap(&err, "DirPublisher(%s).Publish", string(d))
+
+	for _, fs := range publishOrder(m) {
+		for _, f := range fs {
+			if err := copyFile(filepath.Join(string(d), filepath.FromSlash(f.Path)), filepath.Join(dir, filepath.FromSlash(f.Path))); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func copyFile(dst, src string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	if err := os.MkdirAll(filepath.Dir(dst), 0755); err != nil {
+		return err
+	}
+	out, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
+
+// GCSPublisher is a DBPublisher that uploads databases
+// to a Cloud Storage bucket, as the deploy job did.
+type GCSPublisher struct {
+	bucket string
+	svc    *gcs.Service
+}
+
+// NewGCSPublisher returns a GCSPublisher for the given bucket,
+// authenticated with the default credentials.
+func NewGCSPublisher(ctx context.Context, bucket string) (*GCSPublisher, error) {
+	if bucket == "" {
+		return nil, errors.New("NewGCSPublisher: missing bucket")
+	}
+	svc, err := gcs.NewService(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &GCSPublisher{bucket: bucket, svc: svc}, nil
+}
+
+// maxUploads is the maximum number of concurrent uploads to Cloud Storage.
+const maxUploads = 32
+
+// Publish implements DBPublisher.Publish.
+func (p *GCSPublisher) Publish(ctx context.Context, dir string, m *database.Manifest) (err error) {
+	defer derrors.Wrap(&err, "GCSPublisher(%s).Publish", p.bucket)
+
+	for _, fs := range publishOrder(m) {
+		g, gctx := errgroup.WithContext(ctx)
+		g.SetLimit(maxUploads)
+		for _, f := range fs {
+			g.Go(func() error {
+				return p.upload(gctx, filepath.Join(dir, filepath.FromSlash(f.Path)), f.Path)
+			})
+		}
+		if err := g.Wait(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *GCSPublisher) upload(ctx context.Context, filename, name string) error {
+	f, err := os.Open(filename)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	obj := &gcs.Object{Name: name, ContentType: mime.TypeByExtension(path.Ext(name))}
+	_, err = p.svc.Objects.Insert(p.bucket, obj).Media(f).Context(ctx).Do()
+	return err
+}
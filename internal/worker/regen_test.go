@@ -0,0 +1,199 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"golang.org/x/tools/txtar"
+	"golang.org/x/vulndb/internal/database"
+	"golang.org/x/vulndb/internal/gitrepo"
+	"golang.org/x/vulndb/internal/osv"
+	"golang.org/x/vulndb/internal/report"
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+func TestRegenerateDB(t *testing.T) {
+	ctx := context.Background()
+	published := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	committed := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	newEntry := func(id string, vs ...*report.Version) osv.Entry {
+		r := &report.Report{
+			ID:          id,
+			Summary:     "A problem with example.com/module",
+			Description: "A description of the problem.",
+			Modules: []*report.Module{{
+				Module:   "example.com/module",
+				Versions: vs,
+				Packages: []*report.Package{{Package: "example.com/module"}},
+			}},
+			Published: published,
+		}
+		e, err := r.ToOSV(time.Time{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return e
+	}
+	fixed := report.Fixed("1.2.0")
+	// GO-2024-0002 affects all versions.
+	var ar txtar.Archive
+	for _, e := range []osv.Entry{newEntry("GO-2024-0001", fixed), newEntry("GO-2024-0002")} {
+		b, err := json.Marshal(e)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ar.Files = append(ar.Files, txtar.File{Name: "data/osv/" + e.ID + ".json", Data: b})
+	}
+	repo, err := gitrepo.FromTxtarArchive(&ar, committed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	head, err := gitrepo.HeadCommit(repo)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The deployed database has the first entry only.
+	writeDB := func(entries ...osv.Entry) string {
+		for i := range entries {
+			entries[i].Modified = osv.Time{Time: published}
+		}
+		db, err := database.New(entries...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		dir := t.TempDir()
+		if err := db.Write(dir); err != nil {
+			t.Fatal(err)
+		}
+		return dir
+	}
+	existing := LocalDB(writeDB(newEntry("GO-2024-0001", fixed)))
+
+	t.Run("publish", func(t *testing.T) {
+		mstore := store.NewMemStore()
+		pubDir := t.TempDir()
+		got, err := RegenerateDB(ctx, repo, existing, DirPublisher(pubDir), mstore, false, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		m, err := database.NewManifest(pubDir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := RegenerateDBStats{NumEntries: 2, NumFiles: 11, Size: m.Size(), Digest: m.Digest, Published: true}
+		if got != want {
+			t.Errorf("got stats %+v, want %+v", got, want)
+		}
+		if _, err := database.Load(pubDir); err != nil {
+			t.Errorf("published database is invalid: %v", err)
+		}
+
+		rs, err := mstore.ListDBRegenRecords(ctx, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		wantRecords := []*store.DBRegenRecord{{
+			CommitHash:     head.Hash.String(),
+			CommitTime:     committed,
+			Stage:          store.DBRegenDone,
+			NumEntries:     2,
+			Published:      true,
+			ManifestDigest: m.Digest,
+			Manifest:       m.Uncompressed(),
+		}}
+		if diff := cmp.Diff(wantRecords, rs, cmpopts.IgnoreFields(store.DBRegenRecord{}, "ID", "StartedAt", "EndedAt"), cmpopts.EquateApproxTime(time.Second)); diff != "" {
+			t.Errorf("records mismatch (-want, +got):\n%s", diff)
+		}
+
+		// The same commit is not regenerated, unless forced.
+		got, err = RegenerateDB(ctx, repo, existing, DirPublisher(pubDir), mstore, false, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := (RegenerateDBStats{Skipped: true}); got != want {
+			t.Errorf("second regeneration: got stats %+v, want %+v", got, want)
+		}
+		got, err = RegenerateDB(ctx, repo, existing, DirPublisher(pubDir), mstore, true, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got.Skipped || got.Digest != m.Digest {
+			t.Errorf("forced regeneration: got stats %+v, want digest %s", got, m.Digest)
+		}
+	})
+
+	t.Run("no publisher", func(t *testing.T) {
+		mstore := store.NewMemStore()
+		got, err := RegenerateDB(ctx, repo, existing, nil, mstore, false, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got.Published || got.NumEntries != 2 {
+			t.Errorf("got stats %+v, want 2 unpublished entries", got)
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		// An entry can't be removed from the database.
+		existing := LocalDB(writeDB(newEntry("GO-2024-0001", fixed), newEntry("GO-2024-0003", fixed)))
+		mstore := store.NewMemStore()
+		pubDir := t.TempDir()
+		_, err := RegenerateDB(ctx, repo, existing, DirPublisher(pubDir), mstore, false, false)
+		if err == nil || !strings.Contains(err.Error(), "GO-2024-0003 is not present") {
+			t.Fatalf("got error %v, want missing GO-2024-0003", err)
+		}
+		if _, err := os.Stat(filepath.Join(pubDir, "index")); !os.IsNotExist(err) {
+			t.Errorf("database published after a failed validation")
+		}
+		rs, err := mstore.ListDBRegenRecords(ctx, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(rs) != 1 || rs[0].Stage != store.DBRegenValidate || rs[0].Published || rs[0].Error == "" || rs[0].EndedAt.IsZero() {
+			t.Errorf("got records %+v, want one failed at %s", rs, store.DBRegenValidate)
+		}
+	})
+
+	t.Run("anomalies", func(t *testing.T) {
+		// The deployed GO-2024-0002 has a fixed version.
+		existing := LocalDB(writeDB(newEntry("GO-2024-0001", fixed), newEntry("GO-2024-0002", fixed)))
+		mstore := store.NewMemStore()
+		pubDir := t.TempDir()
+		_, err := RegenerateDB(ctx, repo, existing, DirPublisher(pubDir), mstore, false, false)
+		if err == nil || !strings.Contains(err.Error(), "GO-2024-0002: ranges for module example.com/module now cover all versions") {
+			t.Fatalf("got error %v, want anomaly in GO-2024-0002", err)
+		}
+		if _, err := os.Stat(filepath.Join(pubDir, "index")); !os.IsNotExist(err) {
+			t.Errorf("database with anomalies published")
+		}
+		rs, err := mstore.ListDBRegenRecords(ctx, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(rs) != 1 || rs[0].Published || len(rs[0].Anomalies) != 1 {
+			t.Errorf("got records %+v, want one unpublished with an anomaly", rs)
+		}
+
+		// Anomalies can be allowed.
+		got, err := RegenerateDB(ctx, repo, existing, DirPublisher(pubDir), mstore, false, true)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !got.Published || got.NumAnomalies != 1 {
+			t.Errorf("got stats %+v, want published with 1 anomaly", got)
+		}
+	})
+}
@@ -0,0 +1,47 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package worker
+
+import (
+	"context"
+	"fmt"
+
+	"golang.org/x/vulndb/internal/derrors"
+	"golang.org/x/vulndb/internal/observe"
+	"golang.org/x/vulndb/internal/pkgsite"
+	"golang.org/x/vulndb/internal/triage"
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+// ReplayDecision repeats the automated decision that the CVE with the
+// given ID does not affect Go, using the inputs recorded in its
+// CVE4Record when the decision was made. It returns the record and the
+// result of the replay, which is nil if the current triage logic makes
+// the same decision.
+//
+// Module paths that were not looked up when the decision was made are
+// looked up with pc, unless it is nil.
+func ReplayDecision(ctx context.Context, st store.Store, id string, pc *pkgsite.Client) (_ *store.CVE4Record, _ *triage.Result, err error) {
+	defer derrors.Wrap(&err, "ReplayDecision(%q)", id)
+	ctx, span := observe.Start(ctx, "ReplayDecision")
+	defer span.End()
+
+	rec, err := st.GetRecord(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	cr, ok := rec.(*store.CVE4Record)
+	if !ok || cr == nil {
+		return nil, nil, fmt.Errorf("no CVE record for %s", id)
+	}
+	if cr.TriageInputs == nil {
+		return nil, nil, fmt.Errorf("no recorded triage inputs for %s (triage state %s)", id, cr.TriageState)
+	}
+	result, err := triage.Replay(ctx, id, cr.TriageInputs, pc)
+	if err != nil {
+		return nil, nil, err
+	}
+	return cr, result, nil
+}
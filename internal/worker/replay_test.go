@@ -0,0 +1,65 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package worker
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"golang.org/x/vulndb/internal/triage"
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+func TestReplayDecision(t *testing.T) {
+	ctx := context.Background()
+	mstore := store.NewMemStore()
+	base := store.CVE4Record{
+		Path:        "path",
+		BlobHash:    "hash",
+		CommitHash:  "commit",
+		CommitTime:  time.Now(),
+		TriageState: store.TriageStateNoActionNeeded,
+	}
+	withInputs, withoutInputs := base, base
+	withInputs.ID = "CVE-2000-0001"
+	withInputs.TriageInputs = &triage.Inputs{
+		ReferenceURLs: []string{"https://example.com/a/b"},
+		Lookups:       []*triage.Lookup{{ModulePath: "example.com/a/b", Known: false}},
+	}
+	withoutInputs.ID = "CVE-2000-0002"
+	createCVE4Records(t, mstore, []*store.CVE4Record{&withInputs, &withoutInputs})
+
+	cr, result, err := ReplayDecision(ctx, mstore, withInputs.ID, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cr.ID != withInputs.ID {
+		t.Errorf("got record %s, want %s", cr.ID, withInputs.ID)
+	}
+	if result != nil {
+		t.Errorf("got result %+v, want nil (same decision)", result)
+	}
+
+	// Change the recorded answer, as if pkgsite now knew the module.
+	rec, err := mstore.GetRecord(ctx, withInputs.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rec.(*store.CVE4Record).TriageInputs.Lookups[0].Known = true
+	_, result, err = ReplayDecision(ctx, mstore, withInputs.ID, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result == nil || result.ModulePath != "example.com/a/b" {
+		t.Errorf("got result %+v, want module example.com/a/b", result)
+	}
+
+	for _, id := range []string{withoutInputs.ID, "CVE-2000-0003"} {
+		if _, _, err := ReplayDecision(ctx, mstore, id, nil); err == nil {
+			t.Errorf("ReplayDecision(%s): got nil error, want error", id)
+		}
+	}
+}
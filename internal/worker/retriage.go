@@ -0,0 +1,183 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package worker
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"golang.org/x/vulndb/internal/derrors"
+	"golang.org/x/vulndb/internal/observe"
+	"golang.org/x/vulndb/internal/pkgsite"
+	"golang.org/x/vulndb/internal/report"
+	"golang.org/x/vulndb/internal/triage"
+	"golang.org/x/vulndb/internal/worker/log"
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+// A vulnTriageFunc decides whether a vuln affects Go, and returns the
+// inputs to its decision.
+type vulnTriageFunc func(context.Context, triage.Vuln) (*triage.Result, *triage.Inputs, error)
+
+// RetriageStats are statistics about a run of RetriageDismissedCVEs.
+type RetriageStats struct {
+	// Number of dismissed CVEs checked.
+	NumChecked int
+	// Number of CVEs whose published record has not changed
+	// since they were triaged, or that have no published record.
+	NumUnchanged int
+	// Number of CVEs whose record changed, but has no new
+	// references to Go modules.
+	NumStillDismissed int
+	// Number of CVEs re-filed because their record has new
+	// references to Go modules.
+	NumRefiled int
+}
+
+// RetriageDismissedCVEs re-examines the CVEs that triage decided do not
+// affect Go (those in the NoActionNeeded and FalsePositive states)
+// whose published records changed after they were last triaged.
+//
+// Only the references that were not present when the CVE was triaged
+// are examined. If one of them refers to a Go module, the CVE is moved
+// to the NeedsIssue state, so that CreateIssues files an issue for it.
+// Otherwise its new references are recorded, so that the same change
+// is not examined again.
+func RetriageDismissedCVEs(ctx context.Context, st store.Store, published CVERecordFunc, pc *pkgsite.Client, rc *report.Client) (RetriageStats, error) {
+	return retriageDismissedCVEs(ctx, st, published, func(ctx context.Context, v triage.Vuln) (*triage.Result, *triage.Inputs, error) {
+		return triage.RefersToGoModuleWithInputs(ctx, v, pc)
+	}, rc, time.Now())
+}
+
+func retriageDismissedCVEs(ctx context.Context, st store.Store, published CVERecordFunc, affectsGo vulnTriageFunc, rc *report.Client, now time.Time) (stats RetriageStats, err error) {
+	defer derrors.Wrap(&err, "RetriageDismissedCVEs")
+	ctx, span := observe.Start(ctx, "RetriageDismissedCVEs")
+	defer span.End()
+
+	var dismissed []*store.CVE4Record
+	for _, ts := range []store.TriageState{store.TriageStateNoActionNeeded, store.TriageStateFalsePositive} {
+		crs, err := st.ListCVE4RecordsWithTriageState(ctx, ts)
+		if err != nil {
+			return stats, err
+		}
+		dismissed = append(dismissed, crs...)
+	}
+	for _, cr := range dismissed {
+		if rc.AliasHasReport(cr.ID) {
+			// The next update will move the CVE to HasVuln.
+			continue
+		}
+		stats.NumChecked++
+		current, err := published(ctx, cr.ID)
+		if err != nil {
+			return stats, err
+		}
+		if current == nil {
+			stats.NumUnchanged++
+			continue
+		}
+		updated, err := current.Metadata.UpdatedTime()
+		if err != nil {
+			log.Warningf(ctx, "%s: ignoring bad update time: %v", cr.ID, err)
+			stats.NumUnchanged++
+			continue
+		}
+		triaged := cr.CommitTime
+		if cr.RetriagedAt.After(triaged) {
+			triaged = cr.RetriagedAt
+		}
+		if !updated.After(triaged) {
+			stats.NumUnchanged++
+			continue
+		}
+
+		known := knownReferenceURLs(cr)
+		var newRefs []string
+		for _, u := range current.ReferenceURLs() {
+			if !slices.Contains(known, u) {
+				newRefs = append(newRefs, u)
+			}
+		}
+		var (
+			result *triage.Result
+			inputs *triage.Inputs
+		)
+		if len(newRefs) > 0 {
+			result, inputs, err = affectsGo(ctx, &refsVuln{id: cr.ID, refs: newRefs})
+			if err != nil {
+				return stats, err
+			}
+		}
+
+		mod := *cr
+		mod.RetriagedAt = now
+		if result != nil {
+			mod.History = append([]*store.CVE4RecordSnapshot{cr.Snapshot()}, cr.History...)
+			mod.TriageState = store.TriageStateNeedsIssue
+			mod.TriageStateReason = fmt.Sprintf("re-triaged after CVE record changed: %s", result.Reason)
+			mod.Module = result.ModulePath
+			mod.Package = result.PackagePath
+			mod.CVE5 = current
+			mod.TriageInputs = nil
+			log.Infof(ctx, "%s: re-filed: %s", cr.ID, result.Reason)
+			stats.NumRefiled++
+		} else {
+			// Remember the references that were examined.
+			switch {
+			case len(newRefs) == 0:
+			case mod.TriageState == store.TriageStateFalsePositive:
+				mod.ReferenceURLs = append(slices.Clone(cr.ReferenceURLs), newRefs...)
+			default:
+				mod.TriageInputs = mergeInputs(cr.TriageInputs, inputs)
+			}
+			stats.NumStillDismissed++
+		}
+		if err := st.RunTransaction(ctx, func(_ context.Context, tx store.Transaction) error {
+			return tx.SetRecord(&mod)
+		}); err != nil {
+			return stats, err
+		}
+	}
+	log.Infof(ctx, "Re-triaged %d dismissed CVEs: %d unchanged, %d still dismissed, %d re-filed",
+		stats.NumChecked, stats.NumUnchanged, stats.NumStillDismissed, stats.NumRefiled)
+	return stats, nil
+}
+
+// knownReferenceURLs returns the reference URLs of the CVE that were
+// examined when it was triaged, if they were recorded.
+func knownReferenceURLs(cr *store.CVE4Record) []string {
+	if cr.TriageState == store.TriageStateFalsePositive {
+		return cr.ReferenceURLs
+	}
+	if cr.TriageInputs != nil {
+		return cr.TriageInputs.ReferenceURLs
+	}
+	return nil
+}
+
+// mergeInputs returns the inputs to a decision made from the
+// references in both old and added.
+func mergeInputs(old, added *triage.Inputs) *triage.Inputs {
+	merged := &triage.Inputs{}
+	for _, in := range []*triage.Inputs{old, added} {
+		if in == nil {
+			continue
+		}
+		merged.ReferenceURLs = append(merged.ReferenceURLs, in.ReferenceURLs...)
+		merged.Lookups = append(merged.Lookups, in.Lookups...)
+	}
+	return merged
+}
+
+// refsVuln is a vuln with only some of the references of a CVE.
+type refsVuln struct {
+	id   string
+	refs []string
+}
+
+func (v *refsVuln) SourceID() string        { return v.id }
+func (v *refsVuln) ReferenceURLs() []string { return v.refs }
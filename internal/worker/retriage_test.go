@@ -0,0 +1,131 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package worker
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/vulndb/internal/cve5"
+	"golang.org/x/vulndb/internal/report"
+	"golang.org/x/vulndb/internal/triage"
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+func TestRetriageDismissedCVEs(t *testing.T) {
+	ctx := context.Background()
+	triaged := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	now := triaged.Add(48 * time.Hour)
+
+	base := store.CVE4Record{
+		Path:        "path",
+		BlobHash:    "hash",
+		CommitHash:  "commit",
+		CommitTime:  triaged,
+		TriageState: store.TriageStateNoActionNeeded,
+	}
+	refiled, unchanged, falsePositive, unpublished := base, base, base, base
+	refiled.ID = "CVE-2000-0001"
+	refiled.TriageInputs = &triage.Inputs{ReferenceURLs: []string{"https://example.com/a"}}
+	unchanged.ID = "CVE-2000-0002"
+	falsePositive.ID = "CVE-2000-0003"
+	falsePositive.TriageState = store.TriageStateFalsePositive
+	falsePositive.ReferenceURLs = []string{"https://github.com/a/b"}
+	unpublished.ID = "CVE-2000-0004"
+	mstore := store.NewMemStore()
+	createCVE4Records(t, mstore, []*store.CVE4Record{&refiled, &unchanged, &falsePositive, &unpublished})
+
+	record := func(id string, updated time.Time, refs ...string) *cve5.CVERecord {
+		c := &cve5.CVERecord{Metadata: cve5.Metadata{
+			ID:          id,
+			State:       cve5.StatePublished,
+			DateUpdated: updated.Format("2006-01-02T15:04:05.000Z"),
+		}}
+		for _, r := range refs {
+			c.Containers.CNAContainer.References = append(c.Containers.CNAContainer.References, cve5.Reference{URL: r})
+		}
+		return c
+	}
+	published := map[string]*cve5.CVERecord{
+		"CVE-2000-0001": record("CVE-2000-0001", triaged.Add(time.Hour), "https://example.com/a", "https://github.com/x/y"),
+		"CVE-2000-0002": record("CVE-2000-0002", triaged.Add(-time.Hour), "https://github.com/x/y"),
+		// The reference to a Go module was already present when
+		// the false positive was triaged.
+		"CVE-2000-0003": record("CVE-2000-0003", triaged.Add(time.Hour), "https://github.com/a/b", "https://example.com/c"),
+	}
+	fetch := func(_ context.Context, id string) (*cve5.CVERecord, error) {
+		return published[id], nil
+	}
+	// Module paths on github.com are Go modules.
+	var examined []string
+	affectsGo := func(_ context.Context, v triage.Vuln) (*triage.Result, *triage.Inputs, error) {
+		in := &triage.Inputs{ReferenceURLs: v.ReferenceURLs()}
+		for _, u := range v.ReferenceURLs() {
+			examined = append(examined, u)
+			if mp, ok := strings.CutPrefix(u, "https://"); ok && strings.HasPrefix(mp, "github.com/") {
+				return &triage.Result{ModulePath: mp, Reason: "refers to " + mp}, in, nil
+			}
+			in.Lookups = append(in.Lookups, &triage.Lookup{ModulePath: strings.TrimPrefix(u, "https://")})
+		}
+		return nil, in, nil
+	}
+	rc, err := report.NewTestClient(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	stats, err := retriageDismissedCVEs(ctx, mstore, fetch, affectsGo, rc, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (RetriageStats{NumChecked: 4, NumUnchanged: 2, NumStillDismissed: 1, NumRefiled: 1}); stats != want {
+		t.Errorf("got stats %+v, want %+v", stats, want)
+	}
+	// Only new references are examined.
+	if want := []string{"https://github.com/x/y", "https://example.com/c"}; !cmp.Equal(examined, want) {
+		t.Errorf("examined %v, want %v", examined, want)
+	}
+
+	got := getCVE4Record(t, mstore, refiled.ID)
+	if got.TriageState != store.TriageStateNeedsIssue || got.Module != "github.com/x/y" || got.CVE5 == nil {
+		t.Errorf("%s: got state %s, module %q, CVE5 %v; want NeedsIssue, github.com/x/y, non-nil",
+			got.ID, got.TriageState, got.Module, got.CVE5)
+	}
+	if len(got.History) != 1 || got.History[0].TriageState != store.TriageStateNoActionNeeded {
+		t.Errorf("%s: got history %v, want previous state", got.ID, got.History)
+	}
+	got = getCVE4Record(t, mstore, falsePositive.ID)
+	if want := []string{"https://github.com/a/b", "https://example.com/c"}; got.TriageState != store.TriageStateFalsePositive || !cmp.Equal(got.ReferenceURLs, want) {
+		t.Errorf("%s: got state %s, references %v; want FalsePositive, %v", got.ID, got.TriageState, got.ReferenceURLs, want)
+	}
+	if !got.RetriagedAt.Equal(now) {
+		t.Errorf("%s: got RetriagedAt %s, want %s", got.ID, got.RetriagedAt, now)
+	}
+
+	// A second run finds no changes.
+	examined = nil
+	stats, err = retriageDismissedCVEs(ctx, mstore, fetch, affectsGo, rc, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (RetriageStats{NumChecked: 3, NumUnchanged: 3}); stats != want {
+		t.Errorf("second run: got stats %+v, want %+v", stats, want)
+	}
+	if len(examined) != 0 {
+		t.Errorf("second run: examined %v, want none", examined)
+	}
+}
+
+func getCVE4Record(t *testing.T, st store.Store, id string) *store.CVE4Record {
+	t.Helper()
+	r, err := st.GetRecord(context.Background(), id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return r.(*store.CVE4Record)
+}
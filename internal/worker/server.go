@@ -16,18 +16,22 @@ import (
 	"runtime/debug"
 	"strconv"
 	"strings"
-	"sync/atomic"
 	"time"
 
 	"cloud.google.com/go/errorreporting"
+	"github.com/go-git/go-git/v5/plumbing/object"
 	"github.com/google/safehtml/template"
 	"github.com/jba/metrics"
 	"golang.org/x/sync/errgroup"
 	"golang.org/x/vulndb/internal/cvelistrepo"
 	"golang.org/x/vulndb/internal/derrors"
+	"golang.org/x/vulndb/internal/epss"
+	"golang.org/x/vulndb/internal/genericosv"
 	"golang.org/x/vulndb/internal/ghsa"
 	"golang.org/x/vulndb/internal/gitrepo"
 	"golang.org/x/vulndb/internal/issues"
+	"golang.org/x/vulndb/internal/kev"
+	"golang.org/x/vulndb/internal/nvd"
 	"golang.org/x/vulndb/internal/observe"
 	"golang.org/x/vulndb/internal/pkgsite"
 	"golang.org/x/vulndb/internal/proxy"
@@ -48,9 +52,13 @@ type Server struct {
 	indexTemplate *template.Template
 	issueClient   *issues.Client
 	ghsaClient    *ghsa.Client
+	nvdClient     *nvd.Client
+	kevClient     *kev.Client
+	epssClient    *epss.Client
 	proxyClient   *proxy.Client
 	reportClient  *report.Client
 	observer      *observe.Observer
+	jobs          []*job
 }
 
 func NewServer(ctx context.Context, cfg Config) (_ *Server, err error) {
@@ -76,6 +84,9 @@ func NewServer(ctx context.Context, cfg Config) (_ *Server, err error) {
 	}
 
 	s.ghsaClient = ghsa.NewClient(ctx, cfg.GitHubAccessToken)
+	s.nvdClient = nvd.NewClient(cfg.NVDAPIKey)
+	s.kevClient = kev.NewClient()
+	s.epssClient = epss.NewClient()
 	if cfg.IssueRepo != "" {
 		owner, repoName, err := gitrepo.ParseGitHubRepo(cfg.IssueRepo)
 		if err != nil {
@@ -93,6 +104,12 @@ func NewServer(ctx context.Context, cfg Config) (_ *Server, err error) {
 
 	s.proxyClient = proxy.NewDefaultClient()
 
+	if cfg.ConfigFile != "" {
+		if _, err := s.reloadConfig(ctx); err != nil {
+			return nil, err
+		}
+	}
+
 	rc, err := report.NewDefaultClient(ctx)
 	if err != nil {
 		return nil, err
@@ -110,13 +127,72 @@ func NewServer(ctx context.Context, cfg Config) (_ *Server, err error) {
 		return nil
 	})
 
-	// update: Update the DB from the cvelist repo head and the Github Security
-	// Advisories API and decide which CVEs and GHSAs need issues.
-	s.handle(ctx, "/update", s.handleUpdate)
-	// issues: File issues on GitHub for CVEs and GHSAs that need them.
-	s.handle(ctx, "/issues", s.handleIssues)
-	// update-and-issues: do update followed by issues.
-	s.handle(ctx, "/update-and-issues", s.handleUpdateAndIssues)
+	// The jobs, with how often the deployment schedules them
+	// (see terraform/environment/worker.tf).
+	for _, j := range []*job{
+		// update: Update the DB from the cvelist repo head and the Github Security
+		// Advisories API and decide which CVEs and GHSAs need issues.
+		{name: "update", run: s.handleUpdate},
+		// issues: File issues on GitHub for CVEs and GHSAs that need them.
+		{name: "issues", run: s.handleIssues},
+		// update-and-issues: do update followed by issues. It holds the
+		// update lock too, so that it never runs alongside update.
+		{name: "update-and-issues", interval: time.Hour, lock: "update", run: s.handleUpdateAndIssues},
+		// scan-nvd: Cross-check recently modified NVD CVEs for Go CPEs
+		// and decide which CVEs missed by the cvelist triage need issues.
+		{name: "scan-nvd", run: s.handleScanNVD},
+		// scan-osv: Look for advisories of other ecosystems in OSV.dev
+		// that refer to Go modules, and decide which of their CVEs missed
+		// by the cvelist triage need issues.
+		{name: "scan-osv", interval: 24 * time.Hour, run: s.handleScanOSV},
+		// sync-issues: Mirror the issue tracker's issues into the store.
+		{name: "sync-issues", run: s.handleSyncIssues},
+		// process-intake: Answer public reports of missing vulnerabilities.
+		{name: "process-intake", run: s.handleProcessIntake},
+		// process-symbol-feedback: Record feedback that report symbols
+		// are not vulnerable.
+		{name: "process-symbol-feedback", run: s.handleProcessSymbolFeedback},
+		// reconcile-cves: Check that the published records of the Go CNA's
+		// CVEs match the records generated from their reports.
+		{name: "reconcile-cves", run: s.handleReconcileCVEs},
+		// sync-cve-publications: Record the publication state of the Go
+		// CNA's CVEs in the store, for the dashboard.
+		{name: "sync-cve-publications", interval: 6 * time.Hour, run: s.handleSyncCVEPublications},
+		// sync-ghsa-reviews: Record, for each GHSA of a reviewed report,
+		// whether it says more than the report, and, if issues=true, file
+		// issues for the reports to be re-reviewed.
+		{name: "sync-ghsa-reviews", run: s.handleSyncGHSAReviews},
+		// sync-kev: Match CISA's catalog of Known Exploited Vulnerabilities
+		// against the reports and open issues and, if issues=true, escalate
+		// the issues and file issues for unreviewed reports to be reviewed.
+		{name: "sync-kev", interval: 24 * time.Hour, run: s.handleSyncKEV},
+		// refresh-epss: Record the current EPSS scores of the reports, and
+		// which reports have out-of-date scores.
+		{name: "refresh-epss", interval: 24 * time.Hour, run: s.handleRefreshEPSS},
+		// refresh-issues: Update the structured section of the open issues
+		// of CVEs and GHSAs that changed since their issue was filed.
+		{name: "refresh-issues", run: s.handleRefreshIssues},
+		// retriage-cves: Re-examine CVEs that were triaged as not affecting
+		// Go whose records have changed since, and re-file them if they now
+		// refer to Go modules.
+		{name: "retriage-cves", interval: 24 * time.Hour, run: s.handleRetriageCVEs},
+		// update-provenance: Record where the CVEs that need issues came
+		// from, by fetching their records from the NVD, the CNA feeds of
+		// the config and, if asked, the cvelistV5 repo.
+		{name: "update-provenance", run: s.handleUpdateProvenance},
+		// notify-osv: Notify the notification targets of the config of
+		// the OSV entries added or modified since the last notification.
+		{name: "notify-osv", run: s.handleNotifyOSV},
+		// regenerate-db: Regenerate the vulnerability database from the
+		// vulndb repo head, validate it against the deployed database and
+		// publish it to the database bucket, if there is one.
+		{name: "regenerate-db", interval: 10 * time.Minute, run: s.handleRegenerateDB},
+	} {
+		s.handleJob(ctx, j)
+	}
+	// reload-config: Load the config file into the store.
+	s.handle(ctx, "/reload-config", s.handleReloadConfig)
+	s.registerAPI(ctx)
 	return s, nil
 }
 
@@ -224,16 +300,23 @@ func renderPage(ctx context.Context, w http.ResponseWriter, page interface{}, tm
 type indexPage struct {
 	BuildInfo        string
 	CVEListRepoURL   string
+	VulnDBRepoURL    string
 	Namespace        string
+	Jobs             []*jobStatus
 	Updates          []*store.CommitUpdateRecord
+	DBRegens         []*store.DBRegenRecord
 	CVEsNeedingIssue []*store.CVE4Record
 	CVEsUpdatedSince []*store.CVE4Record
+	// CVEPublications are the CVEs of the Go CNA whose
+	// publication needs attention.
+	CVEPublications []*store.CVEPublicationRecord
 }
 
 func (s *Server) indexPage(w http.ResponseWriter, r *http.Request) error {
 
 	var page = indexPage{
 		CVEListRepoURL: cvelistrepo.URLv4,
+		VulnDBRepoURL:  report.VulndbURL,
 		Namespace:      s.cfg.Namespace,
 	}
 
@@ -257,11 +340,24 @@ func (s *Server) indexPage(w http.ResponseWriter, r *http.Request) error {
 		}
 	}
 	g, ctx := errgroup.WithContext(r.Context())
+	g.Go(func() error {
+		rs, err := s.cfg.Store.ListJobRecords(ctx)
+		if err != nil {
+			return err
+		}
+		page.Jobs = jobStatuses(s.jobs, rs, time.Now())
+		return nil
+	})
 	g.Go(func() error {
 		var err error
 		page.Updates, err = s.cfg.Store.ListCommitUpdateRecords(ctx, 10)
 		return err
 	})
+	g.Go(func() error {
+		var err error
+		page.DBRegens, err = s.cfg.Store.ListDBRegenRecords(ctx, 10)
+		return err
+	})
 	g.Go(func() error {
 		var err error
 		page.CVEsNeedingIssue, err = s.cfg.Store.ListCVE4RecordsWithTriageState(ctx, store.TriageStateNeedsIssue)
@@ -272,6 +368,18 @@ func (s *Server) indexPage(w http.ResponseWriter, r *http.Request) error {
 		page.CVEsUpdatedSince, err = s.cfg.Store.ListCVE4RecordsWithTriageState(ctx, store.TriageStateUpdatedSinceIssueCreation)
 		return err
 	})
+	g.Go(func() error {
+		rs, err := s.cfg.Store.ListCVEPublicationRecords(ctx)
+		if err != nil {
+			return err
+		}
+		for _, r := range rs {
+			if r.NeedsAttention() {
+				page.CVEPublications = append(page.CVEPublications, r)
+			}
+		}
+		return nil
+	})
 	if err := g.Wait(); err != nil {
 		return err
 	}
@@ -285,58 +393,82 @@ type UpdateOutcome struct {
 var updateCounters = metrics.NewCounterGroup[int64, UpdateOutcome]("updates", "calls to handleUpdate")
 
 func (s *Server) handleUpdate(w http.ResponseWriter, r *http.Request) error {
-	err := s.doUpdate(r)
-	if err == nil {
-		fmt.Fprintf(w, "Update succeeded.\n")
+	skipped, err := s.doUpdate(r)
+	if err != nil {
+		return err
 	}
-	return err
+	if skipped != "" {
+		fmt.Fprintf(w, "Update skipped: %s.\n", skipped)
+		return nil
+	}
+	fmt.Fprintf(w, "Update succeeded.\n")
+	return nil
 }
 
-func (s *Server) doUpdate(r *http.Request) (err error) {
+// doUpdate performs an update. If the update is skipped,
+// it returns the reason.
+func (s *Server) doUpdate(r *http.Request) (skipped string, err error) {
 	defer func() {
 		success := err == nil
 		updateCounters.At(UpdateOutcome{success}).Add(1)
 		log.Debugf(r.Context(), "recorded one /update operation in counter (success=%t)", success)
 	}()
 
-	if r.Method != http.MethodPost {
-		return &serverError{
-			status: http.StatusMethodNotAllowed,
-			err:    fmt.Errorf("%s required", http.MethodPost),
+	force := (r.FormValue("force") == "true")
+	if !force {
+		reason, err := s.updateTooSoon(r.Context())
+		if err != nil || reason != "" {
+			return reason, err
 		}
 	}
-	force := (r.FormValue("force") == "true")
 
 	rc, err := report.NewDefaultClient(r.Context())
 	if err != nil {
-		return err
+		return "", err
 	}
 
-	err = UpdateCVEsAtCommit(r.Context(), cvelistrepo.URLv4, "HEAD", s.cfg.Store, pkgsite.Default(), rc, force)
+	err = UpdateCVEsAtCommit(r.Context(), cvelistrepo.URLv4, "HEAD", s.cfg.Store, pkgsite.Default(pkgsite.WithProxyFallback(s.proxyClient)), rc, force)
 	if cerr := new(CheckUpdateError); errors.As(err, &cerr) {
-		return &serverError{
+		return "", &serverError{
 			status: http.StatusPreconditionFailed,
 			err:    fmt.Errorf("%w; use /update?force=true to override", cerr),
 		}
 	}
 	if err != nil {
-		return err
+		return "", err
 	}
 	listSAs := func(ctx context.Context, since time.Time) ([]*ghsa.SecurityAdvisory, error) {
 		return s.ghsaClient.List(ctx, since)
 	}
 	_, err = UpdateGHSAs(r.Context(), listSAs, s.cfg.Store)
-	return err
+	return "", err
+}
 
+// updateTooSoon returns a reason to skip an unforced update if the
+// last one started less than the configured minimum interval ago.
+func (s *Server) updateTooSoon(ctx context.Context) (string, error) {
+	lc, err := loadLiveConfig(ctx, s.cfg.Store)
+	if err != nil {
+		return "", err
+	}
+	if lc.minUpdateInterval <= 0 {
+		return "", nil
+	}
+	urs, err := s.cfg.Store.ListCommitUpdateRecords(ctx, 1)
+	if err != nil {
+		return "", err
+	}
+	if len(urs) == 0 {
+		return "", nil
+	}
+	if since := time.Since(urs[0].StartedAt); since < lc.minUpdateInterval {
+		return fmt.Sprintf("last update started %s ago, less than the minimum interval of %s; use /update?force=true to override",
+			since.Round(time.Second), lc.minUpdateInterval), nil
+	}
+	return "", nil
 }
 
 func (s *Server) handleIssues(w http.ResponseWriter, r *http.Request) error {
-	if r.Method != http.MethodPost {
-		return &serverError{
-			status: http.StatusMethodNotAllowed,
-			err:    fmt.Errorf("%s required", http.MethodPost),
-		}
-	}
 	if s.issueClient == nil {
 		return &serverError{
 			status: http.StatusPreconditionFailed,
@@ -344,9 +476,12 @@ func (s *Server) handleIssues(w http.ResponseWriter, r *http.Request) error {
 		}
 	}
 	// Unless explicitly asked to, don't create more than a few issues.
-	limit := 10
+	lc, err := loadLiveConfig(r.Context(), s.cfg.Store)
+	if err != nil {
+		return err
+	}
+	limit := lc.issueLimit
 	if sl := r.FormValue("limit"); sl != "" {
-		var err error
 		limit, err = strconv.Atoi(sl)
 		if err != nil {
 			return &serverError{
@@ -359,24 +494,318 @@ func (s *Server) handleIssues(w http.ResponseWriter, r *http.Request) error {
 	return CreateIssues(r.Context(), s.cfg.Store, s.issueClient, s.proxyClient, s.reportClient, limit)
 }
 
-var updateAndIssuesInProgress atomic.Value
+func (s *Server) handleUpdateAndIssues(w http.ResponseWriter, r *http.Request) error {
+	skipped, err := s.doUpdate(r)
+	if err != nil {
+		return err
+	}
+	if skipped != "" {
+		fmt.Fprintf(w, "Update skipped: %s.\n", skipped)
+	}
+	return s.handleIssues(w, r)
+}
+
+func (s *Server) handleScanNVD(w http.ResponseWriter, r *http.Request) error {
+	lc, err := loadLiveConfig(r.Context(), s.cfg.Store)
+	if err != nil {
+		return err
+	}
+	window := lc.nvdScanWindow
+	if sw := r.FormValue("window"); sw != "" {
+		var err error
+		window, err = time.ParseDuration(sw)
+		if err != nil {
+			return &serverError{
+				status: http.StatusBadRequest,
+				err:    fmt.Errorf("parsing window query param: %w", err),
+			}
+		}
+	}
+	listCVEs := func(ctx context.Context, since time.Time) ([]*nvd.CVE, error) {
+		return s.nvdClient.List(ctx, since)
+	}
+	stats, err := ScanNVD(r.Context(), listCVEs, time.Now().Add(-window), s.cfg.Store, s.proxyClient, s.reportClient)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(w, "NVD scan succeeded: %+v\n", stats)
+	return nil
+}
 
-func init() {
-	updateAndIssuesInProgress.Store(false)
+func (s *Server) handleScanOSV(w http.ResponseWriter, r *http.Request) error {
+	lc, err := loadLiveConfig(r.Context(), s.cfg.Store)
+	if err != nil {
+		return err
+	}
+	window := lc.nvdScanWindow
+	if sw := r.FormValue("window"); sw != "" {
+		var err error
+		window, err = time.ParseDuration(sw)
+		if err != nil {
+			return &serverError{
+				status: http.StatusBadRequest,
+				err:    fmt.Errorf("parsing window query param: %w", err),
+			}
+		}
+	}
+	ecosystems := DefaultOSVScanEcosystems
+	if eco := r.FormValue("ecosystem"); eco != "" {
+		ecosystems = []genericosv.Ecosystem{genericosv.Ecosystem(eco)}
+	}
+	list := func(ctx context.Context, eco genericosv.Ecosystem) ([]*genericosv.Entry, error) {
+		return genericosv.ListEcosystem(ctx, http.DefaultClient, eco)
+	}
+	pc := pkgsite.Default(pkgsite.WithProxyFallback(s.proxyClient))
+	stats, err := ScanOSV(r.Context(), list, ecosystems, time.Now().Add(-window), s.cfg.Store, pc, s.reportClient)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(w, "OSV scan succeeded: %+v\n", stats)
+	return nil
 }
 
-func (s *Server) handleUpdateAndIssues(w http.ResponseWriter, r *http.Request) error {
-	if updateAndIssuesInProgress.Load().(bool) {
+func (s *Server) handleSyncIssues(w http.ResponseWriter, r *http.Request) error {
+	if s.issueClient == nil {
 		return &serverError{
 			status: http.StatusPreconditionFailed,
-			err:    errors.New("update-and-issues already in progress"),
+			err:    errors.New("no issue repo configured"),
 		}
 	}
-	updateAndIssuesInProgress.Store(true)
-	defer func() { updateAndIssuesInProgress.Store(false) }()
+	full := (r.FormValue("full") == "true")
+	stats, err := SyncIssues(r.Context(), s.issueClient.Issues, s.cfg.Store, full)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(w, "issue sync succeeded: %+v\n", stats)
+	return nil
+}
 
-	if err := s.doUpdate(r); err != nil {
+func (s *Server) handleReconcileCVEs(w http.ResponseWriter, r *http.Request) error {
+	published, err := NewCVERecordFunc(r.Context(), r.FormValue("source"), "")
+	if errors.Is(err, errUnknownCVESource) {
+		return &serverError{status: http.StatusBadRequest, err: err}
+	}
+	if err != nil {
 		return err
 	}
-	return s.handleIssues(w, r)
+	stats, ds, err := ReconcileCVEs(r.Context(), published, s.reportClient)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(w, "CVE reconciliation succeeded: %+v\n", stats)
+	for _, d := range ds {
+		fmt.Fprintln(w, d)
+	}
+	return nil
+}
+
+func (s *Server) handleSyncCVEPublications(w http.ResponseWriter, r *http.Request) error {
+	published, err := NewCVERecordFunc(r.Context(), r.FormValue("source"), "")
+	if errors.Is(err, errUnknownCVESource) {
+		return &serverError{status: http.StatusBadRequest, err: err}
+	}
+	if err != nil {
+		return err
+	}
+	stats, err := SyncCVEPublications(r.Context(), published, s.reportClient, s.cfg.Store)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(w, "CVE publication sync succeeded: %+v\n", stats)
+	return nil
+}
+
+func (s *Server) handleSyncGHSAReviews(w http.ResponseWriter, r *http.Request) error {
+	var client *issues.Client
+	if r.FormValue("issues") == "true" {
+		if s.issueClient == nil {
+			return &serverError{
+				status: http.StatusPreconditionFailed,
+				err:    errors.New("no issue repo configured"),
+			}
+		}
+		client = s.issueClient
+	}
+	stats, err := SyncGHSAReviews(r.Context(), s.ghsaClient.FetchGHSA, s.reportClient, s.cfg.Store, client)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(w, "GHSA review sync succeeded: %+v\n", stats)
+	return nil
+}
+
+func (s *Server) handleSyncKEV(w http.ResponseWriter, r *http.Request) error {
+	var client *issues.Client
+	if r.FormValue("issues") == "true" {
+		if s.issueClient == nil {
+			return &serverError{
+				status: http.StatusPreconditionFailed,
+				err:    errors.New("no issue repo configured"),
+			}
+		}
+		client = s.issueClient
+	}
+	stats, err := SyncKEV(r.Context(), s.kevClient.Fetch, s.reportClient, s.cfg.Store, client)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(w, "KEV sync succeeded: %+v\n", stats)
+	return nil
+}
+
+func (s *Server) handleRefreshEPSS(w http.ResponseWriter, r *http.Request) error {
+	stats, err := RefreshEPSS(r.Context(), s.epssClient.Scores, s.reportClient, s.cfg.Store)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(w, "EPSS refresh succeeded: %+v\n", stats)
+	return nil
+}
+
+func (s *Server) handleRefreshIssues(w http.ResponseWriter, r *http.Request) error {
+	if s.issueClient == nil {
+		return &serverError{
+			status: http.StatusPreconditionFailed,
+			err:    errors.New("no issue repo configured"),
+		}
+	}
+	stats, err := RefreshIssues(r.Context(), s.cfg.Store, s.issueClient, s.proxyClient)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(w, "issue refresh succeeded: %+v\n", stats)
+	return nil
+}
+
+func (s *Server) handleRetriageCVEs(w http.ResponseWriter, r *http.Request) error {
+	published, err := NewCVERecordFunc(r.Context(), r.FormValue("source"), "")
+	if errors.Is(err, errUnknownCVESource) {
+		return &serverError{status: http.StatusBadRequest, err: err}
+	}
+	if err != nil {
+		return err
+	}
+	pc := pkgsite.Default(pkgsite.WithProxyFallback(s.proxyClient))
+	stats, err := RetriageDismissedCVEs(r.Context(), s.cfg.Store, published, pc, s.reportClient)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(w, "CVE re-triage succeeded: %+v\n", stats)
+	return nil
+}
+
+func (s *Server) handleUpdateProvenance(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	var commit *object.Commit
+	if r.FormValue("cvelist") == "true" {
+		repo, err := cvelistrepo.Clone(ctx, cvelistrepo.URLv5, "")
+		if err != nil {
+			return err
+		}
+		commit, err = gitrepo.HeadCommit(repo)
+		if err != nil {
+			return err
+		}
+	}
+	sources, err := ProvenanceSources(ctx, s.cfg.Store, s.nvdClient, commit)
+	if err != nil {
+		return err
+	}
+	stats, err := UpdateProvenance(ctx, s.cfg.Store, sources)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(w, "provenance update succeeded: %+v\n", stats)
+	return nil
+}
+
+func (s *Server) handleNotifyOSV(w http.ResponseWriter, r *http.Request) error {
+	stats, err := NotifyOSVChanges(r.Context(), s.cfg.Store, s.cfg.VulnDBURL)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(w, "OSV notification succeeded: %+v\n", stats)
+	return nil
+}
+
+func (s *Server) handleRegenerateDB(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	repo, err := gitrepo.CloneWithHistory(ctx, report.VulndbURL)
+	if err != nil {
+		return err
+	}
+	var pub DBPublisher
+	if s.cfg.DBBucket != "" {
+		pub, err = NewGCSPublisher(ctx, s.cfg.DBBucket)
+		if err != nil {
+			return err
+		}
+	}
+	force := r.FormValue("force") == "true"
+	allowAnomalies := r.FormValue("allow-anomalies") == "true"
+	stats, err := RegenerateDB(ctx, repo, DownloadDB(http.DefaultClient, s.cfg.VulnDBURL), pub, s.cfg.Store, force, allowAnomalies)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(w, "database regeneration succeeded: %+v\n", stats)
+	return nil
+}
+
+func (s *Server) handleProcessIntake(w http.ResponseWriter, r *http.Request) error {
+	if s.issueClient == nil {
+		return &serverError{
+			status: http.StatusPreconditionFailed,
+			err:    errors.New("no issue repo configured"),
+		}
+	}
+	stats, err := ProcessIntake(r.Context(), s.cfg.Store, s.issueClient, s.reportClient)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(w, "intake succeeded: %+v\n", stats)
+	return nil
+}
+
+func (s *Server) handleProcessSymbolFeedback(w http.ResponseWriter, r *http.Request) error {
+	if s.issueClient == nil {
+		return &serverError{
+			status: http.StatusPreconditionFailed,
+			err:    errors.New("no issue repo configured"),
+		}
+	}
+	stats, err := ProcessSymbolFeedback(r.Context(), s.cfg.Store, s.issueClient, s.reportClient)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(w, "symbol feedback succeeded: %+v\n", stats)
+	return nil
+}
+
+func (s *Server) handleReloadConfig(w http.ResponseWriter, r *http.Request) error {
+	if r.Method != http.MethodPost {
+		return &serverError{
+			status: http.StatusMethodNotAllowed,
+			err:    fmt.Errorf("%s required", http.MethodPost),
+		}
+	}
+	if s.cfg.ConfigFile == "" {
+		return &serverError{
+			status: http.StatusPreconditionFailed,
+			err:    errors.New("no config file"),
+		}
+	}
+	changed, err := s.reloadConfig(r.Context())
+	if err != nil {
+		return err
+	}
+	if changed {
+		fmt.Fprintf(w, "Config changed.\n")
+	} else {
+		fmt.Fprintf(w, "Config unchanged.\n")
+	}
+	return nil
+}
+
+func (s *Server) reloadConfig(ctx context.Context) (bool, error) {
+	return ReloadWorkerConfig(ctx, s.cfg.Store, s.cfg.ConfigFile, "file:"+s.cfg.ConfigFile)
 }
@@ -8,6 +8,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
 	"strings"
 	"time"
 
@@ -33,8 +36,19 @@ import (
 // are some collections:
 // - CVEs for CVE4Records
 // - CommitUpdates for CommitUpdateRecords
+// - DBRegens for DBRegenRecords
 // - DirHashes for directory hashes
-// - GHSAs for LegacyGHSARecords.
+// - GHSAs for LegacyGHSARecords
+// - Issues for IssueRecords
+// - SymbolFeedback for SymbolFeedbackRecords
+// - CVEPublications for CVEPublicationRecords
+// - GHSAReviews for GHSAReviewRecords
+// - FindingStats for FindingStatsRecords
+// - KEV for KEVRecords
+// - EPSS for EPSSRecords
+// - Jobs for JobRecords
+// - Config for the WorkerConfig and the NotificationRecord, in a document each
+// - ConfigChanges for ConfigChangeRecords.
 type FireStore struct {
 	namespace string
 	client    *firestore.Client
@@ -42,11 +56,29 @@ type FireStore struct {
 }
 
 const (
-	namespaceCollection  = "Namespaces"
-	updateCollection     = "Updates"
-	cve4Collection       = "CVEs"
-	dirHashCollection    = "DirHashes"
-	legacyGHSACollection = "GHSAs"
+	namespaceCollection      = "Namespaces"
+	updateCollection         = "Updates"
+	dbRegenCollection        = "DBRegens"
+	cve4Collection           = "CVEs"
+	dirHashCollection        = "DirHashes"
+	legacyGHSACollection     = "GHSAs"
+	issueCollection          = "Issues"
+	symbolFeedbackCollection = "SymbolFeedback"
+	cvePublicationCollection = "CVEPublications"
+	ghsaReviewCollection     = "GHSAReviews"
+	findingStatsCollection   = "FindingStats"
+	kevCollection            = "KEV"
+	epssCollection           = "EPSS"
+	jobCollection            = "Jobs"
+	configCollection         = "Config"
+	configChangeCollection   = "ConfigChanges"
+)
+
+// The IDs of the documents in configCollection
+// that hold the WorkerConfig and the NotificationRecord.
+const (
+	workerConfigID = "worker"
+	notificationID = "notification"
 )
 
 // NewFireStore creates a new FireStore, backed by a client to Firestore. Since
@@ -142,6 +174,56 @@ func (fs *FireStore) ListCommitUpdateRecords(ctx context.Context, limit int) (_
 	return urs, nil
 }
 
+// CreateDBRegenRecord implements Store.CreateDBRegenRecord.
+// On successful return, r.ID is set to the record's ID.
+func (fs *FireStore) CreateDBRegenRecord(ctx context.Context, r *DBRegenRecord) (err error) {
+	defer derrors.Wrap(&err, "FireStore.CreateDBRegenRecord")
+
+	docref := fs.nsDoc.Collection(dbRegenCollection).NewDoc()
+	if _, err := docref.Create(ctx, r); err != nil {
+		return err
+	}
+	r.ID = docref.ID
+	return nil
+}
+
+// SetDBRegenRecord implements Store.SetDBRegenRecord.
+func (fs *FireStore) SetDBRegenRecord(ctx context.Context, r *DBRegenRecord) (err error) {
+	defer derrors.Wrap(&err, "FireStore.SetDBRegenRecord(%q)", r.ID)
+
+	if r.ID == "" {
+		return errors.New("missing ID")
+	}
+	_, err = fs.nsDoc.Collection(dbRegenCollection).Doc(r.ID).Set(ctx, r)
+	return err
+}
+
+// ListDBRegenRecords implements Store.ListDBRegenRecords.
+func (fs *FireStore) ListDBRegenRecords(ctx context.Context, limit int) (_ []*DBRegenRecord, err error) {
+	defer derrors.Wrap(&err, "Firestore.ListDBRegenRecords(%d)", limit)
+
+	var rs []*DBRegenRecord
+	q := fs.nsDoc.Collection(dbRegenCollection).OrderBy("StartedAt", firestore.Desc)
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+	iter := q.Documents(ctx)
+	defer iter.Stop()
+	err = apply(iter, func(ds *firestore.DocumentSnapshot) error {
+		var r DBRegenRecord
+		if err := ds.DataTo(&r); err != nil {
+			return err
+		}
+		r.ID = ds.Ref.ID
+		rs = append(rs, &r)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return rs, nil
+}
+
 type dirHash struct {
 	Hash string
 }
@@ -196,6 +278,575 @@ func (fs *FireStore) SetDirectoryHash(ctx context.Context, dir, hash string) (er
 	return err
 }
 
+// issueRef returns a DocumentRef for the issue with the given number.
+func (fs *FireStore) issueRef(number int) *firestore.DocumentRef {
+	return fs.nsDoc.Collection(issueCollection).Doc(strconv.Itoa(number))
+}
+
+// SetIssueRecords implements Store.SetIssueRecords.
+func (fs *FireStore) SetIssueRecords(ctx context.Context, rs []*IssueRecord) (err error) {
+	defer derrors.Wrap(&err, "FireStore.SetIssueRecords(%d records)", len(rs))
+
+	bw := fs.client.BulkWriter(ctx)
+	var jobs []*firestore.BulkWriterJob
+	for _, r := range rs {
+		c := *r
+		c.SyncedAt = time.Now()
+		j, err := bw.Set(fs.issueRef(c.Number), &c)
+		if err != nil {
+			bw.End()
+			return err
+		}
+		jobs = append(jobs, j)
+	}
+	bw.End()
+	for _, j := range jobs {
+		if _, err := j.Results(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetIssueRecord implements Store.GetIssueRecord.
+func (fs *FireStore) GetIssueRecord(ctx context.Context, number int) (_ *IssueRecord, err error) {
+	defer derrors.Wrap(&err, "FireStore.GetIssueRecord(%d)", number)
+
+	ds, err := fs.issueRef(number).Get(ctx)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var ir IssueRecord
+	if err := ds.DataTo(&ir); err != nil {
+		return nil, err
+	}
+	return &ir, nil
+}
+
+// ListIssueRecords implements Store.ListIssueRecords.
+func (fs *FireStore) ListIssueRecords(ctx context.Context, state string) (_ []*IssueRecord, err error) {
+	defer derrors.Wrap(&err, "FireStore.ListIssueRecords(%q)", state)
+
+	q := fs.nsDoc.Collection(issueCollection).Query
+	if state != "" {
+		q = q.Where("State", "==", state)
+	}
+	iter := q.OrderBy("Number", firestore.Asc).Documents(ctx)
+	defer iter.Stop()
+	var irs []*IssueRecord
+	err = apply(iter, func(ds *firestore.DocumentSnapshot) error {
+		var ir IssueRecord
+		if err := ds.DataTo(&ir); err != nil {
+			return err
+		}
+		irs = append(irs, &ir)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return irs, nil
+}
+
+// symbolFeedbackRef returns a DocumentRef for the SymbolFeedbackRecord
+// with the given key. The key is escaped because document IDs
+// cannot contain slashes.
+func (fs *FireStore) symbolFeedbackRef(key string) *firestore.DocumentRef {
+	return fs.nsDoc.Collection(symbolFeedbackCollection).Doc(url.QueryEscape(key))
+}
+
+// SetSymbolFeedbackRecords implements Store.SetSymbolFeedbackRecords.
+func (fs *FireStore) SetSymbolFeedbackRecords(ctx context.Context, rs []*SymbolFeedbackRecord) (err error) {
+	defer derrors.Wrap(&err, "FireStore.SetSymbolFeedbackRecords(%d records)", len(rs))
+
+	bw := fs.client.BulkWriter(ctx)
+	var jobs []*firestore.BulkWriterJob
+	for _, r := range rs {
+		j, err := bw.Set(fs.symbolFeedbackRef(r.Key()), r)
+		if err != nil {
+			bw.End()
+			return err
+		}
+		jobs = append(jobs, j)
+	}
+	bw.End()
+	for _, j := range jobs {
+		if _, err := j.Results(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ListSymbolFeedbackRecords implements Store.ListSymbolFeedbackRecords.
+func (fs *FireStore) ListSymbolFeedbackRecords(ctx context.Context, status SymbolFeedbackStatus) (_ []*SymbolFeedbackRecord, err error) {
+	defer derrors.Wrap(&err, "FireStore.ListSymbolFeedbackRecords(%q)", status)
+
+	q := fs.nsDoc.Collection(symbolFeedbackCollection).Query
+	if status != "" {
+		q = q.Where("Status", "==", status)
+	}
+	iter := q.Documents(ctx)
+	defer iter.Stop()
+	var rs []*SymbolFeedbackRecord
+	err = apply(iter, func(ds *firestore.DocumentSnapshot) error {
+		var r SymbolFeedbackRecord
+		if err := ds.DataTo(&r); err != nil {
+			return err
+		}
+		rs = append(rs, &r)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	// Sort here rather than in the query, because the key
+	// is not a field.
+	slices.SortFunc(rs, func(a, b *SymbolFeedbackRecord) int {
+		return strings.Compare(a.Key(), b.Key())
+	})
+	return rs, nil
+}
+
+// SetCVEPublicationRecords implements Store.SetCVEPublicationRecords.
+func (fs *FireStore) SetCVEPublicationRecords(ctx context.Context, rs []*CVEPublicationRecord) (err error) {
+	defer derrors.Wrap(&err, "FireStore.SetCVEPublicationRecords(%d records)", len(rs))
+
+	bw := fs.client.BulkWriter(ctx)
+	var jobs []*firestore.BulkWriterJob
+	for _, r := range rs {
+		j, err := bw.Set(fs.nsDoc.Collection(cvePublicationCollection).Doc(r.CVE), r)
+		if err != nil {
+			bw.End()
+			return err
+		}
+		jobs = append(jobs, j)
+	}
+	bw.End()
+	for _, j := range jobs {
+		if _, err := j.Results(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ListCVEPublicationRecords implements Store.ListCVEPublicationRecords.
+func (fs *FireStore) ListCVEPublicationRecords(ctx context.Context) (_ []*CVEPublicationRecord, err error) {
+	defer derrors.Wrap(&err, "FireStore.ListCVEPublicationRecords")
+
+	iter := fs.nsDoc.Collection(cvePublicationCollection).OrderBy("CVE", firestore.Asc).Documents(ctx)
+	defer iter.Stop()
+	var rs []*CVEPublicationRecord
+	err = apply(iter, func(ds *firestore.DocumentSnapshot) error {
+		var r CVEPublicationRecord
+		if err := ds.DataTo(&r); err != nil {
+			return err
+		}
+		rs = append(rs, &r)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return rs, nil
+}
+
+// SetGHSAReviewRecords implements Store.SetGHSAReviewRecords.
+func (fs *FireStore) SetGHSAReviewRecords(ctx context.Context, rs []*GHSAReviewRecord) (err error) {
+	defer derrors.Wrap(&err, "FireStore.SetGHSAReviewRecords(%d records)", len(rs))
+
+	bw := fs.client.BulkWriter(ctx)
+	var jobs []*firestore.BulkWriterJob
+	for _, r := range rs {
+		j, err := bw.Set(fs.nsDoc.Collection(ghsaReviewCollection).Doc(r.Key()), r)
+		if err != nil {
+			bw.End()
+			return err
+		}
+		jobs = append(jobs, j)
+	}
+	bw.End()
+	for _, j := range jobs {
+		if _, err := j.Results(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ListGHSAReviewRecords implements Store.ListGHSAReviewRecords.
+func (fs *FireStore) ListGHSAReviewRecords(ctx context.Context) (_ []*GHSAReviewRecord, err error) {
+	defer derrors.Wrap(&err, "FireStore.ListGHSAReviewRecords")
+
+	iter := fs.nsDoc.Collection(ghsaReviewCollection).Documents(ctx)
+	defer iter.Stop()
+	var rs []*GHSAReviewRecord
+	err = apply(iter, func(ds *firestore.DocumentSnapshot) error {
+		var r GHSAReviewRecord
+		if err := ds.DataTo(&r); err != nil {
+			return err
+		}
+		rs = append(rs, &r)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	// Sort here rather than in the query, because the key
+	// is not a field.
+	slices.SortFunc(rs, func(a, b *GHSAReviewRecord) int {
+		return strings.Compare(a.Key(), b.Key())
+	})
+	return rs, nil
+}
+
+// SetFindingStatsRecords implements Store.SetFindingStatsRecords.
+func (fs *FireStore) SetFindingStatsRecords(ctx context.Context, rs []*FindingStatsRecord) (err error) {
+	defer derrors.Wrap(&err, "FireStore.SetFindingStatsRecords(%d records)", len(rs))
+
+	bw := fs.client.BulkWriter(ctx)
+	var jobs []*firestore.BulkWriterJob
+	for _, r := range rs {
+		j, err := bw.Set(fs.nsDoc.Collection(findingStatsCollection).Doc(r.ReportID), r)
+		if err != nil {
+			bw.End()
+			return err
+		}
+		jobs = append(jobs, j)
+	}
+	bw.End()
+	for _, j := range jobs {
+		if _, err := j.Results(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ListFindingStatsRecords implements Store.ListFindingStatsRecords.
+func (fs *FireStore) ListFindingStatsRecords(ctx context.Context) (_ []*FindingStatsRecord, err error) {
+	defer derrors.Wrap(&err, "FireStore.ListFindingStatsRecords")
+
+	iter := fs.nsDoc.Collection(findingStatsCollection).OrderBy("ReportID", firestore.Asc).Documents(ctx)
+	defer iter.Stop()
+	var rs []*FindingStatsRecord
+	err = apply(iter, func(ds *firestore.DocumentSnapshot) error {
+		var r FindingStatsRecord
+		if err := ds.DataTo(&r); err != nil {
+			return err
+		}
+		rs = append(rs, &r)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return rs, nil
+}
+
+// SetKEVRecords implements Store.SetKEVRecords.
+func (fs *FireStore) SetKEVRecords(ctx context.Context, rs []*KEVRecord) (err error) {
+	defer derrors.Wrap(&err, "FireStore.SetKEVRecords(%d records)", len(rs))
+
+	bw := fs.client.BulkWriter(ctx)
+	var jobs []*firestore.BulkWriterJob
+	for _, r := range rs {
+		j, err := bw.Set(fs.nsDoc.Collection(kevCollection).Doc(r.CVE), r)
+		if err != nil {
+			bw.End()
+			return err
+		}
+		jobs = append(jobs, j)
+	}
+	bw.End()
+	for _, j := range jobs {
+		if _, err := j.Results(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ListKEVRecords implements Store.ListKEVRecords.
+func (fs *FireStore) ListKEVRecords(ctx context.Context) (_ []*KEVRecord, err error) {
+	defer derrors.Wrap(&err, "FireStore.ListKEVRecords")
+
+	iter := fs.nsDoc.Collection(kevCollection).OrderBy("CVE", firestore.Asc).Documents(ctx)
+	defer iter.Stop()
+	var rs []*KEVRecord
+	err = apply(iter, func(ds *firestore.DocumentSnapshot) error {
+		var r KEVRecord
+		if err := ds.DataTo(&r); err != nil {
+			return err
+		}
+		rs = append(rs, &r)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return rs, nil
+}
+
+// SetEPSSRecords implements Store.SetEPSSRecords.
+func (fs *FireStore) SetEPSSRecords(ctx context.Context, rs []*EPSSRecord) (err error) {
+	defer derrors.Wrap(&err, "FireStore.SetEPSSRecords(%d records)", len(rs))
+
+	bw := fs.client.BulkWriter(ctx)
+	var jobs []*firestore.BulkWriterJob
+	for _, r := range rs {
+		j, err := bw.Set(fs.nsDoc.Collection(epssCollection).Doc(r.ReportID), r)
+		if err != nil {
+			bw.End()
+			return err
+		}
+		jobs = append(jobs, j)
+	}
+	bw.End()
+	for _, j := range jobs {
+		if _, err := j.Results(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ListEPSSRecords implements Store.ListEPSSRecords.
+func (fs *FireStore) ListEPSSRecords(ctx context.Context) (_ []*EPSSRecord, err error) {
+	defer derrors.Wrap(&err, "FireStore.ListEPSSRecords")
+
+	iter := fs.nsDoc.Collection(epssCollection).OrderBy("ReportID", firestore.Asc).Documents(ctx)
+	defer iter.Stop()
+	var rs []*EPSSRecord
+	err = apply(iter, func(ds *firestore.DocumentSnapshot) error {
+		var r EPSSRecord
+		if err := ds.DataTo(&r); err != nil {
+			return err
+		}
+		rs = append(rs, &r)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return rs, nil
+}
+
+// StartJob implements Store.StartJob.
+func (fs *FireStore) StartJob(ctx context.Context, name string, now time.Time, lockTimeout time.Duration) (_ *JobRecord, started bool, err error) {
+	defer derrors.Wrap(&err, "FireStore.StartJob(%q)", name)
+
+	var r *JobRecord
+	err = fs.updateJobRecord(ctx, name, func(jr *JobRecord) error {
+		r = jr
+		started = jr.start(now, lockTimeout)
+		return nil
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	return r, started, nil
+}
+
+// EndJob implements Store.EndJob.
+func (fs *FireStore) EndJob(ctx context.Context, name string, startedAt, now time.Time, runErr error) (_ *JobRecord, err error) {
+	defer derrors.Wrap(&err, "FireStore.EndJob(%q)", name)
+
+	var r *JobRecord
+	err = fs.updateJobRecord(ctx, name, func(jr *JobRecord) error {
+		r = jr
+		return jr.end(startedAt, now, runErr)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// updateJobRecord calls f on the JobRecord of the job with the given
+// name (a new one if there is none), and writes it back, in a
+// transaction.
+func (fs *FireStore) updateJobRecord(ctx context.Context, name string, f func(*JobRecord) error) error {
+	ref := fs.nsDoc.Collection(jobCollection).Doc(name)
+	return fs.client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		r := &JobRecord{Name: name}
+		ds, err := tx.Get(ref)
+		if err != nil && status.Code(err) != codes.NotFound {
+			return err
+		}
+		if err == nil {
+			if err := ds.DataTo(r); err != nil {
+				return err
+			}
+		}
+		if err := f(r); err != nil {
+			return err
+		}
+		return tx.Set(ref, r)
+	})
+}
+
+// ListJobRecords implements Store.ListJobRecords.
+func (fs *FireStore) ListJobRecords(ctx context.Context) (_ []*JobRecord, err error) {
+	defer derrors.Wrap(&err, "FireStore.ListJobRecords")
+
+	iter := fs.nsDoc.Collection(jobCollection).OrderBy("Name", firestore.Asc).Documents(ctx)
+	defer iter.Stop()
+	var rs []*JobRecord
+	err = apply(iter, func(ds *firestore.DocumentSnapshot) error {
+		var r JobRecord
+		if err := ds.DataTo(&r); err != nil {
+			return err
+		}
+		rs = append(rs, &r)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return rs, nil
+}
+
+// LatestIssueUpdate implements Store.LatestIssueUpdate.
+func (fs *FireStore) LatestIssueUpdate(ctx context.Context) (_ time.Time, err error) {
+	defer derrors.Wrap(&err, "FireStore.LatestIssueUpdate")
+
+	q := fs.nsDoc.Collection(issueCollection).OrderBy("UpdatedAt", firestore.Desc).Limit(1)
+	docsnaps, err := q.Documents(ctx).GetAll()
+	if err != nil {
+		return time.Time{}, err
+	}
+	if len(docsnaps) == 0 {
+		return time.Time{}, nil
+	}
+	var ir IssueRecord
+	if err := docsnaps[0].DataTo(&ir); err != nil {
+		return time.Time{}, err
+	}
+	return ir.UpdatedAt, nil
+}
+
+func (fs *FireStore) workerConfigRef() *firestore.DocumentRef {
+	return fs.nsDoc.Collection(configCollection).Doc(workerConfigID)
+}
+
+// GetWorkerConfig implements Store.GetWorkerConfig.
+func (fs *FireStore) GetWorkerConfig(ctx context.Context) (_ *WorkerConfig, err error) {
+	defer derrors.Wrap(&err, "FireStore.GetWorkerConfig")
+
+	ds, err := fs.workerConfigRef().Get(ctx)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var c WorkerConfig
+	if err := ds.DataTo(&c); err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+// SetWorkerConfig implements Store.SetWorkerConfig.
+func (fs *FireStore) SetWorkerConfig(ctx context.Context, c *WorkerConfig, source string) (changed bool, err error) {
+	defer derrors.Wrap(&err, "FireStore.SetWorkerConfig(%q)", source)
+
+	if err := c.Validate(); err != nil {
+		return false, err
+	}
+	err = fs.client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		changed = false
+		var old *WorkerConfig
+		ds, err := tx.Get(fs.workerConfigRef())
+		if err != nil && status.Code(err) != codes.NotFound {
+			return err
+		}
+		if err == nil {
+			old = new(WorkerConfig)
+			if err := ds.DataTo(old); err != nil {
+				return err
+			}
+			if old.Equal(c) {
+				return nil
+			}
+		}
+		if err := tx.Set(fs.workerConfigRef(), c); err != nil {
+			return err
+		}
+		changed = true
+		return tx.Create(fs.nsDoc.Collection(configChangeCollection).NewDoc(), &ConfigChangeRecord{
+			ChangedAt: time.Now(),
+			Source:    source,
+			Old:       old,
+			New:       c,
+		})
+	})
+	if err != nil {
+		return false, err
+	}
+	return changed, nil
+}
+
+// ListConfigChanges implements Store.ListConfigChanges.
+func (fs *FireStore) ListConfigChanges(ctx context.Context, limit int) (_ []*ConfigChangeRecord, err error) {
+	defer derrors.Wrap(&err, "FireStore.ListConfigChanges(%d)", limit)
+
+	q := fs.nsDoc.Collection(configChangeCollection).OrderBy("ChangedAt", firestore.Desc)
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+	iter := q.Documents(ctx)
+	defer iter.Stop()
+	var ccs []*ConfigChangeRecord
+	err = apply(iter, func(ds *firestore.DocumentSnapshot) error {
+		var cc ConfigChangeRecord
+		if err := ds.DataTo(&cc); err != nil {
+			return err
+		}
+		ccs = append(ccs, &cc)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ccs, nil
+}
+
+func (fs *FireStore) notificationRef() *firestore.DocumentRef {
+	return fs.nsDoc.Collection(configCollection).Doc(notificationID)
+}
+
+// GetNotificationRecord implements Store.GetNotificationRecord.
+func (fs *FireStore) GetNotificationRecord(ctx context.Context) (_ *NotificationRecord, err error) {
+	defer derrors.Wrap(&err, "FireStore.GetNotificationRecord")
+
+	ds, err := fs.notificationRef().Get(ctx)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var nr NotificationRecord
+	if err := ds.DataTo(&nr); err != nil {
+		return nil, err
+	}
+	return &nr, nil
+}
+
+// SetNotificationRecord implements Store.SetNotificationRecord.
+func (fs *FireStore) SetNotificationRecord(ctx context.Context, nr *NotificationRecord) (err error) {
+	defer derrors.Wrap(&err, "FireStore.SetNotificationRecord")
+
+	_, err = fs.notificationRef().Set(ctx, nr)
+	return err
+}
+
 // RunTransaction implements Store.RunTransaction.
 func (fs *FireStore) RunTransaction(ctx context.Context, f func(context.Context, Transaction) error) (err error) {
 	defer derrors.Wrap(&err, "FireStore.RunTransaction")
@@ -266,6 +917,9 @@ type Record interface {
 	GetID() string
 	GetSource() report.Source
 	GetUnit() string
+	// GetUnits returns all the Go modules or packages that might
+	// be affected, starting with GetUnit.
+	GetUnits() []string
 	GetDescription() string
 	GetIssueReference() string
 	GetIssueCreatedAt() time.Time
@@ -21,8 +21,20 @@ type MemStore struct {
 	mu                sync.Mutex
 	cve4Records       map[string]*CVE4Record
 	updateRecords     map[string]*CommitUpdateRecord
+	dbRegenRecords    map[string]*DBRegenRecord
 	dirHashes         map[string]string
 	legacyGHSARecords map[string]*LegacyGHSARecord
+	issueRecords      map[int]*IssueRecord
+	symbolFeedback    map[string]*SymbolFeedbackRecord
+	cvePublications   map[string]*CVEPublicationRecord
+	ghsaReviews       map[string]*GHSAReviewRecord
+	findingStats      map[string]*FindingStatsRecord
+	kevRecords        map[string]*KEVRecord
+	epssRecords       map[string]*EPSSRecord
+	jobs              map[string]*JobRecord
+	workerConfig      *WorkerConfig
+	configChanges     []*ConfigChangeRecord
+	notification      *NotificationRecord
 }
 
 // NewMemStore creates a new, empty MemStore.
@@ -36,8 +48,20 @@ func NewMemStore() *MemStore {
 func (ms *MemStore) Clear(context.Context) error {
 	ms.cve4Records = map[string]*CVE4Record{}
 	ms.updateRecords = map[string]*CommitUpdateRecord{}
+	ms.dbRegenRecords = map[string]*DBRegenRecord{}
 	ms.dirHashes = map[string]string{}
 	ms.legacyGHSARecords = map[string]*LegacyGHSARecord{}
+	ms.issueRecords = map[int]*IssueRecord{}
+	ms.symbolFeedback = map[string]*SymbolFeedbackRecord{}
+	ms.cvePublications = map[string]*CVEPublicationRecord{}
+	ms.ghsaReviews = map[string]*GHSAReviewRecord{}
+	ms.findingStats = map[string]*FindingStatsRecord{}
+	ms.kevRecords = map[string]*KEVRecord{}
+	ms.epssRecords = map[string]*EPSSRecord{}
+	ms.jobs = map[string]*JobRecord{}
+	ms.workerConfig = nil
+	ms.configChanges = nil
+	ms.notification = nil
 	return nil
 }
 
@@ -82,6 +106,41 @@ func (ms *MemStore) ListCommitUpdateRecords(_ context.Context, limit int) ([]*Co
 	return urs, nil
 }
 
+// CreateDBRegenRecord implements Store.CreateDBRegenRecord.
+func (ms *MemStore) CreateDBRegenRecord(ctx context.Context, r *DBRegenRecord) error {
+	r.ID = fmt.Sprint(rand.Uint32())
+	if ms.dbRegenRecords[r.ID] != nil {
+		panic("duplicate ID")
+	}
+	return ms.SetDBRegenRecord(ctx, r)
+}
+
+// SetDBRegenRecord implements Store.SetDBRegenRecord.
+func (ms *MemStore) SetDBRegenRecord(_ context.Context, r *DBRegenRecord) error {
+	if r.ID == "" {
+		return errors.New("SetDBRegenRecord: need ID")
+	}
+	c := *r
+	ms.dbRegenRecords[c.ID] = &c
+	return nil
+}
+
+// ListDBRegenRecords implements Store.ListDBRegenRecords.
+func (ms *MemStore) ListDBRegenRecords(_ context.Context, limit int) ([]*DBRegenRecord, error) {
+	var rs []*DBRegenRecord
+	for _, r := range ms.dbRegenRecords {
+		c := *r
+		rs = append(rs, &c)
+	}
+	sort.Slice(rs, func(i, j int) bool {
+		return rs[i].StartedAt.After(rs[j].StartedAt)
+	})
+	if limit > 0 && len(rs) > limit {
+		rs = rs[:limit]
+	}
+	return rs, nil
+}
+
 // GetRecord implements store.GetCVE4Record.
 func (ms *MemStore) GetRecord(_ context.Context, id string) (Record, error) {
 	switch {
@@ -118,6 +177,286 @@ func (ms *MemStore) SetDirectoryHash(_ context.Context, dir, hash string) error
 	return nil
 }
 
+// SetIssueRecords implements Store.SetIssueRecords.
+func (ms *MemStore) SetIssueRecords(_ context.Context, rs []*IssueRecord) error {
+	for _, r := range rs {
+		c := *r
+		c.SyncedAt = time.Now()
+		ms.issueRecords[c.Number] = &c
+	}
+	return nil
+}
+
+// GetIssueRecord implements Store.GetIssueRecord.
+func (ms *MemStore) GetIssueRecord(_ context.Context, number int) (*IssueRecord, error) {
+	return ms.issueRecords[number], nil
+}
+
+// ListIssueRecords implements Store.ListIssueRecords.
+func (ms *MemStore) ListIssueRecords(_ context.Context, state string) ([]*IssueRecord, error) {
+	var irs []*IssueRecord
+	for _, r := range ms.issueRecords {
+		if state == "" || r.State == state {
+			irs = append(irs, r)
+		}
+	}
+	sort.Slice(irs, func(i, j int) bool {
+		return irs[i].Number < irs[j].Number
+	})
+	return irs, nil
+}
+
+// LatestIssueUpdate implements Store.LatestIssueUpdate.
+func (ms *MemStore) LatestIssueUpdate(context.Context) (time.Time, error) {
+	var latest time.Time
+	for _, r := range ms.issueRecords {
+		if r.UpdatedAt.After(latest) {
+			latest = r.UpdatedAt
+		}
+	}
+	return latest, nil
+}
+
+// SetSymbolFeedbackRecords implements Store.SetSymbolFeedbackRecords.
+func (ms *MemStore) SetSymbolFeedbackRecords(_ context.Context, rs []*SymbolFeedbackRecord) error {
+	for _, r := range rs {
+		c := *r
+		ms.symbolFeedback[c.Key()] = &c
+	}
+	return nil
+}
+
+// ListSymbolFeedbackRecords implements Store.ListSymbolFeedbackRecords.
+func (ms *MemStore) ListSymbolFeedbackRecords(_ context.Context, status SymbolFeedbackStatus) ([]*SymbolFeedbackRecord, error) {
+	var rs []*SymbolFeedbackRecord
+	for _, r := range ms.symbolFeedback {
+		if status == "" || r.Status == status {
+			c := *r
+			rs = append(rs, &c)
+		}
+	}
+	sort.Slice(rs, func(i, j int) bool {
+		return rs[i].Key() < rs[j].Key()
+	})
+	return rs, nil
+}
+
+// SetCVEPublicationRecords implements Store.SetCVEPublicationRecords.
+func (ms *MemStore) SetCVEPublicationRecords(_ context.Context, rs []*CVEPublicationRecord) error {
+	for _, r := range rs {
+		c := *r
+		ms.cvePublications[c.CVE] = &c
+	}
+	return nil
+}
+
+// ListCVEPublicationRecords implements Store.ListCVEPublicationRecords.
+func (ms *MemStore) ListCVEPublicationRecords(context.Context) ([]*CVEPublicationRecord, error) {
+	var rs []*CVEPublicationRecord
+	for _, r := range ms.cvePublications {
+		c := *r
+		rs = append(rs, &c)
+	}
+	sort.Slice(rs, func(i, j int) bool {
+		return rs[i].CVE < rs[j].CVE
+	})
+	return rs, nil
+}
+
+// SetGHSAReviewRecords implements Store.SetGHSAReviewRecords.
+func (ms *MemStore) SetGHSAReviewRecords(_ context.Context, rs []*GHSAReviewRecord) error {
+	for _, r := range rs {
+		c := *r
+		ms.ghsaReviews[c.Key()] = &c
+	}
+	return nil
+}
+
+// ListGHSAReviewRecords implements Store.ListGHSAReviewRecords.
+func (ms *MemStore) ListGHSAReviewRecords(context.Context) ([]*GHSAReviewRecord, error) {
+	var rs []*GHSAReviewRecord
+	for _, r := range ms.ghsaReviews {
+		c := *r
+		rs = append(rs, &c)
+	}
+	sort.Slice(rs, func(i, j int) bool {
+		return rs[i].Key() < rs[j].Key()
+	})
+	return rs, nil
+}
+
+// SetFindingStatsRecords implements Store.SetFindingStatsRecords.
+func (ms *MemStore) SetFindingStatsRecords(_ context.Context, rs []*FindingStatsRecord) error {
+	for _, r := range rs {
+		c := *r
+		ms.findingStats[c.ReportID] = &c
+	}
+	return nil
+}
+
+// ListFindingStatsRecords implements Store.ListFindingStatsRecords.
+func (ms *MemStore) ListFindingStatsRecords(context.Context) ([]*FindingStatsRecord, error) {
+	var rs []*FindingStatsRecord
+	for _, r := range ms.findingStats {
+		c := *r
+		rs = append(rs, &c)
+	}
+	sort.Slice(rs, func(i, j int) bool {
+		return rs[i].ReportID < rs[j].ReportID
+	})
+	return rs, nil
+}
+
+// SetKEVRecords implements Store.SetKEVRecords.
+func (ms *MemStore) SetKEVRecords(_ context.Context, rs []*KEVRecord) error {
+	for _, r := range rs {
+		c := *r
+		ms.kevRecords[c.CVE] = &c
+	}
+	return nil
+}
+
+// ListKEVRecords implements Store.ListKEVRecords.
+func (ms *MemStore) ListKEVRecords(context.Context) ([]*KEVRecord, error) {
+	var rs []*KEVRecord
+	for _, r := range ms.kevRecords {
+		c := *r
+		rs = append(rs, &c)
+	}
+	sort.Slice(rs, func(i, j int) bool {
+		return rs[i].CVE < rs[j].CVE
+	})
+	return rs, nil
+}
+
+// SetEPSSRecords implements Store.SetEPSSRecords.
+func (ms *MemStore) SetEPSSRecords(_ context.Context, rs []*EPSSRecord) error {
+	for _, r := range rs {
+		c := *r
+		ms.epssRecords[c.ReportID] = &c
+	}
+	return nil
+}
+
+// ListEPSSRecords implements Store.ListEPSSRecords.
+func (ms *MemStore) ListEPSSRecords(context.Context) ([]*EPSSRecord, error) {
+	var rs []*EPSSRecord
+	for _, r := range ms.epssRecords {
+		c := *r
+		rs = append(rs, &c)
+	}
+	sort.Slice(rs, func(i, j int) bool {
+		return rs[i].ReportID < rs[j].ReportID
+	})
+	return rs, nil
+}
+
+// StartJob implements Store.StartJob.
+func (ms *MemStore) StartJob(_ context.Context, name string, now time.Time, lockTimeout time.Duration) (*JobRecord, bool, error) {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	r, ok := ms.jobs[name]
+	if !ok {
+		r = &JobRecord{Name: name}
+		ms.jobs[name] = r
+	}
+	started := r.start(now, lockTimeout)
+	c := *r
+	return &c, started, nil
+}
+
+// EndJob implements Store.EndJob.
+func (ms *MemStore) EndJob(_ context.Context, name string, startedAt, now time.Time, runErr error) (*JobRecord, error) {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	r, ok := ms.jobs[name]
+	if !ok {
+		return nil, fmt.Errorf("job %s never started", name)
+	}
+	if err := r.end(startedAt, now, runErr); err != nil {
+		return nil, err
+	}
+	c := *r
+	return &c, nil
+}
+
+// ListJobRecords implements Store.ListJobRecords.
+func (ms *MemStore) ListJobRecords(context.Context) ([]*JobRecord, error) {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	var rs []*JobRecord
+	for _, r := range ms.jobs {
+		c := *r
+		rs = append(rs, &c)
+	}
+	sort.Slice(rs, func(i, j int) bool {
+		return rs[i].Name < rs[j].Name
+	})
+	return rs, nil
+}
+
+// GetWorkerConfig implements Store.GetWorkerConfig.
+func (ms *MemStore) GetWorkerConfig(context.Context) (*WorkerConfig, error) {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	return ms.workerConfig, nil
+}
+
+// SetWorkerConfig implements Store.SetWorkerConfig.
+func (ms *MemStore) SetWorkerConfig(_ context.Context, c *WorkerConfig, source string) (bool, error) {
+	if err := c.Validate(); err != nil {
+		return false, err
+	}
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	if ms.workerConfig != nil && ms.workerConfig.Equal(c) {
+		return false, nil
+	}
+	nc := *c
+	ms.configChanges = append(ms.configChanges, &ConfigChangeRecord{
+		ChangedAt: time.Now(),
+		Source:    source,
+		Old:       ms.workerConfig,
+		New:       &nc,
+	})
+	ms.workerConfig = &nc
+	return true, nil
+}
+
+// ListConfigChanges implements Store.ListConfigChanges.
+func (ms *MemStore) ListConfigChanges(_ context.Context, limit int) ([]*ConfigChangeRecord, error) {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	var ccs []*ConfigChangeRecord
+	for i := len(ms.configChanges) - 1; i >= 0; i-- {
+		if limit > 0 && len(ccs) >= limit {
+			break
+		}
+		ccs = append(ccs, ms.configChanges[i])
+	}
+	return ccs, nil
+}
+
+// GetNotificationRecord implements Store.GetNotificationRecord.
+func (ms *MemStore) GetNotificationRecord(context.Context) (*NotificationRecord, error) {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	if ms.notification == nil {
+		return nil, nil
+	}
+	nr := *ms.notification
+	return &nr, nil
+}
+
+// SetNotificationRecord implements Store.SetNotificationRecord.
+func (ms *MemStore) SetNotificationRecord(_ context.Context, nr *NotificationRecord) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	c := *nr
+	ms.notification = &c
+	return nil
+}
+
 // RunTransaction implements Store.RunTransaction.
 // A transaction runs with a single lock on the entire DB.
 func (ms *MemStore) RunTransaction(ctx context.Context, f func(context.Context, Transaction) error) error {
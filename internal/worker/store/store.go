@@ -9,12 +9,20 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
+	"net/url"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/go-git/go-git/v5/plumbing/object"
 	"golang.org/x/vulndb/internal/cve4"
+	"golang.org/x/vulndb/internal/cve5"
+	"golang.org/x/vulndb/internal/database"
 	"golang.org/x/vulndb/internal/ghsa"
+	"golang.org/x/vulndb/internal/issues"
 	"golang.org/x/vulndb/internal/report"
+	"golang.org/x/vulndb/internal/triage"
 )
 
 // A CVE4Record contains information about a v4 CVE.
@@ -43,13 +51,35 @@ type CVE4Record struct {
 	// Package is the Go package path that might be affected.
 	Package string
 
-	// CVE is a copy of the CVE, for the NeedsIssue triage state.
+	// CVE is a copy of the CVE, for the NeedsIssue and
+	// UpdatedSinceIssueCreation triage states.
 	CVE *cve4.CVE
 
+	// CVE5 is a copy of the CVE's 5.0 record, for the NeedsIssue triage
+	// state, if the CVE was re-filed by re-triage (which reads 5.0
+	// records) rather than by an update from the cvelist repo.
+	CVE5 *cve5.CVERecord
+
+	// Aliases are other IDs of the CVE's vulnerability, found by
+	// a scan of other OSV databases (see worker.ScanOSV), for the
+	// NeedsIssue triage state. They are added to the issue's report.
+	Aliases []string
+
 	// ReferenceURLs is a list of the URLs in the CVE references,
 	// for the FalsePositive triage state.
 	ReferenceURLs []string
 
+	// TriageInputs are the inputs to the most recent automated
+	// decision that the CVE does not affect Go, so that the
+	// decision can be replayed (see triage.Replay).
+	// Set only when that decision was made by triage.
+	TriageInputs *triage.Inputs
+
+	// RetriagedAt is the time the CVE was last re-triaged (see
+	// worker.RetriageDismissedCVEs). If zero, the CVE was last
+	// triaged when the repo was updated to CommitTime.
+	RetriagedAt time.Time
+
 	// IssueReference is a reference to the GitHub issue that was filed.
 	// E.g. golang/vulndb#12345.
 	// Set only after a GitHub issue has been successfully created.
@@ -59,20 +89,37 @@ type CVE4Record struct {
 	// Set only after a GitHub issue has been successfully created.
 	IssueCreatedAt time.Time
 
+	// Provenance describes the copies of the CVE's record in each
+	// source that has one (see worker.UpdateProvenance), one entry
+	// per source.
+	Provenance []*CVEProvenance
+
 	// History holds previous states of a CVE4Record,
 	// from most to least recent.
 	History []*CVE4RecordSnapshot
 }
 
-func (r *CVE4Record) GetID() string   { return r.ID }
-func (r *CVE4Record) GetUnit() string { return r.Module }
+func (r *CVE4Record) GetID() string      { return r.ID }
+func (r *CVE4Record) GetUnit() string    { return r.Module }
+func (r *CVE4Record) GetUnits() []string { return []string{r.Module} }
 func (r *CVE4Record) GetDescription() string {
+	if r.CVE5 != nil {
+		if ds := r.CVE5.Containers.CNAContainer.Descriptions; len(ds) > 0 {
+			return ds[0].Value
+		}
+		return ""
+	}
 	if r.CVE == nil || len(r.CVE.Description.Data) == 0 {
 		return ""
 	}
 	return r.CVE.Description.Data[0].Value
 }
-func (r *CVE4Record) GetSource() report.Source     { return r.CVE }
+func (r *CVE4Record) GetSource() report.Source {
+	if r.CVE5 != nil {
+		return r.CVE5
+	}
+	return r.CVE
+}
 func (r *CVE4Record) GetIssueReference() string    { return r.IssueReference }
 func (r *CVE4Record) GetIssueCreatedAt() time.Time { return r.IssueCreatedAt }
 func (r *CVE4Record) GetTriageState() TriageState  { return r.TriageState }
@@ -161,6 +208,50 @@ func (r *CVE4Record) Snapshot() *CVE4RecordSnapshot {
 	}
 }
 
+// A CVEProvenance describes the copy of a CVE record in one source,
+// such as the cvelist repo, the NVD or the feed of a CNA.
+type CVEProvenance struct {
+	// Source is the name of the source (see cvelistrepo.Source).
+	Source string
+	// Updated is when the source last updated its copy.
+	// If zero, the source does not say.
+	Updated time.Time
+	// Version identifies the copy, for change detection.
+	Version string
+	// URL is where the copy can be viewed, if anywhere.
+	URL string
+	// FetchedAt is when the copy was last fetched.
+	FetchedAt time.Time
+}
+
+// SetProvenance records p as the provenance of r from p.Source,
+// replacing any previous provenance from that source. It reports
+// whether the copy differs from the one previously recorded.
+func (r *CVE4Record) SetProvenance(p *CVEProvenance) (changed bool) {
+	for i, q := range r.Provenance {
+		if q.Source == p.Source {
+			changed = q.Version != p.Version || !q.Updated.Equal(p.Updated) || q.URL != p.URL
+			r.Provenance[i] = p
+			return changed
+		}
+	}
+	r.Provenance = append(r.Provenance, p)
+	return true
+}
+
+// FreshestProvenance returns the provenance of the most recently
+// updated copy of r, or nil if r has no provenance. Ties go to the
+// source recorded first.
+func (r *CVE4Record) FreshestProvenance() *CVEProvenance {
+	var freshest *CVEProvenance
+	for _, p := range r.Provenance {
+		if freshest == nil || p.Updated.After(freshest.Updated) {
+			freshest = p
+		}
+	}
+	return freshest
+}
+
 // A CommitUpdateRecord describes a single update operation, which reconciles
 // a commit in the CVE list repo with the DB state.
 type CommitUpdateRecord struct {
@@ -188,6 +279,53 @@ type CommitUpdateRecord struct {
 	UpdatedAt time.Time `firestore:",serverTimestamp"`
 }
 
+// A DBRegenRecord describes a single regeneration of the vulnerability
+// database from a commit of the vulndb repo, from generation through
+// validation against the deployed database to publication.
+type DBRegenRecord struct {
+	// The ID of this record in the DB. Needed to modify the record.
+	ID string
+	// When the regeneration started and completed. If EndedAt is zero,
+	// the regeneration is in progress (or it crashed).
+	StartedAt, EndedAt time.Time
+	// The repo commit hash that the database is generated from.
+	CommitHash string
+	// The time the commit occurred.
+	CommitTime time.Time
+	// The stage the regeneration is at, or stopped at, if it failed.
+	Stage DBRegenStage
+	// The number of entries in the generated database.
+	NumEntries int
+	// Published is true if the database was published.
+	Published bool
+	// ManifestDigest is the digest of the manifest of the generated
+	// database (see database.Manifest).
+	ManifestDigest string
+	// Manifest lists the uncompressed files of the generated database.
+	// (The compressed files are left out, to keep the record small.)
+	Manifest []*database.ManifestFile
+	// Anomalies lists the anomalies of the generated database (see
+	// database.FindAnomalies). Unless they were allowed, the
+	// database was not published.
+	Anomalies []string
+	// The error that stopped the regeneration.
+	Error string
+}
+
+// DBRegenStage is a stage of a database regeneration.
+type DBRegenStage string
+
+const (
+	// Generating the database from the reports.
+	DBRegenGenerate DBRegenStage = "GENERATE"
+	// Validating the generated database against the deployed one.
+	DBRegenValidate DBRegenStage = "VALIDATE"
+	// Publishing the generated database.
+	DBRegenPublish DBRegenStage = "PUBLISH"
+	// The regeneration is done.
+	DBRegenDone DBRegenStage = "DONE"
+)
+
 // A LegacyGHSARecord holds information about a GitHub security advisory.
 type LegacyGHSARecord struct {
 	// GHSA is the advisory.
@@ -214,6 +352,485 @@ func (r *LegacyGHSARecord) GetIssueCreatedAt() time.Time { return r.IssueCreated
 func (r *LegacyGHSARecord) GetTriageState() TriageState  { return r.TriageState }
 func (r *LegacyGHSARecord) Validate() error              { return nil }
 
+func (r *LegacyGHSARecord) GetUnits() []string {
+	var units []string
+	for _, v := range r.GHSA.Vulns {
+		if !slices.Contains(units, v.Package) {
+			units = append(units, v.Package)
+		}
+	}
+	return units
+}
+
+// An IssueRecord is a copy of the metadata of an issue in the
+// issue tracker, mirrored so that tools can read it without calling
+// the tracker's API.
+type IssueRecord struct {
+	// Number is the issue number.
+	Number int
+	Title  string
+	Body   string
+	// State is the state of the issue in the tracker, "open" or "closed".
+	State    string
+	Assignee string
+	Labels   []string
+	// CreatedAt and UpdatedAt are the issue's times according to the tracker.
+	CreatedAt, UpdatedAt time.Time
+	// SyncedAt is the last time this record was written by a sync.
+	SyncedAt time.Time
+}
+
+// NewIssueRecord returns an IssueRecord that mirrors iss.
+func NewIssueRecord(iss *issues.Issue) *IssueRecord {
+	return &IssueRecord{
+		Number:    iss.Number,
+		Title:     iss.Title,
+		Body:      iss.Body,
+		State:     iss.State,
+		Assignee:  iss.Assignee,
+		Labels:    iss.Labels,
+		CreatedAt: iss.CreatedAt,
+		UpdatedAt: iss.UpdatedAt,
+	}
+}
+
+// Issue returns the issue mirrored by r.
+func (r *IssueRecord) Issue() *issues.Issue {
+	return &issues.Issue{
+		Number:    r.Number,
+		Title:     r.Title,
+		Body:      r.Body,
+		State:     r.State,
+		Assignee:  r.Assignee,
+		Labels:    r.Labels,
+		CreatedAt: r.CreatedAt,
+		UpdatedAt: r.UpdatedAt,
+	}
+}
+
+// A SymbolFeedbackRecord holds feedback, given in issues in the issue
+// tracker, that a symbol listed in a report is not actually vulnerable.
+// There is one record for each report, package and symbol.
+type SymbolFeedbackRecord struct {
+	// ReportID is the ID of the report, e.g. "GO-2024-0001".
+	ReportID string
+	// Package is the import path of the package containing the symbol.
+	Package string
+	// Symbol is the disputed symbol, as listed in the report.
+	Symbol string
+	// Issues are the numbers of the issues that gave the feedback,
+	// in the order they were received.
+	Issues []int
+	// Status is whether the feedback is still waiting for review.
+	Status SymbolFeedbackStatus
+	// Resolution explains how the feedback was resolved.
+	// Set only if Status is SymbolFeedbackResolved.
+	Resolution string
+	// CreatedAt is the time of the first feedback, and UpdatedAt
+	// the last time the record was changed.
+	CreatedAt, UpdatedAt time.Time
+}
+
+// Key returns the key of r, which uniquely identifies
+// the report, package and symbol.
+func (r *SymbolFeedbackRecord) Key() string {
+	return r.ReportID + " " + r.Package + " " + r.Symbol
+}
+
+// SymbolFeedbackStatus is the status of a SymbolFeedbackRecord.
+type SymbolFeedbackStatus string
+
+const (
+	// The feedback has not been resolved by a reviewer.
+	SymbolFeedbackOpen SymbolFeedbackStatus = "OPEN"
+	// The feedback was resolved, by removing the symbol from the report
+	// or by closing the feedback issues.
+	SymbolFeedbackResolved SymbolFeedbackStatus = "RESOLVED"
+)
+
+// A CVEPublicationRecord holds the state of the publication, with CVE
+// Services, of the CVE record of a report with cve_metadata (that is,
+// of a CVE assigned by the Go CNA). There is one record for each CVE.
+type CVEPublicationRecord struct {
+	// CVE is the CVE ID, e.g. "CVE-2024-0001".
+	CVE string
+	// ReportID is the ID of the report, e.g. "GO-2024-0001".
+	ReportID string
+	// Withdrawn is true if the report is withdrawn,
+	// in which case the CVE should be rejected.
+	Withdrawn bool
+	// State is the state of the CVE's record in CVE Services.
+	State CVEPublicationState
+	// DraftParts are the parts of the record generated from the
+	// report that differ from the published record.
+	// Set only if State is CVEPublicationDraft.
+	DraftParts []string
+	// Owner is the UUID of the CNA that owns the CVE, if it is not
+	// the Go CNA. The record must not be published by the Go CNA.
+	Owner string
+	// RecordUpdated is when CVE Services last updated the record.
+	// Zero if the CVE has no record.
+	RecordUpdated time.Time
+	// StateChangedAt is when the sync first saw the current State.
+	StateChangedAt time.Time
+	// SyncedAt is the last time the record was synced
+	// with CVE Services.
+	SyncedAt time.Time
+}
+
+// NeedsAttention reports whether the CVE's record is not in the state
+// its report calls for: the record of a report that is not withdrawn
+// should be published and match the report, and the CVE of a withdrawn
+// report should be rejected. It also reports CVEs owned by other CNAs.
+func (r *CVEPublicationRecord) NeedsAttention() bool {
+	if r.Owner != "" {
+		return true
+	}
+	if r.Withdrawn {
+		return r.State != CVEPublicationRejected
+	}
+	return r.State != CVEPublicationPublished
+}
+
+// CVEPublicationState is the state of a CVEPublicationRecord.
+type CVEPublicationState string
+
+const (
+	// The CVE is reserved, but its record has not been published.
+	CVEPublicationReserved CVEPublicationState = "RESERVED"
+	// The CVE's record is published, but differs from the record
+	// generated from the report, because a change to the report was
+	// not published (or the record was edited out of band).
+	CVEPublicationDraft CVEPublicationState = "DRAFT"
+	// The CVE's record is published and matches the report.
+	CVEPublicationPublished CVEPublicationState = "PUBLISHED"
+	// The CVE is rejected.
+	CVEPublicationRejected CVEPublicationState = "REJECTED"
+)
+
+// A GHSAReviewRecord holds the result of comparing a REVIEWED report
+// with one of its GHSAs, to find GHSAs that say more about the
+// vulnerability than the report, for example because GitHub added
+// affected versions after the report was reviewed. There is one record
+// for each report and GHSA.
+type GHSAReviewRecord struct {
+	// ReportID is the ID of the report, e.g. "GO-2024-0001".
+	ReportID string
+	// GHSA is the ID of the GHSA, e.g. "GHSA-xxxx-yyyy-zzzz".
+	GHSA string
+	// GHSAUpdated is when GitHub last updated the GHSA.
+	GHSAUpdated time.Time
+	// AddedVersions describe the vulnerable version ranges of the
+	// GHSA that include versions the report does not consider
+	// affected (see ghsa.Divergence).
+	AddedVersions []string
+	// AddedReferences are the URLs the GHSA links to
+	// that are not in the report.
+	AddedReferences []string
+	// IssueReference is a reference to the issue filed for the report
+	// to be re-reviewed, if any, e.g.
+	// "https://github.com/golang/vulndb/issues/1234".
+	IssueReference string
+	// DivergedAt is when the sync first saw the GHSA diverge from the
+	// report. Zero if it does not diverge.
+	DivergedAt time.Time
+	// SyncedAt is the last time the record was synced with GitHub.
+	SyncedAt time.Time
+}
+
+// Key returns the key of r, which uniquely identifies
+// the report and GHSA.
+func (r *GHSAReviewRecord) Key() string {
+	return r.ReportID + " " + r.GHSA
+}
+
+// Diverges reports whether the GHSA says anything
+// about the vulnerability that the report does not.
+func (r *GHSAReviewRecord) Diverges() bool {
+	return len(r.AddedVersions) > 0 || len(r.AddedReferences) > 0
+}
+
+// A FindingStatsRecord holds how often govulncheck reported findings
+// for a report, according to the last imported aggregate of govulncheck
+// telemetry, and whether that makes the report worth re-reviewing.
+// There is one record for each report.
+type FindingStatsRecord struct {
+	// ReportID is the ID of the report, e.g. "GO-2024-0001".
+	ReportID string
+	// PeriodStart and PeriodEnd are the bounds of the period
+	// the imported statistics cover.
+	PeriodStart, PeriodEnd time.Time
+	// ModuleFindings, PackageFindings and SymbolFindings are the
+	// number of govulncheck runs during the period that found the
+	// report's vulnerable module versions in the build list, its
+	// vulnerable packages imported, and its vulnerable symbols called.
+	ModuleFindings, PackageFindings, SymbolFindings int
+	// Anomaly is why the report should be re-reviewed, if it should.
+	Anomaly FindingAnomaly
+	// IssueReference is a reference to the issue filed for the report
+	// to be re-reviewed, if any.
+	IssueReference string
+	// FlaggedAt is when an import first saw the anomaly.
+	// Zero if there is none.
+	FlaggedAt time.Time
+	// ImportedAt is the last time the record was imported.
+	ImportedAt time.Time
+}
+
+// A FindingAnomaly is a reason that the findings
+// for a report suggest it is wrong.
+type FindingAnomaly string
+
+const (
+	// FindingAnomalyNone means that the findings look normal.
+	FindingAnomalyNone FindingAnomaly = ""
+	// FindingAnomalyOverMatched means that the module versions of the
+	// report were found much more often than those of other reports,
+	// which suggests its version ranges are too broad.
+	FindingAnomalyOverMatched FindingAnomaly = "OVER_MATCHED"
+	// FindingAnomalyNeverMatched means that the module versions of
+	// the report were never found, which suggests its module path or
+	// version ranges are wrong.
+	FindingAnomalyNeverMatched FindingAnomaly = "NEVER_MATCHED"
+	// FindingAnomalyNeverCalled means that the packages of the report
+	// were often imported, but its symbols were never called, which
+	// suggests its symbols are wrong.
+	FindingAnomalyNeverCalled FindingAnomaly = "NEVER_CALLED"
+)
+
+// A KEVRecord holds what a CVE in CISA's catalog of Known Exploited
+// Vulnerabilities (KEV) matches in vulndb: the reports and the open
+// triage issues with the CVE as an alias. There is one record for each
+// CVE in the catalog that matches anything.
+type KEVRecord struct {
+	// CVE is the ID of the CVE, e.g. "CVE-2024-1234".
+	CVE string
+	// DateAdded is the date (YYYY-MM-DD) CISA added
+	// the CVE to the catalog.
+	DateAdded string
+	// VulnerabilityName is the catalog's name for the vulnerability.
+	VulnerabilityName string
+	// ReportIDs are the IDs of the reports with the CVE as an alias.
+	ReportIDs []string
+	// Issues are the numbers of the open issues in the tracker
+	// with the CVE as an alias.
+	Issues []int
+	// IssueReference is a reference to the issue filed for an
+	// UNREVIEWED report of the CVE to be reviewed, if any.
+	IssueReference string
+	// FirstSeen is when the sync first saw the CVE
+	// match anything.
+	FirstSeen time.Time
+	// SyncedAt is the last time the record was synced with the catalog.
+	SyncedAt time.Time
+}
+
+// An EPSSRecord holds the current EPSS score of the CVEs of a report,
+// and whether the score recorded in the report is out of date. There is
+// one record for each report with a CVE that EPSS has scored.
+type EPSSRecord struct {
+	// ReportID is the ID of the report, e.g. "GO-2024-0001".
+	ReportID string
+	// CVE is the report's CVE with the highest score.
+	CVE string
+	// Score is the CVE's current EPSS score, from 0 to 1.
+	Score float64
+	// Percentile is the CVE's current EPSS percentile, from 0 to 1.
+	Percentile float64
+	// Date is the date (YYYY-MM-DD) EPSS computed the score.
+	Date string
+	// Stale is whether the report has no EPSS score, or one that differs
+	// significantly from the current one, so that it should be updated
+	// with vulnreport enrich.
+	Stale bool
+	// SyncedAt is the last time the record was synced with EPSS.
+	SyncedAt time.Time
+}
+
+// A JobRecord holds the state of a worker job: whether a run of it is
+// in progress, which is a lock that keeps other runs from starting, and
+// how its last run went. There is one record for each job.
+type JobRecord struct {
+	// Name is the name of the job, e.g. "scan-nvd".
+	Name string
+	// StartedAt is when the last run started.
+	StartedAt time.Time
+	// EndedAt is when the last run ended.
+	// Zero if it has not ended.
+	EndedAt time.Time
+	// LockedUntil is when the lock of the last run expires, so that
+	// a run that died without ending doesn't block the job forever.
+	LockedUntil time.Time
+	// Error is the error of the last run that ended, if it failed.
+	Error string
+	// LastSucceededAt is when the last successful run ended.
+	LastSucceededAt time.Time
+	// ConsecutiveFailures is the number of runs that failed
+	// since the last successful one.
+	ConsecutiveFailures int
+}
+
+// Running reports whether a run of the job is in progress at now.
+func (r *JobRecord) Running(now time.Time) bool {
+	return !r.StartedAt.IsZero() && r.EndedAt.IsZero() && now.Before(r.LockedUntil)
+}
+
+// start starts a run of the job at now, locked for lockTimeout,
+// and reports whether it did. It does not start a run if
+// one is in progress.
+func (r *JobRecord) start(now time.Time, lockTimeout time.Duration) bool {
+	if r.Running(now) {
+		return false
+	}
+	// Firestore keeps times to the microsecond. Truncate so that
+	// StartedAt identifies the run after a round trip.
+	now = now.Truncate(time.Microsecond)
+	r.StartedAt = now
+	r.EndedAt = time.Time{}
+	r.LockedUntil = now.Add(lockTimeout)
+	return true
+}
+
+// end ends the run of the job that started at startedAt at now,
+// with the error of the run.
+func (r *JobRecord) end(startedAt, now time.Time, runErr error) error {
+	if !r.StartedAt.Equal(startedAt) || !r.EndedAt.IsZero() {
+		return fmt.Errorf("job %s: run started at %s is not in progress (its lock may have expired)", r.Name, startedAt)
+	}
+	r.EndedAt = now
+	r.LockedUntil = time.Time{}
+	if runErr != nil {
+		r.Error = runErr.Error()
+		r.ConsecutiveFailures++
+	} else {
+		r.Error = ""
+		r.LastSucceededAt = now
+		r.ConsecutiveFailures = 0
+	}
+	return nil
+}
+
+// A WorkerConfig holds the worker settings that can be changed
+// while the worker is running, without a redeploy.
+//
+// The zero value of each field means to use the worker's default.
+type WorkerConfig struct {
+	// IssueLimit is the maximum number of issues to create in one run
+	// of issue creation, unless the run asks for a different limit.
+	IssueLimit int `json:"issue_limit,omitempty"`
+	// NVDScanWindow is how far back an NVD scan looks for modified
+	// CVEs, as a Go duration (for example, "168h").
+	NVDScanWindow string `json:"nvd_scan_window,omitempty"`
+	// MinUpdateInterval is the minimum time between the starts of two
+	// unforced updates, as a Go duration. Updates requested sooner
+	// are skipped.
+	MinUpdateInterval string `json:"min_update_interval,omitempty"`
+	// DeniedModules are module paths for whose CVEs and GHSAs
+	// no issues are created. An entry also denies the modules
+	// below it (e.g. "example.com/a" denies "example.com/a/b").
+	DeniedModules []string `json:"denied_modules,omitempty"`
+	// NotificationTargets are the destinations of the
+	// worker's notifications.
+	NotificationTargets []string `json:"notification_targets,omitempty"`
+	// CNAFeeds are the feeds of individual CNAs from which the worker
+	// fetches copies of CVE records, by name. Each value is a URL
+	// template in which "{id}" is replaced by the CVE ID.
+	CNAFeeds map[string]string `json:"cna_feeds,omitempty"`
+}
+
+// Validate reports whether c is a valid WorkerConfig.
+func (c *WorkerConfig) Validate() error {
+	if c.IssueLimit < 0 {
+		return fmt.Errorf("negative issue_limit %d", c.IssueLimit)
+	}
+	for name, d := range map[string]string{
+		"nvd_scan_window":     c.NVDScanWindow,
+		"min_update_interval": c.MinUpdateInterval,
+	} {
+		if d == "" {
+			continue
+		}
+		if v, err := time.ParseDuration(d); err != nil || v < 0 {
+			return fmt.Errorf("invalid %s %q: want a non-negative duration like \"24h\"", name, d)
+		}
+	}
+	for _, t := range c.NotificationTargets {
+		if err := ValidateNotificationTarget(t); err != nil {
+			return fmt.Errorf("invalid notification_targets entry %q: %v", t, err)
+		}
+	}
+	for name, tmpl := range c.CNAFeeds {
+		u, err := url.Parse(tmpl)
+		if err != nil || (u.Scheme != "https" && u.Scheme != "http") || !strings.Contains(tmpl, "{id}") {
+			return fmt.Errorf("invalid cna_feeds[%q] %q: want an http(s) URL containing \"{id}\"", name, tmpl)
+		}
+	}
+	return nil
+}
+
+// PubSubTargetPrefix is the prefix of notification targets that are
+// Pub/Sub topics, as in "pubsub:projects/PROJECT/topics/TOPIC".
+// Other notification targets are the URLs of webhooks.
+const PubSubTargetPrefix = "pubsub:"
+
+// ValidateNotificationTarget reports whether t is a valid
+// notification target: a Pub/Sub topic or an http(s) URL.
+func ValidateNotificationTarget(t string) error {
+	if topic, ok := strings.CutPrefix(t, PubSubTargetPrefix); ok {
+		parts := strings.Split(topic, "/")
+		if len(parts) != 4 || parts[0] != "projects" || parts[1] == "" || parts[2] != "topics" || parts[3] == "" {
+			return errors.New("want pubsub:projects/PROJECT/topics/TOPIC")
+		}
+		return nil
+	}
+	u, err := url.Parse(t)
+	if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+		return errors.New("want an http(s) URL or pubsub:projects/PROJECT/topics/TOPIC")
+	}
+	return nil
+}
+
+// Equal reports whether c and d hold the same settings.
+// Nil is equal to the zero WorkerConfig.
+func (c *WorkerConfig) Equal(d *WorkerConfig) bool {
+	if c == nil {
+		c = &WorkerConfig{}
+	}
+	if d == nil {
+		d = &WorkerConfig{}
+	}
+	return c.IssueLimit == d.IssueLimit &&
+		c.NVDScanWindow == d.NVDScanWindow &&
+		c.MinUpdateInterval == d.MinUpdateInterval &&
+		slices.Equal(c.DeniedModules, d.DeniedModules) &&
+		slices.Equal(c.NotificationTargets, d.NotificationTargets) &&
+		maps.Equal(c.CNAFeeds, d.CNAFeeds)
+}
+
+// A ConfigChangeRecord is an audit entry for a change
+// to the WorkerConfig.
+type ConfigChangeRecord struct {
+	// ChangedAt is the time of the change.
+	ChangedAt time.Time
+	// Source describes where the change came from, such as
+	// a config file or the user who ran a command.
+	Source string
+	// Old and New are the configurations before and after the change.
+	// Old is nil if there was no configuration before.
+	Old, New *WorkerConfig
+}
+
+// A NotificationRecord holds the state of the worker's notifications
+// about the OSV entries of the Go vulnerability database.
+type NotificationRecord struct {
+	// LatestModified is the latest modified time of the OSV entries
+	// that notifications have been sent for. Entries modified later
+	// are new to the notification targets.
+	LatestModified time.Time
+	// NotifiedAt is the time notifications were last sent.
+	NotifiedAt time.Time
+}
+
 // A Store is a storage system for the CVE database.
 type Store interface {
 	// CreateCommitUpdateRecord creates a new CommitUpdateRecord. It should be called at the start
@@ -229,6 +846,18 @@ type Store interface {
 	// least recent.
 	ListCommitUpdateRecords(ctx context.Context, limit int) ([]*CommitUpdateRecord, error)
 
+	// CreateDBRegenRecord creates a new DBRegenRecord. On successful
+	// return, the DBRegenRecord's ID field will be set to a new, unique ID.
+	CreateDBRegenRecord(context.Context, *DBRegenRecord) error
+
+	// SetDBRegenRecord modifies the DBRegenRecord. Use the same record passed to
+	// CreateDBRegenRecord, because it will have the correct ID.
+	SetDBRegenRecord(context.Context, *DBRegenRecord) error
+
+	// ListDBRegenRecords returns some of the DBRegenRecords in the store, from most to
+	// least recent.
+	ListDBRegenRecords(ctx context.Context, limit int) ([]*DBRegenRecord, error)
+
 	// GetRecord returns the Record with the given id. If not found, it returns (nil, nil).
 	GetRecord(ctx context.Context, id string) (Record, error)
 
@@ -243,6 +872,105 @@ type Store interface {
 	// SetDirectoryHash sets the hash for the given directory.
 	SetDirectoryHash(ctx context.Context, dir, hash string) error
 
+	// SetIssueRecords creates or replaces the IssueRecords with the
+	// same numbers as the given records.
+	SetIssueRecords(context.Context, []*IssueRecord) error
+
+	// GetIssueRecord returns the IssueRecord with the given number.
+	// If not found, it returns (nil, nil).
+	GetIssueRecord(ctx context.Context, number int) (*IssueRecord, error)
+
+	// ListIssueRecords returns all IssueRecords with the given state,
+	// ordered by number. If state is empty, it returns all IssueRecords.
+	ListIssueRecords(ctx context.Context, state string) ([]*IssueRecord, error)
+
+	// LatestIssueUpdate returns the largest UpdatedAt time of all
+	// IssueRecords, or the zero time if there are none.
+	LatestIssueUpdate(context.Context) (time.Time, error)
+
+	// SetSymbolFeedbackRecords creates or replaces the
+	// SymbolFeedbackRecords with the same keys as the given records.
+	SetSymbolFeedbackRecords(context.Context, []*SymbolFeedbackRecord) error
+
+	// ListSymbolFeedbackRecords returns all SymbolFeedbackRecords with the
+	// given status, ordered by key. If status is empty, it returns all
+	// SymbolFeedbackRecords.
+	ListSymbolFeedbackRecords(ctx context.Context, status SymbolFeedbackStatus) ([]*SymbolFeedbackRecord, error)
+
+	// SetCVEPublicationRecords creates or replaces the
+	// CVEPublicationRecords with the same CVEs as the given records.
+	SetCVEPublicationRecords(context.Context, []*CVEPublicationRecord) error
+
+	// ListCVEPublicationRecords returns all CVEPublicationRecords,
+	// ordered by CVE.
+	ListCVEPublicationRecords(context.Context) ([]*CVEPublicationRecord, error)
+
+	// SetGHSAReviewRecords creates or replaces the
+	// GHSAReviewRecords with the same keys as the given records.
+	SetGHSAReviewRecords(context.Context, []*GHSAReviewRecord) error
+
+	// ListGHSAReviewRecords returns all GHSAReviewRecords,
+	// ordered by key.
+	ListGHSAReviewRecords(context.Context) ([]*GHSAReviewRecord, error)
+
+	// SetFindingStatsRecords creates or replaces the
+	// FindingStatsRecords with the same report IDs as the given records.
+	SetFindingStatsRecords(context.Context, []*FindingStatsRecord) error
+
+	// ListFindingStatsRecords returns all FindingStatsRecords,
+	// ordered by report ID.
+	ListFindingStatsRecords(context.Context) ([]*FindingStatsRecord, error)
+
+	// SetKEVRecords creates or replaces the KEVRecords
+	// with the same CVEs as the given records.
+	SetKEVRecords(context.Context, []*KEVRecord) error
+
+	// ListKEVRecords returns all KEVRecords, ordered by CVE.
+	ListKEVRecords(context.Context) ([]*KEVRecord, error)
+
+	// SetEPSSRecords creates or replaces the EPSSRecords
+	// with the same report IDs as the given records.
+	SetEPSSRecords(context.Context, []*EPSSRecord) error
+
+	// ListEPSSRecords returns all EPSSRecords, ordered by report ID.
+	ListEPSSRecords(context.Context) ([]*EPSSRecord, error)
+
+	// StartJob starts a run of the job with the given name at now,
+	// holding its lock until now+lockTimeout, unless a run of the job
+	// is in progress (see JobRecord.Running). It returns the job's
+	// record, and whether it started the run.
+	StartJob(ctx context.Context, name string, now time.Time, lockTimeout time.Duration) (*JobRecord, bool, error)
+
+	// EndJob ends the run of the job with the given name that started
+	// at startedAt, at now, releasing its lock, and records runErr, the
+	// error of the run (nil if it succeeded). It returns the job's
+	// record. It is an error if that run is not in progress.
+	EndJob(ctx context.Context, name string, startedAt, now time.Time, runErr error) (*JobRecord, error)
+
+	// ListJobRecords returns all JobRecords, ordered by name.
+	ListJobRecords(context.Context) ([]*JobRecord, error)
+
+	// GetWorkerConfig returns the current WorkerConfig.
+	// If none has been set, it returns (nil, nil).
+	GetWorkerConfig(context.Context) (*WorkerConfig, error)
+
+	// SetWorkerConfig replaces the WorkerConfig with c and records a
+	// ConfigChangeRecord with the given source, unless c is equal to the
+	// current WorkerConfig. It reports whether the WorkerConfig changed.
+	SetWorkerConfig(ctx context.Context, c *WorkerConfig, source string) (bool, error)
+
+	// ListConfigChanges returns the most recent ConfigChangeRecords,
+	// most recent first. If limit is positive, at most limit records
+	// are returned.
+	ListConfigChanges(ctx context.Context, limit int) ([]*ConfigChangeRecord, error)
+
+	// GetNotificationRecord returns the NotificationRecord.
+	// If none has been set, it returns (nil, nil).
+	GetNotificationRecord(context.Context) (*NotificationRecord, error)
+
+	// SetNotificationRecord replaces the NotificationRecord.
+	SetNotificationRecord(context.Context, *NotificationRecord) error
+
 	// RunTransaction runs the function in a transaction.
 	RunTransaction(context.Context, func(context.Context, Transaction) error) error
 }
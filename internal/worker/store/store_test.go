@@ -6,6 +6,7 @@ package store
 
 import (
 	"context"
+	"errors"
 	"sort"
 	"testing"
 	"time"
@@ -13,6 +14,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"golang.org/x/vulndb/internal/cve4"
+	"golang.org/x/vulndb/internal/database"
 	"golang.org/x/vulndb/internal/ghsa"
 )
 
@@ -39,6 +41,9 @@ func testStore(t *testing.T, s Store) {
 	t.Run("Updates", func(t *testing.T) {
 		testUpdates(t, s)
 	})
+	t.Run("DBRegens", func(t *testing.T) {
+		testDBRegens(t, s)
+	})
 	t.Run("CVEs", func(t *testing.T) {
 		testCVEs(t, s)
 	})
@@ -48,6 +53,36 @@ func testStore(t *testing.T, s Store) {
 	t.Run("GHSAs", func(t *testing.T) {
 		testGHSAs(t, s)
 	})
+	t.Run("Issues", func(t *testing.T) {
+		testIssues(t, s)
+	})
+	t.Run("SymbolFeedback", func(t *testing.T) {
+		testSymbolFeedback(t, s)
+	})
+	t.Run("CVEPublications", func(t *testing.T) {
+		testCVEPublications(t, s)
+	})
+	t.Run("GHSAReviews", func(t *testing.T) {
+		testGHSAReviews(t, s)
+	})
+	t.Run("FindingStats", func(t *testing.T) {
+		testFindingStats(t, s)
+	})
+	t.Run("KEV", func(t *testing.T) {
+		testKEV(t, s)
+	})
+	t.Run("EPSS", func(t *testing.T) {
+		testEPSS(t, s)
+	})
+	t.Run("Jobs", func(t *testing.T) {
+		testJobs(t, s)
+	})
+	t.Run("WorkerConfig", func(t *testing.T) {
+		testWorkerConfig(t, s)
+	})
+	t.Run("Notification", func(t *testing.T) {
+		testNotification(t, s)
+	})
 }
 
 func testUpdates(t *testing.T, s Store) {
@@ -83,6 +118,38 @@ func testUpdates(t *testing.T, s Store) {
 	}
 }
 
+func testDBRegens(t *testing.T, s Store) {
+	ctx := context.Background()
+	start := time.Date(2024, time.September, 1, 0, 0, 0, 0, time.UTC)
+
+	r1 := &DBRegenRecord{
+		StartedAt:  start,
+		CommitHash: "abc",
+		Stage:      DBRegenGenerate,
+	}
+	must(s.CreateDBRegenRecord(ctx, r1))(t)
+	r1.EndedAt = start.Add(time.Minute)
+	r1.Stage = DBRegenValidate
+	r1.Error = "validation failed"
+	must(s.SetDBRegenRecord(ctx, r1))(t)
+	r2 := &DBRegenRecord{
+		StartedAt:  start.Add(time.Hour),
+		CommitHash: "def",
+		Stage:      DBRegenGenerate,
+	}
+	must(s.CreateDBRegenRecord(ctx, r2))(t)
+	r2.EndedAt = r2.StartedAt.Add(time.Minute)
+	r2.Stage = DBRegenDone
+	r2.NumEntries = 1
+	r2.Published = true
+	r2.ManifestDigest = "123"
+	r2.Manifest = []*database.ManifestFile{{Path: "index/db.json", Size: 10, SHA256: "456"}}
+	must(s.SetDBRegenRecord(ctx, r2))(t)
+
+	diff(t, []*DBRegenRecord{r2, r1}, must1(s.ListDBRegenRecords(ctx, 0))(t))
+	diff(t, []*DBRegenRecord{r2}, must1(s.ListDBRegenRecords(ctx, 1))(t))
+}
+
 func testCVEs(t *testing.T, s Store) {
 	ctx := context.Background()
 	const (
@@ -245,6 +312,279 @@ func testGHSAs(t *testing.T, s Store) {
 	}
 }
 
+func testIssues(t *testing.T, s Store) {
+	ctx := context.Background()
+	date := func(day int) time.Time {
+		return time.Date(2024, time.March, day, 0, 0, 0, 0, time.UTC)
+	}
+
+	got := must1(s.LatestIssueUpdate(ctx))(t)
+	if !got.IsZero() {
+		t.Fatalf("got latest update %s, want zero", got)
+	}
+	irs := []*IssueRecord{
+		{Number: 2, Title: "two", State: "open", Labels: []string{"excluded: NOT_GO_CODE"}, UpdatedAt: date(3)},
+		{Number: 1, Title: "one", State: "open", Assignee: "alice", UpdatedAt: date(1)},
+	}
+	must(s.SetIssueRecords(ctx, irs))(t)
+	// Replace one of them.
+	closed := *irs[0]
+	closed.State = "closed"
+	closed.UpdatedAt = date(2)
+	must(s.SetIssueRecords(ctx, []*IssueRecord{&closed}))(t)
+
+	ignoreSynced := cmpopts.IgnoreFields(IssueRecord{}, "SyncedAt")
+	all := must1(s.ListIssueRecords(ctx, ""))(t)
+	diff(t, []*IssueRecord{irs[1], &closed}, all, ignoreSynced)
+	for _, ir := range all {
+		if ir.SyncedAt.IsZero() {
+			t.Errorf("issue %d: zero SyncedAt field", ir.Number)
+		}
+	}
+	open := must1(s.ListIssueRecords(ctx, "open"))(t)
+	diff(t, []*IssueRecord{irs[1]}, open, ignoreSynced)
+
+	got1 := must1(s.GetIssueRecord(ctx, 1))(t)
+	diff(t, irs[1], got1, ignoreSynced)
+	if got := must1(s.GetIssueRecord(ctx, 3))(t); got != nil {
+		t.Errorf("GetIssueRecord(3) = %+v, want nil", got)
+	}
+	got = must1(s.LatestIssueUpdate(ctx))(t)
+	if want := date(2); !got.Equal(want) {
+		t.Errorf("got latest update %s, want %s", got, want)
+	}
+}
+
+func testSymbolFeedback(t *testing.T, s Store) {
+	ctx := context.Background()
+	date := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+	rs := []*SymbolFeedbackRecord{
+		{ReportID: "GO-2024-0002", Package: "example.com/a", Symbol: "F", Issues: []int{2}, Status: SymbolFeedbackOpen, CreatedAt: date, UpdatedAt: date},
+		{ReportID: "GO-2024-0001", Package: "example.com/a/b", Symbol: "T.M", Issues: []int{1}, Status: SymbolFeedbackOpen, CreatedAt: date, UpdatedAt: date},
+	}
+	must(s.SetSymbolFeedbackRecords(ctx, rs))(t)
+	// Resolve one of them.
+	resolved := *rs[0]
+	resolved.Issues = []int{2, 3}
+	resolved.Status = SymbolFeedbackResolved
+	resolved.Resolution = "symbol removed from report"
+	must(s.SetSymbolFeedbackRecords(ctx, []*SymbolFeedbackRecord{&resolved}))(t)
+
+	all := must1(s.ListSymbolFeedbackRecords(ctx, ""))(t)
+	diff(t, []*SymbolFeedbackRecord{rs[1], &resolved}, all)
+	open := must1(s.ListSymbolFeedbackRecords(ctx, SymbolFeedbackOpen))(t)
+	diff(t, []*SymbolFeedbackRecord{rs[1]}, open)
+}
+
+func testCVEPublications(t *testing.T, s Store) {
+	ctx := context.Background()
+	date := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+	rs := []*CVEPublicationRecord{
+		{CVE: "CVE-2024-0002", ReportID: "GO-2024-0002", State: CVEPublicationReserved, StateChangedAt: date, SyncedAt: date},
+		{CVE: "CVE-2024-0001", ReportID: "GO-2024-0001", State: CVEPublicationPublished, RecordUpdated: date, StateChangedAt: date, SyncedAt: date},
+	}
+	must(s.SetCVEPublicationRecords(ctx, rs))(t)
+	// Publish the reserved one.
+	published := *rs[0]
+	published.State = CVEPublicationPublished
+	published.RecordUpdated = date.Add(time.Hour)
+	published.StateChangedAt = date.Add(time.Hour)
+	must(s.SetCVEPublicationRecords(ctx, []*CVEPublicationRecord{&published}))(t)
+
+	got := must1(s.ListCVEPublicationRecords(ctx))(t)
+	diff(t, []*CVEPublicationRecord{rs[1], &published}, got)
+}
+
+func testGHSAReviews(t *testing.T, s Store) {
+	ctx := context.Background()
+	date := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+	rs := []*GHSAReviewRecord{
+		{ReportID: "GO-2024-0002", GHSA: "GHSA-xxxx-yyyy-zzzz", GHSAUpdated: date, SyncedAt: date},
+		{ReportID: "GO-2024-0001", GHSA: "GHSA-aaaa-bbbb-cccc", GHSAUpdated: date, SyncedAt: date},
+	}
+	must(s.SetGHSAReviewRecords(ctx, rs))(t)
+	// The GHSA of the first report adds a reference.
+	diverged := *rs[0]
+	diverged.AddedReferences = []string{"https://example.com/advisory"}
+	diverged.DivergedAt = date.Add(time.Hour)
+	diverged.SyncedAt = date.Add(time.Hour)
+	must(s.SetGHSAReviewRecords(ctx, []*GHSAReviewRecord{&diverged}))(t)
+
+	got := must1(s.ListGHSAReviewRecords(ctx))(t)
+	diff(t, []*GHSAReviewRecord{rs[1], &diverged}, got)
+}
+
+func testFindingStats(t *testing.T, s Store) {
+	ctx := context.Background()
+	start := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 1, 0)
+
+	rs := []*FindingStatsRecord{
+		{ReportID: "GO-2024-0002", PeriodStart: start, PeriodEnd: end, ModuleFindings: 10, ImportedAt: end},
+		{ReportID: "GO-2024-0001", PeriodStart: start, PeriodEnd: end, Anomaly: FindingAnomalyNeverMatched, FlaggedAt: end, ImportedAt: end},
+	}
+	must(s.SetFindingStatsRecords(ctx, rs))(t)
+	// The next period finds the second report much more often.
+	next := *rs[0]
+	next.PeriodStart, next.PeriodEnd = end, end.AddDate(0, 1, 0)
+	next.ModuleFindings = 10000
+	next.Anomaly = FindingAnomalyOverMatched
+	next.FlaggedAt = next.PeriodEnd
+	must(s.SetFindingStatsRecords(ctx, []*FindingStatsRecord{&next}))(t)
+
+	got := must1(s.ListFindingStatsRecords(ctx))(t)
+	diff(t, []*FindingStatsRecord{rs[1], &next}, got)
+}
+
+func testKEV(t *testing.T, s Store) {
+	ctx := context.Background()
+	date := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+	rs := []*KEVRecord{
+		{CVE: "CVE-2024-0002", DateAdded: "2024-02-01", Issues: []int{12}, FirstSeen: date, SyncedAt: date},
+		{CVE: "CVE-2024-0001", DateAdded: "2024-01-01", ReportIDs: []string{"GO-2024-0001"}, FirstSeen: date, SyncedAt: date},
+	}
+	must(s.SetKEVRecords(ctx, rs))(t)
+	// The issue became a report.
+	next := *rs[0]
+	next.Issues = nil
+	next.ReportIDs = []string{"GO-2024-0012"}
+	next.SyncedAt = date.AddDate(0, 0, 1)
+	must(s.SetKEVRecords(ctx, []*KEVRecord{&next}))(t)
+
+	got := must1(s.ListKEVRecords(ctx))(t)
+	diff(t, []*KEVRecord{rs[1], &next}, got)
+}
+
+func testEPSS(t *testing.T, s Store) {
+	ctx := context.Background()
+	date := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+	rs := []*EPSSRecord{
+		{ReportID: "GO-2024-0002", CVE: "CVE-2024-0002", Score: 0.1, Percentile: 0.8, Date: "2024-03-01", Stale: true, SyncedAt: date},
+		{ReportID: "GO-2024-0001", CVE: "CVE-2024-0001", Score: 0.01, Percentile: 0.5, Date: "2024-03-01", SyncedAt: date},
+	}
+	must(s.SetEPSSRecords(ctx, rs))(t)
+	// The report was enriched.
+	next := *rs[0]
+	next.Stale = false
+	next.SyncedAt = date.AddDate(0, 0, 1)
+	must(s.SetEPSSRecords(ctx, []*EPSSRecord{&next}))(t)
+
+	got := must1(s.ListEPSSRecords(ctx))(t)
+	diff(t, []*EPSSRecord{rs[1], &next}, got)
+}
+
+func testJobs(t *testing.T, s Store) {
+	ctx := context.Background()
+	start := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+	timeout := time.Hour
+	startJob := func(name string, now time.Time) (*JobRecord, bool) {
+		t.Helper()
+		r, started, err := s.StartJob(ctx, name, now, timeout)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return r, started
+	}
+
+	r, started := startJob("scan-nvd", start)
+	if !started {
+		t.Fatal("first run did not start")
+	}
+	// The job is locked while the run is in progress.
+	if _, started := startJob("scan-nvd", start.Add(time.Minute)); started {
+		t.Error("second run started while the first was in progress")
+	}
+	// Other jobs aren't.
+	startJob("scan-osv", start)
+
+	end := start.Add(2 * time.Minute)
+	got := must1(s.EndJob(ctx, "scan-nvd", r.StartedAt, end, errors.New("bad")))(t)
+	want := &JobRecord{Name: "scan-nvd", StartedAt: start, EndedAt: end, Error: "bad", ConsecutiveFailures: 1}
+	diff(t, want, got)
+	if _, err := s.EndJob(ctx, "scan-nvd", r.StartedAt, end, nil); err == nil {
+		t.Error("ending a run twice: got no error")
+	}
+
+	// A run whose lock expired doesn't block the next one.
+	r, _ = startJob("scan-nvd", end)
+	start2 := end.Add(2 * timeout)
+	r2, started := startJob("scan-nvd", start2)
+	if !started {
+		t.Fatal("run did not start after the lock expired")
+	}
+	if _, err := s.EndJob(ctx, "scan-nvd", r.StartedAt, start2, nil); err == nil {
+		t.Error("ending an expired run: got no error")
+	}
+	end2 := start2.Add(time.Minute)
+	must1(s.EndJob(ctx, "scan-nvd", r2.StartedAt, end2, nil))(t)
+
+	rs := must1(s.ListJobRecords(ctx))(t)
+	diff(t, []*JobRecord{
+		{Name: "scan-nvd", StartedAt: start2, EndedAt: end2, LastSucceededAt: end2},
+		{Name: "scan-osv", StartedAt: start, LockedUntil: start.Add(timeout)},
+	}, rs)
+}
+
+func testWorkerConfig(t *testing.T, s Store) {
+	ctx := context.Background()
+
+	if got := must1(s.GetWorkerConfig(ctx))(t); got != nil {
+		t.Fatalf("got config %+v before any was set, want nil", got)
+	}
+	c1 := &WorkerConfig{IssueLimit: 5}
+	c2 := &WorkerConfig{IssueLimit: 5, NVDScanWindow: "48h", DeniedModules: []string{"example.com/a"}}
+
+	for _, tc := range []struct {
+		c           *WorkerConfig
+		source      string
+		wantChanged bool
+	}{
+		{c1, "one", true},
+		{&WorkerConfig{IssueLimit: 5}, "same", false},
+		{c2, "two", true},
+	} {
+		if got := must1(s.SetWorkerConfig(ctx, tc.c, tc.source))(t); got != tc.wantChanged {
+			t.Errorf("SetWorkerConfig(%+v) changed = %t, want %t", tc.c, got, tc.wantChanged)
+		}
+	}
+	diff(t, c2, must1(s.GetWorkerConfig(ctx))(t), cmpopts.EquateEmpty())
+
+	got := must1(s.ListConfigChanges(ctx, 0))(t)
+	want := []*ConfigChangeRecord{
+		{Source: "two", Old: c1, New: c2},
+		{Source: "one", Old: nil, New: c1},
+	}
+	diff(t, want, got, cmpopts.IgnoreFields(ConfigChangeRecord{}, "ChangedAt"), cmpopts.EquateEmpty())
+	if len(got) == 2 && got[0].ChangedAt.Before(got[1].ChangedAt) {
+		t.Errorf("config changes not ordered most recent first")
+	}
+	diff(t, want[:1], must1(s.ListConfigChanges(ctx, 1))(t), cmpopts.IgnoreFields(ConfigChangeRecord{}, "ChangedAt"), cmpopts.EquateEmpty())
+
+	if _, err := s.SetWorkerConfig(ctx, &WorkerConfig{NVDScanWindow: "a week"}, "bad"); err == nil {
+		t.Error("SetWorkerConfig with invalid duration: got nil error, want error")
+	}
+}
+
+func testNotification(t *testing.T, s Store) {
+	ctx := context.Background()
+
+	if got := must1(s.GetNotificationRecord(ctx))(t); got != nil {
+		t.Fatalf("got notification record %+v before any was set, want nil", got)
+	}
+	for _, nr := range []*NotificationRecord{
+		{LatestModified: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), NotifiedAt: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)},
+		{LatestModified: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), NotifiedAt: time.Date(2024, 2, 2, 0, 0, 0, 0, time.UTC)},
+	} {
+		must(s.SetNotificationRecord(ctx, nr))(t)
+		diff(t, nr, must1(s.GetNotificationRecord(ctx))(t))
+	}
+}
+
 func createCVE4Records(t *testing.T, ctx context.Context, s Store, crs []*CVE4Record) {
 	must(s.RunTransaction(ctx, func(ctx context.Context, tx Transaction) error {
 		for _, cr := range crs {
@@ -0,0 +1,318 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package worker
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"golang.org/x/vulndb/internal/derrors"
+	"golang.org/x/vulndb/internal/idstr"
+	"golang.org/x/vulndb/internal/issues"
+	"golang.org/x/vulndb/internal/observe"
+	"golang.org/x/vulndb/internal/report"
+	"golang.org/x/vulndb/internal/worker/log"
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+// Labels used by the ingestion of symbol feedback.
+const (
+	// labelSymbolFeedback is applied by the "Symbol not vulnerable"
+	// issue template (.github/ISSUE_TEMPLATE/symbol_feedback.yml).
+	labelSymbolFeedback = "Symbol Feedback"
+	// labelSymbolFeedbackChecked marks issues that
+	// ProcessSymbolFeedback has recorded.
+	labelSymbolFeedbackChecked = "SymbolFeedbackChecked"
+)
+
+// SymbolFeedbackStats are statistics about a run of ProcessSymbolFeedback.
+type SymbolFeedbackStats struct {
+	// Number of feedback issues checked.
+	NumChecked int
+	// Number of issues that could not be parsed, or did not
+	// name a report, package and symbols in the database.
+	NumInvalid int
+	// Number of disputed symbols recorded.
+	NumRecorded int
+	// Number of records resolved.
+	NumResolved int
+}
+
+// ProcessSymbolFeedback ingests feedback that symbols listed in reports
+// are not actually vulnerable. The feedback is given in open issues in
+// the tracker filed with the "Symbol not vulnerable" template.
+//
+// For each issue that has not been checked before, ProcessSymbolFeedback
+// parses the report ID, package and symbols, checks them against the
+// reports in rc, and records each valid symbol in a SymbolFeedbackRecord
+// in st. It comments on the issue with the outcome and labels it
+// NeedsTriage so that a reviewer looks at it.
+//
+// It then resolves the open records whose symbol is no longer listed in
+// the report, or whose feedback issues have all been closed.
+func ProcessSymbolFeedback(ctx context.Context, st store.Store, client *issues.Client, rc *report.Client) (stats SymbolFeedbackStats, err error) {
+	defer derrors.Wrap(&err, "ProcessSymbolFeedback(%q)", client.Destination())
+	ctx, span := observe.Start(ctx, "ProcessSymbolFeedback")
+	defer span.End()
+
+	all, err := st.ListSymbolFeedbackRecords(ctx, "")
+	if err != nil {
+		return stats, err
+	}
+	records := make(map[string]*store.SymbolFeedbackRecord)
+	for _, r := range all {
+		records[r.Key()] = r
+	}
+
+	iss, err := client.Issues(ctx, issues.IssuesOptions{
+		State:  "open",
+		Labels: []string{labelSymbolFeedback},
+	})
+	if err != nil {
+		return stats, err
+	}
+	var changed []*store.SymbolFeedbackRecord
+	for _, is := range iss {
+		if is.HasLabel(labelSymbolFeedbackChecked) {
+			continue
+		}
+		rs, err := processSymbolFeedbackIssue(ctx, client, rc, is, records, &stats)
+		if err != nil {
+			return stats, err
+		}
+		changed = append(changed, rs...)
+	}
+
+	resolved, err := resolveSymbolFeedback(ctx, client, rc, records)
+	if err != nil {
+		return stats, err
+	}
+	stats.NumResolved = len(resolved)
+	changed = append(changed, resolved...)
+
+	if len(changed) > 0 {
+		if err := st.SetSymbolFeedbackRecords(ctx, changed); err != nil {
+			return stats, err
+		}
+	}
+	log.Infof(ctx, "ProcessSymbolFeedback done: %+v", stats)
+	return stats, nil
+}
+
+// processSymbolFeedbackIssue records the feedback in the issue is,
+// updating records, and returns the records it created or changed.
+func processSymbolFeedbackIssue(ctx context.Context, client *issues.Client, rc *report.Client, is *issues.Issue, records map[string]*store.SymbolFeedbackRecord, stats *SymbolFeedbackStats) ([]*store.SymbolFeedbackRecord, error) {
+	stats.NumChecked++
+	ref := client.Reference(is.Number)
+	now := time.Now()
+
+	id, pkg, syms, problems := parseSymbolFeedback(rc, is.Body)
+	var changed []*store.SymbolFeedbackRecord
+	for _, s := range syms {
+		r := &store.SymbolFeedbackRecord{ReportID: id, Package: pkg, Symbol: s}
+		if old, ok := records[r.Key()]; ok {
+			r = old
+		} else {
+			r.CreatedAt = now
+			records[r.Key()] = r
+		}
+		if !slices.Contains(r.Issues, is.Number) {
+			r.Issues = append(r.Issues, is.Number)
+		}
+		r.Status = store.SymbolFeedbackOpen
+		r.Resolution = ""
+		r.UpdatedAt = now
+		changed = append(changed, r)
+	}
+	stats.NumRecorded += len(syms)
+
+	var comment string
+	if len(syms) == 0 {
+		stats.NumInvalid++
+		comment = intakeComment("Thanks for the feedback. We could not record it:", problems,
+			"Please edit the issue so that it names a report, one of its packages, and symbols listed "+
+				"for that package, and a maintainer will take a look.")
+	} else {
+		var items []string
+		for _, s := range syms {
+			items = append(items, fmt.Sprintf("`%s.%s`", pkg, s))
+		}
+		items = append(items, problems...)
+		comment = intakeComment(
+			fmt.Sprintf("Thanks for the feedback. We recorded that these symbols of [%s](%s) may not be vulnerable:", id, idstr.GoAdvisory(id)),
+			items,
+			"A maintainer will review the report. Until then, the symbols remain in the report and are marked as disputed.")
+	}
+
+	labels := slices.DeleteFunc(slices.Clone(is.Labels), func(l string) bool {
+		return l == labelNeedsTriage
+	})
+	labels = append(labels, labelNeedsTriage, labelSymbolFeedbackChecked)
+	if err := client.AddComments(ctx, is.Number, []string{comment}); err != nil {
+		return nil, err
+	}
+	if err := client.SetLabels(ctx, is.Number, labels); err != nil {
+		return nil, err
+	}
+	log.With("issue", ref, "report", id, "symbols", syms).Infof(ctx, "recorded symbol feedback %s", ref)
+	return changed, nil
+}
+
+// parseSymbolFeedback parses the body of a feedback issue, and returns
+// the report ID, package and symbols it disputes that are in the report,
+// along with descriptions of any problems.
+func parseSymbolFeedback(rc *report.Client, body string) (id, pkg string, syms, problems []string) {
+	form := parseIssueForm(body)
+	id = strings.TrimSpace(form["Report ID"])
+	pkg = strings.Trim(strings.TrimSpace(form["Package"]), "`")
+	if !idstr.IsGoID(id) {
+		return "", "", nil, []string{fmt.Sprintf("%q is not a Go report ID (GO-YYYY-NNNN)", id)}
+	}
+	r := reportByID(rc, id)
+	if r == nil || r.IsExcluded() {
+		return "", "", nil, []string{fmt.Sprintf("%s is not in the database", id)}
+	}
+	var p *report.Package
+	for _, m := range r.Modules {
+		for _, mp := range m.Packages {
+			if mp.Package == pkg {
+				p = mp
+			}
+		}
+	}
+	if p == nil {
+		return "", "", nil, []string{fmt.Sprintf("%s does not affect package %q", id, pkg)}
+	}
+	affected := p.AllSymbols()
+	for _, line := range strings.Split(form["Symbols"], "\n") {
+		s := strings.Trim(strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(line), "-")), "`")
+		s = strings.TrimPrefix(s, pkg+".")
+		switch {
+		case s == "" || s == noResponse:
+		case slices.Contains(affected, s):
+			if !slices.Contains(syms, s) {
+				syms = append(syms, s)
+			}
+		default:
+			problems = append(problems, fmt.Sprintf("`%s` is not listed as an affected symbol of %s in %s", s, pkg, id))
+		}
+	}
+	if len(syms) == 0 && len(problems) == 0 {
+		problems = append(problems, "no symbols were listed")
+	}
+	return id, pkg, syms, problems
+}
+
+// The value of an issue form field that was left empty.
+const noResponse = "_No response_"
+
+// parseIssueForm returns the fields of an issue body created from an
+// issue form, which has a "### Label" heading before each value.
+func parseIssueForm(body string) map[string]string {
+	form := make(map[string]string)
+	var (
+		label string
+		value strings.Builder
+	)
+	flush := func() {
+		if label != "" {
+			form[label] = strings.TrimSpace(value.String())
+		}
+		value.Reset()
+	}
+	for _, line := range strings.Split(body, "\n") {
+		if l, ok := strings.CutPrefix(line, "### "); ok {
+			flush()
+			label = strings.TrimSpace(l)
+			continue
+		}
+		value.WriteString(line)
+		value.WriteString("\n")
+	}
+	flush()
+	return form
+}
+
+// resolveSymbolFeedback resolves the open records in records whose
+// symbol is no longer listed in the report, or all of whose feedback
+// issues are closed. It returns the resolved records.
+func resolveSymbolFeedback(ctx context.Context, client *issues.Client, rc *report.Client, records map[string]*store.SymbolFeedbackRecord) ([]*store.SymbolFeedbackRecord, error) {
+	var (
+		open []*store.SymbolFeedbackRecord
+		nums []int
+	)
+	for _, r := range records {
+		if r.Status == store.SymbolFeedbackOpen {
+			open = append(open, r)
+			nums = append(nums, r.Issues...)
+		}
+	}
+	if len(open) == 0 {
+		return nil, nil
+	}
+	slices.Sort(nums)
+	iss, err := client.IssuesByNumber(ctx, slices.Compact(nums))
+	if err != nil {
+		return nil, err
+	}
+
+	var resolved []*store.SymbolFeedbackRecord
+	for _, r := range open {
+		var resolution string
+		switch {
+		case !reportListsSymbol(rc, r):
+			resolution = "symbol is no longer listed in the report"
+		case allClosed(iss, r.Issues):
+			resolution = "feedback issues were closed"
+		default:
+			continue
+		}
+		r.Status = store.SymbolFeedbackResolved
+		r.Resolution = resolution
+		r.UpdatedAt = time.Now()
+		resolved = append(resolved, r)
+	}
+	return resolved, nil
+}
+
+func reportListsSymbol(rc *report.Client, fr *store.SymbolFeedbackRecord) bool {
+	r := reportByID(rc, fr.ReportID)
+	if r == nil {
+		return false
+	}
+	for _, m := range r.Modules {
+		for _, p := range m.Packages {
+			if p.Package == fr.Package && slices.Contains(p.AllSymbols(), fr.Symbol) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// allClosed reports whether all the issues with the given numbers
+// are closed. Issues missing from iss are treated as open.
+func allClosed(iss map[int]*issues.Issue, nums []int) bool {
+	for _, n := range nums {
+		if is, ok := iss[n]; !ok || is.State != "closed" {
+			return false
+		}
+	}
+	return true
+}
+
+// reportByID returns the (non-excluded) report in rc with the given ID,
+// or nil if there is none.
+func reportByID(rc *report.Client, id string) *report.Report {
+	fname, err := (&report.Report{ID: id}).YAMLFilename()
+	if err != nil {
+		return nil
+	}
+	r, _ := rc.Report(fname)
+	return r
+}
@@ -0,0 +1,176 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"golang.org/x/vulndb/internal/issues"
+	"golang.org/x/vulndb/internal/issues/githubtest"
+	"golang.org/x/vulndb/internal/report"
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+func TestProcessSymbolFeedback(t *testing.T) {
+	ctx := context.Background()
+	mstore := store.NewMemStore()
+
+	ic, mux := githubtest.Setup(ctx, t, &issues.Config{
+		Owner: githubtest.TestOwner,
+		Repo:  githubtest.TestRepo,
+		Token: githubtest.TestToken,
+	})
+	prefix := fmt.Sprintf("/repos/%s/%s/issues", githubtest.TestOwner, githubtest.TestRepo)
+	mux.HandleFunc(prefix, func(w http.ResponseWriter, r *http.Request) {
+		if got, want := r.URL.Query().Get("labels"), labelSymbolFeedback; got != want {
+			t.Errorf("labels query = %q, want %q", got, want)
+		}
+		fmt.Fprint(w, `[
+{"number": 10, "title": "x/vulndb: symbol feedback for GO-2000-0001", "labels": [{"name": "Symbol Feedback"}],
+ "body": "### Report ID\n\nGO-2000-0001\n\n### Package\n\nexample.com/a/p\n\n### Symbols\n\nF\n`+"`example.com/a/p.T.M`"+`\nNope\n\n### Explanation\n\nF is only called on trusted input."},
+{"number": 11, "title": "x/vulndb: symbol feedback for GO-2000-9999", "labels": [{"name": "Symbol Feedback"}],
+ "body": "### Report ID\n\nGO-2000-9999\n\n### Package\n\nexample.com/a/p\n\n### Symbols\n\nF"},
+{"number": 12, "title": "x/vulndb: symbol feedback for GO-2000-0001", "labels": [{"name": "Symbol Feedback"}, {"name": "SymbolFeedbackChecked"}]}
+]`)
+	})
+	var (
+		mu       sync.Mutex
+		comments = map[int][]string{}
+		labels   = map[int][]string{}
+	)
+	mux.HandleFunc(prefix+"/", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		var num int
+		rest := strings.TrimPrefix(r.URL.Path, prefix+"/")
+		var body struct {
+			Body   string
+			Labels []string
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Error(err)
+			return
+		}
+		switch {
+		case r.Method == http.MethodPost && strings.HasSuffix(rest, "/comments"):
+			fmt.Sscanf(rest, "%d/comments", &num)
+			comments[num] = append(comments[num], body.Body)
+			fmt.Fprint(w, `{}`)
+		case r.Method == http.MethodPatch:
+			fmt.Sscanf(rest, "%d", &num)
+			labels[num] = body.Labels
+			fmt.Fprintf(w, `{"number": %d}`, num)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data": {"repository": {
+			"i5": {"number": 5, "state": "CLOSED", "assignees": {"nodes": []}, "labels": {"nodes": []}},
+			"i6": {"number": 6, "state": "OPEN", "assignees": {"nodes": []}, "labels": {"nodes": []}},
+			"i10": {"number": 10, "state": "OPEN", "assignees": {"nodes": []}, "labels": {"nodes": []}}}}}`)
+	})
+
+	open := func(sym string, issue int) *store.SymbolFeedbackRecord {
+		return &store.SymbolFeedbackRecord{
+			ReportID: "GO-2000-0001",
+			Package:  "example.com/a/p",
+			Symbol:   sym,
+			Issues:   []int{issue},
+			Status:   store.SymbolFeedbackOpen,
+		}
+	}
+	if err := mstore.SetSymbolFeedbackRecords(ctx, []*store.SymbolFeedbackRecord{
+		open("G", 5),   // issue closed
+		open("Old", 6), // no longer in the report
+	}); err != nil {
+		t.Fatal(err)
+	}
+	rc, err := report.NewTestClient(map[string]*report.Report{
+		"data/reports/GO-2000-0001.yaml": {
+			ID: "GO-2000-0001",
+			Modules: []*report.Module{{
+				Module: "example.com/a",
+				Packages: []*report.Package{{
+					Package:        "example.com/a/p",
+					Symbols:        []string{"F", "T.M"},
+					DerivedSymbols: []string{"G"},
+				}},
+			}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	stats, err := ProcessSymbolFeedback(ctx, mstore, ic, rc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (SymbolFeedbackStats{NumChecked: 2, NumInvalid: 1, NumRecorded: 2, NumResolved: 2}); stats != want {
+		t.Errorf("got stats %+v, want %+v", stats, want)
+	}
+
+	wantLabels := map[int][]string{
+		10: {"Symbol Feedback", "NeedsTriage", "SymbolFeedbackChecked"},
+		11: {"Symbol Feedback", "NeedsTriage", "SymbolFeedbackChecked"},
+	}
+	if diff := cmp.Diff(wantLabels, labels); diff != "" {
+		t.Errorf("labels mismatch (-want, +got):\n%s", diff)
+	}
+	for num, wants := range map[int][]string{
+		10: {"`example.com/a/p.F`", "`example.com/a/p.T.M`", "`Nope` is not listed"},
+		11: {"GO-2000-9999 is not in the database"},
+	} {
+		if len(comments[num]) != 1 {
+			t.Errorf("issue %d: got %d comments, want 1", num, len(comments[num]))
+			continue
+		}
+		for _, want := range wants {
+			if !strings.Contains(comments[num][0], want) {
+				t.Errorf("issue %d: comment\n%s\ndoes not contain %q", num, comments[num][0], want)
+			}
+		}
+	}
+
+	got, err := mstore.ListSymbolFeedbackRecords(ctx, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resolved := func(r *store.SymbolFeedbackRecord, resolution string) *store.SymbolFeedbackRecord {
+		r.Status = store.SymbolFeedbackResolved
+		r.Resolution = resolution
+		return r
+	}
+	want := []*store.SymbolFeedbackRecord{
+		open("F", 10),
+		resolved(open("G", 5), "feedback issues were closed"),
+		resolved(open("Old", 6), "symbol is no longer listed in the report"),
+		open("T.M", 10),
+	}
+	if diff := cmp.Diff(want, got, cmpopts.IgnoreFields(store.SymbolFeedbackRecord{}, "CreatedAt", "UpdatedAt")); diff != "" {
+		t.Errorf("records mismatch (-want, +got):\n%s", diff)
+	}
+}
+
+func TestParseIssueForm(t *testing.T) {
+	body := "### Report ID\n\nGO-2000-0001\n\n### Symbols\n\nA\nB\n\n### Explanation\n\n_No response_"
+	want := map[string]string{
+		"Report ID":   "GO-2000-0001",
+		"Symbols":     "A\nB",
+		"Explanation": "_No response_",
+	}
+	if diff := cmp.Diff(want, parseIssueForm(body)); diff != "" {
+		t.Errorf("mismatch (-want, +got):\n%s", diff)
+	}
+}
@@ -27,9 +27,11 @@ import (
 )
 
 // A triageFunc triages a CVE: it decides whether an issue needs to be filed.
-// If so, it returns a non-empty string indicating the possibly
+// If so, it returns a non-nil result indicating the possibly
 // affected module.
-type triageFunc func(*cve4.CVE) (*triage.Result, error)
+// It also returns the inputs to its decision, if it can, so
+// that a decision that the CVE does not affect Go can be replayed.
+type triageFunc func(*cve4.CVE) (*triage.Result, *triage.Inputs, error)
 
 // A cveUpdater performs an update operation on the DB.
 type cveUpdater struct {
@@ -277,7 +279,10 @@ func (u *cveUpdater) handleCVE(f cvelistrepo.File, old *store.CVE4Record, tx sto
 	if err != nil {
 		return nil, false, err
 	}
-	var result *triage.Result
+	var (
+		result *triage.Result
+		inputs *triage.Inputs
+	)
 	if cve.State == cve4.StatePublic && !u.rc.AliasHasReport(cve.ID) {
 		c := cve
 		// If a false positive has changed, we only care about
@@ -287,10 +292,13 @@ func (u *cveUpdater) handleCVE(f cvelistrepo.File, old *store.CVE4Record, tx sto
 		if old != nil && old.TriageState == store.TriageStateFalsePositive {
 			c = copyRemoving(cve, old.ReferenceURLs)
 		}
-		result, err = u.affectedModule(c)
+		result, inputs, err = u.affectedModule(c)
 		if err != nil {
 			return nil, false, err
 		}
+		if result != nil {
+			inputs = nil
+		}
 	}
 
 	pathname := path.Join(f.DirPath, f.Filename)
@@ -312,6 +320,7 @@ func (u *cveUpdater) handleCVE(f cvelistrepo.File, old *store.CVE4Record, tx sto
 			cr.TriageState = store.TriageStateHasVuln
 		default:
 			cr.TriageState = store.TriageStateNoActionNeeded
+			cr.TriageInputs = inputs
 		}
 		return cr, true, nil
 	}
@@ -332,6 +341,7 @@ func (u *cveUpdater) handleCVE(f cvelistrepo.File, old *store.CVE4Record, tx sto
 			mod.TriageStateReason = result.Reason
 			mod.CVE = cve
 		}
+		mod.TriageInputs = inputs
 		// Else don't change the triage state, but we still want
 		// to update the other changed fields.
 
@@ -341,6 +351,8 @@ func (u *cveUpdater) handleCVE(f cvelistrepo.File, old *store.CVE4Record, tx sto
 			mod.TriageState = store.TriageStateNoActionNeeded
 			mod.Module = ""
 			mod.CVE = nil
+			mod.CVE5 = nil
+			mod.TriageInputs = inputs
 		}
 		// Else don't change the triage state, but we still want
 		// to update the other changed fields.
@@ -348,6 +360,10 @@ func (u *cveUpdater) handleCVE(f cvelistrepo.File, old *store.CVE4Record, tx sto
 	case store.TriageStateIssueCreated, store.TriageStateUpdatedSinceIssueCreation:
 		// An issue was filed, so a person should revisit this CVE.
 		mod.TriageState = store.TriageStateUpdatedSinceIssueCreation
+		// Keep the current CVE, so that RefreshIssues can
+		// update the issue with it.
+		mod.CVE = cve
+		mod.CVE5 = nil
 		var mp string
 		if result != nil {
 			mp = result.ModulePath
@@ -365,7 +381,7 @@ func (u *cveUpdater) handleCVE(f cvelistrepo.File, old *store.CVE4Record, tx sto
 	if old.TriageState != mod.TriageState {
 		mod.History = append([]*store.CVE4RecordSnapshot{old.Snapshot()}, mod.History...)
 	}
-	if mod.TriageState == store.TriageStateNeedsIssue && mod.CVE == nil {
+	if mod.TriageState == store.TriageStateNeedsIssue && mod.CVE == nil && mod.CVE5 == nil {
 		return nil, false, errors.New("needs issue but CVE is nil")
 	}
 	// If we're here, then mod is a valid modification to the DB.
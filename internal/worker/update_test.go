@@ -66,6 +66,9 @@ func modify(r, m *store.CVE4Record) *store.CVE4Record {
 	if m.ReferenceURLs != nil {
 		c.ReferenceURLs = m.ReferenceURLs
 	}
+	if m.TriageInputs != nil {
+		c.TriageInputs = m.TriageInputs
+	}
 	if m.History != nil {
 		c.History = m.History
 	}
@@ -103,8 +106,8 @@ func TestDoUpdate(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	needsIssue := func(cve *cve4.CVE) (*triage.Result, error) {
-		return triage.RefersToGoModule(ctx, cve, pc)
+	needsIssue := func(cve *cve4.CVE) (*triage.Result, *triage.Inputs, error) {
+		return triage.RefersToGoModuleWithInputs(ctx, cve, pc)
 	}
 
 	commitHash := commit.Hash.String()
@@ -245,6 +248,7 @@ func TestDoUpdate(t *testing.T) {
 				modify(rs[1], &store.CVE4Record{
 					TriageState:       store.TriageStateUpdatedSinceIssueCreation,
 					TriageStateReason: `CVE changed; affected module = ""`,
+					CVE:               cves[1],
 				}),
 				rs[2],
 				rs[3],
@@ -277,6 +281,9 @@ func TestDoUpdate(t *testing.T) {
 						"https://www.intel.com/content/www/us/en/security-center/advisory/intel-sa-00477.html",
 						"https://golang.org/x/mod",
 					},
+					// The decision was made without the known URLs,
+					// which leaves nothing to look up.
+					TriageInputs: &triage.Inputs{},
 				}),
 				rs[1], rs[2], rs[3], rs[4],
 			},
@@ -398,7 +405,7 @@ func TestDoUpdateError(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	needsIssue := func(cve *cve4.CVE) (*triage.Result, error) { return nil, nil }
+	needsIssue := func(cve *cve4.CVE) (*triage.Result, *triage.Inputs, error) { return nil, nil, nil }
 
 	for _, test := range []struct {
 		name                                      string
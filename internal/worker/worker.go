@@ -11,6 +11,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"slices"
 	"strconv"
 	"strings"
 	"text/template"
@@ -20,16 +21,18 @@ import (
 	"github.com/go-git/go-git/v5/plumbing/object"
 	"golang.org/x/time/rate"
 	"golang.org/x/vulndb/internal/cve4"
+	"golang.org/x/vulndb/internal/cvelistrepo"
 	"golang.org/x/vulndb/internal/derrors"
 	"golang.org/x/vulndb/internal/ghsa"
-	"golang.org/x/vulndb/internal/gitrepo"
 	"golang.org/x/vulndb/internal/idstr"
 	"golang.org/x/vulndb/internal/issues"
 	"golang.org/x/vulndb/internal/observe"
 	"golang.org/x/vulndb/internal/pkgsite"
 	"golang.org/x/vulndb/internal/proxy"
 	"golang.org/x/vulndb/internal/report"
+	"golang.org/x/vulndb/internal/stdlib"
 	"golang.org/x/vulndb/internal/triage"
+	"golang.org/x/vulndb/internal/triage/owners"
 	"golang.org/x/vulndb/internal/worker/log"
 	"golang.org/x/vulndb/internal/worker/store"
 )
@@ -44,7 +47,7 @@ func UpdateCVEsAtCommit(ctx context.Context, repoPath, commitHashString string,
 		return err
 	}
 
-	repo, err := gitrepo.CloneOrOpen(ctx, repoPath)
+	repo, err := cvelistrepo.CloneOrOpen(ctx, repoPath, commitHashString)
 	if err != nil {
 		return err
 	}
@@ -67,8 +70,8 @@ func UpdateCVEsAtCommit(ctx context.Context, repoPath, commitHashString string,
 			return err
 		}
 	}
-	u := newCVEUpdater(repo, commit, st, rc, func(cve *cve4.CVE) (*triage.Result, error) {
-		return triage.RefersToGoModule(ctx, cve, pc)
+	u := newCVEUpdater(repo, commit, st, rc, func(cve *cve4.CVE) (*triage.Result, *triage.Inputs, error) {
+		return triage.RefersToGoModuleWithInputs(ctx, cve, pc)
 	})
 	return u.update(ctx)
 }
@@ -165,10 +168,28 @@ func CreateIssues(ctx context.Context, st store.Store, client *issues.Client, pc
 	ctx, span := observe.Start(ctx, "CreateIssues")
 	defer span.End()
 
-	if err := createCVEIssues(ctx, st, client, pc, rc, limit); err != nil {
+	lc, err := loadLiveConfig(ctx, st)
+	if err != nil {
+		return err
+	}
+	if err := createCVEIssues(ctx, st, client, pc, rc, lc, limit); err != nil {
 		return err
 	}
-	return createGHSAIssues(ctx, st, client, pc, rc, limit)
+	return createGHSAIssues(ctx, st, client, pc, rc, lc, limit)
+}
+
+// isDeniedGHSA reports whether all the packages affected
+// by sa are denied by lc.
+func isDeniedGHSA(sa *ghsa.SecurityAdvisory, lc *liveConfig) bool {
+	if len(sa.Vulns) == 0 {
+		return false
+	}
+	for _, v := range sa.Vulns {
+		if !lc.isDenied(v.Package) {
+			return false
+		}
+	}
+	return true
 }
 
 // xref returns cross-references for a report: Information about other reports
@@ -179,7 +200,7 @@ func xref(r *report.Report, rc *report.Client) string {
 	return rc.XRef(r).ToString(aliasTitle, moduleTitle, noneMessage)
 }
 
-func createCVEIssues(ctx context.Context, st store.Store, client *issues.Client, pc *proxy.Client, rc *report.Client, limit int) (err error) {
+func createCVEIssues(ctx context.Context, st store.Store, client *issues.Client, pc *proxy.Client, rc *report.Client, lc *liveConfig, limit int) (err error) {
 	defer derrors.Wrap(&err, "createCVEIssues(destination: %s)", client.Destination())
 
 	needsIssue, err := st.ListCVE4RecordsWithTriageState(ctx, store.TriageStateNeedsIssue)
@@ -193,6 +214,10 @@ func createCVEIssues(ctx context.Context, st store.Store, client *issues.Client,
 		if limit > 0 && numCreated >= limit {
 			break
 		}
+		if lc.isDenied(cr.Module) {
+			log.Infof(ctx, "not creating issue for %s: module %s is denied by the worker config", cr.ID, cr.Module)
+			continue
+		}
 		ref, err := createIssue(ctx, cr, client, pc, rc)
 		if err != nil {
 			return err
@@ -219,7 +244,7 @@ func createCVEIssues(ctx context.Context, st store.Store, client *issues.Client,
 	return nil
 }
 
-func createGHSAIssues(ctx context.Context, st store.Store, client *issues.Client, pc *proxy.Client, rc *report.Client, limit int) (err error) {
+func createGHSAIssues(ctx context.Context, st store.Store, client *issues.Client, pc *proxy.Client, rc *report.Client, lc *liveConfig, limit int) (err error) {
 	defer derrors.Wrap(&err, "createGHSAIssues(destination: %s)", client.Destination())
 
 	sas, err := getGHSARecords(ctx, st)
@@ -240,6 +265,10 @@ func createGHSAIssues(ctx context.Context, st store.Store, client *issues.Client
 		if limit > 0 && numCreated >= limit {
 			break
 		}
+		if isDeniedGHSA(gr.GHSA, lc) {
+			log.Infof(ctx, "not creating issue for %s: all its packages are denied by the worker config", gr.GHSA.ID)
+			continue
+		}
 		// TODO(https://github.com/golang/go/issues/54049): Move this
 		// check to the triage step of the worker.
 		if isDuplicate(gr.GHSA, pc, rc) {
@@ -295,7 +324,17 @@ func isDuplicate(sa *ghsa.SecurityAdvisory, pc *proxy.Client, rc *report.Client)
 	return false
 }
 
+// NewIssueBody returns the body of a tracker issue for report r.
+// The modules of r are listed as the candidate modules of the issue.
 func NewIssueBody(r *report.Report, desc string, rc *report.Client) (body string, err error) {
+	return newIssueBody(r, desc, rc, nil, nil)
+}
+
+// newIssueBody is like NewIssueBody, but if cr is non-nil it also
+// lists the sources of the CVE's record, marking the freshest copy.
+// If modules is non-empty, it lists them as the candidate modules
+// instead of the modules of r.
+func newIssueBody(r *report.Report, desc string, rc *report.Client, cr *store.CVE4Record, modules []string) (body string, err error) {
 	// Truncate the description if it is too long.
 	if len(desc) > 600 {
 		desc = desc[:600] + "..."
@@ -305,15 +344,24 @@ func NewIssueBody(r *report.Report, desc string, rc *report.Client) (body string
 	if err != nil {
 		return "", err
 	}
+	if len(modules) == 0 {
+		for _, m := range r.Modules {
+			modules = append(modules, m.Module)
+		}
+	}
+	meta := issueMeta(r, modules)
 	var b strings.Builder
 	if err := issueTemplate.Execute(&b, issueTemplateData{
-		SourceID:     r.SourceMeta.ID,
-		AdvisoryLink: idstr.AdvisoryLink(r.SourceMeta.ID),
-		Description:  desc,
-		Xrefs:        xref(r, rc),
-		Report:       r,
-		ReportStr:    rs,
-		Pre:          "```",
+		SourceID:         r.SourceMeta.ID,
+		AdvisoryLink:     idstr.AdvisoryLink(r.SourceMeta.ID),
+		Description:      desc,
+		Xrefs:            xref(r, rc),
+		Report:           r,
+		ReportStr:        rs,
+		Sources:          issueSources(cr),
+		CandidateModules: modules,
+		Meta:             meta.Section(),
+		Pre:              "```",
 	}); err != nil {
 		return "", err
 	}
@@ -339,9 +387,9 @@ func createIssue(ctx context.Context, r store.Record, client *issues.Client, pc
 		return "", nil
 	}
 
-	rep := report.New(src, pc,
-		report.WithModulePath(r.GetUnit()))
-	body, err := NewIssueBody(rep, r.GetDescription(), rc)
+	rep := newIssueReport(r, pc)
+	cr, _ := r.(*store.CVE4Record)
+	body, err := newIssueBody(rep, r.GetDescription(), rc, cr, candidateModules(rep, r.GetUnits(), pc))
 	if err != nil {
 		log.With("ID", id).Errorf(ctx, "%s: triage state is NeedsIssue but could not generate body; skipping: %v", id, err)
 		return "", nil
@@ -359,6 +407,7 @@ func createIssue(ctx context.Context, r store.Record, client *issues.Client, pc
 		Body:   body,
 		Labels: labels,
 	}
+	routeIssue(iss, owners.Default(), id, r.GetUnit())
 	if err := issueRateLimiter.Wait(ctx); err != nil {
 		return "", err
 	}
@@ -375,6 +424,78 @@ func createIssue(ctx context.Context, r store.Record, client *issues.Client, pc
 	return ref, nil
 }
 
+// newIssueReport returns the report for the source of r that is
+// filed in its tracker issue.
+func newIssueReport(r store.Record, pc *proxy.Client) *report.Report {
+	opts := []report.NewOption{report.WithModulePath(r.GetUnit())}
+	if cr, ok := r.(*store.CVE4Record); ok && len(cr.Aliases) > 0 {
+		opts = append(opts, report.WithAliases(cr.Aliases))
+	}
+	return report.New(r.GetSource(), pc, opts...)
+}
+
+// issueMeta returns the structured section of the tracker issue
+// for report r, with the given candidate modules.
+func issueMeta(r *report.Report, modules []string) *issues.Meta {
+	m := &issues.Meta{Modules: modules, Aliases: r.Aliases()}
+	for _, ref := range r.References {
+		m.References = appendNew(m.References, ref.URL)
+	}
+	return m
+}
+
+// candidateModules returns the paths of the modules that might be
+// affected by the vulnerability of r: those of the modules of r,
+// followed by the modules of the units (modules or packages) that
+// are not in one of them.
+func candidateModules(r *report.Report, units []string, pc *proxy.Client) []string {
+	var mps []string
+	for _, m := range r.Modules {
+		mps = appendNew(mps, m.Module)
+	}
+	for _, u := range units {
+		if slices.ContainsFunc(mps, func(mp string) bool {
+			return u == mp || strings.HasPrefix(u, mp+"/")
+		}) {
+			continue
+		}
+		if stdlib.Contains(u) {
+			if u == stdlib.ToolchainModulePath || strings.HasPrefix(u, stdlib.ToolchainModulePath+"/") {
+				mps = appendNew(mps, stdlib.ToolchainModulePath)
+			} else {
+				mps = appendNew(mps, stdlib.ModulePath)
+			}
+			continue
+		}
+		mp, err := pc.FindModule(u)
+		if err != nil {
+			// The unit might still be a module the proxy doesn't know about.
+			mp = u
+		}
+		mps = appendNew(mps, mp)
+	}
+	return mps
+}
+
+func appendNew(ss []string, s string) []string {
+	if s == "" || slices.Contains(ss, s) {
+		return ss
+	}
+	return append(ss, s)
+}
+
+// routeIssue labels iss with the area of the first of paths that has
+// one in o, and assigns it to one of the area's preferred reviewers,
+// chosen by id.
+func routeIssue(iss *issues.Issue, o *owners.Owners, id string, paths ...string) {
+	a := o.Lookup(paths...)
+	if a == nil {
+		return
+	}
+	iss.Labels = append(iss.Labels, a.Label())
+	iss.Assignee = a.Reviewer(id)
+}
+
 func yearLabel(cve string) string {
 	if !strings.HasPrefix(cve, "CVE-") {
 		return ""
@@ -400,24 +521,58 @@ type issueTemplateData struct {
 	Description  string
 	Xrefs        string
 	ReportStr    string
-	Pre          string // markdown string for a <pre> block
+	Sources      []issueSource
+	// CandidateModules are the paths of the modules that might be affected.
+	CandidateModules []string
+	// Meta is the machine-readable section of the issue (see issues.Meta).
+	Meta string
+	Pre  string // markdown string for a <pre> block
+}
+
+// An issueSource describes a copy of the advisory's record.
+type issueSource struct {
+	Name     string
+	Updated  string
+	URL      string
+	Freshest bool
+}
+
+func issueSources(cr *store.CVE4Record) []issueSource {
+	if cr == nil {
+		return nil
+	}
+	freshest := cr.FreshestProvenance()
+	var ss []issueSource
+	for _, p := range cr.Provenance {
+		s := issueSource{Name: p.Source, URL: p.URL, Freshest: p == freshest}
+		if !p.Updated.IsZero() {
+			s.Updated = p.Updated.UTC().Format(time.RFC3339)
+		}
+		ss = append(ss, s)
+	}
+	return ss
 }
 
 var issueTemplate = template.Must(template.New("issue").Parse(`Advisory [{{.SourceID}}]({{.AdvisoryLink}}) references a vulnerability in the following Go modules:
 
 | Module |
-| - |{{range .Modules}}
-| [{{.Module}}](https://pkg.go.dev/{{.Module}}) |{{end}}
+| - |{{range .CandidateModules}}
+| [{{.}}](https://pkg.go.dev/{{.}}) |{{end}}
 
 Description:
 {{.Description}}
 
 References:{{range .References}}
 - {{.Type}}: {{.URL}}{{end}}
-
+{{if .Sources}}
+Sources:{{range .Sources}}
+- {{if .URL}}[{{.Name}}]({{.URL}}){{else}}{{.Name}}{{end}}{{if .Updated}}, updated {{.Updated}}{{end}}{{if .Freshest}} (freshest){{end}}{{end}}
+{{end}}
 {{.Xrefs}}
 See [doc/quickstart.md](https://github.com/golang/vulndb/blob/master/doc/quickstart.md) for instructions on how to triage this report.
 
+{{.Meta}}
+
 {{if (and .Pre .ReportStr) -}}
 {{.Pre}}
 {{.ReportStr}}
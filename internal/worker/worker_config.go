@@ -0,0 +1,122 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package worker
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"strings"
+	"time"
+
+	"golang.org/x/vulndb/internal/derrors"
+	"golang.org/x/vulndb/internal/observe"
+	"golang.org/x/vulndb/internal/worker/log"
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+// Defaults for the settings of a store.WorkerConfig.
+const (
+	defaultIssueLimit = 10
+	// How far back /scan-nvd and /scan-osv look, unless told otherwise.
+	defaultNVDScanWindow = 7 * 24 * time.Hour
+)
+
+// ReadWorkerConfigFile reads a store.WorkerConfig from a JSON file.
+func ReadWorkerConfigFile(filename string) (_ *store.WorkerConfig, err error) {
+	defer derrors.Wrap(&err, "ReadWorkerConfigFile(%q)", filename)
+
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.DisallowUnknownFields()
+	var c store.WorkerConfig
+	if err := d.Decode(&c); err != nil {
+		return nil, err
+	}
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+// ReloadWorkerConfig replaces the worker config in st with the one in
+// filename. If the config changes, st records an audit entry for the
+// change with the given source. ReloadWorkerConfig reports whether the
+// config changed.
+func ReloadWorkerConfig(ctx context.Context, st store.Store, filename, source string) (changed bool, err error) {
+	defer derrors.Wrap(&err, "ReloadWorkerConfig(%q)", filename)
+	ctx, span := observe.Start(ctx, "ReloadWorkerConfig")
+	defer span.End()
+
+	c, err := ReadWorkerConfigFile(filename)
+	if err != nil {
+		return false, err
+	}
+	changed, err = st.SetWorkerConfig(ctx, c, source)
+	if err != nil {
+		return false, err
+	}
+	if changed {
+		log.With("source", source).Infof(ctx, "worker config changed: %+v", *c)
+	}
+	return changed, nil
+}
+
+// liveConfig is a store.WorkerConfig with defaults
+// filled in and durations parsed.
+type liveConfig struct {
+	issueLimit          int
+	nvdScanWindow       time.Duration
+	minUpdateInterval   time.Duration
+	deniedModules       []string
+	cnaFeeds            map[string]string
+	notificationTargets []string
+}
+
+// loadLiveConfig reads the current worker config from st.
+// Because it is read anew for each operation, changes to the
+// config take effect without restarting the worker.
+func loadLiveConfig(ctx context.Context, st store.Store) (*liveConfig, error) {
+	c, err := st.GetWorkerConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lc := &liveConfig{
+		issueLimit:    defaultIssueLimit,
+		nvdScanWindow: defaultNVDScanWindow,
+	}
+	if c == nil {
+		return lc, nil
+	}
+	if c.IssueLimit > 0 {
+		lc.issueLimit = c.IssueLimit
+	}
+	// The durations were validated when the config was stored.
+	if d, err := time.ParseDuration(c.NVDScanWindow); err == nil && d > 0 {
+		lc.nvdScanWindow = d
+	}
+	if d, err := time.ParseDuration(c.MinUpdateInterval); err == nil {
+		lc.minUpdateInterval = d
+	}
+	lc.deniedModules = c.DeniedModules
+	lc.cnaFeeds = c.CNAFeeds
+	lc.notificationTargets = c.NotificationTargets
+	return lc, nil
+}
+
+// isDenied reports whether issues should not be created
+// for vulnerabilities in the module or package path.
+func (lc *liveConfig) isDenied(path string) bool {
+	for _, m := range lc.deniedModules {
+		if path == m || strings.HasPrefix(path, m+"/") {
+			return true
+		}
+	}
+	return false
+}
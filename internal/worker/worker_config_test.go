@@ -0,0 +1,95 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package worker
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+func TestReloadWorkerConfig(t *testing.T) {
+	ctx := context.Background()
+	mstore := store.NewMemStore()
+	filename := filepath.Join(t.TempDir(), "config.json")
+	write := func(s string) {
+		t.Helper()
+		if err := os.WriteFile(filename, []byte(s), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	reload := func(wantChanged bool) {
+		t.Helper()
+		changed, err := ReloadWorkerConfig(ctx, mstore, filename, "test")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if changed != wantChanged {
+			t.Errorf("changed = %t, want %t", changed, wantChanged)
+		}
+	}
+
+	// Before any config is loaded, the defaults apply.
+	lc, err := loadLiveConfig(ctx, mstore)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &liveConfig{issueLimit: defaultIssueLimit, nvdScanWindow: defaultNVDScanWindow}
+	if diff := cmp.Diff(want, lc, cmp.AllowUnexported(liveConfig{})); diff != "" {
+		t.Errorf("default config mismatch (-want, +got):\n%s", diff)
+	}
+
+	write(`{"issue_limit": 3, "nvd_scan_window": "48h", "denied_modules": ["example.com/a"], "cna_feeds": {"example-cna": "https://cna.example.com/cves/{id}.json"}, "notification_targets": ["https://example.com/hook", "pubsub:projects/p/topics/t"]}`)
+	reload(true)
+	reload(false)
+	lc, err = loadLiveConfig(ctx, mstore)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want = &liveConfig{issueLimit: 3, nvdScanWindow: 48 * time.Hour, deniedModules: []string{"example.com/a"},
+		cnaFeeds:            map[string]string{"example-cna": "https://cna.example.com/cves/{id}.json"},
+		notificationTargets: []string{"https://example.com/hook", "pubsub:projects/p/topics/t"}}
+	if diff := cmp.Diff(want, lc, cmp.AllowUnexported(liveConfig{})); diff != "" {
+		t.Errorf("loaded config mismatch (-want, +got):\n%s", diff)
+	}
+	for path, wantDenied := range map[string]bool{
+		"example.com/a":   true,
+		"example.com/a/b": true,
+		"example.com/ab":  false,
+		"":                false,
+	} {
+		if got := lc.isDenied(path); got != wantDenied {
+			t.Errorf("isDenied(%q) = %t, want %t", path, got, wantDenied)
+		}
+	}
+
+	// Invalid files leave the config as it was.
+	for _, bad := range []string{
+		`{"issue_limit": "3"}`,
+		`{"unknown_setting": 1}`,
+		`{"min_update_interval": "1 day"}`,
+		`{"cna_feeds": {"example-cna": "https://cna.example.com/cves.json"}}`,
+		`{"cna_feeds": {"example-cna": "file:///cves/{id}.json"}}`,
+		`{"notification_targets": ["ftp://example.com/hook"]}`,
+		`{"notification_targets": ["pubsub:projects/p/topic/t"]}`,
+	} {
+		write(bad)
+		if _, err := ReloadWorkerConfig(ctx, mstore, filename, "test"); err == nil {
+			t.Errorf("%s: got nil error, want error", bad)
+		}
+	}
+	ccs, err := mstore.ListConfigChanges(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ccs) != 1 {
+		t.Errorf("got %d config changes, want 1", len(ccs))
+	}
+}
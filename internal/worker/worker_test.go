@@ -26,6 +26,7 @@ import (
 	"golang.org/x/vulndb/internal/issues/githubtest"
 	"golang.org/x/vulndb/internal/proxy"
 	"golang.org/x/vulndb/internal/report"
+	"golang.org/x/vulndb/internal/triage/owners"
 	"golang.org/x/vulndb/internal/worker/store"
 )
 
@@ -156,6 +157,15 @@ func TestCreateIssues(t *testing.T) {
 			Path:        "path3",
 			TriageState: store.TriageStateIssueCreated,
 		},
+		{
+			ID:          "CVE-2000-0004",
+			BlobHash:    "bh4",
+			CommitHash:  "ch",
+			CommitTime:  ctime,
+			Path:        "path4",
+			Module:      "example.com/denied/sub",
+			TriageState: store.TriageStateNeedsIssue,
+		},
 	}
 	createCVE4Records(t, mstore, crs)
 	grs := []*store.LegacyGHSARecord{
@@ -195,9 +205,22 @@ func TestCreateIssues(t *testing.T) {
 			},
 			TriageState: store.TriageStateNeedsIssue,
 		},
+		{
+			GHSA: &ghsa.SecurityAdvisory{
+				ID:    ghsa6,
+				Vulns: []*ghsa.Vuln{{Package: "example.com/denied"}},
+			},
+			TriageState: store.TriageStateNeedsIssue,
+		},
 	}
 	createLegacyGHSARecords(t, mstore, grs)
 
+	// Issues are not created for denied modules; their
+	// records are left as they are.
+	if _, err := mstore.SetWorkerConfig(ctx, &store.WorkerConfig{DeniedModules: []string{"example.com/denied"}}, "test"); err != nil {
+		t.Fatal(err)
+	}
+
 	// Add an existing report with GHSA "g5".
 	rc, err := report.NewTestClient(map[string]*report.Report{
 		"data/reports/GO-1999-0001.yaml": {GHSAs: []string{ghsa5}},
@@ -311,6 +334,12 @@ Cross references:
 
 See [doc/quickstart.md](https://github.com/golang/vulndb/blob/master/doc/quickstart.md) for instructions on how to triage this report.
 
+<!-- vulndb-meta
+modules: golang.org/x/vulndb
+aliases: CVE-2000-0001
+references: https://nvd.nist.gov/vuln/detail/CVE-2000-0001
+-->
+
 ` + "```" + `
 id: GO-ID-PENDING
 modules:
@@ -323,6 +352,7 @@ references:
     - advisory: https://nvd.nist.gov/vuln/detail/CVE-2000-0001
 source:
     id: CVE-2000-0001
+    origin: CVE
     created: 1999-01-01T00:00:00Z
 review_status: UNREVIEWED
 
@@ -390,6 +420,12 @@ References:
 
 See [doc/quickstart.md](https://github.com/golang/vulndb/blob/master/doc/quickstart.md) for instructions on how to triage this report.
 
+<!-- vulndb-meta
+modules: golang.org/x/tools
+aliases: GHSA-xxxx-yyyy-zzzz
+references: https://github.com/advisories/GHSA-xxxx-yyyy-zzzz https://example.com/commit/12345
+-->
+
 ` + "```" + `
 id: GO-ID-PENDING
 modules:
@@ -405,6 +441,7 @@ references:
     - fix: https://example.com/commit/12345
 source:
     id: GHSA-xxxx-yyyy-zzzz
+    origin: GHSA
     created: 1999-01-01T00:00:00Z
 review_status: UNREVIEWED
 
@@ -415,6 +452,19 @@ review_status: UNREVIEWED
 	}
 }
 
+func TestCandidateModules(t *testing.T) {
+	r := &report.Report{
+		Modules: []*report.Module{{Module: "example.com/a"}},
+	}
+	// Units in the standard library, the toolchain or a module
+	// of the report don't need the proxy.
+	got := candidateModules(r, []string{"example.com/a/pkg", "net/http", "cmd/go", "example.com/a", "os"}, nil)
+	want := []string{"example.com/a", "std", "cmd"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("mismatch (-want, +got):\n%s", diff)
+	}
+}
+
 // unindent removes leading whitespace from s.
 // It first finds the line beginning with the fewest space and tab characters.
 // It then removes that many characters from every line.
@@ -588,3 +638,29 @@ func TestYearLabel(t *testing.T) {
 		}
 	}
 }
+
+func TestRouteIssue(t *testing.T) {
+	o, err := owners.Parse([]byte(`
+areas:
+  - name: crypto
+    prefixes: [golang.org/x/crypto]
+    reviewers: [alice]
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, test := range []struct {
+		unit         string
+		wantLabels   []string
+		wantAssignee string
+	}{
+		{"golang.org/x/crypto/ssh", []string{"NeedsTriage", "area: crypto"}, "alice"},
+		{"example.com/m", []string{"NeedsTriage"}, ""},
+	} {
+		iss := &issues.Issue{Labels: []string{"NeedsTriage"}}
+		routeIssue(iss, o, "CVE-2024-0001", test.unit)
+		if !cmp.Equal(iss.Labels, test.wantLabels) || iss.Assignee != test.wantAssignee {
+			t.Errorf("routeIssue(%s): got labels %v, assignee %q; want %v, %q", test.unit, iss.Labels, iss.Assignee, test.wantLabels, test.wantAssignee)
+		}
+	}
+}
@@ -42,6 +42,12 @@ variable "issue_repo" {
   type        = string
 }
 
+variable "db_bucket" {
+  description = "Cloud Storage bucket to publish the vulnerability database to (empty to not publish)"
+  type        = string
+  default     = ""
+}
+
 
 ################################################################
 # Cloud Run service.
@@ -85,6 +91,10 @@ resource "google_cloud_run_service" "worker" {
           name  = "VULN_WORKER_ISSUE_REPO"
           value = var.issue_repo
         }
+        env {
+          name  = "VULN_WORKER_DB_BUCKET"
+          value = var.db_bucket
+        }
         env {
           name = "VULN_GITHUB_ACCESS_TOKEN"
           value_from {
@@ -183,3 +193,166 @@ resource "google_cloud_scheduler_job" "vuln_issue_triage" {
     retry_count          = 0
   }
 }
+
+resource "google_storage_bucket_iam_member" "worker_db_bucket" {
+  count  = var.db_bucket == "" ? 0 : 1
+  bucket = var.db_bucket
+  role   = "roles/storage.objectAdmin"
+  member = "serviceAccount:${data.google_compute_default_service_account.default.email}"
+}
+
+resource "google_cloud_scheduler_job" "vuln_db_regen" {
+  name             = "vuln-${var.env}-db-regen"
+  description      = "Regenerates, validates and publishes the vulnerability database when the vulndb repo changes."
+  schedule         = "*/10 * * * *" # every 10 minutes
+  time_zone        = local.tz
+  project          = var.project
+  attempt_deadline = format("%ds", 30 * 60)
+
+  http_target {
+    http_method = "POST"
+    uri         = "${google_cloud_run_service.worker.status[0].url}/regenerate-db"
+    oidc_token {
+      service_account_email = data.google_compute_default_service_account.default.email
+      audience              = var.oauth_client_id
+    }
+  }
+
+  retry_config {
+    max_backoff_duration = "3600s"
+    max_doublings        = 5
+    max_retry_duration   = "0s"
+    min_backoff_duration = "5s"
+    retry_count          = 0
+  }
+}
+
+resource "google_cloud_scheduler_job" "vuln_cve_publication_sync" {
+  name             = "vuln-${var.env}-cve-publication-sync"
+  description      = "Records the publication state of the Go CNA's CVEs for the dashboard."
+  schedule         = "0 */6 * * *" # every 6 hours
+  time_zone        = local.tz
+  project          = var.project
+  attempt_deadline = format("%ds", 30 * 60)
+
+  http_target {
+    http_method = "POST"
+    uri         = "${google_cloud_run_service.worker.status[0].url}/sync-cve-publications"
+    oidc_token {
+      service_account_email = data.google_compute_default_service_account.default.email
+      audience              = var.oauth_client_id
+    }
+  }
+
+  retry_config {
+    max_backoff_duration = "3600s"
+    max_doublings        = 5
+    max_retry_duration   = "0s"
+    min_backoff_duration = "5s"
+    retry_count          = 0
+  }
+}
+
+resource "google_cloud_scheduler_job" "vuln_cve_retriage" {
+  name             = "vuln-${var.env}-cve-retriage"
+  description      = "Re-files CVEs triaged as not Go whose records now refer to Go modules."
+  schedule         = "30 4 * * *" # every day at 4:30
+  time_zone        = local.tz
+  project          = var.project
+  attempt_deadline = format("%ds", 30 * 60)
+
+  http_target {
+    http_method = "POST"
+    uri         = "${google_cloud_run_service.worker.status[0].url}/retriage-cves?source=cvelist"
+    oidc_token {
+      service_account_email = data.google_compute_default_service_account.default.email
+      audience              = var.oauth_client_id
+    }
+  }
+
+  retry_config {
+    max_backoff_duration = "3600s"
+    max_doublings        = 5
+    max_retry_duration   = "0s"
+    min_backoff_duration = "5s"
+    retry_count          = 0
+  }
+}
+
+resource "google_cloud_scheduler_job" "vuln_osv_scan" {
+  name             = "vuln-${var.env}-osv-scan"
+  description      = "Marks CVEs of OSV.dev advisories in other ecosystems that refer to Go modules as needing issues."
+  schedule         = "0 5 * * *" # every day at 5:00
+  time_zone        = local.tz
+  project          = var.project
+  attempt_deadline = format("%ds", 30 * 60)
+
+  http_target {
+    http_method = "POST"
+    uri         = "${google_cloud_run_service.worker.status[0].url}/scan-osv"
+    oidc_token {
+      service_account_email = data.google_compute_default_service_account.default.email
+      audience              = var.oauth_client_id
+    }
+  }
+
+  retry_config {
+    max_backoff_duration = "3600s"
+    max_doublings        = 5
+    max_retry_duration   = "0s"
+    min_backoff_duration = "5s"
+    retry_count          = 0
+  }
+}
+
+resource "google_cloud_scheduler_job" "vuln_kev_sync" {
+  name             = "vuln-${var.env}-kev-sync"
+  description      = "Escalates the reports and open issues of vulnerabilities in CISA's Known Exploited Vulnerabilities catalog."
+  schedule         = "30 5 * * *" # every day at 5:30
+  time_zone        = local.tz
+  project          = var.project
+  attempt_deadline = format("%ds", 30 * 60)
+
+  http_target {
+    http_method = "POST"
+    uri         = "${google_cloud_run_service.worker.status[0].url}/sync-kev?issues=true"
+    oidc_token {
+      service_account_email = data.google_compute_default_service_account.default.email
+      audience              = var.oauth_client_id
+    }
+  }
+
+  retry_config {
+    max_backoff_duration = "3600s"
+    max_doublings        = 5
+    max_retry_duration   = "0s"
+    min_backoff_duration = "5s"
+    retry_count          = 0
+  }
+}
+
+resource "google_cloud_scheduler_job" "vuln_epss_refresh" {
+  name             = "vuln-${var.env}-epss-refresh"
+  description      = "Records the current EPSS scores of the reports, and which reports have out-of-date scores."
+  schedule         = "0 6 * * *" # every day at 6:00
+  time_zone        = local.tz
+  project          = var.project
+  attempt_deadline = format("%ds", 30 * 60)
+
+  http_target {
+    http_method = "POST"
+    uri         = "${google_cloud_run_service.worker.status[0].url}/refresh-epss"
+    oidc_token {
+      service_account_email = data.google_compute_default_service_account.default.email
+      audience              = var.oauth_client_id
+    }
+  }
+
+  retry_config {
+    max_backoff_duration = "3600s"
+    max_doublings        = 5
+    max_retry_duration   = "0s"
+    min_backoff_duration = "5s"
+    retry_count          = 0
+  }
+}
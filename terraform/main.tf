@@ -59,12 +59,13 @@ module "prod" {
   min_frontend_instances = 1
   oauth_client_id        = var.prod_client_id
   issue_repo             = var.prod_issue_repo
+  db_bucket              = "go-vulndb"
 }
 
 
 resource "google_cloudbuild_trigger" "vulndb-redeploy" {
   project     = var.prod_project
-  description = "Rebuild vulndb database and push to GCS bucket"
+  description = "Deploy vulndb web files and check the deployed database"
   filename    = "deploy/build.yaml"
   name        = "vulndb-redeploy"
   trigger_template {